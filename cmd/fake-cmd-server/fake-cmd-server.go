@@ -31,14 +31,14 @@ func main() {
 
 	domainManager := virtwrap.NewMockDomainManager(gomock.NewController(nil))
 	domainManager.EXPECT().Exec(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
-		AnyTimes().DoAndReturn(func(domainName string, _ string, _ []string) (string, error) {
+		AnyTimes().DoAndReturn(func(domainName string, _ string, _ []string, _ int32) (string, string, error) {
 		if domainName == "error" {
-			return "", errors.New("fake error")
+			return "", "", errors.New("fake error")
 		}
 		if domainName == "fail" {
-			return "command failed", agent.ExecExitCode{ExitCode: 1}
+			return "command failed", "fake failure", agent.ExecExitCode{ExitCode: 1}
 		}
-		return "success", nil
+		return "success", "", nil
 	})
 	log.Log.Info("running fake server")
 	done, err := cmdserver.RunServer(*socket, domainManager, stopChan, options)
@@ -30,7 +30,7 @@ import (
 
 	"kubevirt.io/kubevirt/pkg/hooks"
 	hooksInfo "kubevirt.io/kubevirt/pkg/hooks/info"
-	hooksV1alpha3 "kubevirt.io/kubevirt/pkg/hooks/v1alpha3"
+	hooksNetworkBindingV1alpha1 "kubevirt.io/kubevirt/pkg/hooks/networkbinding/v1alpha1"
 
 	srv "kubevirt.io/kubevirt/cmd/sidecars/network-passt-binding/server"
 )
@@ -48,10 +48,11 @@ func main() {
 	defer os.Remove(socketPath)
 
 	server := grpc.NewServer([]grpc.ServerOption{}...)
-	hooksInfo.RegisterInfoServer(server, srv.InfoServer{Version: "v1alpha3"})
+	hooksInfo.RegisterInfoServer(server, srv.InfoServer{Version: hooksNetworkBindingV1alpha1.Version})
 
 	shutdownChan := make(chan struct{})
-	hooksV1alpha3.RegisterCallbacksServer(server, srv.V1alpha3Server{Done: shutdownChan})
-	log.Log.Infof("passt sidecar is now exposing its services on socket %s using %q API version", socketPath, "v1alpha3")
+	hooksNetworkBindingV1alpha1.RegisterNetworkBindingServer(server, srv.NetworkBindingServer{Done: shutdownChan})
+	log.Log.Infof("passt sidecar is now exposing its services on socket %s using %q API version",
+		socketPath, hooksNetworkBindingV1alpha1.Version)
 	srv.Serve(server, socket, shutdownChan)
 }
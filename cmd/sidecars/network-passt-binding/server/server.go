@@ -39,7 +39,7 @@ import (
 	"kubevirt.io/kubevirt/cmd/sidecars/network-passt-binding/domain"
 
 	hooksInfo "kubevirt.io/kubevirt/pkg/hooks/info"
-	hooksV1alpha3 "kubevirt.io/kubevirt/pkg/hooks/v1alpha3"
+	hooksNetworkBindingV1alpha1 "kubevirt.io/kubevirt/pkg/hooks/networkbinding/v1alpha1"
 )
 
 type InfoServer struct {
@@ -65,11 +65,14 @@ func (s InfoServer) Info(_ context.Context, _ *hooksInfo.InfoParams) (*hooksInfo
 	}, nil
 }
 
-type V1alpha3Server struct {
+type NetworkBindingServer struct {
 	Done chan struct{}
 }
 
-func (s V1alpha3Server) OnDefineDomain(_ context.Context, params *hooksV1alpha3.OnDefineDomainParams) (*hooksV1alpha3.OnDefineDomainResult, error) {
+func (s NetworkBindingServer) OnDefineDomain(
+	_ context.Context,
+	params *hooksNetworkBindingV1alpha1.OnDefineDomainParams,
+) (*hooksNetworkBindingV1alpha1.OnDefineDomainResult, error) {
 	vmi := &vmschema.VirtualMachineInstance{}
 	if err := json.Unmarshal(params.GetVmi(), vmi); err != nil {
 		return nil, fmt.Errorf("failed to unmarshal VMI: %v", err)
@@ -98,21 +101,56 @@ func (s V1alpha3Server) OnDefineDomain(_ context.Context, params *hooksV1alpha3.
 		return nil, err
 	}
 
-	return &hooksV1alpha3.OnDefineDomainResult{
+	return &hooksNetworkBindingV1alpha1.OnDefineDomainResult{
 		DomainXML: newDomainXML,
 	}, nil
 }
 
-func (s V1alpha3Server) PreCloudInitIso(_ context.Context, params *hooksV1alpha3.PreCloudInitIsoParams) (*hooksV1alpha3.PreCloudInitIsoResult, error) {
-	return &hooksV1alpha3.PreCloudInitIsoResult{
-		CloudInitData: params.GetCloudInitData(),
-	}, nil
+// The passt process is started by libvirt and serves the guest on its own,
+// therefore the pod network namespace requires no preparation, nor reconfiguration after a migration.
+
+func (s NetworkBindingServer) SetupNetwork(
+	_ context.Context,
+	_ *hooksNetworkBindingV1alpha1.SetupNetworkParams,
+) (*hooksNetworkBindingV1alpha1.SetupNetworkResult, error) {
+	return &hooksNetworkBindingV1alpha1.SetupNetworkResult{}, nil
+}
+
+func (s NetworkBindingServer) PostMigration(
+	_ context.Context,
+	_ *hooksNetworkBindingV1alpha1.PostMigrationParams,
+) (*hooksNetworkBindingV1alpha1.PostMigrationResult, error) {
+	return &hooksNetworkBindingV1alpha1.PostMigrationResult{}, nil
+}
+
+func (s NetworkBindingServer) HotplugInterfaces(
+	_ context.Context,
+	_ *hooksNetworkBindingV1alpha1.HotplugInterfacesParams,
+) (*hooksNetworkBindingV1alpha1.HotplugInterfacesResult, error) {
+	return &hooksNetworkBindingV1alpha1.HotplugInterfacesResult{}, nil
+}
+
+func (s NetworkBindingServer) UnplugInterfaces(
+	_ context.Context,
+	_ *hooksNetworkBindingV1alpha1.UnplugInterfacesParams,
+) (*hooksNetworkBindingV1alpha1.UnplugInterfacesResult, error) {
+	return &hooksNetworkBindingV1alpha1.UnplugInterfacesResult{}, nil
+}
+
+func (s NetworkBindingServer) InterfacesStatus(
+	_ context.Context,
+	_ *hooksNetworkBindingV1alpha1.InterfacesStatusParams,
+) (*hooksNetworkBindingV1alpha1.InterfacesStatusResult, error) {
+	return &hooksNetworkBindingV1alpha1.InterfacesStatusResult{}, nil
 }
 
-func (s V1alpha3Server) Shutdown(_ context.Context, _ *hooksV1alpha3.ShutdownParams) (*hooksV1alpha3.ShutdownResult, error) {
+func (s NetworkBindingServer) Shutdown(
+	_ context.Context,
+	_ *hooksNetworkBindingV1alpha1.ShutdownParams,
+) (*hooksNetworkBindingV1alpha1.ShutdownResult, error) {
 	log.Log.Info("Shutdown passt network binding")
 	s.Done <- struct{}{}
-	return &hooksV1alpha3.ShutdownResult{}, nil
+	return &hooksNetworkBindingV1alpha1.ShutdownResult{}, nil
 }
 
 func waitForShutdown(server *grpc.Server, errChan <-chan error, shutdownChan <-chan struct{}) {
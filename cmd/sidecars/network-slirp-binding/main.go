@@ -28,7 +28,7 @@ import (
 
 	"kubevirt.io/kubevirt/pkg/hooks"
 	hooksInfo "kubevirt.io/kubevirt/pkg/hooks/info"
-	hooksV1alpha2 "kubevirt.io/kubevirt/pkg/hooks/v1alpha2"
+	hooksNetworkBindingV1alpha1 "kubevirt.io/kubevirt/pkg/hooks/networkbinding/v1alpha1"
 
 	"kubevirt.io/client-go/log"
 
@@ -53,9 +53,10 @@ func main() {
 	defer os.Remove(socketPath)
 
 	server := grpc.NewServer([]grpc.ServerOption{}...)
-	hooksInfo.RegisterInfoServer(server, srv.InfoServer{Version: "v1alpha2"})
-	hooksV1alpha2.RegisterCallbacksServer(server, srv.V1alpha2Server{SearchDomains: searchDomains})
+	hooksInfo.RegisterInfoServer(server, srv.InfoServer{Version: hooksNetworkBindingV1alpha1.Version})
+	hooksNetworkBindingV1alpha1.RegisterNetworkBindingServer(server, srv.NetworkBindingServer{SearchDomains: searchDomains})
 
-	log.Log.Infof("Starting hook server exposing 'info' and '%s' services on socket %q", socketPath, "v1alpha2")
+	log.Log.Infof("Starting hook server exposing 'info' and '%s' services on socket %q",
+		hooksNetworkBindingV1alpha1.Version, socketPath)
 	server.Serve(socket)
 }
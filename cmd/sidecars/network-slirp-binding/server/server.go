@@ -29,7 +29,7 @@ import (
 	"kubevirt.io/client-go/log"
 
 	hooksInfo "kubevirt.io/kubevirt/pkg/hooks/info"
-	hooksV1alpha2 "kubevirt.io/kubevirt/pkg/hooks/v1alpha2"
+	hooksNetworkBindingV1alpha1 "kubevirt.io/kubevirt/pkg/hooks/networkbinding/v1alpha1"
 
 	"kubevirt.io/kubevirt/cmd/sidecars/network-slirp-binding/callback"
 	"kubevirt.io/kubevirt/cmd/sidecars/network-slirp-binding/domain"
@@ -56,11 +56,14 @@ func (s InfoServer) Info(_ context.Context, _ *hooksInfo.InfoParams) (*hooksInfo
 	}, nil
 }
 
-type V1alpha2Server struct {
+type NetworkBindingServer struct {
 	SearchDomains []string
 }
 
-func (s V1alpha2Server) OnDefineDomain(_ context.Context, params *hooksV1alpha2.OnDefineDomainParams) (*hooksV1alpha2.OnDefineDomainResult, error) {
+func (s NetworkBindingServer) OnDefineDomain(
+	_ context.Context,
+	params *hooksNetworkBindingV1alpha1.OnDefineDomainParams,
+) (*hooksNetworkBindingV1alpha1.OnDefineDomainResult, error) {
 	log.Log.Info("OnDefineDomain callback method has been called")
 
 	vmi := &vmschema.VirtualMachineInstance{}
@@ -78,15 +81,52 @@ func (s V1alpha2Server) OnDefineDomain(_ context.Context, params *hooksV1alpha2.
 		return nil, err
 	}
 
-	return &hooksV1alpha2.OnDefineDomainResult{
+	return &hooksNetworkBindingV1alpha1.OnDefineDomainResult{
 		DomainXML: newDomainXML,
 	}, nil
 }
 
-func (s V1alpha2Server) PreCloudInitIso(_ context.Context, params *hooksV1alpha2.PreCloudInitIsoParams) (*hooksV1alpha2.PreCloudInitIsoResult, error) {
-	log.Log.Info("PreCloudInitIso method has been called")
+// The user-mode network stack is part of QEMU, therefore the pod network namespace
+// requires no preparation, nor reconfiguration after a migration.
 
-	return &hooksV1alpha2.PreCloudInitIsoResult{
-		CloudInitData: params.GetCloudInitData(),
-	}, nil
+func (s NetworkBindingServer) SetupNetwork(
+	_ context.Context,
+	_ *hooksNetworkBindingV1alpha1.SetupNetworkParams,
+) (*hooksNetworkBindingV1alpha1.SetupNetworkResult, error) {
+	return &hooksNetworkBindingV1alpha1.SetupNetworkResult{}, nil
+}
+
+func (s NetworkBindingServer) PostMigration(
+	_ context.Context,
+	_ *hooksNetworkBindingV1alpha1.PostMigrationParams,
+) (*hooksNetworkBindingV1alpha1.PostMigrationResult, error) {
+	return &hooksNetworkBindingV1alpha1.PostMigrationResult{}, nil
+}
+
+func (s NetworkBindingServer) HotplugInterfaces(
+	_ context.Context,
+	_ *hooksNetworkBindingV1alpha1.HotplugInterfacesParams,
+) (*hooksNetworkBindingV1alpha1.HotplugInterfacesResult, error) {
+	return &hooksNetworkBindingV1alpha1.HotplugInterfacesResult{}, nil
+}
+
+func (s NetworkBindingServer) UnplugInterfaces(
+	_ context.Context,
+	_ *hooksNetworkBindingV1alpha1.UnplugInterfacesParams,
+) (*hooksNetworkBindingV1alpha1.UnplugInterfacesResult, error) {
+	return &hooksNetworkBindingV1alpha1.UnplugInterfacesResult{}, nil
+}
+
+func (s NetworkBindingServer) InterfacesStatus(
+	_ context.Context,
+	_ *hooksNetworkBindingV1alpha1.InterfacesStatusParams,
+) (*hooksNetworkBindingV1alpha1.InterfacesStatusResult, error) {
+	return &hooksNetworkBindingV1alpha1.InterfacesStatusResult{}, nil
+}
+
+func (s NetworkBindingServer) Shutdown(
+	_ context.Context,
+	_ *hooksNetworkBindingV1alpha1.ShutdownParams,
+) (*hooksNetworkBindingV1alpha1.ShutdownResult, error) {
+	return &hooksNetworkBindingV1alpha1.ShutdownResult{}, nil
 }
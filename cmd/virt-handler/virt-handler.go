@@ -81,6 +81,7 @@ import (
 	virtcache "kubevirt.io/kubevirt/pkg/virt-handler/cache"
 	cmdclient "kubevirt.io/kubevirt/pkg/virt-handler/cmd-client"
 	dmetricsmanager "kubevirt.io/kubevirt/pkg/virt-handler/dmetrics-manager"
+	"kubevirt.io/kubevirt/pkg/virt-handler/guestload"
 	"kubevirt.io/kubevirt/pkg/virt-handler/isolation"
 	migrationproxy "kubevirt.io/kubevirt/pkg/virt-handler/migration-proxy"
 	nodelabeller "kubevirt.io/kubevirt/pkg/virt-handler/node-labeller"
@@ -117,6 +118,9 @@ const (
 	// Default ConfigMap name of CA
 	defaultCAConfigMapName = "kubevirt-ca"
 
+	// ConfigMap name of the CAs of other clusters trusted for cross cluster migrations
+	crossClusterMigrationCAConfigMapName = "kubevirt-cross-cluster-migration-ca"
+
 	// Default certificate and key paths
 	defaultClientCertFilePath = "/etc/virt-handler/clientcertificates/tls.crt"
 	defaultClientKeyFilePath  = "/etc/virt-handler/clientcertificates/tls.key"
@@ -148,15 +152,16 @@ type virtHandlerApp struct {
 	virtCli   kubecli.KubevirtClient
 	namespace string
 
-	serverTLSConfig       *tls.Config
-	clientTLSConfig       *tls.Config
-	consoleServerPort     int
-	clientcertmanager     certificate.Manager
-	servercertmanager     certificate.Manager
-	promTLSConfig         *tls.Config
-	clusterConfig         *virtconfig.ClusterConfig
-	reloadableRateLimiter *ratelimiter.ReloadableRateLimiter
-	caManager             kvtls.ClientCAManager
+	serverTLSConfig          *tls.Config
+	clientTLSConfig          *tls.Config
+	migrationServerTLSConfig *tls.Config
+	consoleServerPort        int
+	clientcertmanager        certificate.Manager
+	servercertmanager        certificate.Manager
+	promTLSConfig            *tls.Config
+	clusterConfig            *virtconfig.ClusterConfig
+	reloadableRateLimiter    *ratelimiter.ReloadableRateLimiter
+	caManager                kvtls.ClientCAManager
 }
 
 var (
@@ -284,7 +289,7 @@ func (app *virtHandlerApp) Run() {
 
 	app.clusterConfig.SetConfigModifiedCallback(vsockConfigCallback)
 
-	migrationProxy := migrationproxy.NewMigrationProxyManager(app.serverTLSConfig, app.clientTLSConfig, app.clusterConfig)
+	migrationProxy := migrationproxy.NewMigrationProxyManager(app.migrationServerTLSConfig, app.clientTLSConfig, app.clusterConfig)
 
 	stop := make(chan struct{})
 	defer close(stop)
@@ -323,6 +328,10 @@ func (app *virtHandlerApp) Run() {
 	if err != nil {
 		panic(err)
 	}
+	migrationNetworkIpAddresses, err := virthandler.FindMigrationNetworkIPs(app.clusterConfig.GetMigrationConfiguration().AdditionalNetworks)
+	if err != nil {
+		panic(err)
+	}
 
 	downwardMetricsManager := dmetricsmanager.NewDownwardMetricsManager(app.HostOverride)
 
@@ -331,6 +340,7 @@ func (app *virtHandlerApp) Run() {
 		app.virtCli,
 		app.HostOverride,
 		migrationIpAddress,
+		migrationNetworkIpAddresses,
 		app.VirtShareDir,
 		app.VirtPrivateDir,
 		app.KubeletPodsDir,
@@ -401,6 +411,7 @@ func (app *virtHandlerApp) Run() {
 	}
 
 	go vmController.Run(10, stop)
+	go guestload.NewLoadReporter(app.virtCli, vmiSourceInformer.GetStore(), app.clusterConfig).Run(stop)
 
 	doneCh := make(chan string)
 	defer close(doneCh)
@@ -527,6 +538,7 @@ func (app *virtHandlerApp) runServer(errCh chan error, consoleHandler *rest.Cons
 	ws.Route(ws.PUT("/v1/namespaces/{namespace}/virtualmachineinstances/{name}/softreboot").To(lifecycleHandler.SoftRebootHandler))
 	ws.Route(ws.GET("/v1/namespaces/{namespace}/virtualmachineinstances/{name}/guestosinfo").To(lifecycleHandler.GetGuestInfo).Produces(restful.MIME_JSON).Consumes(restful.MIME_JSON).Returns(http.StatusOK, "OK", v1.VirtualMachineInstanceGuestAgentInfo{}))
 	ws.Route(ws.GET("/v1/namespaces/{namespace}/virtualmachineinstances/{name}/userlist").To(lifecycleHandler.GetUsers).Produces(restful.MIME_JSON).Consumes(restful.MIME_JSON).Returns(http.StatusOK, "OK", v1.VirtualMachineInstanceGuestOSUserList{}))
+	ws.Route(ws.POST("/v1/namespaces/{namespace}/virtualmachineinstances/{name}/guestexec").To(lifecycleHandler.GuestExecHandler).Produces(restful.MIME_JSON).Consumes(restful.MIME_JSON).Reads(v1.VirtualMachineInstanceGuestExecOptions{}).Returns(http.StatusOK, "OK", v1.VirtualMachineInstanceGuestExecResult{}))
 	ws.Route(ws.GET("/v1/namespaces/{namespace}/virtualmachineinstances/{name}/filesystemlist").To(lifecycleHandler.GetFilesystems).Produces(restful.MIME_JSON).Consumes(restful.MIME_JSON).Returns(http.StatusOK, "OK", v1.VirtualMachineInstanceFileSystemList{}))
 	ws.Route(ws.GET("/v1/namespaces/{namespace}/virtualmachineinstances/{name}/vsock").Param(restful.QueryParameter("port", "Target VSOCK port")).To(consoleHandler.VSOCKHandler))
 	ws.Route(ws.GET("/v1/namespaces/{namespace}/virtualmachineinstances/{name}/sev/fetchcertchain").To(lifecycleHandler.SEVFetchCertChainHandler).Produces(restful.MIME_JSON).Consumes(restful.MIME_JSON).Returns(http.StatusOK, "OK", v1.SEVPlatformInfo{}))
@@ -619,7 +631,12 @@ func (app *virtHandlerApp) setupTLS(factory controller.KubeInformerFactory) erro
 
 	app.promTLSConfig = kvtls.SetupPromTLS(app.servercertmanager, app.clusterConfig)
 	app.serverTLSConfig = kvtls.SetupTLSForVirtHandlerServer(app.caManager, app.servercertmanager, app.externallyManaged, app.clusterConfig)
-	app.clientTLSConfig = kvtls.SetupTLSForVirtHandlerClients(app.caManager, app.clientcertmanager, app.externallyManaged)
+
+	// Migrations additionally trust the virt-handlers of the clusters listed for cross cluster migrations
+	crossClusterMigrationCAConfigInformer := factory.KubeVirtCrossClusterMigrationCAConfigMap()
+	migrationCAManager := kvtls.NewMultiCAManager(app.caManager, kvtls.NewCAManager(crossClusterMigrationCAConfigInformer.GetStore(), app.namespace, crossClusterMigrationCAConfigMapName))
+	app.migrationServerTLSConfig = kvtls.SetupTLSForVirtHandlerServer(migrationCAManager, app.servercertmanager, app.externallyManaged, app.clusterConfig)
+	app.clientTLSConfig = kvtls.SetupTLSForVirtHandlerClients(migrationCAManager, app.clientcertmanager, app.externallyManaged)
 
 	return nil
 }
@@ -63,10 +63,13 @@ func main() {
 		os.Exit(0)
 	}
 
-	exitCode, stdOut, err := client.Exec(*domainName, *command, pflag.Args(), *timeoutSeconds)
+	exitCode, stdOut, stdErr, err := client.Exec(*domainName, *command, pflag.Args(), *timeoutSeconds)
 	if len(stdOut) > 0 {
 		fmt.Println(stdOut)
 	}
+	if len(stdErr) > 0 {
+		fmt.Fprintln(os.Stderr, stdErr)
+	}
 	if err != nil {
 		log.Log.Reason(err).Critical("Failed executing the command")
 	}
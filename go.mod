@@ -52,6 +52,7 @@ require (
 	github.com/prometheus/client_model v0.6.1
 	github.com/prometheus/common v0.55.0
 	github.com/prometheus/procfs v0.15.1
+	github.com/robfig/cron/v3 v3.0.1
 	github.com/spf13/cobra v1.8.1
 	github.com/spf13/pflag v1.0.5
 	github.com/vishvananda/netlink v1.3.0
@@ -68,6 +69,7 @@ require (
 	k8s.io/apiextensions-apiserver v0.31.0
 	k8s.io/apimachinery v0.31.0
 	k8s.io/client-go v12.0.0+incompatible
+	k8s.io/component-helpers v0.31.0
 	k8s.io/klog/v2 v2.130.1
 	k8s.io/kube-aggregator v0.26.4
 	k8s.io/kube-openapi v0.31.0
@@ -183,6 +185,7 @@ replace (
 	k8s.io/cluster-bootstrap => k8s.io/cluster-bootstrap v0.31.0
 	k8s.io/code-generator => k8s.io/code-generator v0.31.0
 	k8s.io/component-base => k8s.io/component-base v0.31.0
+	k8s.io/component-helpers => k8s.io/component-helpers v0.31.0
 	k8s.io/cri-api => k8s.io/cri-api v0.31.0
 	k8s.io/csi-translation-lib => k8s.io/csi-translation-lib v0.31.0
 	k8s.io/klog => k8s.io/klog v0.4.0
@@ -9,6 +9,7 @@ protoc --proto_path=pkg/hooks/info --go_out=plugins=grpc,import_path=info:pkg/ho
 protoc --proto_path=pkg/hooks/v1alpha1 --go_out=plugins=grpc,import_path=v1alpha1:pkg/hooks/v1alpha1 pkg/hooks/v1alpha1/api_v1alpha1.proto
 protoc --proto_path=pkg/hooks/v1alpha2 --go_out=plugins=grpc,import_path=v1alpha2:pkg/hooks/v1alpha2 pkg/hooks/v1alpha2/api_v1alpha2.proto
 protoc --proto_path=pkg/hooks/v1alpha3 --go_out=plugins=grpc,import_path=v1alpha3:pkg/hooks/v1alpha3 pkg/hooks/v1alpha3/api_v1alpha3.proto
+protoc --proto_path=pkg/hooks/networkbinding/v1alpha1 --go_out=plugins=grpc,import_path=v1alpha1:pkg/hooks/networkbinding/v1alpha1 pkg/hooks/networkbinding/v1alpha1/api_networkbinding_v1alpha1.proto
 protoc --go_out=plugins=grpc:. pkg/handler-launcher-com/notify/v1/notify.proto
 protoc --go_out=plugins=grpc:. pkg/handler-launcher-com/notify/info/info.proto
 protoc --go_out=plugins=grpc:. pkg/handler-launcher-com/cmd/v1/cmd.proto
@@ -39,6 +39,7 @@ import (
 	appsv1 "k8s.io/api/apps/v1"
 	batchv1 "k8s.io/api/batch/v1"
 	k8sv1 "k8s.io/api/core/v1"
+	discoveryv1 "k8s.io/api/discovery/v1"
 	networkingv1 "k8s.io/api/networking/v1"
 	policyv1 "k8s.io/api/policy/v1"
 	rbacv1 "k8s.io/api/rbac/v1"
@@ -78,6 +79,9 @@ const (
 	*/
 	OperatorLabel    = kubev1.ManagedByLabel + " in (" + kubev1.ManagedByLabelOperatorValue + "," + kubev1.ManagedByLabelOperatorOldValue + " )"
 	NotOperatorLabel = kubev1.ManagedByLabel + " notin (" + kubev1.ManagedByLabelOperatorValue + "," + kubev1.ManagedByLabelOperatorOldValue + " )"
+
+	// EndpointSliceManagedBy is the managed-by label value of the EndpointSlices published by virt-controller
+	EndpointSliceManagedBy = "virt-controller.kubevirt.io"
 )
 
 var unexpectedObjectError = errors.New("unexpected object")
@@ -135,6 +139,15 @@ type KubeInformerFactory interface {
 	// Watches VirtualMachineRestore objects
 	VirtualMachineRestore() cache.SharedIndexInformer
 
+	// Watches VirtualMachineSnapshotSchedule objects
+	VirtualMachineSnapshotSchedule() cache.SharedIndexInformer
+
+	// Watches VirtualMachineSnapshotGroup objects
+	VirtualMachineSnapshotGroup() cache.SharedIndexInformer
+
+	// Watches VirtualMachineRestoreGroup objects
+	VirtualMachineRestoreGroup() cache.SharedIndexInformer
+
 	// Watches MigrationPolicy objects
 	MigrationPolicy() cache.SharedIndexInformer
 
@@ -162,12 +175,27 @@ type KubeInformerFactory interface {
 	// Watches for the kubevirt export CA config map
 	KubeVirtExportCAConfigMap() cache.SharedIndexInformer
 
+	// Watches for the config map with the CAs of other clusters trusted for cross cluster migrations
+	KubeVirtCrossClusterMigrationCAConfigMap() cache.SharedIndexInformer
+
 	// Watches for the export route config map
 	ExportRouteConfigMap() cache.SharedIndexInformer
 
 	// Watches for the kubevirt export service
 	ExportService() cache.SharedIndexInformer
 
+	// Watches for Service objects
+	Service() cache.SharedIndexInformer
+
+	// Fake Service informer used when the VMIEndpoints feature gate is disabled
+	DummyService() cache.SharedIndexInformer
+
+	// Watches for EndpointSlice objects managed by virt-controller
+	ManagedEndpointSlice() cache.SharedIndexInformer
+
+	// Fake EndpointSlice informer used when the VMIEndpoints feature gate is disabled
+	DummyManagedEndpointSlice() cache.SharedIndexInformer
+
 	// ConfigMaps which are managed by the operator
 	OperatorConfigMap() cache.SharedIndexInformer
 
@@ -644,6 +672,30 @@ func GetVirtualMachineSnapshotInformerIndexers() cache.Indexers {
 				return []string{fmt.Sprintf("%s/%s", vms.Namespace, vms.Spec.Source.Name)}, nil
 			}
 
+			return nil, nil
+		},
+		"schedule": func(obj interface{}) ([]string, error) {
+			vms, ok := obj.(*snapshotv1.VirtualMachineSnapshot)
+			if !ok {
+				return nil, unexpectedObjectError
+			}
+
+			if scheduleName, ok := vms.Labels[snapshotv1.SnapshotScheduleLabel]; ok {
+				return []string{fmt.Sprintf("%s/%s", vms.Namespace, scheduleName)}, nil
+			}
+
+			return nil, nil
+		},
+		"group": func(obj interface{}) ([]string, error) {
+			vms, ok := obj.(*snapshotv1.VirtualMachineSnapshot)
+			if !ok {
+				return nil, unexpectedObjectError
+			}
+
+			if groupName, ok := vms.Labels[snapshotv1.SnapshotGroupLabel]; ok {
+				return []string{fmt.Sprintf("%s/%s", vms.Namespace, groupName)}, nil
+			}
+
 			return nil, nil
 		},
 	}
@@ -709,6 +761,27 @@ func (f *kubeInformerFactory) VirtualMachineRestore() cache.SharedIndexInformer
 	})
 }
 
+func (f *kubeInformerFactory) VirtualMachineSnapshotSchedule() cache.SharedIndexInformer {
+	return f.getInformer("vmSnapshotScheduleInformer", func() cache.SharedIndexInformer {
+		lw := cache.NewListWatchFromClient(f.clientSet.GeneratedKubeVirtClient().SnapshotV1beta1().RESTClient(), "virtualmachinesnapshotschedules", k8sv1.NamespaceAll, fields.Everything())
+		return cache.NewSharedIndexInformer(lw, &snapshotv1.VirtualMachineSnapshotSchedule{}, f.defaultResync, cache.Indexers{})
+	})
+}
+
+func (f *kubeInformerFactory) VirtualMachineSnapshotGroup() cache.SharedIndexInformer {
+	return f.getInformer("vmSnapshotGroupInformer", func() cache.SharedIndexInformer {
+		lw := cache.NewListWatchFromClient(f.clientSet.GeneratedKubeVirtClient().SnapshotV1beta1().RESTClient(), "virtualmachinesnapshotgroups", k8sv1.NamespaceAll, fields.Everything())
+		return cache.NewSharedIndexInformer(lw, &snapshotv1.VirtualMachineSnapshotGroup{}, f.defaultResync, cache.Indexers{})
+	})
+}
+
+func (f *kubeInformerFactory) VirtualMachineRestoreGroup() cache.SharedIndexInformer {
+	return f.getInformer("vmRestoreGroupInformer", func() cache.SharedIndexInformer {
+		lw := cache.NewListWatchFromClient(f.clientSet.GeneratedKubeVirtClient().SnapshotV1beta1().RESTClient(), "virtualmachinerestoregroups", k8sv1.NamespaceAll, fields.Everything())
+		return cache.NewSharedIndexInformer(lw, &snapshotv1.VirtualMachineRestoreGroup{}, f.defaultResync, cache.Indexers{})
+	})
+}
+
 func (f *kubeInformerFactory) MigrationPolicy() cache.SharedIndexInformer {
 	return f.getInformer("migrationPolicyInformer", func() cache.SharedIndexInformer {
 		lw := cache.NewListWatchFromClient(f.clientSet.GeneratedKubeVirtClient().MigrationsV1alpha1().RESTClient(), migrations.ResourceMigrationPolicies, k8sv1.NamespaceAll, fields.Everything())
@@ -720,9 +793,22 @@ func GetVirtualMachineCloneInformerIndexers() cache.Indexers {
 	getkey := func(vmClone *clone.VirtualMachineClone, resourceName string) string {
 		return fmt.Sprintf("%s/%s", vmClone.Namespace, resourceName)
 	}
+	// the restore of a clone is created in the namespace of the target
+	getTargetKey := func(vmClone *clone.VirtualMachineClone, resourceName string) string {
+		return fmt.Sprintf("%s/%s", getVirtualMachineCloneTargetNamespace(vmClone), resourceName)
+	}
 
 	return cache.Indexers{
 		cache.NamespaceIndex: cache.MetaNamespaceIndexFunc,
+		// Gets: namespace. Returns: clones with their target in the namespace
+		"targetNamespace": func(obj interface{}) ([]string, error) {
+			vmClone, ok := obj.(*clone.VirtualMachineClone)
+			if !ok {
+				return nil, unexpectedObjectError
+			}
+
+			return []string{getVirtualMachineCloneTargetNamespace(vmClone)}, nil
+		},
 		// Gets: snapshot key. Returns: clones that their source is the specified snapshot
 		"snapshotSource": func(obj interface{}) ([]string, error) {
 			vmClone, ok := obj.(*clone.VirtualMachineClone)
@@ -758,7 +844,7 @@ func GetVirtualMachineCloneInformerIndexers() cache.Indexers {
 			}
 
 			if vmClone.Status.Phase == clone.RestoreInProgress && vmClone.Status.RestoreName != nil {
-				return []string{getkey(vmClone, *vmClone.Status.RestoreName)}, nil
+				return []string{getTargetKey(vmClone, *vmClone.Status.RestoreName)}, nil
 			}
 
 			return nil, nil
@@ -771,7 +857,7 @@ func GetVirtualMachineCloneInformerIndexers() cache.Indexers {
 			}
 
 			if vmClone.Status.Phase == clone.Succeeded && vmClone.Status.RestoreName != nil {
-				return []string{getkey(vmClone, *vmClone.Status.RestoreName)}, nil
+				return []string{getTargetKey(vmClone, *vmClone.Status.RestoreName)}, nil
 			}
 
 			return nil, nil
@@ -779,6 +865,13 @@ func GetVirtualMachineCloneInformerIndexers() cache.Indexers {
 	}
 }
 
+func getVirtualMachineCloneTargetNamespace(vmClone *clone.VirtualMachineClone) string {
+	if vmClone.Spec.TargetNamespace != "" {
+		return vmClone.Spec.TargetNamespace
+	}
+	return vmClone.Namespace
+}
+
 func (f *kubeInformerFactory) VirtualMachineClone() cache.SharedIndexInformer {
 	return f.getInformer("virtualMachineCloneInformer", func() cache.SharedIndexInformer {
 		lw := cache.NewListWatchFromClient(f.clientSet.GeneratedKubeVirtClient().CloneV1beta1().RESTClient(), clonebase.ResourceVMClonePlural, k8sv1.NamespaceAll, fields.Everything())
@@ -915,6 +1008,15 @@ func (f *kubeInformerFactory) KubeVirtExportCAConfigMap() cache.SharedIndexInfor
 	})
 }
 
+func (f *kubeInformerFactory) KubeVirtCrossClusterMigrationCAConfigMap() cache.SharedIndexInformer {
+	return f.getInformer("extensionsKubeVirtCrossClusterMigrationCAConfigMapInformer", func() cache.SharedIndexInformer {
+		restClient := f.clientSet.CoreV1().RESTClient()
+		fieldSelector := fields.OneTermEqualSelector("metadata.name", "kubevirt-cross-cluster-migration-ca")
+		lw := cache.NewListWatchFromClient(restClient, "configmaps", f.kubevirtNamespace, fieldSelector)
+		return cache.NewSharedIndexInformer(lw, &k8sv1.ConfigMap{}, f.defaultResync, cache.Indexers{cache.NamespaceIndex: cache.MetaNamespaceIndexFunc})
+	})
+}
+
 func (f *kubeInformerFactory) ExportRouteConfigMap() cache.SharedIndexInformer {
 	return f.getInformer("extensionsExportRouteConfigMapInformer", func() cache.SharedIndexInformer {
 		restClient := f.clientSet.CoreV1().RESTClient()
@@ -937,6 +1039,56 @@ func (f *kubeInformerFactory) ExportService() cache.SharedIndexInformer {
 	})
 }
 
+func (f *kubeInformerFactory) Service() cache.SharedIndexInformer {
+	return f.getInformer("serviceInformer", func() cache.SharedIndexInformer {
+		restClient := f.clientSet.CoreV1().RESTClient()
+		lw := cache.NewListWatchFromClient(restClient, "services", k8sv1.NamespaceAll, fields.Everything())
+		return cache.NewSharedIndexInformer(lw, &k8sv1.Service{}, f.defaultResync, cache.Indexers{cache.NamespaceIndex: cache.MetaNamespaceIndexFunc})
+	})
+}
+
+func (f *kubeInformerFactory) DummyService() cache.SharedIndexInformer {
+	return f.getInformer("fakeServiceInformer", func() cache.SharedIndexInformer {
+		informer, _ := testutils.NewFakeInformerFor(&k8sv1.Service{})
+		return informer
+	})
+}
+
+func GetManagedEndpointSliceInformerIndexers() cache.Indexers {
+	return cache.Indexers{
+		"service": func(obj interface{}) ([]string, error) {
+			slice, ok := obj.(*discoveryv1.EndpointSlice)
+			if !ok {
+				return nil, unexpectedObjectError
+			}
+			serviceName, exists := slice.Labels[discoveryv1.LabelServiceName]
+			if !exists {
+				return nil, nil
+			}
+			return []string{fmt.Sprintf("%s/%s", slice.Namespace, serviceName)}, nil
+		},
+	}
+}
+
+func (f *kubeInformerFactory) ManagedEndpointSlice() cache.SharedIndexInformer {
+	return f.getInformer("managedEndpointSliceInformer", func() cache.SharedIndexInformer {
+		labelSelector, err := labels.Parse(fmt.Sprintf("%s=%s", discoveryv1.LabelManagedBy, EndpointSliceManagedBy))
+		if err != nil {
+			panic(err)
+		}
+
+		lw := NewListWatchFromClient(f.clientSet.DiscoveryV1().RESTClient(), "endpointslices", k8sv1.NamespaceAll, fields.Everything(), labelSelector)
+		return cache.NewSharedIndexInformer(lw, &discoveryv1.EndpointSlice{}, f.defaultResync, GetManagedEndpointSliceInformerIndexers())
+	})
+}
+
+func (f *kubeInformerFactory) DummyManagedEndpointSlice() cache.SharedIndexInformer {
+	return f.getInformer("fakeManagedEndpointSliceInformer", func() cache.SharedIndexInformer {
+		informer, _ := testutils.NewFakeInformerWithIndexersFor(&discoveryv1.EndpointSlice{}, GetManagedEndpointSliceInformerIndexers())
+		return informer
+	})
+}
+
 func (f *kubeInformerFactory) PersistentVolumeClaim() cache.SharedIndexInformer {
 	return f.getInformer("persistentVolumeClaimInformer", func() cache.SharedIndexInformer {
 		restClient := f.clientSet.CoreV1().RESTClient()
@@ -41,6 +41,7 @@ It has these top-level messages:
 	SEVInfoResponse
 	LaunchMeasurementResponse
 	InjectLaunchSecretRequest
+	BackupRequest
 */
 package v1
 
@@ -744,6 +745,7 @@ type ExecResponse struct {
 	Response *Response `protobuf:"bytes,1,opt,name=response" json:"response,omitempty"`
 	ExitCode int32     `protobuf:"varint,2,opt,name=exitCode" json:"exitCode,omitempty"`
 	StdOut   string    `protobuf:"bytes,3,opt,name=stdOut" json:"stdOut,omitempty"`
+	StdErr   string    `protobuf:"bytes,4,opt,name=stdErr" json:"stdErr,omitempty"`
 }
 
 func (m *ExecResponse) Reset()                    { *m = ExecResponse{} }
@@ -772,6 +774,13 @@ func (m *ExecResponse) GetStdOut() string {
 	return ""
 }
 
+func (m *ExecResponse) GetStdErr() string {
+	if m != nil {
+		return m.StdErr
+	}
+	return ""
+}
+
 type GuestPingRequest struct {
 	DomainName     string `protobuf:"bytes,1,opt,name=domainName" json:"domainName,omitempty"`
 	TimeoutSeconds int32  `protobuf:"varint,2,opt,name=timeoutSeconds" json:"timeoutSeconds,omitempty"`
@@ -932,6 +941,30 @@ func (m *InjectLaunchSecretRequest) GetOptions() []byte {
 	return nil
 }
 
+type BackupRequest struct {
+	Vmi     *VMI   `protobuf:"bytes,1,opt,name=vmi" json:"vmi,omitempty"`
+	Options []byte `protobuf:"bytes,2,opt,name=options,proto3" json:"options,omitempty"`
+}
+
+func (m *BackupRequest) Reset()                    { *m = BackupRequest{} }
+func (m *BackupRequest) String() string            { return proto.CompactTextString(m) }
+func (*BackupRequest) ProtoMessage()               {}
+func (*BackupRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{31} }
+
+func (m *BackupRequest) GetVmi() *VMI {
+	if m != nil {
+		return m.Vmi
+	}
+	return nil
+}
+
+func (m *BackupRequest) GetOptions() []byte {
+	if m != nil {
+		return m.Options
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*QemuVersionResponse)(nil), "kubevirt.cmd.v1.QemuVersionResponse")
 	proto.RegisterType((*VMI)(nil), "kubevirt.cmd.v1.VMI")
@@ -964,6 +997,7 @@ func init() {
 	proto.RegisterType((*SEVInfoResponse)(nil), "kubevirt.cmd.v1.SEVInfoResponse")
 	proto.RegisterType((*LaunchMeasurementResponse)(nil), "kubevirt.cmd.v1.LaunchMeasurementResponse")
 	proto.RegisterType((*InjectLaunchSecretRequest)(nil), "kubevirt.cmd.v1.InjectLaunchSecretRequest")
+	proto.RegisterType((*BackupRequest)(nil), "kubevirt.cmd.v1.BackupRequest")
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -1007,6 +1041,8 @@ type CmdClient interface {
 	GetSEVInfo(ctx context.Context, in *EmptyRequest, opts ...grpc.CallOption) (*SEVInfoResponse, error)
 	GetLaunchMeasurement(ctx context.Context, in *VMIRequest, opts ...grpc.CallOption) (*LaunchMeasurementResponse, error)
 	InjectLaunchSecret(ctx context.Context, in *InjectLaunchSecretRequest, opts ...grpc.CallOption) (*Response, error)
+	BackupVirtualMachine(ctx context.Context, in *BackupRequest, opts ...grpc.CallOption) (*Response, error)
+	AbortVirtualMachineBackup(ctx context.Context, in *VMIRequest, opts ...grpc.CallOption) (*Response, error)
 }
 
 type cmdClient struct {
@@ -1287,6 +1323,24 @@ func (c *cmdClient) InjectLaunchSecret(ctx context.Context, in *InjectLaunchSecr
 	return out, nil
 }
 
+func (c *cmdClient) BackupVirtualMachine(ctx context.Context, in *BackupRequest, opts ...grpc.CallOption) (*Response, error) {
+	out := new(Response)
+	err := grpc.Invoke(ctx, "/kubevirt.cmd.v1.Cmd/BackupVirtualMachine", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cmdClient) AbortVirtualMachineBackup(ctx context.Context, in *VMIRequest, opts ...grpc.CallOption) (*Response, error) {
+	out := new(Response)
+	err := grpc.Invoke(ctx, "/kubevirt.cmd.v1.Cmd/AbortVirtualMachineBackup", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // Server API for Cmd service
 
 type CmdServer interface {
@@ -1320,6 +1374,8 @@ type CmdServer interface {
 	GetSEVInfo(context.Context, *EmptyRequest) (*SEVInfoResponse, error)
 	GetLaunchMeasurement(context.Context, *VMIRequest) (*LaunchMeasurementResponse, error)
 	InjectLaunchSecret(context.Context, *InjectLaunchSecretRequest) (*Response, error)
+	BackupVirtualMachine(context.Context, *BackupRequest) (*Response, error)
+	AbortVirtualMachineBackup(context.Context, *VMIRequest) (*Response, error)
 }
 
 func RegisterCmdServer(s *grpc.Server, srv CmdServer) {
@@ -1866,6 +1922,42 @@ func _Cmd_InjectLaunchSecret_Handler(srv interface{}, ctx context.Context, dec f
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Cmd_BackupVirtualMachine_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BackupRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CmdServer).BackupVirtualMachine(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kubevirt.cmd.v1.Cmd/BackupVirtualMachine",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CmdServer).BackupVirtualMachine(ctx, req.(*BackupRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Cmd_AbortVirtualMachineBackup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VMIRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CmdServer).AbortVirtualMachineBackup(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kubevirt.cmd.v1.Cmd/AbortVirtualMachineBackup",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CmdServer).AbortVirtualMachineBackup(ctx, req.(*VMIRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Cmd_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "kubevirt.cmd.v1.Cmd",
 	HandlerType: (*CmdServer)(nil),
@@ -1990,6 +2082,14 @@ var _Cmd_serviceDesc = grpc.ServiceDesc{
 			MethodName: "InjectLaunchSecret",
 			Handler:    _Cmd_InjectLaunchSecret_Handler,
 		},
+		{
+			MethodName: "BackupVirtualMachine",
+			Handler:    _Cmd_BackupVirtualMachine_Handler,
+		},
+		{
+			MethodName: "AbortVirtualMachineBackup",
+			Handler:    _Cmd_AbortVirtualMachineBackup_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "pkg/handler-launcher-com/cmd/v1/cmd.proto",
@@ -1998,118 +2098,120 @@ var _Cmd_serviceDesc = grpc.ServiceDesc{
 func init() { proto.RegisterFile("pkg/handler-launcher-com/cmd/v1/cmd.proto", fileDescriptor0) }
 
 var fileDescriptor0 = []byte{
-	// 1802 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x59, 0x5f, 0x6f, 0x23, 0xb7,
-	0x11, 0x3f, 0x59, 0xb2, 0x2d, 0x8d, 0xff, 0xe4, 0x8e, 0xfe, 0x93, 0xb5, 0xdb, 0xbb, 0x73, 0x89,
-	0xe2, 0xe0, 0x14, 0x89, 0xdd, 0xbb, 0x5c, 0x82, 0xe2, 0x50, 0x04, 0x17, 0xcb, 0xb2, 0xe3, 0xe4,
-	0x7c, 0xa7, 0xac, 0x6c, 0x1f, 0x9a, 0x36, 0x08, 0xe8, 0x5d, 0x5a, 0x66, 0xbd, 0x4b, 0x2a, 0x4b,
-	0xae, 0x7a, 0xca, 0x53, 0x81, 0x14, 0x7d, 0x28, 0xd0, 0xcf, 0xd7, 0xb7, 0x7e, 0x8b, 0x02, 0x7d,
-	0x0c, 0xc8, 0xe5, 0xca, 0x2b, 0xed, 0xae, 0x7d, 0x86, 0xf4, 0x64, 0x92, 0x33, 0xf3, 0x9b, 0x21,
-	0x39, 0x33, 0xfc, 0x69, 0x0d, 0x1f, 0xf5, 0xae, 0xba, 0xbb, 0x97, 0x84, 0xfb, 0x01, 0x8d, 0x3e,
-	0x09, 0x48, 0xcc, 0xbd, 0x4b, 0x1a, 0x7d, 0xe2, 0x89, 0x70, 0xd7, 0x0b, 0xfd, 0xdd, 0xfe, 0x53,
-	0xfd, 0x67, 0xa7, 0x17, 0x09, 0x25, 0xd0, 0x07, 0x57, 0xf1, 0x39, 0xed, 0xb3, 0x48, 0xed, 0xe8,
-	0xb5, 0xfe, 0x53, 0x7c, 0x01, 0x2b, 0xdf, 0xd2, 0x30, 0x3e, 0xa3, 0x91, 0x64, 0x82, 0xbb, 0x54,
-	0xf6, 0x04, 0x97, 0x14, 0x7d, 0x06, 0xf5, 0xc8, 0x8e, 0x9d, 0xca, 0x56, 0x65, 0x7b, 0xe1, 0xd9,
-	0xc6, 0xce, 0x98, 0xe9, 0x4e, 0xaa, 0xec, 0x0e, 0x55, 0x91, 0x03, 0xf3, 0xfd, 0x04, 0xc9, 0x99,
-	0xd9, 0xaa, 0x6c, 0x37, 0xdc, 0x74, 0x8a, 0x1f, 0x43, 0xf5, 0xec, 0xf8, 0xc8, 0x28, 0x84, 0xec,
-	0x6b, 0x29, 0xb8, 0x81, 0x5d, 0x74, 0xd3, 0x29, 0x7e, 0x0a, 0xd5, 0x66, 0xfb, 0x14, 0x2d, 0xc3,
-	0x0c, 0xf3, 0x8d, 0x6c, 0xc9, 0x9d, 0x61, 0x3e, 0xda, 0x84, 0xba, 0x64, 0xe7, 0x01, 0xe3, 0x5d,
-	0xe9, 0xcc, 0x6c, 0x55, 0xb7, 0x97, 0xdc, 0xe1, 0x1c, 0xef, 0xc2, 0x7c, 0x27, 0x19, 0xe7, 0xcc,
-	0x56, 0x61, 0xb6, 0x4f, 0x82, 0x98, 0x9a, 0x30, 0x6a, 0x6e, 0x32, 0xc1, 0x2d, 0x98, 0x6d, 0x93,
-	0x2e, 0x95, 0x5a, 0xec, 0x89, 0x98, 0x2b, 0x63, 0x51, 0x73, 0x93, 0x09, 0x42, 0x50, 0x8b, 0x39,
-	0x53, 0x36, 0x74, 0x33, 0xd6, 0x6b, 0x92, 0xfd, 0x44, 0x9d, 0xaa, 0x81, 0x36, 0x63, 0xfc, 0x1c,
-	0xe6, 0x8e, 0x69, 0x28, 0xa2, 0x01, 0x5a, 0x87, 0x39, 0x12, 0x66, 0x80, 0xec, 0xac, 0x08, 0x09,
-	0xff, 0xa7, 0x02, 0xb5, 0x26, 0x0d, 0x82, 0x5c, 0xac, 0xbb, 0x30, 0x17, 0x1a, 0x38, 0xa3, 0xbe,
-	0xf0, 0xec, 0xc3, 0xdc, 0x49, 0x27, 0xde, 0x5c, 0xab, 0x86, 0x3e, 0x86, 0xd9, 0x9e, 0xde, 0x86,
-	0x53, 0xdd, 0xaa, 0x6e, 0x2f, 0x3c, 0x5b, 0xcf, 0xe9, 0x9b, 0x4d, 0xba, 0x89, 0x12, 0xfa, 0x1c,
-	0x1a, 0x3e, 0x93, 0x8a, 0x70, 0x8f, 0x4a, 0xa7, 0x66, 0x2c, 0x9c, 0x9c, 0x85, 0x3d, 0x47, 0xf7,
-	0x5a, 0x15, 0x6d, 0x43, 0xcd, 0xeb, 0xc5, 0xd2, 0x99, 0x35, 0x26, 0xab, 0x39, 0x93, 0x66, 0xfb,
-	0xd4, 0x35, 0x1a, 0xf8, 0x25, 0xd4, 0x4f, 0x44, 0x4f, 0x04, 0xa2, 0x3b, 0x40, 0xcf, 0x01, 0x78,
-	0x1c, 0x92, 0x1f, 0x3c, 0x1a, 0x04, 0xd2, 0xa9, 0x18, 0xdb, 0xb5, 0xbc, 0x2d, 0x0d, 0x02, 0xb7,
-	0xa1, 0x15, 0xf5, 0x48, 0xe2, 0x7f, 0x55, 0x60, 0xae, 0x73, 0xbc, 0xc7, 0x84, 0x44, 0x18, 0x16,
-	0x43, 0xc2, 0xe3, 0x0b, 0xe2, 0xa9, 0x38, 0xa2, 0x91, 0x39, 0xa7, 0x86, 0x3b, 0xb2, 0xa6, 0xb3,
-	0xa8, 0x17, 0x09, 0x3f, 0xf6, 0xd2, 0x13, 0x4e, 0xa7, 0xd9, 0x04, 0xac, 0x8e, 0x24, 0x20, 0xba,
-	0x0f, 0x55, 0x79, 0x15, 0x3b, 0x35, 0xb3, 0xaa, 0x87, 0xfa, 0xf2, 0x2e, 0x48, 0xc8, 0x82, 0x81,
-	0x33, 0x6b, 0x16, 0xed, 0x0c, 0xff, 0xb3, 0x02, 0xf5, 0x7d, 0x26, 0xaf, 0x8e, 0xf8, 0x85, 0x30,
-	0x4a, 0x22, 0x0a, 0x89, 0xb2, 0x81, 0xd8, 0x19, 0xda, 0x82, 0x85, 0x73, 0xe2, 0x5d, 0x31, 0xde,
-	0x3d, 0x60, 0x01, 0xb5, 0x61, 0x64, 0x97, 0xd0, 0x23, 0x00, 0x1d, 0x2f, 0x09, 0x3a, 0x69, 0xfe,
-	0xd4, 0xdc, 0xcc, 0x8a, 0x46, 0xd0, 0x47, 0x92, 0x2a, 0xd4, 0x8c, 0x42, 0x76, 0x09, 0xff, 0xaf,
-	0x02, 0x4b, 0xcd, 0x20, 0x96, 0x8a, 0x46, 0x4d, 0xc1, 0x2f, 0x58, 0x17, 0xed, 0x00, 0x6a, 0xbd,
-	0xeb, 0x11, 0xee, 0xeb, 0xf8, 0x64, 0x8b, 0x93, 0xf3, 0x80, 0x26, 0xa9, 0x54, 0x77, 0x0b, 0x24,
-	0xe8, 0x8f, 0xb0, 0x71, 0x10, 0x51, 0xaa, 0xf3, 0xc1, 0xa5, 0x3d, 0x11, 0x29, 0xc6, 0xbb, 0xfb,
-	0x4c, 0x26, 0x66, 0x33, 0xc6, 0xac, 0x5c, 0x01, 0xbd, 0x00, 0x67, 0x4f, 0x78, 0x97, 0x72, 0x9f,
-	0xc9, 0x5e, 0x40, 0x06, 0x07, 0x22, 0x6a, 0x1d, 0x1c, 0x1d, 0xc6, 0x54, 0x2a, 0x69, 0xf6, 0x53,
-	0x77, 0x4b, 0xe5, 0xda, 0xb6, 0x43, 0x23, 0x46, 0x82, 0xa6, 0xe0, 0x52, 0x04, 0xf4, 0x95, 0xb8,
-	0x76, 0x5c, 0x4b, 0x6c, 0xcb, 0xe4, 0xf8, 0x53, 0xd8, 0x38, 0xe2, 0x8a, 0x46, 0x17, 0xc4, 0xa3,
-	0x7b, 0x8c, 0xfb, 0x8c, 0x77, 0x8f, 0x59, 0x37, 0x22, 0x4a, 0xdf, 0xe3, 0xba, 0x2e, 0x3e, 0x75,
-	0x29, 0xfc, 0xf4, 0x42, 0x92, 0x19, 0xfe, 0xef, 0x3c, 0xac, 0x9d, 0x25, 0x87, 0x77, 0x4c, 0xbc,
-	0x4b, 0xc6, 0xe9, 0x9b, 0x9e, 0x36, 0x90, 0xe8, 0x1b, 0x58, 0x1d, 0x15, 0x24, 0x99, 0x66, 0xfb,
-	0x5a, 0xbe, 0xda, 0x12, 0xb1, 0x5b, 0x68, 0x84, 0x9e, 0xc3, 0xda, 0x31, 0x0d, 0xf7, 0x48, 0x10,
-	0x08, 0xc1, 0x3b, 0x8a, 0x28, 0xd9, 0xa6, 0x11, 0x13, 0xc9, 0x69, 0x2e, 0xb9, 0xc5, 0x42, 0xf4,
-	0x7b, 0x58, 0x69, 0x47, 0x54, 0xaf, 0x7b, 0x44, 0x51, 0xff, 0x4c, 0x04, 0x71, 0x68, 0xeb, 0xb7,
-	0xe1, 0x16, 0x89, 0x74, 0x03, 0x56, 0xb6, 0xa6, 0xcc, 0x79, 0x15, 0x35, 0xe0, 0xb4, 0xe8, 0xdc,
-	0xa1, 0x2a, 0xea, 0x40, 0xc3, 0x24, 0x80, 0xce, 0x5d, 0x5b, 0xb9, 0x9f, 0xe5, 0xec, 0x0a, 0x8f,
-	0x69, 0x67, 0x68, 0xd7, 0xe2, 0x2a, 0x1a, 0xb8, 0xd7, 0x38, 0x25, 0x59, 0x37, 0x57, 0x9a, 0x75,
-	0xfb, 0xb0, 0xe4, 0x65, 0xd3, 0xd6, 0x99, 0x37, 0x1b, 0x78, 0x94, 0x6f, 0x03, 0x59, 0x2d, 0x77,
-	0xd4, 0x08, 0xfd, 0x5c, 0x81, 0x0d, 0x96, 0xa6, 0xc1, 0xbe, 0x08, 0x09, 0xe3, 0x5f, 0x2a, 0x45,
-	0xbc, 0xcb, 0x90, 0x72, 0xe5, 0xd4, 0xcd, 0xde, 0x5a, 0xef, 0xb9, 0xb7, 0xa3, 0x32, 0x9c, 0x64,
-	0xaf, 0xe5, 0x7e, 0x10, 0x07, 0x34, 0x14, 0x0e, 0x93, 0xd0, 0x69, 0x18, 0xef, 0x5f, 0xdc, 0xd5,
-	0xfb, 0x10, 0x20, 0x71, 0x5b, 0x80, 0xbc, 0xf9, 0x16, 0x96, 0x47, 0x2f, 0x42, 0x37, 0xae, 0x2b,
-	0x3a, 0xb0, 0xd9, 0xae, 0x87, 0x68, 0x37, 0xfb, 0xb8, 0x15, 0x25, 0x46, 0xda, 0xbd, 0xec, 0xbb,
-	0xf7, 0x62, 0xe6, 0x0f, 0x95, 0xcd, 0x57, 0xf0, 0xe8, 0xe6, 0x53, 0x28, 0x70, 0x34, 0xf2, 0x8a,
-	0x36, 0xb2, 0x68, 0x3f, 0xc2, 0x87, 0x25, 0xbb, 0x2a, 0x80, 0x79, 0x39, 0x1a, 0xef, 0xef, 0x72,
-	0xf1, 0x96, 0x56, 0x7b, 0xc6, 0x25, 0xee, 0x03, 0x9c, 0x1d, 0x1f, 0xb9, 0xf4, 0x47, 0xdd, 0x60,
-	0xd0, 0x13, 0xa8, 0xf6, 0x43, 0x66, 0x6b, 0x38, 0xff, 0x38, 0x69, 0x4d, 0xad, 0x80, 0x5e, 0xc2,
-	0xbc, 0x48, 0xae, 0xc1, 0x7a, 0x7f, 0xf2, 0x7e, 0x97, 0xe6, 0xa6, 0x66, 0xf8, 0x04, 0xee, 0x5f,
-	0xc7, 0x73, 0x47, 0xef, 0xce, 0xa8, 0xf7, 0xc5, 0x6b, 0xd4, 0x9f, 0x2b, 0xb0, 0xd0, 0x7a, 0x47,
-	0xbd, 0x14, 0xf1, 0x11, 0x80, 0x6f, 0x6e, 0xe5, 0x35, 0x09, 0xa9, 0x3d, 0xbc, 0xcc, 0x8a, 0x46,
-	0x6a, 0x8a, 0x30, 0x24, 0xdc, 0x4f, 0x9f, 0x3c, 0x3b, 0xd5, 0x5c, 0xe3, 0xcb, 0xa8, 0x9b, 0x36,
-	0x13, 0x33, 0x46, 0x4f, 0x60, 0x59, 0xb1, 0x90, 0x8a, 0x58, 0x75, 0xa8, 0x27, 0xb8, 0x2f, 0x4d,
-	0x0f, 0x99, 0x75, 0xc7, 0x56, 0xf1, 0x32, 0x2c, 0xb6, 0xc2, 0x9e, 0x1a, 0xd8, 0x28, 0xf0, 0x17,
-	0x50, 0x77, 0x33, 0x5c, 0x4e, 0xc6, 0x9e, 0x47, 0xa5, 0xb4, 0x0f, 0x4c, 0x3a, 0xd5, 0x92, 0x90,
-	0x4a, 0x49, 0xba, 0x69, 0x62, 0xa4, 0x53, 0xfc, 0x03, 0x2c, 0x27, 0xb9, 0x35, 0x29, 0x91, 0x5c,
-	0x87, 0xb9, 0x64, 0xf3, 0xd6, 0x83, 0x9d, 0x61, 0x0e, 0x2b, 0x89, 0x03, 0xd3, 0x5d, 0x27, 0xf5,
-	0xb2, 0x05, 0x0b, 0xfe, 0x35, 0x5a, 0xfa, 0x88, 0x67, 0x96, 0xf0, 0x3b, 0x78, 0x60, 0x1e, 0x34,
-	0x53, 0x4d, 0x13, 0x7a, 0xfb, 0x18, 0x1e, 0x74, 0xc7, 0xb1, 0xac, 0xcf, 0xbc, 0x00, 0xff, 0xa3,
-	0x02, 0x6b, 0xc6, 0xf5, 0xa9, 0xa4, 0xd1, 0x2b, 0x26, 0xd5, 0xa4, 0xee, 0x9f, 0xc3, 0x5a, 0xb7,
-	0x08, 0xcf, 0x86, 0x50, 0x2c, 0xc4, 0xff, 0xae, 0x80, 0x63, 0xc2, 0xd0, 0x9c, 0x46, 0x0e, 0xa4,
-	0xa2, 0xe1, 0xc4, 0xc7, 0xfe, 0x02, 0x9c, 0x6e, 0x09, 0xa4, 0x0d, 0xa6, 0x54, 0x8e, 0x07, 0xb0,
-	0x98, 0x94, 0xcd, 0x64, 0x21, 0x6c, 0x42, 0x9d, 0xbe, 0x63, 0xaa, 0x29, 0xfc, 0xc4, 0xe5, 0xac,
-	0x3b, 0x9c, 0xeb, 0xdc, 0x93, 0xca, 0x7f, 0x13, 0x2b, 0x4b, 0x21, 0xed, 0x0c, 0x7f, 0x07, 0xf7,
-	0xcd, 0x49, 0xb4, 0x35, 0x51, 0x7e, 0xcf, 0xb2, 0xcd, 0x17, 0xe2, 0x4c, 0x61, 0x21, 0x7e, 0x6d,
-	0xf3, 0x2c, 0xc1, 0x9e, 0x68, 0x6f, 0x58, 0xc0, 0x92, 0xe6, 0x74, 0x3f, 0xd1, 0xbb, 0x76, 0xab,
-	0xcf, 0x61, 0x3d, 0xe6, 0x17, 0xc6, 0xf4, 0xa4, 0x28, 0xe8, 0x12, 0x29, 0x7e, 0x0b, 0x0f, 0x92,
-	0x5f, 0x28, 0xfb, 0x71, 0xd8, 0xbb, 0xab, 0xd3, 0x4d, 0xa8, 0xfb, 0x71, 0xd8, 0x6b, 0x13, 0x75,
-	0x69, 0x2f, 0x7f, 0x38, 0xc7, 0xe7, 0xf0, 0x41, 0xa7, 0x75, 0x36, 0x8d, 0xda, 0xd3, 0xcd, 0x8c,
-	0xf6, 0x0d, 0x2b, 0xb2, 0x8d, 0xd8, 0x4e, 0xf1, 0xdf, 0x2b, 0xb0, 0xf1, 0xca, 0xfc, 0x66, 0x3e,
-	0xa6, 0x44, 0xc6, 0x11, 0xd5, 0x0f, 0xe2, 0x14, 0x4a, 0x3d, 0x18, 0xc7, 0xb4, 0x8e, 0xf3, 0x02,
-	0xfc, 0xbd, 0xe6, 0xbb, 0x7f, 0xa5, 0x9e, 0x4a, 0xe2, 0xe8, 0x50, 0x2f, 0xa2, 0x6a, 0x6a, 0x4f,
-	0xcd, 0xb3, 0xff, 0xaf, 0x40, 0xb5, 0x19, 0xfa, 0xe8, 0x35, 0xa0, 0xce, 0x80, 0x7b, 0xa3, 0xcf,
-	0x1d, 0xfa, 0x55, 0x21, 0x64, 0xe2, 0x7c, 0xb3, 0x7c, 0xb3, 0xf8, 0x1e, 0x7a, 0x03, 0x2b, 0x6d,
-	0x12, 0x4b, 0x3a, 0x35, 0xc0, 0x6f, 0x61, 0xed, 0x94, 0xf7, 0xa6, 0x0a, 0xd9, 0x81, 0xd5, 0xa4,
-	0x16, 0xc6, 0x10, 0xf3, 0x5c, 0x74, 0xa4, 0x64, 0x6e, 0x06, 0x75, 0x61, 0xfd, 0xd4, 0x56, 0xc2,
-	0xd4, 0x02, 0x3d, 0x01, 0xa7, 0x23, 0x2e, 0x94, 0x4b, 0xcf, 0x85, 0x50, 0x53, 0x43, 0x75, 0x61,
-	0xbd, 0x73, 0x19, 0x2b, 0x5f, 0xfc, 0x8d, 0x4f, 0x0d, 0xf3, 0x35, 0xa0, 0x6f, 0x58, 0x10, 0x4c,
-	0x0d, 0xaf, 0x0d, 0xab, 0xfb, 0x34, 0xa0, 0x6a, 0x7a, 0x67, 0xf9, 0x16, 0xd6, 0x12, 0xc6, 0x36,
-	0x0e, 0xf9, 0x9b, 0xfc, 0x97, 0x95, 0x31, 0x66, 0x77, 0x6b, 0xc6, 0xeb, 0x0a, 0x1a, 0x1a, 0x9d,
-	0x90, 0xa8, 0x4b, 0xd5, 0x04, 0x91, 0xfe, 0x09, 0x1e, 0x36, 0x09, 0xf7, 0xe8, 0xd8, 0x69, 0x5e,
-	0xff, 0xda, 0x9d, 0xec, 0xea, 0x59, 0x97, 0x93, 0x20, 0x09, 0xb2, 0x2d, 0xfc, 0x66, 0x40, 0x09,
-	0x8f, 0x7b, 0x13, 0x60, 0xfe, 0x19, 0x1e, 0x1f, 0x30, 0x4e, 0x02, 0x36, 0x9e, 0xf8, 0xd3, 0x08,
-	0xf8, 0x35, 0xa0, 0xaf, 0x84, 0xea, 0x05, 0x71, 0xf7, 0x2b, 0x21, 0xd5, 0x3e, 0xed, 0x33, 0x8f,
-	0xca, 0x09, 0xf0, 0x8e, 0xa1, 0x71, 0x48, 0x55, 0xc2, 0x16, 0xd1, 0xc3, 0x9c, 0x66, 0x96, 0xf7,
-	0x6e, 0x3e, 0xce, 0xff, 0x84, 0x1a, 0xa1, 0xb1, 0x26, 0xa9, 0x96, 0x87, 0x70, 0x86, 0x1b, 0xde,
-	0x86, 0xf9, 0xdb, 0x12, 0xcc, 0x11, 0xe6, 0x6a, 0x5a, 0xd4, 0xe2, 0x21, 0x55, 0x43, 0x96, 0x79,
-	0x1b, 0x2c, 0xce, 0x89, 0x73, 0x04, 0xd5, 0x80, 0xd6, 0x0f, 0xa9, 0x61, 0x73, 0xb7, 0xc6, 0xf9,
-	0xa4, 0x18, 0x30, 0xc7, 0x04, 0xef, 0xa1, 0xbf, 0x98, 0x23, 0xc8, 0xb0, 0xb2, 0xdb, 0xa0, 0x3f,
-	0x2a, 0x86, 0x2e, 0xe2, 0x75, 0xf7, 0xd0, 0x1e, 0xd4, 0x34, 0xfb, 0xb9, 0x0d, 0xf3, 0xc6, 0x3b,
-	0x6f, 0x41, 0x4d, 0xb3, 0x43, 0xf4, 0xeb, 0x3c, 0xc6, 0xf5, 0x6f, 0xad, 0xcd, 0x87, 0x25, 0xd2,
-	0x4c, 0x33, 0x6e, 0x0c, 0xd9, 0x58, 0x41, 0xd3, 0x18, 0x67, 0x81, 0x65, 0x77, 0x92, 0x25, 0x73,
-	0xa6, 0x7a, 0x9c, 0xb1, 0xaa, 0x19, 0x92, 0x26, 0x84, 0x4b, 0xbe, 0xf9, 0x66, 0x18, 0xd5, 0x6d,
-	0x3d, 0x4f, 0xdf, 0x4d, 0xe6, 0x53, 0xfe, 0xdd, 0xd3, 0xb3, 0xe0, 0xff, 0x00, 0xb6, 0x8f, 0xe4,
-	0x58, 0x43, 0xb3, 0x7d, 0x2a, 0x27, 0x7c, 0xec, 0x72, 0x98, 0xf6, 0x93, 0xfa, 0x24, 0x7c, 0x04,
-	0x0e, 0xa9, 0xb2, 0x84, 0xf1, 0xb6, 0xed, 0x6f, 0xe5, 0x3f, 0xfb, 0x8d, 0x32, 0x4d, 0x7c, 0x0f,
-	0x11, 0x58, 0x3d, 0xa4, 0x2a, 0x47, 0x0e, 0x6f, 0x0e, 0x31, 0xff, 0x75, 0xa3, 0x94, 0x5d, 0xe2,
-	0x7b, 0xe8, 0x7b, 0x40, 0x79, 0xea, 0x87, 0x8a, 0xbe, 0x90, 0x94, 0xf0, 0xc3, 0x1b, 0x8f, 0x64,
-	0xaf, 0xf6, 0xdd, 0x4c, 0xff, 0xe9, 0xf9, 0x9c, 0xf9, 0xdf, 0xcf, 0xa7, 0xbf, 0x04, 0x00, 0x00,
-	0xff, 0xff, 0x7a, 0xa3, 0xf8, 0x64, 0x28, 0x1a, 0x00, 0x00,
+	// 1840 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x59, 0x5f, 0x73, 0x1b, 0xb7,
+	0x11, 0x17, 0x45, 0x4a, 0x22, 0x57, 0x7f, 0x62, 0xc3, 0x92, 0x72, 0x52, 0x6b, 0x5b, 0xbd, 0xe9,
+	0x78, 0x94, 0x4e, 0x22, 0xd5, 0x8e, 0x93, 0xe9, 0x78, 0x3a, 0x19, 0x5b, 0x14, 0xa5, 0x28, 0xb1,
+	0x6c, 0xfa, 0x28, 0xc9, 0xd3, 0xb4, 0x99, 0x0c, 0x74, 0x07, 0x51, 0xa8, 0xee, 0x80, 0xcb, 0x01,
+	0xc7, 0x9a, 0x7e, 0xea, 0x4c, 0x3a, 0x7d, 0xe8, 0x4c, 0x1f, 0xfa, 0x1d, 0xfa, 0x9d, 0xfa, 0xd6,
+	0x6f, 0xd1, 0xf7, 0x0e, 0x70, 0x38, 0xea, 0xc8, 0xbb, 0x13, 0xad, 0x90, 0x4f, 0xc2, 0x62, 0x77,
+	0x7f, 0xbb, 0x00, 0x76, 0x81, 0x1f, 0x4f, 0xf0, 0x49, 0x78, 0xd5, 0xdd, 0xbd, 0xc4, 0xcc, 0xf3,
+	0x49, 0xf4, 0x99, 0x8f, 0x63, 0xe6, 0x5e, 0x92, 0xe8, 0x33, 0x97, 0x07, 0xbb, 0x6e, 0xe0, 0xed,
+	0xf6, 0x1e, 0xab, 0x3f, 0x3b, 0x61, 0xc4, 0x25, 0x47, 0x1f, 0x5d, 0xc5, 0xe7, 0xa4, 0x47, 0x23,
+	0xb9, 0xa3, 0xe6, 0x7a, 0x8f, 0xed, 0x0b, 0xb8, 0xf7, 0x86, 0x04, 0xf1, 0x19, 0x89, 0x04, 0xe5,
+	0xcc, 0x21, 0x22, 0xe4, 0x4c, 0x10, 0xf4, 0x05, 0xd4, 0x23, 0x33, 0xb6, 0x2a, 0x5b, 0x95, 0xed,
+	0xc5, 0x27, 0x1b, 0x3b, 0x23, 0xae, 0x3b, 0xa9, 0xb1, 0x33, 0x30, 0x45, 0x16, 0x2c, 0xf4, 0x12,
+	0x24, 0x6b, 0x76, 0xab, 0xb2, 0xdd, 0x70, 0x52, 0xd1, 0x7e, 0x08, 0xd5, 0xb3, 0xe3, 0x23, 0x6d,
+	0x10, 0xd0, 0x6f, 0x04, 0x67, 0x1a, 0x76, 0xc9, 0x49, 0x45, 0xfb, 0x31, 0x54, 0x9b, 0xed, 0x53,
+	0xb4, 0x02, 0xb3, 0xd4, 0xd3, 0xba, 0x65, 0x67, 0x96, 0x7a, 0x68, 0x13, 0xea, 0x82, 0x9e, 0xfb,
+	0x94, 0x75, 0x85, 0x35, 0xbb, 0x55, 0xdd, 0x5e, 0x76, 0x06, 0xb2, 0xbd, 0x0b, 0x0b, 0x9d, 0x64,
+	0x9c, 0x73, 0x5b, 0x85, 0xb9, 0x1e, 0xf6, 0x63, 0xa2, 0xd3, 0xa8, 0x39, 0x89, 0x60, 0xb7, 0x60,
+	0xae, 0x8d, 0xbb, 0x44, 0x28, 0xb5, 0xcb, 0x63, 0x26, 0xb5, 0x47, 0xcd, 0x49, 0x04, 0x84, 0xa0,
+	0x16, 0x33, 0x2a, 0x4d, 0xea, 0x7a, 0xac, 0xe6, 0x04, 0x7d, 0x4f, 0xac, 0xaa, 0x86, 0xd6, 0x63,
+	0xfb, 0x29, 0xcc, 0x1f, 0x93, 0x80, 0x47, 0x7d, 0xb4, 0x0e, 0xf3, 0x38, 0xc8, 0x00, 0x19, 0xa9,
+	0x08, 0xc9, 0xfe, 0x4f, 0x05, 0x6a, 0x4d, 0xe2, 0xfb, 0xb9, 0x5c, 0x77, 0x61, 0x3e, 0xd0, 0x70,
+	0xda, 0x7c, 0xf1, 0xc9, 0xc7, 0xb9, 0x9d, 0x4e, 0xa2, 0x39, 0xc6, 0x0c, 0x7d, 0x0a, 0x73, 0xa1,
+	0x5a, 0x86, 0x55, 0xdd, 0xaa, 0x6e, 0x2f, 0x3e, 0x59, 0xcf, 0xd9, 0xeb, 0x45, 0x3a, 0x89, 0x11,
+	0xfa, 0x12, 0x1a, 0x1e, 0x15, 0x12, 0x33, 0x97, 0x08, 0xab, 0xa6, 0x3d, 0xac, 0x9c, 0x87, 0xd9,
+	0x47, 0xe7, 0xda, 0x14, 0x6d, 0x43, 0xcd, 0x0d, 0x63, 0x61, 0xcd, 0x69, 0x97, 0xd5, 0x9c, 0x4b,
+	0xb3, 0x7d, 0xea, 0x68, 0x0b, 0xfb, 0x39, 0xd4, 0x4f, 0x78, 0xc8, 0x7d, 0xde, 0xed, 0xa3, 0xa7,
+	0x00, 0x2c, 0x0e, 0xf0, 0x0f, 0x2e, 0xf1, 0x7d, 0x61, 0x55, 0xb4, 0xef, 0x5a, 0xde, 0x97, 0xf8,
+	0xbe, 0xd3, 0x50, 0x86, 0x6a, 0x24, 0xec, 0x7f, 0x54, 0x60, 0xbe, 0x73, 0xbc, 0x47, 0xb9, 0x40,
+	0x36, 0x2c, 0x05, 0x98, 0xc5, 0x17, 0xd8, 0x95, 0x71, 0x44, 0x22, 0xbd, 0x4f, 0x0d, 0x67, 0x68,
+	0x4e, 0x55, 0x51, 0x18, 0x71, 0x2f, 0x76, 0xd3, 0x1d, 0x4e, 0xc5, 0x6c, 0x01, 0x56, 0x87, 0x0a,
+	0x10, 0xdd, 0x81, 0xaa, 0xb8, 0x8a, 0xad, 0x9a, 0x9e, 0x55, 0x43, 0x75, 0x78, 0x17, 0x38, 0xa0,
+	0x7e, 0xdf, 0x9a, 0xd3, 0x93, 0x46, 0xb2, 0xff, 0x5e, 0x81, 0xfa, 0x3e, 0x15, 0x57, 0x47, 0xec,
+	0x82, 0x6b, 0x23, 0x1e, 0x05, 0x58, 0x9a, 0x44, 0x8c, 0x84, 0xb6, 0x60, 0xf1, 0x1c, 0xbb, 0x57,
+	0x94, 0x75, 0x0f, 0xa8, 0x4f, 0x4c, 0x1a, 0xd9, 0x29, 0xf4, 0x00, 0x40, 0xe5, 0x8b, 0xfd, 0x4e,
+	0x5a, 0x3f, 0x35, 0x27, 0x33, 0xa3, 0x10, 0xd4, 0x96, 0xa4, 0x06, 0x35, 0x6d, 0x90, 0x9d, 0xb2,
+	0xff, 0x57, 0x81, 0xe5, 0xa6, 0x1f, 0x0b, 0x49, 0xa2, 0x26, 0x67, 0x17, 0xb4, 0x8b, 0x76, 0x00,
+	0xb5, 0xde, 0x85, 0x98, 0x79, 0x2a, 0x3f, 0xd1, 0x62, 0xf8, 0xdc, 0x27, 0x49, 0x29, 0xd5, 0x9d,
+	0x02, 0x0d, 0xfa, 0x3d, 0x6c, 0x1c, 0x44, 0x84, 0xa8, 0x7a, 0x70, 0x48, 0xc8, 0x23, 0x49, 0x59,
+	0x77, 0x9f, 0x8a, 0xc4, 0x6d, 0x56, 0xbb, 0x95, 0x1b, 0xa0, 0x67, 0x60, 0xed, 0x71, 0xf7, 0x52,
+	0xec, 0x53, 0x11, 0xfa, 0xb8, 0x7f, 0xc0, 0xa3, 0xd6, 0xc1, 0xd1, 0x61, 0x4c, 0x84, 0x14, 0x7a,
+	0x3d, 0x75, 0xa7, 0x54, 0xaf, 0x7c, 0x3b, 0x24, 0xa2, 0xd8, 0x6f, 0x72, 0x26, 0xb8, 0x4f, 0x5e,
+	0xf2, 0xeb, 0xc0, 0xb5, 0xc4, 0xb7, 0x4c, 0x6f, 0x7f, 0x0e, 0x1b, 0x47, 0x4c, 0x92, 0xe8, 0x02,
+	0xbb, 0x64, 0x8f, 0x32, 0x8f, 0xb2, 0xee, 0x31, 0xed, 0x46, 0x58, 0xaa, 0x73, 0x5c, 0x57, 0xcd,
+	0x27, 0x2f, 0xb9, 0x97, 0x1e, 0x48, 0x22, 0xd9, 0xff, 0x5d, 0x80, 0xb5, 0xb3, 0x64, 0xf3, 0x8e,
+	0xb1, 0x7b, 0x49, 0x19, 0x79, 0x1d, 0x2a, 0x07, 0x81, 0xbe, 0x85, 0xd5, 0x61, 0x45, 0x52, 0x69,
+	0x56, 0xa5, 0xa4, 0xdb, 0x12, 0xb5, 0x53, 0xe8, 0x84, 0x9e, 0xc2, 0xda, 0x31, 0x09, 0xf6, 0xb0,
+	0xef, 0x73, 0xce, 0x3a, 0x12, 0x4b, 0xd1, 0x26, 0x11, 0xe5, 0xc9, 0x6e, 0x2e, 0x3b, 0xc5, 0x4a,
+	0xf4, 0x5b, 0xb8, 0xd7, 0x8e, 0x88, 0x9a, 0x77, 0xb1, 0x24, 0xde, 0x19, 0xf7, 0xe3, 0xc0, 0xf4,
+	0x6f, 0xc3, 0x29, 0x52, 0xa9, 0x0b, 0x58, 0x9a, 0x9e, 0xb2, 0x6a, 0x25, 0x17, 0x70, 0xda, 0x74,
+	0xce, 0xc0, 0x14, 0x75, 0xa0, 0xa1, 0x0b, 0x40, 0xd5, 0xae, 0xe9, 0xdc, 0x2f, 0x72, 0x7e, 0x85,
+	0xdb, 0xb4, 0x33, 0xf0, 0x6b, 0x31, 0x19, 0xf5, 0x9d, 0x6b, 0x9c, 0x92, 0xaa, 0x9b, 0x2f, 0xad,
+	0xba, 0x7d, 0x58, 0x76, 0xb3, 0x65, 0x6b, 0x2d, 0xe8, 0x05, 0x3c, 0xc8, 0x5f, 0x03, 0x59, 0x2b,
+	0x67, 0xd8, 0x09, 0xfd, 0x54, 0x81, 0x0d, 0x9a, 0x96, 0xc1, 0x3e, 0x0f, 0x30, 0x65, 0x2f, 0xa4,
+	0xc4, 0xee, 0x65, 0x40, 0x98, 0xb4, 0xea, 0x7a, 0x6d, 0xad, 0x0f, 0x5c, 0xdb, 0x51, 0x19, 0x4e,
+	0xb2, 0xd6, 0xf2, 0x38, 0x88, 0x01, 0x1a, 0x28, 0x07, 0x45, 0x68, 0x35, 0x74, 0xf4, 0xaf, 0x6e,
+	0x1b, 0x7d, 0x00, 0x90, 0x84, 0x2d, 0x40, 0xde, 0x7c, 0x0b, 0x2b, 0xc3, 0x07, 0xa1, 0x2e, 0xae,
+	0x2b, 0xd2, 0x37, 0xd5, 0xae, 0x86, 0x68, 0x37, 0xfb, 0xb8, 0x15, 0x15, 0x46, 0x7a, 0x7b, 0x99,
+	0x77, 0xef, 0xd9, 0xec, 0xef, 0x2a, 0x9b, 0x2f, 0xe1, 0xc1, 0xcd, 0xbb, 0x50, 0x10, 0x68, 0xe8,
+	0x15, 0x6d, 0x64, 0xd1, 0x7e, 0x84, 0x8f, 0x4b, 0x56, 0x55, 0x00, 0xf3, 0x7c, 0x38, 0xdf, 0xdf,
+	0xe4, 0xf2, 0x2d, 0xed, 0xf6, 0x4c, 0x48, 0xbb, 0x07, 0x70, 0x76, 0x7c, 0xe4, 0x90, 0x1f, 0xd5,
+	0x05, 0x83, 0x1e, 0x41, 0xb5, 0x17, 0x50, 0xd3, 0xc3, 0xf9, 0xc7, 0x49, 0x59, 0x2a, 0x03, 0xf4,
+	0x1c, 0x16, 0x78, 0x72, 0x0c, 0x26, 0xfa, 0xa3, 0x0f, 0x3b, 0x34, 0x27, 0x75, 0xb3, 0x4f, 0xe0,
+	0xce, 0x75, 0x3e, 0xb7, 0x8c, 0x6e, 0x0d, 0x47, 0x5f, 0xba, 0x46, 0xfd, 0xa9, 0x02, 0x8b, 0xad,
+	0x77, 0xc4, 0x4d, 0x11, 0x1f, 0x00, 0x78, 0xfa, 0x54, 0x5e, 0xe1, 0x80, 0x98, 0xcd, 0xcb, 0xcc,
+	0x28, 0xa4, 0x26, 0x0f, 0x02, 0xcc, 0xbc, 0xf4, 0xc9, 0x33, 0xa2, 0xe2, 0x1a, 0x2f, 0xa2, 0x6e,
+	0x7a, 0x99, 0xe8, 0x31, 0x7a, 0x04, 0x2b, 0x92, 0x06, 0x84, 0xc7, 0xb2, 0x43, 0x5c, 0xce, 0x3c,
+	0xa1, 0xef, 0x90, 0x39, 0x67, 0x64, 0xd6, 0x5e, 0x81, 0xa5, 0x56, 0x10, 0xca, 0xbe, 0xc9, 0xc2,
+	0xfe, 0x0a, 0xea, 0x4e, 0x86, 0xcb, 0x89, 0xd8, 0x75, 0x89, 0x10, 0xe6, 0x81, 0x49, 0x45, 0xa5,
+	0x09, 0x88, 0x10, 0xb8, 0x9b, 0x16, 0x46, 0x2a, 0xda, 0x3f, 0xc0, 0x4a, 0x52, 0x5b, 0x93, 0x12,
+	0xc9, 0x75, 0x98, 0x4f, 0x16, 0x6f, 0x22, 0x18, 0xc9, 0x66, 0x70, 0x2f, 0x09, 0xa0, 0x6f, 0xd7,
+	0x49, 0xa3, 0x6c, 0xc1, 0xa2, 0x77, 0x8d, 0x96, 0x3e, 0xe2, 0x99, 0x29, 0xfb, 0x1d, 0xdc, 0xd5,
+	0x0f, 0x9a, 0xee, 0xa6, 0x09, 0xa3, 0x7d, 0x0a, 0x77, 0xbb, 0xa3, 0x58, 0x26, 0x66, 0x5e, 0x61,
+	0xff, 0xad, 0x02, 0x6b, 0x3a, 0xf4, 0xa9, 0x20, 0xd1, 0x4b, 0x2a, 0xe4, 0xa4, 0xe1, 0x9f, 0xc2,
+	0x5a, 0xb7, 0x08, 0xcf, 0xa4, 0x50, 0xac, 0xb4, 0xff, 0x59, 0x01, 0x4b, 0xa7, 0xa1, 0x38, 0x8d,
+	0xe8, 0x0b, 0x49, 0x82, 0x89, 0xb7, 0xfd, 0x19, 0x58, 0xdd, 0x12, 0x48, 0x93, 0x4c, 0xa9, 0xde,
+	0xfe, 0x57, 0x05, 0x96, 0x92, 0xbe, 0x99, 0x2c, 0x87, 0x4d, 0xa8, 0x93, 0x77, 0x54, 0x36, 0xb9,
+	0x97, 0xc4, 0x9c, 0x73, 0x06, 0xb2, 0x2a, 0x3e, 0x21, 0xbd, 0xd7, 0xb1, 0x34, 0x1c, 0xd2, 0x48,
+	0x66, 0xbe, 0x15, 0x45, 0x86, 0x45, 0x1a, 0xc9, 0xfe, 0x0e, 0xee, 0xe8, 0x2d, 0x6a, 0x2b, 0x06,
+	0xfd, 0x81, 0xfd, 0x9c, 0xef, 0xd0, 0xd9, 0xc2, 0x0e, 0xfd, 0x06, 0xee, 0x66, 0xb0, 0x27, 0x5a,
+	0xb3, 0xcd, 0x61, 0x59, 0x91, 0xbd, 0xf7, 0xe4, 0xb6, 0xd7, 0xd8, 0x97, 0xb0, 0x1e, 0xb3, 0x0b,
+	0xed, 0x7a, 0x52, 0x94, 0x74, 0x89, 0xd6, 0x7e, 0x0b, 0x77, 0x93, 0x9f, 0x2e, 0xfb, 0x71, 0x10,
+	0xde, 0x36, 0xe8, 0x26, 0xd4, 0xbd, 0x38, 0x08, 0xdb, 0x58, 0x5e, 0x9a, 0xaa, 0x18, 0xc8, 0xf6,
+	0x39, 0x7c, 0xd4, 0x69, 0x9d, 0x4d, 0xa3, 0x29, 0xd5, 0x2d, 0x47, 0x7a, 0x9a, 0x2e, 0x99, 0x1b,
+	0xda, 0x88, 0xf6, 0x5f, 0x2b, 0xb0, 0xf1, 0x52, 0xff, 0x98, 0x3e, 0x26, 0x58, 0xc4, 0x11, 0x51,
+	0x2f, 0xe5, 0x14, 0xee, 0x00, 0x7f, 0x14, 0xd3, 0x04, 0xce, 0x2b, 0xec, 0xef, 0x15, 0x11, 0xfe,
+	0x33, 0x71, 0x65, 0x92, 0x47, 0x87, 0xb8, 0x11, 0x91, 0xd3, 0x7b, 0x83, 0xde, 0xc0, 0xf2, 0x1e,
+	0x76, 0xaf, 0xe2, 0x70, 0x6a, 0x90, 0x4f, 0xfe, 0xbd, 0x06, 0xd5, 0x66, 0xe0, 0xa1, 0x57, 0x80,
+	0x3a, 0x7d, 0xe6, 0x0e, 0x3f, 0xad, 0xe8, 0x17, 0x85, 0x90, 0x49, 0xf0, 0xcd, 0xf2, 0xfd, 0xb3,
+	0x67, 0xd0, 0x6b, 0xb8, 0xd7, 0xc6, 0xb1, 0x20, 0x53, 0x03, 0x7c, 0x03, 0x6b, 0xa7, 0x2c, 0x9c,
+	0x2a, 0x64, 0x07, 0x56, 0x93, 0xf6, 0x1a, 0x41, 0xcc, 0xf3, 0xde, 0xa1, 0x2e, 0xbc, 0x19, 0xd4,
+	0x81, 0xf5, 0x53, 0x76, 0x51, 0x04, 0xfb, 0xf3, 0x13, 0x3d, 0x01, 0xab, 0xc3, 0x2f, 0xa4, 0x43,
+	0xce, 0x39, 0x97, 0x53, 0x43, 0x75, 0x60, 0xbd, 0x73, 0x19, 0x4b, 0x8f, 0xff, 0x85, 0x4d, 0x0d,
+	0xf3, 0x15, 0xa0, 0x6f, 0xa9, 0xef, 0x4f, 0x0d, 0xaf, 0x0d, 0xab, 0xfb, 0xc4, 0x27, 0x72, 0x7a,
+	0x7b, 0xf9, 0x16, 0xd6, 0x12, 0x76, 0x38, 0x0a, 0xf9, 0xab, 0x9c, 0xd7, 0x28, 0x8b, 0x1c, 0x5b,
+	0xf1, 0xaa, 0x83, 0x06, 0x4e, 0x27, 0x38, 0xea, 0x12, 0x39, 0x41, 0xa6, 0x7f, 0x80, 0xfb, 0x4d,
+	0xf5, 0x65, 0x67, 0x64, 0x37, 0x07, 0x01, 0x26, 0x3c, 0x7a, 0xda, 0x65, 0xd8, 0x4f, 0x92, 0x6c,
+	0x73, 0xaf, 0xe9, 0x13, 0xcc, 0xe2, 0x70, 0x02, 0xcc, 0x3f, 0xc2, 0xc3, 0x03, 0xca, 0xb0, 0x4f,
+	0xdf, 0x93, 0xe9, 0x27, 0xfc, 0x0a, 0xd0, 0xd7, 0x5c, 0x86, 0x7e, 0xdc, 0xfd, 0x9a, 0x0b, 0xb9,
+	0x4f, 0x7a, 0xd4, 0x25, 0x62, 0x02, 0xbc, 0x63, 0x68, 0x1c, 0x12, 0x99, 0x30, 0x53, 0x74, 0x3f,
+	0x67, 0x99, 0xe5, 0xd8, 0x9b, 0x0f, 0xf3, 0x3f, 0xd7, 0x86, 0x28, 0xb3, 0x2e, 0xaa, 0x95, 0x01,
+	0x9c, 0xe6, 0xa1, 0xe3, 0x30, 0x7f, 0x5d, 0x82, 0x39, 0xc4, 0x92, 0xf5, 0x15, 0xb5, 0x74, 0x48,
+	0xe4, 0x80, 0xd1, 0x8e, 0x83, 0xb5, 0x73, 0xea, 0x1c, 0x19, 0xd6, 0xa0, 0xf5, 0x43, 0xa2, 0x99,
+	0xe3, 0xd8, 0x3c, 0x1f, 0x15, 0x03, 0xe6, 0x58, 0xe7, 0x0c, 0xfa, 0x93, 0xde, 0x82, 0x0c, 0x03,
+	0x1c, 0x07, 0xfd, 0x49, 0x31, 0x74, 0x11, 0x87, 0x9c, 0x41, 0x7b, 0x50, 0x53, 0x84, 0x6a, 0x1c,
+	0xe6, 0x8d, 0x67, 0xde, 0x82, 0x9a, 0x22, 0xa2, 0xe8, 0x97, 0x79, 0x8c, 0xeb, 0xdf, 0x75, 0x9b,
+	0xf7, 0x4b, 0xb4, 0x99, 0xcb, 0xb8, 0x31, 0x20, 0x78, 0x05, 0x97, 0xc6, 0x28, 0xb1, 0xdc, 0xb4,
+	0x6f, 0x32, 0xc9, 0x74, 0x8f, 0x35, 0xd2, 0x35, 0x03, 0x1e, 0x86, 0xec, 0x92, 0xef, 0xcb, 0x19,
+	0x92, 0x36, 0xee, 0xce, 0x53, 0x67, 0x93, 0xf9, 0xb7, 0xc1, 0xed, 0xcb, 0xb3, 0xe0, 0x7f, 0x0e,
+	0xe6, 0x1e, 0xc9, 0xb1, 0x86, 0x66, 0xfb, 0x54, 0x4c, 0xf8, 0xd8, 0xe5, 0x30, 0x93, 0x05, 0x4f,
+	0xc4, 0x47, 0xe0, 0x90, 0x48, 0xc3, 0x41, 0xc7, 0x2d, 0x7f, 0x2b, 0xa7, 0x1e, 0x21, 0xaf, 0xf6,
+	0x0c, 0xc2, 0xb0, 0x7a, 0x48, 0x64, 0x8e, 0x6f, 0xde, 0x9c, 0x62, 0xfe, 0x4b, 0x4a, 0x29, 0x61,
+	0xb5, 0x67, 0xd0, 0xf7, 0x80, 0xf2, 0x6c, 0x12, 0x15, 0x7d, 0x8d, 0x29, 0xa1, 0x9c, 0x63, 0xe9,
+	0x4f, 0xc2, 0x26, 0xc7, 0xd2, 0x9f, 0x21, 0xd2, 0x79, 0x33, 0xe8, 0x29, 0x6c, 0xbc, 0x38, 0xe7,
+	0xd1, 0x08, 0x4b, 0x49, 0x00, 0x7e, 0xfe, 0xf1, 0xed, 0xd5, 0xbe, 0x9b, 0xed, 0x3d, 0x3e, 0x9f,
+	0xd7, 0xff, 0x13, 0xfb, 0xfc, 0xff, 0x03, 0x00, 0x4e, 0x89, 0x76, 0x2b, 0x40, 0x1b, 0x00, 0x00,
 }
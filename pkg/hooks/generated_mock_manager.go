@@ -75,3 +75,54 @@ func (_m *MockManager) Shutdown() error {
 func (_mr *_MockManagerRecorder) Shutdown() *gomock.Call {
 	return _mr.mock.ctrl.RecordCall(_mr.mock, "Shutdown")
 }
+
+func (_m *MockManager) SetupNetwork(_param0 *v1.VirtualMachineInstance) error {
+	ret := _m.ctrl.Call(_m, "SetupNetwork", _param0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+func (_mr *_MockManagerRecorder) SetupNetwork(arg0 interface{}) *gomock.Call {
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "SetupNetwork", arg0)
+}
+
+func (_m *MockManager) PostMigration(_param0 *v1.VirtualMachineInstance) error {
+	ret := _m.ctrl.Call(_m, "PostMigration", _param0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+func (_mr *_MockManagerRecorder) PostMigration(arg0 interface{}) *gomock.Call {
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "PostMigration", arg0)
+}
+
+func (_m *MockManager) HotplugInterfaces(_param0 *v1.VirtualMachineInstance, _param1 []string) error {
+	ret := _m.ctrl.Call(_m, "HotplugInterfaces", _param0, _param1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+func (_mr *_MockManagerRecorder) HotplugInterfaces(arg0, arg1 interface{}) *gomock.Call {
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "HotplugInterfaces", arg0, arg1)
+}
+
+func (_m *MockManager) UnplugInterfaces(_param0 *v1.VirtualMachineInstance, _param1 []string) error {
+	ret := _m.ctrl.Call(_m, "UnplugInterfaces", _param0, _param1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+func (_mr *_MockManagerRecorder) UnplugInterfaces(arg0, arg1 interface{}) *gomock.Call {
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "UnplugInterfaces", arg0, arg1)
+}
+
+func (_m *MockManager) InterfacesStatus() ([]api.InterfaceStatus, error) {
+	ret := _m.ctrl.Call(_m, "InterfacesStatus")
+	ret0, _ := ret[0].([]api.InterfaceStatus)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+func (_mr *_MockManagerRecorder) InterfacesStatus() *gomock.Call {
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "InterfacesStatus")
+}
@@ -22,3 +22,8 @@ package info
 const OnDefineDomainHookPointName = "OnDefineDomain"
 const PreCloudInitIsoHookPointName = "PreCloudInitIso"
 const ShutdownHookPointName = "Shutdown"
+const SetupNetworkHookPointName = "SetupNetwork"
+const PostMigrationHookPointName = "PostMigration"
+const HotplugInterfacesHookPointName = "HotplugInterfaces"
+const UnplugInterfacesHookPointName = "UnplugInterfaces"
+const InterfacesStatusHookPointName = "InterfacesStatus"
@@ -36,6 +36,7 @@ import (
 
 	cloudinit "kubevirt.io/kubevirt/pkg/cloud-init"
 	hooksInfo "kubevirt.io/kubevirt/pkg/hooks/info"
+	hooksNetworkBindingV1alpha1 "kubevirt.io/kubevirt/pkg/hooks/networkbinding/v1alpha1"
 	hooksV1alpha1 "kubevirt.io/kubevirt/pkg/hooks/v1alpha1"
 	hooksV1alpha2 "kubevirt.io/kubevirt/pkg/hooks/v1alpha2"
 	hooksV1alpha3 "kubevirt.io/kubevirt/pkg/hooks/v1alpha3"
@@ -62,6 +63,11 @@ type (
 		OnDefineDomain(*virtwrapApi.DomainSpec, *v1.VirtualMachineInstance) (string, error)
 		PreCloudInitIso(*v1.VirtualMachineInstance, *cloudinit.CloudInitData) (*cloudinit.CloudInitData, error)
 		Shutdown() error
+		SetupNetwork(*v1.VirtualMachineInstance) error
+		PostMigration(*v1.VirtualMachineInstance) error
+		HotplugInterfaces(*v1.VirtualMachineInstance, []string) error
+		UnplugInterfaces(*v1.VirtualMachineInstance, []string) error
+		InterfacesStatus() ([]virtwrapApi.InterfaceStatus, error)
 	}
 	hookManager struct {
 		CallbacksPerHookPoint     map[string][]*callBackClient
@@ -163,6 +169,7 @@ func processSideCarSocket(socketPath string) (*callBackClient, bool, error) {
 
 	// The order matters. We should match newer versions first.
 	supportedVersions := []string{
+		hooksNetworkBindingV1alpha1.Version,
 		hooksV1alpha3.Version,
 		hooksV1alpha2.Version,
 		hooksV1alpha1.Version,
@@ -268,6 +275,17 @@ func (m *hookManager) onDefineDomainCallback(callback *callBackClient, domainSpe
 			return nil, err
 		}
 		domainSpecXML = result.GetDomainXML()
+	case hooksNetworkBindingV1alpha1.Version:
+		client := hooksNetworkBindingV1alpha1.NewNetworkBindingClient(conn)
+		result, err := client.OnDefineDomain(ctx, &hooksNetworkBindingV1alpha1.OnDefineDomainParams{
+			DomainXML: domainSpecXML,
+			Vmi:       vmiJSON,
+		})
+		if err != nil {
+			log.Log.Reason(err).Error("Failed to call OnDefineDomain")
+			return nil, err
+		}
+		domainSpecXML = result.GetDomainXML()
 	default:
 		log.Log.Errorf("Unsupported callback version: %s", callback.Version)
 	}
@@ -413,9 +431,145 @@ func (m *hookManager) Shutdown() error {
 				log.Log.Reason(err).Error("Failed to run Shutdown")
 				return err
 			}
+		case hooksNetworkBindingV1alpha1.Version:
+			err := callNetworkBindingPlugin(callback, func(ctx context.Context, client hooksNetworkBindingV1alpha1.NetworkBindingClient) error {
+				_, err := client.Shutdown(ctx, &hooksNetworkBindingV1alpha1.ShutdownParams{})
+				return err
+			})
+			if err != nil {
+				log.Log.Reason(err).Error("Failed to run Shutdown")
+				return err
+			}
 		default:
 			log.Log.Errorf("Unsupported callback version: %s", callback.Version)
 		}
 	}
 	return nil
 }
+
+func (m *hookManager) SetupNetwork(vmi *v1.VirtualMachineInstance) error {
+	vmiJSON, err := json.Marshal(vmi)
+	if err != nil {
+		return fmt.Errorf("failed to marshal VMI spec: %v, err: %v", vmi, err)
+	}
+
+	return m.callNetworkBindingPlugins(hooksInfo.SetupNetworkHookPointName,
+		func(ctx context.Context, client hooksNetworkBindingV1alpha1.NetworkBindingClient) error {
+			_, err := client.SetupNetwork(ctx, &hooksNetworkBindingV1alpha1.SetupNetworkParams{Vmi: vmiJSON})
+			return err
+		},
+	)
+}
+
+func (m *hookManager) PostMigration(vmi *v1.VirtualMachineInstance) error {
+	vmiJSON, err := json.Marshal(vmi)
+	if err != nil {
+		return fmt.Errorf("failed to marshal VMI spec: %v, err: %v", vmi, err)
+	}
+
+	return m.callNetworkBindingPlugins(hooksInfo.PostMigrationHookPointName,
+		func(ctx context.Context, client hooksNetworkBindingV1alpha1.NetworkBindingClient) error {
+			_, err := client.PostMigration(ctx, &hooksNetworkBindingV1alpha1.PostMigrationParams{Vmi: vmiJSON})
+			return err
+		},
+	)
+}
+
+func (m *hookManager) HotplugInterfaces(vmi *v1.VirtualMachineInstance, networks []string) error {
+	vmiJSON, err := json.Marshal(vmi)
+	if err != nil {
+		return fmt.Errorf("failed to marshal VMI spec: %v, err: %v", vmi, err)
+	}
+
+	return m.callNetworkBindingPlugins(hooksInfo.HotplugInterfacesHookPointName,
+		func(ctx context.Context, client hooksNetworkBindingV1alpha1.NetworkBindingClient) error {
+			_, err := client.HotplugInterfaces(ctx, &hooksNetworkBindingV1alpha1.HotplugInterfacesParams{
+				Vmi:      vmiJSON,
+				Networks: networks,
+			})
+			return err
+		},
+	)
+}
+
+func (m *hookManager) UnplugInterfaces(vmi *v1.VirtualMachineInstance, networks []string) error {
+	vmiJSON, err := json.Marshal(vmi)
+	if err != nil {
+		return fmt.Errorf("failed to marshal VMI spec: %v, err: %v", vmi, err)
+	}
+
+	return m.callNetworkBindingPlugins(hooksInfo.UnplugInterfacesHookPointName,
+		func(ctx context.Context, client hooksNetworkBindingV1alpha1.NetworkBindingClient) error {
+			_, err := client.UnplugInterfaces(ctx, &hooksNetworkBindingV1alpha1.UnplugInterfacesParams{
+				Vmi:      vmiJSON,
+				Networks: networks,
+			})
+			return err
+		},
+	)
+}
+
+// InterfacesStatus collects the status of the interfaces served by the network binding plugins.
+func (m *hookManager) InterfacesStatus() ([]virtwrapApi.InterfaceStatus, error) {
+	var interfacesStatus []virtwrapApi.InterfaceStatus
+	err := m.callNetworkBindingPlugins(hooksInfo.InterfacesStatusHookPointName,
+		func(ctx context.Context, client hooksNetworkBindingV1alpha1.NetworkBindingClient) error {
+			result, err := client.InterfacesStatus(ctx, &hooksNetworkBindingV1alpha1.InterfacesStatusParams{})
+			if err != nil {
+				return err
+			}
+			for _, ifaceStatus := range result.GetInterfaces() {
+				interfacesStatus = append(interfacesStatus, interfaceStatusFromNetworkBinding(ifaceStatus))
+			}
+			return nil
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+	return interfacesStatus, nil
+}
+
+func interfaceStatusFromNetworkBinding(ifaceStatus *hooksNetworkBindingV1alpha1.InterfaceStatus) virtwrapApi.InterfaceStatus {
+	status := virtwrapApi.InterfaceStatus{
+		Mac:           ifaceStatus.GetMac(),
+		IPs:           ifaceStatus.GetIps(),
+		InterfaceName: ifaceStatus.GetInterfaceName(),
+	}
+	if len(status.IPs) > 0 {
+		status.Ip = status.IPs[0]
+	}
+	return status
+}
+
+type networkBindingCall func(ctx context.Context, client hooksNetworkBindingV1alpha1.NetworkBindingClient) error
+
+// callNetworkBindingPlugins invokes the call on each network binding plugin subscribed to the hook point.
+// Only sidecars exposing the network binding API may subscribe to its hook points.
+func (m *hookManager) callNetworkBindingPlugins(hookPointName string, call networkBindingCall) error {
+	for _, callback := range m.CallbacksPerHookPoint[hookPointName] {
+		if callback.Version != hooksNetworkBindingV1alpha1.Version {
+			log.Log.Errorf("Unsupported callback version for %s: %s", hookPointName, callback.Version)
+			continue
+		}
+		if err := callNetworkBindingPlugin(callback, call); err != nil {
+			log.Log.Reason(err).Errorf("Failed to call %s", hookPointName)
+			return err
+		}
+	}
+	return nil
+}
+
+func callNetworkBindingPlugin(callback *callBackClient, call networkBindingCall) error {
+	conn, err := grpcutil.DialSocketWithTimeout(callback.SocketPath, 1)
+	if err != nil {
+		log.Log.Reason(err).Errorf(dialSockErr, callback.SocketPath)
+		return err
+	}
+	defer conn.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Minute)
+	defer cancel()
+
+	return call(ctx, hooksNetworkBindingV1alpha1.NewNetworkBindingClient(conn))
+}
@@ -28,12 +28,17 @@ import (
 	"time"
 
 	"google.golang.org/grpc"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 
+	v1 "kubevirt.io/api/core/v1"
+
 	hooksInfo "kubevirt.io/kubevirt/pkg/hooks/info"
+	hooksNetworkBindingV1alpha1 "kubevirt.io/kubevirt/pkg/hooks/networkbinding/v1alpha1"
 	hooksV1alpha3 "kubevirt.io/kubevirt/pkg/hooks/v1alpha3"
+	virtwrapApi "kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/api"
 )
 
 type dynamicInfoServer struct {
@@ -78,6 +83,39 @@ func hookListenAndServe(socketPath string, hookName string, hookPointName string
 	return socket, nil
 }
 
+type networkBindingInfoServer struct{}
+
+func (s networkBindingInfoServer) Info(_ context.Context, _ *hooksInfo.InfoParams) (*hooksInfo.InfoResult, error) {
+	return &hooksInfo.InfoResult{
+		Name:     "network-binding",
+		Versions: []string{hooksNetworkBindingV1alpha1.Version},
+		HookPoints: []*hooksInfo.HookPoint{
+			{Name: hooksInfo.SetupNetworkHookPointName},
+			{Name: hooksInfo.InterfacesStatusHookPointName},
+		},
+	}, nil
+}
+
+type networkBindingServer struct {
+	hooksNetworkBindingV1alpha1.NetworkBindingServer
+	setupVMIs chan []byte
+}
+
+func (s networkBindingServer) SetupNetwork(_ context.Context, params *hooksNetworkBindingV1alpha1.SetupNetworkParams) (*hooksNetworkBindingV1alpha1.SetupNetworkResult, error) {
+	s.setupVMIs <- params.GetVmi()
+	return &hooksNetworkBindingV1alpha1.SetupNetworkResult{}, nil
+}
+
+func (s networkBindingServer) InterfacesStatus(_ context.Context, _ *hooksNetworkBindingV1alpha1.InterfacesStatusParams) (*hooksNetworkBindingV1alpha1.InterfacesStatusResult, error) {
+	return &hooksNetworkBindingV1alpha1.InterfacesStatusResult{
+		Interfaces: []*hooksNetworkBindingV1alpha1.InterfaceStatus{{
+			Mac:           "02:00:00:00:00:01",
+			Ips:           []string{"10.0.2.2", "fd10:0:2::2"},
+			InterfaceName: "eth0",
+		}},
+	}, nil
+}
+
 var _ = Describe("HooksManager", func() {
 	Context("With existing sockets", func() {
 		var socketDir string
@@ -153,6 +191,36 @@ var _ = Describe("HooksManager", func() {
 			}
 		})
 
+		It("Should call the network binding plugins", func() {
+			socketPath := filepath.Join(socketDir, "binding.sock")
+			socket, err := net.Listen("unix", socketPath)
+			Expect(err).ToNot(HaveOccurred())
+			defer os.Remove(socketPath)
+
+			setupVMIs := make(chan []byte, 1)
+			server := grpc.NewServer()
+			hooksInfo.RegisterInfoServer(server, networkBindingInfoServer{})
+			hooksNetworkBindingV1alpha1.RegisterNetworkBindingServer(server, networkBindingServer{setupVMIs: setupVMIs})
+			go server.Serve(socket)
+			defer server.Stop()
+
+			manager := newManager(socketDir)
+			Expect(manager.Collect(1, 10*time.Second)).To(Succeed())
+
+			vmi := &v1.VirtualMachineInstance{ObjectMeta: metav1.ObjectMeta{Name: "testvmi"}}
+			Expect(manager.SetupNetwork(vmi)).To(Succeed())
+			Expect(setupVMIs).To(Receive(ContainSubstring("testvmi")))
+
+			Expect(manager.InterfacesStatus()).To(Equal([]virtwrapApi.InterfaceStatus{{
+				Mac:           "02:00:00:00:00:01",
+				Ip:            "10.0.2.2",
+				IPs:           []string{"10.0.2.2", "fd10:0:2::2"},
+				InterfaceName: "eth0",
+			}}))
+
+			Expect(manager.PostMigration(vmi)).To(Succeed(), "plugins not subscribed to a hook point should not be called")
+		})
+
 		AfterEach(func() {
 			os.RemoveAll(socketDir)
 		})
@@ -0,0 +1,614 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// source: api_networkbinding_v1alpha1.proto
+
+/*
+Package v1alpha1 is a generated protocol buffer package.
+
+It is generated from these files:
+
+	api_networkbinding_v1alpha1.proto
+
+It has these top-level messages:
+
+	OnDefineDomainParams
+	OnDefineDomainResult
+	SetupNetworkParams
+	SetupNetworkResult
+	PostMigrationParams
+	PostMigrationResult
+	HotplugInterfacesParams
+	HotplugInterfacesResult
+	UnplugInterfacesParams
+	UnplugInterfacesResult
+	InterfacesStatusParams
+	InterfacesStatusResult
+	InterfaceStatus
+	ShutdownParams
+	ShutdownResult
+*/
+package v1alpha1
+
+import (
+	fmt "fmt"
+
+	proto "github.com/golang/protobuf/proto"
+
+	math "math"
+
+	context "golang.org/x/net/context"
+
+	grpc "google.golang.org/grpc"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.ProtoPackageIsVersion2 // please upgrade the proto package
+
+type OnDefineDomainParams struct {
+	// domainXML is original libvirt domain specification
+	DomainXML []byte `protobuf:"bytes,1,opt,name=domainXML,proto3" json:"domainXML,omitempty"`
+	// vmi is VirtualMachineInstance is object of virtual machine currently processed by virt-launcher, it is encoded as JSON
+	Vmi []byte `protobuf:"bytes,2,opt,name=vmi,proto3" json:"vmi,omitempty"`
+}
+
+func (m *OnDefineDomainParams) Reset()                    { *m = OnDefineDomainParams{} }
+func (m *OnDefineDomainParams) String() string            { return proto.CompactTextString(m) }
+func (*OnDefineDomainParams) ProtoMessage()               {}
+func (*OnDefineDomainParams) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{0} }
+
+func (m *OnDefineDomainParams) GetDomainXML() []byte {
+	if m != nil {
+		return m.DomainXML
+	}
+	return nil
+}
+
+func (m *OnDefineDomainParams) GetVmi() []byte {
+	if m != nil {
+		return m.Vmi
+	}
+	return nil
+}
+
+type OnDefineDomainResult struct {
+	// domainXML is processed libvirt domain specification
+	DomainXML []byte `protobuf:"bytes,1,opt,name=domainXML,proto3" json:"domainXML,omitempty"`
+}
+
+func (m *OnDefineDomainResult) Reset()                    { *m = OnDefineDomainResult{} }
+func (m *OnDefineDomainResult) String() string            { return proto.CompactTextString(m) }
+func (*OnDefineDomainResult) ProtoMessage()               {}
+func (*OnDefineDomainResult) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{1} }
+
+func (m *OnDefineDomainResult) GetDomainXML() []byte {
+	if m != nil {
+		return m.DomainXML
+	}
+	return nil
+}
+
+type SetupNetworkParams struct {
+	// vmi is VirtualMachineInstance is object of virtual machine currently processed by virt-launcher, it is encoded as JSON
+	Vmi []byte `protobuf:"bytes,1,opt,name=vmi,proto3" json:"vmi,omitempty"`
+}
+
+func (m *SetupNetworkParams) Reset()                    { *m = SetupNetworkParams{} }
+func (m *SetupNetworkParams) String() string            { return proto.CompactTextString(m) }
+func (*SetupNetworkParams) ProtoMessage()               {}
+func (*SetupNetworkParams) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{2} }
+
+func (m *SetupNetworkParams) GetVmi() []byte {
+	if m != nil {
+		return m.Vmi
+	}
+	return nil
+}
+
+type SetupNetworkResult struct {
+}
+
+func (m *SetupNetworkResult) Reset()                    { *m = SetupNetworkResult{} }
+func (m *SetupNetworkResult) String() string            { return proto.CompactTextString(m) }
+func (*SetupNetworkResult) ProtoMessage()               {}
+func (*SetupNetworkResult) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{3} }
+
+type PostMigrationParams struct {
+	// vmi is VirtualMachineInstance is object of virtual machine currently processed by virt-launcher, it is encoded as JSON
+	Vmi []byte `protobuf:"bytes,1,opt,name=vmi,proto3" json:"vmi,omitempty"`
+}
+
+func (m *PostMigrationParams) Reset()                    { *m = PostMigrationParams{} }
+func (m *PostMigrationParams) String() string            { return proto.CompactTextString(m) }
+func (*PostMigrationParams) ProtoMessage()               {}
+func (*PostMigrationParams) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{4} }
+
+func (m *PostMigrationParams) GetVmi() []byte {
+	if m != nil {
+		return m.Vmi
+	}
+	return nil
+}
+
+type PostMigrationResult struct {
+}
+
+func (m *PostMigrationResult) Reset()                    { *m = PostMigrationResult{} }
+func (m *PostMigrationResult) String() string            { return proto.CompactTextString(m) }
+func (*PostMigrationResult) ProtoMessage()               {}
+func (*PostMigrationResult) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{5} }
+
+type HotplugInterfacesParams struct {
+	// vmi is VirtualMachineInstance is object of virtual machine currently processed by virt-launcher, it is encoded as JSON
+	Vmi []byte `protobuf:"bytes,1,opt,name=vmi,proto3" json:"vmi,omitempty"`
+	// networks are the names of the networks whose interfaces are about to be attached to the domain
+	Networks []string `protobuf:"bytes,2,rep,name=networks" json:"networks,omitempty"`
+}
+
+func (m *HotplugInterfacesParams) Reset()                    { *m = HotplugInterfacesParams{} }
+func (m *HotplugInterfacesParams) String() string            { return proto.CompactTextString(m) }
+func (*HotplugInterfacesParams) ProtoMessage()               {}
+func (*HotplugInterfacesParams) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{6} }
+
+func (m *HotplugInterfacesParams) GetVmi() []byte {
+	if m != nil {
+		return m.Vmi
+	}
+	return nil
+}
+
+func (m *HotplugInterfacesParams) GetNetworks() []string {
+	if m != nil {
+		return m.Networks
+	}
+	return nil
+}
+
+type HotplugInterfacesResult struct {
+}
+
+func (m *HotplugInterfacesResult) Reset()                    { *m = HotplugInterfacesResult{} }
+func (m *HotplugInterfacesResult) String() string            { return proto.CompactTextString(m) }
+func (*HotplugInterfacesResult) ProtoMessage()               {}
+func (*HotplugInterfacesResult) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{7} }
+
+type UnplugInterfacesParams struct {
+	// vmi is VirtualMachineInstance is object of virtual machine currently processed by virt-launcher, it is encoded as JSON
+	Vmi []byte `protobuf:"bytes,1,opt,name=vmi,proto3" json:"vmi,omitempty"`
+	// networks are the names of the networks whose interfaces were detached from the domain
+	Networks []string `protobuf:"bytes,2,rep,name=networks" json:"networks,omitempty"`
+}
+
+func (m *UnplugInterfacesParams) Reset()                    { *m = UnplugInterfacesParams{} }
+func (m *UnplugInterfacesParams) String() string            { return proto.CompactTextString(m) }
+func (*UnplugInterfacesParams) ProtoMessage()               {}
+func (*UnplugInterfacesParams) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{8} }
+
+func (m *UnplugInterfacesParams) GetVmi() []byte {
+	if m != nil {
+		return m.Vmi
+	}
+	return nil
+}
+
+func (m *UnplugInterfacesParams) GetNetworks() []string {
+	if m != nil {
+		return m.Networks
+	}
+	return nil
+}
+
+type UnplugInterfacesResult struct {
+}
+
+func (m *UnplugInterfacesResult) Reset()                    { *m = UnplugInterfacesResult{} }
+func (m *UnplugInterfacesResult) String() string            { return proto.CompactTextString(m) }
+func (*UnplugInterfacesResult) ProtoMessage()               {}
+func (*UnplugInterfacesResult) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{9} }
+
+type InterfacesStatusParams struct {
+}
+
+func (m *InterfacesStatusParams) Reset()                    { *m = InterfacesStatusParams{} }
+func (m *InterfacesStatusParams) String() string            { return proto.CompactTextString(m) }
+func (*InterfacesStatusParams) ProtoMessage()               {}
+func (*InterfacesStatusParams) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{10} }
+
+type InterfacesStatusResult struct {
+	// interfaces is the status of the interfaces served by the plugin
+	Interfaces []*InterfaceStatus `protobuf:"bytes,1,rep,name=interfaces" json:"interfaces,omitempty"`
+}
+
+func (m *InterfacesStatusResult) Reset()                    { *m = InterfacesStatusResult{} }
+func (m *InterfacesStatusResult) String() string            { return proto.CompactTextString(m) }
+func (*InterfacesStatusResult) ProtoMessage()               {}
+func (*InterfacesStatusResult) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{11} }
+
+func (m *InterfacesStatusResult) GetInterfaces() []*InterfaceStatus {
+	if m != nil {
+		return m.Interfaces
+	}
+	return nil
+}
+
+type InterfaceStatus struct {
+	// mac is the MAC address of the guest interface
+	Mac string `protobuf:"bytes,1,opt,name=mac" json:"mac,omitempty"`
+	// ips are the IP addresses of the guest interface
+	Ips []string `protobuf:"bytes,2,rep,name=ips" json:"ips,omitempty"`
+	// interfaceName is the name of the interface inside the guest
+	InterfaceName string `protobuf:"bytes,3,opt,name=interfaceName" json:"interfaceName,omitempty"`
+}
+
+func (m *InterfaceStatus) Reset()                    { *m = InterfaceStatus{} }
+func (m *InterfaceStatus) String() string            { return proto.CompactTextString(m) }
+func (*InterfaceStatus) ProtoMessage()               {}
+func (*InterfaceStatus) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{12} }
+
+func (m *InterfaceStatus) GetMac() string {
+	if m != nil {
+		return m.Mac
+	}
+	return ""
+}
+
+func (m *InterfaceStatus) GetIps() []string {
+	if m != nil {
+		return m.Ips
+	}
+	return nil
+}
+
+func (m *InterfaceStatus) GetInterfaceName() string {
+	if m != nil {
+		return m.InterfaceName
+	}
+	return ""
+}
+
+type ShutdownParams struct {
+}
+
+func (m *ShutdownParams) Reset()                    { *m = ShutdownParams{} }
+func (m *ShutdownParams) String() string            { return proto.CompactTextString(m) }
+func (*ShutdownParams) ProtoMessage()               {}
+func (*ShutdownParams) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{13} }
+
+type ShutdownResult struct {
+}
+
+func (m *ShutdownResult) Reset()                    { *m = ShutdownResult{} }
+func (m *ShutdownResult) String() string            { return proto.CompactTextString(m) }
+func (*ShutdownResult) ProtoMessage()               {}
+func (*ShutdownResult) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{14} }
+
+func init() {
+	proto.RegisterType((*OnDefineDomainParams)(nil), "kubevirt.hooks.networkbinding.v1alpha1.OnDefineDomainParams")
+	proto.RegisterType((*OnDefineDomainResult)(nil), "kubevirt.hooks.networkbinding.v1alpha1.OnDefineDomainResult")
+	proto.RegisterType((*SetupNetworkParams)(nil), "kubevirt.hooks.networkbinding.v1alpha1.SetupNetworkParams")
+	proto.RegisterType((*SetupNetworkResult)(nil), "kubevirt.hooks.networkbinding.v1alpha1.SetupNetworkResult")
+	proto.RegisterType((*PostMigrationParams)(nil), "kubevirt.hooks.networkbinding.v1alpha1.PostMigrationParams")
+	proto.RegisterType((*PostMigrationResult)(nil), "kubevirt.hooks.networkbinding.v1alpha1.PostMigrationResult")
+	proto.RegisterType((*HotplugInterfacesParams)(nil), "kubevirt.hooks.networkbinding.v1alpha1.HotplugInterfacesParams")
+	proto.RegisterType((*HotplugInterfacesResult)(nil), "kubevirt.hooks.networkbinding.v1alpha1.HotplugInterfacesResult")
+	proto.RegisterType((*UnplugInterfacesParams)(nil), "kubevirt.hooks.networkbinding.v1alpha1.UnplugInterfacesParams")
+	proto.RegisterType((*UnplugInterfacesResult)(nil), "kubevirt.hooks.networkbinding.v1alpha1.UnplugInterfacesResult")
+	proto.RegisterType((*InterfacesStatusParams)(nil), "kubevirt.hooks.networkbinding.v1alpha1.InterfacesStatusParams")
+	proto.RegisterType((*InterfacesStatusResult)(nil), "kubevirt.hooks.networkbinding.v1alpha1.InterfacesStatusResult")
+	proto.RegisterType((*InterfaceStatus)(nil), "kubevirt.hooks.networkbinding.v1alpha1.InterfaceStatus")
+	proto.RegisterType((*ShutdownParams)(nil), "kubevirt.hooks.networkbinding.v1alpha1.ShutdownParams")
+	proto.RegisterType((*ShutdownResult)(nil), "kubevirt.hooks.networkbinding.v1alpha1.ShutdownResult")
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// Client API for NetworkBinding service
+
+type NetworkBindingClient interface {
+	OnDefineDomain(ctx context.Context, in *OnDefineDomainParams, opts ...grpc.CallOption) (*OnDefineDomainResult, error)
+	SetupNetwork(ctx context.Context, in *SetupNetworkParams, opts ...grpc.CallOption) (*SetupNetworkResult, error)
+	PostMigration(ctx context.Context, in *PostMigrationParams, opts ...grpc.CallOption) (*PostMigrationResult, error)
+	HotplugInterfaces(ctx context.Context, in *HotplugInterfacesParams, opts ...grpc.CallOption) (*HotplugInterfacesResult, error)
+	UnplugInterfaces(ctx context.Context, in *UnplugInterfacesParams, opts ...grpc.CallOption) (*UnplugInterfacesResult, error)
+	InterfacesStatus(ctx context.Context, in *InterfacesStatusParams, opts ...grpc.CallOption) (*InterfacesStatusResult, error)
+	Shutdown(ctx context.Context, in *ShutdownParams, opts ...grpc.CallOption) (*ShutdownResult, error)
+}
+
+type networkBindingClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewNetworkBindingClient(cc *grpc.ClientConn) NetworkBindingClient {
+	return &networkBindingClient{cc}
+}
+
+func (c *networkBindingClient) OnDefineDomain(ctx context.Context, in *OnDefineDomainParams, opts ...grpc.CallOption) (*OnDefineDomainResult, error) {
+	out := new(OnDefineDomainResult)
+	err := grpc.Invoke(ctx, "/kubevirt.hooks.networkbinding.v1alpha1.NetworkBinding/OnDefineDomain", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *networkBindingClient) SetupNetwork(ctx context.Context, in *SetupNetworkParams, opts ...grpc.CallOption) (*SetupNetworkResult, error) {
+	out := new(SetupNetworkResult)
+	err := grpc.Invoke(ctx, "/kubevirt.hooks.networkbinding.v1alpha1.NetworkBinding/SetupNetwork", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *networkBindingClient) PostMigration(ctx context.Context, in *PostMigrationParams, opts ...grpc.CallOption) (*PostMigrationResult, error) {
+	out := new(PostMigrationResult)
+	err := grpc.Invoke(ctx, "/kubevirt.hooks.networkbinding.v1alpha1.NetworkBinding/PostMigration", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *networkBindingClient) HotplugInterfaces(ctx context.Context, in *HotplugInterfacesParams, opts ...grpc.CallOption) (*HotplugInterfacesResult, error) {
+	out := new(HotplugInterfacesResult)
+	err := grpc.Invoke(ctx, "/kubevirt.hooks.networkbinding.v1alpha1.NetworkBinding/HotplugInterfaces", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *networkBindingClient) UnplugInterfaces(ctx context.Context, in *UnplugInterfacesParams, opts ...grpc.CallOption) (*UnplugInterfacesResult, error) {
+	out := new(UnplugInterfacesResult)
+	err := grpc.Invoke(ctx, "/kubevirt.hooks.networkbinding.v1alpha1.NetworkBinding/UnplugInterfaces", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *networkBindingClient) InterfacesStatus(ctx context.Context, in *InterfacesStatusParams, opts ...grpc.CallOption) (*InterfacesStatusResult, error) {
+	out := new(InterfacesStatusResult)
+	err := grpc.Invoke(ctx, "/kubevirt.hooks.networkbinding.v1alpha1.NetworkBinding/InterfacesStatus", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *networkBindingClient) Shutdown(ctx context.Context, in *ShutdownParams, opts ...grpc.CallOption) (*ShutdownResult, error) {
+	out := new(ShutdownResult)
+	err := grpc.Invoke(ctx, "/kubevirt.hooks.networkbinding.v1alpha1.NetworkBinding/Shutdown", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// Server API for NetworkBinding service
+
+type NetworkBindingServer interface {
+	OnDefineDomain(context.Context, *OnDefineDomainParams) (*OnDefineDomainResult, error)
+	SetupNetwork(context.Context, *SetupNetworkParams) (*SetupNetworkResult, error)
+	PostMigration(context.Context, *PostMigrationParams) (*PostMigrationResult, error)
+	HotplugInterfaces(context.Context, *HotplugInterfacesParams) (*HotplugInterfacesResult, error)
+	UnplugInterfaces(context.Context, *UnplugInterfacesParams) (*UnplugInterfacesResult, error)
+	InterfacesStatus(context.Context, *InterfacesStatusParams) (*InterfacesStatusResult, error)
+	Shutdown(context.Context, *ShutdownParams) (*ShutdownResult, error)
+}
+
+func RegisterNetworkBindingServer(s *grpc.Server, srv NetworkBindingServer) {
+	s.RegisterService(&_NetworkBinding_serviceDesc, srv)
+}
+
+func _NetworkBinding_OnDefineDomain_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(OnDefineDomainParams)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NetworkBindingServer).OnDefineDomain(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kubevirt.hooks.networkbinding.v1alpha1.NetworkBinding/OnDefineDomain",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NetworkBindingServer).OnDefineDomain(ctx, req.(*OnDefineDomainParams))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NetworkBinding_SetupNetwork_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetupNetworkParams)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NetworkBindingServer).SetupNetwork(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kubevirt.hooks.networkbinding.v1alpha1.NetworkBinding/SetupNetwork",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NetworkBindingServer).SetupNetwork(ctx, req.(*SetupNetworkParams))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NetworkBinding_PostMigration_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PostMigrationParams)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NetworkBindingServer).PostMigration(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kubevirt.hooks.networkbinding.v1alpha1.NetworkBinding/PostMigration",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NetworkBindingServer).PostMigration(ctx, req.(*PostMigrationParams))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NetworkBinding_HotplugInterfaces_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(HotplugInterfacesParams)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NetworkBindingServer).HotplugInterfaces(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kubevirt.hooks.networkbinding.v1alpha1.NetworkBinding/HotplugInterfaces",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NetworkBindingServer).HotplugInterfaces(ctx, req.(*HotplugInterfacesParams))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NetworkBinding_UnplugInterfaces_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnplugInterfacesParams)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NetworkBindingServer).UnplugInterfaces(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kubevirt.hooks.networkbinding.v1alpha1.NetworkBinding/UnplugInterfaces",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NetworkBindingServer).UnplugInterfaces(ctx, req.(*UnplugInterfacesParams))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NetworkBinding_InterfacesStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(InterfacesStatusParams)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NetworkBindingServer).InterfacesStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kubevirt.hooks.networkbinding.v1alpha1.NetworkBinding/InterfacesStatus",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NetworkBindingServer).InterfacesStatus(ctx, req.(*InterfacesStatusParams))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NetworkBinding_Shutdown_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ShutdownParams)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NetworkBindingServer).Shutdown(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kubevirt.hooks.networkbinding.v1alpha1.NetworkBinding/Shutdown",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NetworkBindingServer).Shutdown(ctx, req.(*ShutdownParams))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _NetworkBinding_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "kubevirt.hooks.networkbinding.v1alpha1.NetworkBinding",
+	HandlerType: (*NetworkBindingServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "OnDefineDomain",
+			Handler:    _NetworkBinding_OnDefineDomain_Handler,
+		},
+		{
+			MethodName: "SetupNetwork",
+			Handler:    _NetworkBinding_SetupNetwork_Handler,
+		},
+		{
+			MethodName: "PostMigration",
+			Handler:    _NetworkBinding_PostMigration_Handler,
+		},
+		{
+			MethodName: "HotplugInterfaces",
+			Handler:    _NetworkBinding_HotplugInterfaces_Handler,
+		},
+		{
+			MethodName: "UnplugInterfaces",
+			Handler:    _NetworkBinding_UnplugInterfaces_Handler,
+		},
+		{
+			MethodName: "InterfacesStatus",
+			Handler:    _NetworkBinding_InterfacesStatus_Handler,
+		},
+		{
+			MethodName: "Shutdown",
+			Handler:    _NetworkBinding_Shutdown_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "api_networkbinding_v1alpha1.proto",
+}
+
+func init() { proto.RegisterFile("api_networkbinding_v1alpha1.proto", fileDescriptor0) }
+
+var fileDescriptor0 = []byte{
+	// 493 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x95, 0xdf, 0x6b, 0x13, 0x41,
+	0x10, 0xc7, 0xd9, 0x06, 0xb4, 0x19, 0xdb, 0x18, 0xd7, 0x5a, 0xcf, 0xc3, 0x87, 0xb8, 0x48, 0xcd,
+	0xd3, 0x41, 0xab, 0x28, 0xa8, 0x28, 0x48, 0xa9, 0x0a, 0xb6, 0x96, 0x04, 0x51, 0xf0, 0xa1, 0x6c,
+	0x9a, 0x6d, 0xb2, 0x24, 0xb7, 0x7b, 0xde, 0xed, 0xa5, 0xe0, 0x1f, 0x20, 0x08, 0x3e, 0x09, 0xfe,
+	0xa3, 0xfe, 0x05, 0x72, 0x77, 0x93, 0x1f, 0xf7, 0x23, 0xb2, 0x1e, 0xbe, 0xed, 0xcd, 0xce, 0x7c,
+	0xe6, 0x3b, 0x77, 0xf3, 0x4d, 0xe0, 0x1e, 0x0f, 0xe4, 0x99, 0x12, 0xe6, 0x52, 0x87, 0x93, 0x81,
+	0x54, 0x43, 0xa9, 0x46, 0x67, 0xb3, 0x7d, 0x3e, 0x0d, 0xc6, 0x7c, 0xdf, 0x0b, 0x42, 0x6d, 0x34,
+	0xdd, 0x9b, 0xc4, 0x03, 0x31, 0x93, 0xa1, 0xf1, 0xc6, 0x5a, 0x4f, 0x22, 0x2f, 0x9f, 0xed, 0xcd,
+	0xb3, 0xd9, 0x11, 0xec, 0xbc, 0x57, 0x87, 0xe2, 0x42, 0x2a, 0x71, 0xa8, 0x7d, 0x2e, 0xd5, 0x29,
+	0x0f, 0xb9, 0x1f, 0xd1, 0xbb, 0xd0, 0x1c, 0xa6, 0xcf, 0x9f, 0x8e, 0xdf, 0x39, 0xa4, 0x43, 0xba,
+	0x5b, 0xbd, 0x65, 0x80, 0xb6, 0xa1, 0x31, 0xf3, 0xa5, 0xb3, 0x91, 0xc6, 0x93, 0x23, 0x7b, 0x54,
+	0xe4, 0xf4, 0x44, 0x14, 0x4f, 0xcd, 0xdf, 0x39, 0x6c, 0x0f, 0x68, 0x5f, 0x98, 0x38, 0x38, 0xc9,
+	0xd4, 0x61, 0x6f, 0xa4, 0x93, 0x25, 0x7d, 0x27, 0x9f, 0x97, 0xb1, 0xd9, 0x03, 0xb8, 0x79, 0xaa,
+	0x23, 0x73, 0x2c, 0x47, 0x21, 0x37, 0x52, 0xab, 0xb5, 0xe5, 0xb7, 0x0a, 0x89, 0x58, 0xff, 0x1a,
+	0x6e, 0xbf, 0xd1, 0x26, 0x98, 0xc6, 0xa3, 0xb7, 0xca, 0x88, 0xf0, 0x82, 0x9f, 0x8b, 0x68, 0x1d,
+	0x83, 0xba, 0xb0, 0x89, 0xef, 0x30, 0x72, 0x36, 0x3a, 0x8d, 0x6e, 0xb3, 0xb7, 0x78, 0x66, 0x77,
+	0x2a, 0x40, 0xd8, 0xe3, 0x08, 0x76, 0x3f, 0xa8, 0xff, 0xd0, 0xc2, 0x29, 0x73, 0xb0, 0x83, 0x03,
+	0xbb, 0xcb, 0x58, 0xdf, 0x70, 0x13, 0x63, 0x07, 0xf6, 0xa5, 0x7c, 0x83, 0x5f, 0xe5, 0x23, 0x80,
+	0x5c, 0xdc, 0x38, 0xa4, 0xd3, 0xe8, 0x5e, 0x3b, 0x78, 0xe2, 0xd9, 0xad, 0x8c, 0xb7, 0x60, 0x22,
+	0x72, 0x05, 0xc5, 0x3e, 0xc3, 0xf5, 0xc2, 0x75, 0x32, 0xa7, 0xcf, 0xcf, 0xd3, 0x39, 0x9b, 0xbd,
+	0xe4, 0x98, 0x44, 0x64, 0x30, 0x1f, 0x31, 0x39, 0xd2, 0xfb, 0xb0, 0xbd, 0x80, 0x9c, 0x70, 0x5f,
+	0x38, 0x8d, 0x34, 0x3b, 0x1f, 0x64, 0x6d, 0x68, 0xf5, 0xc7, 0xb1, 0x19, 0xea, 0x4b, 0xfc, 0xd4,
+	0xab, 0x91, 0x6c, 0xb2, 0x83, 0xdf, 0x57, 0xa1, 0x85, 0x5b, 0xf2, 0x2a, 0x13, 0x4e, 0x7f, 0x10,
+	0x68, 0xe5, 0x77, 0x93, 0x3e, 0xb7, 0x9d, 0xb5, 0xca, 0x1b, 0x6e, 0xcd, 0x6a, 0x7c, 0xf7, 0xdf,
+	0x08, 0x6c, 0xad, 0x2e, 0x33, 0x7d, 0x6a, 0x8b, 0x2b, 0x5b, 0xc5, 0xad, 0x55, 0x8b, 0x42, 0xbe,
+	0x13, 0xd8, 0xce, 0xd9, 0x82, 0x3e, 0xb3, 0xa5, 0x55, 0xd8, 0xce, 0xad, 0x57, 0x8c, 0x5a, 0x7e,
+	0x11, 0xb8, 0x51, 0xb2, 0x10, 0x7d, 0x69, 0x8b, 0x5c, 0x63, 0x63, 0xb7, 0x3e, 0x00, 0x75, 0xfd,
+	0x24, 0xd0, 0x2e, 0xfa, 0x8e, 0xbe, 0xb0, 0xa5, 0x56, 0x3b, 0xdf, 0xad, 0x5d, 0xbf, 0x22, 0xaa,
+	0x68, 0x6c, 0x7b, 0x51, 0xd5, 0x3f, 0x16, 0x6e, 0xed, 0x7a, 0x14, 0xf5, 0x15, 0x36, 0xe7, 0x56,
+	0xa4, 0x8f, 0xad, 0xb7, 0x32, 0x67, 0x67, 0xf7, 0x9f, 0xeb, 0xb2, 0xde, 0x83, 0x2b, 0xe9, 0x7f,
+	0xde, 0xc3, 0x3f, 0x03, 0x00, 0xe7, 0x56, 0xce, 0x18, 0x18, 0x07, 0x00, 0x00,
+}
@@ -61,6 +61,10 @@ func (vmiReport *VirtualMachineInstanceReport) GetVmiStats() VirtualMachineInsta
 	return *vmiReport.vmiStats
 }
 
+func (vmiReport *VirtualMachineInstanceReport) GetVmi() *k6tv1.VirtualMachineInstance {
+	return vmiReport.vmi
+}
+
 func (vmiReport *VirtualMachineInstanceReport) buildRuntimeLabels() {
 	vmiReport.runtimeLabels = map[string]string{}
 
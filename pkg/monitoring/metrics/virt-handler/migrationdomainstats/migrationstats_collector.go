@@ -34,6 +34,7 @@ var (
 			migrateVMIDataProcessed,
 			migrateVmiDirtyMemoryRate,
 			migrateVmiMemoryTransferRate,
+			migrateVmiMemoryCompressionRatio,
 		},
 		CollectCallback: migrationStatsCollectorCallback,
 	}
@@ -72,6 +73,13 @@ var (
 			Help: "The rate at which the memory is being transferred.",
 		},
 	)
+
+	migrateVmiMemoryCompressionRatio = operatormetrics.NewGauge(
+		operatormetrics.MetricOpts{
+			Name: "kubevirt_vmi_migration_memory_compression_ratio",
+			Help: "The ratio of the migrated guest memory to the memory data transferred to the new VM.",
+		},
+	)
 )
 
 func SetupMigrationStatsCollector(vmiInformer cache.SharedIndexInformer) error {
@@ -120,6 +128,10 @@ func parse(r *result) []operatormetrics.CollectorResult {
 		crs = append(crs, newCR(r, migrateVmiMemoryTransferRate, float64(jobInfo.MemoryBps)))
 	}
 
+	if jobInfo.CompressionRatioSet {
+		crs = append(crs, newCR(r, migrateVmiMemoryCompressionRatio, jobInfo.CompressionRatio))
+	}
+
 	return crs
 }
 
@@ -35,16 +35,18 @@ var _ = Describe("migration metrics", func() {
 		vmiStats := &result{
 			vmi: "test-vmi-1",
 			domainJobInfo: stats.DomainJobInfo{
-				DataTotalSet:     true,
-				DataTotal:        3,
-				DataRemainingSet: true,
-				DataRemaining:    1,
-				DataProcessedSet: true,
-				DataProcessed:    2,
-				MemDirtyRateSet:  true,
-				MemDirtyRate:     3,
-				MemoryBpsSet:     true,
-				MemoryBps:        4,
+				DataTotalSet:        true,
+				DataTotal:           3,
+				DataRemainingSet:    true,
+				DataRemaining:       1,
+				DataProcessedSet:    true,
+				DataProcessed:       2,
+				MemDirtyRateSet:     true,
+				MemDirtyRate:        3,
+				MemoryBpsSet:        true,
+				MemoryBps:           4,
+				CompressionRatioSet: true,
+				CompressionRatio:    2.5,
 			},
 		}
 
@@ -57,6 +59,7 @@ var _ = Describe("migration metrics", func() {
 			Entry("kubevirt_vmi_migration_data_processed_bytes", migrateVMIDataProcessed, 2.0),
 			Entry("kubevirt_vmi_migration_dirty_memory_rate_bytes", migrateVmiDirtyMemoryRate, 3.0),
 			Entry("kubevirt_vmi_migration_disk_transfer_rate_bytes", migrateVmiMemoryTransferRate, 4.0),
+			Entry("kubevirt_vmi_migration_memory_compression_ratio", migrateVmiMemoryCompressionRatio, 2.5),
 		)
 
 		It("result should be empty if stat not populated or set is false", func() {
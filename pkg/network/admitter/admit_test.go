@@ -66,12 +66,12 @@ var _ = Describe("Validating VMI network spec", func() {
 			}))
 	})
 
-	It("network interface state value of absent is not supported when bridge-binding is not used", func() {
+	It("network interface state value of absent is not supported when bridge or SR-IOV binding is not used", func() {
 		vm := api.NewMinimalVMI("testvm")
 		vm.Spec.Domain.Devices.Interfaces = []v1.Interface{{
-			Name:                   "foo",
-			State:                  v1.InterfaceStateAbsent,
-			InterfaceBindingMethod: v1.InterfaceBindingMethod{SRIOV: &v1.InterfaceSRIOV{}},
+			Name:    "foo",
+			State:   v1.InterfaceStateAbsent,
+			Binding: &v1.PluginBinding{Name: "custom"},
 		}}
 		vm.Spec.Networks = []v1.Network{
 			{Name: "foo", NetworkSource: v1.NetworkSource{Multus: &v1.MultusNetwork{NetworkName: "net"}}},
@@ -80,11 +80,25 @@ var _ = Describe("Validating VMI network spec", func() {
 		Expect(validator.Validate()).To(
 			ConsistOf(metav1.StatusCause{
 				Type:    "FieldValueInvalid",
-				Message: "\"foo\" interface's state \"absent\" is supported only for bridge binding",
+				Message: "\"foo\" interface's state \"absent\" is supported only for bridge and SR-IOV binding",
 				Field:   "fake.domain.devices.interfaces[0].state",
 			}))
 	})
 
+	It("network interface state value of absent is supported with SR-IOV binding", func() {
+		vm := api.NewMinimalVMI("testvm")
+		vm.Spec.Domain.Devices.Interfaces = []v1.Interface{{
+			Name:                   "foo",
+			State:                  v1.InterfaceStateAbsent,
+			InterfaceBindingMethod: v1.InterfaceBindingMethod{SRIOV: &v1.InterfaceSRIOV{}},
+		}}
+		vm.Spec.Networks = []v1.Network{
+			{Name: "foo", NetworkSource: v1.NetworkSource{Multus: &v1.MultusNetwork{NetworkName: "net"}}},
+		}
+		validator := admitter.NewValidator(k8sfield.NewPath("fake"), &vm.Spec, stubClusterConfigChecker{})
+		Expect(validator.Validate()).To(BeEmpty())
+	})
+
 	It("network interface state value of absent is not supported on the default network", func() {
 		vm := api.NewMinimalVMI("testvm")
 		vm.Spec.Domain.Devices.Interfaces = []v1.Interface{{
@@ -0,0 +1,120 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package admitter
+
+import (
+	"fmt"
+	"net"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	k8sfield "k8s.io/apimachinery/pkg/util/validation/field"
+
+	v1 "kubevirt.io/api/core/v1"
+)
+
+const maxFirewallPort = 65535
+
+func validateFirewall(field *k8sfield.Path, idx int, iface v1.Interface) []metav1.StatusCause {
+	if iface.Firewall == nil {
+		return nil
+	}
+	firewallField := field.Child("domain", "devices", "interfaces").Index(idx).Child("firewall")
+
+	if iface.Bridge == nil && iface.Masquerade == nil {
+		return []metav1.StatusCause{{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: "firewall is only supported on interfaces with bridge or masquerade binding",
+			Field:   firewallField.String(),
+		}}
+	}
+
+	var causes []metav1.StatusCause
+	for ruleIdx, rule := range iface.Firewall.Ingress {
+		causes = append(causes, validateFirewallRule(firewallField.Child("ingress").Index(ruleIdx), rule)...)
+	}
+	for ruleIdx, rule := range iface.Firewall.Egress {
+		causes = append(causes, validateFirewallRule(firewallField.Child("egress").Index(ruleIdx), rule)...)
+	}
+	return causes
+}
+
+func validateFirewallRule(ruleField *k8sfield.Path, rule v1.FirewallRule) []metav1.StatusCause {
+	var causes []metav1.StatusCause
+	for cidrIdx, cidr := range rule.CIDRs {
+		if _, _, err := net.ParseCIDR(cidr); err != nil {
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueInvalid,
+				Message: fmt.Sprintf("%q is not a valid CIDR", cidr),
+				Field:   ruleField.Child("cidrs").Index(cidrIdx).String(),
+			})
+		}
+	}
+
+	if len(rule.Ports) > 0 && rule.Protocol != "TCP" && rule.Protocol != "UDP" && rule.Protocol != "SCTP" {
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: "ports require the TCP, UDP or SCTP protocol",
+			Field:   ruleField.Child("ports").String(),
+		})
+	}
+	for portIdx, port := range rule.Ports {
+		portField := ruleField.Child("ports").Index(portIdx)
+		if port.Port < 1 || port.Port > maxFirewallPort {
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueInvalid,
+				Message: fmt.Sprintf("port %d is out of range [1, %d]", port.Port, maxFirewallPort),
+				Field:   portField.Child("port").String(),
+			})
+		}
+		if port.EndPort != nil && (*port.EndPort < port.Port || *port.EndPort > maxFirewallPort) {
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueInvalid,
+				Message: fmt.Sprintf("endPort %d must be between port %d and %d", *port.EndPort, port.Port, maxFirewallPort),
+				Field:   portField.Child("endPort").String(),
+			})
+		}
+	}
+
+	if rule.RateLimit != nil {
+		rateLimitField := ruleField.Child("rateLimit")
+		if rule.RateLimit.PacketsPerSecond <= 0 {
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueInvalid,
+				Message: "packetsPerSecond must be greater than 0",
+				Field:   rateLimitField.Child("packetsPerSecond").String(),
+			})
+		}
+		if rule.RateLimit.Burst != nil && *rule.RateLimit.Burst <= 0 {
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueInvalid,
+				Message: "burst must be greater than 0",
+				Field:   rateLimitField.Child("burst").String(),
+			})
+		}
+		if rule.Action == v1.FirewallActionDrop {
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueInvalid,
+				Message: "rateLimit can only be set on rules with the Accept action",
+				Field:   rateLimitField.String(),
+			})
+		}
+	}
+	return causes
+}
@@ -0,0 +1,149 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package admitter_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	k8sfield "k8s.io/apimachinery/pkg/util/validation/field"
+
+	v1 "kubevirt.io/api/core/v1"
+
+	"kubevirt.io/kubevirt/pkg/network/admitter"
+	"kubevirt.io/kubevirt/pkg/pointer"
+)
+
+var _ = Describe("Validating interface firewall", func() {
+	newSpec := func(binding v1.InterfaceBindingMethod, firewall *v1.InterfaceFirewall) *v1.VirtualMachineInstanceSpec {
+		spec := &v1.VirtualMachineInstanceSpec{}
+		spec.Domain.Devices.Interfaces = []v1.Interface{{
+			Name:                   "default",
+			InterfaceBindingMethod: binding,
+			Firewall:               firewall,
+		}}
+		spec.Networks = []v1.Network{*v1.DefaultPodNetwork()}
+		return spec
+	}
+	masquerade := v1.InterfaceBindingMethod{Masquerade: &v1.InterfaceMasquerade{}}
+
+	It("should accept a valid firewall", func() {
+		spec := newSpec(masquerade, &v1.InterfaceFirewall{
+			Ingress: []v1.FirewallRule{{
+				CIDRs:     []string{"10.0.0.0/8", "fd00::/64"},
+				Protocol:  "TCP",
+				Ports:     []v1.FirewallPort{{Port: 22}, {Port: 8000, EndPort: pointer.P(int32(8080))}},
+				RateLimit: &v1.FirewallRateLimit{PacketsPerSecond: 100, Burst: pointer.P(int32(10))},
+			}},
+			Egress: []v1.FirewallRule{{Protocol: "ICMP", Action: v1.FirewallActionDrop}},
+		})
+
+		validator := admitter.NewValidator(k8sfield.NewPath("fake"), spec, stubClusterConfigChecker{})
+		Expect(validator.Validate()).To(BeEmpty())
+	})
+
+	It("should reject a firewall on an interface with an unsupported binding", func() {
+		spec := newSpec(v1.InterfaceBindingMethod{SRIOV: &v1.InterfaceSRIOV{}}, &v1.InterfaceFirewall{})
+		spec.Networks = []v1.Network{{
+			Name:          "default",
+			NetworkSource: v1.NetworkSource{Multus: &v1.MultusNetwork{NetworkName: "test"}},
+		}}
+
+		validator := admitter.NewValidator(k8sfield.NewPath("fake"), spec, stubClusterConfigChecker{})
+		Expect(validator.Validate()).To(ConsistOf(metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: "firewall is only supported on interfaces with bridge or masquerade binding",
+			Field:   "fake.domain.devices.interfaces[0].firewall",
+		}))
+	})
+
+	DescribeTable("should reject an invalid rule", func(rule v1.FirewallRule, expectedCause metav1.StatusCause) {
+		spec := newSpec(masquerade, &v1.InterfaceFirewall{Egress: []v1.FirewallRule{rule}})
+
+		validator := admitter.NewValidator(k8sfield.NewPath("fake"), spec, stubClusterConfigChecker{})
+		Expect(validator.Validate()).To(ConsistOf(expectedCause))
+	},
+		Entry("with an invalid CIDR",
+			v1.FirewallRule{CIDRs: []string{"10.0.0.0/8", "10.0.0.300/24"}},
+			metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueInvalid,
+				Message: `"10.0.0.300/24" is not a valid CIDR`,
+				Field:   "fake.domain.devices.interfaces[0].firewall.egress[0].cidrs[1]",
+			},
+		),
+		Entry("with ports and no protocol",
+			v1.FirewallRule{Ports: []v1.FirewallPort{{Port: 22}}},
+			metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueInvalid,
+				Message: "ports require the TCP, UDP or SCTP protocol",
+				Field:   "fake.domain.devices.interfaces[0].firewall.egress[0].ports",
+			},
+		),
+		Entry("with ports and the ICMP protocol",
+			v1.FirewallRule{Protocol: "ICMP", Ports: []v1.FirewallPort{{Port: 22}}},
+			metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueInvalid,
+				Message: "ports require the TCP, UDP or SCTP protocol",
+				Field:   "fake.domain.devices.interfaces[0].firewall.egress[0].ports",
+			},
+		),
+		Entry("with a port out of range",
+			v1.FirewallRule{Protocol: "UDP", Ports: []v1.FirewallPort{{Port: 70000}}},
+			metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueInvalid,
+				Message: "port 70000 is out of range [1, 65535]",
+				Field:   "fake.domain.devices.interfaces[0].firewall.egress[0].ports[0].port",
+			},
+		),
+		Entry("with an end port lower than the port",
+			v1.FirewallRule{Protocol: "TCP", Ports: []v1.FirewallPort{{Port: 80, EndPort: pointer.P(int32(79))}}},
+			metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueInvalid,
+				Message: "endPort 79 must be between port 80 and 65535",
+				Field:   "fake.domain.devices.interfaces[0].firewall.egress[0].ports[0].endPort",
+			},
+		),
+		Entry("with a non positive rate",
+			v1.FirewallRule{RateLimit: &v1.FirewallRateLimit{PacketsPerSecond: 0}},
+			metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueInvalid,
+				Message: "packetsPerSecond must be greater than 0",
+				Field:   "fake.domain.devices.interfaces[0].firewall.egress[0].rateLimit.packetsPerSecond",
+			},
+		),
+		Entry("with a non positive burst",
+			v1.FirewallRule{RateLimit: &v1.FirewallRateLimit{PacketsPerSecond: 10, Burst: pointer.P(int32(0))}},
+			metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueInvalid,
+				Message: "burst must be greater than 0",
+				Field:   "fake.domain.devices.interfaces[0].firewall.egress[0].rateLimit.burst",
+			},
+		),
+		Entry("with a rate limit on a drop rule",
+			v1.FirewallRule{Action: v1.FirewallActionDrop, RateLimit: &v1.FirewallRateLimit{PacketsPerSecond: 10}},
+			metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueInvalid,
+				Message: "rateLimit can only be set on rules with the Accept action",
+				Field:   "fake.domain.devices.interfaces[0].firewall.egress[0].rateLimit",
+			},
+		),
+	)
+})
@@ -113,6 +113,7 @@ func validateInterfacesFields(field *k8sfield.Path, spec *v1.VirtualMachineInsta
 		causes = append(causes, validatePciAddress(field, idx, iface)...)
 		causes = append(causes, validatePortConfiguration(field, idx, iface, networksByName[iface.Name])...)
 		causes = append(causes, validateDHCPOptions(field, idx, iface)...)
+		causes = append(causes, validateFirewall(field, idx, iface)...)
 	}
 	return causes
 }
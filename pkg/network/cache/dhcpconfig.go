@@ -91,6 +91,8 @@ type DHCPConfig struct {
 	Name                string
 	IP                  netlink.Addr
 	IPv6                netlink.Addr
+	IPv6Gateway         net.IP
+	IPv6Routes          *[]netlink.Route
 	MAC                 net.HardwareAddr
 	AdvertisingIPAddr   net.IP
 	AdvertisingIPv6Addr net.IP
@@ -103,7 +105,7 @@ type DHCPConfig struct {
 
 func (d DHCPConfig) String() string {
 	return fmt.Sprintf(
-		"DHCPConfig: { Name: %s, IPv4: %s, IPv6: %s, MAC: %s, AdvertisingIPAddr: %s, MTU: %d, Gateway: %s, IPAMDisabled: %t, Routes: %v}",
+		"DHCPConfig: { Name: %s, IPv4: %s, IPv6: %s, MAC: %s, AdvertisingIPAddr: %s, MTU: %d, Gateway: %s, IPAMDisabled: %t, Routes: %v, IPv6Gateway: %s, IPv6Routes: %v}",
 		d.Name,
 		d.IP,
 		d.IPv6,
@@ -113,5 +115,7 @@ func (d DHCPConfig) String() string {
 		d.Gateway,
 		d.IPAMDisabled,
 		d.Routes,
+		d.IPv6Gateway,
+		d.IPv6Routes,
 	)
 }
@@ -36,10 +36,11 @@ const (
 )
 
 type PodIfaceCacheData struct {
-	Iface  *v1.Interface `json:"iface,omitempty"`
-	PodIP  string        `json:"podIP,omitempty"`
-	PodIPs []string      `json:"podIPs,omitempty"`
-	State  PodIfaceState `json:"networkState,omitempty"`
+	Iface         *v1.Interface `json:"iface,omitempty"`
+	PodIP         string        `json:"podIP,omitempty"`
+	PodIPs        []string      `json:"podIPs,omitempty"`
+	State         PodIfaceState `json:"networkState,omitempty"`
+	FirewallRules []string      `json:"firewallRules,omitempty"`
 }
 
 type PodInterfaceCache struct {
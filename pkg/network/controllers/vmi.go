@@ -88,7 +88,9 @@ func calculateSecondaryIfaceStatuses(
 
 	networkStatusesByPodIfaceName := multus.NetworkStatusesByPodIfaceName(networkStatuses)
 	podIfaceNamesByNetworkName := namescheme.CreateFromNetworkStatuses(vmi.Spec.Networks, networkStatuses)
+	ifacesSpecByName := vmispec.IndexInterfaceSpecByName(vmi.Spec.Domain.Devices.Interfaces)
 	for _, network := range vmispec.FilterMultusNonDefaultNetworks(vmi.Spec.Networks) {
+		isAbsent := ifacesSpecByName[network.Name].State == v1.InterfaceStateAbsent
 		vmiIfaceStatus := vmispec.LookupInterfaceStatusByName(vmi.Status.Interfaces, network.Name)
 		podIfaceName, wasFound := podIfaceNamesByNetworkName[network.Name]
 		if !wasFound {
@@ -107,11 +109,17 @@ func calculateSecondaryIfaceStatuses(
 			updatedIfaceStatus := *vmiIfaceStatus
 			updatedIfaceStatus.InfoSource = vmispec.AddInfoSource(updatedIfaceStatus.InfoSource, vmispec.InfoSourceMultusStatus)
 			updatedIfaceStatus.PodInterfaceName = podIfaceName
+			if isAbsent && updatedIfaceStatus.HotUnplugPhase == "" {
+				updatedIfaceStatus.HotUnplugPhase = v1.InterfaceHotUnplugRequested
+			}
 			interfaceStatuses = append(interfaceStatuses, updatedIfaceStatus)
 		case !exists && vmiIfaceStatus != nil:
 			updatedIfaceStatus := *vmiIfaceStatus
 			updatedIfaceStatus.InfoSource = vmispec.RemoveInfoSource(updatedIfaceStatus.InfoSource, vmispec.InfoSourceMultusStatus)
 			updatedIfaceStatus.PodInterfaceName = podIfaceName
+			if isAbsent {
+				updatedIfaceStatus.HotUnplugPhase = v1.InterfaceHotUnplugPodNetworkRemoved
+			}
 			interfaceStatuses = append(interfaceStatuses, updatedIfaceStatus)
 		}
 	}
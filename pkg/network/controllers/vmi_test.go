@@ -423,6 +423,41 @@ var _ = Describe("Status Update", func() {
 		Expect(vmi.Status.Interfaces).To(Equal(expectedInterfacesStatus))
 	})
 
+	DescribeTable("Should report the hot-unplug phase of an absent interface",
+		func(podNetworkStatus string, existingPhase, expectedPhase v1.InterfaceHotUnplugPhase) {
+			existingInterfacesStatus := []v1.VirtualMachineInstanceNetworkInterface{
+				{
+					Name:             secondaryNetworkName,
+					PodInterfaceName: "pod7e0055a6880",
+					InfoSource:       vmispec.InfoSourceMultusStatus,
+					HotUnplugPhase:   existingPhase,
+				},
+			}
+			absentIface := libvmi.InterfaceDeviceWithBridgeBinding(secondaryNetworkName)
+			absentIface.State = v1.InterfaceStateAbsent
+
+			vmi := libvmi.New(
+				libvmi.WithNamespace(testNamespace),
+				libvmi.WithInterface(absentIface),
+				libvmi.WithNetwork(libvmi.MultusNetwork(secondaryNetworkName, secondaryNetworkAttachmentDefinitionName)),
+				libvmistatus.WithStatus(libvmistatus.New(WithInterfacesStatus(existingInterfacesStatus))),
+			)
+
+			podAnnotations := map[string]string{networkv1.NetworkStatusAnnot: podNetworkStatus}
+
+			Expect(controllers.UpdateVMIStatus(vmi, newPodFromVMI(vmi, podAnnotations))).To(Succeed())
+
+			Expect(vmi.Status.Interfaces).To(HaveLen(1))
+			Expect(vmi.Status.Interfaces[0].HotUnplugPhase).To(Equal(expectedPhase))
+		},
+		Entry("requested when the pod network still exists",
+			multusNetworkStatusWithPrimaryAndSecondaryNets, v1.InterfaceHotUnplugPhase(""), v1.InterfaceHotUnplugRequested),
+		Entry("kept when the guest detached the interface and the pod network still exists",
+			multusNetworkStatusWithPrimaryAndSecondaryNets, v1.InterfaceHotUnplugDetachedInGuest, v1.InterfaceHotUnplugDetachedInGuest),
+		Entry("pod network removed when Multus no longer reports it",
+			multusNetworkStatusWithPrimaryNet, v1.InterfaceHotUnplugDetachedInGuest, v1.InterfaceHotUnplugPodNetworkRemoved),
+	)
+
 	It("Should keep existing interface status when another info source is reported and Multus network-status is missing", func() {
 		existingInterfacesStatus := []v1.VirtualMachineInstanceNetworkInterface{
 			{Name: secondaryNetworkName, InfoSource: vmispec.InfoSourceGuestAgent},
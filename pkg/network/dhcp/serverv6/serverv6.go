@@ -20,6 +20,7 @@
 package serverv6
 
 import (
+	"bytes"
 	"fmt"
 	"net"
 	"time"
@@ -37,10 +38,14 @@ const (
 
 type DHCPv6Handler struct {
 	clientIP  net.IP
+	clientMAC net.HardwareAddr
 	modifiers []dhcpv6.Modifier
 }
 
-func SingleClientDHCPv6Server(clientIP net.IP, serverIfaceName string) error {
+// SingleClientDHCPv6Server serves the given IPv6 address, DNS servers and search domains to a single client.
+// When the client MAC is specified (bridge binding), requests which cannot be correlated to it are ignored,
+// as the server interface is shared with other hosts on the pod network segment.
+func SingleClientDHCPv6Server(clientIP net.IP, clientMAC net.HardwareAddr, serverIfaceName string, dnsIPs []net.IP, searchDomains []string) error {
 	log.Log.Info("Starting SingleClientDHCPv6Server")
 
 	iface, err := net.InterfaceByName(serverIfaceName)
@@ -48,10 +53,11 @@ func SingleClientDHCPv6Server(clientIP net.IP, serverIfaceName string) error {
 		return fmt.Errorf("couldn't create DHCPv6 server, couldn't get the dhcp6 server interface: %v", err)
 	}
 
-	modifiers := prepareDHCPv6Modifiers(clientIP, iface.HardwareAddr)
+	modifiers := prepareDHCPv6Modifiers(clientIP, iface.HardwareAddr, dnsIPs, searchDomains)
 
 	handler := &DHCPv6Handler{
 		clientIP:  clientIP,
+		clientMAC: clientMAC,
 		modifiers: modifiers,
 	}
 
@@ -76,7 +82,10 @@ func SingleClientDHCPv6Server(clientIP net.IP, serverIfaceName string) error {
 func (h *DHCPv6Handler) ServeDHCPv6(conn net.PacketConn, peer net.Addr, m dhcpv6.DHCPv6) {
 	log.Log.V(4).Info("DHCPv6 serving a new request")
 
-	// TODO if we extend the server to support bridge binding, we need to filter out non-vm requests
+	if !h.isRequestFromClient(m, peer) {
+		log.Log.V(4).Infof("DHCPv6 ignoring a request from %s which does not originate from the VM", peer)
+		return
+	}
 
 	response, err := h.buildResponse(m)
 	if err != nil {
@@ -121,9 +130,47 @@ func (h *DHCPv6Handler) buildResponse(msg dhcpv6.DHCPv6) (*dhcpv6.Message, error
 	return response, nil
 }
 
-func prepareDHCPv6Modifiers(clientIP net.IP, serverInterfaceMac net.HardwareAddr) []dhcpv6.Modifier {
+// isRequestFromClient reports whether the request was sent by the served client.
+// The client is identified by the link-layer address of its DUID, or by the modified EUI-64
+// interface identifier of its link-local source address.
+func (h *DHCPv6Handler) isRequestFromClient(msg dhcpv6.DHCPv6, peer net.Addr) bool {
+	if h.clientMAC == nil {
+		return true
+	}
+
+	if dhcpv6Msg, ok := msg.(*dhcpv6.Message); ok {
+		switch duid := dhcpv6Msg.Options.ClientID().(type) {
+		case *dhcpv6.DUIDLL:
+			return bytes.Equal(duid.LinkLayerAddr, h.clientMAC)
+		case *dhcpv6.DUIDLLT:
+			return bytes.Equal(duid.LinkLayerAddr, h.clientMAC)
+		}
+	}
+
+	if udpPeer, ok := peer.(*net.UDPAddr); ok && udpPeer.IP.IsLinkLocalUnicast() {
+		return bytes.Equal(udpPeer.IP.To16()[8:], eui64InterfaceID(h.clientMAC))
+	}
+	return false
+}
+
+func eui64InterfaceID(mac net.HardwareAddr) []byte {
+	const universalLocalBit = 0x02
+	if len(mac) != 6 {
+		return nil
+	}
+	return []byte{mac[0] ^ universalLocalBit, mac[1], mac[2], 0xff, 0xfe, mac[3], mac[4], mac[5]}
+}
+
+func prepareDHCPv6Modifiers(clientIP net.IP, serverInterfaceMac net.HardwareAddr, dnsIPs []net.IP, searchDomains []string) []dhcpv6.Modifier {
 	optIAAddress := dhcpv6.OptIAAddress{IPv6Addr: clientIP, PreferredLifetime: infiniteLease, ValidLifetime: infiniteLease}
 	duid := &dhcpv6.DUIDLL{HWType: iana.HWTypeEthernet, LinkLayerAddr: serverInterfaceMac}
 
-	return []dhcpv6.Modifier{dhcpv6.WithIANA(optIAAddress), dhcpv6.WithServerID(duid)}
+	modifiers := []dhcpv6.Modifier{dhcpv6.WithIANA(optIAAddress), dhcpv6.WithServerID(duid)}
+	if len(dnsIPs) > 0 {
+		modifiers = append(modifiers, dhcpv6.WithDNS(dnsIPs...))
+	}
+	if len(searchDomains) > 0 {
+		modifiers = append(modifiers, dhcpv6.WithDomainSearchList(searchDomains...))
+	}
+	return modifiers
 }
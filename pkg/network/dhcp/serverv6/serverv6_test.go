@@ -34,7 +34,7 @@ var _ = Describe("DHCPv6", func() {
 		It("should contain ianaAdrress and duid", func() {
 			clientIP := net.ParseIP("fd10:0:2::2")
 			serverInterfaceMac, _ := net.ParseMAC("12:34:56:78:9A:BC")
-			modifiers := prepareDHCPv6Modifiers(clientIP, serverInterfaceMac)
+			modifiers := prepareDHCPv6Modifiers(clientIP, serverInterfaceMac, nil, nil)
 			Expect(modifiers).To(HaveLen(2))
 
 			msg := &dhcpv6.Message{
@@ -53,13 +53,64 @@ var _ = Describe("DHCPv6", func() {
 			Expect(msg.GetOneOption(dhcpv6.OptionServerID).String()).To(Equal(expectedServerId.String()))
 		})
 	})
+	Context("prepareDHCPv6Modifiers with DNS and search domains", func() {
+		It("should contain the DNS servers and the domain search list", func() {
+			clientIP := net.ParseIP("fd10:0:2::2")
+			serverInterfaceMac, _ := net.ParseMAC("12:34:56:78:9A:BC")
+			dnsIP := net.ParseIP("fd00:10:96::a")
+			searchDomains := []string{"default.svc.cluster.local", "cluster.local"}
+			modifiers := prepareDHCPv6Modifiers(clientIP, serverInterfaceMac, []net.IP{dnsIP}, searchDomains)
+			Expect(modifiers).To(HaveLen(4))
+
+			msg := &dhcpv6.Message{
+				MessageType: dhcpv6.MessageTypeAdvertise,
+			}
+			for _, modifier := range modifiers {
+				modifier(msg)
+			}
+			Expect(msg.Options.DNS()).To(Equal([]net.IP{dnsIP}))
+			Expect(msg.Options.DomainSearchList().Labels).To(Equal(searchDomains))
+		})
+	})
+	Context("isRequestFromClient", func() {
+		const (
+			vmMAC    = "02:00:00:00:00:01"
+			otherMAC = "02:00:00:00:00:02"
+		)
+		var handler *DHCPv6Handler
+
+		BeforeEach(func() {
+			clientMAC, _ := net.ParseMAC(vmMAC)
+			handler = &DHCPv6Handler{clientMAC: clientMAC}
+		})
+
+		DescribeTable("should identify the client", func(duid dhcpv6.DUID, peerIP string, expected bool) {
+			clientMessage, err := dhcpv6.NewMessage(dhcpv6.WithClientID(duid))
+			Expect(err).ToNot(HaveOccurred())
+			peer := &net.UDPAddr{IP: net.ParseIP(peerIP), Port: dhcpv6.DefaultClientPort}
+			Expect(handler.isRequestFromClient(clientMessage, peer)).To(Equal(expected))
+		},
+			Entry("by a matching DUID-LL", duidLL(vmMAC), "fe80::1234", true),
+			Entry("by a matching DUID-LLT", &dhcpv6.DUIDLLT{HWType: iana.HWTypeEthernet, LinkLayerAddr: parseMAC(vmMAC)}, "fe80::1234", true),
+			Entry("rejecting a DUID-LL of another host", duidLL(otherMAC), "fe80::ff:fe00:1", false),
+			Entry("by the EUI-64 link-local address when the DUID has no link-layer address", &dhcpv6.DUIDUUID{}, "fe80::ff:fe00:1", true),
+			Entry("rejecting a non matching link-local address when the DUID has no link-layer address", &dhcpv6.DUIDUUID{}, "fe80::ff:fe00:2", false),
+		)
+
+		It("should accept any request when the client MAC is not specified", func() {
+			handler.clientMAC = nil
+			clientMessage, err := dhcpv6.NewMessage(dhcpv6.WithClientID(duidLL(otherMAC)))
+			Expect(err).ToNot(HaveOccurred())
+			Expect(handler.isRequestFromClient(clientMessage, &net.UDPAddr{IP: net.ParseIP("fe80::1")})).To(BeTrue())
+		})
+	})
 	Context("buildResponse should build a response with", func() {
 		var handler *DHCPv6Handler
 
 		BeforeEach(func() {
 			clientIP := net.ParseIP("fd10:0:2::2")
 			serverInterfaceMac, _ := net.ParseMAC("12:34:56:78:9A:BC")
-			modifiers := prepareDHCPv6Modifiers(clientIP, serverInterfaceMac)
+			modifiers := prepareDHCPv6Modifiers(clientIP, serverInterfaceMac, nil, nil)
 
 			handler = &DHCPv6Handler{
 				clientIP:  clientIP,
@@ -124,6 +175,15 @@ var _ = Describe("DHCPv6", func() {
 	})
 })
 
+func parseMAC(mac string) net.HardwareAddr {
+	hwAddr, _ := net.ParseMAC(mac)
+	return hwAddr
+}
+
+func duidLL(mac string) *dhcpv6.DUIDLL {
+	return &dhcpv6.DUIDLL{HWType: iana.HWTypeEthernet, LinkLayerAddr: parseMAC(mac)}
+}
+
 func newMessage(messageType dhcpv6.MessageType) (*dhcpv6.Message, error) {
 	clientMac, _ := net.ParseMAC("34:56:78:9A:BC:DE")
 	duid := &dhcpv6.DUIDLL{HWType: iana.HWTypeEthernet, LinkLayerAddr: clientMac}
@@ -16,6 +16,7 @@ const (
 	nameserverPrefix    = "nameserver"
 	defaultDNS          = "8.8.8.8"
 	defaultSearchDomain = "cluster.local"
+	resolvConf          = "/etc/resolv.conf"
 )
 
 func ParseNameservers(content string) ([][]byte, error) {
@@ -50,6 +51,30 @@ func ParseNameservers(content string) ([][]byte, error) {
 	return nameservers, nil
 }
 
+// ParseIPv6Nameservers returns the IPv6 nameservers listed in the resolver's configuration.
+// Unlike ParseNameservers, no default nameserver is applied.
+func ParseIPv6Nameservers(content string) ([]net.IP, error) {
+	var nameservers []net.IP
+
+	scanner := bufio.NewScanner(strings.NewReader(content))
+
+	for scanner.Scan() {
+		fields := strings.Fields(scanner.Text())
+		if len(fields) < 2 || fields[0] != nameserverPrefix {
+			continue
+		}
+		if ip := net.ParseIP(fields[1]); ip != nil && ip.To4() == nil {
+			nameservers = append(nameservers, ip)
+		}
+	}
+
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+
+	return nameservers, nil
+}
+
 func ParseSearchDomains(content string) ([]string, error) {
 	var searchDomains []string
 
@@ -130,8 +155,6 @@ func DomainNameWithSubdomain(searchDomains []string, subdomain string) string {
 // GetResolvConfDetailsFromPod reads and parses the DNS resolver's configuration file.
 func GetResolvConfDetailsFromPod() ([][]byte, []string, error) {
 	// #nosec No risk for path injection. resolvConf is static "/etc/resolve.conf"
-	const resolvConf = "/etc/resolv.conf"
-
 	b, err := os.ReadFile(resolvConf)
 	if err != nil {
 		return nil, nil, err
@@ -152,3 +175,14 @@ func GetResolvConfDetailsFromPod() ([][]byte, []string, error) {
 
 	return nameservers, searchDomains, err
 }
+
+// GetIPv6NameserversFromPod reads the IPv6 nameservers from the DNS resolver's configuration file.
+func GetIPv6NameserversFromPod() ([]net.IP, error) {
+	// #nosec No risk for path injection. resolvConf is static "/etc/resolve.conf"
+	b, err := os.ReadFile(resolvConf)
+	if err != nil {
+		return nil, err
+	}
+
+	return ParseIPv6Nameservers(string(b))
+}
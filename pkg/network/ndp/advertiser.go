@@ -0,0 +1,164 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package ndp
+
+import (
+	"bytes"
+	"errors"
+	"net"
+	"os"
+	"sync/atomic"
+	"time"
+
+	"kubevirt.io/client-go/log"
+)
+
+const (
+	routerLifetime      = 30 * time.Minute
+	readvertiseInterval = 10 * time.Minute
+
+	maxMessageSize = 1500
+)
+
+type conn interface {
+	ReadFrom(b []byte) (int, net.IP, error)
+	WriteTo(b []byte, dst, src net.IP) error
+	SetReadDeadline(t time.Time) error
+	Close() error
+}
+
+// Config describes the IPv6 network of a single client, as seen by the pod interface.
+type Config struct {
+	// ClientMAC is the MAC address of the guest interface, the only one being answered.
+	ClientMAC net.HardwareAddr
+	// Prefix is the on-link prefix of the guest address.
+	Prefix *net.IPNet
+	// Router is the link-local address of the network gateway, advertised as the default router.
+	Router net.IP
+	// Routes are the destinations which are reachable through the router.
+	Routes []*net.IPNet
+}
+
+// RouterAdvertiser answers the Router Solicitations of a single client, instructing it to
+// acquire its address through DHCPv6 and advertising the pod network gateway and routes.
+// Advertisements are sent only as unicast to the client, so other hosts on the network segment
+// are not affected.
+type RouterAdvertiser struct {
+	conn      conn
+	clientMAC net.HardwareAddr
+	source    net.IP
+	message   []byte
+	interval  time.Duration
+	closed    atomic.Bool
+}
+
+// NewRouterAdvertiser creates a router advertiser on the given interface.
+func NewRouterAdvertiser(ifaceName string, config Config) (*RouterAdvertiser, error) {
+	icmpConn, err := NewICMPConn(ifaceName)
+	if err != nil {
+		return nil, err
+	}
+	return newRouterAdvertiser(icmpConn, config, readvertiseInterval), nil
+}
+
+func newRouterAdvertiser(c conn, config Config, interval time.Duration) *RouterAdvertiser {
+	ra := RouterAdvertisement{
+		ManagedConfiguration: true,
+		OtherConfiguration:   true,
+	}
+	if config.Prefix != nil {
+		if prefixLen, bits := config.Prefix.Mask.Size(); prefixLen < bits {
+			ra.Prefix = config.Prefix
+		}
+	}
+
+	// The advertisement is sent on behalf of the gateway, which is reachable by the guest only
+	// through its link-local address. Otherwise, the interface address is used as the source and
+	// the guest is not given a default router.
+	var source net.IP
+	if config.Router.IsLinkLocalUnicast() {
+		source = config.Router
+		ra.RouterLifetime = routerLifetime
+		ra.Routes = config.Routes
+		ra.RouteLifetime = routerLifetime
+	}
+
+	return &RouterAdvertiser{
+		conn:      c,
+		clientMAC: config.ClientMAC,
+		source:    source,
+		message:   ra.Marshal(),
+		interval:  interval,
+	}
+}
+
+// Serve answers Router Solicitations until the advertiser is closed.
+// Once the client address is learned, advertisements are repeated before the router lifetime expires.
+func (r *RouterAdvertiser) Serve() error {
+	var clientIP net.IP
+	b := make([]byte, maxMessageSize)
+	for {
+		if err := r.conn.SetReadDeadline(time.Now().Add(r.interval)); err != nil {
+			return r.stopped(err)
+		}
+
+		n, srcIP, err := r.conn.ReadFrom(b)
+		if errors.Is(err, os.ErrDeadlineExceeded) {
+			if clientIP != nil {
+				r.advertise(clientIP)
+			}
+			continue
+		}
+		if err != nil {
+			return r.stopped(err)
+		}
+
+		rs, err := ParseRouterSolicitation(b[:n])
+		if err != nil {
+			log.Log.V(4).Reason(err).Infof("ignoring an invalid router solicitation from %s", srcIP)
+			continue
+		}
+		if srcIP.IsUnspecified() || !bytes.Equal(rs.SourceLinkLayerAddress, r.clientMAC) {
+			log.Log.V(4).Infof("ignoring a router solicitation from %s which does not originate from the VM", srcIP)
+			continue
+		}
+
+		clientIP = srcIP
+		r.advertise(clientIP)
+	}
+}
+
+func (r *RouterAdvertiser) advertise(dst net.IP) {
+	if err := r.conn.WriteTo(r.message, dst, r.source); err != nil {
+		log.Log.Reason(err).Errorf("failed to send a router advertisement to %s", dst)
+	}
+}
+
+func (r *RouterAdvertiser) stopped(err error) error {
+	if r.closed.Load() {
+		return nil
+	}
+	return err
+}
+
+func (r *RouterAdvertiser) Close() error {
+	r.closed.Store(true)
+	return r.conn.Close()
+}
@@ -0,0 +1,183 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package ndp
+
+import (
+	"errors"
+	"net"
+	"os"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("RouterAdvertiser", func() {
+	const (
+		vmMAC         = "02:00:00:00:00:01"
+		otherMAC      = "02:00:00:00:00:02"
+		vmLinkLocal   = "fe80::ff:fe00:1"
+		routerAddress = "fe80::1"
+	)
+
+	var (
+		conn   *connStub
+		prefix *net.IPNet
+	)
+
+	BeforeEach(func() {
+		conn = newConnStub()
+		var err error
+		_, prefix, err = net.ParseCIDR("2001:db8::/64")
+		Expect(err).NotTo(HaveOccurred())
+	})
+
+	newConfig := func(router string) Config {
+		clientMAC, err := net.ParseMAC(vmMAC)
+		Expect(err).NotTo(HaveOccurred())
+		return Config{ClientMAC: clientMAC, Prefix: prefix, Router: net.ParseIP(router)}
+	}
+
+	serve := func(advertiser *RouterAdvertiser) chan error {
+		done := make(chan error, 1)
+		go func() {
+			done <- advertiser.Serve()
+		}()
+		return done
+	}
+
+	It("should answer a router solicitation from the VM on behalf of the router", func() {
+		advertiser := newRouterAdvertiser(conn, newConfig(routerAddress), time.Hour)
+		done := serve(advertiser)
+
+		conn.receive(routerSolicitation(vmMAC), vmLinkLocal)
+
+		var sent packet
+		Eventually(conn.sent).Should(Receive(&sent))
+		Expect(sent.dst.String()).To(Equal(vmLinkLocal))
+		Expect(sent.src.String()).To(Equal(routerAddress))
+		Expect(sent.b).To(Equal(advertiser.message))
+		Expect(sent.b[6:8]).To(Equal([]byte{0x07, 0x08}))
+
+		Expect(advertiser.Close()).To(Succeed())
+		Eventually(done).Should(Receive(BeNil()))
+	})
+
+	It("should ignore router solicitations from other hosts", func() {
+		advertiser := newRouterAdvertiser(conn, newConfig(routerAddress), time.Hour)
+		done := serve(advertiser)
+
+		conn.receive(routerSolicitation(otherMAC), "fe80::ff:fe00:2")
+		conn.receive([]byte{133, 0, 0, 0, 0, 0, 0, 0}, vmLinkLocal)
+		Consistently(conn.sent, 100*time.Millisecond).ShouldNot(Receive())
+
+		Expect(advertiser.Close()).To(Succeed())
+		Eventually(done).Should(Receive(BeNil()))
+	})
+
+	It("should not advertise a default router when the gateway is not link-local", func() {
+		advertiser := newRouterAdvertiser(conn, newConfig("2001:db8::1"), time.Hour)
+		done := serve(advertiser)
+
+		conn.receive(routerSolicitation(vmMAC), vmLinkLocal)
+
+		var sent packet
+		Eventually(conn.sent).Should(Receive(&sent))
+		Expect(sent.src).To(BeNil())
+		Expect(sent.b[6:8]).To(Equal([]byte{0, 0}))
+
+		Expect(advertiser.Close()).To(Succeed())
+		Eventually(done).Should(Receive(BeNil()))
+	})
+
+	It("should repeat the advertisement to the learned VM address", func() {
+		advertiser := newRouterAdvertiser(conn, newConfig(routerAddress), 10*time.Millisecond)
+		done := serve(advertiser)
+
+		conn.receive(routerSolicitation(vmMAC), vmLinkLocal)
+
+		for i := 0; i < 3; i++ {
+			var sent packet
+			Eventually(conn.sent).Should(Receive(&sent))
+			Expect(sent.dst.String()).To(Equal(vmLinkLocal))
+		}
+
+		Expect(advertiser.Close()).To(Succeed())
+		Eventually(done).Should(Receive(BeNil()))
+	})
+})
+
+func routerSolicitation(mac string) []byte {
+	hwAddr, _ := net.ParseMAC(mac)
+	return append([]byte{133, 0, 0, 0, 0, 0, 0, 0, 1, 1}, hwAddr...)
+}
+
+type packet struct {
+	b   []byte
+	src net.IP
+	dst net.IP
+}
+
+type connStub struct {
+	received chan packet
+	sent     chan packet
+	deadline chan time.Time
+	closed   chan struct{}
+}
+
+func newConnStub() *connStub {
+	return &connStub{
+		received: make(chan packet, 10),
+		sent:     make(chan packet, 10),
+		deadline: make(chan time.Time, 1),
+		closed:   make(chan struct{}),
+	}
+}
+
+func (c *connStub) receive(b []byte, src string) {
+	c.received <- packet{b: b, src: net.ParseIP(src)}
+}
+
+func (c *connStub) ReadFrom(b []byte) (int, net.IP, error) {
+	deadline := <-c.deadline
+	select {
+	case p := <-c.received:
+		return copy(b, p.b), p.src, nil
+	case <-c.closed:
+		return 0, nil, errors.New("use of closed connection")
+	case <-time.After(time.Until(deadline)):
+		return 0, nil, os.ErrDeadlineExceeded
+	}
+}
+
+func (c *connStub) WriteTo(b []byte, dst, src net.IP) error {
+	c.sent <- packet{b: b, src: src, dst: dst}
+	return nil
+}
+
+func (c *connStub) SetReadDeadline(t time.Time) error {
+	c.deadline <- t
+	return nil
+}
+
+func (c *connStub) Close() error {
+	close(c.closed)
+	return nil
+}
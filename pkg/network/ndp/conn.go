@@ -0,0 +1,145 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package ndp
+
+import (
+	"fmt"
+	"net"
+	"time"
+
+	"golang.org/x/net/ipv6"
+	"golang.org/x/sys/unix"
+)
+
+const ndpHopLimit = 255
+
+// ICMPConn is a raw ICMPv6 connection bound to a single interface, receiving only Router Solicitations.
+type ICMPConn struct {
+	iface      *net.Interface
+	packetConn *ipv6.PacketConn
+}
+
+// NewICMPConn opens the connection on the given interface.
+// Creating the connection requires the CAP_NET_RAW capability.
+func NewICMPConn(ifaceName string) (*ICMPConn, error) {
+	const errFmt = "failed creating an ICMPv6 connection on %s: %v"
+
+	iface, err := net.InterfaceByName(ifaceName)
+	if err != nil {
+		return nil, fmt.Errorf(errFmt, ifaceName, err)
+	}
+
+	conn, err := net.ListenPacket("ip6:ipv6-icmp", "::")
+	if err != nil {
+		return nil, fmt.Errorf(errFmt, ifaceName, err)
+	}
+
+	icmpConn, err := configureICMPConn(conn, iface)
+	if err != nil {
+		conn.Close()
+		return nil, fmt.Errorf(errFmt, ifaceName, err)
+	}
+	return icmpConn, nil
+}
+
+func configureICMPConn(conn net.PacketConn, iface *net.Interface) (*ICMPConn, error) {
+	if err := enableFreeBind(conn); err != nil {
+		return nil, err
+	}
+
+	packetConn := ipv6.NewPacketConn(conn)
+	if err := packetConn.SetHopLimit(ndpHopLimit); err != nil {
+		return nil, err
+	}
+	if err := packetConn.SetMulticastHopLimit(ndpHopLimit); err != nil {
+		return nil, err
+	}
+	if err := packetConn.SetControlMessage(ipv6.FlagInterface, true); err != nil {
+		return nil, err
+	}
+
+	var filter ipv6.ICMPFilter
+	filter.SetAll(true)
+	filter.Accept(ipv6.ICMPTypeRouterSolicitation)
+	if err := packetConn.SetICMPFilter(&filter); err != nil {
+		return nil, err
+	}
+
+	if err := packetConn.JoinGroup(iface, &net.IPAddr{IP: net.IPv6linklocalallrouters}); err != nil {
+		return nil, err
+	}
+
+	return &ICMPConn{iface: iface, packetConn: packetConn}, nil
+}
+
+// enableFreeBind allows sending messages on behalf of the network router,
+// using its (non local) link-local address as the source address.
+func enableFreeBind(conn net.PacketConn) error {
+	syscallConn, ok := conn.(*net.IPConn)
+	if !ok {
+		return fmt.Errorf("unexpected connection type %T", conn)
+	}
+	rawConn, err := syscallConn.SyscallConn()
+	if err != nil {
+		return err
+	}
+
+	var sockoptErr error
+	if err := rawConn.Control(func(fd uintptr) {
+		sockoptErr = unix.SetsockoptInt(int(fd), unix.SOL_IPV6, unix.IPV6_FREEBIND, 1)
+	}); err != nil {
+		return err
+	}
+	return sockoptErr
+}
+
+// ReadFrom reads the next message received on the connection interface.
+func (c *ICMPConn) ReadFrom(b []byte) (int, net.IP, error) {
+	for {
+		n, cm, addr, err := c.packetConn.ReadFrom(b)
+		if err != nil {
+			return 0, nil, err
+		}
+		if cm != nil && cm.IfIndex != c.iface.Index {
+			continue
+		}
+		ipAddr, ok := addr.(*net.IPAddr)
+		if !ok {
+			continue
+		}
+		return n, ipAddr.IP, nil
+	}
+}
+
+// WriteTo sends the message to the destination through the connection interface.
+// When the source is not specified, the interface address is used.
+func (c *ICMPConn) WriteTo(b []byte, dst, src net.IP) error {
+	cm := &ipv6.ControlMessage{IfIndex: c.iface.Index, HopLimit: ndpHopLimit, Src: src}
+	_, err := c.packetConn.WriteTo(b, cm, &net.IPAddr{IP: dst, Zone: c.iface.Name})
+	return err
+}
+
+func (c *ICMPConn) SetReadDeadline(t time.Time) error {
+	return c.packetConn.SetReadDeadline(t)
+}
+
+func (c *ICMPConn) Close() error {
+	return c.packetConn.Close()
+}
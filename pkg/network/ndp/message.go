@@ -0,0 +1,155 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package ndp
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"math"
+	"net"
+	"time"
+
+	"golang.org/x/net/ipv6"
+)
+
+const (
+	optionSourceLinkLayerAddress = 1
+	optionPrefixInformation      = 3
+	optionRouteInformation       = 24
+
+	// The option length is expressed in units of 8 octets.
+	optionLengthUnit = 8
+
+	routerAdvertisementHeaderLen  = 16
+	routerSolicitationHeaderLen   = 8
+	prefixInformationOptionLength = 4
+
+	flagManagedAddressConfiguration = 0x80
+	flagOtherConfiguration          = 0x40
+	flagOnLink                      = 0x80
+
+	defaultCurHopLimit = 64
+)
+
+var infiniteLifetime = time.Duration(math.MaxUint32) * time.Second
+
+// RouterAdvertisement is an ICMPv6 Router Advertisement message (RFC 4861),
+// optionally carrying Route Information options (RFC 4191).
+type RouterAdvertisement struct {
+	ManagedConfiguration bool
+	OtherConfiguration   bool
+	RouterLifetime       time.Duration
+	Prefix               *net.IPNet
+	Routes               []*net.IPNet
+	RouteLifetime        time.Duration
+}
+
+// Marshal encodes the message. The checksum is left empty, as it is computed by the kernel.
+func (ra RouterAdvertisement) Marshal() []byte {
+	b := make([]byte, routerAdvertisementHeaderLen)
+	b[0] = byte(ipv6.ICMPTypeRouterAdvertisement)
+	b[4] = defaultCurHopLimit
+	if ra.ManagedConfiguration {
+		b[5] |= flagManagedAddressConfiguration
+	}
+	if ra.OtherConfiguration {
+		b[5] |= flagOtherConfiguration
+	}
+	binary.BigEndian.PutUint16(b[6:8], uint16(ra.RouterLifetime/time.Second))
+
+	if ra.Prefix != nil {
+		b = append(b, marshalPrefixInformation(ra.Prefix)...)
+	}
+	for _, route := range ra.Routes {
+		b = append(b, marshalRouteInformation(route, ra.RouteLifetime)...)
+	}
+	return b
+}
+
+func marshalPrefixInformation(prefix *net.IPNet) []byte {
+	b := make([]byte, prefixInformationOptionLength*optionLengthUnit)
+	prefixLen, _ := prefix.Mask.Size()
+
+	b[0] = optionPrefixInformation
+	b[1] = prefixInformationOptionLength
+	b[2] = byte(prefixLen)
+	// Addresses are assigned by DHCPv6, the prefix is only advertised as on-link (autonomous flag is unset).
+	b[3] = flagOnLink
+	binary.BigEndian.PutUint32(b[4:8], uint32(infiniteLifetime/time.Second))
+	binary.BigEndian.PutUint32(b[8:12], uint32(infiniteLifetime/time.Second))
+	copy(b[16:32], prefix.IP.Mask(prefix.Mask).To16())
+	return b
+}
+
+func marshalRouteInformation(destination *net.IPNet, lifetime time.Duration) []byte {
+	prefixLen, _ := destination.Mask.Size()
+
+	// The prefix field is truncated to the minimal number of 8 octets units (RFC 4191 section 2.3).
+	var length int
+	switch {
+	case prefixLen == 0:
+		length = 1
+	case prefixLen <= 64:
+		length = 2
+	default:
+		length = 3
+	}
+
+	b := make([]byte, length*optionLengthUnit)
+	b[0] = optionRouteInformation
+	b[1] = byte(length)
+	b[2] = byte(prefixLen)
+	binary.BigEndian.PutUint32(b[4:8], uint32(lifetime/time.Second))
+	copy(b[8:], destination.IP.Mask(destination.Mask).To16())
+	return b
+}
+
+// RouterSolicitation is an ICMPv6 Router Solicitation message (RFC 4861).
+type RouterSolicitation struct {
+	SourceLinkLayerAddress net.HardwareAddr
+}
+
+// ParseRouterSolicitation decodes a Router Solicitation message, including its ICMPv6 header.
+func ParseRouterSolicitation(b []byte) (*RouterSolicitation, error) {
+	if len(b) < routerSolicitationHeaderLen {
+		return nil, fmt.Errorf("router solicitation is too short: %d bytes", len(b))
+	}
+	if b[0] != byte(ipv6.ICMPTypeRouterSolicitation) {
+		return nil, fmt.Errorf("unexpected ICMPv6 message type %d", b[0])
+	}
+
+	rs := &RouterSolicitation{}
+	options := b[routerSolicitationHeaderLen:]
+	for len(options) > 0 {
+		if len(options) < 2 {
+			return nil, errors.New("truncated router solicitation option")
+		}
+		optionLen := int(options[1]) * optionLengthUnit
+		if optionLen == 0 || optionLen > len(options) {
+			return nil, fmt.Errorf("invalid router solicitation option length: %d", options[1])
+		}
+		if options[0] == optionSourceLinkLayerAddress {
+			rs.SourceLinkLayerAddress = net.HardwareAddr(options[2:optionLen])
+		}
+		options = options[optionLen:]
+	}
+	return rs, nil
+}
@@ -0,0 +1,104 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package ndp_test
+
+import (
+	"net"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"kubevirt.io/kubevirt/pkg/network/ndp"
+)
+
+var _ = Describe("NDP messages", func() {
+	Context("RouterAdvertisement", func() {
+		It("should encode the header without a router", func() {
+			ra := ndp.RouterAdvertisement{ManagedConfiguration: true, OtherConfiguration: true}
+			Expect(ra.Marshal()).To(Equal([]byte{
+				134, 0, 0, 0, // type, code, checksum
+				64, 0xc0, 0, 0, // hop limit, M and O flags, router lifetime
+				0, 0, 0, 0, // reachable time
+				0, 0, 0, 0, // retrans timer
+			}))
+		})
+
+		It("should encode the router lifetime, prefix and routes", func() {
+			_, prefix, err := net.ParseCIDR("2001:db8:1::5/64")
+			Expect(err).NotTo(HaveOccurred())
+			_, route, err := net.ParseCIDR("2001:db8:2::/48")
+			Expect(err).NotTo(HaveOccurred())
+			_, hostRoute, err := net.ParseCIDR("2001:db8:3::1/128")
+			Expect(err).NotTo(HaveOccurred())
+
+			ra := ndp.RouterAdvertisement{
+				RouterLifetime: 30 * time.Minute,
+				Prefix:         prefix,
+				Routes:         []*net.IPNet{route, hostRoute},
+				RouteLifetime:  30 * time.Minute,
+			}
+			b := ra.Marshal()
+			Expect(b).To(HaveLen(16 + 32 + 16 + 24))
+			Expect(b[6:8]).To(Equal([]byte{0x07, 0x08}))
+
+			prefixOption := b[16:48]
+			Expect(prefixOption[:4]).To(Equal([]byte{3, 4, 64, 0x80}))
+			Expect(prefixOption[4:12]).To(Equal([]byte{0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff}))
+			Expect(net.IP(prefixOption[16:32]).String()).To(Equal("2001:db8:1::"))
+
+			routeOption := b[48:64]
+			Expect(routeOption[:3]).To(Equal([]byte{24, 2, 48}))
+			Expect(routeOption[4:8]).To(Equal([]byte{0, 0, 0x07, 0x08}))
+			Expect(routeOption[8:16]).To(Equal([]byte{0x20, 0x01, 0x0d, 0xb8, 0, 0x02, 0, 0}))
+
+			hostRouteOption := b[64:88]
+			Expect(hostRouteOption[:3]).To(Equal([]byte{24, 3, 128}))
+			Expect(net.IP(hostRouteOption[8:24]).String()).To(Equal("2001:db8:3::1"))
+		})
+	})
+
+	Context("ParseRouterSolicitation", func() {
+		It("should decode the source link-layer address", func() {
+			rs, err := ndp.ParseRouterSolicitation([]byte{
+				133, 0, 0, 0, 0, 0, 0, 0,
+				1, 1, 0x02, 0, 0, 0, 0, 0x01,
+			})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(rs.SourceLinkLayerAddress.String()).To(Equal("02:00:00:00:00:01"))
+		})
+
+		It("should decode a solicitation without options", func() {
+			rs, err := ndp.ParseRouterSolicitation([]byte{133, 0, 0, 0, 0, 0, 0, 0})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(rs.SourceLinkLayerAddress).To(BeNil())
+		})
+
+		DescribeTable("should fail", func(b []byte) {
+			_, err := ndp.ParseRouterSolicitation(b)
+			Expect(err).To(HaveOccurred())
+		},
+			Entry("on a truncated header", []byte{133, 0, 0, 0}),
+			Entry("on another message type", []byte{134, 0, 0, 0, 0, 0, 0, 0}),
+			Entry("on a zero option length", []byte{133, 0, 0, 0, 0, 0, 0, 0, 1, 0}),
+			Entry("on an option exceeding the message", []byte{133, 0, 0, 0, 0, 0, 0, 0, 1, 2, 0, 0, 0, 0, 0, 0}),
+		)
+	})
+})
@@ -172,12 +172,13 @@ func shouldAddIstioKubeVirtAnnotation(vmi *v1.VirtualMachineInstance) bool {
 }
 
 func ifacesAndNetsForMultusAnnotationUpdate(vmi *v1.VirtualMachineInstance) ([]v1.Interface, []v1.Network, bool) {
+	ifacesStatusByName := vmispec.IndexInterfaceStatusByName(vmi.Status.Interfaces, nil)
+
 	vmiNonAbsentSpecIfaces := vmispec.FilterInterfacesSpec(vmi.Spec.Domain.Devices.Interfaces, func(iface v1.Interface) bool {
-		return iface.State != v1.InterfaceStateAbsent
+		return iface.State != v1.InterfaceStateAbsent || isSRIOVIfaceAttachedToGuest(iface, ifacesStatusByName)
 	})
 	ifacesToHotUnplugExist := len(vmi.Spec.Domain.Devices.Interfaces) > len(vmiNonAbsentSpecIfaces)
 
-	ifacesStatusByName := vmispec.IndexInterfaceStatusByName(vmi.Status.Interfaces, nil)
 	ifacesToAnnotate := vmispec.FilterInterfacesSpec(vmiNonAbsentSpecIfaces, func(iface v1.Interface) bool {
 		_, ifaceInStatus := ifacesStatusByName[iface.Name]
 		sriovIfaceNotPlugged := iface.SRIOV != nil && !ifaceInStatus
@@ -198,3 +199,12 @@ func ifacesAndNetsForMultusAnnotationUpdate(vmi *v1.VirtualMachineInstance) ([]v
 	}
 	return ifacesToAnnotate, networksToAnnotate, ifaceChangeRequired
 }
+
+// isSRIOVIfaceAttachedToGuest reports whether an SR-IOV interface is yet to be detached from the guest.
+// The VF must not be released from the pod while the guest still uses it.
+func isSRIOVIfaceAttachedToGuest(iface v1.Interface, ifacesStatusByName map[string]v1.VirtualMachineInstanceNetworkInterface) bool {
+	ifaceStatus, inStatus := ifacesStatusByName[iface.Name]
+	return iface.SRIOV != nil && inStatus &&
+		ifaceStatus.HotUnplugPhase != v1.InterfaceHotUnplugDetachedInGuest &&
+		ifaceStatus.HotUnplugPhase != v1.InterfaceHotUnplugPodNetworkRemoved
+}
@@ -726,6 +726,44 @@ var _ = Describe("Annotations Generator", func() {
 			Expect(annotations[networkv1.NetworkAttachmentAnnot]).To(MatchJSON(expectedMultusNetAttach))
 		})
 
+		DescribeTable("Should keep an absent SR-IOV iface in the network attachment annotation until it is detached from the guest",
+			func(hotUnplugPhase v1.InterfaceHotUnplugPhase, expectAnnotationUpdate bool) {
+				sriovIface := libvmi.InterfaceDeviceWithSRIOVBinding(network1Name)
+				sriovIface.State = v1.InterfaceStateAbsent
+				vmi := libvmi.New(
+					libvmi.WithNamespace(testNamespace),
+					libvmi.WithInterface(*v1.DefaultBridgeNetworkInterface()),
+					libvmi.WithInterface(sriovIface),
+					libvmi.WithNetwork(v1.DefaultPodNetwork()),
+					libvmi.WithNetwork(libvmi.MultusNetwork(network1Name, networkAttachmentDefinitionName1)),
+					libvmistatus.WithStatus(libvmistatus.New(
+						libvmistatus.WithInterfaceStatus(v1.VirtualMachineInstanceNetworkInterface{Name: "default"}),
+						libvmistatus.WithInterfaceStatus(v1.VirtualMachineInstanceNetworkInterface{
+							Name:           network1Name,
+							InfoSource:     vmispec.InfoSourceMultusStatus,
+							HotUnplugPhase: hotUnplugPhase,
+						}),
+					)),
+				)
+
+				podAnnotations := map[string]string{
+					networkv1.NetworkAttachmentAnnot: multusNetworksAnnotation,
+					networkv1.NetworkStatusAnnot:     multusNetworkStatusWithPrimaryAndSecondaryNets,
+				}
+
+				generator := annotations.NewGenerator(clusterConfig)
+				annotations := generator.GenerateFromActivePod(vmi, newStubVirtLauncherPod(vmi, podAnnotations))
+
+				if expectAnnotationUpdate {
+					Expect(annotations).To(HaveKeyWithValue(networkv1.NetworkAttachmentAnnot, ""))
+				} else {
+					Expect(annotations).ToNot(HaveKey(networkv1.NetworkAttachmentAnnot))
+				}
+			},
+			Entry("when the hot-unplug is requested", v1.InterfaceHotUnplugRequested, false),
+			Entry("when the iface is detached in the guest", v1.InterfaceHotUnplugDetachedInGuest, true),
+		)
+
 		It("Should remove the Multus network attachment annotation when the last secondary interface is hot unplugged", func() {
 			vmi := libvmi.New(
 				libvmi.WithNamespace(testNamespace),
@@ -57,6 +57,8 @@ type NetConf struct {
 	configStateMutex *sync.RWMutex
 
 	clusterConfigurer clusterConfigurer
+
+	routerAdvertisers *routerAdvertisers
 }
 
 type nsFactory func(int) NSExecutor
@@ -79,6 +81,7 @@ func NewNetConfWithCustomFactoryAndConfigState(nsFactory nsFactory, cacheCreator
 		cacheCreator:      cacheCreator,
 		nsFactory:         nsFactory,
 		clusterConfigurer: clusterConfigurer,
+		routerAdvertisers: newRouterAdvertisers(cacheCreator),
 	}
 }
 
@@ -127,9 +130,17 @@ func (c *NetConf) Setup(vmi *v1.VirtualMachineInstance, networks []v1.Network, l
 	if err := netpod.Setup(); err != nil {
 		return fmt.Errorf("setup failed, err: %w", err)
 	}
+
+	c.EnsureRouterAdvertisers(vmi, launcherPid)
 	return nil
 }
 
+// EnsureRouterAdvertisers (re)starts the router advertisers of a running VMI.
+// They live in virt-handler only, so they have to be restored after virt-handler restarts.
+func (c *NetConf) EnsureRouterAdvertisers(vmi *v1.VirtualMachineInstance, launcherPid int) {
+	c.routerAdvertisers.ensure(vmi, launcherPid, c.nsFactory(launcherPid))
+}
+
 func upgradeConfigStateCache(stateCache *ConfigStateCache, networks []v1.Network, cacheCreator cacheCreator, vmiUID string) (*ConfigStateCache, error) {
 	for networkName, podIfaceName := range namescheme.CreateOrdinalNetworkNameScheme(networks) {
 		exists, err := stateCache.Exists(podIfaceName)
@@ -156,6 +167,8 @@ func upgradeConfigStateCache(stateCache *ConfigStateCache, networks []v1.Network
 }
 
 func (c *NetConf) Teardown(vmi *v1.VirtualMachineInstance) error {
+	c.routerAdvertisers.stop(vmi)
+
 	c.configStateMutex.Lock()
 	delete(c.state, string(vmi.UID))
 	c.configStateMutex.Unlock()
@@ -38,20 +38,25 @@ import (
 func (n NetPod) storeBridgeBindingDHCPInterfaceData(currentStatus *nmstate.Status, podIfaceStatus nmstate.Interface, vmiSpecIface v1.Interface, podIfaceName string) error {
 	var dhcpConfig cache.DHCPConfig
 	dhcpConfig.IPAMDisabled = true
-	if ipAddress := firstIPGlobalUnicast(podIfaceStatus.IPv4); ipAddress != nil {
-		dhcpConfig.IPAMDisabled = false
 
-		addr, iperr := vishnetlink.ParseAddr(fmt.Sprintf("%s/%d", ipAddress.IP, ipAddress.PrefixLen))
-		if iperr != nil {
-			return iperr
-		}
-		dhcpConfig.IP = *addr
+	ipv4Address := firstIPGlobalUnicast(podIfaceStatus.IPv4)
+	ipv6Address := firstIPGlobalUnicast(podIfaceStatus.IPv6)
+	if ipv4Address != nil || ipv6Address != nil {
+		dhcpConfig.IPAMDisabled = false
 
 		mac, err := resolveMacAddress(podIfaceStatus.MacAddress, vmiSpecIface.MacAddress)
 		if err != nil {
 			return err
 		}
 		dhcpConfig.MAC = mac
+	}
+
+	if ipv4Address != nil {
+		addr, iperr := vishnetlink.ParseAddr(fmt.Sprintf("%s/%d", ipv4Address.IP, ipv4Address.PrefixLen))
+		if iperr != nil {
+			return iperr
+		}
+		dhcpConfig.IP = *addr
 
 		linkRoutes, err := filterIPv4RoutesByInterface(currentStatus, podIfaceName)
 		if err != nil {
@@ -59,12 +64,12 @@ func (n NetPod) storeBridgeBindingDHCPInterfaceData(currentStatus *nmstate.Statu
 		}
 		dhcpConfig.Gateway = net.ParseIP(linkRoutes[0].NextHopAddress)
 
-		otherRoutes, err := filterRoutesByNonLocalDestination(linkRoutes, addr)
+		otherRoutes, err := filterRoutesByNonLocalDestination(linkRoutes, addr, vishnetlink.FAMILY_V4)
 		if err != nil {
 			return err
 		}
 
-		dhcpRoutes, err := translateNmstateToNetlinkRoutes(otherRoutes)
+		dhcpRoutes, err := translateNmstateToNetlinkRoutes(otherRoutes, vishnetlink.FAMILY_V4)
 		if err != nil {
 			return err
 		}
@@ -73,6 +78,12 @@ func (n NetPod) storeBridgeBindingDHCPInterfaceData(currentStatus *nmstate.Statu
 		}
 	}
 
+	if ipv6Address != nil {
+		if err := setIPv6DHCPConfig(&dhcpConfig, currentStatus, ipv6Address, podIfaceName); err != nil {
+			return err
+		}
+	}
+
 	log.Log.V(4).Infof("The generated dhcpConfig: %s\nRoutes: %+v", dhcpConfig.String(), dhcpConfig.Routes)
 	if err := cache.WriteDHCPInterfaceCache(n.cacheCreator, strconv.Itoa(n.podPID), podIfaceName, &dhcpConfig); err != nil {
 		return fmt.Errorf("failed to save DHCP configuration: %v", err)
@@ -97,10 +108,48 @@ func (n NetPod) storeBridgeDomainInterfaceData(podIfaceStatus nmstate.Interface,
 	return nil
 }
 
-func translateNmstateToNetlinkRoutes(otherRoutes []nmstate.Route) ([]vishnetlink.Route, error) {
+// setIPv6DHCPConfig sets the IPv6 address, gateway and routes of the pod interface on the DHCP configuration.
+// Unlike IPv4, a missing IPv6 gateway is not treated as an error, as it is common for secondary
+// networks to have no IPv6 router.
+func setIPv6DHCPConfig(dhcpConfig *cache.DHCPConfig, currentStatus *nmstate.Status, ipAddress *nmstate.IPAddress, podIfaceName string) error {
+	addr, err := vishnetlink.ParseAddr(fmt.Sprintf("%s/%d", ipAddress.IP, ipAddress.PrefixLen))
+	if err != nil {
+		return err
+	}
+	dhcpConfig.IPv6 = *addr
+
+	linkRoutes, err := filterIPv6RoutesByInterface(currentStatus, podIfaceName)
+	if err != nil {
+		return err
+	}
+
+	defaultDestination := nmstate.DefaultDestinationRoute(vishnetlink.FAMILY_V6).String()
+	for _, route := range linkRoutes {
+		if route.Destination == defaultDestination && route.NextHopAddress != "" {
+			dhcpConfig.IPv6Gateway = net.ParseIP(route.NextHopAddress)
+			break
+		}
+	}
+
+	otherRoutes, err := filterRoutesByNonLocalDestination(linkRoutes, addr, vishnetlink.FAMILY_V6)
+	if err != nil {
+		return err
+	}
+
+	dhcpRoutes, err := translateNmstateToNetlinkRoutes(otherRoutes, vishnetlink.FAMILY_V6)
+	if err != nil {
+		return err
+	}
+	if len(dhcpRoutes) > 0 {
+		dhcpConfig.IPv6Routes = &dhcpRoutes
+	}
+	return nil
+}
+
+func translateNmstateToNetlinkRoutes(otherRoutes []nmstate.Route, family int) ([]vishnetlink.Route, error) {
 	var dhcpRoutes []vishnetlink.Route
 	for _, nmstateRoute := range otherRoutes {
-		isDefaultRoute := nmstateRoute.Destination == nmstate.DefaultDestinationRoute(vishnetlink.FAMILY_V4).String()
+		isDefaultRoute := nmstateRoute.Destination == nmstate.DefaultDestinationRoute(family).String()
 		var dstAddr *net.IPNet
 		if !isDefaultRoute {
 			_, ipNet, perr := net.ParseCIDR(nmstateRoute.Destination)
@@ -120,7 +169,7 @@ func translateNmstateToNetlinkRoutes(otherRoutes []nmstate.Route) ([]vishnetlink
 
 // filterRoutesByNonLocalDestination filters out local routes (the destination is of the local link).
 // Default routes should not be filter out.
-func filterRoutesByNonLocalDestination(linkRoutes []nmstate.Route, addr *vishnetlink.Addr) ([]nmstate.Route, error) {
+func filterRoutesByNonLocalDestination(linkRoutes []nmstate.Route, addr *vishnetlink.Addr, family int) ([]nmstate.Route, error) {
 	_, localNetwork, err := net.ParseCIDR(addr.String())
 	if err != nil {
 		return nil, err
@@ -134,7 +183,7 @@ func filterRoutesByNonLocalDestination(linkRoutes []nmstate.Route, addr *vishnet
 		if perr != nil {
 			return nil, perr
 		}
-		isDefaultRoute := route.Destination == nmstate.DefaultDestinationRoute(vishnetlink.FAMILY_V4).String()
+		isDefaultRoute := route.Destination == nmstate.DefaultDestinationRoute(family).String()
 		localDestination := !isDefaultRoute && dstIPNet.String() == localNetworkStr
 		if !localDestination {
 			otherRoutes = append(otherRoutes, route)
@@ -163,6 +212,25 @@ func filterIPv4RoutesByInterface(currentStatus *nmstate.Status, podIfaceName str
 	return linkRoutes, nil
 }
 
+// filterIPv6RoutesByInterface returns the IPv6 routes of the given interface, excluding the link-local
+// and multicast ones which are set by the kernel.
+func filterIPv6RoutesByInterface(currentStatus *nmstate.Status, podIfaceName string) ([]nmstate.Route, error) {
+	var linkRoutes []nmstate.Route
+	for _, route := range currentStatus.Routes.Running {
+		ip, _, err := net.ParseCIDR(route.Destination)
+		if err != nil {
+			return nil, err
+		}
+		if !isIPv6Family(ip) || ip.IsLinkLocalUnicast() || ip.IsMulticast() {
+			continue
+		}
+		if route.NextHopInterface == podIfaceName {
+			linkRoutes = append(linkRoutes, route)
+		}
+	}
+	return linkRoutes, nil
+}
+
 func resolveMacAddress(macAddressFromCurrent string, macAddressFromVMISpec string) (net.HardwareAddr, error) {
 	macAddress := macAddressFromCurrent
 	if macAddressFromVMISpec != "" {
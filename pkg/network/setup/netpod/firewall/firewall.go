@@ -0,0 +1,230 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package firewall
+
+import (
+	"fmt"
+	"net"
+	"strconv"
+	"strings"
+
+	v1 "kubevirt.io/api/core/v1"
+
+	"kubevirt.io/kubevirt/pkg/network/driver/nft"
+)
+
+type nftable interface {
+	AddTable(family nft.IPFamily, name string) error
+	AddChain(family nft.IPFamily, table, name string, chainspec ...string) error
+	FlushChain(family nft.IPFamily, table, name string) error
+	AddRule(family nft.IPFamily, table, chain string, rulespec ...string) error
+}
+
+type Firewall struct {
+	nftable nftable
+}
+
+const (
+	firewallTable = "kubevirt_firewall"
+
+	forwardChainPrefix = "forward-"
+	ingressChainPrefix = "ingress-"
+	egressChainPrefix  = "egress-"
+)
+
+// bridgeControlTraffic is the traffic the guest needs to configure its addresses, which
+// passes through the bridge of the bridge binding
+var bridgeControlTraffic = [][]string{
+	{"ether", "type", "arp", "accept"},
+	{"icmpv6", "type", "{ nd-router-solicit, nd-router-advert, nd-neighbor-solicit, nd-neighbor-advert }", "accept"},
+	{"udp", "dport", "{ 67, 68, 546, 547 }", "accept"},
+}
+
+type option func(*Firewall)
+
+func New(opts ...option) Firewall {
+	f := Firewall{nftable: nft.NFTBin{}}
+	for _, opt := range opts {
+		opt(&f)
+	}
+	return f
+}
+
+func WithNftableAdapter(h nftable) option {
+	return func(f *Firewall) {
+		f.nftable = h
+	}
+}
+
+// Setup enforces the firewall of the vmi interface on the pod device the guest traffic passes through:
+// the tap device for the bridge binding and the bridge for the masquerade binding, where the traffic is routed.
+// The chains of the device are rebuilt on every call. It returns the rules enforcing the firewall.
+func (f Firewall) Setup(vmiIface v1.Interface, deviceName string) ([]string, error) {
+	if vmiIface.Firewall == nil {
+		return nil, nil
+	}
+	family := nft.Bridge
+	if vmiIface.Masquerade != nil {
+		family = nft.Inet
+	}
+
+	forwardChain := forwardChainPrefix + deviceName
+	ingressChain := ingressChainPrefix + deviceName
+	egressChain := egressChainPrefix + deviceName
+
+	if err := f.nftable.AddTable(family, firewallTable); err != nil {
+		return nil, err
+	}
+	if err := f.nftable.AddChain(family, firewallTable, forwardChain, "{ type filter hook forward priority 0; }"); err != nil {
+		return nil, err
+	}
+	for _, chain := range []string{ingressChain, egressChain} {
+		if err := f.nftable.AddChain(family, firewallTable, chain); err != nil {
+			return nil, err
+		}
+	}
+	for _, chain := range []string{forwardChain, ingressChain, egressChain} {
+		if err := f.nftable.FlushChain(family, firewallTable, chain); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := f.nftable.AddRule(family, firewallTable, forwardChain, "oifname", deviceName, "jump", ingressChain); err != nil {
+		return nil, err
+	}
+	if err := f.nftable.AddRule(family, firewallTable, forwardChain, "iifname", deviceName, "jump", egressChain); err != nil {
+		return nil, err
+	}
+
+	var appliedRules []string
+	directions := []struct {
+		chain         string
+		address       string
+		rules         []v1.FirewallRule
+		defaultAction v1.FirewallAction
+	}{
+		{ingressChain, "saddr", vmiIface.Firewall.Ingress, vmiIface.Firewall.IngressDefaultAction},
+		{egressChain, "daddr", vmiIface.Firewall.Egress, vmiIface.Firewall.EgressDefaultAction},
+	}
+	for _, direction := range directions {
+		rulespecs := [][]string{{"ct", "state", "established,related", "accept"}}
+		if family == nft.Bridge {
+			rulespecs = append(rulespecs, bridgeControlTraffic...)
+		}
+		for _, rule := range direction.rules {
+			specs, err := ruleSpecs(rule, direction.address)
+			if err != nil {
+				return nil, err
+			}
+			rulespecs = append(rulespecs, specs...)
+		}
+		if defaultAction(direction.rules, direction.defaultAction) == v1.FirewallActionDrop {
+			rulespecs = append(rulespecs, []string{"counter", "drop"})
+		}
+
+		for _, rulespec := range rulespecs {
+			if err := f.nftable.AddRule(family, firewallTable, direction.chain, rulespec...); err != nil {
+				return nil, fmt.Errorf("failed to add firewall rule %q to %s: %v", strings.Join(rulespec, " "), direction.chain, err)
+			}
+			appliedRules = append(appliedRules, fmt.Sprintf("%s %s", direction.chain, strings.Join(rulespec, " ")))
+		}
+	}
+	return appliedRules, nil
+}
+
+func defaultAction(rules []v1.FirewallRule, action v1.FirewallAction) v1.FirewallAction {
+	if action != "" {
+		return action
+	}
+	if len(rules) > 0 {
+		return v1.FirewallActionDrop
+	}
+	return v1.FirewallActionAccept
+}
+
+// ruleSpecs renders a firewall rule, as one nft rule per IP family of its CIDRs
+func ruleSpecs(rule v1.FirewallRule, address string) ([][]string, error) {
+	var matches []string
+	switch protocol := strings.ToLower(rule.Protocol); {
+	case protocol == "":
+	case protocol == "icmp":
+		matches = append(matches, "meta", "l4proto", "{ icmp, ipv6-icmp }")
+	case len(rule.Ports) > 0:
+		matches = append(matches, protocol, "dport", portsSpec(rule.Ports))
+	default:
+		matches = append(matches, "meta", "l4proto", protocol)
+	}
+
+	if rule.RateLimit != nil {
+		matches = append(matches, "limit", "rate", fmt.Sprintf("%d/second", rule.RateLimit.PacketsPerSecond))
+		if rule.RateLimit.Burst != nil {
+			matches = append(matches, "burst", strconv.Itoa(int(*rule.RateLimit.Burst)), "packets")
+		}
+	}
+
+	matches = append(matches, "counter")
+	if rule.Action == v1.FirewallActionDrop {
+		matches = append(matches, "drop")
+	} else {
+		matches = append(matches, "accept")
+	}
+
+	if len(rule.CIDRs) == 0 {
+		return [][]string{matches}, nil
+	}
+
+	var ipv4CIDRs, ipv6CIDRs []string
+	for _, cidr := range rule.CIDRs {
+		ip, _, err := net.ParseCIDR(cidr)
+		if err != nil {
+			return nil, fmt.Errorf("invalid firewall CIDR %s: %v", cidr, err)
+		}
+		if ip.To4() != nil {
+			ipv4CIDRs = append(ipv4CIDRs, cidr)
+		} else {
+			ipv6CIDRs = append(ipv6CIDRs, cidr)
+		}
+	}
+
+	var specs [][]string
+	for _, family := range []struct {
+		keyword string
+		cidrs   []string
+	}{{"ip", ipv4CIDRs}, {"ip6", ipv6CIDRs}} {
+		if len(family.cidrs) == 0 {
+			continue
+		}
+		spec := []string{family.keyword, address, fmt.Sprintf("{ %s }", strings.Join(family.cidrs, ", "))}
+		specs = append(specs, append(spec, matches...))
+	}
+	return specs, nil
+}
+
+func portsSpec(ports []v1.FirewallPort) string {
+	var formattedPorts []string
+	for _, port := range ports {
+		if port.EndPort != nil && *port.EndPort != port.Port {
+			formattedPorts = append(formattedPorts, fmt.Sprintf("%d-%d", port.Port, *port.EndPort))
+		} else {
+			formattedPorts = append(formattedPorts, strconv.Itoa(int(port.Port)))
+		}
+	}
+	return fmt.Sprintf("{ %s }", strings.Join(formattedPorts, ", "))
+}
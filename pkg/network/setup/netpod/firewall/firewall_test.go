@@ -0,0 +1,225 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package firewall_test
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	v1 "kubevirt.io/api/core/v1"
+
+	"kubevirt.io/kubevirt/pkg/network/driver/nft"
+	"kubevirt.io/kubevirt/pkg/network/setup/netpod/firewall"
+	"kubevirt.io/kubevirt/pkg/pointer"
+)
+
+var _ = Describe("interface firewall", func() {
+	It("does nothing without a firewall", func() {
+		nftStub := &nftableStub{}
+		fw := firewall.New(firewall.WithNftableAdapter(nftStub))
+
+		rules, err := fw.Setup(v1.Interface{
+			Name:                   "default",
+			InterfaceBindingMethod: v1.InterfaceBindingMethod{Masquerade: &v1.InterfaceMasquerade{}},
+		}, "k6t-eth0")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(rules).To(BeEmpty())
+		Expect(nftStub.String()).To(Equal(""))
+	})
+
+	It("setup fails", func() {
+		testErr := errors.New("test error")
+		fw := firewall.New(firewall.WithNftableAdapter(&nftableStub{addTableErr: testErr}))
+
+		_, err := fw.Setup(v1.Interface{
+			Name:                   "default",
+			InterfaceBindingMethod: v1.InterfaceBindingMethod{Masquerade: &v1.InterfaceMasquerade{}},
+			Firewall:               &v1.InterfaceFirewall{},
+		}, "k6t-eth0")
+		Expect(err).To(MatchError(testErr))
+	})
+
+	It("setup masquerade binding with ingress rules", func() {
+		nftStub := &nftableStub{}
+		fw := firewall.New(firewall.WithNftableAdapter(nftStub))
+
+		rules, err := fw.Setup(v1.Interface{
+			Name:                   "default",
+			InterfaceBindingMethod: v1.InterfaceBindingMethod{Masquerade: &v1.InterfaceMasquerade{}},
+			Firewall: &v1.InterfaceFirewall{
+				Ingress: []v1.FirewallRule{
+					{
+						CIDRs:    []string{"10.0.0.0/8", "fd00::/64", "192.168.0.0/16"},
+						Protocol: "TCP",
+						Ports:    []v1.FirewallPort{{Port: 22}, {Port: 8000, EndPort: pointer.P(int32(8080))}},
+					},
+					{
+						Protocol:  "ICMP",
+						RateLimit: &v1.FirewallRateLimit{PacketsPerSecond: 10, Burst: pointer.P(int32(20))},
+					},
+				},
+			},
+		}, "k6t-eth0")
+		Expect(err).NotTo(HaveOccurred())
+
+		expectedConfig := `tables:
+family inet name kubevirt_firewall
+chains:
+family inet table kubevirt_firewall name forward-k6t-eth0 chainspec [{ type filter hook forward priority 0; }]
+family inet table kubevirt_firewall name ingress-k6t-eth0 chainspec []
+family inet table kubevirt_firewall name egress-k6t-eth0 chainspec []
+flushed chains:
+family inet table kubevirt_firewall name forward-k6t-eth0
+family inet table kubevirt_firewall name ingress-k6t-eth0
+family inet table kubevirt_firewall name egress-k6t-eth0
+rules:
+family inet table kubevirt_firewall chain forward-k6t-eth0 rulespec [oifname k6t-eth0 jump ingress-k6t-eth0]
+family inet table kubevirt_firewall chain forward-k6t-eth0 rulespec [iifname k6t-eth0 jump egress-k6t-eth0]
+family inet table kubevirt_firewall chain ingress-k6t-eth0 rulespec [ct state established,related accept]
+family inet table kubevirt_firewall chain ingress-k6t-eth0 rulespec [ip saddr { 10.0.0.0/8, 192.168.0.0/16 } tcp dport { 22, 8000-8080 } counter accept]
+family inet table kubevirt_firewall chain ingress-k6t-eth0 rulespec [ip6 saddr { fd00::/64 } tcp dport { 22, 8000-8080 } counter accept]
+family inet table kubevirt_firewall chain ingress-k6t-eth0 rulespec [meta l4proto { icmp, ipv6-icmp } limit rate 10/second burst 20 packets counter accept]
+family inet table kubevirt_firewall chain ingress-k6t-eth0 rulespec [counter drop]
+family inet table kubevirt_firewall chain egress-k6t-eth0 rulespec [ct state established,related accept]
+`
+		Expect(nftStub.String()).To(Equal(expectedConfig), fmt.Sprintf("actual:\n%s\n\nexpected:\n%s", nftStub.String(), expectedConfig))
+		Expect(rules).To(Equal([]string{
+			"ingress-k6t-eth0 ct state established,related accept",
+			"ingress-k6t-eth0 ip saddr { 10.0.0.0/8, 192.168.0.0/16 } tcp dport { 22, 8000-8080 } counter accept",
+			"ingress-k6t-eth0 ip6 saddr { fd00::/64 } tcp dport { 22, 8000-8080 } counter accept",
+			"ingress-k6t-eth0 meta l4proto { icmp, ipv6-icmp } limit rate 10/second burst 20 packets counter accept",
+			"ingress-k6t-eth0 counter drop",
+			"egress-k6t-eth0 ct state established,related accept",
+		}))
+	})
+
+	It("setup bridge binding with egress rules and explicit default actions", func() {
+		nftStub := &nftableStub{}
+		fw := firewall.New(firewall.WithNftableAdapter(nftStub))
+
+		rules, err := fw.Setup(v1.Interface{
+			Name:                   "red",
+			InterfaceBindingMethod: v1.InterfaceBindingMethod{Bridge: &v1.InterfaceBridge{}},
+			Firewall: &v1.InterfaceFirewall{
+				Egress: []v1.FirewallRule{
+					{CIDRs: []string{"10.10.0.0/16"}, Action: v1.FirewallActionDrop},
+					{Protocol: "UDP"},
+				},
+				IngressDefaultAction: v1.FirewallActionDrop,
+				EgressDefaultAction:  v1.FirewallActionAccept,
+			},
+		}, "tap0")
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(nftStub.Tables).To(ConsistOf(tableData{Family: nft.Bridge, Name: "kubevirt_firewall"}))
+		Expect(rules).To(Equal([]string{
+			"ingress-tap0 ct state established,related accept",
+			"ingress-tap0 ether type arp accept",
+			"ingress-tap0 icmpv6 type { nd-router-solicit, nd-router-advert, nd-neighbor-solicit, nd-neighbor-advert } accept",
+			"ingress-tap0 udp dport { 67, 68, 546, 547 } accept",
+			"ingress-tap0 counter drop",
+			"egress-tap0 ct state established,related accept",
+			"egress-tap0 ether type arp accept",
+			"egress-tap0 icmpv6 type { nd-router-solicit, nd-router-advert, nd-neighbor-solicit, nd-neighbor-advert } accept",
+			"egress-tap0 udp dport { 67, 68, 546, 547 } accept",
+			"egress-tap0 ip daddr { 10.10.0.0/16 } counter drop",
+			"egress-tap0 meta l4proto udp counter accept",
+		}))
+	})
+})
+
+type nftableStub struct {
+	addTableErr   error
+	Tables        []tableData
+	Chains        []chainData
+	FlushedChains []chainData
+	Rules         []ruleData
+}
+
+type tableData struct {
+	Family nft.IPFamily
+	Name   string
+}
+
+type chainData struct {
+	Table     tableData
+	Name      string
+	Chainspec []string
+}
+
+type ruleData struct {
+	Chain    chainData
+	Rulespec []string
+}
+
+func (n *nftableStub) AddTable(family nft.IPFamily, name string) error {
+	if n.addTableErr != nil {
+		return n.addTableErr
+	}
+	n.Tables = append(n.Tables, tableData{family, name})
+	return nil
+}
+
+func (n *nftableStub) AddChain(family nft.IPFamily, table string, name string, chainspec ...string) error {
+	n.Chains = append(n.Chains, chainData{tableData{family, table}, name, chainspec})
+	return nil
+}
+
+func (n *nftableStub) FlushChain(family nft.IPFamily, table string, name string) error {
+	n.FlushedChains = append(n.FlushedChains, chainData{Table: tableData{family, table}, Name: name})
+	return nil
+}
+
+func (n *nftableStub) AddRule(family nft.IPFamily, table string, chain string, rulespec ...string) error {
+	n.Rules = append(n.Rules, ruleData{
+		Chain:    chainData{Table: tableData{Family: family, Name: table}, Name: chain},
+		Rulespec: rulespec,
+	})
+	return nil
+}
+
+func (n *nftableStub) String() string {
+	if len(n.Tables) == 0 {
+		return ""
+	}
+	var out strings.Builder
+
+	out.WriteString("tables:\n")
+	for _, t := range n.Tables {
+		fmt.Fprintf(&out, "family %s name %s\n", t.Family, t.Name)
+	}
+	out.WriteString("chains:\n")
+	for _, c := range n.Chains {
+		fmt.Fprintf(&out, "family %s table %s name %s chainspec %s\n", c.Table.Family, c.Table.Name, c.Name, c.Chainspec)
+	}
+	out.WriteString("flushed chains:\n")
+	for _, c := range n.FlushedChains {
+		fmt.Fprintf(&out, "family %s table %s name %s\n", c.Table.Family, c.Table.Name, c.Name)
+	}
+	out.WriteString("rules:\n")
+	for _, r := range n.Rules {
+		fmt.Fprintf(&out, "family %s table %s chain %s rulespec %s\n", r.Chain.Table.Family, r.Chain.Table.Name, r.Chain.Name, r.Rulespec)
+	}
+	return out.String()
+}
@@ -21,8 +21,10 @@ package netpod
 
 import (
 	"encoding/json"
+	"errors"
 	"fmt"
 	"net"
+	"os"
 	"strconv"
 
 	k8serrors "k8s.io/apimachinery/pkg/util/errors"
@@ -36,6 +38,7 @@ import (
 	"kubevirt.io/kubevirt/pkg/network/link"
 	"kubevirt.io/kubevirt/pkg/network/namescheme"
 	"kubevirt.io/kubevirt/pkg/network/netmachinery"
+	"kubevirt.io/kubevirt/pkg/network/setup/netpod/firewall"
 	"kubevirt.io/kubevirt/pkg/network/setup/netpod/masquerade"
 	"kubevirt.io/kubevirt/pkg/network/vmispec"
 
@@ -55,6 +58,10 @@ type masqueradeAdapter interface {
 	Setup(bridgeIfaceSpec, podIfaceSpec *nmstate.Interface, vmiIface v1.Interface) error
 }
 
+type firewallAdapter interface {
+	Setup(vmiIface v1.Interface, deviceName string) ([]string, error)
+}
+
 type cacheCreator interface {
 	New(filePath string) *cache.Cache
 }
@@ -74,6 +81,7 @@ type NetPod struct {
 
 	nmstateAdapter    nmstateAdapter
 	masqueradeAdapter masqueradeAdapter
+	firewallAdapter   firewallAdapter
 
 	cacheCreator cacheCreator
 	state        *State
@@ -97,6 +105,7 @@ func NewNetPod(vmiNetworks []v1.Network, vmiIfaces []v1.Interface, vmiUID string
 
 		nmstateAdapter:    nmstate.New(),
 		masqueradeAdapter: masquerade.New(),
+		firewallAdapter:   firewall.New(),
 
 		cacheCreator:         cache.CacheCreator{},
 		bindingPluginsByName: map[string]v1.InterfaceBindingPlugin{},
@@ -121,6 +130,12 @@ func WithMasqueradeAdapter(h masqueradeAdapter) option {
 	}
 }
 
+func WithFirewallAdapter(h firewallAdapter) option {
+	return func(n *NetPod) {
+		n.firewallAdapter = h
+	}
+}
+
 func WithCacheCreator(c cacheCreator) option {
 	return func(n *NetPod) {
 		n.cacheCreator = c
@@ -247,7 +262,11 @@ func (n NetPod) config(currentStatus *nmstate.Status) error {
 
 	// Configuring NAT (nftables) is temporary done outside nmstate.
 	// This should be eventually embedded into the nmstate desired state and applied by it.
-	return n.setupNAT(desiredSpec, currentStatus)
+	if err = n.setupNAT(desiredSpec, currentStatus); err != nil {
+		return err
+	}
+
+	return n.setupFirewalls(desiredSpec)
 }
 
 func (n NetPod) composeDesiredSpec(currentStatus *nmstate.Status) (*nmstate.Spec, error) {
@@ -549,6 +568,50 @@ func (n NetPod) setupNAT(desiredSpec *nmstate.Spec, currentStatus *nmstate.Statu
 	return n.masqueradeAdapter.Setup(bridgeIfaceSpec, podIfaceSpec, vmiIface[0])
 }
 
+func (n NetPod) setupFirewalls(desiredSpec *nmstate.Spec) error {
+	for _, vmiIface := range n.vmiSpecIfaces {
+		if vmiIface.Firewall == nil || vmiIface.State == v1.InterfaceStateAbsent {
+			continue
+		}
+		var deviceType string
+		switch {
+		case vmiIface.Masquerade != nil:
+			deviceType = nmstate.TypeBridge
+		case vmiIface.Bridge != nil:
+			deviceType = nmstate.TypeTap
+		default:
+			continue
+		}
+		deviceSpec := nmstate.LookupInterface(desiredSpec.Interfaces, func(i nmstate.Interface) bool {
+			return i.Metadata != nil && i.Metadata.NetworkName == vmiIface.Name && i.TypeName == deviceType
+		})
+		if deviceSpec == nil {
+			return fmt.Errorf("setup-firewall: %s link for interface %s is missing", deviceType, vmiIface.Name)
+		}
+
+		rules, err := n.firewallAdapter.Setup(vmiIface, deviceSpec.Name)
+		if err != nil {
+			return fmt.Errorf("setup-firewall: interface %s: %v", vmiIface.Name, err)
+		}
+		if err := n.storeFirewallRules(vmiIface.Name, rules); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (n NetPod) storeFirewallRules(ifaceName string, rules []string) error {
+	ifCache, err := cache.ReadPodInterfaceCache(n.cacheCreator, n.vmiUID, ifaceName)
+	if err != nil {
+		if !errors.Is(err, os.ErrNotExist) {
+			return fmt.Errorf("failed to read pod interface cache for %s: %v", ifaceName, err)
+		}
+		ifCache = &cache.PodIfaceCacheData{}
+	}
+	ifCache.FirewallRules = rules
+	return cache.WritePodInterfaceCache(n.cacheCreator, n.vmiUID, ifaceName, ifCache)
+}
+
 func (n NetPod) lookupMasquradeBridge(desiredIfacesSpec []nmstate.Interface) *nmstate.Interface {
 	masqueradeIfaces := vmispec.FilterInterfacesSpec(n.vmiSpecIfaces, func(i v1.Interface) bool {
 		return i.Masquerade != nil
@@ -420,6 +420,7 @@ var _ = Describe("netpod", func() {
 
 		expDHCPConfig, err := expectedDHCPConfig(
 			"10.222.222.1/30",
+			primaryIPv6Address+"/64",
 			podIfaceOrignalMAC,
 			defaultGatewayIP4Address,
 			"192.168.1.0/24",
@@ -577,6 +578,7 @@ var _ = Describe("netpod", func() {
 
 		expDHCPConfig, err := expectedDHCPConfig(
 			"10.222.222.1/30",
+			primaryIPv6Address+"/64",
 			podIfaceOrignalMAC,
 			defaultGatewayIP4Address,
 			"192.168.1.0/24",
@@ -682,6 +684,85 @@ var _ = Describe("netpod", func() {
 		}))
 	})
 
+	It("setup bridge binding with IPv6 only stores the IPv6 DHCP configuration", func() {
+		const (
+			podIfaceOrignalMAC       = "12:34:56:78:90:ab"
+			defaultGatewayIP6Address = "fe80::1"
+			staticRouteDestination   = "2001:db8::/64"
+		)
+		nmstatestub := nmstateStub{status: nmstate.Status{
+			Interfaces: []nmstate.Interface{{
+				Name:       "eth0",
+				Index:      0,
+				TypeName:   nmstate.TypeVETH,
+				State:      nmstate.IfaceStateUp,
+				MacAddress: podIfaceOrignalMAC,
+				MTU:        1500,
+				IPv4:       ipDisabled,
+				IPv6: nmstate.IP{
+					Enabled: pointer.P(true),
+					Address: []nmstate.IPAddress{{
+						IP:        primaryIPv6Address,
+						PrefixLen: 64,
+					}},
+				},
+			}},
+			Routes: nmstate.Routes{Running: []nmstate.Route{
+				// Default Route
+				{
+					Destination:      "::/0",
+					NextHopInterface: "eth0",
+					NextHopAddress:   defaultGatewayIP6Address,
+				},
+				// Local Route (should be ignored)
+				{
+					Destination:      "2001::/64",
+					NextHopInterface: "eth0",
+				},
+				// Link-local Route (should be ignored)
+				{
+					Destination:      "fe80::/64",
+					NextHopInterface: "eth0",
+				},
+				// Static Route
+				{
+					Destination:      staticRouteDestination,
+					NextHopInterface: "eth0",
+					NextHopAddress:   defaultGatewayIP6Address,
+				},
+			}},
+		}}
+
+		netPod := netpod.NewNetPod(
+			[]v1.Network{*v1.DefaultPodNetwork()},
+			[]v1.Interface{{
+				Name:                   defaultPodNetworkName,
+				InterfaceBindingMethod: v1.InterfaceBindingMethod{Bridge: &v1.InterfaceBridge{}},
+			}},
+			vmiUID, 0, 0, 0, state,
+			netpod.WithNMStateAdapter(&nmstatestub),
+			netpod.WithCacheCreator(&baseCacheCreator),
+		)
+		Expect(netPod.Setup()).To(Succeed())
+
+		ipv6, err := vishnetlink.ParseAddr(primaryIPv6Address + "/64")
+		Expect(err).NotTo(HaveOccurred())
+		mac, err := net.ParseMAC(podIfaceOrignalMAC)
+		Expect(err).NotTo(HaveOccurred())
+		_, staticRouteDst, err := net.ParseCIDR(staticRouteDestination)
+		Expect(err).NotTo(HaveOccurred())
+		expectedRoutes := []vishnetlink.Route{
+			{Gw: net.ParseIP(defaultGatewayIP6Address)},
+			{Dst: staticRouteDst, Gw: net.ParseIP(defaultGatewayIP6Address)},
+		}
+		Expect(cache.ReadDHCPInterfaceCache(&baseCacheCreator, "0", "eth0")).To(Equal(&cache.DHCPConfig{
+			IPv6:        *ipv6,
+			IPv6Gateway: net.ParseIP(defaultGatewayIP6Address),
+			IPv6Routes:  &expectedRoutes,
+			MAC:         mac,
+		}))
+	})
+
 	When("using secondary network", func() {
 
 		const (
@@ -893,6 +974,47 @@ var _ = Describe("netpod", func() {
 			Entry("with hotplug (second invoke adds a network)", hotplugEnabled),
 		)
 
+		It("setup firewalls on masquerade (primary) and bridge (secondary) binding", func() {
+			specInterfaces[0].Firewall = &v1.InterfaceFirewall{Ingress: []v1.FirewallRule{{Protocol: "TCP"}}}
+			specInterfaces[1].Firewall = &v1.InterfaceFirewall{Egress: []v1.FirewallRule{{Protocol: "UDP"}}}
+			fwstub := firewallStub{rules: []string{"ingress-dev counter drop"}}
+			netPod := netpod.NewNetPod(
+				specNetworks,
+				specInterfaces,
+				vmiUID, 0, 0, 0, state,
+				netpod.WithNMStateAdapter(&nmstatestub),
+				netpod.WithMasqueradeAdapter(&masqstub),
+				netpod.WithFirewallAdapter(&fwstub),
+				netpod.WithCacheCreator(&baseCacheCreator),
+			)
+			Expect(netPod.Setup()).To(Succeed())
+
+			Expect(fwstub.deviceByIface).To(Equal(map[string]string{
+				defaultPodNetworkName: "k6t-eth0",
+				secondaryNetworkName:  "tap914f438d88d",
+			}))
+			primaryCache, err := cache.ReadPodInterfaceCache(&baseCacheCreator, vmiUID, defaultPodNetworkName)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(primaryCache.FirewallRules).To(Equal(fwstub.rules))
+			secondaryCache, err := cache.ReadPodInterfaceCache(&baseCacheCreator, vmiUID, secondaryNetworkName)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(secondaryCache.FirewallRules).To(Equal(fwstub.rules))
+		})
+
+		It("setup fails when the firewall cannot be configured", func() {
+			specInterfaces[1].Firewall = &v1.InterfaceFirewall{}
+			netPod := netpod.NewNetPod(
+				specNetworks,
+				specInterfaces,
+				vmiUID, 0, 0, 0, state,
+				netpod.WithNMStateAdapter(&nmstatestub),
+				netpod.WithMasqueradeAdapter(&masqstub),
+				netpod.WithFirewallAdapter(&firewallStub{setupErr: errFirewallSetup}),
+				netpod.WithCacheCreator(&baseCacheCreator),
+			)
+			Expect(netPod.Setup()).To(MatchError(ContainSubstring(errFirewallSetup.Error())))
+		})
+
 		It("setup secondary bridge binding with hashed pod interfaces and absent set", func() {
 			specInterfaces[1].State = v1.InterfaceStateAbsent
 			netPod := netpod.NewNetPod(
@@ -1941,6 +2063,25 @@ func (m *masqueradeStub) Setup(bridgeIfaceSpec, podIfaceSpec *nmstate.Interface,
 	return nil
 }
 
+type firewallStub struct {
+	setupErr      error
+	rules         []string
+	deviceByIface map[string]string
+}
+
+var errFirewallSetup = errors.New("firewall Setup Test Error")
+
+func (f *firewallStub) Setup(vmiIface v1.Interface, deviceName string) ([]string, error) {
+	if f.setupErr != nil {
+		return nil, f.setupErr
+	}
+	if f.deviceByIface == nil {
+		f.deviceByIface = map[string]string{}
+	}
+	f.deviceByIface[vmiIface.Name] = deviceName
+	return f.rules, nil
+}
+
 type tempCacheCreator struct {
 	once   sync.Once
 	tmpDir string
@@ -1957,11 +2098,15 @@ func (c *tempCacheCreator) New(filePath string) *cache.Cache {
 	return cache.NewCustomCache(filePath, kfs.NewWithRootPath(c.tmpDir))
 }
 
-func expectedDHCPConfig(podIfaceCIDR, podIfaceMAC, defaultGW, staticRouteDst, staticRouteToWiderSubnet string) (*cache.DHCPConfig, error) {
+func expectedDHCPConfig(podIfaceCIDR, podIfaceIPv6CIDR, podIfaceMAC, defaultGW, staticRouteDst, staticRouteToWiderSubnet string) (*cache.DHCPConfig, error) {
 	ipv4, err := vishnetlink.ParseAddr(podIfaceCIDR)
 	if err != nil {
 		return nil, err
 	}
+	ipv6, err := vishnetlink.ParseAddr(podIfaceIPv6CIDR)
+	if err != nil {
+		return nil, err
+	}
 	mac, err := net.ParseMAC(podIfaceMAC)
 	if err != nil {
 		return nil, err
@@ -1983,6 +2128,7 @@ func expectedDHCPConfig(podIfaceCIDR, podIfaceMAC, defaultGW, staticRouteDst, st
 	}
 	return &cache.DHCPConfig{
 		IP:           *ipv4,
+		IPv6:         *ipv6,
 		MAC:          mac,
 		Routes:       &routes,
 		IPAMDisabled: false,
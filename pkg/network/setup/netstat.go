@@ -121,6 +121,7 @@ func (c *NetStat) UpdateStatus(vmi *v1.VirtualMachineInstance, domain *api.Domai
 	interfacesStatus = ifacesStatusFromMultus(interfacesStatus, multusStatusNetworksByName, vmiInterfacesSpecByName)
 
 	interfacesStatus = restorePodIfaceNames(interfacesStatus, vmi.Status.Interfaces)
+	interfacesStatus = updateHotUnplugPhases(interfacesStatus, vmi.Status.Interfaces, vmiInterfacesSpecByName)
 	vmi.Status.Interfaces = interfacesStatus
 
 	c.removeAbsentIfacesFromVolatileCache(vmi)
@@ -167,6 +168,30 @@ func restorePodIfaceNames(
 	return interfacesStatus
 }
 
+// updateHotUnplugPhases restores the hot-unplug phase of absent interfaces based on the last report,
+// and marks the ones no longer seen by the domain as detached from the guest.
+func updateHotUnplugPhases(
+	interfacesStatus []v1.VirtualMachineInstanceNetworkInterface,
+	prevIfaceStatuses []v1.VirtualMachineInstanceNetworkInterface,
+	vmiIfacesSpecByName map[string]v1.Interface,
+) []v1.VirtualMachineInstanceNetworkInterface {
+	prevIfaceStatusesByName := netvmispec.IndexInterfaceStatusByName(prevIfaceStatuses, nil)
+
+	for i, ifaceStatus := range interfacesStatus {
+		if vmiIfacesSpecByName[ifaceStatus.Name].State != v1.InterfaceStateAbsent {
+			continue
+		}
+		phase := prevIfaceStatusesByName[ifaceStatus.Name].HotUnplugPhase
+		isDetachedFromDomain := !netvmispec.ContainsInfoSource(ifaceStatus.InfoSource, netvmispec.InfoSourceDomain)
+		if isDetachedFromDomain && (phase == "" || phase == v1.InterfaceHotUnplugRequested) {
+			phase = v1.InterfaceHotUnplugDetachedInGuest
+		}
+		interfacesStatus[i].HotUnplugPhase = phase
+	}
+
+	return interfacesStatus
+}
+
 func movePrimaryIfaceStatusToFront(
 	interfacesStatus []v1.VirtualMachineInstanceNetworkInterface,
 	primaryNetworkName string,
@@ -206,7 +231,7 @@ func ifacesStatusFromMultus(
 	return interfacesStatus
 }
 
-// updateIfacesStatusFromPodCache updates the provided interfaces statuses with data (IP/s) from the pod-cache.
+// updateIfacesStatusFromPodCache updates the provided interfaces statuses with data (IP/s, firewall rules) from the pod-cache.
 func (c *NetStat) updateIfacesStatusFromPodCache(ifacesStatus []v1.VirtualMachineInstanceNetworkInterface, ifacesSpec []v1.Interface, vmi *v1.VirtualMachineInstance) ([]v1.VirtualMachineInstanceNetworkInterface, error) {
 	for _, iface := range ifacesSpec {
 		ifaceStatus := netvmispec.LookupInterfaceStatusByName(ifacesStatus, iface.Name)
@@ -221,6 +246,7 @@ func (c *NetStat) updateIfacesStatusFromPodCache(ifacesStatus []v1.VirtualMachin
 
 		ifaceStatus.IP = podIface.PodIP
 		ifaceStatus.IPs = podIface.PodIPs
+		ifaceStatus.FirewallRules = podIface.FirewallRules
 	}
 	return ifacesStatus, nil
 }
@@ -349,6 +349,31 @@ var _ = Describe("netstat", func() {
 			Expect(setup.NetStat.PodInterfaceVolatileDataIsCached(setup.Vmi, primaryNetworkName)).To(BeTrue())
 		})
 
+		It("run status and expect the applied firewall rules to be reported based on pod data", func() {
+			const primaryMAC = "1C:CE:C0:01:BE:E7"
+			firewallRules := []string{
+				"ingress-k6t-eth0 ct state established,related accept",
+				"ingress-k6t-eth0 counter drop",
+			}
+
+			Expect(
+				setup.addNetworkInterface(
+					newVMISpecIfaceWithMasqueradeBinding(primaryNetworkName),
+					newVMISpecPodNetwork(primaryNetworkName),
+					newDomainSpecIface(primaryNetworkName, primaryMAC),
+					primaryPodIPv4,
+				),
+			).To(Succeed())
+			podCacheData := makePodCacheInterface(primaryNetworkName, primaryPodIPv4)
+			podCacheData.FirewallRules = firewallRules
+			setup.NetStat.CachePodInterfaceVolatileData(setup.Vmi, primaryNetworkName, podCacheData)
+
+			Expect(setup.NetStat.UpdateStatus(setup.Vmi, setup.Domain)).To(Succeed())
+
+			Expect(setup.Vmi.Status.Interfaces).To(HaveLen(1))
+			Expect(setup.Vmi.Status.Interfaces[0].FirewallRules).To(Equal(firewallRules))
+		})
+
 		It("should update existing interface status with MAC from the domain", func() {
 			const (
 				origMAC      = "C0:01:BE:E7:15:G0:0D"
@@ -925,6 +950,35 @@ var _ = Describe("netstat", func() {
 		}), "primary and secondary ifaces should exist in status, where secondary iface have multus-status only")
 	})
 
+	DescribeTable("run status and expect the hot-unplug phase of an absent iface to be reported", func(attachedToDomain bool, prevPhase, expectedPhase v1.InterfaceHotUnplugPhase) {
+		const (
+			networkName = "secondary"
+			mac         = "1C:CE:C0:01:BE:E7"
+		)
+		absentIface := newVMISpecIfaceWithBridgeBinding(networkName)
+		absentIface.State = v1.InterfaceStateAbsent
+		setup.Vmi.Spec.Domain.Devices.Interfaces = append(setup.Vmi.Spec.Domain.Devices.Interfaces, absentIface)
+		setup.Vmi.Spec.Networks = append(setup.Vmi.Spec.Networks, newVMISpecMultusNetwork(networkName))
+		if attachedToDomain {
+			setup.Domain.Spec.Devices.Interfaces = append(setup.Domain.Spec.Devices.Interfaces, newDomainSpecIface(networkName, mac))
+		}
+		setup.Vmi.Status.Interfaces = []v1.VirtualMachineInstanceNetworkInterface{
+			{Name: networkName, InfoSource: netvmispec.InfoSourceMultusStatus, HotUnplugPhase: prevPhase},
+		}
+
+		Expect(setup.NetStat.UpdateStatus(setup.Vmi, setup.Domain)).To(Succeed())
+
+		Expect(setup.Vmi.Status.Interfaces).To(HaveLen(1))
+		Expect(setup.Vmi.Status.Interfaces[0].HotUnplugPhase).To(Equal(expectedPhase))
+	},
+		Entry("requested while the iface is attached to the domain",
+			true, v1.InterfaceHotUnplugRequested, v1.InterfaceHotUnplugRequested),
+		Entry("detached in guest once the iface is removed from the domain",
+			false, v1.InterfaceHotUnplugRequested, v1.InterfaceHotUnplugDetachedInGuest),
+		Entry("pod network removal is kept",
+			false, v1.InterfaceHotUnplugPodNetworkRemoved, v1.InterfaceHotUnplugPodNetworkRemoved),
+	)
+
 	It("run status and expect iface that doesn't exist in VMI spec to NOT be reported", func() {
 		const (
 			primaryNetworkName = "primary"
@@ -0,0 +1,201 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package network
+
+import (
+	"errors"
+	"io"
+	"io/fs"
+	"net"
+	"strconv"
+	"sync"
+
+	v1 "kubevirt.io/api/core/v1"
+	"kubevirt.io/client-go/log"
+
+	"kubevirt.io/kubevirt/pkg/network/cache"
+	"kubevirt.io/kubevirt/pkg/network/link"
+	"kubevirt.io/kubevirt/pkg/network/namescheme"
+	"kubevirt.io/kubevirt/pkg/network/ndp"
+	"kubevirt.io/kubevirt/pkg/network/vmispec"
+)
+
+type routerAdvertiserStarter func(nsExec NSExecutor, ifaceName string, config ndp.Config) (io.Closer, error)
+
+// routerAdvertisers tracks the router advertisers serving the bridge binding interfaces of each VMI.
+// Router advertisements require a raw socket, therefore they are served by virt-handler from within
+// the pod network namespace, while DHCPv6 is served by virt-launcher.
+type routerAdvertisers struct {
+	mutex       sync.Mutex
+	byNetwork   map[string]map[string]io.Closer
+	start       routerAdvertiserStarter
+	cacheReader func(pid, ifaceName string) (*cache.DHCPConfig, error)
+}
+
+func newRouterAdvertisers(cacheCreator cacheCreator) *routerAdvertisers {
+	return &routerAdvertisers{
+		byNetwork: map[string]map[string]io.Closer{},
+		start:     startRouterAdvertiser,
+		cacheReader: func(pid, ifaceName string) (*cache.DHCPConfig, error) {
+			return cache.ReadDHCPInterfaceCache(cacheCreator, pid, ifaceName)
+		},
+	}
+}
+
+// ensure starts a router advertiser for each bridge binding interface of the VMI with an IPv6 address,
+// and stops the ones of interfaces which are no longer in use.
+// Failures are not fatal to the network setup and are retried on the next call.
+func (r *routerAdvertisers) ensure(vmi *v1.VirtualMachineInstance, launcherPid int, nsExec NSExecutor) {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	vmiUID := string(vmi.UID)
+	advertisers := r.byNetwork[vmiUID]
+	if advertisers == nil {
+		advertisers = map[string]io.Closer{}
+	}
+
+	bridgeNetworks := map[string]v1.Network{}
+	for _, network := range vmi.Spec.Networks {
+		iface := vmispec.LookupInterfaceByName(vmi.Spec.Domain.Devices.Interfaces, network.Name)
+		if iface != nil && iface.Bridge != nil && iface.State != v1.InterfaceStateAbsent {
+			bridgeNetworks[network.Name] = network
+		}
+	}
+
+	for networkName, advertiser := range advertisers {
+		if _, exists := bridgeNetworks[networkName]; !exists {
+			closeRouterAdvertiser(vmi, networkName, advertiser)
+			delete(advertisers, networkName)
+		}
+	}
+
+	for networkName, network := range bridgeNetworks {
+		if _, exists := advertisers[networkName]; exists {
+			continue
+		}
+
+		podIfaceName, dhcpConfig, err := r.readDHCPConfig(vmi, network, launcherPid)
+		if err != nil {
+			log.Log.Object(vmi).Reason(err).Errorf("failed to read the DHCP configuration of network %s", networkName)
+			continue
+		}
+		if dhcpConfig == nil || dhcpConfig.IPAMDisabled || dhcpConfig.IPv6.IPNet == nil {
+			continue
+		}
+
+		advertiser, err := r.start(nsExec, link.GenerateBridgeName(podIfaceName), routerAdvertiserConfig(dhcpConfig))
+		if err != nil {
+			log.Log.Object(vmi).Reason(err).Errorf("failed to start the router advertiser of network %s", networkName)
+			continue
+		}
+		log.Log.Object(vmi).Infof("started the router advertiser of network %s", networkName)
+		advertisers[networkName] = advertiser
+	}
+
+	if len(advertisers) > 0 {
+		r.byNetwork[vmiUID] = advertisers
+	} else {
+		delete(r.byNetwork, vmiUID)
+	}
+}
+
+// readDHCPConfig reads the DHCP configuration stored by the network discovery,
+// which is keyed by the pod interface name of either the hashed or the ordinal naming scheme.
+func (r *routerAdvertisers) readDHCPConfig(
+	vmi *v1.VirtualMachineInstance,
+	network v1.Network,
+	launcherPid int,
+) (string, *cache.DHCPConfig, error) {
+	candidateNames := []string{
+		namescheme.HashedPodInterfaceName(network, vmi.Status.Interfaces),
+		namescheme.OrdinalPodInterfaceName(network.Name, vmi.Spec.Networks),
+	}
+	for _, podIfaceName := range candidateNames {
+		if podIfaceName == "" {
+			continue
+		}
+		dhcpConfig, err := r.cacheReader(strconv.Itoa(launcherPid), podIfaceName)
+		if errors.Is(err, fs.ErrNotExist) {
+			continue
+		}
+		if err != nil {
+			return "", nil, err
+		}
+		return podIfaceName, dhcpConfig, nil
+	}
+	return "", nil, nil
+}
+
+func (r *routerAdvertisers) stop(vmi *v1.VirtualMachineInstance) {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	for networkName, advertiser := range r.byNetwork[string(vmi.UID)] {
+		closeRouterAdvertiser(vmi, networkName, advertiser)
+	}
+	delete(r.byNetwork, string(vmi.UID))
+}
+
+func closeRouterAdvertiser(vmi *v1.VirtualMachineInstance, networkName string, advertiser io.Closer) {
+	if err := advertiser.Close(); err != nil {
+		log.Log.Object(vmi).Reason(err).Warningf("failed to stop the router advertiser of network %s", networkName)
+	}
+}
+
+func routerAdvertiserConfig(dhcpConfig *cache.DHCPConfig) ndp.Config {
+	config := ndp.Config{
+		ClientMAC: dhcpConfig.MAC,
+		Prefix: &net.IPNet{
+			IP:   dhcpConfig.IPv6.IP.Mask(dhcpConfig.IPv6.Mask),
+			Mask: dhcpConfig.IPv6.Mask,
+		},
+		Router: dhcpConfig.IPv6Gateway,
+	}
+
+	if dhcpConfig.IPv6Routes != nil {
+		for _, route := range *dhcpConfig.IPv6Routes {
+			// The default route is advertised through the router lifetime.
+			if route.Dst != nil && route.Gw.Equal(dhcpConfig.IPv6Gateway) {
+				config.Routes = append(config.Routes, route.Dst)
+			}
+		}
+	}
+	return config
+}
+
+func startRouterAdvertiser(nsExec NSExecutor, ifaceName string, config ndp.Config) (io.Closer, error) {
+	var advertiser *ndp.RouterAdvertiser
+	err := nsExec.Do(func() error {
+		var err error
+		advertiser, err = ndp.NewRouterAdvertiser(ifaceName, config)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	go func() {
+		if err := advertiser.Serve(); err != nil {
+			log.Log.Reason(err).Errorf("router advertiser on %s stopped", ifaceName)
+		}
+	}()
+	return advertiser, nil
+}
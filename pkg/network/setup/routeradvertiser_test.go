@@ -0,0 +1,185 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package network
+
+import (
+	"io"
+	"io/fs"
+	"net"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/vishvananda/netlink"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	v1 "kubevirt.io/api/core/v1"
+
+	"kubevirt.io/kubevirt/pkg/network/cache"
+	"kubevirt.io/kubevirt/pkg/network/ndp"
+)
+
+var _ = Describe("router advertisers", func() {
+	const (
+		networkName     = "default"
+		podIfaceName    = "eth0"
+		vmMAC           = "02:00:00:00:00:01"
+		ipv6Gateway     = "fe80::1"
+		launcherPid     = 1
+		bridgeIfaceName = "k6t-eth0"
+	)
+
+	var (
+		advertisers  *routerAdvertisers
+		vmi          *v1.VirtualMachineInstance
+		dhcpConfigs  map[string]*cache.DHCPConfig
+		started      map[string]ndp.Config
+		startedCount int
+		closers      []*closerStub
+	)
+
+	BeforeEach(func() {
+		dhcpConfigs = map[string]*cache.DHCPConfig{}
+		started = map[string]ndp.Config{}
+		startedCount = 0
+		closers = nil
+
+		advertisers = &routerAdvertisers{
+			byNetwork: map[string]map[string]io.Closer{},
+			start: func(_ NSExecutor, ifaceName string, config ndp.Config) (io.Closer, error) {
+				started[ifaceName] = config
+				startedCount++
+				closer := &closerStub{}
+				closers = append(closers, closer)
+				return closer, nil
+			},
+			cacheReader: func(_, ifaceName string) (*cache.DHCPConfig, error) {
+				if dhcpConfig, exists := dhcpConfigs[ifaceName]; exists {
+					return dhcpConfig, nil
+				}
+				return nil, fs.ErrNotExist
+			},
+		}
+
+		vmi = &v1.VirtualMachineInstance{
+			ObjectMeta: metav1.ObjectMeta{UID: "123", Name: "vmi1"},
+			Spec: v1.VirtualMachineInstanceSpec{
+				Domain: v1.DomainSpec{Devices: v1.Devices{Interfaces: []v1.Interface{{
+					Name:                   networkName,
+					InterfaceBindingMethod: v1.InterfaceBindingMethod{Bridge: &v1.InterfaceBridge{}},
+				}}}},
+				Networks: []v1.Network{*v1.DefaultPodNetwork()},
+			},
+		}
+	})
+
+	ipv6DHCPConfig := func() *cache.DHCPConfig {
+		mac, err := net.ParseMAC(vmMAC)
+		Expect(err).NotTo(HaveOccurred())
+		addr, err := netlink.ParseAddr("2001:db8::5/64")
+		Expect(err).NotTo(HaveOccurred())
+		_, staticRoute, err := net.ParseCIDR("2001:db8:1::/48")
+		Expect(err).NotTo(HaveOccurred())
+		_, onLinkRoute, err := net.ParseCIDR("2001:db8:2::/48")
+		Expect(err).NotTo(HaveOccurred())
+
+		routes := []netlink.Route{
+			{Gw: net.ParseIP(ipv6Gateway)},
+			{Dst: staticRoute, Gw: net.ParseIP(ipv6Gateway)},
+			{Dst: onLinkRoute},
+		}
+		return &cache.DHCPConfig{
+			MAC:         mac,
+			IPv6:        *addr,
+			IPv6Gateway: net.ParseIP(ipv6Gateway),
+			IPv6Routes:  &routes,
+		}
+	}
+
+	It("starts a router advertiser for a bridge binding interface with IPv6", func() {
+		dhcpConfigs[podIfaceName] = ipv6DHCPConfig()
+
+		advertisers.ensure(vmi, launcherPid, nil)
+
+		Expect(started).To(HaveKey(bridgeIfaceName))
+		config := started[bridgeIfaceName]
+		Expect(config.ClientMAC.String()).To(Equal(vmMAC))
+		Expect(config.Prefix.String()).To(Equal("2001:db8::/64"))
+		Expect(config.Router.String()).To(Equal(ipv6Gateway))
+		Expect(config.Routes).To(HaveLen(1))
+		Expect(config.Routes[0].String()).To(Equal("2001:db8:1::/48"))
+	})
+
+	It("does not start a router advertiser without IPv6", func() {
+		addr, err := netlink.ParseAddr("10.0.0.5/24")
+		Expect(err).NotTo(HaveOccurred())
+		dhcpConfigs[podIfaceName] = &cache.DHCPConfig{IP: *addr}
+
+		advertisers.ensure(vmi, launcherPid, nil)
+
+		Expect(started).To(BeEmpty())
+	})
+
+	It("does not start a router advertiser before the network discovery", func() {
+		advertisers.ensure(vmi, launcherPid, nil)
+
+		Expect(started).To(BeEmpty())
+	})
+
+	It("does not restart a running router advertiser", func() {
+		dhcpConfigs[podIfaceName] = ipv6DHCPConfig()
+
+		advertisers.ensure(vmi, launcherPid, nil)
+		advertisers.ensure(vmi, launcherPid, nil)
+
+		Expect(startedCount).To(Equal(1))
+	})
+
+	It("stops the router advertiser of an unplugged interface", func() {
+		dhcpConfigs[podIfaceName] = ipv6DHCPConfig()
+		advertisers.ensure(vmi, launcherPid, nil)
+		Expect(closers).To(HaveLen(1))
+
+		vmi.Spec.Domain.Devices.Interfaces[0].State = v1.InterfaceStateAbsent
+		advertisers.ensure(vmi, launcherPid, nil)
+
+		Expect(closers[0].closed).To(BeTrue())
+		Expect(advertisers.byNetwork).To(BeEmpty())
+	})
+
+	It("stops the router advertisers of the VMI", func() {
+		dhcpConfigs[podIfaceName] = ipv6DHCPConfig()
+		advertisers.ensure(vmi, launcherPid, nil)
+		Expect(closers).To(HaveLen(1))
+
+		advertisers.stop(vmi)
+
+		Expect(closers[0].closed).To(BeTrue())
+		Expect(advertisers.byNetwork).To(BeEmpty())
+	})
+})
+
+type closerStub struct {
+	closed bool
+}
+
+func (c *closerStub) Close() error {
+	c.closed = true
+	return nil
+}
@@ -375,6 +375,19 @@ var _ = Describe("Validating VM Admitter", func() {
 				})
 				return false
 			}),
+			Entry("accept adding the memory state volume", func(vm *v1.VirtualMachine) bool {
+				vm.Spec.Template.Spec.Volumes = append(vm.Spec.Template.Spec.Volumes, v1.Volume{
+					Name: "memory-state",
+					VolumeSource: v1.VolumeSource{MemoryDump: &v1.MemoryDumpVolumeSource{
+						PersistentVolumeClaimVolumeSource: v1.PersistentVolumeClaimVolumeSource{
+							PersistentVolumeClaimVolumeSource: k8sv1.PersistentVolumeClaimVolumeSource{ClaimName: "memory-state"},
+							Hotpluggable:                      true,
+						},
+						Format: v1.MemoryDumpFormatSaveState,
+					}},
+				})
+				return true
+			}),
 			Entry("reject update to volumees", func(vm *v1.VirtualMachine) bool {
 				vm.Spec.Template.Spec.Volumes[0].VolumeSource = v1.VolumeSource{DataVolume: &v1.DataVolumeSource{Name: "fake"}}
 				return false
@@ -70,7 +70,7 @@ func (a *Admitter) validateSnapshotStatus() []metav1.StatusCause {
 		}}
 	}
 
-	if !compareVolumes(oldVM.Spec.Template.Spec.Volumes, a.vm.Spec.Template.Spec.Volumes) {
+	if !compareVolumes(withoutMemoryStateVolumes(oldVM.Spec.Template.Spec.Volumes), withoutMemoryStateVolumes(a.vm.Spec.Template.Spec.Volumes)) {
 		return []metav1.StatusCause{{
 			Type:    metav1.CauseTypeFieldValueNotSupported,
 			Message: fmt.Sprintf("Cannot update vm disks or volumes until snapshot %q completes", *a.vm.Status.SnapshotInProgress),
@@ -102,6 +102,19 @@ func compareVolumes(old, new []v1.Volume) bool {
 	return true
 }
 
+// withoutMemoryStateVolumes filters out the volumes the snapshot controller
+// hotplugs to save the memory state of the VM
+func withoutMemoryStateVolumes(volumes []v1.Volume) []v1.Volume {
+	var filtered []v1.Volume
+	for _, volume := range volumes {
+		if volume.MemoryDump != nil && volume.MemoryDump.Format == v1.MemoryDumpFormatSaveState {
+			continue
+		}
+		filtered = append(filtered, volume)
+	}
+	return filtered
+}
+
 func compareRunningSpec(old, new *v1.VirtualMachineSpec) bool {
 	if old == nil || new == nil {
 		// This should never happen, but just in case return false
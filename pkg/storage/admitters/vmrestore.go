@@ -26,6 +26,8 @@ import (
 	"strings"
 
 	admissionv1 "k8s.io/api/admission/v1"
+	authenticationv1 "k8s.io/api/authentication/v1"
+	authorizationv1 "k8s.io/api/authorization/v1"
 	"k8s.io/apimachinery/pkg/api/equality"
 	"k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -38,8 +40,10 @@ import (
 	"kubevirt.io/client-go/kubecli"
 
 	backendstorage "kubevirt.io/kubevirt/pkg/storage/backend-storage"
+	"kubevirt.io/kubevirt/pkg/storage/snapshot"
 	webhookutils "kubevirt.io/kubevirt/pkg/util/webhooks"
 	virtconfig "kubevirt.io/kubevirt/pkg/virt-config"
+	"kubevirt.io/kubevirt/pkg/virt-config/featuregate"
 )
 
 // VMRestoreAdmitter validates VirtualMachineRestores
@@ -95,6 +99,13 @@ func (admitter *VMRestoreAdmitter) Admit(ctx context.Context, ar *admissionv1.Ad
 			case core.GroupName:
 				switch vmRestore.Spec.Target.Kind {
 				case "VirtualMachine":
+					causes, err = admitter.validateSource(ctx, k8sfield.NewPath("spec"), ar.Request.UserInfo, vmRestore)
+					if err != nil {
+						return webhookutils.ToAdmissionResponseError(err)
+					}
+					if len(causes) > 0 {
+						break
+					}
 					causes, err = admitter.validateTargetVM(ctx, k8sfield.NewPath("spec"), vmRestore)
 					if err != nil {
 						return webhookutils.ToAdmissionResponseError(err)
@@ -167,13 +178,115 @@ func (admitter *VMRestoreAdmitter) Admit(ctx context.Context, ar *admissionv1.Ad
 	return &reviewResponse
 }
 
+// validateSource validates where the restore is restored from, a snapshot in
+// the namespace of the restore, a snapshot in another namespace the user may
+// read from or a VirtualMachineExport of another cluster
+func (admitter *VMRestoreAdmitter) validateSource(ctx context.Context, field *k8sfield.Path, userInfo authenticationv1.UserInfo, vmRestore *snapshotv1.VirtualMachineRestore) ([]metav1.StatusCause, error) {
+	spec := vmRestore.Spec
+
+	if spec.VirtualMachineExport != nil {
+		exportField := field.Child("virtualMachineExport")
+		if !admitter.Config.CrossNamespaceRestoreEnabled() {
+			return []metav1.StatusCause{{
+				Type:    metav1.CauseTypeFieldValueNotSupported,
+				Message: fmt.Sprintf("restore from a VirtualMachineExport requires the %s feature gate", featuregate.CrossNamespaceRestoreGate),
+				Field:   exportField.String(),
+			}}, nil
+		}
+
+		var causes []metav1.StatusCause
+		if spec.VirtualMachineSnapshotName != "" || spec.VirtualMachineSnapshotNamespace != "" {
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueInvalid,
+				Message: "virtualMachineExport and virtualMachineSnapshotName are mutually exclusive",
+				Field:   exportField.String(),
+			})
+		}
+		manifestURL, err := snapshot.ParseExportManifestURL(spec.VirtualMachineExport.ManifestURL)
+		if err == nil {
+			err = snapshot.ValidateExportHost(manifestURL, admitter.Config.GetVMRestoreImportAllowedHosts())
+		}
+		if err != nil {
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueInvalid,
+				Message: fmt.Sprintf("invalid manifestURL: %v", err),
+				Field:   exportField.Child("manifestURL").String(),
+			})
+		}
+		if spec.VirtualMachineExport.TokenSecretRef == "" {
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueRequired,
+				Message: "missing tokenSecretRef",
+				Field:   exportField.Child("tokenSecretRef").String(),
+			})
+		}
+		return causes, nil
+	}
+
+	if spec.VirtualMachineSnapshotName == "" {
+		return []metav1.StatusCause{{
+			Type:    metav1.CauseTypeFieldValueRequired,
+			Message: "missing virtualMachineSnapshotName",
+			Field:   field.Child("virtualMachineSnapshotName").String(),
+		}}, nil
+	}
+
+	snapshotNamespace := spec.VirtualMachineSnapshotNamespace
+	if snapshotNamespace == "" || snapshotNamespace == vmRestore.Namespace {
+		return nil, nil
+	}
+
+	namespaceField := field.Child("virtualMachineSnapshotNamespace")
+	if !admitter.Config.CrossNamespaceRestoreEnabled() {
+		return []metav1.StatusCause{{
+			Type:    metav1.CauseTypeFieldValueNotSupported,
+			Message: fmt.Sprintf("restore from another namespace requires the %s feature gate", featuregate.CrossNamespaceRestoreGate),
+			Field:   namespaceField.String(),
+		}}, nil
+	}
+
+	allowed, err := admitter.canGetVMSnapshot(ctx, userInfo, snapshotNamespace, spec.VirtualMachineSnapshotName)
+	if err != nil {
+		return nil, err
+	}
+	if !allowed {
+		return []metav1.StatusCause{{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: fmt.Sprintf("user %s is not allowed to get VirtualMachineSnapshot %s/%s", userInfo.Username, snapshotNamespace, spec.VirtualMachineSnapshotName),
+			Field:   namespaceField.String(),
+		}}, nil
+	}
+
+	return nil, nil
+}
+
+// canGetVMSnapshot checks if the user creating the restore may read the
+// snapshot, a restore must not give access to data of other namespaces
+func (admitter *VMRestoreAdmitter) canGetVMSnapshot(ctx context.Context, userInfo authenticationv1.UserInfo, namespace, name string) (bool, error) {
+	return webhookutils.IsUserAllowed(ctx, admitter.Client.AuthorizationV1().SubjectAccessReviews(), userInfo, &authorizationv1.ResourceAttributes{
+		Namespace: namespace,
+		Verb:      "get",
+		Group:     snapshotv1.SchemeGroupVersion.Group,
+		Resource:  "virtualmachinesnapshots",
+		Name:      name,
+	})
+}
+
 func (admitter *VMRestoreAdmitter) validateTargetVM(ctx context.Context, field *k8sfield.Path, vmRestore *snapshotv1.VirtualMachineRestore) (causes []metav1.StatusCause, err error) {
 	targetName := vmRestore.Spec.Target.Name
 	namespace := vmRestore.Namespace
+	snapshotNamespace := vmRestore.Spec.VirtualMachineSnapshotNamespace
+	if snapshotNamespace == "" {
+		snapshotNamespace = namespace
+	}
 
 	causes = admitter.validatePatches(vmRestore.Spec.Patches, field.Child("patches"))
 
-	vmSnapshot, err := admitter.Client.VirtualMachineSnapshot(namespace).Get(ctx, vmRestore.Spec.VirtualMachineSnapshotName, metav1.GetOptions{})
+	if vmRestore.Spec.VirtualMachineExport != nil {
+		return admitter.validateNewTarget(ctx, field, vmRestore, causes)
+	}
+
+	vmSnapshot, err := admitter.Client.VirtualMachineSnapshot(snapshotNamespace).Get(ctx, vmRestore.Spec.VirtualMachineSnapshotName, metav1.GetOptions{})
 	if err != nil {
 		if errors.IsNotFound(err) {
 			return nil, nil
@@ -181,6 +294,13 @@ func (admitter *VMRestoreAdmitter) validateTargetVM(ctx context.Context, field *
 		return nil, err
 	}
 
+	if vmRestore.Spec.NewIdentity != nil {
+		causes, err = admitter.validateNewTarget(ctx, field, vmRestore, causes)
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	target, err := admitter.Client.VirtualMachine(namespace).Get(ctx, targetName, metav1.GetOptions{})
 	if err != nil && !errors.IsNotFound(err) {
 		return nil, err
@@ -193,7 +313,7 @@ func (admitter *VMRestoreAdmitter) validateTargetVM(ctx context.Context, field *
 			return nil, fmt.Errorf("snapshot content name is nil in vmSnapshot status")
 		}
 
-		vmSnapshotContent, err := admitter.Client.VirtualMachineSnapshotContent(namespace).Get(ctx, *contentName, metav1.GetOptions{})
+		vmSnapshotContent, err := admitter.Client.VirtualMachineSnapshotContent(snapshotNamespace).Get(ctx, *contentName, metav1.GetOptions{})
 		if err != nil {
 			return nil, err
 		}
@@ -215,6 +335,24 @@ func (admitter *VMRestoreAdmitter) validateTargetVM(ctx context.Context, field *
 	return causes, nil
 }
 
+// validateNewTarget rejects imports and new identities for a target which
+// already exists, both only make sense for a new VirtualMachine
+func (admitter *VMRestoreAdmitter) validateNewTarget(ctx context.Context, field *k8sfield.Path, vmRestore *snapshotv1.VirtualMachineRestore, causes []metav1.StatusCause) ([]metav1.StatusCause, error) {
+	_, err := admitter.Client.VirtualMachine(vmRestore.Namespace).Get(ctx, vmRestore.Spec.Target.Name, metav1.GetOptions{})
+	if errors.IsNotFound(err) {
+		return causes, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return append(causes, metav1.StatusCause{
+		Type:    metav1.CauseTypeFieldValueInvalid,
+		Message: fmt.Sprintf("VirtualMachine %s already exists, virtualMachineExport and newIdentity require a new target", vmRestore.Spec.Target.Name),
+		Field:   field.Child("target").String(),
+	}), nil
+}
+
 func (admitter *VMRestoreAdmitter) validatePatches(patches []string, field *k8sfield.Path) (causes []metav1.StatusCause) {
 	// Validate patches are either on labels/annotations or on elements under "/spec/" path only
 	for _, patch := range patches {
@@ -0,0 +1,104 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+package admitters
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	admissionv1 "k8s.io/api/admission/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	k8sfield "k8s.io/apimachinery/pkg/util/validation/field"
+
+	snapshotv1 "kubevirt.io/api/snapshot/v1beta1"
+
+	webhookutils "kubevirt.io/kubevirt/pkg/util/webhooks"
+	virtconfig "kubevirt.io/kubevirt/pkg/virt-config"
+)
+
+// VMRestoreGroupAdmitter validates VirtualMachineRestoreGroups
+type VMRestoreGroupAdmitter struct {
+	Config *virtconfig.ClusterConfig
+}
+
+// NewVMRestoreGroupAdmitter creates a VMRestoreGroupAdmitter
+func NewVMRestoreGroupAdmitter(config *virtconfig.ClusterConfig) *VMRestoreGroupAdmitter {
+	return &VMRestoreGroupAdmitter{
+		Config: config,
+	}
+}
+
+// Admit validates an AdmissionReview
+func (admitter *VMRestoreGroupAdmitter) Admit(_ context.Context, ar *admissionv1.AdmissionReview) *admissionv1.AdmissionResponse {
+	if ar.Request.Resource.Group != snapshotv1.SchemeGroupVersion.Group ||
+		ar.Request.Resource.Resource != "virtualmachinerestoregroups" {
+		return webhookutils.ToAdmissionResponseError(fmt.Errorf("unexpected resource %+v", ar.Request.Resource))
+	}
+
+	if ar.Request.Operation == admissionv1.Create && !admitter.Config.SnapshotEnabled() {
+		return webhookutils.ToAdmissionResponseError(fmt.Errorf("snapshot feature gate not enabled"))
+	}
+
+	restoreGroup := &snapshotv1.VirtualMachineRestoreGroup{}
+	if err := json.Unmarshal(ar.Request.Object.Raw, restoreGroup); err != nil {
+		return webhookutils.ToAdmissionResponseError(err)
+	}
+
+	var causes []metav1.StatusCause
+	switch ar.Request.Operation {
+	case admissionv1.Create:
+		if restoreGroup.Spec.VirtualMachineSnapshotGroupName == "" {
+			causes = []metav1.StatusCause{
+				{
+					Type:    metav1.CauseTypeFieldValueRequired,
+					Message: "missing virtualMachineSnapshotGroupName",
+					Field:   k8sfield.NewPath("spec", "virtualMachineSnapshotGroupName").String(),
+				},
+			}
+		}
+	case admissionv1.Update:
+		prevObj := &snapshotv1.VirtualMachineRestoreGroup{}
+		if err := json.Unmarshal(ar.Request.OldObject.Raw, prevObj); err != nil {
+			return webhookutils.ToAdmissionResponseError(err)
+		}
+
+		if !equality.Semantic.DeepEqual(prevObj.Spec, restoreGroup.Spec) {
+			causes = []metav1.StatusCause{
+				{
+					Type:    metav1.CauseTypeFieldValueInvalid,
+					Message: "spec in immutable after creation",
+					Field:   k8sfield.NewPath("spec").String(),
+				},
+			}
+		}
+	default:
+		return webhookutils.ToAdmissionResponseError(fmt.Errorf("unexpected operation %s", ar.Request.Operation))
+	}
+
+	if len(causes) > 0 {
+		return webhookutils.ToAdmissionResponse(causes)
+	}
+
+	reviewResponse := admissionv1.AdmissionResponse{
+		Allowed: true,
+	}
+	return &reviewResponse
+}
@@ -0,0 +1,126 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+package admitters
+
+import (
+	"context"
+	"encoding/json"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	admissionv1 "k8s.io/api/admission/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	v1 "kubevirt.io/api/core/v1"
+	snapshotv1 "kubevirt.io/api/snapshot/v1beta1"
+
+	"kubevirt.io/kubevirt/pkg/testutils"
+)
+
+var _ = Describe("Validating VirtualMachineRestoreGroup Admitter", func() {
+	config, _, kvStore := testutils.NewFakeClusterConfigUsingKVConfig(&v1.KubeVirtConfiguration{})
+
+	newRestoreGroup := func() *snapshotv1.VirtualMachineRestoreGroup {
+		return &snapshotv1.VirtualMachineRestoreGroup{
+			Spec: snapshotv1.VirtualMachineRestoreGroupSpec{
+				VirtualMachineSnapshotGroupName: "app",
+			},
+		}
+	}
+
+	It("should reject create without feature gate enabled", func() {
+		ar := createRestoreGroupAdmissionReview(admissionv1.Create, newRestoreGroup(), nil)
+		resp := NewVMRestoreGroupAdmitter(config).Admit(context.Background(), ar)
+		Expect(resp.Allowed).To(BeFalse())
+		Expect(resp.Result.Message).Should(Equal("snapshot feature gate not enabled"))
+	})
+
+	Context("With feature gate enabled", func() {
+		BeforeEach(func() {
+			testutils.UpdateFakeKubeVirtClusterConfig(kvStore, &v1.KubeVirt{
+				Spec: v1.KubeVirtSpec{
+					Configuration: v1.KubeVirtConfiguration{
+						DeveloperConfiguration: &v1.DeveloperConfiguration{
+							FeatureGates: []string{"Snapshot"},
+						},
+					},
+				},
+			})
+		})
+
+		AfterEach(func() {
+			testutils.UpdateFakeKubeVirtClusterConfig(kvStore, &v1.KubeVirt{})
+		})
+
+		It("should accept a valid restore group", func() {
+			ar := createRestoreGroupAdmissionReview(admissionv1.Create, newRestoreGroup(), nil)
+			resp := NewVMRestoreGroupAdmitter(config).Admit(context.Background(), ar)
+			Expect(resp.Allowed).To(BeTrue())
+		})
+
+		It("should reject a missing snapshot group name", func() {
+			restoreGroup := newRestoreGroup()
+			restoreGroup.Spec.VirtualMachineSnapshotGroupName = ""
+
+			ar := createRestoreGroupAdmissionReview(admissionv1.Create, restoreGroup, nil)
+			resp := NewVMRestoreGroupAdmitter(config).Admit(context.Background(), ar)
+			Expect(resp.Allowed).To(BeFalse())
+			Expect(resp.Result.Details.Causes[0].Field).To(Equal("spec.virtualMachineSnapshotGroupName"))
+		})
+
+		It("should reject spec update", func() {
+			restoreGroup := newRestoreGroup()
+			restoreGroup.Spec.VirtualMachineSnapshotGroupName = "other"
+
+			ar := createRestoreGroupAdmissionReview(admissionv1.Update, restoreGroup, newRestoreGroup())
+			resp := NewVMRestoreGroupAdmitter(config).Admit(context.Background(), ar)
+			Expect(resp.Allowed).To(BeFalse())
+			Expect(resp.Result.Details.Causes[0].Field).To(Equal("spec"))
+		})
+	})
+})
+
+func createRestoreGroupAdmissionReview(operation admissionv1.Operation, restoreGroup, oldRestoreGroup *snapshotv1.VirtualMachineRestoreGroup) *admissionv1.AdmissionReview {
+	bytes, _ := json.Marshal(restoreGroup)
+
+	ar := &admissionv1.AdmissionReview{
+		Request: &admissionv1.AdmissionRequest{
+			Operation: operation,
+			Namespace: "foo",
+			Resource: metav1.GroupVersionResource{
+				Group:    "snapshot.kubevirt.io",
+				Resource: "virtualmachinerestoregroups",
+			},
+			Object: runtime.RawExtension{
+				Raw: bytes,
+			},
+		},
+	}
+
+	if oldRestoreGroup != nil {
+		oldBytes, _ := json.Marshal(oldRestoreGroup)
+		ar.Request.OldObject = runtime.RawExtension{
+			Raw: oldBytes,
+		}
+	}
+
+	return ar
+}
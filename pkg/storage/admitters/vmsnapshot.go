@@ -96,6 +96,7 @@ func (admitter *VMSnapshotAdmitter) Admit(ctx context.Context, ar *admissionv1.A
 				if err != nil {
 					return webhookutils.ToAdmissionResponseError(err)
 				}
+				causes = append(causes, admitter.validateIncludeMemory(vmSnapshot)...)
 			default:
 				causes = []metav1.StatusCause{
 					{
@@ -163,3 +164,21 @@ func (admitter *VMSnapshotAdmitter) validateCreateVM(ctx context.Context, field
 
 	return []metav1.StatusCause{}, nil
 }
+
+func (admitter *VMSnapshotAdmitter) validateIncludeMemory(vmSnapshot *snapshotv1.VirtualMachineSnapshot) []metav1.StatusCause {
+	if !vmSnapshot.Spec.IncludeMemory {
+		return nil
+	}
+
+	if !admitter.Config.MemorySnapshotEnabled() || !admitter.Config.HotplugVolumesEnabled() {
+		return []metav1.StatusCause{
+			{
+				Type:    metav1.CauseTypeFieldValueNotSupported,
+				Message: "including the memory state requires the MemorySnapshot and HotplugVolumes feature gates",
+				Field:   k8sfield.NewPath("spec", "includeMemory").String(),
+			},
+		}
+	}
+
+	return nil
+}
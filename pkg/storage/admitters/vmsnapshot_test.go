@@ -312,6 +312,42 @@ var _ = Describe("Validating VirtualMachineSnapshot Admitter", func() {
 				resp := createTestVMSnapshotAdmitter(config, vm).Admit(context.Background(), ar)
 				Expect(resp.Allowed).To(BeTrue())
 			})
+
+			DescribeTable("when including the memory state", func(featureGates []string, allowed bool) {
+				testutils.UpdateFakeKubeVirtClusterConfig(kvStore, &v1.KubeVirt{
+					Spec: v1.KubeVirtSpec{
+						Configuration: v1.KubeVirtConfiguration{
+							DeveloperConfiguration: &v1.DeveloperConfiguration{
+								FeatureGates: featureGates,
+							},
+						},
+					},
+				})
+				snapshot := &snapshotv1.VirtualMachineSnapshot{
+					Spec: snapshotv1.VirtualMachineSnapshotSpec{
+						Source: corev1.TypedLocalObjectReference{
+							APIGroup: &apiGroup,
+							Kind:     "VirtualMachine",
+							Name:     vmName,
+						},
+						IncludeMemory: true,
+					},
+				}
+
+				vm.Spec.RunStrategy = pointer.P(v1.RunStrategyAlways)
+
+				ar := createSnapshotAdmissionReview(snapshot)
+				resp := createTestVMSnapshotAdmitter(config, vm).Admit(context.Background(), ar)
+				Expect(resp.Allowed).To(Equal(allowed))
+				if !allowed {
+					Expect(resp.Result.Details.Causes).To(HaveLen(1))
+					Expect(resp.Result.Details.Causes[0].Field).To(Equal("spec.includeMemory"))
+				}
+			},
+				Entry("should accept with the required feature gates", []string{"Snapshot", "MemorySnapshot", "HotplugVolumes"}, true),
+				Entry("should reject without the MemorySnapshot feature gate", []string{"Snapshot", "HotplugVolumes"}, false),
+				Entry("should reject without the HotplugVolumes feature gate", []string{"Snapshot", "MemorySnapshot"}, false),
+			)
 		})
 	})
 })
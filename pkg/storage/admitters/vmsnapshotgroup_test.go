@@ -0,0 +1,171 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+package admitters
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	admissionv1 "k8s.io/api/admission/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	v1 "kubevirt.io/api/core/v1"
+	snapshotv1 "kubevirt.io/api/snapshot/v1beta1"
+
+	"kubevirt.io/kubevirt/pkg/pointer"
+	"kubevirt.io/kubevirt/pkg/testutils"
+	"kubevirt.io/kubevirt/pkg/virt-api/webhooks"
+)
+
+var _ = Describe("Validating VirtualMachineSnapshotGroup Admitter", func() {
+	config, _, kvStore := testutils.NewFakeClusterConfigUsingKVConfig(&v1.KubeVirtConfiguration{})
+
+	newGroup := func() *snapshotv1.VirtualMachineSnapshotGroup {
+		return &snapshotv1.VirtualMachineSnapshotGroup{
+			Spec: snapshotv1.VirtualMachineSnapshotGroupSpec{
+				Selector: metav1.LabelSelector{
+					MatchLabels: map[string]string{"app": "shop"},
+				},
+			},
+		}
+	}
+
+	Context("Without feature gate enabled", func() {
+		It("should reject create", func() {
+			ar := createSnapshotGroupAdmissionReview(admissionv1.Create, newGroup(), nil)
+			resp := NewVMSnapshotGroupAdmitter(config).Admit(context.Background(), ar)
+			Expect(resp.Allowed).To(BeFalse())
+			Expect(resp.Result.Message).Should(Equal("snapshot feature gate not enabled"))
+		})
+	})
+
+	Context("With feature gate enabled", func() {
+		BeforeEach(func() {
+			testutils.UpdateFakeKubeVirtClusterConfig(kvStore, &v1.KubeVirt{
+				Spec: v1.KubeVirtSpec{
+					Configuration: v1.KubeVirtConfiguration{
+						DeveloperConfiguration: &v1.DeveloperConfiguration{
+							FeatureGates: []string{"Snapshot"},
+						},
+					},
+				},
+			})
+		})
+
+		AfterEach(func() {
+			testutils.UpdateFakeKubeVirtClusterConfig(kvStore, &v1.KubeVirt{})
+		})
+
+		It("should reject invalid request resource", func() {
+			ar := &admissionv1.AdmissionReview{
+				Request: &admissionv1.AdmissionRequest{
+					Resource: webhooks.VirtualMachineGroupVersionResource,
+				},
+			}
+
+			resp := NewVMSnapshotGroupAdmitter(config).Admit(context.Background(), ar)
+			Expect(resp.Allowed).To(BeFalse())
+			Expect(resp.Result.Message).Should(ContainSubstring("unexpected resource"))
+		})
+
+		It("should accept a valid group", func() {
+			group := newGroup()
+			group.Spec.DeletionPolicy = pointer.P(snapshotv1.VirtualMachineSnapshotContentRetain)
+			group.Spec.FailureDeadline = &metav1.Duration{Duration: time.Minute}
+
+			ar := createSnapshotGroupAdmissionReview(admissionv1.Create, group, nil)
+			resp := NewVMSnapshotGroupAdmitter(config).Admit(context.Background(), ar)
+			Expect(resp.Allowed).To(BeTrue())
+		})
+
+		DescribeTable("should reject", func(mutate func(*snapshotv1.VirtualMachineSnapshotGroup), field string) {
+			group := newGroup()
+			mutate(group)
+
+			ar := createSnapshotGroupAdmissionReview(admissionv1.Create, group, nil)
+			resp := NewVMSnapshotGroupAdmitter(config).Admit(context.Background(), ar)
+			Expect(resp.Allowed).To(BeFalse())
+			Expect(resp.Result.Details.Causes).To(HaveLen(1))
+			Expect(resp.Result.Details.Causes[0].Field).To(Equal(field))
+		},
+			Entry("an invalid selector", func(g *snapshotv1.VirtualMachineSnapshotGroup) {
+				g.Spec.Selector.MatchExpressions = []metav1.LabelSelectorRequirement{{Key: "app", Operator: "Foo"}}
+			}, "spec.selector"),
+			Entry("a negative failure deadline", func(g *snapshotv1.VirtualMachineSnapshotGroup) {
+				g.Spec.FailureDeadline = &metav1.Duration{Duration: -time.Minute}
+			}, "spec.failureDeadline"),
+			Entry("an invalid deletion policy", func(g *snapshotv1.VirtualMachineSnapshotGroup) {
+				g.Spec.DeletionPolicy = pointer.P(snapshotv1.DeletionPolicy("Keep"))
+			}, "spec.deletionPolicy"),
+		)
+
+		It("should reject spec update", func() {
+			oldGroup := newGroup()
+			group := newGroup()
+			group.Spec.Selector.MatchLabels["tier"] = "db"
+
+			ar := createSnapshotGroupAdmissionReview(admissionv1.Update, group, oldGroup)
+			resp := NewVMSnapshotGroupAdmitter(config).Admit(context.Background(), ar)
+			Expect(resp.Allowed).To(BeFalse())
+			Expect(resp.Result.Details.Causes[0].Field).To(Equal("spec"))
+		})
+
+		It("should allow metadata update", func() {
+			oldGroup := newGroup()
+			group := newGroup()
+			group.Labels = map[string]string{"team": "storage"}
+
+			ar := createSnapshotGroupAdmissionReview(admissionv1.Update, group, oldGroup)
+			resp := NewVMSnapshotGroupAdmitter(config).Admit(context.Background(), ar)
+			Expect(resp.Allowed).To(BeTrue())
+		})
+	})
+})
+
+func createSnapshotGroupAdmissionReview(operation admissionv1.Operation, group, oldGroup *snapshotv1.VirtualMachineSnapshotGroup) *admissionv1.AdmissionReview {
+	bytes, _ := json.Marshal(group)
+
+	ar := &admissionv1.AdmissionReview{
+		Request: &admissionv1.AdmissionRequest{
+			Operation: operation,
+			Namespace: "foo",
+			Resource: metav1.GroupVersionResource{
+				Group:    "snapshot.kubevirt.io",
+				Resource: "virtualmachinesnapshotgroups",
+			},
+			Object: runtime.RawExtension{
+				Raw: bytes,
+			},
+		},
+	}
+
+	if oldGroup != nil {
+		oldBytes, _ := json.Marshal(oldGroup)
+		ar.Request.OldObject = runtime.RawExtension{
+			Raw: oldBytes,
+		}
+	}
+
+	return ar
+}
@@ -0,0 +1,170 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package admitters
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/robfig/cron/v3"
+	admissionv1 "k8s.io/api/admission/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	k8svalidation "k8s.io/apimachinery/pkg/util/validation"
+	k8sfield "k8s.io/apimachinery/pkg/util/validation/field"
+
+	snapshotv1 "kubevirt.io/api/snapshot/v1beta1"
+
+	webhookutils "kubevirt.io/kubevirt/pkg/util/webhooks"
+	virtconfig "kubevirt.io/kubevirt/pkg/virt-config"
+)
+
+// VMSnapshotScheduleAdmitter validates VirtualMachineSnapshotSchedules
+type VMSnapshotScheduleAdmitter struct {
+	Config *virtconfig.ClusterConfig
+}
+
+// NewVMSnapshotScheduleAdmitter creates a VMSnapshotScheduleAdmitter
+func NewVMSnapshotScheduleAdmitter(config *virtconfig.ClusterConfig) *VMSnapshotScheduleAdmitter {
+	return &VMSnapshotScheduleAdmitter{
+		Config: config,
+	}
+}
+
+// Admit validates an AdmissionReview
+func (admitter *VMSnapshotScheduleAdmitter) Admit(_ context.Context, ar *admissionv1.AdmissionReview) *admissionv1.AdmissionResponse {
+	if ar.Request.Resource.Group != snapshotv1.SchemeGroupVersion.Group ||
+		ar.Request.Resource.Resource != "virtualmachinesnapshotschedules" {
+		return webhookutils.ToAdmissionResponseError(fmt.Errorf("unexpected resource %+v", ar.Request.Resource))
+	}
+
+	if ar.Request.Operation == admissionv1.Create && !admitter.Config.SnapshotEnabled() {
+		return webhookutils.ToAdmissionResponseError(fmt.Errorf("snapshot feature gate not enabled"))
+	}
+
+	switch ar.Request.Operation {
+	case admissionv1.Create, admissionv1.Update:
+	default:
+		return webhookutils.ToAdmissionResponseError(fmt.Errorf("unexpected operation %s", ar.Request.Operation))
+	}
+
+	schedule := &snapshotv1.VirtualMachineSnapshotSchedule{}
+	if err := json.Unmarshal(ar.Request.Object.Raw, schedule); err != nil {
+		return webhookutils.ToAdmissionResponseError(err)
+	}
+
+	var causes []metav1.StatusCause
+	if ar.Request.Operation == admissionv1.Create {
+		causes = append(causes, validateVMSnapshotScheduleName(k8sfield.NewPath("metadata", "name"), schedule.Name)...)
+	}
+	causes = append(causes, validateVMSnapshotScheduleSpec(k8sfield.NewPath("spec"), &schedule.Spec)...)
+	if len(causes) > 0 {
+		return webhookutils.ToAdmissionResponse(causes)
+	}
+
+	reviewResponse := admissionv1.AdmissionResponse{
+		Allowed: true,
+	}
+	return &reviewResponse
+}
+
+// validateVMSnapshotScheduleName makes sure the name can be the value of the
+// label the snapshots created by the schedule are tracked with
+func validateVMSnapshotScheduleName(field *k8sfield.Path, name string) []metav1.StatusCause {
+	if len(name) <= k8svalidation.LabelValueMaxLength {
+		return nil
+	}
+	return []metav1.StatusCause{{
+		Type:    metav1.CauseTypeFieldValueInvalid,
+		Message: fmt.Sprintf("name must be no more than %d characters, it is the value of the %s label of the scheduled snapshots", k8svalidation.LabelValueMaxLength, snapshotv1.SnapshotScheduleLabel),
+		Field:   field.String(),
+	}}
+}
+
+func validateVMSnapshotScheduleSpec(field *k8sfield.Path, spec *snapshotv1.VirtualMachineSnapshotScheduleSpec) []metav1.StatusCause {
+	var causes []metav1.StatusCause
+
+	if _, err := cron.ParseStandard(spec.Schedule); err != nil {
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: fmt.Sprintf("invalid schedule %q: %v", spec.Schedule, err),
+			Field:   field.Child("schedule").String(),
+		})
+	}
+
+	if _, err := metav1.LabelSelectorAsSelector(&spec.Selector); err != nil {
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: fmt.Sprintf("invalid selector: %v", err),
+			Field:   field.Child("selector").String(),
+		})
+	}
+
+	if retention := spec.Retention; retention != nil {
+		retentionField := field.Child("retention")
+		for _, rule := range []struct {
+			name  string
+			value *int32
+		}{
+			{"last", retention.Last},
+			{"daily", retention.Daily},
+			{"weekly", retention.Weekly},
+		} {
+			if rule.value != nil && *rule.value < 1 {
+				causes = append(causes, metav1.StatusCause{
+					Type:    metav1.CauseTypeFieldValueInvalid,
+					Message: fmt.Sprintf("%s must be greater than zero", rule.name),
+					Field:   retentionField.Child(rule.name).String(),
+				})
+			}
+		}
+	}
+
+	if template := spec.SnapshotTemplate; template != nil {
+		templateField := field.Child("snapshotTemplate")
+		if _, ok := template.Labels[snapshotv1.SnapshotScheduleLabel]; ok {
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueInvalid,
+				Message: fmt.Sprintf("label %s is reserved", snapshotv1.SnapshotScheduleLabel),
+				Field:   templateField.Child("labels").String(),
+			})
+		}
+
+		if template.FailureDeadline != nil && template.FailureDeadline.Duration < 0 {
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueInvalid,
+				Message: "failureDeadline must not be negative",
+				Field:   templateField.Child("failureDeadline").String(),
+			})
+		}
+
+		if policy := template.DeletionPolicy; policy != nil &&
+			*policy != snapshotv1.VirtualMachineSnapshotContentDelete &&
+			*policy != snapshotv1.VirtualMachineSnapshotContentRetain {
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueInvalid,
+				Message: fmt.Sprintf("invalid deletionPolicy %q", *policy),
+				Field:   templateField.Child("deletionPolicy").String(),
+			})
+		}
+	}
+
+	return causes
+}
@@ -0,0 +1,244 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package backup
+
+import (
+	k8score "k8s.io/api/core/v1"
+	"k8s.io/client-go/tools/cache"
+
+	v1 "kubevirt.io/api/core/v1"
+	"kubevirt.io/client-go/kubecli"
+	"kubevirt.io/client-go/log"
+
+	storageutils "kubevirt.io/kubevirt/pkg/storage/utils"
+)
+
+const (
+	ErrorReason = "BackupError"
+	failed      = "Backup failed"
+)
+
+func HasCompleted(vm *v1.VirtualMachine) bool {
+	return vm.Status.BackupRequest != nil && vm.Status.BackupRequest.Phase != v1.BackupAssociating && vm.Status.BackupRequest.Phase != v1.BackupInProgress
+}
+
+func RemoveBackupVolumeFromVMISpec(vmiSpec *v1.VirtualMachineInstanceSpec, claimName string) *v1.VirtualMachineInstanceSpec {
+	return storageutils.RemoveVolumeFromVMISpec(vmiSpec, claimName)
+}
+
+func HandleRequest(client kubecli.KubevirtClient, vm *v1.VirtualMachine, vmi *v1.VirtualMachineInstance, pvcStore cache.Store) error {
+	if vm.Status.BackupRequest == nil {
+		return nil
+	}
+
+	vmiVolumeMap := make(map[string]v1.Volume)
+	if vmi != nil {
+		for _, volume := range vmi.Spec.Volumes {
+			vmiVolumeMap[volume.Name] = volume
+		}
+	}
+	switch vm.Status.BackupRequest.Phase {
+	case v1.BackupAssociating:
+		if vmi == nil || vmi.DeletionTimestamp != nil || !vmi.IsRunning() {
+			return nil
+		}
+		// When in state associating we want to add the backup pvc
+		// as a volume in the vm and in the vmi to trigger the mount
+		// to virt launcher and the backup
+		vm.Spec.Template.Spec = *applyBackupVolumeRequestOnVMISpec(&vm.Spec.Template.Spec, vm.Status.BackupRequest)
+		if _, exists := vmiVolumeMap[vm.Status.BackupRequest.ClaimName]; exists {
+			return nil
+		}
+		if err := generateVMIBackupVolumePatch(client, vmi, vm.Status.BackupRequest, true); err != nil {
+			log.Log.Object(vmi).Errorf("unable to patch vmi to add backup volume: %v", err)
+			return err
+		}
+	case v1.BackupUnmounting, v1.BackupFailed:
+		if err := patchBackupPVCAnnotation(client, vm, pvcStore); err != nil {
+			return err
+		}
+		// Check if the backup is in the vmi list of volumes,
+		// if it still there remove it to make it unmount from virt launcher
+		if _, exists := vmiVolumeMap[vm.Status.BackupRequest.ClaimName]; !exists {
+			return nil
+		}
+
+		if err := generateVMIBackupVolumePatch(client, vmi, vm.Status.BackupRequest, false); err != nil {
+			log.Log.Object(vmi).Errorf("unable to patch vmi to remove backup volume: %v", err)
+			return err
+		}
+	case v1.BackupDissociating:
+		// Check if the backup is in the vmi list of volumes,
+		// if it still there remove it to make it unmount from virt launcher
+		if _, exists := vmiVolumeMap[vm.Status.BackupRequest.ClaimName]; exists {
+			if err := generateVMIBackupVolumePatch(client, vmi, vm.Status.BackupRequest, false); err != nil {
+				log.Log.Object(vmi).Errorf("unable to patch vmi to remove backup volume: %v", err)
+				return err
+			}
+		}
+
+		vm.Spec.Template.Spec = *RemoveBackupVolumeFromVMISpec(&vm.Spec.Template.Spec, vm.Status.BackupRequest.ClaimName)
+	}
+
+	return nil
+}
+
+func UpdateRequest(vm *v1.VirtualMachine, vmi *v1.VirtualMachineInstance) {
+	if vm.Status.BackupRequest == nil {
+		return
+	}
+
+	updatedBackupReq := vm.Status.BackupRequest.DeepCopy()
+
+	if vm.Status.BackupRequest.Remove {
+		updatedBackupReq.Phase = v1.BackupDissociating
+	}
+
+	switch vm.Status.BackupRequest.Phase {
+	case v1.BackupCompleted:
+		// Once the backup completed, there is no update needed,
+		// a new update will come from the subresource API once
+		// a new request will be issued
+		return
+	case v1.BackupAssociating:
+		// Update Phase to InProgress once the backup volume
+		// is in the list of vm volumes
+		for _, volume := range vm.Spec.Template.Spec.Volumes {
+			if vm.Status.BackupRequest.ClaimName == volume.Name {
+				updatedBackupReq.Phase = v1.BackupInProgress
+				break
+			}
+		}
+	case v1.BackupInProgress:
+		// Update to unmounting once getting update in the vmi volume status
+		// that the backup has completed
+		if vmi != nil {
+			for _, volumeStatus := range vmi.Status.VolumeStatus {
+				if volumeStatus.Name != vm.Status.BackupRequest.ClaimName || volumeStatus.BackupVolume == nil ||
+					volumeStatus.BackupVolume.BackupName != vm.Status.BackupRequest.BackupName {
+					continue
+				}
+				if volumeStatus.BackupVolume.StartTimestamp != nil {
+					updatedBackupReq.StartTimestamp = volumeStatus.BackupVolume.StartTimestamp
+				}
+				if volumeStatus.Phase == v1.BackupVolumeCompleted {
+					updatedBackupReq.Phase = v1.BackupUnmounting
+					updatedBackupReq.EndTimestamp = volumeStatus.BackupVolume.EndTimestamp
+				} else if volumeStatus.Phase == v1.BackupVolumeFailed {
+					updatedBackupReq.Phase = v1.BackupFailed
+					updatedBackupReq.Message = volumeStatus.Message
+					updatedBackupReq.EndTimestamp = volumeStatus.BackupVolume.EndTimestamp
+				}
+			}
+		}
+	case v1.BackupUnmounting:
+		// Update backup as completed once the backup volume has been
+		// unmounted - not a part of the vmi volume status
+		if vmi != nil {
+			for _, volumeStatus := range vmi.Status.VolumeStatus {
+				// If we found the claim name in the vmi volume status
+				// then the pvc is still mounted
+				if volumeStatus.Name == vm.Status.BackupRequest.ClaimName {
+					return
+				}
+			}
+		}
+		updatedBackupReq.Phase = v1.BackupCompleted
+	case v1.BackupDissociating:
+		// Make sure the backup volume is not in the vmi list of volumes
+		if vmi != nil {
+			for _, volumeStatus := range vmi.Status.VolumeStatus {
+				if volumeStatus.Name == vm.Status.BackupRequest.ClaimName {
+					return
+				}
+			}
+		}
+		// Make sure the backup volume is not in the list of vm volumes
+		for _, volume := range vm.Spec.Template.Spec.Volumes {
+			if vm.Status.BackupRequest.ClaimName == volume.Name {
+				return
+			}
+		}
+		// Remove the backup request
+		updatedBackupReq = nil
+	}
+
+	vm.Status.BackupRequest = updatedBackupReq
+}
+
+func generateVMIBackupVolumePatch(client kubecli.KubevirtClient, vmi *v1.VirtualMachineInstance, request *v1.VirtualMachineBackupRequest, addVolume bool) error {
+	return storageutils.PatchVMIRequestVolume(client, vmi, request.ClaimName, addVolume, func(vmiSpec *v1.VirtualMachineInstanceSpec) *v1.VirtualMachineInstanceSpec {
+		return applyBackupVolumeRequestOnVMISpec(vmiSpec, request)
+	})
+}
+
+func applyBackupVolumeRequestOnVMISpec(vmiSpec *v1.VirtualMachineInstanceSpec, request *v1.VirtualMachineBackupRequest) *v1.VirtualMachineInstanceSpec {
+	backupVol := &v1.BackupVolumeSource{
+		PersistentVolumeClaimVolumeSource: v1.PersistentVolumeClaimVolumeSource{
+			PersistentVolumeClaimVolumeSource: k8score.PersistentVolumeClaimVolumeSource{
+				ClaimName: request.ClaimName,
+			},
+			Hotpluggable: true,
+		},
+		BackupName:      request.BackupName,
+		IncrementalFrom: request.IncrementalFrom,
+		Format:          request.Format,
+	}
+
+	for i, volume := range vmiSpec.Volumes {
+		if volume.Name == request.ClaimName {
+			// The volume is kept in the vm spec between requests,
+			// make sure it carries the options of the current one
+			vmiSpec.Volumes[i].VolumeSource.Backup = backupVol
+			return vmiSpec
+		}
+	}
+
+	newVolume := v1.Volume{
+		Name: request.ClaimName,
+	}
+	newVolume.VolumeSource.Backup = backupVol
+
+	vmiSpec.Volumes = append(vmiSpec.Volumes, newVolume)
+
+	return vmiSpec
+}
+
+func patchBackupPVCAnnotation(client kubecli.KubevirtClient, vm *v1.VirtualMachine, pvcStore cache.Store) error {
+	request := vm.Status.BackupRequest
+
+	var patchVal string
+	switch request.Phase {
+	case v1.BackupUnmounting:
+		patchVal = request.BackupName
+	case v1.BackupFailed:
+		patchVal = failed
+	default:
+		log.Log.Object(vm).Errorf("Unexpected phase when patching backup pvc annotation")
+		return nil
+	}
+
+	if err := storageutils.PatchPVCAnnotation(client, pvcStore, vm.Namespace, request.ClaimName, v1.PVCBackupAnnotation, patchVal); err != nil {
+		log.Log.Object(vm).Errorf("failed to annotate backup PVC %s/%s, error: %v", vm.Namespace, request.ClaimName, err)
+		return err
+	}
+
+	return nil
+}
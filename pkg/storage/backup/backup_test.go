@@ -0,0 +1,265 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package backup
+
+import (
+	"context"
+
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	k8score "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	k8sfake "k8s.io/client-go/kubernetes/fake"
+	"k8s.io/client-go/tools/cache"
+
+	v1 "kubevirt.io/api/core/v1"
+	"kubevirt.io/client-go/api"
+	"kubevirt.io/client-go/kubecli"
+	"kubevirt.io/client-go/kubevirt/fake"
+
+	"kubevirt.io/kubevirt/pkg/pointer"
+	"kubevirt.io/kubevirt/pkg/testutils"
+)
+
+const (
+	testPVCName    = "testPVC"
+	testBackupName = "backup-2"
+	vmName         = "testVM"
+)
+
+var now = metav1.Now()
+
+var _ = Describe("Backup", func() {
+	var k8sClient *k8sfake.Clientset
+	var virtClient *kubecli.MockKubevirtClient
+	var virtFakeClient *fake.Clientset
+	var pvcStore cache.Store
+
+	BeforeEach(func() {
+		virtClient = kubecli.NewMockKubevirtClient(gomock.NewController(GinkgoT()))
+		virtFakeClient = fake.NewSimpleClientset()
+
+		pvcInformer, _ := testutils.NewFakeInformerFor(&k8score.PersistentVolumeClaim{})
+		pvcStore = pvcInformer.GetStore()
+
+		virtClient.EXPECT().VirtualMachineInstance(metav1.NamespaceDefault).Return(
+			virtFakeClient.KubevirtV1().VirtualMachineInstances(metav1.NamespaceDefault),
+		).AnyTimes()
+
+		k8sClient = k8sfake.NewSimpleClientset()
+		virtClient.EXPECT().CoreV1().Return(k8sClient.CoreV1()).AnyTimes()
+	})
+
+	Context("UpdateRequest", func() {
+		It("should update backup phase to InProgress when backup in vm volumes", func() {
+			vm, vmi := createVirtualMachineWithBackup(v1.BackupAssociating)
+
+			UpdateRequest(vm, vmi)
+			Expect(vm.Status.BackupRequest.Phase).To(Equal(v1.BackupInProgress))
+		})
+
+		It("should update status to unmounting when backup volume completed", func() {
+			vm, vmi := createVirtualMachineWithBackup(v1.BackupInProgress)
+			vmi.Status.VolumeStatus = []v1.VolumeStatus{
+				{
+					Name:  testPVCName,
+					Phase: v1.BackupVolumeCompleted,
+					BackupVolume: &v1.DomainBackupInfo{
+						StartTimestamp: pointer.P(now),
+						EndTimestamp:   pointer.P(now),
+						ClaimName:      testPVCName,
+						BackupName:     testBackupName,
+					},
+				},
+			}
+
+			UpdateRequest(vm, vmi)
+
+			Expect(vm.Status.BackupRequest.Phase).To(Equal(v1.BackupUnmounting))
+			Expect(vm.Status.BackupRequest.StartTimestamp).To(Equal(pointer.P(now)))
+			Expect(vm.Status.BackupRequest.EndTimestamp).To(Equal(pointer.P(now)))
+		})
+
+		It("should ignore the volume status of a previous backup", func() {
+			vm, vmi := createVirtualMachineWithBackup(v1.BackupInProgress)
+			vmi.Status.VolumeStatus = []v1.VolumeStatus{
+				{
+					Name:  testPVCName,
+					Phase: v1.BackupVolumeCompleted,
+					BackupVolume: &v1.DomainBackupInfo{
+						EndTimestamp: pointer.P(now),
+						ClaimName:    testPVCName,
+						BackupName:   "backup-1",
+					},
+				},
+			}
+
+			UpdateRequest(vm, vmi)
+
+			Expect(vm.Status.BackupRequest.Phase).To(Equal(v1.BackupInProgress))
+		})
+
+		It("should update status to failed when backup failed", func() {
+			vm, vmi := createVirtualMachineWithBackup(v1.BackupInProgress)
+			vmi.Status.VolumeStatus = []v1.VolumeStatus{
+				{
+					Name:    testPVCName,
+					Phase:   v1.BackupVolumeFailed,
+					Message: "Backup failed",
+					BackupVolume: &v1.DomainBackupInfo{
+						ClaimName:    testPVCName,
+						BackupName:   testBackupName,
+						EndTimestamp: pointer.P(now),
+					},
+				},
+			}
+
+			UpdateRequest(vm, vmi)
+
+			Expect(vm.Status.BackupRequest.Phase).To(Equal(v1.BackupFailed))
+			Expect(vm.Status.BackupRequest.Message).To(Equal("Backup failed"))
+			Expect(vm.Status.BackupRequest.EndTimestamp).To(Equal(pointer.P(now)))
+		})
+
+		It("should update backup to completed once backup volume unmounted", func() {
+			vm, vmi := createVirtualMachineWithBackup(v1.BackupUnmounting)
+
+			UpdateRequest(vm, vmi)
+
+			Expect(vm.Status.BackupRequest.Phase).To(Equal(v1.BackupCompleted))
+			Expect(HasCompleted(vm)).To(BeTrue())
+		})
+
+		It("should dissociate backup request when status is Dissociating and not in vm volumes", func() {
+			vm, _ := createVirtualMachineWithBackup(v1.BackupDissociating)
+
+			UpdateRequest(vm, nil)
+
+			Expect(vm.Status.BackupRequest).To(BeNil())
+		})
+	})
+
+	Context("HandleRequest", func() {
+		It("should add the backup volume to the vmi when Associating", func() {
+			vm, vmi := createVirtualMachineWithBackup(v1.BackupAssociating)
+			vm.Status.BackupRequest.IncrementalFrom = "backup-1"
+
+			vmi, err := virtFakeClient.KubevirtV1().VirtualMachineInstances(vm.Namespace).Create(context.Background(), vmi, metav1.CreateOptions{})
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(HandleRequest(virtClient, vm, vmi, pvcStore)).To(Succeed())
+
+			vmi, err = virtFakeClient.KubevirtV1().VirtualMachineInstances(vm.Namespace).Get(context.Background(), vm.Name, metav1.GetOptions{})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(vmi.Spec.Volumes).To(HaveLen(1))
+			Expect(vmi.Spec.Volumes[0].Backup).ToNot(BeNil())
+			Expect(vmi.Spec.Volumes[0].Backup.BackupName).To(Equal(testBackupName))
+			Expect(vmi.Spec.Volumes[0].Backup.IncrementalFrom).To(Equal("backup-1"))
+		})
+
+		DescribeTable("should remove backup volume from vmi volumes and update pvc annotation", func(phase v1.BackupPhase, expectedAnnotation string) {
+			vm, vmi := createVirtualMachineWithBackup(phase)
+
+			vmi, err := virtFakeClient.KubevirtV1().VirtualMachineInstances(vm.Namespace).Create(context.Background(), vmi, metav1.CreateOptions{})
+			Expect(err).NotTo(HaveOccurred())
+
+			pvc := &k8score.PersistentVolumeClaim{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      testPVCName,
+					Namespace: vm.Namespace,
+				},
+			}
+			pvc, err = k8sClient.CoreV1().PersistentVolumeClaims(pvc.Namespace).Create(context.TODO(), pvc, metav1.CreateOptions{})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(pvcStore.Add(pvc)).To(Succeed())
+
+			Expect(HandleRequest(virtClient, vm, vmi, pvcStore)).To(Succeed())
+
+			vmi, err = virtFakeClient.KubevirtV1().VirtualMachineInstances(vm.Namespace).Get(context.Background(), vm.Name, metav1.GetOptions{})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(vmi.Spec.Volumes).To(BeEmpty())
+
+			pvc, err = k8sClient.CoreV1().PersistentVolumeClaims(pvc.Namespace).Get(context.TODO(), pvc.Name, metav1.GetOptions{})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(pvc.Annotations[v1.PVCBackupAnnotation]).To(Equal(expectedAnnotation))
+		},
+			Entry("when phase is Unmounting", v1.BackupUnmounting, testBackupName),
+			Entry("when phase is Failed", v1.BackupFailed, "Backup failed"),
+		)
+	})
+})
+
+func applyVMIBackupVol(spec *v1.VirtualMachineInstanceSpec) {
+	newVolume := v1.Volume{
+		Name: testPVCName,
+		VolumeSource: v1.VolumeSource{
+			Backup: &v1.BackupVolumeSource{
+				PersistentVolumeClaimVolumeSource: v1.PersistentVolumeClaimVolumeSource{
+					PersistentVolumeClaimVolumeSource: k8score.PersistentVolumeClaimVolumeSource{
+						ClaimName: testPVCName,
+					},
+					Hotpluggable: true,
+				},
+				BackupName: testBackupName,
+				Format:     v1.BackupFormatQCOW2,
+			},
+		},
+	}
+
+	spec.Volumes = append(spec.Volumes, newVolume)
+}
+
+func createVirtualMachineWithBackup(backupPhase v1.BackupPhase) (*v1.VirtualMachine, *v1.VirtualMachineInstance) {
+	vmi := api.NewMinimalVMI(vmName)
+	vmi.Status.Phase = v1.Running
+	vm := &v1.VirtualMachine{
+		ObjectMeta: metav1.ObjectMeta{Name: vmName, Namespace: vmi.ObjectMeta.Namespace, ResourceVersion: "1", UID: "vm-uid"},
+		Spec: v1.VirtualMachineSpec{
+			RunStrategy: pointer.P(v1.RunStrategyAlways),
+			Template: &v1.VirtualMachineInstanceTemplateSpec{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:   vmi.ObjectMeta.Name,
+					Labels: vmi.ObjectMeta.Labels,
+				},
+				Spec: vmi.Spec,
+			},
+		},
+	}
+	vm.Status.BackupRequest = &v1.VirtualMachineBackupRequest{
+		ClaimName:  testPVCName,
+		BackupName: testBackupName,
+		Format:     v1.BackupFormatQCOW2,
+		Phase:      backupPhase,
+	}
+	switch backupPhase {
+	case v1.BackupAssociating:
+		applyVMIBackupVol(&vm.Spec.Template.Spec)
+	case v1.BackupInProgress, v1.BackupFailed:
+		applyVMIBackupVol(&vm.Spec.Template.Spec)
+		vmi.Spec = vm.Spec.Template.Spec
+	case v1.BackupUnmounting:
+		applyVMIBackupVol(&vm.Spec.Template.Spec)
+		vmi.Spec = vm.Spec.Template.Spec
+		vm.Status.BackupRequest.EndTimestamp = pointer.P(now)
+	}
+	return vm, vmi
+}
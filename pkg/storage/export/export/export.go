@@ -164,6 +164,10 @@ func dirURI(pvc *corev1.PersistentVolumeClaim) string {
 	return path.Join(fmt.Sprintf("%s/%s/dir", urlBasePath, pvc.Name)) + "/"
 }
 
+func backupURI(pvc *corev1.PersistentVolumeClaim) string {
+	return path.Join(fmt.Sprintf("%s/%s/backup", urlBasePath, pvc.Name))
+}
+
 type sourceVolumes struct {
 	volumes          []*corev1.PersistentVolumeClaim
 	inUse            bool
@@ -1182,6 +1186,12 @@ func (ctrl *VMExportController) addVolumeEnvironmentVariables(exportContainer *c
 				Value: dirURI(pvc),
 			})
 		}
+		if _, isBackup := pvc.Annotations[virtv1.PVCBackupAnnotation]; isBackup {
+			exportContainer.Env = append(exportContainer.Env, corev1.EnvVar{
+				Name:  fmt.Sprintf("VOLUME%d_EXPORT_BACKUP_URI", index),
+				Value: backupURI(pvc),
+			})
+		}
 	}
 }
 
@@ -137,6 +137,12 @@ func (ctrl *VMExportController) getLinks(pvcs []*corev1.PersistentVolumeClaim, e
 				Url:    scheme + path.Join(hostAndBase, volumeInfo.ArchiveURI),
 			})
 		}
+		if volumeInfo.BackupURI != "" {
+			ev.Formats = append(ev.Formats, exportv1.VirtualMachineExportVolumeFormat{
+				Format: exportv1.Backup,
+				Url:    scheme + path.Join(hostAndBase, volumeInfo.BackupURI),
+			})
+		}
 
 		if len(ev.Formats) == 0 {
 			log.Log.Warningf("No formats found for volume %s", pvc.Name)
@@ -15,6 +15,7 @@ type VolumeInfo struct {
 	DirURI     string
 	RawURI     string
 	RawGzURI   string
+	BackupURI  string
 }
 
 // ServerPaths contains static paths and per-volume paths
@@ -58,6 +59,7 @@ func CreateServerPaths(env map[string]string) *ServerPaths {
 				DirURI:     env[envPrefix+"_EXPORT_DIR_URI"],
 				RawURI:     env[envPrefix+"_EXPORT_RAW_URI"],
 				RawGzURI:   env[envPrefix+"_EXPORT_RAW_GZIP_URI"],
+				BackupURI:  env[envPrefix+"_EXPORT_BACKUP_URI"],
 			}
 			result.Volumes = append(result.Volumes, vi)
 		}
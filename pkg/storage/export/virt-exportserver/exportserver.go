@@ -66,6 +66,8 @@ const (
 
 	external = "/external"
 	internal = "/internal"
+
+	backupManifestFile = "backup.json"
 )
 
 type TokenGetterFunc func() (string, error)
@@ -86,6 +88,7 @@ type ExportServerConfig struct {
 	DirHandler         func(string, string) http.Handler
 	FileHandler        func(string) http.Handler
 	GzipHandler        func(string) http.Handler
+	BackupHandler      func(string, string) http.Handler
 	VmHandler          func([]export.VolumeInfo, func() (string, error), func() (*corev1.ConfigMap, error)) http.Handler
 	TokenSecretHandler func(TokenGetterFunc) http.Handler
 
@@ -171,6 +174,12 @@ func (s *exportServer) getHandlerMap(vi export.VolumeInfo) map[string]http.Handl
 		result[vi.DirURI] = s.DirHandler(vi.DirURI, vi.Path)
 	}
 
+	if vi.BackupURI != "" {
+		backupHandler := s.BackupHandler(vi.BackupURI, vi.Path)
+		result[vi.BackupURI] = backupHandler
+		result[vi.BackupURI+"/"] = backupHandler
+	}
+
 	p := vi.Path
 	if fi.IsDir() {
 		p = path.Join(p, "disk.img")
@@ -243,6 +252,10 @@ func NewExportServer(config ExportServerConfig) service.Service {
 		es.GzipHandler = gzipHandler
 	}
 
+	if es.BackupHandler == nil {
+		es.BackupHandler = backupHandler
+	}
+
 	if es.VmHandler == nil {
 		es.VmHandler = vmHandler
 	}
@@ -697,6 +710,38 @@ func dirHandler(uri, mountPoint string) http.Handler {
 	return http.StripPrefix(uri, http.FileServer(http.Dir(mountPoint)))
 }
 
+// backupHandler serves the backup manifest on the base uri and the disk images listed in it below the base uri
+func backupHandler(uri, mountPoint string) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		fileName := strings.TrimPrefix(strings.TrimPrefix(r.URL.Path, uri), "/")
+		if fileName == "" {
+			fileName = backupManifestFile
+		}
+		switch filepath.Ext(fileName) {
+		case ".qcow2", ".raw", ".json":
+		default:
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		if fileName != filepath.Base(fileName) {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+
+		f, err := os.Open(filepath.Join(mountPoint, fileName))
+		if errors.Is(err, os.ErrNotExist) {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		} else if err != nil {
+			log.Log.Reason(err).Errorf("error opening %s", fileName)
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+		defer f.Close()
+		http.ServeContent(w, r, fileName, time.Time{}, f)
+	})
+}
+
 func fileHandler(file string) http.Handler {
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		f, err := os.Open(file)
@@ -25,6 +25,8 @@ import (
 	"io"
 	"net/http"
 	"net/http/httptest"
+	"os"
+	"path/filepath"
 	"strings"
 
 	. "github.com/onsi/ginkgo/v2"
@@ -63,6 +65,9 @@ func newTestServer(token string) *exportServer {
 		GzipHandler: func(string) http.Handler {
 			return http.HandlerFunc(successHandler)
 		},
+		BackupHandler: func(string, string) http.Handler {
+			return http.HandlerFunc(successHandler)
+		},
 		VmHandler: func([]export.VolumeInfo, func() (string, error), func() (*v1.ConfigMap, error)) http.Handler {
 			return http.HandlerFunc(successHandler)
 		},
@@ -125,6 +130,16 @@ var _ = Describe("exportserver", func() {
 			&export.VolumeInfo{Path: "/tmp", RawGzURI: "/volume/v1/disk.img.gz"},
 			"/volume/v1/disk.img.gz",
 		),
+		Entry("backup URI",
+			"",
+			&export.VolumeInfo{Path: "/tmp", BackupURI: "/volume/v1/backup"},
+			"/volume/v1/backup",
+		),
+		Entry("backup disk URI",
+			"",
+			&export.VolumeInfo{Path: "/tmp", BackupURI: "/volume/v1/backup"},
+			"/volume/v1/backup/rootdisk.qcow2",
+		),
 		Entry("VM definition URI",
 			"/manifest",
 			nil,
@@ -295,6 +310,38 @@ var _ = Describe("exportserver", func() {
 		),
 	)
 
+	Context("Backup handler", func() {
+		var mountPoint string
+
+		BeforeEach(func() {
+			mountPoint = GinkgoT().TempDir()
+			Expect(os.WriteFile(filepath.Join(mountPoint, "backup.json"), []byte(`{"name":"backup-1"}`), 0644)).To(Succeed())
+			Expect(os.WriteFile(filepath.Join(mountPoint, "rootdisk.qcow2"), []byte("qcow2 data"), 0644)).To(Succeed())
+			Expect(os.WriteFile(filepath.Join(mountPoint, "other.txt"), []byte("other"), 0644)).To(Succeed())
+		})
+
+		DescribeTable("should serve", func(uri string, expectedStatus int, expectedBody string) {
+			httpServer := httptest.NewServer(backupHandler("/volume/v1/backup", mountPoint))
+			defer httpServer.Close()
+
+			res, err := http.Get(httpServer.URL + uri)
+			Expect(err).ToNot(HaveOccurred())
+			defer res.Body.Close()
+			Expect(res.StatusCode).To(Equal(expectedStatus))
+			if expectedBody != "" {
+				out, err := io.ReadAll(res.Body)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(string(out)).To(Equal(expectedBody))
+			}
+		},
+			Entry("the manifest on the base URI", "/volume/v1/backup", http.StatusOK, `{"name":"backup-1"}`),
+			Entry("a disk image", "/volume/v1/backup/rootdisk.qcow2", http.StatusOK, "qcow2 data"),
+			Entry("not found for a missing disk image", "/volume/v1/backup/datadisk.raw", http.StatusNotFound, ""),
+			Entry("not found for files which are not part of a backup", "/volume/v1/backup/other.txt", http.StatusNotFound, ""),
+			Entry("not found for nested paths", "/volume/v1/backup/sub/rootdisk.qcow2", http.StatusNotFound, ""),
+		)
+	})
+
 	Context("Vm handler", func() {
 		var (
 			orgGetExportName       = getExportName
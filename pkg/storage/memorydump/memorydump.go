@@ -20,20 +20,14 @@
 package memorydump
 
 import (
-	"context"
-	"fmt"
-
 	k8score "k8s.io/api/core/v1"
-	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
-	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/client-go/tools/cache"
 
 	v1 "kubevirt.io/api/core/v1"
 	"kubevirt.io/client-go/kubecli"
 	"kubevirt.io/client-go/log"
 
-	"kubevirt.io/kubevirt/pkg/apimachinery/patch"
-	storagetypes "kubevirt.io/kubevirt/pkg/storage/types"
+	storageutils "kubevirt.io/kubevirt/pkg/storage/utils"
 )
 
 const (
@@ -46,14 +40,7 @@ func HasCompleted(vm *v1.VirtualMachine) bool {
 }
 
 func RemoveMemoryDumpVolumeFromVMISpec(vmiSpec *v1.VirtualMachineInstanceSpec, claimName string) *v1.VirtualMachineInstanceSpec {
-	newVolumesList := []v1.Volume{}
-	for _, volume := range vmiSpec.Volumes {
-		if volume.Name != claimName {
-			newVolumesList = append(newVolumesList, volume)
-		}
-	}
-	vmiSpec.Volumes = newVolumesList
-	return vmiSpec
+	return storageutils.RemoveVolumeFromVMISpec(vmiSpec, claimName)
 }
 
 func HandleRequest(client kubecli.KubevirtClient, vm *v1.VirtualMachine, vmi *v1.VirtualMachineInstance, pvcStore cache.Store) error {
@@ -75,7 +62,7 @@ func HandleRequest(client kubecli.KubevirtClient, vm *v1.VirtualMachine, vmi *v1
 		// When in state associating we want to add the memory dump pvc
 		// as a volume in the vm and in the vmi to trigger the mount
 		// to virt launcher and the memory dump
-		vm.Spec.Template.Spec = *applyMemoryDumpVolumeRequestOnVMISpec(&vm.Spec.Template.Spec, vm.Status.MemoryDumpRequest.ClaimName)
+		vm.Spec.Template.Spec = *applyMemoryDumpVolumeRequestOnVMISpec(&vm.Spec.Template.Spec, vm.Status.MemoryDumpRequest)
 		if _, exists := vmiVolumeMap[vm.Status.MemoryDumpRequest.ClaimName]; exists {
 			return nil
 		}
@@ -197,45 +184,13 @@ func UpdateRequest(vm *v1.VirtualMachine, vmi *v1.VirtualMachineInstance) {
 }
 
 func generateVMIMemoryDumpVolumePatch(client kubecli.KubevirtClient, vmi *v1.VirtualMachineInstance, request *v1.VirtualMachineMemoryDumpRequest, addVolume bool) error {
-	foundRemoveVol := false
-	for _, volume := range vmi.Spec.Volumes {
-		if request.ClaimName == volume.Name {
-			if addVolume {
-				return fmt.Errorf("Unable to add volume [%s] because it already exists", volume.Name)
-			} else {
-				foundRemoveVol = true
-			}
-		}
-	}
-
-	if !foundRemoveVol && !addVolume {
-		return fmt.Errorf("Unable to remove volume [%s] because it does not exist", request.ClaimName)
-	}
-
-	vmiCopy := vmi.DeepCopy()
-	if addVolume {
-		vmiCopy.Spec = *applyMemoryDumpVolumeRequestOnVMISpec(&vmiCopy.Spec, request.ClaimName)
-	} else {
-		vmiCopy.Spec = *RemoveMemoryDumpVolumeFromVMISpec(&vmiCopy.Spec, request.ClaimName)
-	}
-	patchset := patch.New(
-		patch.WithTest("/spec/volumes", vmi.Spec.Volumes),
-	)
-	if len(vmi.Spec.Volumes) > 0 {
-		patchset.AddOption(patch.WithReplace("/spec/volumes", vmiCopy.Spec.Volumes))
-	} else {
-		patchset.AddOption(patch.WithAdd("/spec/volumes", vmiCopy.Spec.Volumes))
-	}
-
-	patchBytes, err := patchset.GeneratePayload()
-	if err != nil {
-		return err
-	}
-	_, err = client.VirtualMachineInstance(vmi.Namespace).Patch(context.Background(), vmi.Name, types.JSONPatchType, patchBytes, metav1.PatchOptions{})
-	return err
+	return storageutils.PatchVMIRequestVolume(client, vmi, request.ClaimName, addVolume, func(vmiSpec *v1.VirtualMachineInstanceSpec) *v1.VirtualMachineInstanceSpec {
+		return applyMemoryDumpVolumeRequestOnVMISpec(vmiSpec, request)
+	})
 }
 
-func applyMemoryDumpVolumeRequestOnVMISpec(vmiSpec *v1.VirtualMachineInstanceSpec, claimName string) *v1.VirtualMachineInstanceSpec {
+func applyMemoryDumpVolumeRequestOnVMISpec(vmiSpec *v1.VirtualMachineInstanceSpec, request *v1.VirtualMachineMemoryDumpRequest) *v1.VirtualMachineInstanceSpec {
+	claimName := request.ClaimName
 	for _, volume := range vmiSpec.Volumes {
 		if volume.Name == claimName {
 			return vmiSpec
@@ -249,6 +204,7 @@ func applyMemoryDumpVolumeRequestOnVMISpec(vmiSpec *v1.VirtualMachineInstanceSpe
 			},
 			Hotpluggable: true,
 		},
+		Format: request.Format,
 	}
 
 	newVolume := v1.Volume{
@@ -263,15 +219,6 @@ func applyMemoryDumpVolumeRequestOnVMISpec(vmiSpec *v1.VirtualMachineInstanceSpe
 
 func patchMemoryDumpPVCAnnotation(client kubecli.KubevirtClient, vm *v1.VirtualMachine, pvcStore cache.Store) error {
 	request := vm.Status.MemoryDumpRequest
-	pvc, err := storagetypes.GetPersistentVolumeClaimFromCache(vm.Namespace, request.ClaimName, pvcStore)
-	if err != nil {
-		log.Log.Object(vm).Errorf("Error getting PersistentVolumeClaim to update memory dump annotation: %v", err)
-		return err
-	}
-	if pvc == nil {
-		log.Log.Object(vm).Errorf("Error getting PersistentVolumeClaim to update memory dump annotation: %v", err)
-		return fmt.Errorf("Error when trying to update memory dump annotation, pvc %s not found", request.ClaimName)
-	}
 
 	var patchVal string
 	switch request.Phase {
@@ -289,24 +236,37 @@ func patchMemoryDumpPVCAnnotation(client kubecli.KubevirtClient, vm *v1.VirtualM
 		return nil
 	}
 
-	annoPatch := patch.New()
-	if len(pvc.Annotations) == 0 {
-		annoPatch.AddOption(patch.WithAdd("/metadata/annotations", map[string]string{v1.PVCMemoryDumpAnnotation: patchVal}))
-	} else if ann, ok := pvc.Annotations[v1.PVCMemoryDumpAnnotation]; ok && ann == patchVal {
-		return nil
-	} else {
-		annoPatch.AddOption(patch.WithReplace("/metadata/annotations/"+patch.EscapeJSONPointer(v1.PVCMemoryDumpAnnotation), patchVal))
-	}
-
-	annoPatchPayload, err := annoPatch.GeneratePayload()
-	if err != nil {
+	if err := storageutils.PatchPVCAnnotation(client, pvcStore, vm.Namespace, request.ClaimName, v1.PVCMemoryDumpAnnotation, patchVal); err != nil {
+		log.Log.Object(vm).Errorf("failed to annotate memory dump PVC %s/%s, error: %v", vm.Namespace, request.ClaimName, err)
 		return err
 	}
 
-	_, err = client.CoreV1().PersistentVolumeClaims(pvc.Namespace).Patch(context.Background(), pvc.Name, types.JSONPatchType, annoPatchPayload, metav1.PatchOptions{})
-	if err != nil {
-		log.Log.Object(vm).Errorf("failed to annotate memory dump PVC %s/%s, error: %s", pvc.Namespace, pvc.Name, err)
+	return nil
+}
+
+// ApplyResumeFromMemoryState hands a pending request to resume from a saved
+// memory state over from the VM to the VMI which is about to be created.
+func ApplyResumeFromMemoryState(vm *v1.VirtualMachine, vmi *v1.VirtualMachineInstance) {
+	claimName, exists := vm.Annotations[v1.ResumeFromMemoryStateAnnotation]
+	if !exists || claimName == "" {
+		return
+	}
+	if vmi.Annotations == nil {
+		vmi.Annotations = map[string]string{}
 	}
+	vmi.Annotations[v1.ResumeFromMemoryStateAnnotation] = claimName
+}
 
-	return nil
+// ClearResumeFromMemoryState drops the request to resume from a saved memory
+// state from the VM once it was handed over to the VMI, so that following
+// starts of the VM boot normally.
+func ClearResumeFromMemoryState(vm *v1.VirtualMachine, vmi *v1.VirtualMachineInstance) {
+	claimName, exists := vm.Annotations[v1.ResumeFromMemoryStateAnnotation]
+	if !exists || vmi == nil {
+		return
+	}
+	if vmi.Annotations[v1.ResumeFromMemoryStateAnnotation] != claimName {
+		return
+	}
+	delete(vm.Annotations, v1.ResumeFromMemoryStateAnnotation)
 }
@@ -203,6 +203,42 @@ var _ = Describe("MemoryDump", func() {
 		Entry("when phase is Unmounting", v1.MemoryDumpUnmounting, targetFileName),
 		Entry("when phase is Failed", v1.MemoryDumpFailed, "Memory dump failed"),
 	)
+
+	Context("resume from memory state", func() {
+		It("should hand the memory state over to the VMI", func() {
+			vm, vmi := createVirtualMachineWithMemoryDump(v1.MemoryDumpCompleted)
+			vm.Annotations = map[string]string{v1.ResumeFromMemoryStateAnnotation: testPVCName}
+
+			ApplyResumeFromMemoryState(vm, vmi)
+			Expect(vmi.Annotations).To(HaveKeyWithValue(v1.ResumeFromMemoryStateAnnotation, testPVCName))
+		})
+
+		It("should not annotate the VMI without a memory state", func() {
+			vm, vmi := createVirtualMachineWithMemoryDump(v1.MemoryDumpCompleted)
+
+			ApplyResumeFromMemoryState(vm, vmi)
+			Expect(vmi.Annotations).ToNot(HaveKey(v1.ResumeFromMemoryStateAnnotation))
+		})
+
+		DescribeTable("should clear the memory state from the VM", func(vmiClaimName string, cleared bool) {
+			vm, vmi := createVirtualMachineWithMemoryDump(v1.MemoryDumpCompleted)
+			vm.Annotations = map[string]string{v1.ResumeFromMemoryStateAnnotation: testPVCName}
+			if vmiClaimName != "" {
+				vmi.Annotations = map[string]string{v1.ResumeFromMemoryStateAnnotation: vmiClaimName}
+			}
+
+			ClearResumeFromMemoryState(vm, vmi)
+			if cleared {
+				Expect(vm.Annotations).ToNot(HaveKey(v1.ResumeFromMemoryStateAnnotation))
+			} else {
+				Expect(vm.Annotations).To(HaveKey(v1.ResumeFromMemoryStateAnnotation))
+			}
+		},
+			Entry("once the VMI resumes from it", testPVCName, true),
+			Entry("not before the VMI resumes from it", "", false),
+			Entry("not if the VMI resumes from another one", "other-claim", false),
+		)
+	})
 })
 
 func ApplyVMIMemoryDumpVol(spec *v1.VirtualMachineInstanceSpec) {
@@ -0,0 +1,469 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package snapshot
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/openshift/library-go/pkg/build/naming"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/util/validation"
+	"k8s.io/client-go/tools/cache"
+
+	"kubevirt.io/api/core"
+	kubevirtv1 "kubevirt.io/api/core/v1"
+	snapshotv1 "kubevirt.io/api/snapshot/v1beta1"
+	"kubevirt.io/client-go/log"
+
+	"kubevirt.io/kubevirt/pkg/controller"
+	"kubevirt.io/kubevirt/pkg/pointer"
+)
+
+const (
+	vmSnapshotGroupCreateEvent = "SuccessfulVirtualMachineSnapshotCreate"
+
+	vmSnapshotGroupCreateErrorEvent = "VirtualMachineSnapshotCreateError"
+
+	vmSnapshotGroupFreezeEvent = "SuccessfulFreeze"
+
+	vmSnapshotGroupFreezeErrorEvent = "FreezeError"
+
+	vmSnapshotGroupThawEvent = "SuccessfulThaw"
+
+	vmSnapshotGroupThawErrorEvent = "ThawError"
+
+	noGroupMembersError = "no VirtualMachines match the selector"
+)
+
+func vmSnapshotGroupFailed(group *snapshotv1.VirtualMachineSnapshotGroup) bool {
+	return group.Status != nil && group.Status.Phase == snapshotv1.Failed
+}
+
+func vmSnapshotGroupSucceeded(group *snapshotv1.VirtualMachineSnapshotGroup) bool {
+	return group.Status != nil && group.Status.Phase == snapshotv1.Succeeded
+}
+
+func vmSnapshotGroupFrozen(group *snapshotv1.VirtualMachineSnapshotGroup) bool {
+	return group.Status != nil && group.Status.FreezeTime != nil && group.Status.ThawTime == nil
+}
+
+func getGroupFailureDeadline(group *snapshotv1.VirtualMachineSnapshotGroup) time.Duration {
+	failureDeadline := snapshotv1.DefaultFailureDeadline
+	if group.Spec.FailureDeadline != nil {
+		failureDeadline = group.Spec.FailureDeadline.Duration
+	}
+
+	return failureDeadline
+}
+
+func timeUntilGroupDeadline(group *snapshotv1.VirtualMachineSnapshotGroup) time.Duration {
+	failureDeadline := getGroupFailureDeadline(group)
+	// No Deadline set by user
+	if failureDeadline == 0 {
+		return failureDeadline
+	}
+	deadline := group.CreationTimestamp.Add(failureDeadline)
+	return time.Until(deadline)
+}
+
+func vmSnapshotGroupDeadlineExceeded(group *snapshotv1.VirtualMachineSnapshotGroup) bool {
+	if vmSnapshotGroupSucceeded(group) || getGroupFailureDeadline(group) == 0 {
+		return false
+	}
+	return timeUntilGroupDeadline(group) < 0
+}
+
+func getGroupMemberSnapshotName(group *snapshotv1.VirtualMachineSnapshotGroup, vmName string) string {
+	return naming.GetName(group.Name, vmName, validation.DNS1123SubdomainMaxLength)
+}
+
+func (ctrl *VMSnapshotGroupController) updateVMSnapshotGroup(group *snapshotv1.VirtualMachineSnapshotGroup) (time.Duration, error) {
+	log.Log.V(3).Infof("Updating VirtualMachineSnapshotGroup %s/%s", group.Namespace, group.Name)
+
+	// The member snapshots are owned by the group, deleting them
+	// unfreezes their source if it is still frozen
+	if group.DeletionTimestamp != nil || vmSnapshotGroupFailed(group) {
+		return 0, nil
+	}
+
+	groupCpy := group.DeepCopy()
+	if groupCpy.Status == nil {
+		groupCpy.Status = &snapshotv1.VirtualMachineSnapshotGroupStatus{
+			ReadyToUse: pointer.P(false),
+		}
+	}
+
+	// Members are resolved only once so that a changing selector
+	// never leaves a group with partial snapshots
+	if len(groupCpy.Status.Members) == 0 {
+		vms, err := ctrl.getGroupVMs(group)
+		if err != nil {
+			return 0, err
+		}
+		if len(vms) == 0 {
+			failVMSnapshotGroup(groupCpy, noGroupMembersError)
+			return 0, ctrl.updateVMSnapshotGroupStatus(group, groupCpy)
+		}
+
+		for _, vm := range vms {
+			groupCpy.Status.Members = append(groupCpy.Status.Members, snapshotv1.VirtualMachineSnapshotGroupMember{
+				VirtualMachineName:         vm.Name,
+				VirtualMachineSnapshotName: getGroupMemberSnapshotName(group, vm.Name),
+			})
+		}
+		groupCpy.Status.Phase = snapshotv1.InProgress
+		groupCpy.Status.Conditions = updateCondition(groupCpy.Status.Conditions, newProgressingCondition(corev1.ConditionTrue, "Creating member snapshots"), true)
+		groupCpy.Status.Conditions = updateCondition(groupCpy.Status.Conditions, newReadyCondition(corev1.ConditionFalse, "Not ready"), true)
+		return 0, ctrl.updateVMSnapshotGroupStatus(group, groupCpy)
+	}
+
+	snapshots, err := ctrl.getOrCreateMemberSnapshots(groupCpy)
+	if err != nil {
+		return 0, err
+	}
+
+	if vmSnapshotGroupDeadlineExceeded(group) {
+		ctrl.thawFrozenMembers(groupCpy)
+		failVMSnapshotGroup(groupCpy, vmSnapshotDeadlineExceededError)
+		return 0, ctrl.updateVMSnapshotGroupStatus(group, groupCpy)
+	}
+
+	for _, vmSnapshot := range snapshots {
+		if vmSnapshotFailed(vmSnapshot) {
+			message := fmt.Sprintf("VirtualMachineSnapshot %s failed", vmSnapshot.Name)
+			if e := vmSnapshotError(vmSnapshot); e != nil && e.Message != nil {
+				message = fmt.Sprintf("%s: %s", message, *e.Message)
+			}
+			ctrl.thawFrozenMembers(groupCpy)
+			failVMSnapshotGroup(groupCpy, message)
+			return 0, ctrl.updateVMSnapshotGroupStatus(group, groupCpy)
+		}
+	}
+
+	// wait for the created snapshots to show up in the cache
+	if len(snapshots) < len(groupCpy.Status.Members) {
+		return 0, ctrl.updateVMSnapshotGroupStatus(group, groupCpy)
+	}
+
+	var retry time.Duration
+	switch {
+	case groupCpy.Status.FreezeTime == nil:
+		// every member VM has to be locked by its snapshot before freezing
+		exist, err := ctrl.memberContentsExist(snapshots)
+		if err != nil {
+			return 0, err
+		}
+		if !exist {
+			break
+		}
+
+		if err := ctrl.freezeMembers(groupCpy); err != nil {
+			groupCpy.Status.Error = &snapshotv1.Error{
+				Time:    currentTime(),
+				Message: pointer.P(err.Error()),
+			}
+			retry = snapshotRetryInterval
+			break
+		}
+		groupCpy.Status.Error = nil
+		groupCpy.Status.FreezeTime = currentTime()
+		groupCpy.Status.Conditions = updateCondition(groupCpy.Status.Conditions, newProgressingCondition(corev1.ConditionTrue, "Members frozen"), true)
+	case groupCpy.Status.ThawTime == nil:
+		if !allMemberSnapshotsCreated(snapshots) {
+			break
+		}
+
+		if err := ctrl.thawMembers(groupCpy); err != nil {
+			groupCpy.Status.Error = &snapshotv1.Error{
+				Time:    currentTime(),
+				Message: pointer.P(err.Error()),
+			}
+			retry = snapshotRetryInterval
+			break
+		}
+		groupCpy.Status.Error = nil
+		groupCpy.Status.ThawTime = currentTime()
+		groupCpy.Status.Conditions = updateCondition(groupCpy.Status.Conditions, newProgressingCondition(corev1.ConditionTrue, "Members thawed"), true)
+	default:
+		if !allMemberSnapshotsSucceeded(snapshots) {
+			break
+		}
+
+		groupCpy.Status.Phase = snapshotv1.Succeeded
+		if groupCpy.Status.CreationTime == nil {
+			groupCpy.Status.CreationTime = currentTime()
+		}
+		groupCpy.Status.ReadyToUse = pointer.P(allMemberSnapshotsReady(snapshots))
+		groupCpy.Status.Conditions = updateCondition(groupCpy.Status.Conditions, newProgressingCondition(corev1.ConditionFalse, "Operation complete"), true)
+		groupCpy.Status.Conditions = updateCondition(groupCpy.Status.Conditions, newReadyCondition(corev1.ConditionTrue, "Operation complete"), true)
+	}
+
+	if err := ctrl.updateVMSnapshotGroupStatus(group, groupCpy); err != nil {
+		return 0, err
+	}
+
+	if retry == 0 && !vmSnapshotGroupSucceeded(groupCpy) {
+		retry = timeUntilGroupDeadline(groupCpy)
+	}
+
+	return retry, nil
+}
+
+func failVMSnapshotGroup(group *snapshotv1.VirtualMachineSnapshotGroup, message string) {
+	group.Status.Phase = snapshotv1.Failed
+	group.Status.ReadyToUse = pointer.P(false)
+	group.Status.Error = &snapshotv1.Error{
+		Time:    currentTime(),
+		Message: pointer.P(message),
+	}
+	group.Status.Conditions = updateCondition(group.Status.Conditions, newProgressingCondition(corev1.ConditionFalse, message), true)
+	group.Status.Conditions = updateCondition(group.Status.Conditions, newFailureCondition(corev1.ConditionTrue, message), true)
+	group.Status.Conditions = updateCondition(group.Status.Conditions, newReadyCondition(corev1.ConditionFalse, "Operation failed"), true)
+}
+
+func (ctrl *VMSnapshotGroupController) updateVMSnapshotGroupStatus(group, groupCpy *snapshotv1.VirtualMachineSnapshotGroup) error {
+	if equality.Semantic.DeepEqual(group.Status, groupCpy.Status) {
+		return nil
+	}
+
+	_, err := ctrl.Client.VirtualMachineSnapshotGroup(groupCpy.Namespace).UpdateStatus(context.Background(), groupCpy, metav1.UpdateOptions{})
+	return err
+}
+
+func (ctrl *VMSnapshotGroupController) getGroupVMs(group *snapshotv1.VirtualMachineSnapshotGroup) ([]*kubevirtv1.VirtualMachine, error) {
+	selector, err := metav1.LabelSelectorAsSelector(&group.Spec.Selector)
+	if err != nil {
+		return nil, err
+	}
+
+	objs, err := ctrl.VMInformer.GetIndexer().ByIndex(cache.NamespaceIndex, group.Namespace)
+	if err != nil {
+		return nil, err
+	}
+
+	var vms []*kubevirtv1.VirtualMachine
+	for _, obj := range objs {
+		vm, ok := obj.(*kubevirtv1.VirtualMachine)
+		if !ok {
+			return nil, fmt.Errorf(unexpectedResourceFmt, obj)
+		}
+		if vm.DeletionTimestamp != nil || !selector.Matches(labels.Set(vm.Labels)) {
+			continue
+		}
+		vms = append(vms, vm)
+	}
+
+	sort.Slice(vms, func(i, j int) bool {
+		return vms[i].Name < vms[j].Name
+	})
+
+	return vms, nil
+}
+
+func (ctrl *VMSnapshotGroupController) getOrCreateMemberSnapshots(group *snapshotv1.VirtualMachineSnapshotGroup) ([]*snapshotv1.VirtualMachineSnapshot, error) {
+	var snapshots []*snapshotv1.VirtualMachineSnapshot
+	for _, member := range group.Status.Members {
+		obj, exists, err := ctrl.VMSnapshotInformer.GetStore().GetByKey(cacheKeyFunc(group.Namespace, member.VirtualMachineSnapshotName))
+		if err != nil {
+			return nil, err
+		}
+		if exists {
+			vmSnapshot, ok := obj.(*snapshotv1.VirtualMachineSnapshot)
+			if !ok {
+				return nil, fmt.Errorf(unexpectedResourceFmt, obj)
+			}
+			snapshots = append(snapshots, vmSnapshot)
+			continue
+		}
+
+		vmSnapshot, err := ctrl.Client.VirtualMachineSnapshot(group.Namespace).Create(context.Background(), newGroupMemberSnapshot(group, member), metav1.CreateOptions{})
+		if k8serrors.IsAlreadyExists(err) {
+			continue
+		}
+		if err != nil {
+			ctrl.Recorder.Eventf(
+				group,
+				corev1.EventTypeWarning,
+				vmSnapshotGroupCreateErrorEvent,
+				"Error creating VirtualMachineSnapshot of VirtualMachine %s: %v",
+				member.VirtualMachineName,
+				err,
+			)
+			return nil, fmt.Errorf("failed to create VirtualMachineSnapshot of VirtualMachine %s: %v", member.VirtualMachineName, err)
+		}
+
+		ctrl.Recorder.Eventf(
+			group,
+			corev1.EventTypeNormal,
+			vmSnapshotGroupCreateEvent,
+			"Successfully created VirtualMachineSnapshot %s",
+			vmSnapshot.Name,
+		)
+		snapshots = append(snapshots, vmSnapshot)
+	}
+
+	return snapshots, nil
+}
+
+func newGroupMemberSnapshot(group *snapshotv1.VirtualMachineSnapshotGroup, member snapshotv1.VirtualMachineSnapshotGroupMember) *snapshotv1.VirtualMachineSnapshot {
+	return &snapshotv1.VirtualMachineSnapshot{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      member.VirtualMachineSnapshotName,
+			Namespace: group.Namespace,
+			Labels: map[string]string{
+				snapshotv1.SnapshotGroupLabel: group.Name,
+			},
+			OwnerReferences: []metav1.OwnerReference{
+				*metav1.NewControllerRef(group, snapshotv1.SchemeGroupVersion.WithKind("VirtualMachineSnapshotGroup")),
+			},
+		},
+		Spec: snapshotv1.VirtualMachineSnapshotSpec{
+			Source: corev1.TypedLocalObjectReference{
+				APIGroup: pointer.P(core.GroupName),
+				Kind:     "VirtualMachine",
+				Name:     member.VirtualMachineName,
+			},
+			DeletionPolicy:  group.Spec.DeletionPolicy,
+			FailureDeadline: group.Spec.FailureDeadline,
+		},
+	}
+}
+
+func (ctrl *VMSnapshotGroupController) memberContentsExist(snapshots []*snapshotv1.VirtualMachineSnapshot) (bool, error) {
+	for _, vmSnapshot := range snapshots {
+		_, exists, err := ctrl.VMSnapshotContentInformer.GetStore().GetByKey(cacheKeyFunc(vmSnapshot.Namespace, GetVMSnapshotContentName(vmSnapshot)))
+		if err != nil || !exists {
+			return false, err
+		}
+	}
+
+	return true, nil
+}
+
+func allMemberSnapshotsCreated(snapshots []*snapshotv1.VirtualMachineSnapshot) bool {
+	for _, vmSnapshot := range snapshots {
+		if vmSnapshot.Status == nil || vmSnapshot.Status.CreationTime == nil {
+			return false
+		}
+	}
+	return true
+}
+
+func allMemberSnapshotsSucceeded(snapshots []*snapshotv1.VirtualMachineSnapshot) bool {
+	for _, vmSnapshot := range snapshots {
+		if !vmSnapshotSucceeded(vmSnapshot) {
+			return false
+		}
+	}
+	return true
+}
+
+func allMemberSnapshotsReady(snapshots []*snapshotv1.VirtualMachineSnapshot) bool {
+	for _, vmSnapshot := range snapshots {
+		if !VmSnapshotReady(vmSnapshot) {
+			return false
+		}
+	}
+	return true
+}
+
+// getFreezableVMIs returns the running VMIs of the members with a connected guest agent,
+// the file systems of all other members can't be frozen
+func (ctrl *VMSnapshotGroupController) getFreezableVMIs(group *snapshotv1.VirtualMachineSnapshotGroup) ([]*kubevirtv1.VirtualMachineInstance, error) {
+	condManager := controller.NewVirtualMachineInstanceConditionManager()
+	var vmis []*kubevirtv1.VirtualMachineInstance
+	for _, member := range group.Status.Members {
+		obj, exists, err := ctrl.VMIInformer.GetStore().GetByKey(cacheKeyFunc(group.Namespace, member.VirtualMachineName))
+		if err != nil {
+			return nil, err
+		}
+		if !exists {
+			continue
+		}
+		vmi := obj.(*kubevirtv1.VirtualMachineInstance)
+		if condManager.HasCondition(vmi, kubevirtv1.VirtualMachineInstanceAgentConnected) {
+			vmis = append(vmis, vmi)
+		}
+	}
+
+	return vmis, nil
+}
+
+// freezeMembers freezes all members or none of them
+func (ctrl *VMSnapshotGroupController) freezeMembers(group *snapshotv1.VirtualMachineSnapshotGroup) error {
+	vmis, err := ctrl.getFreezableVMIs(group)
+	if err != nil {
+		return err
+	}
+
+	for i, vmi := range vmis {
+		err := ctrl.Client.VirtualMachineInstance(vmi.Namespace).Freeze(context.Background(), vmi.Name, getGroupFailureDeadline(group))
+		if err != nil {
+			ctrl.Recorder.Eventf(group, corev1.EventTypeWarning, vmSnapshotGroupFreezeErrorEvent, "Error freezing VirtualMachineInstance %s: %v", vmi.Name, err)
+			for _, frozen := range vmis[:i] {
+				if err := ctrl.Client.VirtualMachineInstance(frozen.Namespace).Unfreeze(context.Background(), frozen.Name); err != nil {
+					log.Log.Object(frozen).Reason(err).Error("Failed to unfreeze after failed group freeze")
+				}
+			}
+			return fmt.Errorf("failed to freeze VirtualMachineInstance %s: %v", vmi.Name, err)
+		}
+	}
+
+	ctrl.Recorder.Eventf(group, corev1.EventTypeNormal, vmSnapshotGroupFreezeEvent, "Successfully froze %d VirtualMachineInstances", len(vmis))
+	return nil
+}
+
+func (ctrl *VMSnapshotGroupController) thawMembers(group *snapshotv1.VirtualMachineSnapshotGroup) error {
+	vmis, err := ctrl.getFreezableVMIs(group)
+	if err != nil {
+		return err
+	}
+
+	for _, vmi := range vmis {
+		err := ctrl.Client.VirtualMachineInstance(vmi.Namespace).Unfreeze(context.Background(), vmi.Name)
+		if err != nil {
+			ctrl.Recorder.Eventf(group, corev1.EventTypeWarning, vmSnapshotGroupThawErrorEvent, "Error thawing VirtualMachineInstance %s: %v", vmi.Name, err)
+			return fmt.Errorf("failed to thaw VirtualMachineInstance %s: %v", vmi.Name, err)
+		}
+	}
+
+	ctrl.Recorder.Eventf(group, corev1.EventTypeNormal, vmSnapshotGroupThawEvent, "Successfully thawed %d VirtualMachineInstances", len(vmis))
+	return nil
+}
+
+// thawFrozenMembers is best effort, the guests thaw themselves once the failure deadline passed
+func (ctrl *VMSnapshotGroupController) thawFrozenMembers(group *snapshotv1.VirtualMachineSnapshotGroup) {
+	if !vmSnapshotGroupFrozen(group) {
+		return
+	}
+
+	if err := ctrl.thawMembers(group); err != nil {
+		log.Log.Object(group).Reason(err).Error("Failed to thaw members of failed snapshot group")
+		return
+	}
+	group.Status.ThawTime = currentTime()
+}
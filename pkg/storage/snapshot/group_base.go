@@ -0,0 +1,297 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package snapshot
+
+import (
+	"fmt"
+	"time"
+
+	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/client-go/util/workqueue"
+
+	snapshotv1 "kubevirt.io/api/snapshot/v1beta1"
+	"kubevirt.io/client-go/kubecli"
+	"kubevirt.io/client-go/log"
+
+	watchutil "kubevirt.io/kubevirt/pkg/virt-controller/watch/util"
+)
+
+// VMSnapshotGroupController is responsible for snapshotting and restoring groups of VMs consistently
+type VMSnapshotGroupController struct {
+	Client kubecli.KubevirtClient
+
+	VMSnapshotGroupInformer   cache.SharedIndexInformer
+	VMRestoreGroupInformer    cache.SharedIndexInformer
+	VMSnapshotInformer        cache.SharedIndexInformer
+	VMSnapshotContentInformer cache.SharedIndexInformer
+	VMRestoreInformer         cache.SharedIndexInformer
+	VMInformer                cache.SharedIndexInformer
+	VMIInformer               cache.SharedIndexInformer
+
+	Recorder record.EventRecorder
+
+	vmSnapshotGroupQueue workqueue.TypedRateLimitingInterface[string]
+	vmRestoreGroupQueue  workqueue.TypedRateLimitingInterface[string]
+}
+
+// Init initializes the snapshot group controller
+func (ctrl *VMSnapshotGroupController) Init() error {
+	ctrl.vmSnapshotGroupQueue = workqueue.NewTypedRateLimitingQueueWithConfig[string](
+		workqueue.DefaultTypedControllerRateLimiter[string](),
+		workqueue.TypedRateLimitingQueueConfig[string]{Name: "virt-controller-snapshot-group"},
+	)
+	ctrl.vmRestoreGroupQueue = workqueue.NewTypedRateLimitingQueueWithConfig[string](
+		workqueue.DefaultTypedControllerRateLimiter[string](),
+		workqueue.TypedRateLimitingQueueConfig[string]{Name: "virt-controller-restore-group"},
+	)
+
+	_, err := ctrl.VMSnapshotGroupInformer.AddEventHandler(
+		cache.ResourceEventHandlerFuncs{
+			AddFunc:    ctrl.handleVMSnapshotGroup,
+			UpdateFunc: func(oldObj, newObj interface{}) { ctrl.handleVMSnapshotGroup(newObj) },
+			DeleteFunc: ctrl.handleVMSnapshotGroup,
+		},
+	)
+	if err != nil {
+		return err
+	}
+
+	_, err = ctrl.VMRestoreGroupInformer.AddEventHandler(
+		cache.ResourceEventHandlerFuncs{
+			AddFunc:    ctrl.handleVMRestoreGroup,
+			UpdateFunc: func(oldObj, newObj interface{}) { ctrl.handleVMRestoreGroup(newObj) },
+			DeleteFunc: ctrl.handleVMRestoreGroup,
+		},
+	)
+	if err != nil {
+		return err
+	}
+
+	_, err = ctrl.VMSnapshotInformer.AddEventHandler(
+		cache.ResourceEventHandlerFuncs{
+			AddFunc:    ctrl.handleVMSnapshot,
+			UpdateFunc: func(oldObj, newObj interface{}) { ctrl.handleVMSnapshot(newObj) },
+			DeleteFunc: ctrl.handleVMSnapshot,
+		},
+	)
+	if err != nil {
+		return err
+	}
+
+	_, err = ctrl.VMSnapshotContentInformer.AddEventHandler(
+		cache.ResourceEventHandlerFuncs{
+			AddFunc:    ctrl.handleVMSnapshotContent,
+			UpdateFunc: func(oldObj, newObj interface{}) { ctrl.handleVMSnapshotContent(newObj) },
+			DeleteFunc: ctrl.handleVMSnapshotContent,
+		},
+	)
+	if err != nil {
+		return err
+	}
+
+	_, err = ctrl.VMRestoreInformer.AddEventHandler(
+		cache.ResourceEventHandlerFuncs{
+			AddFunc:    ctrl.handleVMRestore,
+			UpdateFunc: func(oldObj, newObj interface{}) { ctrl.handleVMRestore(newObj) },
+			DeleteFunc: ctrl.handleVMRestore,
+		},
+	)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// Run the controller
+func (ctrl *VMSnapshotGroupController) Run(threadiness int, stopCh <-chan struct{}) error {
+	defer utilruntime.HandleCrash()
+	defer ctrl.vmSnapshotGroupQueue.ShutDown()
+	defer ctrl.vmRestoreGroupQueue.ShutDown()
+
+	log.Log.Info("Starting snapshot group controller.")
+	defer log.Log.Info("Shutting down snapshot group controller.")
+
+	if !cache.WaitForCacheSync(
+		stopCh,
+		ctrl.VMSnapshotGroupInformer.HasSynced,
+		ctrl.VMRestoreGroupInformer.HasSynced,
+		ctrl.VMSnapshotInformer.HasSynced,
+		ctrl.VMSnapshotContentInformer.HasSynced,
+		ctrl.VMRestoreInformer.HasSynced,
+		ctrl.VMInformer.HasSynced,
+		ctrl.VMIInformer.HasSynced,
+	) {
+		return fmt.Errorf("failed to wait for caches to sync")
+	}
+
+	for i := 0; i < threadiness; i++ {
+		go wait.Until(ctrl.vmSnapshotGroupWorker, time.Second, stopCh)
+		go wait.Until(ctrl.vmRestoreGroupWorker, time.Second, stopCh)
+	}
+
+	<-stopCh
+
+	return nil
+}
+
+func (ctrl *VMSnapshotGroupController) vmSnapshotGroupWorker() {
+	for ctrl.processVMSnapshotGroupWorkItem() {
+	}
+}
+
+func (ctrl *VMSnapshotGroupController) vmRestoreGroupWorker() {
+	for ctrl.processVMRestoreGroupWorkItem() {
+	}
+}
+
+func (ctrl *VMSnapshotGroupController) processVMSnapshotGroupWorkItem() bool {
+	return watchutil.ProcessWorkItem(ctrl.vmSnapshotGroupQueue, func(key string) (time.Duration, error) {
+		log.Log.V(3).Infof("vmSnapshotGroup worker processing key [%s]", key)
+
+		storeObj, exists, err := ctrl.VMSnapshotGroupInformer.GetStore().GetByKey(key)
+		if !exists || err != nil {
+			return 0, err
+		}
+
+		group, ok := storeObj.(*snapshotv1.VirtualMachineSnapshotGroup)
+		if !ok {
+			return 0, fmt.Errorf(unexpectedResourceFmt, storeObj)
+		}
+
+		return ctrl.updateVMSnapshotGroup(group.DeepCopy())
+	})
+}
+
+func (ctrl *VMSnapshotGroupController) processVMRestoreGroupWorkItem() bool {
+	return watchutil.ProcessWorkItem(ctrl.vmRestoreGroupQueue, func(key string) (time.Duration, error) {
+		log.Log.V(3).Infof("vmRestoreGroup worker processing key [%s]", key)
+
+		storeObj, exists, err := ctrl.VMRestoreGroupInformer.GetStore().GetByKey(key)
+		if !exists || err != nil {
+			return 0, err
+		}
+
+		restoreGroup, ok := storeObj.(*snapshotv1.VirtualMachineRestoreGroup)
+		if !ok {
+			return 0, fmt.Errorf(unexpectedResourceFmt, storeObj)
+		}
+
+		return ctrl.updateVMRestoreGroup(restoreGroup.DeepCopy())
+	})
+}
+
+func (ctrl *VMSnapshotGroupController) handleVMSnapshotGroup(obj interface{}) {
+	if unknown, ok := obj.(cache.DeletedFinalStateUnknown); ok && unknown.Obj != nil {
+		obj = unknown.Obj
+	}
+
+	if group, ok := obj.(*snapshotv1.VirtualMachineSnapshotGroup); ok {
+		objName, err := cache.DeletionHandlingMetaNamespaceKeyFunc(group)
+		if err != nil {
+			log.Log.Errorf(failedKeyFromObjectFmt, err, group)
+			return
+		}
+
+		log.Log.V(3).Infof(enqueuedForSyncFmt, objName)
+		ctrl.vmSnapshotGroupQueue.Add(objName)
+
+		// restore groups wait for the snapshot group to succeed
+		for _, obj := range ctrl.VMRestoreGroupInformer.GetStore().List() {
+			restoreGroup, ok := obj.(*snapshotv1.VirtualMachineRestoreGroup)
+			if ok && restoreGroup.Namespace == group.Namespace && restoreGroup.Spec.VirtualMachineSnapshotGroupName == group.Name {
+				ctrl.handleVMRestoreGroup(restoreGroup)
+			}
+		}
+	}
+}
+
+func (ctrl *VMSnapshotGroupController) handleVMRestoreGroup(obj interface{}) {
+	if unknown, ok := obj.(cache.DeletedFinalStateUnknown); ok && unknown.Obj != nil {
+		obj = unknown.Obj
+	}
+
+	if restoreGroup, ok := obj.(*snapshotv1.VirtualMachineRestoreGroup); ok {
+		objName, err := cache.DeletionHandlingMetaNamespaceKeyFunc(restoreGroup)
+		if err != nil {
+			log.Log.Errorf(failedKeyFromObjectFmt, err, restoreGroup)
+			return
+		}
+
+		log.Log.V(3).Infof(enqueuedForSyncFmt, objName)
+		ctrl.vmRestoreGroupQueue.Add(objName)
+	}
+}
+
+func (ctrl *VMSnapshotGroupController) handleVMSnapshot(obj interface{}) {
+	if unknown, ok := obj.(cache.DeletedFinalStateUnknown); ok && unknown.Obj != nil {
+		obj = unknown.Obj
+	}
+
+	if vmSnapshot, ok := obj.(*snapshotv1.VirtualMachineSnapshot); ok {
+		groupName, ok := vmSnapshot.Labels[snapshotv1.SnapshotGroupLabel]
+		if !ok {
+			return
+		}
+
+		k := cacheKeyFunc(vmSnapshot.Namespace, groupName)
+		log.Log.V(3).Infof("Handling VMSnapshot %s/%s, group %s", vmSnapshot.Namespace, vmSnapshot.Name, k)
+		ctrl.vmSnapshotGroupQueue.Add(k)
+	}
+}
+
+func (ctrl *VMSnapshotGroupController) handleVMSnapshotContent(obj interface{}) {
+	if unknown, ok := obj.(cache.DeletedFinalStateUnknown); ok && unknown.Obj != nil {
+		obj = unknown.Obj
+	}
+
+	if content, ok := obj.(*snapshotv1.VirtualMachineSnapshotContent); ok {
+		if content.Spec.VirtualMachineSnapshotName == nil {
+			return
+		}
+
+		storeObj, exists, err := ctrl.VMSnapshotInformer.GetStore().GetByKey(cacheKeyFunc(content.Namespace, *content.Spec.VirtualMachineSnapshotName))
+		if err != nil || !exists {
+			return
+		}
+
+		ctrl.handleVMSnapshot(storeObj)
+	}
+}
+
+func (ctrl *VMSnapshotGroupController) handleVMRestore(obj interface{}) {
+	if unknown, ok := obj.(cache.DeletedFinalStateUnknown); ok && unknown.Obj != nil {
+		obj = unknown.Obj
+	}
+
+	if vmRestore, ok := obj.(*snapshotv1.VirtualMachineRestore); ok {
+		groupName, ok := vmRestore.Labels[snapshotv1.RestoreGroupLabel]
+		if !ok {
+			return
+		}
+
+		k := cacheKeyFunc(vmRestore.Namespace, groupName)
+		log.Log.V(3).Infof("Handling VMRestore %s/%s, restore group %s", vmRestore.Namespace, vmRestore.Name, k)
+		ctrl.vmRestoreGroupQueue.Add(k)
+	}
+}
@@ -0,0 +1,239 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package snapshot
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/openshift/library-go/pkg/build/naming"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/validation"
+
+	"kubevirt.io/api/core"
+	snapshotv1 "kubevirt.io/api/snapshot/v1beta1"
+	"kubevirt.io/client-go/log"
+
+	"kubevirt.io/kubevirt/pkg/pointer"
+)
+
+const (
+	vmRestoreGroupCreateEvent = "SuccessfulVirtualMachineRestoreCreate"
+
+	vmRestoreGroupCreateErrorEvent = "VirtualMachineRestoreCreateError"
+)
+
+func vmRestoreGroupCompleted(restoreGroup *snapshotv1.VirtualMachineRestoreGroup) bool {
+	return restoreGroup.Status != nil && restoreGroup.Status.Complete != nil && *restoreGroup.Status.Complete
+}
+
+func vmRestoreGroupFailed(restoreGroup *snapshotv1.VirtualMachineRestoreGroup) bool {
+	return restoreGroup.Status != nil && hasConditionType(restoreGroup.Status.Conditions, snapshotv1.ConditionFailure)
+}
+
+func getGroupMemberRestoreName(restoreGroup *snapshotv1.VirtualMachineRestoreGroup, vmName string) string {
+	return naming.GetName(restoreGroup.Name, vmName, validation.DNS1123SubdomainMaxLength)
+}
+
+func (ctrl *VMSnapshotGroupController) updateVMRestoreGroup(restoreGroup *snapshotv1.VirtualMachineRestoreGroup) (time.Duration, error) {
+	log.Log.V(3).Infof("Updating VirtualMachineRestoreGroup %s/%s", restoreGroup.Namespace, restoreGroup.Name)
+
+	if restoreGroup.DeletionTimestamp != nil || vmRestoreGroupCompleted(restoreGroup) || vmRestoreGroupFailed(restoreGroup) {
+		return 0, nil
+	}
+
+	restoreGroupCpy := restoreGroup.DeepCopy()
+	if restoreGroupCpy.Status == nil {
+		restoreGroupCpy.Status = &snapshotv1.VirtualMachineRestoreGroupStatus{
+			Complete: pointer.P(false),
+		}
+	}
+
+	group, err := ctrl.getVMSnapshotGroup(restoreGroup)
+	if err != nil {
+		return 0, err
+	}
+
+	switch {
+	case group == nil:
+		reason := fmt.Sprintf("VirtualMachineSnapshotGroup %s does not exist", restoreGroup.Spec.VirtualMachineSnapshotGroupName)
+		updateRestoreGroupCondition(restoreGroupCpy, newProgressingCondition(corev1.ConditionFalse, reason))
+		updateRestoreGroupCondition(restoreGroupCpy, newReadyCondition(corev1.ConditionFalse, reason))
+		return 0, ctrl.updateVMRestoreGroupStatus(restoreGroup, restoreGroupCpy)
+	case vmSnapshotGroupFailed(group):
+		reason := fmt.Sprintf("VirtualMachineSnapshotGroup %s failed", group.Name)
+		failVMRestoreGroup(restoreGroupCpy, reason)
+		return 0, ctrl.updateVMRestoreGroupStatus(restoreGroup, restoreGroupCpy)
+	case !vmSnapshotGroupSucceeded(group) || group.Status.ReadyToUse == nil || !*group.Status.ReadyToUse:
+		reason := fmt.Sprintf("Waiting for VirtualMachineSnapshotGroup %s to be ready", group.Name)
+		updateRestoreGroupCondition(restoreGroupCpy, newProgressingCondition(corev1.ConditionFalse, reason))
+		updateRestoreGroupCondition(restoreGroupCpy, newReadyCondition(corev1.ConditionFalse, reason))
+		return 0, ctrl.updateVMRestoreGroupStatus(restoreGroup, restoreGroupCpy)
+	}
+
+	if len(restoreGroupCpy.Status.Members) == 0 {
+		for _, member := range group.Status.Members {
+			restoreGroupCpy.Status.Members = append(restoreGroupCpy.Status.Members, snapshotv1.VirtualMachineRestoreGroupMember{
+				VirtualMachineName:        member.VirtualMachineName,
+				VirtualMachineRestoreName: getGroupMemberRestoreName(restoreGroup, member.VirtualMachineName),
+			})
+		}
+	}
+
+	restores, err := ctrl.getOrCreateMemberRestores(restoreGroupCpy, group)
+	if err != nil {
+		return 0, err
+	}
+
+	complete := len(restores) == len(restoreGroupCpy.Status.Members)
+	for _, vmRestore := range restores {
+		if vmRestoreFailed(vmRestore) {
+			failVMRestoreGroup(restoreGroupCpy, fmt.Sprintf("VirtualMachineRestore %s failed", vmRestore.Name))
+			return 0, ctrl.updateVMRestoreGroupStatus(restoreGroup, restoreGroupCpy)
+		}
+		if !vmRestoreCompleted(vmRestore) {
+			complete = false
+		}
+	}
+
+	if complete {
+		restoreGroupCpy.Status.Complete = pointer.P(true)
+		restoreGroupCpy.Status.RestoreTime = currentTime()
+		updateRestoreGroupCondition(restoreGroupCpy, newProgressingCondition(corev1.ConditionFalse, "Operation complete"))
+		updateRestoreGroupCondition(restoreGroupCpy, newReadyCondition(corev1.ConditionTrue, "Operation complete"))
+	} else {
+		updateRestoreGroupCondition(restoreGroupCpy, newProgressingCondition(corev1.ConditionTrue, "Restoring members"))
+		updateRestoreGroupCondition(restoreGroupCpy, newReadyCondition(corev1.ConditionFalse, "Not ready"))
+	}
+
+	return 0, ctrl.updateVMRestoreGroupStatus(restoreGroup, restoreGroupCpy)
+}
+
+func updateRestoreGroupCondition(restoreGroup *snapshotv1.VirtualMachineRestoreGroup, c snapshotv1.Condition) {
+	restoreGroup.Status.Conditions = updateCondition(restoreGroup.Status.Conditions, c, true)
+}
+
+func failVMRestoreGroup(restoreGroup *snapshotv1.VirtualMachineRestoreGroup, reason string) {
+	updateRestoreGroupCondition(restoreGroup, newProgressingCondition(corev1.ConditionFalse, reason))
+	updateRestoreGroupCondition(restoreGroup, newFailureCondition(corev1.ConditionTrue, reason))
+	updateRestoreGroupCondition(restoreGroup, newReadyCondition(corev1.ConditionFalse, "Operation failed"))
+}
+
+func (ctrl *VMSnapshotGroupController) updateVMRestoreGroupStatus(restoreGroup, restoreGroupCpy *snapshotv1.VirtualMachineRestoreGroup) error {
+	if equality.Semantic.DeepEqual(restoreGroup.Status, restoreGroupCpy.Status) {
+		return nil
+	}
+
+	_, err := ctrl.Client.VirtualMachineRestoreGroup(restoreGroupCpy.Namespace).UpdateStatus(context.Background(), restoreGroupCpy, metav1.UpdateOptions{})
+	return err
+}
+
+func (ctrl *VMSnapshotGroupController) getVMSnapshotGroup(restoreGroup *snapshotv1.VirtualMachineRestoreGroup) (*snapshotv1.VirtualMachineSnapshotGroup, error) {
+	obj, exists, err := ctrl.VMSnapshotGroupInformer.GetStore().GetByKey(cacheKeyFunc(restoreGroup.Namespace, restoreGroup.Spec.VirtualMachineSnapshotGroupName))
+	if err != nil || !exists {
+		return nil, err
+	}
+
+	group, ok := obj.(*snapshotv1.VirtualMachineSnapshotGroup)
+	if !ok {
+		return nil, fmt.Errorf(unexpectedResourceFmt, obj)
+	}
+
+	return group, nil
+}
+
+func (ctrl *VMSnapshotGroupController) getOrCreateMemberRestores(restoreGroup *snapshotv1.VirtualMachineRestoreGroup, group *snapshotv1.VirtualMachineSnapshotGroup) ([]*snapshotv1.VirtualMachineRestore, error) {
+	snapshotNames := map[string]string{}
+	for _, member := range group.Status.Members {
+		snapshotNames[member.VirtualMachineName] = member.VirtualMachineSnapshotName
+	}
+
+	var restores []*snapshotv1.VirtualMachineRestore
+	for _, member := range restoreGroup.Status.Members {
+		obj, exists, err := ctrl.VMRestoreInformer.GetStore().GetByKey(cacheKeyFunc(restoreGroup.Namespace, member.VirtualMachineRestoreName))
+		if err != nil {
+			return nil, err
+		}
+		if exists {
+			vmRestore, ok := obj.(*snapshotv1.VirtualMachineRestore)
+			if !ok {
+				return nil, fmt.Errorf(unexpectedResourceFmt, obj)
+			}
+			restores = append(restores, vmRestore)
+			continue
+		}
+
+		vmRestore := newGroupMemberRestore(restoreGroup, member, snapshotNames[member.VirtualMachineName])
+		vmRestore, err = ctrl.Client.VirtualMachineRestore(restoreGroup.Namespace).Create(context.Background(), vmRestore, metav1.CreateOptions{})
+		if k8serrors.IsAlreadyExists(err) {
+			continue
+		}
+		if err != nil {
+			ctrl.Recorder.Eventf(
+				restoreGroup,
+				corev1.EventTypeWarning,
+				vmRestoreGroupCreateErrorEvent,
+				"Error creating VirtualMachineRestore of VirtualMachine %s: %v",
+				member.VirtualMachineName,
+				err,
+			)
+			return nil, fmt.Errorf("failed to create VirtualMachineRestore of VirtualMachine %s: %v", member.VirtualMachineName, err)
+		}
+
+		ctrl.Recorder.Eventf(
+			restoreGroup,
+			corev1.EventTypeNormal,
+			vmRestoreGroupCreateEvent,
+			"Successfully created VirtualMachineRestore %s",
+			vmRestore.Name,
+		)
+		restores = append(restores, vmRestore)
+	}
+
+	return restores, nil
+}
+
+func newGroupMemberRestore(restoreGroup *snapshotv1.VirtualMachineRestoreGroup, member snapshotv1.VirtualMachineRestoreGroupMember, snapshotName string) *snapshotv1.VirtualMachineRestore {
+	return &snapshotv1.VirtualMachineRestore{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      member.VirtualMachineRestoreName,
+			Namespace: restoreGroup.Namespace,
+			Labels: map[string]string{
+				snapshotv1.RestoreGroupLabel: restoreGroup.Name,
+			},
+			OwnerReferences: []metav1.OwnerReference{
+				*metav1.NewControllerRef(restoreGroup, snapshotv1.SchemeGroupVersion.WithKind("VirtualMachineRestoreGroup")),
+			},
+		},
+		Spec: snapshotv1.VirtualMachineRestoreSpec{
+			Target: corev1.TypedLocalObjectReference{
+				APIGroup: pointer.P(core.GroupName),
+				Kind:     "VirtualMachine",
+				Name:     member.VirtualMachineName,
+			},
+			VirtualMachineSnapshotName: snapshotName,
+			TargetReadinessPolicy:      restoreGroup.Spec.TargetReadinessPolicy,
+		},
+	}
+}
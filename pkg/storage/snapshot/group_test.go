@@ -0,0 +1,511 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package snapshot
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/testing"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/client-go/tools/record"
+
+	kubevirtv1 "kubevirt.io/api/core/v1"
+	snapshotv1 "kubevirt.io/api/snapshot/v1beta1"
+	"kubevirt.io/client-go/kubecli"
+	kubevirtfake "kubevirt.io/client-go/kubevirt/fake"
+
+	virtcontroller "kubevirt.io/kubevirt/pkg/controller"
+	"kubevirt.io/kubevirt/pkg/pointer"
+	"kubevirt.io/kubevirt/pkg/testutils"
+)
+
+var _ = Describe("Snapshot group controller", func() {
+	const (
+		testNamespace     = "default"
+		groupName         = "app"
+		restoreGroupName  = "app-restore"
+		memberFreezeRetry = snapshotRetryInterval
+	)
+
+	var (
+		controller     *VMSnapshotGroupController
+		recorder       *record.FakeRecorder
+		kubevirtClient *kubevirtfake.Clientset
+		vmiInterface   *kubecli.MockVirtualMachineInstanceInterface
+
+		vmSnapshotGroupInformer   cache.SharedIndexInformer
+		vmRestoreGroupInformer    cache.SharedIndexInformer
+		vmSnapshotInformer        cache.SharedIndexInformer
+		vmSnapshotContentInformer cache.SharedIndexInformer
+		vmRestoreInformer         cache.SharedIndexInformer
+		vmInformer                cache.SharedIndexInformer
+		vmiInformer               cache.SharedIndexInformer
+
+		createdSnapshots     []*snapshotv1.VirtualMachineSnapshot
+		createdRestores      []*snapshotv1.VirtualMachineRestore
+		updatedGroups        []*snapshotv1.VirtualMachineSnapshotGroupStatus
+		updatedRestoreGroups []*snapshotv1.VirtualMachineRestoreGroupStatus
+	)
+
+	createVM := func(name string, labels map[string]string) *kubevirtv1.VirtualMachine {
+		return &kubevirtv1.VirtualMachine{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      name,
+				Namespace: testNamespace,
+				Labels:    labels,
+			},
+			Spec: kubevirtv1.VirtualMachineSpec{
+				Template: &kubevirtv1.VirtualMachineInstanceTemplateSpec{},
+			},
+		}
+	}
+
+	addVMIWithAgent := func(name string) {
+		vmi := &kubevirtv1.VirtualMachineInstance{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      name,
+				Namespace: testNamespace,
+			},
+			Status: kubevirtv1.VirtualMachineInstanceStatus{
+				Conditions: []kubevirtv1.VirtualMachineInstanceCondition{
+					{
+						Type:   kubevirtv1.VirtualMachineInstanceAgentConnected,
+						Status: corev1.ConditionTrue,
+					},
+				},
+			},
+		}
+		Expect(vmiInformer.GetIndexer().Add(vmi)).To(Succeed())
+	}
+
+	createGroup := func() *snapshotv1.VirtualMachineSnapshotGroup {
+		return &snapshotv1.VirtualMachineSnapshotGroup{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:              groupName,
+				Namespace:         testNamespace,
+				UID:               "group-uid",
+				CreationTimestamp: metav1.Now(),
+			},
+			Spec: snapshotv1.VirtualMachineSnapshotGroupSpec{
+				Selector: metav1.LabelSelector{
+					MatchLabels: map[string]string{"app": "shop"},
+				},
+			},
+		}
+	}
+
+	createGroupWithMembers := func(vmNames ...string) *snapshotv1.VirtualMachineSnapshotGroup {
+		group := createGroup()
+		group.Status = &snapshotv1.VirtualMachineSnapshotGroupStatus{
+			Phase:      snapshotv1.InProgress,
+			ReadyToUse: pointer.P(false),
+		}
+		for _, vmName := range vmNames {
+			group.Status.Members = append(group.Status.Members, snapshotv1.VirtualMachineSnapshotGroupMember{
+				VirtualMachineName:         vmName,
+				VirtualMachineSnapshotName: getGroupMemberSnapshotName(group, vmName),
+			})
+		}
+		return group
+	}
+
+	addMemberSnapshot := func(group *snapshotv1.VirtualMachineSnapshotGroup, member snapshotv1.VirtualMachineSnapshotGroupMember, status *snapshotv1.VirtualMachineSnapshotStatus, withContent bool) {
+		vmSnapshot := newGroupMemberSnapshot(group, member)
+		vmSnapshot.UID = types.UID("uid-" + member.VirtualMachineName)
+		vmSnapshot.Status = status
+		Expect(vmSnapshotInformer.GetIndexer().Add(vmSnapshot)).To(Succeed())
+
+		if withContent {
+			content := &snapshotv1.VirtualMachineSnapshotContent{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      GetVMSnapshotContentName(vmSnapshot),
+					Namespace: testNamespace,
+				},
+				Spec: snapshotv1.VirtualMachineSnapshotContentSpec{
+					VirtualMachineSnapshotName: &vmSnapshot.Name,
+				},
+			}
+			Expect(vmSnapshotContentInformer.GetIndexer().Add(content)).To(Succeed())
+		}
+	}
+
+	lastGroupStatus := func() *snapshotv1.VirtualMachineSnapshotGroupStatus {
+		ExpectWithOffset(1, updatedGroups).ToNot(BeEmpty())
+		return updatedGroups[len(updatedGroups)-1]
+	}
+
+	lastRestoreGroupStatus := func() *snapshotv1.VirtualMachineRestoreGroupStatus {
+		ExpectWithOffset(1, updatedRestoreGroups).ToNot(BeEmpty())
+		return updatedRestoreGroups[len(updatedRestoreGroups)-1]
+	}
+
+	BeforeEach(func() {
+		vmSnapshotGroupInformer, _ = testutils.NewFakeInformerFor(&snapshotv1.VirtualMachineSnapshotGroup{})
+		vmRestoreGroupInformer, _ = testutils.NewFakeInformerFor(&snapshotv1.VirtualMachineRestoreGroup{})
+		vmSnapshotInformer, _ = testutils.NewFakeInformerWithIndexersFor(&snapshotv1.VirtualMachineSnapshot{}, virtcontroller.GetVirtualMachineSnapshotInformerIndexers())
+		vmSnapshotContentInformer, _ = testutils.NewFakeInformerWithIndexersFor(&snapshotv1.VirtualMachineSnapshotContent{}, virtcontroller.GetVirtualMachineSnapshotContentInformerIndexers())
+		vmRestoreInformer, _ = testutils.NewFakeInformerWithIndexersFor(&snapshotv1.VirtualMachineRestore{}, virtcontroller.GetVirtualMachineRestoreInformerIndexers())
+		vmInformer, _ = testutils.NewFakeInformerWithIndexersFor(&kubevirtv1.VirtualMachine{}, virtcontroller.GetVirtualMachineInformerIndexers())
+		vmiInformer, _ = testutils.NewFakeInformerWithIndexersFor(&kubevirtv1.VirtualMachineInstance{}, virtcontroller.GetVMIInformerIndexers())
+
+		recorder = record.NewFakeRecorder(100)
+		recorder.IncludeObject = true
+
+		ctrl := gomock.NewController(GinkgoT())
+		virtClient := kubecli.NewMockKubevirtClient(ctrl)
+		vmiInterface = kubecli.NewMockVirtualMachineInstanceInterface(ctrl)
+		kubevirtClient = kubevirtfake.NewSimpleClientset()
+		virtClient.EXPECT().VirtualMachineInstance(testNamespace).Return(vmiInterface).AnyTimes()
+		virtClient.EXPECT().VirtualMachineSnapshot(testNamespace).
+			Return(kubevirtClient.SnapshotV1beta1().VirtualMachineSnapshots(testNamespace)).AnyTimes()
+		virtClient.EXPECT().VirtualMachineRestore(testNamespace).
+			Return(kubevirtClient.SnapshotV1beta1().VirtualMachineRestores(testNamespace)).AnyTimes()
+		virtClient.EXPECT().VirtualMachineSnapshotGroup(testNamespace).
+			Return(kubevirtClient.SnapshotV1beta1().VirtualMachineSnapshotGroups(testNamespace)).AnyTimes()
+		virtClient.EXPECT().VirtualMachineRestoreGroup(testNamespace).
+			Return(kubevirtClient.SnapshotV1beta1().VirtualMachineRestoreGroups(testNamespace)).AnyTimes()
+
+		createdSnapshots = nil
+		createdRestores = nil
+		updatedGroups = nil
+		updatedRestoreGroups = nil
+		kubevirtClient.Fake.PrependReactor("create", "virtualmachinesnapshots", func(action testing.Action) (handled bool, obj runtime.Object, err error) {
+			vmSnapshot := action.(testing.CreateAction).GetObject().(*snapshotv1.VirtualMachineSnapshot)
+			createdSnapshots = append(createdSnapshots, vmSnapshot)
+			return true, vmSnapshot, nil
+		})
+		kubevirtClient.Fake.PrependReactor("create", "virtualmachinerestores", func(action testing.Action) (handled bool, obj runtime.Object, err error) {
+			vmRestore := action.(testing.CreateAction).GetObject().(*snapshotv1.VirtualMachineRestore)
+			createdRestores = append(createdRestores, vmRestore)
+			return true, vmRestore, nil
+		})
+		kubevirtClient.Fake.PrependReactor("update", "virtualmachinesnapshotgroups", func(action testing.Action) (handled bool, obj runtime.Object, err error) {
+			Expect(action.GetSubresource()).To(Equal("status"))
+			group := action.(testing.UpdateAction).GetObject().(*snapshotv1.VirtualMachineSnapshotGroup)
+			updatedGroups = append(updatedGroups, group.Status)
+			return true, group, nil
+		})
+		kubevirtClient.Fake.PrependReactor("update", "virtualmachinerestoregroups", func(action testing.Action) (handled bool, obj runtime.Object, err error) {
+			Expect(action.GetSubresource()).To(Equal("status"))
+			restoreGroup := action.(testing.UpdateAction).GetObject().(*snapshotv1.VirtualMachineRestoreGroup)
+			updatedRestoreGroups = append(updatedRestoreGroups, restoreGroup.Status)
+			return true, restoreGroup, nil
+		})
+
+		controller = &VMSnapshotGroupController{
+			Client:                    virtClient,
+			VMSnapshotGroupInformer:   vmSnapshotGroupInformer,
+			VMRestoreGroupInformer:    vmRestoreGroupInformer,
+			VMSnapshotInformer:        vmSnapshotInformer,
+			VMSnapshotContentInformer: vmSnapshotContentInformer,
+			VMRestoreInformer:         vmRestoreInformer,
+			VMInformer:                vmInformer,
+			VMIInformer:               vmiInformer,
+			Recorder:                  recorder,
+		}
+		Expect(controller.Init()).To(Succeed())
+	})
+
+	Context("snapshot group", func() {
+		It("should resolve the members once from the selector", func() {
+			Expect(vmInformer.GetIndexer().Add(createVM("web", map[string]string{"app": "shop"}))).To(Succeed())
+			Expect(vmInformer.GetIndexer().Add(createVM("db", map[string]string{"app": "shop"}))).To(Succeed())
+			Expect(vmInformer.GetIndexer().Add(createVM("other", nil))).To(Succeed())
+
+			_, err := controller.updateVMSnapshotGroup(createGroup())
+			Expect(err).ToNot(HaveOccurred())
+
+			status := lastGroupStatus()
+			Expect(status.Phase).To(Equal(snapshotv1.InProgress))
+			Expect(status.Members).To(Equal([]snapshotv1.VirtualMachineSnapshotGroupMember{
+				{VirtualMachineName: "db", VirtualMachineSnapshotName: "app-db"},
+				{VirtualMachineName: "web", VirtualMachineSnapshotName: "app-web"},
+			}))
+			Expect(createdSnapshots).To(BeEmpty())
+		})
+
+		It("should fail when no VM matches the selector", func() {
+			Expect(vmInformer.GetIndexer().Add(createVM("other", nil))).To(Succeed())
+
+			_, err := controller.updateVMSnapshotGroup(createGroup())
+			Expect(err).ToNot(HaveOccurred())
+
+			status := lastGroupStatus()
+			Expect(status.Phase).To(Equal(snapshotv1.Failed))
+			Expect(status.Error.Message).To(HaveValue(Equal(noGroupMembersError)))
+		})
+
+		It("should create a snapshot linked to the group for every member", func() {
+			group := createGroupWithMembers("db", "web")
+			group.Spec.DeletionPolicy = pointer.P(snapshotv1.VirtualMachineSnapshotContentRetain)
+
+			_, err := controller.updateVMSnapshotGroup(group)
+			Expect(err).ToNot(HaveOccurred())
+
+			Expect(createdSnapshots).To(HaveLen(2))
+			for i, vmName := range []string{"db", "web"} {
+				vmSnapshot := createdSnapshots[i]
+				Expect(vmSnapshot.Name).To(Equal("app-" + vmName))
+				Expect(vmSnapshot.Spec.Source.Name).To(Equal(vmName))
+				Expect(vmSnapshot.Spec.DeletionPolicy).To(HaveValue(Equal(snapshotv1.VirtualMachineSnapshotContentRetain)))
+				Expect(vmSnapshot.Labels).To(HaveKeyWithValue(snapshotv1.SnapshotGroupLabel, groupName))
+				Expect(metav1.IsControlledBy(vmSnapshot, group)).To(BeTrue())
+			}
+			testutils.ExpectEvent(recorder, vmSnapshotGroupCreateEvent)
+		})
+
+		It("should not freeze before the contents of all members exist", func() {
+			group := createGroupWithMembers("db", "web")
+			addMemberSnapshot(group, group.Status.Members[0], &snapshotv1.VirtualMachineSnapshotStatus{Phase: snapshotv1.InProgress}, true)
+			addMemberSnapshot(group, group.Status.Members[1], &snapshotv1.VirtualMachineSnapshotStatus{Phase: snapshotv1.InProgress}, false)
+
+			_, err := controller.updateVMSnapshotGroup(group)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(updatedGroups).To(BeEmpty())
+		})
+
+		It("should freeze the members with a guest agent at once", func() {
+			group := createGroupWithMembers("db", "web")
+			for _, member := range group.Status.Members {
+				addMemberSnapshot(group, member, &snapshotv1.VirtualMachineSnapshotStatus{Phase: snapshotv1.InProgress}, true)
+			}
+			addVMIWithAgent("db")
+
+			vmiInterface.EXPECT().Freeze(context.Background(), "db", snapshotv1.DefaultFailureDeadline).Return(nil)
+
+			_, err := controller.updateVMSnapshotGroup(group)
+			Expect(err).ToNot(HaveOccurred())
+
+			status := lastGroupStatus()
+			Expect(status.FreezeTime).ToNot(BeNil())
+			Expect(status.ThawTime).To(BeNil())
+			testutils.ExpectEvent(recorder, vmSnapshotGroupFreezeEvent)
+		})
+
+		It("should thaw the frozen members and retry when freezing fails", func() {
+			group := createGroupWithMembers("db", "web")
+			for _, member := range group.Status.Members {
+				addMemberSnapshot(group, member, &snapshotv1.VirtualMachineSnapshotStatus{Phase: snapshotv1.InProgress}, true)
+				addVMIWithAgent(member.VirtualMachineName)
+			}
+
+			vmiInterface.EXPECT().Freeze(context.Background(), "db", snapshotv1.DefaultFailureDeadline).Return(nil)
+			vmiInterface.EXPECT().Freeze(context.Background(), "web", snapshotv1.DefaultFailureDeadline).Return(fmt.Errorf("agent busy"))
+			vmiInterface.EXPECT().Unfreeze(context.Background(), "db").Return(nil)
+
+			retry, err := controller.updateVMSnapshotGroup(group)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(retry).To(Equal(memberFreezeRetry))
+
+			status := lastGroupStatus()
+			Expect(status.FreezeTime).To(BeNil())
+			Expect(status.Error.Message).To(HaveValue(ContainSubstring("agent busy")))
+			testutils.ExpectEvent(recorder, vmSnapshotGroupFreezeErrorEvent)
+		})
+
+		It("should thaw once the volumes of all members are snapshotted", func() {
+			group := createGroupWithMembers("db", "web")
+			group.Status.FreezeTime = currentTime()
+			addMemberSnapshot(group, group.Status.Members[0], &snapshotv1.VirtualMachineSnapshotStatus{Phase: snapshotv1.Succeeded, CreationTime: currentTime()}, true)
+			addMemberSnapshot(group, group.Status.Members[1], &snapshotv1.VirtualMachineSnapshotStatus{Phase: snapshotv1.InProgress}, true)
+			addVMIWithAgent("db")
+
+			_, err := controller.updateVMSnapshotGroup(group)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(updatedGroups).To(BeEmpty())
+
+			addMemberSnapshot(group, group.Status.Members[1], &snapshotv1.VirtualMachineSnapshotStatus{Phase: snapshotv1.Succeeded, CreationTime: currentTime()}, true)
+			vmiInterface.EXPECT().Unfreeze(context.Background(), "db").Return(nil)
+
+			_, err = controller.updateVMSnapshotGroup(group)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(lastGroupStatus().ThawTime).ToNot(BeNil())
+			testutils.ExpectEvent(recorder, vmSnapshotGroupThawEvent)
+		})
+
+		It("should succeed once all members succeeded", func() {
+			group := createGroupWithMembers("db", "web")
+			group.Status.FreezeTime = currentTime()
+			group.Status.ThawTime = currentTime()
+			for _, member := range group.Status.Members {
+				addMemberSnapshot(group, member, &snapshotv1.VirtualMachineSnapshotStatus{
+					Phase:        snapshotv1.Succeeded,
+					CreationTime: currentTime(),
+					ReadyToUse:   pointer.P(true),
+				}, true)
+			}
+
+			retry, err := controller.updateVMSnapshotGroup(group)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(retry).To(BeZero())
+
+			status := lastGroupStatus()
+			Expect(status.Phase).To(Equal(snapshotv1.Succeeded))
+			Expect(status.CreationTime).ToNot(BeNil())
+			Expect(status.ReadyToUse).To(HaveValue(BeTrue()))
+		})
+
+		It("should thaw and fail when a member fails", func() {
+			group := createGroupWithMembers("db", "web")
+			group.Status.FreezeTime = currentTime()
+			addMemberSnapshot(group, group.Status.Members[0], &snapshotv1.VirtualMachineSnapshotStatus{Phase: snapshotv1.InProgress}, true)
+			addMemberSnapshot(group, group.Status.Members[1], &snapshotv1.VirtualMachineSnapshotStatus{
+				Phase: snapshotv1.Failed,
+				Error: &snapshotv1.Error{Message: pointer.P("volume snapshot failed")},
+			}, true)
+			addVMIWithAgent("db")
+
+			vmiInterface.EXPECT().Unfreeze(context.Background(), "db").Return(nil)
+
+			_, err := controller.updateVMSnapshotGroup(group)
+			Expect(err).ToNot(HaveOccurred())
+
+			status := lastGroupStatus()
+			Expect(status.Phase).To(Equal(snapshotv1.Failed))
+			Expect(status.ThawTime).ToNot(BeNil())
+			Expect(status.Error.Message).To(HaveValue(Equal("VirtualMachineSnapshot app-web failed: volume snapshot failed")))
+		})
+
+		It("should fail when the deadline is exceeded", func() {
+			group := createGroupWithMembers("db")
+			group.CreationTimestamp = metav1.NewTime(time.Now().Add(-time.Hour))
+			group.Spec.FailureDeadline = &metav1.Duration{Duration: time.Minute}
+			addMemberSnapshot(group, group.Status.Members[0], &snapshotv1.VirtualMachineSnapshotStatus{Phase: snapshotv1.InProgress}, true)
+
+			_, err := controller.updateVMSnapshotGroup(group)
+			Expect(err).ToNot(HaveOccurred())
+
+			status := lastGroupStatus()
+			Expect(status.Phase).To(Equal(snapshotv1.Failed))
+			Expect(status.Error.Message).To(HaveValue(Equal(vmSnapshotDeadlineExceededError)))
+		})
+	})
+
+	Context("restore group", func() {
+		createRestoreGroup := func() *snapshotv1.VirtualMachineRestoreGroup {
+			return &snapshotv1.VirtualMachineRestoreGroup{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      restoreGroupName,
+					Namespace: testNamespace,
+					UID:       "restore-group-uid",
+				},
+				Spec: snapshotv1.VirtualMachineRestoreGroupSpec{
+					VirtualMachineSnapshotGroupName: groupName,
+				},
+			}
+		}
+
+		addReadyGroup := func() {
+			group := createGroupWithMembers("db", "web")
+			group.Status.Phase = snapshotv1.Succeeded
+			group.Status.ReadyToUse = pointer.P(true)
+			Expect(vmSnapshotGroupInformer.GetIndexer().Add(group)).To(Succeed())
+		}
+
+		It("should wait for the snapshot group to be ready", func() {
+			group := createGroupWithMembers("db")
+			Expect(vmSnapshotGroupInformer.GetIndexer().Add(group)).To(Succeed())
+
+			_, err := controller.updateVMRestoreGroup(createRestoreGroup())
+			Expect(err).ToNot(HaveOccurred())
+
+			Expect(createdRestores).To(BeEmpty())
+			Expect(lastRestoreGroupStatus().Complete).To(HaveValue(BeFalse()))
+		})
+
+		It("should fail when the snapshot group failed", func() {
+			group := createGroupWithMembers("db")
+			group.Status.Phase = snapshotv1.Failed
+			Expect(vmSnapshotGroupInformer.GetIndexer().Add(group)).To(Succeed())
+
+			_, err := controller.updateVMRestoreGroup(createRestoreGroup())
+			Expect(err).ToNot(HaveOccurred())
+
+			Expect(createdRestores).To(BeEmpty())
+			Expect(hasConditionType(lastRestoreGroupStatus().Conditions, snapshotv1.ConditionFailure)).To(BeTrue())
+		})
+
+		It("should restore every member of the snapshot group", func() {
+			addReadyGroup()
+			restoreGroup := createRestoreGroup()
+			restoreGroup.Spec.TargetReadinessPolicy = pointer.P(snapshotv1.VirtualMachineRestoreStopTarget)
+
+			_, err := controller.updateVMRestoreGroup(restoreGroup)
+			Expect(err).ToNot(HaveOccurred())
+
+			Expect(createdRestores).To(HaveLen(2))
+			for i, vmName := range []string{"db", "web"} {
+				vmRestore := createdRestores[i]
+				Expect(vmRestore.Name).To(Equal("app-restore-" + vmName))
+				Expect(vmRestore.Spec.Target.Name).To(Equal(vmName))
+				Expect(vmRestore.Spec.VirtualMachineSnapshotName).To(Equal("app-" + vmName))
+				Expect(vmRestore.Spec.TargetReadinessPolicy).To(HaveValue(Equal(snapshotv1.VirtualMachineRestoreStopTarget)))
+				Expect(vmRestore.Labels).To(HaveKeyWithValue(snapshotv1.RestoreGroupLabel, restoreGroupName))
+				Expect(metav1.IsControlledBy(vmRestore, restoreGroup)).To(BeTrue())
+			}
+
+			status := lastRestoreGroupStatus()
+			Expect(status.Members).To(HaveLen(2))
+			Expect(status.Complete).To(HaveValue(BeFalse()))
+			testutils.ExpectEvent(recorder, vmRestoreGroupCreateEvent)
+		})
+
+		DescribeTable("should report the result of the member restores", func(restoreStatus *snapshotv1.VirtualMachineRestoreStatus, complete, failed bool) {
+			addReadyGroup()
+			restoreGroup := createRestoreGroup()
+			restoreGroup.Status = &snapshotv1.VirtualMachineRestoreGroupStatus{Complete: pointer.P(false)}
+			for _, vmName := range []string{"db", "web"} {
+				member := snapshotv1.VirtualMachineRestoreGroupMember{
+					VirtualMachineName:        vmName,
+					VirtualMachineRestoreName: getGroupMemberRestoreName(restoreGroup, vmName),
+				}
+				restoreGroup.Status.Members = append(restoreGroup.Status.Members, member)
+				vmRestore := newGroupMemberRestore(restoreGroup, member, "app-"+vmName)
+				vmRestore.Status = restoreStatus
+				Expect(vmRestoreInformer.GetIndexer().Add(vmRestore)).To(Succeed())
+			}
+
+			_, err := controller.updateVMRestoreGroup(restoreGroup)
+			Expect(err).ToNot(HaveOccurred())
+
+			status := lastRestoreGroupStatus()
+			Expect(status.Complete).To(HaveValue(Equal(complete)))
+			Expect(status.RestoreTime != nil).To(Equal(complete))
+			Expect(hasConditionType(status.Conditions, snapshotv1.ConditionFailure)).To(Equal(failed))
+			Expect(createdRestores).To(BeEmpty())
+		},
+			Entry("in progress", &snapshotv1.VirtualMachineRestoreStatus{Complete: pointer.P(false)}, false, false),
+			Entry("complete", &snapshotv1.VirtualMachineRestoreStatus{Complete: pointer.P(true)}, true, false),
+			Entry("failed", &snapshotv1.VirtualMachineRestoreStatus{
+				Complete:   pointer.P(false),
+				Conditions: []snapshotv1.Condition{newFailureCondition(corev1.ConditionTrue, "restore failed")},
+			}, false, true),
+		)
+	})
+})
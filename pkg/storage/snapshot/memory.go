@@ -0,0 +1,296 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package snapshot
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	corev1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+
+	kubevirtv1 "kubevirt.io/api/core/v1"
+	snapshotv1 "kubevirt.io/api/snapshot/v1beta1"
+	"kubevirt.io/client-go/log"
+
+	"kubevirt.io/kubevirt/pkg/apimachinery/patch"
+	"kubevirt.io/kubevirt/pkg/controller"
+	storagetypes "kubevirt.io/kubevirt/pkg/storage/types"
+	"kubevirt.io/kubevirt/pkg/util"
+)
+
+const (
+	memoryStateWaitingEvent = "MemoryStateWaiting"
+
+	memoryStateFailedEvent = "MemoryStateFailed"
+
+	memoryStateFailedError = "Failed to save the memory state"
+)
+
+// errMemoryStateFailed is returned once the memory dump of the source failed,
+// the snapshot can't complete anymore
+var errMemoryStateFailed = errors.New(memoryStateFailedError)
+
+func memoryStateClaimName(vmSnapshot *snapshotv1.VirtualMachineSnapshot) string {
+	return fmt.Sprintf("vmsnapshot-%s-memory", vmSnapshot.UID)
+}
+
+func vmiPaused(vmi *kubevirtv1.VirtualMachineInstance) bool {
+	condManager := controller.NewVirtualMachineInstanceConditionManager()
+	return condManager.HasCondition(vmi, kubevirtv1.VirtualMachineInstancePaused)
+}
+
+// updateMemoryStateStatus records where the memory state of a running source
+// is going to be saved and whether the source has to be paused for it. This
+// is persisted before the source is paused so that a VMI paused by the user
+// is not resumed once the snapshot completes.
+func (ctrl *VMSnapshotController) updateMemoryStateStatus(vmSnapshot *snapshotv1.VirtualMachineSnapshot, content *snapshotv1.VirtualMachineSnapshotContent) error {
+	if !vmSnapshot.Spec.IncludeMemory || vmSnapshot.Status.Memory != nil || content != nil {
+		return nil
+	}
+
+	vm, err := ctrl.getVM(vmSnapshot)
+	if err != nil || vm == nil {
+		return err
+	}
+
+	vmi, exists, err := ctrl.getVMI(vm)
+	if err != nil || !exists {
+		return err
+	}
+
+	vmSnapshot.Status.Memory = &snapshotv1.MemorySnapshotStatus{
+		ClaimName:    memoryStateClaimName(vmSnapshot),
+		SourcePaused: !vmiPaused(vmi),
+	}
+
+	return nil
+}
+
+// saveMemoryState drives the memory dump of the source into the memory state
+// PVC and returns true once the state is saved, or if there is no memory state
+// to save at all. It returns errMemoryStateFailed if the memory dump failed.
+func (ctrl *VMSnapshotController) saveMemoryState(vmSnapshot *snapshotv1.VirtualMachineSnapshot) (bool, error) {
+	if !vmSnapshot.Spec.IncludeMemory {
+		return true, nil
+	}
+
+	vm, err := ctrl.getVM(vmSnapshot)
+	if err != nil || vm == nil {
+		return false, err
+	}
+
+	vmi, exists, err := ctrl.getVMI(vm)
+	if err != nil {
+		return false, err
+	}
+
+	memory := vmSnapshot.Status.Memory
+	if memory == nil {
+		// an offline VM has no memory state to save
+		return !exists, nil
+	}
+
+	if !exists {
+		return false, fmt.Errorf("VirtualMachineInstance %s/%s stopped before its memory state was saved", vm.Namespace, vm.Name)
+	}
+
+	if err := ctrl.createMemoryStatePVC(vmSnapshot, vm, vmi); err != nil {
+		return false, err
+	}
+
+	if memory.SourcePaused && !vmiPaused(vmi) {
+		log.Log.Object(vmSnapshot).V(3).Infof("Pausing vmi %s to save its memory state", vmi.Name)
+		return false, ctrl.Client.VirtualMachineInstance(vmi.Namespace).Pause(context.Background(), vmi.Name, &kubevirtv1.PauseOptions{})
+	}
+
+	request := vm.Status.MemoryDumpRequest
+	if request == nil {
+		return false, ctrl.requestMemoryState(vm, memory.ClaimName)
+	}
+
+	if request.ClaimName != memory.ClaimName {
+		ctrl.Recorder.Eventf(
+			vmSnapshot,
+			corev1.EventTypeWarning,
+			memoryStateWaitingEvent,
+			"Waiting for the memory dump of claim %s to be removed from VirtualMachine %s",
+			request.ClaimName,
+			vm.Name,
+		)
+		return false, nil
+	}
+
+	switch request.Phase {
+	case kubevirtv1.MemoryDumpCompleted:
+		return true, nil
+	case kubevirtv1.MemoryDumpFailed:
+		ctrl.Recorder.Eventf(
+			vmSnapshot,
+			corev1.EventTypeWarning,
+			memoryStateFailedEvent,
+			"Failed to save the memory state of VirtualMachine %s: %s",
+			vm.Name,
+			request.Message,
+		)
+		return false, fmt.Errorf("%w of VirtualMachine %s: %s", errMemoryStateFailed, vm.Name, request.Message)
+	}
+
+	return false, nil
+}
+
+// failMemoryState marks the snapshot failed after its memory state could not
+// be saved. Waiting for the deadline would only keep the source paused.
+func failMemoryState(vmSnapshot *snapshotv1.VirtualMachineSnapshot, err error) *snapshotv1.VirtualMachineSnapshot {
+	vmSnapshotCpy := vmSnapshot.DeepCopy()
+	message := err.Error()
+	vmSnapshotCpy.Status.Phase = snapshotv1.Failed
+	vmSnapshotCpy.Status.Error = &snapshotv1.Error{
+		Time:    currentTime(),
+		Message: &message,
+	}
+	updateSnapshotCondition(vmSnapshotCpy, newProgressingCondition(corev1.ConditionFalse, memoryStateFailedError))
+	updateSnapshotCondition(vmSnapshotCpy, newFailureCondition(corev1.ConditionTrue, memoryStateFailedError))
+	return vmSnapshotCpy
+}
+
+func (ctrl *VMSnapshotController) requestMemoryState(vm *kubevirtv1.VirtualMachine, claimName string) error {
+	request := &kubevirtv1.VirtualMachineMemoryDumpRequest{
+		ClaimName: claimName,
+		Phase:     kubevirtv1.MemoryDumpAssociating,
+		Format:    kubevirtv1.MemoryDumpFormatSaveState,
+	}
+
+	patchBytes, err := patch.New(
+		patch.WithTest("/status/memoryDumpRequest", vm.Status.MemoryDumpRequest),
+		patch.WithAdd("/status/memoryDumpRequest", request),
+	).GeneratePayload()
+	if err != nil {
+		return err
+	}
+
+	_, err = ctrl.Client.VirtualMachine(vm.Namespace).PatchStatus(context.Background(), vm.Name, types.JSONPatchType, patchBytes, metav1.PatchOptions{})
+	return err
+}
+
+func (ctrl *VMSnapshotController) createMemoryStatePVC(vmSnapshot *snapshotv1.VirtualMachineSnapshot, vm *kubevirtv1.VirtualMachine, vmi *kubevirtv1.VirtualMachineInstance) error {
+	claimName := vmSnapshot.Status.Memory.ClaimName
+	_, exists, err := ctrl.PVCInformer.GetStore().GetByKey(cacheKeyFunc(vmSnapshot.Namespace, claimName))
+	if err != nil || exists {
+		return err
+	}
+
+	size, err := storagetypes.GetSizeIncludingDefaultFSOverhead(util.CalcExpectedMemoryDumpSize(vmi))
+	if err != nil {
+		return err
+	}
+
+	volumeMode := corev1.PersistentVolumeFilesystem
+	pvc := &corev1.PersistentVolumeClaim{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      claimName,
+			Namespace: vmSnapshot.Namespace,
+			OwnerReferences: []metav1.OwnerReference{
+				*metav1.NewControllerRef(vmSnapshot, snapshotv1.SchemeGroupVersion.WithKind("VirtualMachineSnapshot")),
+			},
+		},
+		Spec: corev1.PersistentVolumeClaimSpec{
+			AccessModes: []corev1.PersistentVolumeAccessMode{corev1.ReadWriteOnce},
+			VolumeMode:  &volumeMode,
+			Resources: corev1.VolumeResourceRequirements{
+				Requests: corev1.ResourceList{
+					corev1.ResourceStorage: *size,
+				},
+			},
+			StorageClassName: ctrl.memoryStateStorageClass(vm),
+		},
+	}
+
+	_, err = ctrl.Client.CoreV1().PersistentVolumeClaims(pvc.Namespace).Create(context.Background(), pvc, metav1.CreateOptions{})
+	if err != nil && !k8serrors.IsAlreadyExists(err) {
+		return err
+	}
+
+	return nil
+}
+
+// memoryStateStorageClass picks the storage class of the VM disks so that the
+// memory state can be snapshotted together with them. It falls back to the
+// default storage class if none can be found.
+func (ctrl *VMSnapshotController) memoryStateStorageClass(vm *kubevirtv1.VirtualMachine) *string {
+	for _, volume := range vm.Spec.Template.Spec.Volumes {
+		if volume.PersistentVolumeClaim == nil && volume.DataVolume == nil {
+			continue
+		}
+		storageClassName, err := ctrl.getVolumeStorageClass(vm.Namespace, &volume)
+		if err == nil && storageClassName != "" {
+			return &storageClassName
+		}
+	}
+	return nil
+}
+
+// releaseMemoryState resumes a source paused for saving its memory state,
+// removes the memory dump association from the VM and deletes the memory
+// state PVC, which is kept by the volume snapshot of the content.
+func (s *vmSnapshotSource) releaseMemoryState(vm *kubevirtv1.VirtualMachine) error {
+	if s.snapshot.Status == nil || s.snapshot.Status.Memory == nil {
+		return nil
+	}
+	memory := s.snapshot.Status.Memory
+
+	if err := s.resumeMemoryStateSource(); err != nil {
+		return err
+	}
+
+	if request := vm.Status.MemoryDumpRequest; request != nil && request.ClaimName == memory.ClaimName && !request.Remove {
+		vm.Status.MemoryDumpRequest = &kubevirtv1.VirtualMachineMemoryDumpRequest{
+			ClaimName: memory.ClaimName,
+			Phase:     kubevirtv1.MemoryDumpDissociating,
+			Remove:    true,
+			Format:    request.Format,
+		}
+	}
+
+	err := s.controller.Client.CoreV1().PersistentVolumeClaims(s.snapshot.Namespace).Delete(context.Background(), memory.ClaimName, metav1.DeleteOptions{})
+	if err != nil && !k8serrors.IsNotFound(err) {
+		return err
+	}
+
+	return nil
+}
+
+func (s *vmSnapshotSource) resumeMemoryStateSource() error {
+	if !s.snapshot.Status.Memory.SourcePaused {
+		return nil
+	}
+
+	vmi, exists, err := s.controller.getVMI(s.vm)
+	if err != nil || !exists || !vmiPaused(vmi) {
+		return err
+	}
+
+	log.Log.V(3).Infof("Unpausing vmi %s after saving its memory state", vmi.Name)
+
+	return s.controller.Client.VirtualMachineInstance(vmi.Namespace).Unpause(context.Background(), vmi.Name, &kubevirtv1.UnpauseOptions{})
+}
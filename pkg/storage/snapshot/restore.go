@@ -56,6 +56,9 @@ import (
 const (
 	RestoreNameAnnotation = "restore.kubevirt.io/name"
 
+	// RestoreCloneUIDLabel marks the restore of a clone, and the PVCs it restores, with the UID of the clone
+	RestoreCloneUIDLabel = "restore.kubevirt.io/clone-uid"
+
 	vmRestoreFinalizer = "snapshot.kubevirt.io/vmrestore-protection"
 
 	populatedForPVCAnnotation = "cdi.kubevirt.io/storage.populatedFor"
@@ -82,6 +85,7 @@ var (
 	restoreTargetNotReady           = "Restore target not ready"
 	restoredFailed                  = "Operation failed"
 	errorRestoreToExistingTarget    = "restore source and restore target are different but restore target already exists"
+	errorImportToExistingTarget     = "restore target of an import already exists"
 )
 
 type restoreTarget interface {
@@ -184,6 +188,10 @@ func (ctrl *VMRestoreController) updateVMRestore(vmRestoreIn *snapshotv1.Virtual
 		}
 	}
 
+	if vmRestoreOut.Spec.VirtualMachineExport != nil {
+		return 0, ctrl.reconcileImport(vmRestoreIn, vmRestoreOut, target)
+	}
+
 	ready, err := target.Ready()
 	if err != nil {
 		logger.Reason(err).Error("Error checking target ready")
@@ -245,6 +253,10 @@ func (ctrl *VMRestoreController) updateVMRestore(vmRestoreIn *snapshotv1.Virtual
 		return 0, ctrl.doUpdateError(vmRestoreIn, err)
 	}
 
+	return 0, ctrl.completeVMRestore(vmRestoreIn, vmRestoreOut)
+}
+
+func (ctrl *VMRestoreController) completeVMRestore(vmRestoreIn, vmRestoreOut *snapshotv1.VirtualMachineRestore) error {
 	ctrl.Recorder.Eventf(
 		vmRestoreOut,
 		corev1.EventTypeNormal,
@@ -259,7 +271,7 @@ func (ctrl *VMRestoreController) updateVMRestore(vmRestoreIn *snapshotv1.Virtual
 	updateRestoreCondition(vmRestoreOut, newProgressingCondition(corev1.ConditionFalse, "Operation complete"))
 	updateRestoreCondition(vmRestoreOut, newReadyCondition(corev1.ConditionTrue, "Operation complete"))
 
-	return 0, ctrl.doUpdateStatus(vmRestoreIn, vmRestoreOut)
+	return ctrl.doUpdateStatus(vmRestoreIn, vmRestoreOut)
 }
 
 func (ctrl *VMRestoreController) doUpdateError(restore *snapshotv1.VirtualMachineRestore, err error) error {
@@ -372,7 +384,7 @@ func (ctrl *VMRestoreController) reconcileVolumeRestores(vmRestore *snapshotv1.V
 		return false, err
 	}
 
-	noRestore, err := ctrl.volumesNotForRestore(content)
+	noRestore, err := ctrl.volumesNotForRestore(vmRestore, content)
 	if err != nil {
 		return false, err
 	}
@@ -480,6 +492,7 @@ func (t *vmRestoreTarget) UpdateDoneRestore() error {
 
 	vmCopy.Status.RestoreInProgress = nil
 	vmCopy.Status.MemoryDumpRequest = nil
+	vmCopy.Status.BackupRequest = nil
 	vmCopy, err := t.controller.Client.VirtualMachine(vmCopy.Namespace).UpdateStatus(context.Background(), vmCopy, metav1.UpdateOptions{})
 	if err != nil {
 		return err
@@ -761,6 +774,7 @@ func (t *vmRestoreTarget) generateRestoredVMSpec(snapshotVM *snapshotv1.VirtualM
 	log.Log.Object(t.vmRestore).V(3).Info("generating restored VM spec")
 	var newTemplates = make([]kubevirtv1.DataVolumeTemplateSpec, len(snapshotVM.Spec.DataVolumeTemplates))
 	var newVolumes []kubevirtv1.Volume
+	var memoryStateClaim string
 
 	for i, t := range snapshotVM.Spec.DataVolumeTemplates {
 		t.DeepCopyInto(&newTemplates[i])
@@ -811,8 +825,11 @@ func (t *vmRestoreTarget) generateRestoredVMSpec(snapshotVM *snapshotv1.VirtualM
 					}
 				}
 			}
-		} else if nv.MemoryDump != nil {
-			// don't restore memory dump volume in the new spec
+		} else if nv.MemoryDump != nil || nv.Backup != nil {
+			// don't restore memory dump and backup volumes in the new spec
+			if isMemoryStateVolume(nv) {
+				memoryStateClaim = restoredMemoryStateClaim(t.vmRestore, nv.Name)
+			}
 			continue
 		}
 		newVolumes = append(newVolumes, *nv)
@@ -846,7 +863,11 @@ func (t *vmRestoreTarget) generateRestoredVMSpec(snapshotVM *snapshotv1.VirtualM
 	}
 	newVM.Spec.DataVolumeTemplates = newTemplates
 	newVM.Spec.Template.Spec.Volumes = newVolumes
+	if !t.Exists() {
+		applyNewIdentity(newVM, t.vmRestore.Spec.NewIdentity)
+	}
 	setLastRestoreAnnotation(t.vmRestore, newVM)
+	setResumeFromMemoryStateAnnotation(newVM, memoryStateClaim)
 
 	return newVM, nil
 }
@@ -975,7 +996,7 @@ func (t *vmRestoreTarget) getControllerRevision(namespace, name string) (*appsv1
 }
 
 func (t *vmRestoreTarget) restoreInstancetypeControllerRevision(vmSnapshotRevisionName, vmSnapshotName string, vm *kubevirtv1.VirtualMachine) (*appsv1.ControllerRevision, error) {
-	snapshotCR, err := t.getControllerRevision(vm.Namespace, vmSnapshotRevisionName)
+	snapshotCR, err := t.getControllerRevision(getVMSnapshotNamespace(t.vmRestore), vmSnapshotRevisionName)
 	if err != nil {
 		return nil, err
 	}
@@ -1191,8 +1212,17 @@ func sourceAndTargetAreDifferent(target restoreTarget, vmSnapshot *snapshotv1.Vi
 	return vmSnapshot.Status != nil && vmSnapshot.Status.SourceUID != nil && targetUID != *vmSnapshot.Status.SourceUID
 }
 
+// getVMSnapshotNamespace returns the namespace of the snapshot to restore,
+// which defaults to the namespace of the restore
+func getVMSnapshotNamespace(vmRestore *snapshotv1.VirtualMachineRestore) string {
+	if vmRestore.Spec.VirtualMachineSnapshotNamespace != "" {
+		return vmRestore.Spec.VirtualMachineSnapshotNamespace
+	}
+	return vmRestore.Namespace
+}
+
 func (ctrl *VMRestoreController) getVMSnapshot(vmRestore *snapshotv1.VirtualMachineRestore) (*snapshotv1.VirtualMachineSnapshot, error) {
-	objKey := cacheKeyFunc(vmRestore.Namespace, vmRestore.Spec.VirtualMachineSnapshotName)
+	objKey := cacheKeyFunc(getVMSnapshotNamespace(vmRestore), vmRestore.Spec.VirtualMachineSnapshotName)
 	obj, exists, err := ctrl.VMSnapshotInformer.GetStore().GetByKey(objKey)
 	if err != nil {
 		return nil, err
@@ -1341,7 +1371,8 @@ func (ctrl *VMRestoreController) createRestorePVC(
 	if vmRestore == nil {
 		return fmt.Errorf("missing vmRestore")
 	}
-	volumeSnapshot, err := ctrl.VolumeSnapshotProvider.GetVolumeSnapshot(vmRestore.Namespace, *volumeBackup.VolumeSnapshotName)
+	snapshotNamespace := getVMSnapshotNamespace(vmRestore)
+	volumeSnapshot, err := ctrl.VolumeSnapshotProvider.GetVolumeSnapshot(snapshotNamespace, *volumeBackup.VolumeSnapshotName)
 	if err != nil {
 		return err
 	}
@@ -1356,6 +1387,15 @@ func (ctrl *VMRestoreController) createRestorePVC(
 	if err != nil {
 		return err
 	}
+	delete(pvc.Labels, RestoreCloneUIDLabel)
+	if cloneUID, ok := vmRestore.Labels[RestoreCloneUIDLabel]; ok {
+		pvc.Labels[RestoreCloneUIDLabel] = cloneUID
+	}
+	if snapshotNamespace != vmRestore.Namespace {
+		// only dataSourceRef can refer to another namespace
+		pvc.Spec.DataSource = nil
+		pvc.Spec.DataSourceRef.Namespace = &snapshotNamespace
+	}
 	target.Own(pvc)
 
 	_, err = ctrl.Client.CoreV1().PersistentVolumeClaims(vmRestore.Namespace).Create(context.Background(), pvc, metav1.CreateOptions{})
@@ -1433,6 +1473,66 @@ func setLastRestoreAnnotation(restore *snapshotv1.VirtualMachineRestore, obj met
 	obj.GetAnnotations()[lastRestoreAnnotation] = getRestoreAnnotationValue(restore)
 }
 
+// setResumeFromMemoryStateAnnotation makes the next start of the VM resume
+// from the restored memory state, or drops a stale request if there is none
+func setResumeFromMemoryStateAnnotation(vm *kubevirtv1.VirtualMachine, claimName string) {
+	if claimName == "" {
+		delete(vm.Annotations, kubevirtv1.ResumeFromMemoryStateAnnotation)
+		return
+	}
+	if vm.Annotations == nil {
+		vm.Annotations = make(map[string]string)
+	}
+	vm.Annotations[kubevirtv1.ResumeFromMemoryStateAnnotation] = claimName
+}
+
+func restoredMemoryStateClaim(vmRestore *snapshotv1.VirtualMachineRestore, volumeName string) string {
+	for _, vr := range vmRestore.Status.Restores {
+		if vr.VolumeName == volumeName {
+			return vr.PersistentVolumeClaimName
+		}
+	}
+	return ""
+}
+
+func isMemoryStateVolume(volume *kubevirtv1.Volume) bool {
+	return volume.MemoryDump != nil && volume.MemoryDump.Format == kubevirtv1.MemoryDumpFormatSaveState
+}
+
+// isRestoreInPlace returns true if the restore targets the VM the snapshot
+// was taken from. Only then the saved memory state matches the domain it
+// would be resumed into.
+func isRestoreInPlace(vmRestore *snapshotv1.VirtualMachineRestore, content *snapshotv1.VirtualMachineSnapshotContent) bool {
+	sourceVM := content.Spec.Source.VirtualMachine
+	if sourceVM == nil {
+		return false
+	}
+	return vmRestore.Spec.Target.Name == sourceVM.Name && vmRestore.Namespace == sourceVM.Namespace
+}
+
+// applyNewIdentity assigns new identifiers to a new target the same way a
+// VirtualMachineClone does
+func applyNewIdentity(vm *kubevirtv1.VirtualMachine, identity *snapshotv1.VirtualMachineRestoreIdentity) {
+	if identity == nil || vm.Spec.Template == nil {
+		return
+	}
+
+	spec := &vm.Spec.Template.Spec
+	for i := range spec.Domain.Devices.Interfaces {
+		iface := &spec.Domain.Devices.Interfaces[i]
+		// an empty mac address makes the cluster assign a new one
+		iface.MacAddress = identity.NewMacAddresses[iface.Name]
+	}
+
+	if firmware := spec.Domain.Firmware; firmware != nil {
+		firmware.Serial = ""
+		if identity.NewSMBiosSerial != nil {
+			firmware.Serial = *identity.NewSMBiosSerial
+		}
+		firmware.UUID = ""
+	}
+}
+
 func getFilteredLabels(labels map[string]string) map[string]string {
 	excludedKey := backendstorage.PVCPrefix
 	excludedMap := map[string]struct{}{
@@ -1471,8 +1571,9 @@ func updateRestoreCondition(r *snapshotv1.VirtualMachineRestore, c snapshotv1.Co
 }
 
 // Returns a set of volumes not for restore
-// Currently only memory dump volumes should not be restored
-func (ctrl *VMRestoreController) volumesNotForRestore(content *snapshotv1.VirtualMachineSnapshotContent) (sets.String, error) {
+// Memory dump and backup volumes should not be restored, except for a saved
+// memory state which can only be resumed by the VM it was taken from
+func (ctrl *VMRestoreController) volumesNotForRestore(vmRestore *snapshotv1.VirtualMachineRestore, content *snapshotv1.VirtualMachineSnapshotContent) (sets.String, error) {
 	noRestore := sets.NewString()
 
 	volumes, err := storageutils.GetVolumes(content.Spec.Source.VirtualMachine, ctrl.Client)
@@ -1481,7 +1582,10 @@ func (ctrl *VMRestoreController) volumesNotForRestore(content *snapshotv1.Virtua
 	}
 
 	for _, volume := range volumes {
-		if volume.MemoryDump != nil {
+		if isMemoryStateVolume(&volume) && isRestoreInPlace(vmRestore, content) {
+			continue
+		}
+		if volume.MemoryDump != nil || volume.Backup != nil {
 			noRestore.Insert(volume.Name)
 		}
 	}
@@ -38,6 +38,7 @@ import (
 	cdiv1 "kubevirt.io/containerized-data-importer-api/pkg/apis/core/v1beta1"
 
 	"kubevirt.io/kubevirt/pkg/storage/status"
+	virtconfig "kubevirt.io/kubevirt/pkg/virt-config"
 	watchutil "kubevirt.io/kubevirt/pkg/virt-controller/watch/util"
 )
 
@@ -59,6 +60,8 @@ type VMRestoreController struct {
 
 	Recorder record.EventRecorder
 
+	ClusterConfig *virtconfig.ClusterConfig
+
 	vmRestoreQueue workqueue.TypedRateLimitingInterface[string]
 
 	VMRestoreStatusUpdater *status.VMRestoreStatusUpdater
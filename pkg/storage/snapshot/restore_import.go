@@ -0,0 +1,441 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package snapshot
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/url"
+	"path"
+	"reflect"
+	"strings"
+	"syscall"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/sets"
+
+	kubevirtv1 "kubevirt.io/api/core/v1"
+	snapshotv1 "kubevirt.io/api/snapshot/v1beta1"
+	"kubevirt.io/client-go/log"
+	cdiv1 "kubevirt.io/containerized-data-importer-api/pkg/apis/core/v1beta1"
+)
+
+const (
+	exportTokenHeader = "x-kubevirt-export-token"
+
+	exportTokenKey = "token"
+
+	exportRequestTimeout = 30 * time.Second
+
+	// the manifests of a VirtualMachineExport are small, anything bigger is not one
+	exportManifestsMaxSize = 4 * 1024 * 1024
+
+	// exportManifestsPathPattern matches the external path serving all the
+	// manifests of a VirtualMachineExport
+	exportManifestsPathPattern = "/api/export.kubevirt.io/*/namespaces/*/virtualmachineexports/*/external/manifests/all"
+)
+
+// exportManifests holds the objects a VirtualMachineExport publishes to
+// recreate its VirtualMachine on another cluster
+type exportManifests struct {
+	vm          *kubevirtv1.VirtualMachine
+	configMaps  []*corev1.ConfigMap
+	dataVolumes []*cdiv1.DataVolume
+}
+
+// checkExportAddress rejects the addresses an export server must not be reached at
+var checkExportAddress = func(ip net.IP) error {
+	if ip.IsLoopback() || ip.IsLinkLocalUnicast() || ip.IsLinkLocalMulticast() ||
+		ip.IsInterfaceLocalMulticast() || ip.IsMulticast() || ip.IsUnspecified() {
+		return fmt.Errorf("export server address %s is not allowed", ip)
+	}
+	return nil
+}
+
+// dialExportServer checks the address a connection to the export server is
+// made to, so that an allowed host resolving to a forbidden address is refused
+func dialExportServer(_, address string, _ syscall.RawConn) error {
+	host, _, err := net.SplitHostPort(address)
+	if err != nil {
+		return err
+	}
+	ip := net.ParseIP(host)
+	if ip == nil {
+		return fmt.Errorf("export server address %s is not an IP address", host)
+	}
+	return checkExportAddress(ip)
+}
+
+var getExportHTTPClient = func(cert string) (*http.Client, error) {
+	tlsConfig := &tls.Config{MinVersion: tls.VersionTLS12}
+	if cert != "" {
+		roots := x509.NewCertPool()
+		if !roots.AppendCertsFromPEM([]byte(cert)) {
+			return nil, fmt.Errorf("invalid export server certificate")
+		}
+		tlsConfig.RootCAs = roots
+	}
+
+	return &http.Client{
+		Transport: &http.Transport{
+			TLSClientConfig: tlsConfig,
+			DialContext: (&net.Dialer{
+				Timeout: exportRequestTimeout,
+				Control: dialExportServer,
+			}).DialContext,
+		},
+		Timeout: exportRequestTimeout,
+		// the export token must not be sent anywhere but the export server
+		CheckRedirect: func(*http.Request, []*http.Request) error {
+			return http.ErrUseLastResponse
+		},
+	}, nil
+}
+
+// ParseExportManifestURL parses the URL a VirtualMachineExport serves its
+// manifests at and rejects any other URL
+func ParseExportManifestURL(rawURL string) (*url.URL, error) {
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return nil, err
+	}
+	if u.Scheme != "https" || u.Host == "" {
+		return nil, fmt.Errorf("manifest URL must be an https URL")
+	}
+	if u.User != nil {
+		return nil, fmt.Errorf("manifest URL must not contain user information")
+	}
+	if match, _ := path.Match(exportManifestsPathPattern, u.Path); !match {
+		return nil, fmt.Errorf("manifest URL must point to the external manifests of a VirtualMachineExport")
+	}
+	return u, nil
+}
+
+// ValidateExportHost only lets a restore import from the hosts allowed by the
+// KubeVirt configuration. Hosts of the cluster itself are always refused, the
+// export server has to be reached from outside of it.
+func ValidateExportHost(u *url.URL, allowedHosts []string) error {
+	host := strings.ToLower(u.Hostname())
+	if ip := net.ParseIP(host); ip != nil {
+		if err := checkExportAddress(ip); err != nil {
+			return err
+		}
+	} else if !strings.Contains(host, ".") || strings.HasSuffix(host, ".svc") ||
+		strings.HasSuffix(host, ".cluster.local") {
+		// hosts without a domain resolve through the search domains of the cluster
+		return fmt.Errorf("export server host %s is internal to the cluster", host)
+	}
+
+	if len(allowedHosts) == 0 {
+		return fmt.Errorf("importing from a VirtualMachineExport requires its host to be listed in vmRestoreImportAllowedHosts of the KubeVirt configuration")
+	}
+	for _, allowed := range allowedHosts {
+		allowed = strings.ToLower(allowed)
+		if host == allowed || (strings.HasPrefix(allowed, "*.") && strings.HasSuffix(host, allowed[1:])) {
+			return nil
+		}
+	}
+	return fmt.Errorf("export server host %s is not listed in vmRestoreImportAllowedHosts of the KubeVirt configuration", host)
+}
+
+// reconcileImport restores the target from a VirtualMachineExport of another
+// cluster. The VirtualMachine of the export is created in the namespace of
+// the restore together with the DataVolumes importing its disks from the
+// export server.
+func (ctrl *VMRestoreController) reconcileImport(vmRestoreIn, vmRestoreOut *snapshotv1.VirtualMachineRestore, target restoreTarget) error {
+	logger := log.Log.Object(vmRestoreOut)
+
+	if target.TargetRestored() {
+		return ctrl.completeVMRestore(vmRestoreIn, vmRestoreOut)
+	}
+	if target.Exists() {
+		logger.Error(errorImportToExistingTarget)
+		return ctrl.doUpdateError(vmRestoreIn, fmt.Errorf(errorImportToExistingTarget))
+	}
+
+	token, err := ctrl.getExportToken(vmRestoreOut)
+	if err != nil {
+		return ctrl.doUpdateError(vmRestoreIn, err)
+	}
+
+	manifests, err := fetchExportManifests(vmRestoreOut.Spec.VirtualMachineExport, token, ctrl.ClusterConfig.GetVMRestoreImportAllowedHosts())
+	if err != nil {
+		logger.Reason(err).Error("Error fetching export manifests")
+		return ctrl.doUpdateError(vmRestoreIn, err)
+	}
+
+	if err := ctrl.createImportObjects(vmRestoreOut, manifests, token); err != nil {
+		logger.Reason(err).Error("Error creating imported objects")
+		return ctrl.doUpdateError(vmRestoreIn, err)
+	}
+
+	updateRestoreCondition(vmRestoreOut, newProgressingCondition(corev1.ConditionTrue, "Importing target"))
+	updateRestoreCondition(vmRestoreOut, newReadyCondition(corev1.ConditionFalse, "Waiting for target to be imported"))
+	return ctrl.doUpdateStatus(vmRestoreIn, vmRestoreOut)
+}
+
+func (ctrl *VMRestoreController) getExportToken(vmRestore *snapshotv1.VirtualMachineRestore) (string, error) {
+	secretName := vmRestore.Spec.VirtualMachineExport.TokenSecretRef
+	secret, err := ctrl.Client.CoreV1().Secrets(vmRestore.Namespace).Get(context.Background(), secretName, metav1.GetOptions{})
+	if err != nil {
+		return "", err
+	}
+
+	token, ok := secret.Data[exportTokenKey]
+	if !ok || len(token) == 0 {
+		return "", fmt.Errorf("secret %s/%s has no %s", vmRestore.Namespace, secretName, exportTokenKey)
+	}
+	return string(token), nil
+}
+
+func fetchExportManifests(source *snapshotv1.VirtualMachineRestoreExportSource, token string, allowedHosts []string) (*exportManifests, error) {
+	manifestURL, err := ParseExportManifestURL(source.ManifestURL)
+	if err != nil {
+		return nil, err
+	}
+	if err := ValidateExportHost(manifestURL, allowedHosts); err != nil {
+		return nil, err
+	}
+
+	client, err := getExportHTTPClient(source.Cert)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest(http.MethodGet, manifestURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Accept", "application/json")
+	req.Header.Set(exportTokenHeader, token)
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("unexpected status %d fetching export manifests", resp.StatusCode)
+	}
+
+	data, err := io.ReadAll(io.LimitReader(resp.Body, exportManifestsMaxSize))
+	if err != nil {
+		return nil, err
+	}
+
+	manifests, err := parseExportManifests(data)
+	if err != nil {
+		return nil, err
+	}
+	for _, dv := range manifests.dataVolumes {
+		if err := validateImportSource(dv.Spec.Source, dv.Spec.SourceRef, manifestURL.Host); err != nil {
+			return nil, fmt.Errorf("DataVolume %s: %v", dv.Name, err)
+		}
+	}
+	return manifests, nil
+}
+
+// validateImportSource only lets an imported DataVolume download its disk
+// from the export server. Any other source would be created with the
+// privileges of the controller, e.g. cloning a PVC of another namespace.
+func validateImportSource(source *cdiv1.DataVolumeSource, sourceRef *cdiv1.DataVolumeSourceRef, exportHost string) error {
+	if sourceRef != nil || source == nil || source.HTTP == nil ||
+		!reflect.DeepEqual(*source, cdiv1.DataVolumeSource{HTTP: source.HTTP}) {
+		return fmt.Errorf("only an http source is allowed")
+	}
+
+	u, err := url.Parse(source.HTTP.URL)
+	if err != nil {
+		return err
+	}
+	if (u.Scheme != "https" && u.Scheme != "http") || u.Host != exportHost {
+		return fmt.Errorf("source URL %q does not point to the export server %s", source.HTTP.URL, exportHost)
+	}
+	return nil
+}
+
+func parseExportManifests(data []byte) (*exportManifests, error) {
+	list := &corev1.List{}
+	if err := json.Unmarshal(data, list); err != nil {
+		return nil, fmt.Errorf("invalid export manifests: %v", err)
+	}
+
+	manifests := &exportManifests{}
+	for _, item := range list.Items {
+		typeMeta := &metav1.TypeMeta{}
+		if err := json.Unmarshal(item.Raw, typeMeta); err != nil {
+			return nil, fmt.Errorf("invalid export manifests: %v", err)
+		}
+
+		var obj interface{}
+		switch typeMeta.Kind {
+		case kubevirtv1.VirtualMachineGroupVersionKind.Kind:
+			if manifests.vm != nil {
+				return nil, fmt.Errorf("export manifests contain more than one VirtualMachine")
+			}
+			manifests.vm = &kubevirtv1.VirtualMachine{}
+			obj = manifests.vm
+		case "ConfigMap":
+			cm := &corev1.ConfigMap{}
+			manifests.configMaps = append(manifests.configMaps, cm)
+			obj = cm
+		case "DataVolume":
+			dv := &cdiv1.DataVolume{}
+			manifests.dataVolumes = append(manifests.dataVolumes, dv)
+			obj = dv
+		default:
+			return nil, fmt.Errorf("unexpected kind %q in export manifests", typeMeta.Kind)
+		}
+
+		if err := json.Unmarshal(item.Raw, obj); err != nil {
+			return nil, fmt.Errorf("invalid export manifests: %v", err)
+		}
+	}
+
+	if manifests.vm == nil {
+		return nil, fmt.Errorf("export manifests contain no VirtualMachine")
+	}
+	return manifests, nil
+}
+
+// createImportObjects creates the imported objects, the VirtualMachine last
+// so that an existing target marks the import as done. The CA and token of
+// the export server are owned by the restore, the rest outlives it.
+func (ctrl *VMRestoreController) createImportObjects(vmRestore *snapshotv1.VirtualMachineRestore, manifests *exportManifests, token string) error {
+	namespace := vmRestore.Namespace
+	ownerRef := *metav1.NewControllerRef(vmRestore, snapshotv1.SchemeGroupVersion.WithKind("VirtualMachineRestore"))
+
+	// the patches of the restore apply to the DataVolumeTemplates as well,
+	// so the sources are checked on the final VM before creating anything
+	vm, err := importedVM(vmRestore, manifests.vm)
+	if err != nil {
+		return err
+	}
+	manifestURL, err := ParseExportManifestURL(vmRestore.Spec.VirtualMachineExport.ManifestURL)
+	if err != nil {
+		return err
+	}
+	for _, dvt := range vm.Spec.DataVolumeTemplates {
+		if err := validateImportSource(dvt.Spec.Source, dvt.Spec.SourceRef, manifestURL.Host); err != nil {
+			return fmt.Errorf("DataVolumeTemplate %s: %v", dvt.Name, err)
+		}
+	}
+
+	for _, cm := range manifests.configMaps {
+		cm.ObjectMeta = metav1.ObjectMeta{
+			Name:            cm.Name,
+			Namespace:       namespace,
+			OwnerReferences: []metav1.OwnerReference{ownerRef},
+		}
+		_, err := ctrl.Client.CoreV1().ConfigMaps(namespace).Create(context.Background(), cm, metav1.CreateOptions{})
+		if err != nil && !k8serrors.IsAlreadyExists(err) {
+			return err
+		}
+	}
+
+	for _, secretName := range importHeaderSecretNames(manifests.dataVolumes, vm).List() {
+		secret := &corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:            secretName,
+				Namespace:       namespace,
+				OwnerReferences: []metav1.OwnerReference{ownerRef},
+			},
+			StringData: map[string]string{
+				exportTokenKey: fmt.Sprintf("%s:%s", exportTokenHeader, token),
+			},
+		}
+		_, err := ctrl.Client.CoreV1().Secrets(namespace).Create(context.Background(), secret, metav1.CreateOptions{})
+		if err != nil && !k8serrors.IsAlreadyExists(err) {
+			return err
+		}
+	}
+
+	for _, dv := range manifests.dataVolumes {
+		dv.ObjectMeta = metav1.ObjectMeta{
+			Name:        dv.Name,
+			Namespace:   namespace,
+			Labels:      dv.Labels,
+			Annotations: dv.Annotations,
+		}
+		dv.Status = cdiv1.DataVolumeStatus{}
+		_, err := ctrl.Client.CdiClient().CdiV1beta1().DataVolumes(namespace).Create(context.Background(), dv, metav1.CreateOptions{})
+		if err != nil && !k8serrors.IsAlreadyExists(err) {
+			return err
+		}
+	}
+
+	_, err = ctrl.Client.VirtualMachine(namespace).Create(context.Background(), vm, metav1.CreateOptions{})
+	return err
+}
+
+func importedVM(vmRestore *snapshotv1.VirtualMachineRestore, exportedVM *kubevirtv1.VirtualMachine) (*kubevirtv1.VirtualMachine, error) {
+	vm := &kubevirtv1.VirtualMachine{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        vmRestore.Spec.Target.Name,
+			Namespace:   vmRestore.Namespace,
+			Labels:      exportedVM.Labels,
+			Annotations: exportedVM.Annotations,
+		},
+		Spec: *exportedVM.Spec.DeepCopy(),
+	}
+
+	// the imported VM is not started before its disks are imported
+	runStrategyHalted := kubevirtv1.RunStrategyHalted
+	vm.Spec.RunStrategy = &runStrategyHalted
+	vm.Spec.Running = nil
+
+	applyNewIdentity(vm, vmRestore.Spec.NewIdentity)
+	setLastRestoreAnnotation(vmRestore, vm)
+
+	patchedVM, err := patchVM(vm, vmRestore.Spec.Patches)
+	if err != nil {
+		return nil, fmt.Errorf("error patching VM %s: %v", vm.Name, err)
+	}
+	return patchedVM, nil
+}
+
+// importHeaderSecretNames returns the secrets the DataVolumes of the export
+// expect to hold the header authenticating them to the export server
+func importHeaderSecretNames(dataVolumes []*cdiv1.DataVolume, vm *kubevirtv1.VirtualMachine) sets.String {
+	names := sets.NewString()
+	addSource := func(source *cdiv1.DataVolumeSource) {
+		if source != nil && source.HTTP != nil {
+			names.Insert(source.HTTP.SecretExtraHeaders...)
+		}
+	}
+
+	for _, dv := range dataVolumes {
+		addSource(dv.Spec.Source)
+	}
+	for _, dvt := range vm.Spec.DataVolumeTemplates {
+		addSource(dvt.Spec.Source)
+	}
+	return names
+}
@@ -2,7 +2,13 @@ package snapshot
 
 import (
 	"context"
+	"encoding/json"
+	"encoding/pem"
 	"fmt"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
 	"reflect"
 	"strings"
 	"time"
@@ -1616,6 +1622,419 @@ var _ = Describe("Restore controller", func() {
 				addVirtualMachineRestore(r)
 				controller.processVMRestoreWorkItem()
 			})
+
+			Context("with memory state", func() {
+				const (
+					memoryStateVolume   = "memory-state"
+					restoredMemoryClaim = "restore-memory-state"
+				)
+
+				addMemoryStateVolume := func(content *snapshotv1.VirtualMachineSnapshotContent) {
+					spec := &content.Spec.Source.VirtualMachine.Spec.Template.Spec
+					spec.Volumes = append(spec.Volumes, kubevirtv1.Volume{
+						Name: memoryStateVolume,
+						VolumeSource: kubevirtv1.VolumeSource{
+							MemoryDump: &kubevirtv1.MemoryDumpVolumeSource{
+								PersistentVolumeClaimVolumeSource: kubevirtv1.PersistentVolumeClaimVolumeSource{
+									PersistentVolumeClaimVolumeSource: corev1.PersistentVolumeClaimVolumeSource{
+										ClaimName: "vmsnapshot-memory",
+									},
+									Hotpluggable: true,
+								},
+								Format: kubevirtv1.MemoryDumpFormatSaveState,
+							},
+						},
+					})
+				}
+
+				DescribeTable("should restore the memory state volume", func(targetName string, restored bool) {
+					r := createRestore()
+					r.Spec.Target.Name = targetName
+					content := sc.DeepCopy()
+					addMemoryStateVolume(content)
+
+					noRestore, err := controller.volumesNotForRestore(r, content)
+					Expect(err).ToNot(HaveOccurred())
+					Expect(noRestore.Has(memoryStateVolume)).To(Equal(!restored))
+				},
+					Entry("when restoring in place", vmName, true),
+					Entry("not when restoring to a new VM", newVMName, false),
+				)
+
+				It("should resume the restored VM from the memory state", func() {
+					r := createRestore()
+					r.Status = &snapshotv1.VirtualMachineRestoreStatus{
+						Restores: []snapshotv1.VolumeRestore{
+							{
+								VolumeName:                memoryStateVolume,
+								PersistentVolumeClaimName: restoredMemoryClaim,
+							},
+						},
+					}
+					content := sc.DeepCopy()
+					addMemoryStateVolume(content)
+					target := &vmRestoreTarget{controller: controller, vmRestore: r, vm: vm}
+
+					newVM, err := target.generateRestoredVMSpec(content.Spec.Source.VirtualMachine)
+					Expect(err).ToNot(HaveOccurred())
+					Expect(newVM.Annotations).To(HaveKeyWithValue(kubevirtv1.ResumeFromMemoryStateAnnotation, restoredMemoryClaim))
+					for _, volume := range newVM.Spec.Template.Spec.Volumes {
+						Expect(volume.Name).ToNot(Equal(memoryStateVolume))
+					}
+				})
+
+				It("should not resume the restored VM without a memory state", func() {
+					r := createRestore()
+					r.Status = &snapshotv1.VirtualMachineRestoreStatus{}
+					targetVM := vm.DeepCopy()
+					targetVM.Annotations = map[string]string{kubevirtv1.ResumeFromMemoryStateAnnotation: restoredMemoryClaim}
+					target := &vmRestoreTarget{controller: controller, vmRestore: r, vm: targetVM}
+
+					newVM, err := target.generateRestoredVMSpec(sc.Spec.Source.VirtualMachine)
+					Expect(err).ToNot(HaveOccurred())
+					Expect(newVM.Annotations).ToNot(HaveKey(kubevirtv1.ResumeFromMemoryStateAnnotation))
+				})
+			})
+
+			Context("from another namespace", func() {
+				const sourceNamespace = "source"
+
+				It("should create restore PVCs referring to the snapshot namespace", func() {
+					r := createRestore()
+					r.Spec.VirtualMachineSnapshotNamespace = sourceNamespace
+					r.Labels = map[string]string{RestoreCloneUIDLabel: "clone-uid"}
+					r.Status = &snapshotv1.VirtualMachineRestoreStatus{}
+					addVolumeRestores(r)
+					vs := createVolumeSnapshot(r.Status.Restores[0].VolumeSnapshotName, resource.MustParse("2Gi"))
+					fakeVolumeSnapshotProvider.Add(vs)
+
+					var createdPVC *corev1.PersistentVolumeClaim
+					k8sClient.Fake.PrependReactor("create", "persistentvolumeclaims", func(action testing.Action) (handled bool, obj runtime.Object, err error) {
+						Expect(action.GetNamespace()).To(Equal(testNamespace))
+						createdPVC = action.(testing.CreateAction).GetObject().(*corev1.PersistentVolumeClaim)
+						return true, createdPVC, nil
+					})
+
+					target := &vmRestoreTarget{controller: controller, vmRestore: r, vm: vm}
+					err := controller.createRestorePVC(r, target, &sc.Spec.VolumeBackups[0], &r.Status.Restores[0], vm.Name, vm.Namespace)
+					Expect(err).ToNot(HaveOccurred())
+					Expect(createdPVC).ToNot(BeNil())
+					Expect(createdPVC.Spec.DataSource).To(BeNil())
+					Expect(createdPVC.Spec.DataSourceRef).ToNot(BeNil())
+					Expect(createdPVC.Spec.DataSourceRef.Kind).To(Equal("VolumeSnapshot"))
+					Expect(createdPVC.Spec.DataSourceRef.Namespace).To(HaveValue(Equal(sourceNamespace)))
+					Expect(createdPVC.Labels).To(HaveKeyWithValue(RestoreCloneUIDLabel, "clone-uid"))
+				})
+			})
+
+			Context("with a new identity", func() {
+				var snapshotVM *snapshotv1.VirtualMachine
+
+				BeforeEach(func() {
+					snapshotVM = sc.Spec.Source.VirtualMachine.DeepCopy()
+					snapshotVM.Spec.Template.Spec.Domain.Devices.Interfaces = []kubevirtv1.Interface{
+						{Name: "default", MacAddress: "02:00:00:00:00:01"},
+						{Name: "secondary", MacAddress: "02:00:00:00:00:02"},
+					}
+					snapshotVM.Spec.Template.Spec.Domain.Firmware = &kubevirtv1.Firmware{
+						Serial: "serial",
+						UUID:   "uuid",
+					}
+				})
+
+				newIdentityRestore := func() *snapshotv1.VirtualMachineRestore {
+					r := createRestore()
+					r.Status = &snapshotv1.VirtualMachineRestoreStatus{}
+					r.Spec.NewIdentity = &snapshotv1.VirtualMachineRestoreIdentity{
+						NewMacAddresses: map[string]string{"default": "02:00:00:00:00:03"},
+						NewSMBiosSerial: pointer.P("new-serial"),
+					}
+					return r
+				}
+
+				It("should assign new identifiers to a new VM", func() {
+					r := newIdentityRestore()
+					r.Spec.Target.Name = newVMName
+					target := &vmRestoreTarget{controller: controller, vmRestore: r}
+
+					newVM, err := target.generateRestoredVMSpec(snapshotVM)
+					Expect(err).ToNot(HaveOccurred())
+					interfaces := newVM.Spec.Template.Spec.Domain.Devices.Interfaces
+					Expect(interfaces[0].MacAddress).To(Equal("02:00:00:00:00:03"))
+					Expect(interfaces[1].MacAddress).To(BeEmpty())
+					Expect(newVM.Spec.Template.Spec.Domain.Firmware.Serial).To(Equal("new-serial"))
+					Expect(newVM.Spec.Template.Spec.Domain.Firmware.UUID).To(BeEmpty())
+				})
+
+				It("should keep the identifiers of an existing VM", func() {
+					r := newIdentityRestore()
+					target := &vmRestoreTarget{controller: controller, vmRestore: r, vm: vm}
+
+					newVM, err := target.generateRestoredVMSpec(snapshotVM)
+					Expect(err).ToNot(HaveOccurred())
+					Expect(newVM.Spec.Template.Spec.Domain.Devices.Interfaces[0].MacAddress).To(Equal("02:00:00:00:00:01"))
+					Expect(newVM.Spec.Template.Spec.Domain.Firmware.Serial).To(Equal("serial"))
+					Expect(newVM.Spec.Template.Spec.Domain.Firmware.UUID).To(BeEquivalentTo("uuid"))
+				})
+			})
+		})
+
+		Context("from a VirtualMachineExport", func() {
+			const (
+				exportToken  = "export-token"
+				headerSecret = "header-secret"
+			)
+
+			const exportPath = "/api/export.kubevirt.io/v1beta1/namespaces/exported/virtualmachineexports/export/external"
+
+			var (
+				server       *httptest.Server
+				allowedHosts []string
+				r            *snapshotv1.VirtualMachineRestore
+				exportedVM   *kubevirtv1.VirtualMachine
+				exportedDV   *cdiv1.DataVolume
+			)
+
+			exportedManifests := func() []byte {
+				cm := &corev1.ConfigMap{
+					TypeMeta: metav1.TypeMeta{
+						APIVersion: "v1",
+						Kind:       "ConfigMap",
+					},
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "export-ca",
+						Namespace: "exported",
+					},
+					Data: map[string]string{"ca.pem": "ca"},
+				}
+
+				list := &corev1.List{
+					TypeMeta: metav1.TypeMeta{
+						APIVersion: "v1",
+						Kind:       "List",
+					},
+				}
+				for _, obj := range []runtime.Object{exportedVM, exportedDV, cm} {
+					list.Items = append(list.Items, runtime.RawExtension{Object: obj})
+				}
+				data, err := json.Marshal(list)
+				Expect(err).ToNot(HaveOccurred())
+				return data
+			}
+
+			BeforeEach(func() {
+				server = httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+					if req.Header.Get(exportTokenHeader) != exportToken {
+						w.WriteHeader(http.StatusUnauthorized)
+						return
+					}
+					if req.URL.Query().Has("redirect") {
+						http.Redirect(w, req, req.URL.Query().Get("redirect"), http.StatusFound)
+						return
+					}
+					_, _ = w.Write(exportedManifests())
+				}))
+				DeferCleanup(server.Close)
+				allowedHosts = []string{"127.0.0.1"}
+
+				// the test server listens on the loopback address
+				checkExportAddressOrig := checkExportAddress
+				checkExportAddress = func(net.IP) error { return nil }
+				DeferCleanup(func() { checkExportAddress = checkExportAddressOrig })
+
+				exportedVM = createSnapshotVM()
+				exportedVM.TypeMeta = metav1.TypeMeta{
+					APIVersion: kubevirtv1.GroupVersion.String(),
+					Kind:       "VirtualMachine",
+				}
+				exportedVM.Spec.RunStrategy = pointer.P(kubevirtv1.RunStrategyAlways)
+				for i := range exportedVM.Spec.DataVolumeTemplates {
+					exportedVM.Spec.DataVolumeTemplates[i].Spec.Source.HTTP.URL = server.URL + exportPath + "/alpine.img.gz"
+				}
+
+				exportedDV = &cdiv1.DataVolume{
+					TypeMeta: metav1.TypeMeta{
+						APIVersion: cdiv1.SchemeGroupVersion.String(),
+						Kind:       "DataVolume",
+					},
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "disk",
+						Namespace: "exported",
+					},
+					Spec: cdiv1.DataVolumeSpec{
+						Source: &cdiv1.DataVolumeSource{
+							HTTP: &cdiv1.DataVolumeSourceHTTP{
+								URL:                server.URL + exportPath + "/disk.img.gz",
+								SecretExtraHeaders: []string{headerSecret},
+							},
+						},
+					},
+				}
+
+				r = createRestore()
+				r.Spec.Target.Name = newVMName
+				r.Spec.VirtualMachineSnapshotName = ""
+				r.Spec.VirtualMachineExport = &snapshotv1.VirtualMachineRestoreExportSource{
+					ManifestURL:    server.URL + exportPath + "/manifests/all",
+					TokenSecretRef: "token",
+					Cert:           string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: server.Certificate().Raw})),
+				}
+			})
+
+			It("should fetch the manifests of the export", func() {
+				manifests, err := fetchExportManifests(r.Spec.VirtualMachineExport, exportToken, allowedHosts)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(manifests.vm.Name).To(Equal(exportedVM.Name))
+				Expect(manifests.dataVolumes).To(HaveLen(1))
+				Expect(manifests.configMaps).To(HaveLen(1))
+			})
+
+			It("should fail to fetch the manifests with a wrong token", func() {
+				_, err := fetchExportManifests(r.Spec.VirtualMachineExport, "wrong-token", allowedHosts)
+				Expect(err).To(MatchError(ContainSubstring("unexpected status 401")))
+			})
+
+			It("should fail to fetch the manifests without the server certificate", func() {
+				r.Spec.VirtualMachineExport.Cert = ""
+				_, err := fetchExportManifests(r.Spec.VirtualMachineExport, exportToken, allowedHosts)
+				Expect(err).To(HaveOccurred())
+			})
+
+			It("should reject a manifest URL that is not an export", func() {
+				r.Spec.VirtualMachineExport.ManifestURL = server.URL + "/manifests/all"
+				_, err := fetchExportManifests(r.Spec.VirtualMachineExport, exportToken, allowedHosts)
+				Expect(err).To(MatchError(ContainSubstring("must point to the external manifests")))
+			})
+
+			It("should not fetch the manifests from a host that is not allowed", func() {
+				_, err := fetchExportManifests(r.Spec.VirtualMachineExport, exportToken, []string{"export.example.com"})
+				Expect(err).To(MatchError(ContainSubstring("not listed in vmRestoreImportAllowedHosts")))
+			})
+
+			It("should not follow redirects of the export server", func() {
+				r.Spec.VirtualMachineExport.ManifestURL = server.URL + exportPath + "/manifests/all?redirect=/api/v1/secrets"
+				_, err := fetchExportManifests(r.Spec.VirtualMachineExport, exportToken, allowedHosts)
+				Expect(err).To(MatchError(ContainSubstring("unexpected status 302")))
+			})
+
+			DescribeTable("should reject a DataVolume not importing from the export server", func(source func() *cdiv1.DataVolumeSource) {
+				exportedDV.Spec.Source = source()
+				_, err := fetchExportManifests(r.Spec.VirtualMachineExport, exportToken, allowedHosts)
+				Expect(err).To(MatchError(ContainSubstring("DataVolume disk")))
+			},
+				Entry("with a PVC source", func() *cdiv1.DataVolumeSource {
+					return &cdiv1.DataVolumeSource{PVC: &cdiv1.DataVolumeSourcePVC{Namespace: "other", Name: "secret-disk"}}
+				}),
+				Entry("with an http source and a PVC source", func() *cdiv1.DataVolumeSource {
+					return &cdiv1.DataVolumeSource{
+						HTTP: &cdiv1.DataVolumeSourceHTTP{URL: server.URL + exportPath + "/disk.img.gz"},
+						PVC:  &cdiv1.DataVolumeSourcePVC{Namespace: "other", Name: "secret-disk"},
+					}
+				}),
+				Entry("with an http source of another host", func() *cdiv1.DataVolumeSource {
+					return &cdiv1.DataVolumeSource{HTTP: &cdiv1.DataVolumeSourceHTTP{URL: "http://kubernetes.default.svc/disk.img.gz"}}
+				}),
+				Entry("without a source", func() *cdiv1.DataVolumeSource {
+					return nil
+				}),
+			)
+
+			It("should reject a DataVolumeSourceRef", func() {
+				exportedDV.Spec.SourceRef = &cdiv1.DataVolumeSourceRef{Kind: "DataSource", Name: "golden", Namespace: pointer.P("other")}
+				_, err := fetchExportManifests(r.Spec.VirtualMachineExport, exportToken, allowedHosts)
+				Expect(err).To(MatchError(ContainSubstring("only an http source is allowed")))
+			})
+
+			It("should not create anything when a DataVolumeTemplate does not import from the export server", func() {
+				exportedVM.Spec.DataVolumeTemplates[0].Spec.Source = &cdiv1.DataVolumeSource{
+					PVC: &cdiv1.DataVolumeSourcePVC{Namespace: "other", Name: "secret-disk"},
+				}
+				manifests, err := fetchExportManifests(r.Spec.VirtualMachineExport, exportToken, allowedHosts)
+				Expect(err).ToNot(HaveOccurred())
+
+				k8sClient.Fake.PrependReactor("create", "*", func(action testing.Action) (handled bool, obj runtime.Object, err error) {
+					Fail("unexpected create of " + action.GetResource().Resource)
+					return true, nil, nil
+				})
+				err = controller.createImportObjects(r, manifests, exportToken)
+				Expect(err).To(MatchError(ContainSubstring("DataVolumeTemplate alpine-dv")))
+			})
+
+			It("should create the imported objects with a halted VirtualMachine", func() {
+				manifests, err := fetchExportManifests(r.Spec.VirtualMachineExport, exportToken, allowedHosts)
+				Expect(err).ToNot(HaveOccurred())
+
+				var created []string
+				k8sClient.Fake.PrependReactor("create", "*", func(action testing.Action) (handled bool, obj runtime.Object, err error) {
+					create := action.(testing.CreateAction)
+					Expect(create.GetNamespace()).To(Equal(testNamespace))
+					created = append(created, action.GetResource().Resource)
+					if secret, ok := create.GetObject().(*corev1.Secret); ok {
+						Expect(secret.Name).To(Equal(headerSecret))
+						Expect(secret.StringData).To(HaveKeyWithValue("token", exportTokenHeader+":"+exportToken))
+					}
+					return true, create.GetObject(), nil
+				})
+				vmInterface.EXPECT().Create(context.Background(), gomock.Any(), metav1.CreateOptions{}).DoAndReturn(
+					func(_ context.Context, newVM *kubevirtv1.VirtualMachine, _ metav1.CreateOptions) (*kubevirtv1.VirtualMachine, error) {
+						created = append(created, "virtualmachines")
+						Expect(newVM.Name).To(Equal(newVMName))
+						Expect(newVM.Namespace).To(Equal(testNamespace))
+						Expect(newVM.Spec.RunStrategy).To(HaveValue(Equal(kubevirtv1.RunStrategyHalted)))
+						Expect(newVM.Annotations).To(HaveKeyWithValue(lastRestoreAnnotation, getRestoreAnnotationValue(r)))
+						return newVM, nil
+					})
+
+				Expect(controller.createImportObjects(r, manifests, exportToken)).To(Succeed())
+				Expect(created).To(Equal([]string{"configmaps", "secrets", "virtualmachines"}))
+
+				dv, err := cdiClient.CdiV1beta1().DataVolumes(testNamespace).Get(context.Background(), "disk", metav1.GetOptions{})
+				Expect(err).ToNot(HaveOccurred())
+				Expect(dv.OwnerReferences).To(BeEmpty())
+			})
+		})
+
+		Context("validating the host of a VirtualMachineExport", func() {
+			const exportPath = "/api/export.kubevirt.io/v1beta1/namespaces/exported/virtualmachineexports/export/external/manifests/all"
+
+			DescribeTable("should allow", func(host string, allowedHosts []string) {
+				u, err := url.Parse("https://" + host + exportPath)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(ValidateExportHost(u, allowedHosts)).To(Succeed())
+			},
+				Entry("a listed host", "export.example.com", []string{"other.example.com", "export.example.com"}),
+				Entry("a listed host with a port", "export.example.com:8443", []string{"export.example.com"}),
+				Entry("a host of a listed domain", "export.example.com", []string{"*.example.com"}),
+				Entry("a listed IP address", "192.0.2.10", []string{"192.0.2.10"}),
+			)
+
+			DescribeTable("should reject", func(host string, allowedHosts []string, expectedErr string) {
+				u, err := url.Parse("https://" + host + exportPath)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(ValidateExportHost(u, allowedHosts)).To(MatchError(ContainSubstring(expectedErr)))
+			},
+				Entry("any host without allowed hosts", "export.example.com", nil, "requires its host to be listed"),
+				Entry("a host that is not listed", "export.example.org", []string{"export.example.com", "*.example.com"}, "is not listed"),
+				Entry("the domain of a listed subdomain wildcard", "example.com", []string{"*.example.com"}, "is not listed"),
+				Entry("a service of the cluster", "kubernetes.default.svc", []string{"kubernetes.default.svc"}, "internal to the cluster"),
+				Entry("a fully qualified service of the cluster", "kubernetes.default.svc.cluster.local", []string{"*.cluster.local"}, "internal to the cluster"),
+				Entry("a host without a domain", "kubernetes", []string{"kubernetes"}, "internal to the cluster"),
+				Entry("a loopback address", "127.0.0.1", []string{"127.0.0.1"}, "is not allowed"),
+				Entry("a link-local address", "169.254.169.254", []string{"169.254.169.254"}, "is not allowed"),
+				Entry("an IPv6 link-local address", "[fe80::1]", []string{"fe80::1"}, "is not allowed"),
+			)
+
+			DescribeTable("should refuse to connect to", func(address string) {
+				Expect(dialExportServer("tcp", address, nil)).To(MatchError(ContainSubstring("is not allowed")))
+			},
+				Entry("a loopback address", "127.0.0.1:443"),
+				Entry("a link-local address", "169.254.169.254:443"),
+				Entry("an unspecified address", "[::]:443"),
+			)
+
+			It("should connect to other addresses", func() {
+				Expect(dialExportServer("tcp", "192.0.2.10:443", nil)).To(Succeed())
+			})
 		})
 
 		It("should create restore PVCs with populated dataSourceRef and dataSource", func() {
@@ -0,0 +1,463 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package snapshot
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/openshift/library-go/pkg/build/naming"
+	"github.com/robfig/cron/v3"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/util/validation"
+	"k8s.io/client-go/tools/cache"
+
+	"kubevirt.io/api/core"
+	kubevirtv1 "kubevirt.io/api/core/v1"
+	snapshotv1 "kubevirt.io/api/snapshot/v1beta1"
+	"kubevirt.io/client-go/log"
+
+	"kubevirt.io/kubevirt/pkg/pointer"
+)
+
+const (
+	vmSnapshotScheduleCreateEvent = "SuccessfulVirtualMachineSnapshotCreate"
+
+	vmSnapshotScheduleCreateErrorEvent = "VirtualMachineSnapshotCreateError"
+
+	vmSnapshotScheduleDeleteEvent = "SuccessfulVirtualMachineSnapshotDelete"
+
+	vmSnapshotScheduleInvalidEvent = "InvalidSchedule"
+
+	scheduleActiveReason = "Schedule active"
+
+	scheduleDisabledReason = "Schedule disabled"
+
+	scheduleInvalidReason = "Invalid schedule"
+
+	scheduledSnapshotNameTimeFormat = "20060102-150405"
+
+	// maxMissedSchedules bounds the schedule times looked at for the latest
+	// missed one, like the CronJob controller bounds its missed start times
+	maxMissedSchedules = 100
+)
+
+func (ctrl *VMSnapshotScheduleController) updateVMSnapshotSchedule(schedule *snapshotv1.VirtualMachineSnapshotSchedule) (time.Duration, error) {
+	log.Log.V(3).Infof("Updating VirtualMachineSnapshotSchedule %s/%s", schedule.Namespace, schedule.Name)
+
+	// Snapshots are not owned by the schedule, they outlive it on purpose
+	if schedule.DeletionTimestamp != nil {
+		return 0, nil
+	}
+
+	scheduleCpy := schedule.DeepCopy()
+	if scheduleCpy.Status == nil {
+		scheduleCpy.Status = &snapshotv1.VirtualMachineSnapshotScheduleStatus{}
+	}
+
+	cronSchedule, err := cron.ParseStandard(schedule.Spec.Schedule)
+	if err != nil {
+		message := fmt.Sprintf("Invalid schedule %q: %v", schedule.Spec.Schedule, err)
+		ctrl.Recorder.Event(schedule, corev1.EventTypeWarning, vmSnapshotScheduleInvalidEvent, message)
+		scheduleCpy.Status.NextScheduleTime = nil
+		scheduleCpy.Status.LastFailure = &snapshotv1.ScheduledSnapshotResult{
+			Time:    currentTime(),
+			Message: &message,
+		}
+		scheduleCpy.Status.Conditions = updateCondition(scheduleCpy.Status.Conditions, newReadyCondition(corev1.ConditionFalse, scheduleInvalidReason), true)
+		// Nothing to retry until the spec changes
+		return 0, ctrl.updateVMSnapshotScheduleStatus(schedule, scheduleCpy)
+	}
+
+	snapshots, err := ctrl.getScheduledSnapshots(schedule)
+	if err != nil {
+		return 0, err
+	}
+
+	now := currentTime()
+	var retry time.Duration
+	if scheduleDisabled(schedule) {
+		scheduleCpy.Status.NextScheduleTime = nil
+		scheduleCpy.Status.Conditions = updateCondition(scheduleCpy.Status.Conditions, newReadyCondition(corev1.ConditionFalse, scheduleDisabledReason), true)
+	} else {
+		if scheduleTime := getLatestScheduleTime(cronSchedule, getLastScheduleTime(schedule), now.Time); scheduleTime != nil {
+			created, err := ctrl.createScheduledSnapshots(schedule, *scheduleTime)
+			if err != nil {
+				message := err.Error()
+				scheduleCpy.Status.LastFailure = &snapshotv1.ScheduledSnapshotResult{
+					Time:    now,
+					Message: &message,
+				}
+				if updateErr := ctrl.updateVMSnapshotScheduleStatus(schedule, scheduleCpy); updateErr != nil {
+					log.Log.Reason(updateErr).Errorf("Failed to update status of VirtualMachineSnapshotSchedule %s/%s", schedule.Namespace, schedule.Name)
+				}
+				return 0, err
+			}
+			snapshots = append(snapshots, created...)
+			scheduleCpy.Status.LastScheduleTime = &metav1.Time{Time: *scheduleTime}
+		}
+
+		next := metav1.NewTime(cronSchedule.Next(now.Time))
+		scheduleCpy.Status.NextScheduleTime = &next
+		scheduleCpy.Status.Conditions = updateCondition(scheduleCpy.Status.Conditions, newReadyCondition(corev1.ConditionTrue, scheduleActiveReason), true)
+		retry = next.Sub(now.Time)
+	}
+
+	updateScheduledSnapshotResults(scheduleCpy.Status, snapshots)
+
+	if err := ctrl.applyRetention(schedule, snapshots); err != nil {
+		return 0, err
+	}
+
+	if err := ctrl.updateVMSnapshotScheduleStatus(schedule, scheduleCpy); err != nil {
+		return 0, err
+	}
+
+	return retry, nil
+}
+
+func (ctrl *VMSnapshotScheduleController) updateVMSnapshotScheduleStatus(schedule, scheduleCpy *snapshotv1.VirtualMachineSnapshotSchedule) error {
+	if equality.Semantic.DeepEqual(schedule.Status, scheduleCpy.Status) {
+		return nil
+	}
+
+	_, err := ctrl.Client.VirtualMachineSnapshotSchedule(scheduleCpy.Namespace).UpdateStatus(context.Background(), scheduleCpy, metav1.UpdateOptions{})
+	return err
+}
+
+func (ctrl *VMSnapshotScheduleController) getScheduledSnapshots(schedule *snapshotv1.VirtualMachineSnapshotSchedule) ([]*snapshotv1.VirtualMachineSnapshot, error) {
+	objs, err := ctrl.VMSnapshotInformer.GetIndexer().ByIndex("schedule", cacheKeyFunc(schedule.Namespace, schedule.Name))
+	if err != nil {
+		return nil, err
+	}
+
+	var snapshots []*snapshotv1.VirtualMachineSnapshot
+	for _, obj := range objs {
+		vmSnapshot, ok := obj.(*snapshotv1.VirtualMachineSnapshot)
+		if !ok {
+			return nil, fmt.Errorf(unexpectedResourceFmt, obj)
+		}
+		snapshots = append(snapshots, vmSnapshot)
+	}
+
+	return snapshots, nil
+}
+
+func (ctrl *VMSnapshotScheduleController) getSelectedVMs(schedule *snapshotv1.VirtualMachineSnapshotSchedule) ([]*kubevirtv1.VirtualMachine, error) {
+	selector, err := metav1.LabelSelectorAsSelector(&schedule.Spec.Selector)
+	if err != nil {
+		return nil, err
+	}
+
+	objs, err := ctrl.VMInformer.GetIndexer().ByIndex(cache.NamespaceIndex, schedule.Namespace)
+	if err != nil {
+		return nil, err
+	}
+
+	var vms []*kubevirtv1.VirtualMachine
+	for _, obj := range objs {
+		vm, ok := obj.(*kubevirtv1.VirtualMachine)
+		if !ok {
+			return nil, fmt.Errorf(unexpectedResourceFmt, obj)
+		}
+		if vm.DeletionTimestamp != nil || !selector.Matches(labels.Set(vm.Labels)) {
+			continue
+		}
+		vms = append(vms, vm)
+	}
+
+	sort.Slice(vms, func(i, j int) bool {
+		return vms[i].Name < vms[j].Name
+	})
+
+	return vms, nil
+}
+
+func (ctrl *VMSnapshotScheduleController) createScheduledSnapshots(schedule *snapshotv1.VirtualMachineSnapshotSchedule, scheduleTime time.Time) ([]*snapshotv1.VirtualMachineSnapshot, error) {
+	vms, err := ctrl.getSelectedVMs(schedule)
+	if err != nil {
+		return nil, err
+	}
+
+	var created []*snapshotv1.VirtualMachineSnapshot
+	for _, vm := range vms {
+		vmSnapshot := newScheduledSnapshot(schedule, vm, scheduleTime)
+		vmSnapshot, err := ctrl.Client.VirtualMachineSnapshot(schedule.Namespace).Create(context.Background(), vmSnapshot, metav1.CreateOptions{})
+		if k8serrors.IsAlreadyExists(err) {
+			continue
+		}
+		if err != nil {
+			ctrl.Recorder.Eventf(
+				schedule,
+				corev1.EventTypeWarning,
+				vmSnapshotScheduleCreateErrorEvent,
+				"Error creating VirtualMachineSnapshot of VirtualMachine %s: %v",
+				vm.Name,
+				err,
+			)
+			return created, fmt.Errorf("failed to create VirtualMachineSnapshot of VirtualMachine %s: %v", vm.Name, err)
+		}
+
+		ctrl.Recorder.Eventf(
+			schedule,
+			corev1.EventTypeNormal,
+			vmSnapshotScheduleCreateEvent,
+			"Successfully created VirtualMachineSnapshot %s",
+			vmSnapshot.Name,
+		)
+		created = append(created, vmSnapshot)
+	}
+
+	return created, nil
+}
+
+func newScheduledSnapshot(schedule *snapshotv1.VirtualMachineSnapshotSchedule, vm *kubevirtv1.VirtualMachine, scheduleTime time.Time) *snapshotv1.VirtualMachineSnapshot {
+	name := naming.GetName(
+		fmt.Sprintf("%s-%s", schedule.Name, vm.Name),
+		scheduleTime.UTC().Format(scheduledSnapshotNameTimeFormat),
+		validation.DNS1123SubdomainMaxLength,
+	)
+
+	vmSnapshot := &snapshotv1.VirtualMachineSnapshot{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: schedule.Namespace,
+			Labels:    map[string]string{},
+		},
+		Spec: snapshotv1.VirtualMachineSnapshotSpec{
+			Source: corev1.TypedLocalObjectReference{
+				APIGroup: pointer.P(core.GroupName),
+				Kind:     "VirtualMachine",
+				Name:     vm.Name,
+			},
+		},
+	}
+
+	if template := schedule.Spec.SnapshotTemplate; template != nil {
+		for k, v := range template.Labels {
+			vmSnapshot.Labels[k] = v
+		}
+		vmSnapshot.Spec.DeletionPolicy = template.DeletionPolicy
+		vmSnapshot.Spec.FailureDeadline = template.FailureDeadline
+	}
+	vmSnapshot.Labels[snapshotv1.SnapshotScheduleLabel] = schedule.Name
+
+	return vmSnapshot
+}
+
+// applyRetention deletes the snapshots which are not selected by any retention rule.
+// Snapshots still in progress are never deleted, failed snapshots are deleted
+// once a newer snapshot of the same VM succeeded.
+func (ctrl *VMSnapshotScheduleController) applyRetention(schedule *snapshotv1.VirtualMachineSnapshotSchedule, snapshots []*snapshotv1.VirtualMachineSnapshot) error {
+	retention := schedule.Spec.Retention
+	if retention == nil || (retention.Last == nil && retention.Daily == nil && retention.Weekly == nil) {
+		return nil
+	}
+
+	for _, vmSnapshot := range snapshotsToDelete(retention, snapshots) {
+		err := ctrl.Client.VirtualMachineSnapshot(vmSnapshot.Namespace).Delete(context.Background(), vmSnapshot.Name, metav1.DeleteOptions{})
+		if err != nil && !k8serrors.IsNotFound(err) {
+			return err
+		}
+
+		ctrl.Recorder.Eventf(
+			schedule,
+			corev1.EventTypeNormal,
+			vmSnapshotScheduleDeleteEvent,
+			"Successfully deleted VirtualMachineSnapshot %s",
+			vmSnapshot.Name,
+		)
+	}
+
+	return nil
+}
+
+func snapshotsToDelete(retention *snapshotv1.VirtualMachineSnapshotScheduleRetention, snapshots []*snapshotv1.VirtualMachineSnapshot) []*snapshotv1.VirtualMachineSnapshot {
+	succeeded := map[string][]*snapshotv1.VirtualMachineSnapshot{}
+	failed := map[string][]*snapshotv1.VirtualMachineSnapshot{}
+	for _, vmSnapshot := range snapshots {
+		if vmSnapshot.DeletionTimestamp != nil || vmSnapshot.Status == nil {
+			continue
+		}
+		vmName := vmSnapshot.Spec.Source.Name
+		switch vmSnapshot.Status.Phase {
+		case snapshotv1.Succeeded:
+			succeeded[vmName] = append(succeeded[vmName], vmSnapshot)
+		case snapshotv1.Failed:
+			failed[vmName] = append(failed[vmName], vmSnapshot)
+		}
+	}
+
+	var result []*snapshotv1.VirtualMachineSnapshot
+	for vmName, vmSnapshots := range succeeded {
+		sortSnapshotsNewestFirst(vmSnapshots)
+		keep := retainedSnapshots(retention, vmSnapshots)
+		for _, vmSnapshot := range vmSnapshots {
+			if !keep[vmSnapshot.Name] {
+				result = append(result, vmSnapshot)
+			}
+		}
+
+		newest := vmSnapshots[0].CreationTimestamp
+		for _, vmSnapshot := range failed[vmName] {
+			if vmSnapshot.CreationTimestamp.Before(&newest) {
+				result = append(result, vmSnapshot)
+			}
+		}
+	}
+
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].Name < result[j].Name
+	})
+
+	return result
+}
+
+// retainedSnapshots expects the snapshots of a single VM sorted newest first
+func retainedSnapshots(retention *snapshotv1.VirtualMachineSnapshotScheduleRetention, vmSnapshots []*snapshotv1.VirtualMachineSnapshot) map[string]bool {
+	keep := map[string]bool{}
+
+	if retention.Last != nil {
+		for i := 0; i < len(vmSnapshots) && i < int(*retention.Last); i++ {
+			keep[vmSnapshots[i].Name] = true
+		}
+	}
+
+	keepPerPeriod := func(count *int32, period func(t time.Time) string) {
+		if count == nil {
+			return
+		}
+		seen := map[string]bool{}
+		for _, vmSnapshot := range vmSnapshots {
+			p := period(vmSnapshot.CreationTimestamp.UTC())
+			if seen[p] {
+				continue
+			}
+			if len(seen) >= int(*count) {
+				return
+			}
+			seen[p] = true
+			keep[vmSnapshot.Name] = true
+		}
+	}
+
+	keepPerPeriod(retention.Daily, func(t time.Time) string {
+		return t.Format(time.DateOnly)
+	})
+	keepPerPeriod(retention.Weekly, func(t time.Time) string {
+		year, week := t.ISOWeek()
+		return fmt.Sprintf("%d-%d", year, week)
+	})
+
+	return keep
+}
+
+func updateScheduledSnapshotResults(status *snapshotv1.VirtualMachineSnapshotScheduleStatus, snapshots []*snapshotv1.VirtualMachineSnapshot) {
+	for _, vmSnapshot := range snapshots {
+		if vmSnapshot.Status == nil {
+			continue
+		}
+
+		switch vmSnapshot.Status.Phase {
+		case snapshotv1.Succeeded:
+			t := vmSnapshot.CreationTimestamp.DeepCopy()
+			if vmSnapshot.Status.CreationTime != nil {
+				t = vmSnapshot.Status.CreationTime.DeepCopy()
+			}
+			if newerResult(status.LastSuccess, t) {
+				status.LastSuccess = &snapshotv1.ScheduledSnapshotResult{
+					VirtualMachineSnapshotName: vmSnapshot.Name,
+					Time:                       t,
+				}
+			}
+		case snapshotv1.Failed:
+			t := vmSnapshot.CreationTimestamp.DeepCopy()
+			var message *string
+			if vmSnapshot.Status.Error != nil {
+				if vmSnapshot.Status.Error.Time != nil {
+					t = vmSnapshot.Status.Error.Time.DeepCopy()
+				}
+				message = vmSnapshot.Status.Error.Message
+			}
+			if newerResult(status.LastFailure, t) {
+				status.LastFailure = &snapshotv1.ScheduledSnapshotResult{
+					VirtualMachineSnapshotName: vmSnapshot.Name,
+					Time:                       t,
+					Message:                    message,
+				}
+			}
+		}
+	}
+}
+
+func newerResult(result *snapshotv1.ScheduledSnapshotResult, t *metav1.Time) bool {
+	return result == nil || result.Time == nil || result.Time.Before(t)
+}
+
+func sortSnapshotsNewestFirst(vmSnapshots []*snapshotv1.VirtualMachineSnapshot) {
+	sort.Slice(vmSnapshots, func(i, j int) bool {
+		ti, tj := vmSnapshots[i].CreationTimestamp, vmSnapshots[j].CreationTimestamp
+		if ti.Equal(&tj) {
+			return vmSnapshots[i].Name > vmSnapshots[j].Name
+		}
+		return tj.Before(&ti)
+	})
+}
+
+func scheduleDisabled(schedule *snapshotv1.VirtualMachineSnapshotSchedule) bool {
+	return schedule.Spec.Disabled != nil && *schedule.Spec.Disabled
+}
+
+func getLastScheduleTime(schedule *snapshotv1.VirtualMachineSnapshotSchedule) time.Time {
+	if schedule.Status != nil && schedule.Status.LastScheduleTime != nil {
+		return schedule.Status.LastScheduleTime.Time
+	}
+	return schedule.CreationTimestamp.Time
+}
+
+// getLatestScheduleTime returns the most recent scheduled time after since and not after now.
+// Missed schedules are not caught up, only the latest one is taken. Schedule times older than
+// maxMissedSchedules times the interval of the schedule are skipped without looking at them.
+func getLatestScheduleTime(cronSchedule cron.Schedule, since, now time.Time) *time.Time {
+	first := cronSchedule.Next(since)
+	if first.IsZero() || first.After(now) {
+		return nil
+	}
+	if second := cronSchedule.Next(first); !second.IsZero() && second.After(first) {
+		if skipTo := now.Add(-maxMissedSchedules * second.Sub(first)); skipTo.After(since) {
+			since = skipTo
+		}
+	}
+
+	var latest *time.Time
+	t := cronSchedule.Next(since)
+	for i := 0; i < maxMissedSchedules && !t.IsZero() && !t.After(now); i++ {
+		scheduleTime := t
+		latest = &scheduleTime
+		t = cronSchedule.Next(t)
+	}
+	return latest
+}
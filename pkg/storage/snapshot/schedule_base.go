@@ -0,0 +1,165 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package snapshot
+
+import (
+	"fmt"
+	"time"
+
+	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/client-go/util/workqueue"
+
+	snapshotv1 "kubevirt.io/api/snapshot/v1beta1"
+	"kubevirt.io/client-go/kubecli"
+	"kubevirt.io/client-go/log"
+
+	watchutil "kubevirt.io/kubevirt/pkg/virt-controller/watch/util"
+)
+
+// VMSnapshotScheduleController is responsible for periodically snapshotting VMs
+type VMSnapshotScheduleController struct {
+	Client kubecli.KubevirtClient
+
+	VMSnapshotScheduleInformer cache.SharedIndexInformer
+	VMSnapshotInformer         cache.SharedIndexInformer
+	VMInformer                 cache.SharedIndexInformer
+
+	Recorder record.EventRecorder
+
+	vmSnapshotScheduleQueue workqueue.TypedRateLimitingInterface[string]
+}
+
+// Init initializes the snapshot schedule controller
+func (ctrl *VMSnapshotScheduleController) Init() error {
+	ctrl.vmSnapshotScheduleQueue = workqueue.NewTypedRateLimitingQueueWithConfig[string](
+		workqueue.DefaultTypedControllerRateLimiter[string](),
+		workqueue.TypedRateLimitingQueueConfig[string]{Name: "virt-controller-snapshot-schedule"},
+	)
+
+	_, err := ctrl.VMSnapshotScheduleInformer.AddEventHandler(
+		cache.ResourceEventHandlerFuncs{
+			AddFunc:    ctrl.handleVMSnapshotSchedule,
+			UpdateFunc: func(oldObj, newObj interface{}) { ctrl.handleVMSnapshotSchedule(newObj) },
+			DeleteFunc: ctrl.handleVMSnapshotSchedule,
+		},
+	)
+	if err != nil {
+		return err
+	}
+
+	_, err = ctrl.VMSnapshotInformer.AddEventHandler(
+		cache.ResourceEventHandlerFuncs{
+			AddFunc:    ctrl.handleVMSnapshot,
+			UpdateFunc: func(oldObj, newObj interface{}) { ctrl.handleVMSnapshot(newObj) },
+			DeleteFunc: ctrl.handleVMSnapshot,
+		},
+	)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// Run the controller
+func (ctrl *VMSnapshotScheduleController) Run(threadiness int, stopCh <-chan struct{}) error {
+	defer utilruntime.HandleCrash()
+	defer ctrl.vmSnapshotScheduleQueue.ShutDown()
+
+	log.Log.Info("Starting snapshot schedule controller.")
+	defer log.Log.Info("Shutting down snapshot schedule controller.")
+
+	if !cache.WaitForCacheSync(
+		stopCh,
+		ctrl.VMSnapshotScheduleInformer.HasSynced,
+		ctrl.VMSnapshotInformer.HasSynced,
+		ctrl.VMInformer.HasSynced,
+	) {
+		return fmt.Errorf("failed to wait for caches to sync")
+	}
+
+	for i := 0; i < threadiness; i++ {
+		go wait.Until(ctrl.vmSnapshotScheduleWorker, time.Second, stopCh)
+	}
+
+	<-stopCh
+
+	return nil
+}
+
+func (ctrl *VMSnapshotScheduleController) vmSnapshotScheduleWorker() {
+	for ctrl.processVMSnapshotScheduleWorkItem() {
+	}
+}
+
+func (ctrl *VMSnapshotScheduleController) processVMSnapshotScheduleWorkItem() bool {
+	return watchutil.ProcessWorkItem(ctrl.vmSnapshotScheduleQueue, func(key string) (time.Duration, error) {
+		log.Log.V(3).Infof("vmSnapshotSchedule worker processing key [%s]", key)
+
+		storeObj, exists, err := ctrl.VMSnapshotScheduleInformer.GetStore().GetByKey(key)
+		if !exists || err != nil {
+			return 0, err
+		}
+
+		schedule, ok := storeObj.(*snapshotv1.VirtualMachineSnapshotSchedule)
+		if !ok {
+			return 0, fmt.Errorf(unexpectedResourceFmt, storeObj)
+		}
+
+		return ctrl.updateVMSnapshotSchedule(schedule.DeepCopy())
+	})
+}
+
+func (ctrl *VMSnapshotScheduleController) handleVMSnapshotSchedule(obj interface{}) {
+	if unknown, ok := obj.(cache.DeletedFinalStateUnknown); ok && unknown.Obj != nil {
+		obj = unknown.Obj
+	}
+
+	if schedule, ok := obj.(*snapshotv1.VirtualMachineSnapshotSchedule); ok {
+		objName, err := cache.DeletionHandlingMetaNamespaceKeyFunc(schedule)
+		if err != nil {
+			log.Log.Errorf(failedKeyFromObjectFmt, err, schedule)
+			return
+		}
+
+		log.Log.V(3).Infof(enqueuedForSyncFmt, objName)
+		ctrl.vmSnapshotScheduleQueue.Add(objName)
+	}
+}
+
+func (ctrl *VMSnapshotScheduleController) handleVMSnapshot(obj interface{}) {
+	if unknown, ok := obj.(cache.DeletedFinalStateUnknown); ok && unknown.Obj != nil {
+		obj = unknown.Obj
+	}
+
+	if vmSnapshot, ok := obj.(*snapshotv1.VirtualMachineSnapshot); ok {
+		scheduleName, ok := vmSnapshot.Labels[snapshotv1.SnapshotScheduleLabel]
+		if !ok {
+			return
+		}
+
+		k := cacheKeyFunc(vmSnapshot.Namespace, scheduleName)
+		log.Log.V(3).Infof("Handling VMSnapshot %s/%s, schedule %s", vmSnapshot.Namespace, vmSnapshot.Name, k)
+		ctrl.vmSnapshotScheduleQueue.Add(k)
+	}
+}
@@ -0,0 +1,376 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package snapshot
+
+import (
+	"time"
+
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/robfig/cron/v3"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/testing"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/client-go/tools/record"
+
+	kubevirtv1 "kubevirt.io/api/core/v1"
+	snapshotv1 "kubevirt.io/api/snapshot/v1beta1"
+	"kubevirt.io/client-go/kubecli"
+	kubevirtfake "kubevirt.io/client-go/kubevirt/fake"
+
+	virtcontroller "kubevirt.io/kubevirt/pkg/controller"
+	"kubevirt.io/kubevirt/pkg/pointer"
+	"kubevirt.io/kubevirt/pkg/testutils"
+)
+
+var _ = Describe("Snapshot schedule controller", func() {
+	const (
+		testNamespace = "default"
+		scheduleName  = "nightly"
+	)
+
+	var (
+		now          = time.Date(2024, 5, 10, 12, 30, 0, 0, time.UTC)
+		creationTime = metav1.NewTime(time.Date(2024, 5, 1, 0, 0, 0, 0, time.UTC))
+
+		controller     *VMSnapshotScheduleController
+		recorder       *record.FakeRecorder
+		kubevirtClient *kubevirtfake.Clientset
+
+		vmSnapshotScheduleInformer cache.SharedIndexInformer
+		vmSnapshotInformer         cache.SharedIndexInformer
+		vmInformer                 cache.SharedIndexInformer
+
+		createdSnapshots []*snapshotv1.VirtualMachineSnapshot
+		deletedSnapshots []string
+		updatedStatuses  []*snapshotv1.VirtualMachineSnapshotScheduleStatus
+	)
+
+	createSchedule := func() *snapshotv1.VirtualMachineSnapshotSchedule {
+		return &snapshotv1.VirtualMachineSnapshotSchedule{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:              scheduleName,
+				Namespace:         testNamespace,
+				CreationTimestamp: creationTime,
+			},
+			Spec: snapshotv1.VirtualMachineSnapshotScheduleSpec{
+				Selector: metav1.LabelSelector{
+					MatchLabels: map[string]string{"backup": "true"},
+				},
+				Schedule: "0 2 * * *",
+			},
+		}
+	}
+
+	createVM := func(name string, labels map[string]string) *kubevirtv1.VirtualMachine {
+		return &kubevirtv1.VirtualMachine{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      name,
+				Namespace: testNamespace,
+				Labels:    labels,
+			},
+			Spec: kubevirtv1.VirtualMachineSpec{
+				Template: &kubevirtv1.VirtualMachineInstanceTemplateSpec{},
+			},
+		}
+	}
+
+	createScheduledSnapshot := func(name, vmName string, phase snapshotv1.VirtualMachineSnapshotPhase, created time.Time) *snapshotv1.VirtualMachineSnapshot {
+		return &snapshotv1.VirtualMachineSnapshot{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:              name,
+				Namespace:         testNamespace,
+				CreationTimestamp: metav1.NewTime(created),
+				Labels:            map[string]string{snapshotv1.SnapshotScheduleLabel: scheduleName},
+			},
+			Spec: snapshotv1.VirtualMachineSnapshotSpec{
+				Source: corev1.TypedLocalObjectReference{
+					APIGroup: pointer.P("kubevirt.io"),
+					Kind:     "VirtualMachine",
+					Name:     vmName,
+				},
+			},
+			Status: &snapshotv1.VirtualMachineSnapshotStatus{
+				Phase: phase,
+			},
+		}
+	}
+
+	BeforeEach(func() {
+		vmSnapshotScheduleInformer, _ = testutils.NewFakeInformerFor(&snapshotv1.VirtualMachineSnapshotSchedule{})
+		vmSnapshotInformer, _ = testutils.NewFakeInformerWithIndexersFor(&snapshotv1.VirtualMachineSnapshot{}, virtcontroller.GetVirtualMachineSnapshotInformerIndexers())
+		vmInformer, _ = testutils.NewFakeInformerWithIndexersFor(&kubevirtv1.VirtualMachine{}, virtcontroller.GetVirtualMachineInformerIndexers())
+
+		recorder = record.NewFakeRecorder(100)
+		recorder.IncludeObject = true
+
+		virtClient := kubecli.NewMockKubevirtClient(gomock.NewController(GinkgoT()))
+		kubevirtClient = kubevirtfake.NewSimpleClientset()
+		virtClient.EXPECT().VirtualMachineSnapshot(testNamespace).
+			Return(kubevirtClient.SnapshotV1beta1().VirtualMachineSnapshots(testNamespace)).AnyTimes()
+		virtClient.EXPECT().VirtualMachineSnapshotSchedule(testNamespace).
+			Return(kubevirtClient.SnapshotV1beta1().VirtualMachineSnapshotSchedules(testNamespace)).AnyTimes()
+
+		createdSnapshots = nil
+		deletedSnapshots = nil
+		updatedStatuses = nil
+		kubevirtClient.Fake.PrependReactor("create", "virtualmachinesnapshots", func(action testing.Action) (handled bool, obj runtime.Object, err error) {
+			vmSnapshot := action.(testing.CreateAction).GetObject().(*snapshotv1.VirtualMachineSnapshot)
+			createdSnapshots = append(createdSnapshots, vmSnapshot)
+			return true, vmSnapshot, nil
+		})
+		kubevirtClient.Fake.PrependReactor("delete", "virtualmachinesnapshots", func(action testing.Action) (handled bool, obj runtime.Object, err error) {
+			deletedSnapshots = append(deletedSnapshots, action.(testing.DeleteAction).GetName())
+			return true, nil, nil
+		})
+		kubevirtClient.Fake.PrependReactor("update", "virtualmachinesnapshotschedules", func(action testing.Action) (handled bool, obj runtime.Object, err error) {
+			Expect(action.GetSubresource()).To(Equal("status"))
+			schedule := action.(testing.UpdateAction).GetObject().(*snapshotv1.VirtualMachineSnapshotSchedule)
+			updatedStatuses = append(updatedStatuses, schedule.Status)
+			return true, schedule, nil
+		})
+
+		controller = &VMSnapshotScheduleController{
+			Client:                     virtClient,
+			VMSnapshotScheduleInformer: vmSnapshotScheduleInformer,
+			VMSnapshotInformer:         vmSnapshotInformer,
+			VMInformer:                 vmInformer,
+			Recorder:                   recorder,
+		}
+		Expect(controller.Init()).To(Succeed())
+
+		currentTime = func() *metav1.Time {
+			t := metav1.NewTime(now)
+			return &t
+		}
+	})
+
+	AfterEach(func() {
+		currentTime = func() *metav1.Time {
+			t := metav1.Now()
+			return &t
+		}
+	})
+
+	Context("scheduling", func() {
+		It("should snapshot the selected VMs at the latest missed schedule time", func() {
+			Expect(vmInformer.GetIndexer().Add(createVM("vm-a", map[string]string{"backup": "true"}))).To(Succeed())
+			Expect(vmInformer.GetIndexer().Add(createVM("vm-b", map[string]string{"backup": "true"}))).To(Succeed())
+			Expect(vmInformer.GetIndexer().Add(createVM("vm-c", nil))).To(Succeed())
+
+			schedule := createSchedule()
+			schedule.Spec.SnapshotTemplate = &snapshotv1.VirtualMachineSnapshotTemplateSpec{
+				Labels:         map[string]string{"team": "storage"},
+				DeletionPolicy: pointer.P(snapshotv1.VirtualMachineSnapshotContentRetain),
+			}
+
+			retry, err := controller.updateVMSnapshotSchedule(schedule)
+			Expect(err).ToNot(HaveOccurred())
+
+			scheduleTime := time.Date(2024, 5, 10, 2, 0, 0, 0, time.UTC)
+			nextScheduleTime := time.Date(2024, 5, 11, 2, 0, 0, 0, time.UTC)
+			Expect(retry).To(Equal(nextScheduleTime.Sub(now)))
+
+			Expect(createdSnapshots).To(HaveLen(2))
+			for i, vmName := range []string{"vm-a", "vm-b"} {
+				vmSnapshot := createdSnapshots[i]
+				Expect(vmSnapshot.Name).To(Equal("nightly-" + vmName + "-20240510-020000"))
+				Expect(vmSnapshot.Spec.Source.Name).To(Equal(vmName))
+				Expect(vmSnapshot.Spec.Source.Kind).To(Equal("VirtualMachine"))
+				Expect(vmSnapshot.Spec.DeletionPolicy).To(HaveValue(Equal(snapshotv1.VirtualMachineSnapshotContentRetain)))
+				Expect(vmSnapshot.Labels).To(HaveKeyWithValue(snapshotv1.SnapshotScheduleLabel, scheduleName))
+				Expect(vmSnapshot.Labels).To(HaveKeyWithValue("team", "storage"))
+			}
+			testutils.ExpectEvents(recorder, vmSnapshotScheduleCreateEvent, vmSnapshotScheduleCreateEvent)
+
+			Expect(updatedStatuses).To(HaveLen(1))
+			status := updatedStatuses[0]
+			Expect(status.LastScheduleTime.Time).To(Equal(scheduleTime))
+			Expect(status.NextScheduleTime.Time).To(Equal(nextScheduleTime))
+			Expect(status.Conditions).To(ConsistOf(newReadyCondition(corev1.ConditionTrue, scheduleActiveReason)))
+		})
+
+		It("should not snapshot before the next schedule time", func() {
+			Expect(vmInformer.GetIndexer().Add(createVM("vm-a", map[string]string{"backup": "true"}))).To(Succeed())
+
+			schedule := createSchedule()
+			schedule.Status = &snapshotv1.VirtualMachineSnapshotScheduleStatus{
+				LastScheduleTime: &metav1.Time{Time: time.Date(2024, 5, 10, 2, 0, 0, 0, time.UTC)},
+				NextScheduleTime: &metav1.Time{Time: time.Date(2024, 5, 11, 2, 0, 0, 0, time.UTC)},
+				Conditions:       []snapshotv1.Condition{newReadyCondition(corev1.ConditionTrue, scheduleActiveReason)},
+			}
+
+			retry, err := controller.updateVMSnapshotSchedule(schedule)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(retry).To(Equal(13*time.Hour + 30*time.Minute))
+			Expect(createdSnapshots).To(BeEmpty())
+			Expect(updatedStatuses).To(BeEmpty())
+		})
+
+		It("should not snapshot when disabled", func() {
+			Expect(vmInformer.GetIndexer().Add(createVM("vm-a", map[string]string{"backup": "true"}))).To(Succeed())
+
+			schedule := createSchedule()
+			schedule.Spec.Disabled = pointer.P(true)
+
+			retry, err := controller.updateVMSnapshotSchedule(schedule)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(retry).To(BeZero())
+			Expect(createdSnapshots).To(BeEmpty())
+			Expect(updatedStatuses).To(HaveLen(1))
+			Expect(updatedStatuses[0].NextScheduleTime).To(BeNil())
+			Expect(updatedStatuses[0].Conditions).To(ConsistOf(newReadyCondition(corev1.ConditionFalse, scheduleDisabledReason)))
+		})
+
+		It("should record a failure for an invalid schedule", func() {
+			schedule := createSchedule()
+			schedule.Spec.Schedule = "not a schedule"
+
+			retry, err := controller.updateVMSnapshotSchedule(schedule)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(retry).To(BeZero())
+			testutils.ExpectEvent(recorder, vmSnapshotScheduleInvalidEvent)
+			Expect(updatedStatuses).To(HaveLen(1))
+			Expect(updatedStatuses[0].LastFailure).ToNot(BeNil())
+			Expect(*updatedStatuses[0].LastFailure.Message).To(ContainSubstring("Invalid schedule"))
+			Expect(updatedStatuses[0].Conditions).To(ConsistOf(newReadyCondition(corev1.ConditionFalse, scheduleInvalidReason)))
+		})
+
+		It("should report the latest succeeded and failed snapshots", func() {
+			schedule := createSchedule()
+			schedule.Spec.Disabled = pointer.P(true)
+
+			older := createScheduledSnapshot("older", "vm-a", snapshotv1.Succeeded, now.Add(-48*time.Hour))
+			newer := createScheduledSnapshot("newer", "vm-a", snapshotv1.Succeeded, now.Add(-24*time.Hour))
+			failed := createScheduledSnapshot("failed", "vm-b", snapshotv1.Failed, now.Add(-24*time.Hour))
+			failed.Status.Error = &snapshotv1.Error{Message: pointer.P("snapshot deadline exceeded")}
+			for _, s := range []*snapshotv1.VirtualMachineSnapshot{older, newer, failed} {
+				Expect(vmSnapshotInformer.GetIndexer().Add(s)).To(Succeed())
+			}
+
+			_, err := controller.updateVMSnapshotSchedule(schedule)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(updatedStatuses).To(HaveLen(1))
+			Expect(updatedStatuses[0].LastSuccess.VirtualMachineSnapshotName).To(Equal("newer"))
+			Expect(updatedStatuses[0].LastFailure.VirtualMachineSnapshotName).To(Equal("failed"))
+			Expect(*updatedStatuses[0].LastFailure.Message).To(Equal("snapshot deadline exceeded"))
+		})
+	})
+
+	Context("retention", func() {
+		addSnapshots := func(snapshots ...*snapshotv1.VirtualMachineSnapshot) {
+			for _, s := range snapshots {
+				Expect(vmSnapshotInformer.GetIndexer().Add(s)).To(Succeed())
+			}
+		}
+
+		It("should keep everything without retention rules", func() {
+			schedule := createSchedule()
+			schedule.Spec.Disabled = pointer.P(true)
+			addSnapshots(
+				createScheduledSnapshot("s1", "vm-a", snapshotv1.Succeeded, now.Add(-3*time.Hour)),
+				createScheduledSnapshot("s2", "vm-a", snapshotv1.Succeeded, now.Add(-2*time.Hour)),
+			)
+
+			_, err := controller.updateVMSnapshotSchedule(schedule)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(deletedSnapshots).To(BeEmpty())
+		})
+
+		It("should keep the last snapshots of each VM", func() {
+			schedule := createSchedule()
+			schedule.Spec.Disabled = pointer.P(true)
+			schedule.Spec.Retention = &snapshotv1.VirtualMachineSnapshotScheduleRetention{Last: pointer.P(int32(2))}
+			addSnapshots(
+				createScheduledSnapshot("a1", "vm-a", snapshotv1.Succeeded, now.Add(-4*time.Hour)),
+				createScheduledSnapshot("a2", "vm-a", snapshotv1.Failed, now.Add(-3*time.Hour)),
+				createScheduledSnapshot("a3", "vm-a", snapshotv1.Succeeded, now.Add(-2*time.Hour)),
+				createScheduledSnapshot("a4", "vm-a", snapshotv1.Succeeded, now.Add(-1*time.Hour)),
+				createScheduledSnapshot("a5", "vm-a", snapshotv1.InProgress, now),
+				createScheduledSnapshot("b1", "vm-b", snapshotv1.Succeeded, now.Add(-4*time.Hour)),
+			)
+
+			_, err := controller.updateVMSnapshotSchedule(schedule)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(deletedSnapshots).To(Equal([]string{"a1", "a2"}))
+			testutils.ExpectEvents(recorder, vmSnapshotScheduleDeleteEvent, vmSnapshotScheduleDeleteEvent)
+		})
+
+		It("should keep the newest snapshot of each day and week", func() {
+			schedule := createSchedule()
+			schedule.Spec.Disabled = pointer.P(true)
+			schedule.Spec.Retention = &snapshotv1.VirtualMachineSnapshotScheduleRetention{
+				Last:   pointer.P(int32(1)),
+				Daily:  pointer.P(int32(2)),
+				Weekly: pointer.P(int32(2)),
+			}
+			// 2024-05-10 is a Friday, the previous ISO week ends on Sunday 2024-05-05
+			addSnapshots(
+				createScheduledSnapshot("fri-late", "vm-a", snapshotv1.Succeeded, time.Date(2024, 5, 10, 10, 0, 0, 0, time.UTC)),
+				createScheduledSnapshot("fri-early", "vm-a", snapshotv1.Succeeded, time.Date(2024, 5, 10, 2, 0, 0, 0, time.UTC)),
+				createScheduledSnapshot("thu", "vm-a", snapshotv1.Succeeded, time.Date(2024, 5, 9, 2, 0, 0, 0, time.UTC)),
+				createScheduledSnapshot("wed", "vm-a", snapshotv1.Succeeded, time.Date(2024, 5, 8, 2, 0, 0, 0, time.UTC)),
+				createScheduledSnapshot("sun", "vm-a", snapshotv1.Succeeded, time.Date(2024, 5, 5, 2, 0, 0, 0, time.UTC)),
+				createScheduledSnapshot("sat", "vm-a", snapshotv1.Succeeded, time.Date(2024, 5, 4, 2, 0, 0, 0, time.UTC)),
+				createScheduledSnapshot("older-week", "vm-a", snapshotv1.Succeeded, time.Date(2024, 4, 28, 2, 0, 0, 0, time.UTC)),
+			)
+
+			_, err := controller.updateVMSnapshotSchedule(schedule)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(deletedSnapshots).To(ConsistOf("fri-early", "wed", "sat", "older-week"))
+		})
+	})
+
+	It("should enqueue the schedule of a scheduled snapshot", func() {
+		mockQueue := testutils.NewMockWorkQueue(controller.vmSnapshotScheduleQueue)
+		controller.vmSnapshotScheduleQueue = mockQueue
+
+		mockQueue.ExpectAdds(1)
+		controller.handleVMSnapshot(createScheduledSnapshot("s1", "vm-a", snapshotv1.Succeeded, now))
+		mockQueue.Wait()
+		Expect(mockQueue.Len()).To(Equal(1))
+		key, _ := mockQueue.Get()
+		Expect(key).To(Equal(testNamespace + "/" + scheduleName))
+	})
+
+	DescribeTable("should find the latest schedule time", func(spec string, since, now, expected time.Time) {
+		cronSchedule, err := cron.ParseStandard(spec)
+		Expect(err).ToNot(HaveOccurred())
+
+		latest := getLatestScheduleTime(cronSchedule, since, now)
+		if expected.IsZero() {
+			Expect(latest).To(BeNil())
+		} else {
+			Expect(latest).To(HaveValue(Equal(expected)))
+		}
+	},
+		Entry("before the first schedule time", "0 2 * * *",
+			time.Date(2026, 3, 1, 3, 0, 0, 0, time.UTC), time.Date(2026, 3, 2, 1, 0, 0, 0, time.UTC), time.Time{}),
+		Entry("after missed schedule times", "0 2 * * *",
+			time.Date(2026, 3, 1, 3, 0, 0, 0, time.UTC), time.Date(2026, 3, 5, 1, 0, 0, 0, time.UTC), time.Date(2026, 3, 4, 2, 0, 0, 0, time.UTC)),
+		Entry("after years of missed schedule times", "* * * * *",
+			time.Date(2016, 3, 1, 3, 0, 0, 0, time.UTC), time.Date(2026, 3, 5, 1, 0, 30, 0, time.UTC), time.Date(2026, 3, 5, 1, 0, 0, 0, time.UTC)),
+		Entry("after missed schedule times of an irregular schedule", "30 2 * * 1-5",
+			time.Date(2025, 3, 1, 3, 0, 0, 0, time.UTC), time.Date(2026, 3, 8, 12, 0, 0, 0, time.UTC), time.Date(2026, 3, 6, 2, 30, 0, 0, time.UTC)),
+	)
+})
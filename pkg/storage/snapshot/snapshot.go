@@ -21,6 +21,7 @@ package snapshot
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"strings"
 	"time"
@@ -201,7 +202,22 @@ func (ctrl *VMSnapshotController) updateVMSnapshot(vmSnapshot *snapshotv1.Virtua
 				} else {
 					// create content if does not exist
 					if content == nil {
-						if err := ctrl.createContent(vmSnapshot); err != nil {
+						saved, err := ctrl.saveMemoryState(vmSnapshot)
+						if errors.Is(err, errMemoryStateFailed) {
+							// resume the source right away, the rest of the
+							// memory state is released once the source is unlocked
+							if err := source.Unfreeze(); err != nil {
+								return 0, err
+							}
+							vmSnapshot = failMemoryState(vmSnapshot, err)
+							if err := ctrl.vmSnapshotStatusUpdater.UpdateStatus(vmSnapshot); err != nil {
+								return 0, err
+							}
+						} else if err != nil {
+							return 0, err
+						} else if !saved {
+							retry = snapshotRetryInterval
+						} else if err := ctrl.createContent(vmSnapshot); err != nil {
 							return 0, err
 						}
 					}
@@ -398,6 +414,25 @@ func (ctrl *VMSnapshotController) updateVMSnapshotContent(content *snapshotv1.Vi
 				continue
 			}
 
+			if !didFreeze && isGroupMember(vmSnapshot) {
+				// the group freezes all members at once
+				frozen, err := ctrl.groupFrozen(vmSnapshot)
+				if err != nil {
+					return 0, err
+				}
+				if !frozen {
+					log.Log.V(3).Infof("Waiting for the group of snapshot %s/%s to freeze", vmSnapshot.Namespace, vmSnapshot.Name)
+					return snapshotRetryInterval, nil
+				}
+
+				didFreeze = true
+			}
+
+			if !didFreeze && vmSnapshot.Status != nil && vmSnapshot.Status.Memory != nil {
+				// the source is paused while its memory state is saved
+				didFreeze = true
+			}
+
 			if !didFreeze {
 				source, err := ctrl.getSnapshotSource(vmSnapshot)
 				if err != nil {
@@ -481,9 +516,12 @@ func (ctrl *VMSnapshotController) updateVMSnapshotContent(content *snapshotv1.Vi
 	if created && contentCpy.Status.CreationTime == nil {
 		contentCpy.Status.CreationTime = currentTime()
 
-		err = ctrl.unfreezeSource(vmSnapshot)
-		if err != nil {
-			return 0, err
+		// the group thaws the members once all of them are created
+		if !isGroupMember(vmSnapshot) {
+			err = ctrl.unfreezeSource(vmSnapshot)
+			if err != nil {
+				return 0, err
+			}
 		}
 	}
 
@@ -790,9 +828,12 @@ func (ctrl *VMSnapshotController) updateSnapshotStatus(vmSnapshot *snapshotv1.Vi
 
 	// terminal phase 1 - failed
 	if vmSnapshotDeadlineExceeded(vmSnapshotCpy) {
+		// a snapshot which already failed keeps the reason it failed for
+		if !vmSnapshotFailed(vmSnapshotCpy) {
+			updateSnapshotCondition(vmSnapshotCpy, newProgressingCondition(corev1.ConditionFalse, vmSnapshotDeadlineExceededError))
+			updateSnapshotCondition(vmSnapshotCpy, newFailureCondition(corev1.ConditionTrue, vmSnapshotDeadlineExceededError))
+		}
 		vmSnapshotCpy.Status.Phase = snapshotv1.Failed
-		updateSnapshotCondition(vmSnapshotCpy, newProgressingCondition(corev1.ConditionFalse, vmSnapshotDeadlineExceededError))
-		updateSnapshotCondition(vmSnapshotCpy, newFailureCondition(corev1.ConditionTrue, vmSnapshotDeadlineExceededError))
 		updateSnapshotCondition(vmSnapshotCpy, newReadyCondition(corev1.ConditionFalse, "Operation failed"))
 		// terminal phase 2 - succeeded
 	} else if vmSnapshotSucceeded(vmSnapshotCpy) || vmSnapshotCpy.Status.CreationTime != nil {
@@ -807,6 +848,9 @@ func (ctrl *VMSnapshotController) updateSnapshotStatus(vmSnapshot *snapshotv1.Vi
 		vmSnapshotCpy.Status.Phase = snapshotv1.InProgress
 		if source != nil {
 			if source.Locked() {
+				if err := ctrl.updateMemoryStateStatus(vmSnapshotCpy, content); err != nil {
+					return vmSnapshot, err
+				}
 				updateSnapshotCondition(vmSnapshotCpy, newProgressingCondition(corev1.ConditionTrue, "Source locked and operation in progress"))
 			} else {
 				updateSnapshotCondition(vmSnapshotCpy, newProgressingCondition(corev1.ConditionFalse, "Source not locked"))
@@ -971,7 +1015,8 @@ func (ctrl *VMSnapshotController) getVolumeSnapshotStatus(vm *kubevirtv1.Virtual
 func (ctrl *VMSnapshotController) isVolumeSnapshottable(volume *kubevirtv1.Volume) bool {
 	return volume.VolumeSource.PersistentVolumeClaim != nil ||
 		volume.VolumeSource.DataVolume != nil ||
-		volume.VolumeSource.MemoryDump != nil
+		volume.VolumeSource.MemoryDump != nil ||
+		volume.VolumeSource.Backup != nil
 }
 
 func (ctrl *VMSnapshotController) getStorageClassNameForPVC(pvcKey string) (string, error) {
@@ -1011,6 +1056,15 @@ func (ctrl *VMSnapshotController) getVolumeStorageClass(namespace string, volume
 		return storageClassName, nil
 	}
 
+	if volume.VolumeSource.Backup != nil {
+		pvcKey := cacheKeyFunc(namespace, volume.VolumeSource.Backup.ClaimName)
+		storageClassName, err := ctrl.getStorageClassNameForPVC(pvcKey)
+		if err != nil {
+			return "", err
+		}
+		return storageClassName, nil
+	}
+
 	if volume.VolumeSource.DataVolume != nil {
 		storageClassName, err := ctrl.getStorageClassNameForDV(namespace, volume.VolumeSource.DataVolume.Name)
 		if err != nil {
@@ -1127,6 +1181,24 @@ func (ctrl *VMSnapshotController) getVMI(vm *kubevirtv1.VirtualMachine) (*kubevi
 	return obj.(*kubevirtv1.VirtualMachineInstance).DeepCopy(), true, nil
 }
 
+func isGroupMember(vmSnapshot *snapshotv1.VirtualMachineSnapshot) bool {
+	if vmSnapshot == nil {
+		return false
+	}
+	_, ok := vmSnapshot.Labels[snapshotv1.SnapshotGroupLabel]
+	return ok
+}
+
+func (ctrl *VMSnapshotController) groupFrozen(vmSnapshot *snapshotv1.VirtualMachineSnapshot) (bool, error) {
+	key := cacheKeyFunc(vmSnapshot.Namespace, vmSnapshot.Labels[snapshotv1.SnapshotGroupLabel])
+	obj, exists, err := ctrl.VMSnapshotGroupInformer.GetStore().GetByKey(key)
+	if err != nil || !exists {
+		return false, err
+	}
+
+	return vmSnapshotGroupFrozen(obj.(*snapshotv1.VirtualMachineSnapshotGroup)), nil
+}
+
 func (ctrl *VMSnapshotController) checkVMIRunning(vm *kubevirtv1.VirtualMachine) (bool, error) {
 	_, exists, err := ctrl.getVMI(vm)
 	return exists, err
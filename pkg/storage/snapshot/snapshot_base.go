@@ -71,6 +71,7 @@ type VMSnapshotController struct {
 
 	VMSnapshotInformer        cache.SharedIndexInformer
 	VMSnapshotContentInformer cache.SharedIndexInformer
+	VMSnapshotGroupInformer   cache.SharedIndexInformer
 	VMInformer                cache.SharedIndexInformer
 	VMIInformer               cache.SharedIndexInformer
 	StorageClassInformer      cache.SharedIndexInformer
@@ -188,6 +189,18 @@ func (ctrl *VMSnapshotController) Init() error {
 		return err
 	}
 
+	_, err = ctrl.VMSnapshotGroupInformer.AddEventHandlerWithResyncPeriod(
+		cache.ResourceEventHandlerFuncs{
+			AddFunc:    ctrl.handleVMSnapshotGroup,
+			UpdateFunc: func(oldObj, newObj interface{}) { ctrl.handleVMSnapshotGroup(newObj) },
+			DeleteFunc: ctrl.handleVMSnapshotGroup,
+		},
+		ctrl.ResyncPeriod,
+	)
+	if err != nil {
+		return err
+	}
+
 	_, err = ctrl.CRDInformer.AddEventHandlerWithResyncPeriod(
 		cache.ResourceEventHandlerFuncs{
 			AddFunc:    ctrl.handleCRD,
@@ -245,6 +258,7 @@ func (ctrl *VMSnapshotController) Run(threadiness int, stopCh <-chan struct{}) e
 		stopCh,
 		ctrl.VMSnapshotInformer.HasSynced,
 		ctrl.VMSnapshotContentInformer.HasSynced,
+		ctrl.VMSnapshotGroupInformer.HasSynced,
 		ctrl.VMInformer.HasSynced,
 		ctrl.VMIInformer.HasSynced,
 		ctrl.CRDInformer.HasSynced,
@@ -508,6 +522,27 @@ func (ctrl *VMSnapshotController) handleVMI(obj interface{}) {
 	}
 }
 
+func (ctrl *VMSnapshotController) handleVMSnapshotGroup(obj interface{}) {
+	if unknown, ok := obj.(cache.DeletedFinalStateUnknown); ok && unknown.Obj != nil {
+		obj = unknown.Obj
+	}
+
+	if group, ok := obj.(*snapshotv1.VirtualMachineSnapshotGroup); ok {
+		k, _ := cache.MetaNamespaceKeyFunc(group)
+		objs, err := ctrl.VMSnapshotInformer.GetIndexer().ByIndex("group", k)
+		if err != nil {
+			utilruntime.HandleError(err)
+			return
+		}
+
+		// the contents of the members wait for the group to freeze them
+		for _, obj := range objs {
+			vmSnapshot := obj.(*snapshotv1.VirtualMachineSnapshot)
+			ctrl.vmSnapshotContentQueue.Add(cacheKeyFunc(vmSnapshot.Namespace, GetVMSnapshotContentName(vmSnapshot)))
+		}
+	}
+}
+
 func (ctrl *VMSnapshotController) handleVolumeSnapshotClass(obj interface{}) {
 	if unknown, ok := obj.(cache.DeletedFinalStateUnknown); ok && unknown.Obj != nil {
 		obj = unknown.Obj
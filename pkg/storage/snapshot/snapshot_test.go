@@ -293,6 +293,8 @@ var _ = Describe("Snapshot controlleer", func() {
 		var crdInformer cache.SharedIndexInformer
 		var crdSource *framework.FakeControllerSource
 		var dvInformer cache.SharedIndexInformer
+		var vmSnapshotGroupInformer cache.SharedIndexInformer
+		var vmSnapshotGroupSource *framework.FakeControllerSource
 		var crInformer cache.SharedIndexInformer
 		var crSource *framework.FakeControllerSource
 		var dvSource *framework.FakeControllerSource
@@ -321,6 +323,7 @@ var _ = Describe("Snapshot controlleer", func() {
 			go podInformer.Run(stop)
 			go dvInformer.Run(stop)
 			go crInformer.Run(stop)
+			go vmSnapshotGroupInformer.Run(stop)
 			Expect(cache.WaitForCacheSync(
 				stop,
 				vmSnapshotInformer.HasSynced,
@@ -334,6 +337,7 @@ var _ = Describe("Snapshot controlleer", func() {
 				podInformer.HasSynced,
 				dvInformer.HasSynced,
 				crInformer.HasSynced,
+				vmSnapshotGroupInformer.HasSynced,
 			)).To(BeTrue())
 		}
 
@@ -357,6 +361,7 @@ var _ = Describe("Snapshot controlleer", func() {
 			pvcInformer, pvcSource = testutils.NewFakeInformerFor(&corev1.PersistentVolumeClaim{})
 			crdInformer, crdSource = testutils.NewFakeInformerFor(&extv1.CustomResourceDefinition{})
 			dvInformer, dvSource = testutils.NewFakeInformerFor(&cdiv1.DataVolume{})
+			vmSnapshotGroupInformer, vmSnapshotGroupSource = testutils.NewFakeInformerFor(&snapshotv1.VirtualMachineSnapshotGroup{})
 
 			recorder = record.NewFakeRecorder(100)
 			recorder.IncludeObject = true
@@ -365,6 +370,7 @@ var _ = Describe("Snapshot controlleer", func() {
 				Client:                    virtClient,
 				VMSnapshotInformer:        vmSnapshotInformer,
 				VMSnapshotContentInformer: vmSnapshotContentInformer,
+				VMSnapshotGroupInformer:   vmSnapshotGroupInformer,
 				VMInformer:                vmInformer,
 				VMIInformer:               vmiInformer,
 				PodInformer:               podInformer,
@@ -1493,6 +1499,80 @@ var _ = Describe("Snapshot controlleer", func() {
 				Expect(*snapshotCreates).To(Equal(1))
 			})
 
+			Context("with a group member", func() {
+				var group *snapshotv1.VirtualMachineSnapshotGroup
+
+				BeforeEach(func() {
+					group = &snapshotv1.VirtualMachineSnapshotGroup{
+						ObjectMeta: metav1.ObjectMeta{
+							Name:      "group",
+							Namespace: testNamespace,
+						},
+						Status: &snapshotv1.VirtualMachineSnapshotGroupStatus{
+							Phase: snapshotv1.InProgress,
+						},
+					}
+				})
+
+				addGroupMember := func(vm *v1.VirtualMachine) *snapshotv1.VirtualMachineSnapshotContent {
+					vmSnapshot := createVMSnapshotInProgress()
+					vmSnapshot.Labels = map[string]string{snapshotv1.SnapshotGroupLabel: group.Name}
+					vmSnapshotContent := createVMSnapshotContent()
+					vmSnapshotContent.UID = contentUID
+
+					vmi := createVMI(vm)
+					vmi.Status.Conditions = append(vmi.Status.Conditions, v1.VirtualMachineInstanceCondition{
+						Type:          v1.VirtualMachineInstanceAgentConnected,
+						LastProbeTime: metav1.Now(),
+						Status:        corev1.ConditionTrue,
+					})
+					vmiSource.Add(vmi)
+					vmSource.Add(vm)
+					storageClassSource.Add(createStorageClass())
+					pvcs := createPersistentVolumeClaims()
+					for i := range pvcs {
+						pvcSource.Add(&pvcs[i])
+					}
+					vmSnapshotGroupSource.Add(group)
+					vmSnapshotContentSource.Add(vmSnapshotContent)
+					vmSnapshotSource.Add(vmSnapshot)
+					addVolumeSnapshotClass(createVolumeSnapshotClasses()[0])
+					return vmSnapshotContent
+				}
+
+				It("should not create VolumeSnapshot before the group froze", func() {
+					vmSnapshotContent := addGroupMember(createLockedVM())
+					snapshotCreates := expectVolumeSnapshotCreates(k8sSnapshotClient, createVolumeSnapshotClasses()[0].Name, vmSnapshotContent)
+
+					controller.processVMSnapshotContentWorkItem()
+					Expect(*snapshotCreates).To(BeZero())
+				})
+
+				It("should create VolumeSnapshot without freezing once the group froze", func() {
+					group.Status.FreezeTime = timeFunc()
+					vmSnapshotContent := addGroupMember(createLockedVM())
+
+					updatedContent := vmSnapshotContent.DeepCopy()
+					updatedContent.ResourceVersion = "1"
+					updatedContent.Status = &snapshotv1.VirtualMachineSnapshotContentStatus{
+						ReadyToUse: pointer.P(false),
+					}
+					for _, vs := range createVolumeSnapshots(vmSnapshotContent) {
+						updatedContent.Status.VolumeSnapshotStatus = append(updatedContent.Status.VolumeSnapshotStatus, snapshotv1.VolumeSnapshotStatus{
+							VolumeSnapshotName: vs.Name,
+						})
+					}
+
+					snapshotCreates := expectVolumeSnapshotCreates(k8sSnapshotClient, createVolumeSnapshotClasses()[0].Name, vmSnapshotContent)
+					updateStatusCalls := expectVMSnapshotContentUpdateStatus(vmSnapshotClient, updatedContent)
+
+					controller.processVMSnapshotContentWorkItem()
+					testutils.ExpectEvent(recorder, "SuccessfulVolumeSnapshotCreate")
+					Expect(*updateStatusCalls).To(Equal(1))
+					Expect(*snapshotCreates).To(Equal(1))
+				})
+			})
+
 			DescribeTable("should update VirtualMachineSnapshotContent", func(readyToUse bool) {
 				vmSnapshot := createVMSnapshotInProgress()
 				vmSnapshotContent := createVMSnapshotContent()
@@ -2235,6 +2315,257 @@ var _ = Describe("Snapshot controlleer", func() {
 
 				Expect(updateCalled).To(BeTrue())
 			})
+			Context("with memory state", func() {
+				createVMSnapshotWithMemory := func() *snapshotv1.VirtualMachineSnapshot {
+					vmSnapshot := createVMSnapshotInProgress()
+					vmSnapshot.Spec.IncludeMemory = true
+					vmSnapshot.Status.Conditions = []snapshotv1.Condition{
+						newProgressingCondition(corev1.ConditionTrue, "Source locked and operation in progress"),
+						newReadyCondition(corev1.ConditionFalse, "Not ready"),
+					}
+					vmSnapshot.Status.Indications = []snapshotv1.Indication{
+						snapshotv1.VMSnapshotOnlineSnapshotIndication,
+						snapshotv1.VMSnapshotNoGuestAgentIndication,
+					}
+					vmSnapshot.Status.Memory = &snapshotv1.MemorySnapshotStatus{
+						ClaimName:    memoryStateClaimName(vmSnapshot),
+						SourcePaused: true,
+					}
+					return vmSnapshot
+				}
+
+				createPausedVMI := func(vm *v1.VirtualMachine) *v1.VirtualMachineInstance {
+					vmi := createVMI(vm)
+					vmi.Status.Conditions = append(vmi.Status.Conditions, v1.VirtualMachineInstanceCondition{
+						Type:   v1.VirtualMachineInstancePaused,
+						Status: corev1.ConditionTrue,
+					})
+					return vmi
+				}
+
+				addMemoryStatePVC := func(vmSnapshot *snapshotv1.VirtualMachineSnapshot) {
+					pvcSource.Add(&corev1.PersistentVolumeClaim{
+						ObjectMeta: metav1.ObjectMeta{
+							Name:      vmSnapshot.Status.Memory.ClaimName,
+							Namespace: vmSnapshot.Namespace,
+						},
+					})
+				}
+
+				BeforeEach(func() {
+					virtClient.EXPECT().CoreV1().Return(k8sClient.CoreV1()).AnyTimes()
+				})
+
+				It("should record that the running source has to be paused", func() {
+					vmSnapshot := createVMSnapshotWithMemory()
+					vmSnapshot.Status.Memory = nil
+					vm := createLockedVM()
+					vmSource.Add(vm)
+					vmiSource.Add(createVMI(vm))
+
+					updatedSnapshot := vmSnapshot.DeepCopy()
+					updatedSnapshot.ResourceVersion = "1"
+					updatedSnapshot.Status.Memory = &snapshotv1.MemorySnapshotStatus{
+						ClaimName:    memoryStateClaimName(vmSnapshot),
+						SourcePaused: true,
+					}
+					updateStatusCalls := expectVMSnapshotUpdateStatus(vmSnapshotClient, updatedSnapshot)
+
+					addVirtualMachineSnapshot(vmSnapshot)
+					controller.processVMSnapshotWorkItem()
+					Expect(*updateStatusCalls).To(Equal(1))
+				})
+
+				It("should not pause a source already paused by the user", func() {
+					vmSnapshot := createVMSnapshotWithMemory()
+					vmSnapshot.Status.Memory = nil
+					vm := createLockedVM()
+					vmSource.Add(vm)
+					vmiSource.Add(createPausedVMI(vm))
+
+					updatedSnapshot := vmSnapshot.DeepCopy()
+					updatedSnapshot.ResourceVersion = "1"
+					updatedSnapshot.Status.Memory = &snapshotv1.MemorySnapshotStatus{
+						ClaimName:    memoryStateClaimName(vmSnapshot),
+						SourcePaused: false,
+					}
+					updateStatusCalls := expectVMSnapshotUpdateStatus(vmSnapshotClient, updatedSnapshot)
+
+					addVirtualMachineSnapshot(vmSnapshot)
+					controller.processVMSnapshotWorkItem()
+					Expect(*updateStatusCalls).To(Equal(1))
+				})
+
+				It("should create the memory state PVC and pause the source", func() {
+					vmSnapshot := createVMSnapshotWithMemory()
+					vm := createLockedVM()
+					vmSource.Add(vm)
+					vmiSource.Add(createVMI(vm))
+					pvcs := createPersistentVolumeClaims()
+					for i := range pvcs {
+						pvcSource.Add(&pvcs[i])
+					}
+
+					pvcCreates := 0
+					k8sClient.Fake.PrependReactor("create", "persistentvolumeclaims", func(action testing.Action) (handled bool, obj runtime.Object, err error) {
+						create, ok := action.(testing.CreateAction)
+						Expect(ok).To(BeTrue())
+						pvc := create.GetObject().(*corev1.PersistentVolumeClaim)
+						Expect(pvc.Name).To(Equal(vmSnapshot.Status.Memory.ClaimName))
+						Expect(pvc.OwnerReferences).To(HaveLen(1))
+						Expect(pvc.OwnerReferences[0].UID).To(Equal(vmSnapshot.UID))
+						Expect(pvc.Spec.StorageClassName).To(Equal(&storageClassName))
+						Expect(pvc.Spec.Resources.Requests).To(HaveKey(corev1.ResourceStorage))
+						pvcCreates++
+						return true, pvc, nil
+					})
+					vmiInterface.EXPECT().Pause(context.Background(), vm.Name, &v1.PauseOptions{}).Return(nil).Times(1)
+
+					addVirtualMachineSnapshot(vmSnapshot)
+					controller.processVMSnapshotWorkItem()
+					Expect(pvcCreates).To(Equal(1))
+				})
+
+				It("should request the memory state once the source is paused", func() {
+					vmSnapshot := createVMSnapshotWithMemory()
+					vm := createLockedVM()
+					vmSource.Add(vm)
+					vmiSource.Add(createPausedVMI(vm))
+					addMemoryStatePVC(vmSnapshot)
+
+					patchBytes := []byte(fmt.Sprintf(
+						`[{"op":"test","path":"/status/memoryDumpRequest","value":null},{"op":"add","path":"/status/memoryDumpRequest","value":{"claimName":"%s","phase":"Associating","format":"savestate"}}]`,
+						vmSnapshot.Status.Memory.ClaimName,
+					))
+					vmInterface.EXPECT().PatchStatus(context.Background(), vm.Name, types.JSONPatchType, patchBytes, metav1.PatchOptions{}).Return(vm, nil).Times(1)
+
+					addVirtualMachineSnapshot(vmSnapshot)
+					controller.processVMSnapshotWorkItem()
+				})
+
+				It("should not create the content while the memory state is being saved", func() {
+					vmSnapshot := createVMSnapshotWithMemory()
+					vm := createLockedVM()
+					vm.Status.MemoryDumpRequest = &v1.VirtualMachineMemoryDumpRequest{
+						ClaimName: vmSnapshot.Status.Memory.ClaimName,
+						Phase:     v1.MemoryDumpInProgress,
+						Format:    v1.MemoryDumpFormatSaveState,
+					}
+					vmSource.Add(vm)
+					vmiSource.Add(createPausedVMI(vm))
+					addMemoryStatePVC(vmSnapshot)
+
+					addVirtualMachineSnapshot(vmSnapshot)
+					controller.processVMSnapshotWorkItem()
+				})
+
+				It("should wait for a memory dump of another claim to be removed", func() {
+					vmSnapshot := createVMSnapshotWithMemory()
+					vm := createLockedVM()
+					vm.Status.MemoryDumpRequest = &v1.VirtualMachineMemoryDumpRequest{
+						ClaimName: "other-claim",
+						Phase:     v1.MemoryDumpCompleted,
+					}
+					vmSource.Add(vm)
+					vmiSource.Add(createPausedVMI(vm))
+					addMemoryStatePVC(vmSnapshot)
+
+					addVirtualMachineSnapshot(vmSnapshot)
+					controller.processVMSnapshotWorkItem()
+					testutils.ExpectEvent(recorder, memoryStateWaitingEvent)
+				})
+
+				It("should fail the snapshot and resume the source when the memory dump failed", func() {
+					vmSnapshot := createVMSnapshotWithMemory()
+					vm := createLockedVM()
+					vm.Status.MemoryDumpRequest = &v1.VirtualMachineMemoryDumpRequest{
+						ClaimName: vmSnapshot.Status.Memory.ClaimName,
+						Phase:     v1.MemoryDumpFailed,
+						Format:    v1.MemoryDumpFormatSaveState,
+						Message:   "no space left on device",
+					}
+					vmSource.Add(vm)
+					vmiSource.Add(createPausedVMI(vm))
+					addMemoryStatePVC(vmSnapshot)
+
+					vmiInterface.EXPECT().Unpause(context.Background(), vm.Name, &v1.UnpauseOptions{}).Return(nil).Times(1)
+
+					var statusUpdates []*snapshotv1.VirtualMachineSnapshot
+					vmSnapshotClient.Fake.PrependReactor("update", "virtualmachinesnapshots", func(action testing.Action) (handled bool, obj runtime.Object, err error) {
+						update, ok := action.(testing.UpdateAction)
+						Expect(ok).To(BeTrue())
+						Expect(update.GetSubresource()).To(Equal("status"))
+						statusUpdates = append(statusUpdates, update.GetObject().(*snapshotv1.VirtualMachineSnapshot))
+						return true, update.GetObject(), nil
+					})
+
+					addVirtualMachineSnapshot(vmSnapshot)
+					controller.processVMSnapshotWorkItem()
+					testutils.ExpectEvent(recorder, memoryStateFailedEvent)
+
+					Expect(statusUpdates).ToNot(BeEmpty())
+					for _, updated := range statusUpdates {
+						Expect(updated.Status.Phase).To(Equal(snapshotv1.Failed))
+						Expect(updated.Status.Error).ToNot(BeNil())
+						Expect(*updated.Status.Error.Message).To(ContainSubstring("no space left on device"))
+						Expect(updated.Status.Conditions).To(ContainElement(And(
+							HaveField("Type", snapshotv1.ConditionFailure),
+							HaveField("Status", corev1.ConditionTrue),
+							HaveField("Reason", memoryStateFailedError),
+						)))
+					}
+				})
+
+				It("should resume the source and release the memory state on unlock", func() {
+					vmSnapshot := createVMSnapshotSuccess()
+					vmSnapshot.Spec.IncludeMemory = true
+					vmSnapshot.Status.Memory = &snapshotv1.MemorySnapshotStatus{
+						ClaimName:    memoryStateClaimName(vmSnapshot),
+						SourcePaused: true,
+					}
+					vm := createLockedVM()
+					vm.Status.MemoryDumpRequest = &v1.VirtualMachineMemoryDumpRequest{
+						ClaimName: vmSnapshot.Status.Memory.ClaimName,
+						Phase:     v1.MemoryDumpCompleted,
+						Format:    v1.MemoryDumpFormatSaveState,
+					}
+					updatedVM := vm.DeepCopy()
+					updatedVM.Finalizers = []string{}
+					updatedVM.ResourceVersion = "1"
+					vmSource.Add(vm)
+					vmiSource.Add(createPausedVMI(vm))
+
+					vmiInterface.EXPECT().Unpause(context.Background(), vm.Name, &v1.UnpauseOptions{}).Return(nil).Times(1)
+
+					pvcDeletes := 0
+					k8sClient.Fake.PrependReactor("delete", "persistentvolumeclaims", func(action testing.Action) (handled bool, obj runtime.Object, err error) {
+						del, ok := action.(testing.DeleteAction)
+						Expect(ok).To(BeTrue())
+						Expect(del.GetName()).To(Equal(vmSnapshot.Status.Memory.ClaimName))
+						pvcDeletes++
+						return true, nil, nil
+					})
+
+					patchBytes, err := patch.GenerateTestReplacePatch("/metadata/finalizers", []string{"snapshot.kubevirt.io/snapshot-source-protection"}, []string{})
+					Expect(err).ToNot(HaveOccurred())
+					vmInterface.EXPECT().Patch(context.Background(), updatedVM.Name, types.JSONPatchType, patchBytes, metav1.PatchOptions{}).Return(updatedVM, nil).Times(1)
+
+					statusUpdate := updatedVM.DeepCopy()
+					statusUpdate.Status.SnapshotInProgress = nil
+					statusUpdate.Status.MemoryDumpRequest = &v1.VirtualMachineMemoryDumpRequest{
+						ClaimName: vmSnapshot.Status.Memory.ClaimName,
+						Phase:     v1.MemoryDumpDissociating,
+						Remove:    true,
+						Format:    v1.MemoryDumpFormatSaveState,
+					}
+					vmInterface.EXPECT().UpdateStatus(context.Background(), statusUpdate, metav1.UpdateOptions{}).Return(statusUpdate, nil).Times(1)
+
+					addVirtualMachineSnapshot(vmSnapshot)
+					controller.processVMSnapshotWorkItem()
+					Expect(pvcDeletes).To(Equal(1))
+				})
+			})
+
 			Describe("it should snapshot vm with instancetypes and preferences", func() {
 				var (
 					vm                *v1.VirtualMachine
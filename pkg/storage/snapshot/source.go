@@ -161,6 +161,10 @@ func (s *vmSnapshotSource) Unlock() (bool, error) {
 		}
 	}
 
+	if err := s.releaseMemoryState(vmCopy); err != nil {
+		return false, err
+	}
+
 	vmCopy.Status.SnapshotInProgress = nil
 	vmCopy, err = s.controller.Client.VirtualMachine(vmCopy.Namespace).UpdateStatus(context.Background(), vmCopy, metav1.UpdateOptions{})
 	if err != nil {
@@ -360,6 +364,10 @@ func (s *vmSnapshotSource) Unfreeze() error {
 		return nil
 	}
 
+	if s.snapshot.Status != nil && s.snapshot.Status.Memory != nil {
+		return s.resumeMemoryStateSource()
+	}
+
 	exists, err := s.GuestAgent()
 	if !exists || err != nil {
 		return err
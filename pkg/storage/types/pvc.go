@@ -115,6 +115,8 @@ func PVCNameFromVirtVolume(volume *virtv1.Volume) string {
 		return volume.PersistentVolumeClaim.ClaimName
 	} else if volume.MemoryDump != nil {
 		return volume.MemoryDump.ClaimName
+	} else if volume.Backup != nil {
+		return volume.Backup.ClaimName
 	}
 
 	return ""
@@ -274,6 +276,9 @@ func IsHotplugVolume(vol *virtv1.Volume) bool {
 	if volSrc.MemoryDump != nil && volSrc.MemoryDump.PersistentVolumeClaimVolumeSource.Hotpluggable {
 		return true
 	}
+	if volSrc.Backup != nil && volSrc.Backup.PersistentVolumeClaimVolumeSource.Hotpluggable {
+		return true
+	}
 
 	return false
 }
@@ -339,6 +344,27 @@ func IsMigratedVolume(name string, vmi *virtv1.VirtualMachineInstance) bool {
 	return false
 }
 
+// IsLocalVolumeToCopy returns true if the volume is a PVC which can not be shared between the source
+// and the target of a live migration, and which a migration can copy to a new PVC instead
+func IsLocalVolumeToCopy(vmi *virtv1.VirtualMachineInstance, volume *virtv1.Volume) bool {
+	if PVCNameFromVirtVolume(volume) == "" || IsHotplugVolume(volume) || IsMigratedVolume(volume.Name, vmi) {
+		return false
+	}
+	if _, isFilesystem := GetFilesystemsFromVolumes(vmi)[volume.Name]; isFilesystem {
+		return false
+	}
+	disk, exists := GetDisksByName(&vmi.Spec)[volume.Name]
+	if !exists || disk.LUN != nil || disk.CDRom != nil || (disk.Shareable != nil && *disk.Shareable) {
+		return false
+	}
+	for _, volumeStatus := range vmi.Status.VolumeStatus {
+		if volumeStatus.Name == volume.Name && volumeStatus.PersistentVolumeClaimInfo != nil {
+			return !HasSharedAccessMode(volumeStatus.PersistentVolumeClaimInfo.AccessModes)
+		}
+	}
+	return false
+}
+
 func GetTotalSizeMigratedVolumes(vmi *virtv1.VirtualMachineInstance) *resource.Quantity {
 	size := int64(0)
 	srcVols := make(map[string]bool)
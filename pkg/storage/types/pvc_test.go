@@ -25,6 +25,8 @@ import (
 	kubev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/client-go/tools/cache"
+
+	virtv1 "kubevirt.io/api/core/v1"
 )
 
 var _ = Describe("PVC utils test", func() {
@@ -98,4 +100,68 @@ var _ = Describe("PVC utils test", func() {
 		})
 	})
 
+	Context("IsLocalVolumeToCopy", func() {
+		const volumeName = "disk0"
+
+		newVMI := func(accessMode kubev1.PersistentVolumeAccessMode) *virtv1.VirtualMachineInstance {
+			return &virtv1.VirtualMachineInstance{
+				Spec: virtv1.VirtualMachineInstanceSpec{
+					Domain: virtv1.DomainSpec{
+						Devices: virtv1.Devices{
+							Disks: []virtv1.Disk{{
+								Name:       volumeName,
+								DiskDevice: virtv1.DiskDevice{Disk: &virtv1.DiskTarget{}},
+							}},
+						},
+					},
+					Volumes: []virtv1.Volume{{
+						Name: volumeName,
+						VolumeSource: virtv1.VolumeSource{
+							PersistentVolumeClaim: &virtv1.PersistentVolumeClaimVolumeSource{
+								PersistentVolumeClaimVolumeSource: kubev1.PersistentVolumeClaimVolumeSource{ClaimName: file1Name},
+							},
+						},
+					}},
+				},
+				Status: virtv1.VirtualMachineInstanceStatus{
+					VolumeStatus: []virtv1.VolumeStatus{{
+						Name: volumeName,
+						PersistentVolumeClaimInfo: &virtv1.PersistentVolumeClaimInfo{
+							ClaimName:   file1Name,
+							AccessModes: []kubev1.PersistentVolumeAccessMode{accessMode},
+						},
+					}},
+				},
+			}
+		}
+
+		It("should copy a ReadWriteOnce PVC", func() {
+			vmi := newVMI(kubev1.ReadWriteOnce)
+			Expect(IsLocalVolumeToCopy(vmi, &vmi.Spec.Volumes[0])).To(BeTrue())
+		})
+
+		It("should not copy a ReadWriteMany PVC", func() {
+			vmi := newVMI(kubev1.ReadWriteMany)
+			Expect(IsLocalVolumeToCopy(vmi, &vmi.Spec.Volumes[0])).To(BeFalse())
+		})
+
+		It("should not copy a volume which is already migrated", func() {
+			vmi := newVMI(kubev1.ReadWriteOnce)
+			vmi.Status.MigratedVolumes = []virtv1.StorageMigratedVolumeInfo{{VolumeName: volumeName}}
+			Expect(IsLocalVolumeToCopy(vmi, &vmi.Spec.Volumes[0])).To(BeFalse())
+		})
+
+		It("should not copy a hotplugged volume", func() {
+			vmi := newVMI(kubev1.ReadWriteOnce)
+			vmi.Spec.Volumes[0].PersistentVolumeClaim.Hotpluggable = true
+			Expect(IsLocalVolumeToCopy(vmi, &vmi.Spec.Volumes[0])).To(BeFalse())
+		})
+
+		It("should not copy a LUN", func() {
+			vmi := newVMI(kubev1.ReadWriteOnce)
+			vmi.Spec.Domain.Devices.Disks[0].DiskDevice = virtv1.DiskDevice{LUN: &virtv1.LunTarget{}}
+			Expect(IsLocalVolumeToCopy(vmi, &vmi.Spec.Volumes[0])).To(BeFalse())
+		})
+	})
+
 })
@@ -0,0 +1,116 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package utils
+
+import (
+	"context"
+	"fmt"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/cache"
+
+	v1 "kubevirt.io/api/core/v1"
+	"kubevirt.io/client-go/kubecli"
+
+	"kubevirt.io/kubevirt/pkg/apimachinery/patch"
+	storagetypes "kubevirt.io/kubevirt/pkg/storage/types"
+)
+
+// RemoveVolumeFromVMISpec drops the named volume from the VMI spec
+func RemoveVolumeFromVMISpec(vmiSpec *v1.VirtualMachineInstanceSpec, volumeName string) *v1.VirtualMachineInstanceSpec {
+	newVolumesList := []v1.Volume{}
+	for _, volume := range vmiSpec.Volumes {
+		if volume.Name != volumeName {
+			newVolumesList = append(newVolumesList, volume)
+		}
+	}
+	vmiSpec.Volumes = newVolumesList
+	return vmiSpec
+}
+
+// PatchVMIRequestVolume adds or removes the volume a VM subresource request
+// hotplugs into the running VMI, applyVolume sets the volume on the spec copy
+func PatchVMIRequestVolume(client kubecli.KubevirtClient, vmi *v1.VirtualMachineInstance, volumeName string, addVolume bool, applyVolume func(*v1.VirtualMachineInstanceSpec) *v1.VirtualMachineInstanceSpec) error {
+	foundRemoveVol := false
+	for _, volume := range vmi.Spec.Volumes {
+		if volumeName == volume.Name {
+			if addVolume {
+				return fmt.Errorf("Unable to add volume [%s] because it already exists", volume.Name)
+			}
+			foundRemoveVol = true
+		}
+	}
+
+	if !foundRemoveVol && !addVolume {
+		return fmt.Errorf("Unable to remove volume [%s] because it does not exist", volumeName)
+	}
+
+	vmiCopy := vmi.DeepCopy()
+	if addVolume {
+		vmiCopy.Spec = *applyVolume(&vmiCopy.Spec)
+	} else {
+		vmiCopy.Spec = *RemoveVolumeFromVMISpec(&vmiCopy.Spec, volumeName)
+	}
+	patchset := patch.New(
+		patch.WithTest("/spec/volumes", vmi.Spec.Volumes),
+	)
+	if len(vmi.Spec.Volumes) > 0 {
+		patchset.AddOption(patch.WithReplace("/spec/volumes", vmiCopy.Spec.Volumes))
+	} else {
+		patchset.AddOption(patch.WithAdd("/spec/volumes", vmiCopy.Spec.Volumes))
+	}
+
+	patchBytes, err := patchset.GeneratePayload()
+	if err != nil {
+		return err
+	}
+	_, err = client.VirtualMachineInstance(vmi.Namespace).Patch(context.Background(), vmi.Name, types.JSONPatchType, patchBytes, metav1.PatchOptions{})
+	return err
+}
+
+// PatchPVCAnnotation sets the annotation on the claim found in the store,
+// nothing is patched when it already holds the value
+func PatchPVCAnnotation(client kubecli.KubevirtClient, pvcStore cache.Store, namespace, claimName, annotation, value string) error {
+	pvc, err := storagetypes.GetPersistentVolumeClaimFromCache(namespace, claimName, pvcStore)
+	if err != nil {
+		return err
+	}
+	if pvc == nil {
+		return fmt.Errorf("pvc %s not found", claimName)
+	}
+
+	annoPatch := patch.New()
+	if len(pvc.Annotations) == 0 {
+		annoPatch.AddOption(patch.WithAdd("/metadata/annotations", map[string]string{annotation: value}))
+	} else if ann, ok := pvc.Annotations[annotation]; ok && ann == value {
+		return nil
+	} else {
+		annoPatch.AddOption(patch.WithAdd("/metadata/annotations/"+patch.EscapeJSONPointer(annotation), value))
+	}
+
+	annoPatchPayload, err := annoPatch.GeneratePayload()
+	if err != nil {
+		return err
+	}
+
+	_, err = client.CoreV1().PersistentVolumeClaims(pvc.Namespace).Patch(context.Background(), pvc.Name, types.JSONPatchType, annoPatchPayload, metav1.PatchOptions{})
+	return err
+}
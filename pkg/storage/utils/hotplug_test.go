@@ -0,0 +1,125 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package utils
+
+import (
+	"context"
+
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	k8sv1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	k8sfake "k8s.io/client-go/kubernetes/fake"
+	"k8s.io/client-go/tools/cache"
+
+	v1 "kubevirt.io/api/core/v1"
+	"kubevirt.io/client-go/kubecli"
+	"kubevirt.io/client-go/kubevirt/fake"
+
+	"kubevirt.io/kubevirt/pkg/testutils"
+)
+
+var _ = Describe("Request volumes", func() {
+	const (
+		claimName  = "request-pvc"
+		annotation = "kubevirt.io/request"
+	)
+
+	var k8sClient *k8sfake.Clientset
+	var virtClient *kubecli.MockKubevirtClient
+	var virtFakeClient *fake.Clientset
+	var pvcStore cache.Store
+
+	BeforeEach(func() {
+		virtClient = kubecli.NewMockKubevirtClient(gomock.NewController(GinkgoT()))
+		virtFakeClient = fake.NewSimpleClientset()
+		k8sClient = k8sfake.NewSimpleClientset()
+		virtClient.EXPECT().VirtualMachineInstance(metav1.NamespaceDefault).Return(
+			virtFakeClient.KubevirtV1().VirtualMachineInstances(metav1.NamespaceDefault),
+		).AnyTimes()
+		virtClient.EXPECT().CoreV1().Return(k8sClient.CoreV1()).AnyTimes()
+
+		pvcInformer, _ := testutils.NewFakeInformerFor(&k8sv1.PersistentVolumeClaim{})
+		pvcStore = pvcInformer.GetStore()
+	})
+
+	addPVC := func(annotations map[string]string) {
+		pvc := &k8sv1.PersistentVolumeClaim{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:        claimName,
+				Namespace:   metav1.NamespaceDefault,
+				Annotations: annotations,
+			},
+		}
+		Expect(pvcStore.Add(pvc)).To(Succeed())
+		_, err := k8sClient.CoreV1().PersistentVolumeClaims(metav1.NamespaceDefault).Create(context.Background(), pvc, metav1.CreateOptions{})
+		Expect(err).ToNot(HaveOccurred())
+	}
+
+	DescribeTable("should set the annotation on the claim", func(annotations map[string]string) {
+		addPVC(annotations)
+
+		Expect(PatchPVCAnnotation(virtClient, pvcStore, metav1.NamespaceDefault, claimName, annotation, "done")).To(Succeed())
+
+		pvc, err := k8sClient.CoreV1().PersistentVolumeClaims(metav1.NamespaceDefault).Get(context.Background(), claimName, metav1.GetOptions{})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(pvc.Annotations).To(HaveKeyWithValue(annotation, "done"))
+	},
+		Entry("without annotations", nil),
+		Entry("with other annotations", map[string]string{"other": "value"}),
+		Entry("with an earlier value", map[string]string{annotation: "earlier"}),
+	)
+
+	It("should not patch a claim that already holds the value", func() {
+		addPVC(map[string]string{annotation: "done"})
+		k8sClient.ClearActions()
+
+		Expect(PatchPVCAnnotation(virtClient, pvcStore, metav1.NamespaceDefault, claimName, annotation, "done")).To(Succeed())
+		Expect(k8sClient.Actions()).To(BeEmpty())
+	})
+
+	It("should fail for a missing claim", func() {
+		Expect(PatchPVCAnnotation(virtClient, pvcStore, metav1.NamespaceDefault, claimName, annotation, "done")).To(MatchError(ContainSubstring("not found")))
+	})
+
+	It("should add and remove the request volume of the VMI", func() {
+		vmi := &v1.VirtualMachineInstance{ObjectMeta: metav1.ObjectMeta{Name: "testvmi", Namespace: metav1.NamespaceDefault}}
+		vmi, err := virtFakeClient.KubevirtV1().VirtualMachineInstances(metav1.NamespaceDefault).Create(context.Background(), vmi, metav1.CreateOptions{})
+		Expect(err).ToNot(HaveOccurred())
+
+		applyVolume := func(vmiSpec *v1.VirtualMachineInstanceSpec) *v1.VirtualMachineInstanceSpec {
+			vmiSpec.Volumes = append(vmiSpec.Volumes, v1.Volume{Name: claimName})
+			return vmiSpec
+		}
+		Expect(PatchVMIRequestVolume(virtClient, vmi, claimName, true, applyVolume)).To(Succeed())
+		vmi, err = virtFakeClient.KubevirtV1().VirtualMachineInstances(metav1.NamespaceDefault).Get(context.Background(), vmi.Name, metav1.GetOptions{})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(vmi.Spec.Volumes).To(ConsistOf(v1.Volume{Name: claimName}))
+
+		Expect(PatchVMIRequestVolume(virtClient, vmi, claimName, true, applyVolume)).To(MatchError(ContainSubstring("already exists")))
+
+		Expect(PatchVMIRequestVolume(virtClient, vmi, claimName, false, applyVolume)).To(Succeed())
+		vmi, err = virtFakeClient.KubevirtV1().VirtualMachineInstances(metav1.NamespaceDefault).Get(context.Background(), vmi.Name, metav1.GetOptions{})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(vmi.Spec.Volumes).To(BeEmpty())
+	})
+})
@@ -1,6 +1,9 @@
 package migrations
 
 import (
+	"time"
+
+	k8sv1 "k8s.io/api/core/v1"
 	v12 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/client-go/tools/cache"
 
@@ -13,6 +16,11 @@ import (
 
 const CancelMigrationFailedVmiNotMigratingErr = "failed to cancel migration - vmi is not migrating"
 
+const (
+	defaultPostMigrationVerificationTimeoutSeconds = int64(60)
+	defaultPostMigrationNetworkCheckPort           = int32(22)
+)
+
 func ListUnfinishedMigrations(store cache.Store) []*v1.VirtualMachineInstanceMigration {
 	objs := store.List()
 	migrations := []*v1.VirtualMachineInstanceMigration{}
@@ -58,6 +66,23 @@ func FilterRunningMigrations(migrations []*v1.VirtualMachineInstanceMigration) [
 	return runningMigrations
 }
 
+// PostMigrationVerificationTimeout returns the time after the end of a migration within which the guest has to pass the checks
+func PostMigrationVerificationTimeout(verification *v1.PostMigrationVerification) time.Duration {
+	timeoutSeconds := defaultPostMigrationVerificationTimeoutSeconds
+	if verification.TimeoutSeconds != nil && *verification.TimeoutSeconds > 0 {
+		timeoutSeconds = *verification.TimeoutSeconds
+	}
+	return time.Duration(timeoutSeconds) * time.Second
+}
+
+// PostMigrationNetworkCheckPort returns the TCP port of the guest the Network check connects to
+func PostMigrationNetworkCheckPort(verification *v1.PostMigrationVerification) int32 {
+	if verification.NetworkCheckPort != nil {
+		return *verification.NetworkCheckPort
+	}
+	return defaultPostMigrationNetworkCheckPort
+}
+
 // IsMigrating returns true if a given VMI is still migrating and false otherwise.
 func IsMigrating(vmi *v1.VirtualMachineInstance) bool {
 	if vmi == nil {
@@ -112,3 +137,60 @@ func VMIMigratableOnEviction(clusterConfig *virtconfig.ClusterConfig, vmi *v1.Vi
 	}
 	return false
 }
+
+// AddMigrationNodeConstraints restricts the nodes the target pod can be scheduled to by the ones requested on the migration.
+// The constraints of the VMI are preserved, so that the migration can only restrict but not bypass them.
+func AddMigrationNodeConstraints(pod *k8sv1.Pod, spec *v1.VirtualMachineInstanceMigrationSpec) {
+	if len(spec.AddedNodeSelector) > 0 && pod.Spec.NodeSelector == nil {
+		pod.Spec.NodeSelector = map[string]string{}
+	}
+	for key, value := range spec.AddedNodeSelector {
+		if _, exists := pod.Spec.NodeSelector[key]; !exists {
+			pod.Spec.NodeSelector[key] = value
+		}
+	}
+
+	addedAffinity := spec.AddedNodeAffinity
+	if addedAffinity == nil {
+		return
+	}
+	if pod.Spec.Affinity == nil {
+		pod.Spec.Affinity = &k8sv1.Affinity{}
+	}
+	if pod.Spec.Affinity.NodeAffinity == nil {
+		pod.Spec.Affinity.NodeAffinity = &k8sv1.NodeAffinity{}
+	}
+	nodeAffinity := pod.Spec.Affinity.NodeAffinity
+	if addedAffinity.RequiredDuringSchedulingIgnoredDuringExecution != nil {
+		nodeAffinity.RequiredDuringSchedulingIgnoredDuringExecution = intersectNodeSelectors(
+			nodeAffinity.RequiredDuringSchedulingIgnoredDuringExecution,
+			addedAffinity.RequiredDuringSchedulingIgnoredDuringExecution,
+		)
+	}
+	nodeAffinity.PreferredDuringSchedulingIgnoredDuringExecution = append(
+		nodeAffinity.PreferredDuringSchedulingIgnoredDuringExecution,
+		addedAffinity.PreferredDuringSchedulingIgnoredDuringExecution...,
+	)
+}
+
+// intersectNodeSelectors returns a node selector which only matches nodes matching both given selectors.
+// Since NodeSelectorTerms are ORed, every term of the one selector is combined with every term of the other.
+func intersectNodeSelectors(selector, added *k8sv1.NodeSelector) *k8sv1.NodeSelector {
+	if selector == nil || len(selector.NodeSelectorTerms) == 0 {
+		return added.DeepCopy()
+	}
+	if len(added.NodeSelectorTerms) == 0 {
+		return selector
+	}
+
+	intersection := &k8sv1.NodeSelector{}
+	for _, term := range selector.NodeSelectorTerms {
+		for _, addedTerm := range added.NodeSelectorTerms {
+			combinedTerm := term.DeepCopy()
+			combinedTerm.MatchExpressions = append(combinedTerm.MatchExpressions, addedTerm.MatchExpressions...)
+			combinedTerm.MatchFields = append(combinedTerm.MatchFields, addedTerm.MatchFields...)
+			intersection.NodeSelectorTerms = append(intersection.NodeSelectorTerms, *combinedTerm)
+		}
+	}
+	return intersection
+}
@@ -126,3 +126,56 @@ func (m *manager) GetCurrent() (*x509.CertPool, error) {
 
 	return pool, nil
 }
+
+type multiCAManager struct {
+	managers []ClientCAManager
+}
+
+// NewMultiCAManager returns a ClientCAManager which trusts the CAs of all given managers.
+// Managers which can't provide a CA are skipped, as long as at least one of them can.
+func NewMultiCAManager(managers ...ClientCAManager) ClientCAManager {
+	return &multiCAManager{
+		managers: managers,
+	}
+}
+
+func (m *multiCAManager) GetCurrentRaw() ([]byte, error) {
+	var bundle []byte
+	var lastErr error
+	for _, manager := range m.managers {
+		raw, err := manager.GetCurrentRaw()
+		if err != nil {
+			lastErr = err
+			continue
+		}
+		if len(bundle) > 0 && bundle[len(bundle)-1] != '\n' {
+			bundle = append(bundle, '\n')
+		}
+		bundle = append(bundle, raw...)
+	}
+
+	if len(bundle) == 0 {
+		if lastErr == nil {
+			lastErr = fmt.Errorf("no CA found")
+		}
+		return nil, lastErr
+	}
+	return bundle, nil
+}
+
+func (m *multiCAManager) GetCurrent() (*x509.CertPool, error) {
+	raw, err := m.GetCurrentRaw()
+	if err != nil {
+		return nil, err
+	}
+
+	certs, err := cert.ParseCertsPEM(raw)
+	if err != nil {
+		return nil, err
+	}
+	pool := x509.NewCertPool()
+	for _, cert := range certs {
+		pool.AddCert(cert)
+	}
+	return pool, nil
+}
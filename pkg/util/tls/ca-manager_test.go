@@ -12,6 +12,7 @@ import (
 	"kubevirt.io/kubevirt/pkg/certificates/triple"
 	"kubevirt.io/kubevirt/pkg/certificates/triple/cert"
 	"kubevirt.io/kubevirt/pkg/util"
+	"kubevirt.io/kubevirt/pkg/virt-operator/resource/generate/components"
 )
 
 var _ = Describe("CaManager", func() {
@@ -93,3 +94,57 @@ var _ = Describe("CaManager", func() {
 		Expect(cert.Subjects()[0]).To(ContainSubstring("first"))
 	})
 })
+
+var _ = Describe("MultiCAManager", func() {
+
+	var store cache.Store
+
+	newCAConfigMap := func(name string, commonName string) *v1.ConfigMap {
+		ca, err := triple.NewCA(commonName, time.Hour)
+		Expect(err).ToNot(HaveOccurred())
+		return &v1.ConfigMap{
+			ObjectMeta: v12.ObjectMeta{
+				Name:            name,
+				Namespace:       "kubevirt",
+				ResourceVersion: "1",
+			},
+			Data: map[string]string{
+				components.CABundleKey: string(cert.EncodeCertPEM(ca.Cert)),
+			},
+		}
+	}
+
+	BeforeEach(func() {
+		store = cache.NewStore(cache.DeletionHandlingMetaNamespaceKeyFunc)
+		Expect(store.Add(newCAConfigMap("local-ca", "local"))).To(Succeed())
+	})
+
+	It("should trust the CAs of all managers", func() {
+		Expect(store.Add(newCAConfigMap("remote-ca", "remote"))).To(Succeed())
+		manager := NewMultiCAManager(NewCAManager(store, "kubevirt", "local-ca"), NewCAManager(store, "kubevirt", "remote-ca"))
+
+		pool, err := manager.GetCurrent()
+		Expect(err).ToNot(HaveOccurred())
+		//nolint:staticcheck
+		subjects := pool.Subjects()
+		Expect(subjects).To(HaveLen(2))
+		Expect(string(subjects[0])).To(ContainSubstring("local"))
+		Expect(string(subjects[1])).To(ContainSubstring("remote"))
+	})
+
+	It("should skip managers without a CA", func() {
+		manager := NewMultiCAManager(NewCAManager(store, "kubevirt", "local-ca"), NewCAManager(store, "kubevirt", "remote-ca"))
+
+		pool, err := manager.GetCurrent()
+		Expect(err).ToNot(HaveOccurred())
+		//nolint:staticcheck
+		Expect(pool.Subjects()).To(HaveLen(1))
+	})
+
+	It("should fail if no manager has a CA", func() {
+		manager := NewMultiCAManager(NewCAManager(store, "kubevirt", "remote-ca"))
+
+		_, err := manager.GetCurrent()
+		Expect(err).To(HaveOccurred())
+	})
+})
@@ -1,15 +1,19 @@
 package webhooks
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"io"
 	"net/http"
 
 	admissionv1 "k8s.io/api/admission/v1"
+	authenticationv1 "k8s.io/api/authentication/v1"
+	authorizationv1 "k8s.io/api/authorization/v1"
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime/schema"
+	authorizationclient "k8s.io/client-go/kubernetes/typed/authorization/v1"
 
 	v12 "kubevirt.io/api/core/v1"
 	instancetypev1beta1 "kubevirt.io/api/instancetype/v1beta1"
@@ -245,3 +249,28 @@ func GetPreferenceSpecFromAdmissionRequest(request *admissionv1.AdmissionRequest
 
 	return &preferenceObj.Spec, nil, nil
 }
+
+// IsUserAllowed asks the API server whether the user of an admission request
+// may act on the given resource
+func IsUserAllowed(ctx context.Context, client authorizationclient.SubjectAccessReviewInterface, userInfo authenticationv1.UserInfo, attributes *authorizationv1.ResourceAttributes) (bool, error) {
+	extra := make(map[string]authorizationv1.ExtraValue, len(userInfo.Extra))
+	for k, v := range userInfo.Extra {
+		extra[k] = authorizationv1.ExtraValue(v)
+	}
+
+	sar := &authorizationv1.SubjectAccessReview{
+		Spec: authorizationv1.SubjectAccessReviewSpec{
+			User:               userInfo.Username,
+			Groups:             userInfo.Groups,
+			UID:                userInfo.UID,
+			Extra:              extra,
+			ResourceAttributes: attributes,
+		},
+	}
+
+	sar, err := client.Create(ctx, sar, v1.CreateOptions{})
+	if err != nil {
+		return false, err
+	}
+	return sar.Status.Allowed, nil
+}
@@ -142,6 +142,10 @@ type virtAPIApp struct {
 	reInitChan chan string
 
 	kubeVirtServiceAccounts map[string]struct{}
+
+	// informers used by the migration check subresource
+	nodeInformer        cache.SharedIndexInformer
+	kubeVirtPodInformer cache.SharedIndexInformer
 }
 
 var (
@@ -239,7 +243,7 @@ func (app *virtAPIApp) composeSubresources() {
 		subws.Doc(fmt.Sprintf("KubeVirt \"%s\" Subresource API.", version.Version))
 		subws.Path(definitions.GroupVersionBasePath(version))
 
-		subresourceApp := rest.NewSubresourceAPIApp(app.virtCli, app.consoleServerPort, app.handlerTLSConfiguration, app.clusterConfig)
+		subresourceApp := rest.NewSubresourceAPIApp(app.virtCli, app.consoleServerPort, app.handlerTLSConfiguration, app.clusterConfig, app.nodeInformer, app.kubeVirtPodInformer)
 
 		restartRouteBuilder := subws.PUT(definitions.NamespacedResourcePath(subresourcesvmGVR)+definitions.SubResourcePath("restart")).
 			To(subresourceApp.RestartVMRequestHandler).
@@ -476,6 +480,30 @@ func (app *virtAPIApp) composeSubresources() {
 			Writes(v1.VirtualMachineInstanceFileSystemList{}).
 			Returns(http.StatusOK, "OK", v1.VirtualMachineInstanceFileSystemList{}))
 
+		subws.Route(subws.PUT(definitions.NamespacedResourcePath(subresourcesvmiGVR)+definitions.SubResourcePath("guestexec")).
+			To(subresourceApp.GuestExecRequestHandler).
+			Consumes(mime.MIME_ANY).
+			Produces(restful.MIME_JSON).
+			Reads(v1.VirtualMachineInstanceGuestExecOptions{}).
+			Param(definitions.NamespaceParam(subws)).Param(definitions.NameParam(subws)).
+			Operation(version.Version+"Guestexec").
+			Doc("Execute a command on the guest via guest agent, and return its buffered output once it exited").
+			Writes(v1.VirtualMachineInstanceGuestExecResult{}).
+			Returns(http.StatusOK, "OK", v1.VirtualMachineInstanceGuestExecResult{}).
+			Returns(http.StatusBadRequest, httpStatusBadRequestMessage, ""))
+
+		subws.Route(subws.PUT(definitions.NamespacedResourcePath(subresourcesvmiGVR)+definitions.SubResourcePath("migrate/check")).
+			To(subresourceApp.MigrationCheckRequestHandler).
+			Consumes(mime.MIME_ANY).
+			Produces(restful.MIME_JSON).
+			Reads(v1.MigrateOptions{}).
+			Param(definitions.NamespaceParam(subws)).Param(definitions.NameParam(subws)).
+			Operation(version.Version+"MigrationCheck").
+			Doc("Check whether a VirtualMachineInstance can be live migrated without migrating it").
+			Writes(v1.VirtualMachineInstanceMigrationCheckResult{}).
+			Returns(http.StatusOK, "OK", v1.VirtualMachineInstanceMigrationCheckResult{}).
+			Returns(http.StatusBadRequest, httpStatusBadRequestMessage, ""))
+
 		subws.Route(subws.PUT(definitions.NamespacedResourcePath(subresourcesvmiGVR)+definitions.SubResourcePath("addvolume")).
 			To(subresourceApp.VMIAddVolumeRequestHandler).
 			Consumes(mime.MIME_ANY).
@@ -534,6 +562,24 @@ func (app *virtAPIApp) composeSubresources() {
 			Returns(http.StatusOK, "OK", "").
 			Returns(http.StatusInternalServerError, httpStatusInternalServerError, ""))
 
+		subws.Route(subws.PUT(definitions.NamespacedResourcePath(subresourcesvmGVR)+definitions.SubResourcePath("backup")).
+			To(subresourceApp.BackupVMRequestHandler).
+			Consumes(mime.MIME_ANY).
+			Reads(v1.VirtualMachineBackupRequest{}).
+			Param(definitions.NamespaceParam(subws)).Param(definitions.NameParam(subws)).
+			Operation(version.Version+"Backup").
+			Doc("Backs up the disks of a running VirtualMachine, fully or incrementally since a previous backup.").
+			Returns(http.StatusOK, "OK", "").
+			Returns(http.StatusInternalServerError, httpStatusInternalServerError, ""))
+
+		subws.Route(subws.PUT(definitions.NamespacedResourcePath(subresourcesvmGVR)+definitions.SubResourcePath("removebackup")).
+			To(subresourceApp.RemoveBackupVMRequestHandler).
+			Param(definitions.NamespaceParam(subws)).Param(definitions.NameParam(subws)).
+			Operation(version.Version+"RemoveBackup").
+			Doc("Remove backup association.").
+			Returns(http.StatusOK, "OK", "").
+			Returns(http.StatusInternalServerError, httpStatusInternalServerError, ""))
+
 		// AMD SEV endpoints
 		subws.Route(subws.GET(definitions.NamespacedResourcePath(subresourcesvmiGVR)+definitions.SubResourcePath("sev/fetchcertchain")).
 			To(subresourceApp.SEVFetchCertChainRequestHandler).
@@ -657,6 +703,14 @@ func (app *virtAPIApp) composeSubresources() {
 						Name:       "virtualmachineinstances/filesystemlist",
 						Namespaced: true,
 					},
+					{
+						Name:       "virtualmachineinstances/guestexec",
+						Namespaced: true,
+					},
+					{
+						Name:       "virtualmachineinstances/migrate/check",
+						Namespaced: true,
+					},
 					{
 						Name:       "virtualmachineinstances/addvolume",
 						Namespaced: true,
@@ -892,7 +946,7 @@ func (app *virtAPIApp) registerValidatingWebhooks(informers *webhooks.Informers)
 		validating_webhook.ServeVMIPreset(w, r)
 	})
 	http.HandleFunc(components.MigrationCreateValidatePath, func(w http.ResponseWriter, r *http.Request) {
-		validating_webhook.ServeMigrationCreate(w, r, app.virtCli)
+		validating_webhook.ServeMigrationCreate(w, r, app.clusterConfig, app.virtCli)
 	})
 	http.HandleFunc(components.MigrationUpdateValidatePath, func(w http.ResponseWriter, r *http.Request) {
 		validating_webhook.ServeMigrationUpdate(w, r)
@@ -900,6 +954,15 @@ func (app *virtAPIApp) registerValidatingWebhooks(informers *webhooks.Informers)
 	http.HandleFunc(components.VMSnapshotValidatePath, func(w http.ResponseWriter, r *http.Request) {
 		validating_webhook.ServeVMSnapshots(w, r, app.clusterConfig, app.virtCli)
 	})
+	http.HandleFunc(components.VMSnapshotScheduleValidatePath, func(w http.ResponseWriter, r *http.Request) {
+		validating_webhook.ServeVMSnapshotSchedules(w, r, app.clusterConfig)
+	})
+	http.HandleFunc(components.VMSnapshotGroupValidatePath, func(w http.ResponseWriter, r *http.Request) {
+		validating_webhook.ServeVMSnapshotGroups(w, r, app.clusterConfig)
+	})
+	http.HandleFunc(components.VMRestoreGroupValidatePath, func(w http.ResponseWriter, r *http.Request) {
+		validating_webhook.ServeVMRestoreGroups(w, r, app.clusterConfig)
+	})
 	http.HandleFunc(components.VMRestoreValidatePath, func(w http.ResponseWriter, r *http.Request) {
 		validating_webhook.ServeVMRestores(w, r, app.clusterConfig, app.virtCli, informers)
 	})
@@ -925,7 +988,7 @@ func (app *virtAPIApp) registerValidatingWebhooks(informers *webhooks.Informers)
 		validating_webhook.ServePodEvictionInterceptor(w, r, app.clusterConfig, app.virtCli)
 	})
 	http.HandleFunc(components.MigrationPolicyCreateValidatePath, func(w http.ResponseWriter, r *http.Request) {
-		validating_webhook.ServeMigrationPolicies(w, r)
+		validating_webhook.ServeMigrationPolicies(w, r, app.clusterConfig)
 	})
 	http.HandleFunc(components.VMCloneCreateValidatePath, func(w http.ResponseWriter, r *http.Request) {
 		validating_webhook.ServeVirtualMachineClones(w, r, app.clusterConfig, app.virtCli)
@@ -1078,6 +1141,8 @@ func (app *virtAPIApp) Run() {
 	vmiPresetInformer := kubeInformerFactory.VirtualMachinePreset()
 	vmRestoreInformer := kubeInformerFactory.VirtualMachineRestore()
 	namespaceInformer := kubeInformerFactory.Namespace()
+	app.nodeInformer = kubeInformerFactory.KubeVirtNode()
+	app.kubeVirtPodInformer = kubeInformerFactory.KubeVirtPod()
 
 	stopChan := make(chan struct{}, 1)
 	defer close(stopChan)
@@ -125,6 +125,9 @@ func snapshotApiServiceDefinitions() []*restful.WebService {
 	vmsGVR := snapshotv1.SchemeGroupVersion.WithResource("virtualmachinesnapshots")
 	vmscGVR := snapshotv1.SchemeGroupVersion.WithResource("virtualmachinesnapshotcontents")
 	vmrGVR := snapshotv1.SchemeGroupVersion.WithResource("virtualmachinerestores")
+	vmssGVR := snapshotv1.SchemeGroupVersion.WithResource("virtualmachinesnapshotschedules")
+	vmsgGVR := snapshotv1.SchemeGroupVersion.WithResource("virtualmachinesnapshotgroups")
+	vmrgGVR := snapshotv1.SchemeGroupVersion.WithResource("virtualmachinerestoregroups")
 
 	ws, err := groupVersionProxyBase(schema.GroupVersion{Group: snapshotv1.SchemeGroupVersion.Group, Version: snapshotv1.SchemeGroupVersion.Version})
 	if err != nil {
@@ -146,6 +149,21 @@ func snapshotApiServiceDefinitions() []*restful.WebService {
 		panic(err)
 	}
 
+	ws, err = genericNamespacedResourceProxy(ws, vmssGVR, &snapshotv1.VirtualMachineSnapshotSchedule{}, "VirtualMachineSnapshotSchedule", &snapshotv1.VirtualMachineSnapshotScheduleList{})
+	if err != nil {
+		panic(err)
+	}
+
+	ws, err = genericNamespacedResourceProxy(ws, vmsgGVR, &snapshotv1.VirtualMachineSnapshotGroup{}, "VirtualMachineSnapshotGroup", &snapshotv1.VirtualMachineSnapshotGroupList{})
+	if err != nil {
+		panic(err)
+	}
+
+	ws, err = genericNamespacedResourceProxy(ws, vmrgGVR, &snapshotv1.VirtualMachineRestoreGroup{}, "VirtualMachineRestoreGroup", &snapshotv1.VirtualMachineRestoreGroupList{})
+	if err != nil {
+		panic(err)
+	}
+
 	ws2, err := resourceProxyAutodiscovery(vmsGVR)
 	if err != nil {
 		panic(err)
@@ -0,0 +1,288 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package rest
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/emicklei/go-restful/v3"
+	"k8s.io/apimachinery/pkg/api/errors"
+	k8smetav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	k8svalidation "k8s.io/apimachinery/pkg/util/validation"
+	"k8s.io/apimachinery/pkg/util/yaml"
+
+	v1 "kubevirt.io/api/core/v1"
+	"kubevirt.io/client-go/log"
+
+	"kubevirt.io/kubevirt/pkg/apimachinery/patch"
+	storagetypes "kubevirt.io/kubevirt/pkg/storage/types"
+	"kubevirt.io/kubevirt/pkg/virt-config/featuregate"
+)
+
+const (
+	backupNameConflictErr   = "can't request backup for pvc [%s] while pvc [%s] is still associated as the backup pvc"
+	backupNameReusedErr     = "backup name [%s] was already used by the previous backup"
+	backupIncrementalRawErr = "incremental backups can only be written in qcow2 format"
+	backupCheckpointErr     = "checkpoint [%s] doesn't exist, only the last completed backup taken by the running VMI can be the base of an incremental backup"
+)
+
+func addBackupRequest(vm, vmCopy *v1.VirtualMachine, backupReq *v1.VirtualMachineBackupRequest) error {
+	claimName := backupReq.ClaimName
+	if vm.Status.BackupRequest != nil {
+		if vm.Status.BackupRequest.Phase == v1.BackupDissociating {
+			return fmt.Errorf("can't backup to pvc [%s] a remove backup request is in progress", claimName)
+		}
+		if vm.Status.BackupRequest.Phase != v1.BackupCompleted && vm.Status.BackupRequest.Phase != v1.BackupFailed {
+			return fmt.Errorf("backup request for pvc [%s] already in progress", claimName)
+		}
+	}
+	vmCopy.Status.BackupRequest = backupReq
+	return nil
+}
+
+func removeBackupRequest(vm, vmCopy *v1.VirtualMachine, backupReq *v1.VirtualMachineBackupRequest) error {
+	if vm.Status.BackupRequest == nil {
+		return fmt.Errorf("can't remove backup association for vm %s, no association found", vm.Name)
+	}
+
+	claimName := vm.Status.BackupRequest.ClaimName
+	if vm.Status.BackupRequest.Remove {
+		return fmt.Errorf("backup remove request for pvc [%s] already exists", claimName)
+	}
+	backupReq.ClaimName = claimName
+	vmCopy.Status.BackupRequest = backupReq
+	return nil
+}
+
+func generateVMBackupRequestPatch(vm *v1.VirtualMachine, backupReq *v1.VirtualMachineBackupRequest, removeRequest bool) ([]byte, error) {
+	vmCopy := vm.DeepCopy()
+
+	if !removeRequest {
+		if err := addBackupRequest(vm, vmCopy, backupReq); err != nil {
+			return nil, err
+		}
+	} else {
+		if err := removeBackupRequest(vm, vmCopy, backupReq); err != nil {
+			return nil, err
+		}
+	}
+
+	patchSet := patch.New(patch.WithTest("/status/backupRequest", vm.Status.BackupRequest))
+	if vm.Status.BackupRequest != nil {
+		patchSet.AddOption(patch.WithReplace("/status/backupRequest", vmCopy.Status.BackupRequest))
+	} else {
+		patchSet.AddOption(patch.WithAdd("/status/backupRequest", vmCopy.Status.BackupRequest))
+	}
+
+	return patchSet.GeneratePayload()
+}
+
+func (app *SubresourceAPIApp) validateBackupClaim(claimName, namespace string) *errors.StatusError {
+	pvc, err := app.fetchPersistentVolumeClaim(claimName, namespace)
+	if err != nil {
+		return err
+	}
+	if storagetypes.IsPVCBlock(pvc.Spec.VolumeMode) {
+		return errors.NewConflict(v1.Resource("persistentvolumeclaim"), claimName, fmt.Errorf(pvcVolumeModeErr))
+	}
+
+	if storagetypes.IsReadOnlyAccessMode(pvc.Spec.AccessModes) {
+		return errors.NewConflict(v1.Resource("persistentvolumeclaim"), claimName, fmt.Errorf(pvcAccessModeErr))
+	}
+
+	return nil
+}
+
+func validateBackupOptions(backupReq *v1.VirtualMachineBackupRequest) *errors.StatusError {
+	if msgs := k8svalidation.IsDNS1123Subdomain(backupReq.BackupName); len(msgs) > 0 {
+		return errors.NewBadRequest(fmt.Sprintf("invalid backup name [%s]: %v", backupReq.BackupName, msgs))
+	}
+	if backupReq.IncrementalFrom != "" && backupReq.IncrementalFrom == backupReq.BackupName {
+		return errors.NewBadRequest("a backup can't be incremental from itself")
+	}
+
+	switch backupReq.Format {
+	case v1.BackupFormatQCOW2:
+	case v1.BackupFormatRaw:
+		if backupReq.IncrementalFrom != "" {
+			return errors.NewBadRequest(backupIncrementalRawErr)
+		}
+	default:
+		return errors.NewBadRequest(fmt.Sprintf("unsupported backup format [%s]", backupReq.Format))
+	}
+
+	return nil
+}
+
+// validateIncrementalFrom makes sure the checkpoint of an incremental backup still exists.
+// The checkpoints live in the domain, they don't survive a restart or a migration of the VMI,
+// and only the one of the last completed backup is kept.
+func validateIncrementalFrom(vm *v1.VirtualMachine, vmi *v1.VirtualMachineInstance, backupReq *v1.VirtualMachineBackupRequest) *errors.StatusError {
+	if backupReq.IncrementalFrom == "" {
+		return nil
+	}
+
+	prevReq := vm.Status.BackupRequest
+	checkpointErr := errors.NewConflict(v1.Resource("virtualmachine"), vm.Name, fmt.Errorf(backupCheckpointErr, backupReq.IncrementalFrom))
+	if prevReq == nil || prevReq.BackupName != backupReq.IncrementalFrom || prevReq.Phase != v1.BackupCompleted || prevReq.StartTimestamp == nil {
+		return checkpointErr
+	}
+	if prevReq.StartTimestamp.Before(&vmi.CreationTimestamp) {
+		return checkpointErr
+	}
+	if vmi.Status.MigrationState != nil && vmi.Status.MigrationState.EndTimestamp != nil &&
+		prevReq.StartTimestamp.Before(vmi.Status.MigrationState.EndTimestamp) {
+		return checkpointErr
+	}
+
+	return nil
+}
+
+func (app *SubresourceAPIApp) validateBackupRequest(vm *v1.VirtualMachine, backupReq *v1.VirtualMachineBackupRequest) *errors.StatusError {
+	if backupReq.ClaimName == "" && vm.Status.BackupRequest == nil {
+		return errors.NewBadRequest("Backup requires claim name to be set")
+	} else if vm.Status.BackupRequest != nil && backupReq.ClaimName != "" {
+		if vm.Status.BackupRequest.ClaimName != backupReq.ClaimName {
+			return errors.NewConflict(v1.Resource("virtualmachine"), vm.Name, fmt.Errorf(backupNameConflictErr, backupReq.ClaimName, vm.Status.BackupRequest.ClaimName))
+		}
+	} else if vm.Status.BackupRequest != nil {
+		backupReq.ClaimName = vm.Status.BackupRequest.ClaimName
+	}
+
+	if backupReq.BackupName == "" {
+		backupReq.BackupName = fmt.Sprintf("%s-%s", vm.Name, time.Now().UTC().Format("20060102-150405"))
+	}
+	if backupReq.Format == "" {
+		backupReq.Format = v1.BackupFormatQCOW2
+	}
+	if statErr := validateBackupOptions(backupReq); statErr != nil {
+		return statErr
+	}
+	if vm.Status.BackupRequest != nil && vm.Status.BackupRequest.BackupName == backupReq.BackupName {
+		return errors.NewConflict(v1.Resource("virtualmachine"), vm.Name, fmt.Errorf(backupNameReusedErr, backupReq.BackupName))
+	}
+
+	vmi, statErr := app.FetchVirtualMachineInstance(vm.Namespace, vm.Name)
+	if statErr != nil {
+		return statErr
+	}
+
+	if !vmi.IsRunning() {
+		return errors.NewConflict(v1.Resource("virtualmachineinstance"), vm.Name, fmt.Errorf(vmiNotRunning))
+	}
+
+	if statErr = validateIncrementalFrom(vm, vmi, backupReq); statErr != nil {
+		return statErr
+	}
+
+	if statErr = app.validateBackupClaim(backupReq.ClaimName, vm.Namespace); statErr != nil {
+		return statErr
+	}
+
+	return nil
+}
+
+func (app *SubresourceAPIApp) vmBackupRequestPatchStatus(name, namespace string, backupReq *v1.VirtualMachineBackupRequest, removeRequest bool) *errors.StatusError {
+	vm, statErr := app.fetchVirtualMachine(name, namespace)
+	if statErr != nil {
+		return statErr
+	}
+
+	if !removeRequest {
+		statErr = app.validateBackupRequest(vm, backupReq)
+		if statErr != nil {
+			return statErr
+		}
+	}
+
+	patchBytes, err := generateVMBackupRequestPatch(vm, backupReq, removeRequest)
+	if err != nil {
+		return errors.NewConflict(v1.Resource("virtualmachine"), name, err)
+	}
+
+	log.Log.Object(vm).V(4).Infof(patchingVMFmt, string(patchBytes))
+	if _, err = app.virtCli.VirtualMachine(vm.Namespace).PatchStatus(context.Background(), vm.Name, types.JSONPatchType, patchBytes, k8smetav1.PatchOptions{}); err != nil {
+		log.Log.Object(vm).Errorf("unable to patch vm status: %v", err)
+		if errors.IsInvalid(err) {
+			if statErr, ok := err.(*errors.StatusError); ok {
+				return statErr
+			}
+		}
+		return errors.NewInternalError(fmt.Errorf("unable to patch vm status: %v", err))
+	}
+	return nil
+}
+
+func (app *SubresourceAPIApp) BackupVMRequestHandler(request *restful.Request, response *restful.Response) {
+	name := request.PathParameter("name")
+	namespace := request.PathParameter("namespace")
+
+	if !app.clusterConfig.IncrementalBackupEnabled() {
+		writeError(errors.NewBadRequest(fmt.Sprintf(featureGateDisabledErrFmt, featuregate.IncrementalBackupGate)), response)
+		return
+	}
+
+	backupReq := &v1.VirtualMachineBackupRequest{}
+	if request.Request.Body != nil {
+		defer request.Request.Body.Close()
+		err := yaml.NewYAMLOrJSONDecoder(request.Request.Body, 1024).Decode(backupReq)
+		switch err {
+		case io.EOF, nil:
+			break
+		default:
+			writeError(errors.NewBadRequest(fmt.Sprintf(unmarshalRequestErrFmt, err)), response)
+			return
+		}
+	} else {
+		writeError(errors.NewBadRequest("Request with no body"), response)
+		return
+	}
+
+	backupReq.Phase = v1.BackupAssociating
+	isRemoveRequest := false
+	if err := app.vmBackupRequestPatchStatus(name, namespace, backupReq, isRemoveRequest); err != nil {
+		writeError(err, response)
+		return
+	}
+
+	response.WriteHeader(http.StatusAccepted)
+}
+
+func (app *SubresourceAPIApp) RemoveBackupVMRequestHandler(request *restful.Request, response *restful.Response) {
+	name := request.PathParameter("name")
+	namespace := request.PathParameter("namespace")
+
+	removeReq := &v1.VirtualMachineBackupRequest{
+		Phase:  v1.BackupDissociating,
+		Remove: true,
+	}
+	isRemoveRequest := true
+	if err := app.vmBackupRequestPatchStatus(name, namespace, removeReq, isRemoveRequest); err != nil {
+		writeError(err, response)
+		return
+	}
+
+	response.WriteHeader(http.StatusAccepted)
+}
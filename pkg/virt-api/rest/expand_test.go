@@ -77,7 +77,7 @@ var _ = Describe("Instancetype expansion subresources", func() {
 			},
 		}
 
-		app = NewSubresourceAPIApp(virtClient, 0, nil, nil)
+		app = NewSubresourceAPIApp(virtClient, 0, nil, nil, nil, nil)
 		app.instancetypeMethods = &instancetype.InstancetypeMethods{
 			Clientset: virtClient,
 		}
@@ -0,0 +1,121 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package rest
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/emicklei/go-restful/v3"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/util/yaml"
+
+	v1 "kubevirt.io/api/core/v1"
+	"kubevirt.io/client-go/kubecli"
+	"kubevirt.io/client-go/log"
+
+	"kubevirt.io/kubevirt/pkg/controller"
+)
+
+const (
+	defaultGuestExecTimeoutSeconds = int32(30)
+	maxGuestExecTimeoutSeconds     = int32(300)
+)
+
+// GuestExecRequestHandler runs a command in the guest through the guest agent and returns its output
+// and exit code once the command exited. The output is buffered, not streamed.
+func (app *SubresourceAPIApp) GuestExecRequestHandler(request *restful.Request, response *restful.Response) {
+	name := request.PathParameter("name")
+	namespace := request.PathParameter("namespace")
+
+	if request.Request.Body == nil {
+		writeError(errors.NewBadRequest("Request with no body: guest exec options are required"), response)
+		return
+	}
+	opts := &v1.VirtualMachineInstanceGuestExecOptions{}
+	err := yaml.NewYAMLOrJSONDecoder(request.Request.Body, 1024).Decode(opts)
+	switch err {
+	case io.EOF, nil:
+		break
+	default:
+		writeError(errors.NewBadRequest(fmt.Sprintf(unmarshalRequestErrFmt, err)), response)
+		return
+	}
+	if opts.Command == "" {
+		writeError(errors.NewBadRequest("a command to execute in the guest is required"), response)
+		return
+	}
+	if opts.TimeoutSeconds == nil {
+		timeout := defaultGuestExecTimeoutSeconds
+		opts.TimeoutSeconds = &timeout
+	}
+	if *opts.TimeoutSeconds < 1 || *opts.TimeoutSeconds > maxGuestExecTimeoutSeconds {
+		writeError(errors.NewBadRequest(fmt.Sprintf("timeoutSeconds must be between 1 and %d", maxGuestExecTimeoutSeconds)), response)
+		return
+	}
+
+	validate := func(vmi *v1.VirtualMachineInstance) *errors.StatusError {
+		if vmi.Status.Phase != v1.Running {
+			return errors.NewConflict(v1.Resource("virtualmachineinstance"), vmi.Name, fmt.Errorf(vmiNotRunning))
+		}
+		condManager := controller.NewVirtualMachineInstanceConditionManager()
+		if !condManager.HasCondition(vmi, v1.VirtualMachineInstanceAgentConnected) {
+			return errors.NewConflict(v1.Resource("virtualmachineinstance"), vmi.Name, fmt.Errorf(vmiGuestAgentErr))
+		}
+		return nil
+	}
+	vmi, statusErr := app.fetchAndValidateVirtualMachineInstance(namespace, name, validate)
+	if statusErr != nil {
+		writeError(statusErr, response)
+		return
+	}
+
+	// The command may run longer than regular requests to virt-handler are allowed to take
+	httpClient := *app.handlerHttpClient
+	httpClient.Timeout += time.Duration(*opts.TimeoutSeconds) * time.Second
+	conn := kubecli.NewVirtHandlerClient(app.virtCli, &httpClient).Port(app.consoleServerPort).ForNode(vmi.Status.NodeName)
+	url, err := conn.GuestExecURI(vmi)
+	if err != nil {
+		writeError(errors.NewBadRequest(err.Error()), response)
+		return
+	}
+
+	body, err := json.Marshal(opts)
+	if err != nil {
+		writeError(errors.NewInternalError(err), response)
+		return
+	}
+	resp, err := conn.Post(url, io.NopCloser(bytes.NewReader(body)))
+	if err != nil {
+		log.Log.Object(vmi).Reason(err).Error("Failed to execute command in the guest")
+		writeError(errors.NewInternalError(err), response)
+		return
+	}
+
+	result := &v1.VirtualMachineInstanceGuestExecResult{}
+	if err := json.Unmarshal([]byte(resp), result); err != nil {
+		writeError(errors.NewInternalError(err), response)
+		return
+	}
+	response.WriteEntity(result)
+}
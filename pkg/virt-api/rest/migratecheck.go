@@ -0,0 +1,274 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package rest
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+
+	"github.com/emicklei/go-restful/v3"
+	k8sv1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	k8smetav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/util/yaml"
+	corev1helpers "k8s.io/component-helpers/scheduling/corev1"
+	"k8s.io/component-helpers/scheduling/corev1/nodeaffinity"
+
+	v1 "kubevirt.io/api/core/v1"
+
+	"kubevirt.io/kubevirt/pkg/controller"
+	"kubevirt.io/kubevirt/pkg/util/migrations"
+)
+
+// MigrationCheckRequestHandler checks whether a VMI can be live migrated without creating a migration.
+// It reports the blockers found by the LiveMigratable condition, in-flight migrations and a simulation
+// of the scheduling of the target pod against the nodes.
+func (app *SubresourceAPIApp) MigrationCheckRequestHandler(request *restful.Request, response *restful.Response) {
+	name := request.PathParameter("name")
+	namespace := request.PathParameter("namespace")
+
+	opts := &v1.MigrateOptions{}
+	if request.Request.Body != nil {
+		err := yaml.NewYAMLOrJSONDecoder(request.Request.Body, 1024).Decode(opts)
+		switch err {
+		case io.EOF, nil:
+			break
+		default:
+			writeError(errors.NewBadRequest(fmt.Sprintf(unmarshalRequestErrFmt, err)), response)
+			return
+		}
+	}
+
+	validate := func(vmi *v1.VirtualMachineInstance) *errors.StatusError {
+		if vmi.Status.Phase != v1.Running {
+			return errors.NewConflict(v1.Resource("virtualmachineinstance"), vmi.Name, fmt.Errorf(vmiNotRunning))
+		}
+		return nil
+	}
+	vmi, statusErr := app.fetchAndValidateVirtualMachineInstance(namespace, name, validate)
+	if statusErr != nil {
+		writeError(statusErr, response)
+		return
+	}
+
+	blockers := liveMigratableBlockers(vmi)
+
+	migrationBlocker, err := app.migrationInProgressBlocker(vmi)
+	if err != nil {
+		writeError(errors.NewInternalError(err), response)
+		return
+	}
+	if migrationBlocker != nil {
+		blockers = append(blockers, *migrationBlocker)
+	}
+
+	sourcePod, err := controller.CurrentVMIPod(vmi, app.kubeVirtPodInformer.GetIndexer())
+	if err != nil {
+		writeError(errors.NewInternalError(err), response)
+		return
+	}
+	var nodes []*k8sv1.Node
+	for _, obj := range app.nodeInformer.GetStore().List() {
+		nodes = append(nodes, obj.(*k8sv1.Node))
+	}
+	targetPod := renderTargetPod(vmi, sourcePod, &v1.VirtualMachineInstanceMigrationSpec{
+		AddedNodeSelector: opts.AddedNodeSelector,
+		AddedNodeAffinity: opts.AddedNodeAffinity,
+	})
+	targetNodes, schedulingBlockers := simulateTargetPodScheduling(vmi, targetPod, nodes)
+	blockers = append(blockers, schedulingBlockers...)
+
+	response.WriteEntity(&v1.VirtualMachineInstanceMigrationCheckResult{
+		Migratable:  len(blockers) == 0,
+		Blockers:    blockers,
+		TargetNodes: targetNodes,
+	})
+}
+
+func liveMigratableBlockers(vmi *v1.VirtualMachineInstance) []v1.MigrationBlocker {
+	var blockers []v1.MigrationBlocker
+	for _, c := range vmi.Status.Conditions {
+		if c.Type == v1.VirtualMachineInstanceIsMigratable && c.Status == k8sv1.ConditionFalse {
+			blockers = append(blockers, v1.MigrationBlocker{
+				Type:    v1.MigrationBlockerLiveMigratable,
+				Reason:  c.Reason,
+				Message: c.Message,
+			})
+		}
+	}
+	return blockers
+}
+
+func (app *SubresourceAPIApp) migrationInProgressBlocker(vmi *v1.VirtualMachineInstance) (*v1.MigrationBlocker, error) {
+	migrations, err := app.virtCli.VirtualMachineInstanceMigration(vmi.Namespace).List(context.Background(), k8smetav1.ListOptions{
+		LabelSelector: fmt.Sprintf("%s=%s", v1.MigrationSelectorLabel, vmi.Name),
+	})
+	if err != nil {
+		return nil, err
+	}
+	for _, migration := range migrations.Items {
+		if migration.IsFinal() {
+			continue
+		}
+		return &v1.MigrationBlocker{
+			Type:    v1.MigrationBlockerMigrationInProgress,
+			Message: fmt.Sprintf("migration %s of the VMI is already in progress", migration.Name),
+		}, nil
+	}
+	return nil, nil
+}
+
+// renderTargetPod returns a pod with the scheduling constraints of the target pod of a migration.
+// The target pod is rendered like the source pod, complemented by the node constraints of the migration.
+func renderTargetPod(vmi *v1.VirtualMachineInstance, sourcePod *k8sv1.Pod, spec *v1.VirtualMachineInstanceMigrationSpec) *k8sv1.Pod {
+	targetPod := &k8sv1.Pod{}
+	if sourcePod != nil {
+		targetPod.Spec.NodeSelector = sourcePod.Spec.NodeSelector
+		targetPod.Spec.Affinity = sourcePod.Spec.Affinity
+		targetPod.Spec.Tolerations = sourcePod.Spec.Tolerations
+	} else {
+		targetPod.Spec.NodeSelector = map[string]string{v1.NodeSchedulable: "true"}
+		for key, value := range vmi.Spec.NodeSelector {
+			targetPod.Spec.NodeSelector[key] = value
+		}
+		targetPod.Spec.Affinity = vmi.Spec.Affinity
+		targetPod.Spec.Tolerations = vmi.Spec.Tolerations
+	}
+	targetPod = targetPod.DeepCopy()
+	migrations.AddMigrationNodeConstraints(targetPod, spec)
+	return targetPod
+}
+
+// simulateTargetPodScheduling returns the nodes the target pod of a migration could be scheduled to.
+// As the migration controller does, the CPU model and features of the source node are required for
+// host-model VMIs.
+func simulateTargetPodScheduling(vmi *v1.VirtualMachineInstance, targetPod *k8sv1.Pod, nodes []*k8sv1.Node) ([]string, []v1.MigrationBlocker) {
+	targetPod = targetPod.DeepCopy()
+	// The CPU labels are matched separately to tell CPU model blockers apart
+	cpuSelector := map[string]string{}
+	for key, value := range targetPod.Spec.NodeSelector {
+		if isCPUNodeLabel(key) {
+			cpuSelector[key] = value
+			delete(targetPod.Spec.NodeSelector, key)
+		}
+	}
+
+	var sourceNode *k8sv1.Node
+	for _, node := range nodes {
+		if node.Name == vmi.Status.NodeName {
+			sourceNode = node
+		}
+	}
+	if err := addHostModelCPUSelector(vmi, sourceNode, cpuSelector); err != nil {
+		return nil, []v1.MigrationBlocker{{
+			Type:    v1.MigrationBlockerCPUModel,
+			Message: err.Error(),
+		}}
+	}
+
+	requiredNodeAffinity := nodeaffinity.GetRequiredNodeAffinity(targetPod)
+	var schedulableNodes, targetNodes []string
+	for _, node := range nodes {
+		if node.Name == vmi.Status.NodeName || !isNodeSchedulable(node, targetPod.Spec.Tolerations) {
+			continue
+		}
+		if matches, err := requiredNodeAffinity.Match(node); err != nil || !matches {
+			continue
+		}
+		schedulableNodes = append(schedulableNodes, node.Name)
+		if labels.SelectorFromSet(cpuSelector).Matches(labels.Set(node.Labels)) {
+			targetNodes = append(targetNodes, node.Name)
+		}
+	}
+	sort.Strings(targetNodes)
+
+	if len(schedulableNodes) == 0 {
+		return nil, []v1.MigrationBlocker{{
+			Type:    v1.MigrationBlockerScheduling,
+			Message: "no node other than the source node matches the scheduling constraints of the VMI",
+		}}
+	}
+	if len(targetNodes) == 0 {
+		return nil, []v1.MigrationBlocker{{
+			Type:    v1.MigrationBlockerCPUModel,
+			Message: fmt.Sprintf("no schedulable node supports the CPU model and features required by the VMI: %v", cpuSelector),
+		}}
+	}
+	return targetNodes, nil
+}
+
+func isCPUNodeLabel(key string) bool {
+	return strings.HasPrefix(key, v1.CPUModelLabel) ||
+		strings.HasPrefix(key, v1.CPUFeatureLabel) ||
+		strings.HasPrefix(key, v1.SupportedHostModelMigrationCPU)
+}
+
+func addHostModelCPUSelector(vmi *v1.VirtualMachineInstance, sourceNode *k8sv1.Node, cpuSelector map[string]string) error {
+	if cpu := vmi.Spec.Domain.CPU; cpu == nil || cpu.Model != v1.CPUModeHostModel {
+		return nil
+	}
+	// A VMI which migrated before already requires the CPU model of its first node
+	for key := range cpuSelector {
+		if strings.HasPrefix(key, v1.SupportedHostModelMigrationCPU) {
+			return nil
+		}
+	}
+	if sourceNode == nil {
+		return fmt.Errorf("the source node %s of the VMI does not exist", vmi.Status.NodeName)
+	}
+
+	hostCPUModelFound := false
+	for key, value := range sourceNode.Labels {
+		if strings.HasPrefix(key, v1.HostModelCPULabel) {
+			cpuSelector[v1.SupportedHostModelMigrationCPU+strings.TrimPrefix(key, v1.HostModelCPULabel)] = value
+			hostCPUModelFound = true
+		}
+		if strings.HasPrefix(key, v1.HostModelRequiredFeaturesLabel) {
+			cpuSelector[v1.CPUFeatureLabel+strings.TrimPrefix(key, v1.HostModelRequiredFeaturesLabel)] = value
+		}
+	}
+	if !hostCPUModelFound {
+		return fmt.Errorf("the source node %s does not report its host CPU model", sourceNode.Name)
+	}
+	return nil
+}
+
+func isNodeSchedulable(node *k8sv1.Node, tolerations []k8sv1.Toleration) bool {
+	if node.Spec.Unschedulable {
+		return false
+	}
+	ready := false
+	for _, condition := range node.Status.Conditions {
+		if condition.Type == k8sv1.NodeReady {
+			ready = condition.Status == k8sv1.ConditionTrue
+		}
+	}
+	if !ready {
+		return false
+	}
+	_, untolerated := corev1helpers.FindMatchingUntoleratedTaint(node.Spec.Taints, tolerations, func(taint *k8sv1.Taint) bool {
+		return taint.Effect == k8sv1.TaintEffectNoSchedule || taint.Effect == k8sv1.TaintEffectNoExecute
+	})
+	return !untolerated
+}
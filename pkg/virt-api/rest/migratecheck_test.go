@@ -0,0 +1,360 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package rest
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+
+	"github.com/emicklei/go-restful/v3"
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	k8sv1 "k8s.io/api/core/v1"
+	k8smetav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/cache"
+
+	v1 "kubevirt.io/api/core/v1"
+	"kubevirt.io/client-go/kubecli"
+
+	"kubevirt.io/kubevirt/pkg/testutils"
+)
+
+var _ = Describe("Migration check subresource", func() {
+	const (
+		vmiName    = "testvmi"
+		sourceNode = "node01"
+	)
+
+	var (
+		vmiClient     *kubecli.MockVirtualMachineInstanceInterface
+		migrateClient *kubecli.MockVirtualMachineInstanceMigrationInterface
+		virtClient    *kubecli.MockKubevirtClient
+		app           *SubresourceAPIApp
+		nodeInformer  cache.SharedIndexInformer
+		podInformer   cache.SharedIndexInformer
+
+		request  *restful.Request
+		recorder *httptest.ResponseRecorder
+		response *restful.Response
+
+		vmi           *v1.VirtualMachineInstance
+		migrationList *v1.VirtualMachineInstanceMigrationList
+	)
+
+	newNode := func(name string, nodeLabels map[string]string) *k8sv1.Node {
+		node := &k8sv1.Node{
+			ObjectMeta: k8smetav1.ObjectMeta{
+				Name:   name,
+				Labels: map[string]string{v1.NodeSchedulable: "true"},
+			},
+			Status: k8sv1.NodeStatus{
+				Conditions: []k8sv1.NodeCondition{{Type: k8sv1.NodeReady, Status: k8sv1.ConditionTrue}},
+			},
+		}
+		for key, value := range nodeLabels {
+			node.Labels[key] = value
+		}
+		return node
+	}
+
+	newSourcePod := func(nodeSelector map[string]string) *k8sv1.Pod {
+		return &k8sv1.Pod{
+			ObjectMeta: k8smetav1.ObjectMeta{
+				Name:      "virt-launcher-testvmi",
+				Namespace: k8smetav1.NamespaceDefault,
+				Labels: map[string]string{
+					v1.AppLabel:       "virt-launcher",
+					v1.CreatedByLabel: string(vmi.UID),
+				},
+				OwnerReferences: []k8smetav1.OwnerReference{*k8smetav1.NewControllerRef(vmi, v1.VirtualMachineInstanceGroupVersionKind)},
+			},
+			Spec: k8sv1.PodSpec{
+				NodeName:     sourceNode,
+				NodeSelector: nodeSelector,
+			},
+		}
+	}
+
+	runCheck := func(opts *v1.MigrateOptions) *v1.VirtualMachineInstanceMigrationCheckResult {
+		body, err := json.Marshal(opts)
+		Expect(err).ToNot(HaveOccurred())
+		request.Request.Body = io.NopCloser(bytes.NewReader(body))
+		response.SetRequestAccepts(restful.MIME_JSON)
+
+		app.MigrationCheckRequestHandler(request, response)
+
+		Expect(recorder.Code).To(Equal(http.StatusOK))
+		result := &v1.VirtualMachineInstanceMigrationCheckResult{}
+		Expect(json.Unmarshal(recorder.Body.Bytes(), result)).To(Succeed())
+		return result
+	}
+
+	setup := func(objects ...interface{}) {
+		for _, obj := range objects {
+			switch o := obj.(type) {
+			case *k8sv1.Node:
+				Expect(nodeInformer.GetStore().Add(o)).To(Succeed())
+			case *k8sv1.Pod:
+				Expect(podInformer.GetStore().Add(o)).To(Succeed())
+			}
+		}
+	}
+
+	simulate := func(sourcePod *k8sv1.Pod, nodes ...*k8sv1.Node) ([]string, []v1.MigrationBlocker) {
+		return simulateTargetPodScheduling(vmi, renderTargetPod(vmi, sourcePod, &v1.VirtualMachineInstanceMigrationSpec{}), nodes)
+	}
+
+	BeforeEach(func() {
+		ctrl := gomock.NewController(GinkgoT())
+		vmiClient = kubecli.NewMockVirtualMachineInstanceInterface(ctrl)
+		migrateClient = kubecli.NewMockVirtualMachineInstanceMigrationInterface(ctrl)
+		virtClient = kubecli.NewMockKubevirtClient(ctrl)
+		virtClient.EXPECT().VirtualMachineInstance(k8smetav1.NamespaceDefault).Return(vmiClient).AnyTimes()
+		virtClient.EXPECT().VirtualMachineInstanceMigration(k8smetav1.NamespaceDefault).Return(migrateClient).AnyTimes()
+		nodeInformer, _ = testutils.NewFakeInformerFor(&k8sv1.Node{})
+		podInformer, _ = testutils.NewFakeInformerWithIndexersFor(&k8sv1.Pod{}, cache.Indexers{cache.NamespaceIndex: cache.MetaNamespaceIndexFunc})
+		app = NewSubresourceAPIApp(virtClient, 0, nil, nil, nodeInformer, podInformer)
+
+		request = restful.NewRequest(&http.Request{})
+		request.PathParameters()["name"] = vmiName
+		request.PathParameters()["namespace"] = k8smetav1.NamespaceDefault
+		recorder = httptest.NewRecorder()
+		response = restful.NewResponse(recorder)
+
+		vmi = newVirtualMachineInstanceInPhase(v1.Running)
+		vmi.Name = vmiName
+		vmi.Namespace = k8smetav1.NamespaceDefault
+		vmi.Status.NodeName = sourceNode
+		vmiClient.EXPECT().Get(context.Background(), vmiName, k8smetav1.GetOptions{}).Return(vmi, nil).AnyTimes()
+		migrationList = &v1.VirtualMachineInstanceMigrationList{}
+		migrateClient.EXPECT().List(context.Background(), gomock.Any()).DoAndReturn(func(_ context.Context, _ k8smetav1.ListOptions) (*v1.VirtualMachineInstanceMigrationList, error) {
+			return migrationList, nil
+		}).AnyTimes()
+	})
+
+	It("should fail if the VMI is not running", func() {
+		vmi.Status.Phase = v1.Scheduling
+
+		app.MigrationCheckRequestHandler(request, response)
+
+		ExpectStatusErrorWithCode(recorder, http.StatusConflict)
+	})
+
+	It("should report the nodes the VMI can be migrated to", func() {
+		setup(
+			newSourcePod(map[string]string{v1.NodeSchedulable: "true"}),
+			newNode(sourceNode, nil),
+			newNode("node02", nil),
+			newNode("node03", map[string]string{"zone": "east"}),
+		)
+
+		result := runCheck(&v1.MigrateOptions{})
+		Expect(result.Migratable).To(BeTrue())
+		Expect(result.Blockers).To(BeEmpty())
+		Expect(result.TargetNodes).To(Equal([]string{"node02", "node03"}))
+	})
+
+	It("should restrict the target nodes by the added node selector", func() {
+		setup(
+			newSourcePod(map[string]string{v1.NodeSchedulable: "true"}),
+			newNode(sourceNode, nil),
+			newNode("node02", nil),
+			newNode("node03", map[string]string{"zone": "east"}),
+		)
+
+		result := runCheck(&v1.MigrateOptions{AddedNodeSelector: map[string]string{"zone": "east"}})
+		Expect(result.Migratable).To(BeTrue())
+		Expect(result.TargetNodes).To(Equal([]string{"node03"}))
+	})
+
+	It("should restrict the target nodes by the added node affinity", func() {
+		setup(
+			newSourcePod(map[string]string{v1.NodeSchedulable: "true"}),
+			newNode(sourceNode, nil),
+			newNode("node02", nil),
+			newNode("node03", map[string]string{"zone": "east"}),
+		)
+
+		result := runCheck(&v1.MigrateOptions{AddedNodeAffinity: &k8sv1.NodeAffinity{
+			RequiredDuringSchedulingIgnoredDuringExecution: &k8sv1.NodeSelector{
+				NodeSelectorTerms: []k8sv1.NodeSelectorTerm{{
+					MatchExpressions: []k8sv1.NodeSelectorRequirement{{Key: "zone", Operator: k8sv1.NodeSelectorOpNotIn, Values: []string{"east"}}},
+				}},
+			},
+		}})
+		Expect(result.Migratable).To(BeTrue())
+		Expect(result.TargetNodes).To(Equal([]string{"node02"}))
+	})
+
+	It("should report the LiveMigratable condition and in-flight migrations", func() {
+		vmi.Status.Conditions = []v1.VirtualMachineInstanceCondition{{
+			Type:    v1.VirtualMachineInstanceIsMigratable,
+			Status:  k8sv1.ConditionFalse,
+			Reason:  v1.VirtualMachineInstanceReasonHostDeviceNotMigratable,
+			Message: "VMI uses a PCI host devices",
+		}}
+		migrationList.Items = []v1.VirtualMachineInstanceMigration{{
+			ObjectMeta: k8smetav1.ObjectMeta{Name: "migration01"},
+			Status:     v1.VirtualMachineInstanceMigrationStatus{Phase: v1.MigrationRunning},
+		}}
+		setup(newNode(sourceNode, nil), newNode("node02", nil))
+
+		result := runCheck(&v1.MigrateOptions{})
+		Expect(result.Migratable).To(BeFalse())
+		Expect(result.Blockers).To(ConsistOf(
+			v1.MigrationBlocker{
+				Type:    v1.MigrationBlockerLiveMigratable,
+				Reason:  v1.VirtualMachineInstanceReasonHostDeviceNotMigratable,
+				Message: "VMI uses a PCI host devices",
+			},
+			v1.MigrationBlocker{
+				Type:    v1.MigrationBlockerMigrationInProgress,
+				Message: "migration migration01 of the VMI is already in progress",
+			},
+		))
+	})
+
+	Context("target pod scheduling", func() {
+		DescribeTable("should report a scheduling blocker if no other node fits", func(node *k8sv1.Node) {
+			sourcePod := newSourcePod(map[string]string{v1.NodeSchedulable: "true"})
+			_, blockers := simulate(sourcePod, newNode(sourceNode, nil), node)
+			Expect(blockers).To(HaveLen(1))
+			Expect(blockers[0].Type).To(Equal(v1.MigrationBlockerScheduling))
+		},
+			Entry("when the node is cordoned", func() *k8sv1.Node {
+				node := newNode("node02", nil)
+				node.Spec.Unschedulable = true
+				return node
+			}()),
+			Entry("when the node is not ready", func() *k8sv1.Node {
+				node := newNode("node02", nil)
+				node.Status.Conditions[0].Status = k8sv1.ConditionFalse
+				return node
+			}()),
+			Entry("when the node is tainted", func() *k8sv1.Node {
+				node := newNode("node02", nil)
+				node.Spec.Taints = []k8sv1.Taint{{Key: "dedicated", Value: "db", Effect: k8sv1.TaintEffectNoSchedule}}
+				return node
+			}()),
+			Entry("when the node is not schedulable for VMIs", func() *k8sv1.Node {
+				node := newNode("node02", nil)
+				node.Labels[v1.NodeSchedulable] = "false"
+				return node
+			}()),
+		)
+
+		It("should take the tolerations of the VMI into account", func() {
+			node := newNode("node02", nil)
+			node.Spec.Taints = []k8sv1.Taint{{Key: "dedicated", Value: "db", Effect: k8sv1.TaintEffectNoSchedule}}
+			vmi.Spec.Tolerations = []k8sv1.Toleration{{Key: "dedicated", Operator: k8sv1.TolerationOpEqual, Value: "db", Effect: k8sv1.TaintEffectNoSchedule}}
+
+			targetNodes, blockers := simulate(nil, newNode(sourceNode, nil), node)
+			Expect(blockers).To(BeEmpty())
+			Expect(targetNodes).To(Equal([]string{"node02"}))
+		})
+
+		It("should take the tolerations of the source pod into account", func() {
+			node := newNode("node02", nil)
+			node.Spec.Taints = []k8sv1.Taint{{Key: "dedicated", Value: "db", Effect: k8sv1.TaintEffectNoExecute}}
+			sourcePod := newSourcePod(map[string]string{v1.NodeSchedulable: "true"})
+			sourcePod.Spec.Tolerations = []k8sv1.Toleration{{Key: "dedicated", Operator: k8sv1.TolerationOpExists}}
+
+			targetNodes, blockers := simulate(sourcePod, newNode(sourceNode, nil), node)
+			Expect(blockers).To(BeEmpty())
+			Expect(targetNodes).To(Equal([]string{"node02"}))
+		})
+
+		It("should ignore taints preferring not to schedule", func() {
+			node := newNode("node02", nil)
+			node.Spec.Taints = []k8sv1.Taint{{Key: "dedicated", Value: "db", Effect: k8sv1.TaintEffectPreferNoSchedule}}
+
+			targetNodes, blockers := simulate(newSourcePod(map[string]string{v1.NodeSchedulable: "true"}), newNode(sourceNode, nil), node)
+			Expect(blockers).To(BeEmpty())
+			Expect(targetNodes).To(Equal([]string{"node02"}))
+		})
+
+		It("should take the required node affinity into account", func() {
+			vmi.Spec.Affinity = &k8sv1.Affinity{NodeAffinity: &k8sv1.NodeAffinity{
+				RequiredDuringSchedulingIgnoredDuringExecution: &k8sv1.NodeSelector{
+					NodeSelectorTerms: []k8sv1.NodeSelectorTerm{{
+						MatchExpressions: []k8sv1.NodeSelectorRequirement{{Key: "zone", Operator: k8sv1.NodeSelectorOpIn, Values: []string{"east"}}},
+					}, {
+						MatchFields: []k8sv1.NodeSelectorRequirement{{Key: "metadata.name", Operator: k8sv1.NodeSelectorOpIn, Values: []string{"node04"}}},
+					}},
+				},
+			}}
+			targetNodes, blockers := simulate(nil,
+				newNode(sourceNode, nil),
+				newNode("node02", map[string]string{"zone": "west"}),
+				newNode("node03", map[string]string{"zone": "east"}),
+				newNode("node04", nil),
+			)
+			Expect(blockers).To(BeEmpty())
+			Expect(targetNodes).To(Equal([]string{"node03", "node04"}))
+		})
+
+		It("should report a CPU model blocker if no node supports the CPU model", func() {
+			cpuModelLabel := v1.CPUModelLabel + "Skylake-Server"
+			_, blockers := simulate(newSourcePod(map[string]string{cpuModelLabel: "true"}),
+				newNode(sourceNode, map[string]string{cpuModelLabel: "true"}),
+				newNode("node02", nil),
+			)
+			Expect(blockers).To(HaveLen(1))
+			Expect(blockers[0].Type).To(Equal(v1.MigrationBlockerCPUModel))
+			Expect(blockers[0].Message).To(ContainSubstring(cpuModelLabel))
+		})
+
+		Context("with host-model CPU", func() {
+			BeforeEach(func() {
+				vmi.Spec.Domain.CPU = &v1.CPU{Model: v1.CPUModeHostModel}
+			})
+
+			It("should require the CPU model and features of the source node", func() {
+				targetNodes, blockers := simulate(nil,
+					newNode(sourceNode, map[string]string{
+						v1.HostModelCPULabel + "Skylake-Server":   "true",
+						v1.HostModelRequiredFeaturesLabel + "avx": "true",
+					}),
+					newNode("node02", map[string]string{
+						v1.SupportedHostModelMigrationCPU + "Skylake-Server": "true",
+					}),
+					newNode("node03", map[string]string{
+						v1.SupportedHostModelMigrationCPU + "Skylake-Server": "true",
+						v1.CPUFeatureLabel + "avx":                           "true",
+					}),
+				)
+				Expect(blockers).To(BeEmpty())
+				Expect(targetNodes).To(Equal([]string{"node03"}))
+			})
+
+			It("should report a blocker if the source node does not report its CPU model", func() {
+				_, blockers := simulate(nil, newNode(sourceNode, nil), newNode("node02", nil))
+				Expect(blockers).To(HaveLen(1))
+				Expect(blockers[0].Type).To(Equal(v1.MigrationBlockerCPUModel))
+				Expect(blockers[0].Message).To(ContainSubstring("does not report its host CPU model"))
+			})
+		})
+	})
+})
@@ -41,6 +41,7 @@ import (
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/apimachinery/pkg/util/json"
 	"k8s.io/apimachinery/pkg/util/yaml"
+	"k8s.io/client-go/tools/cache"
 
 	v1 "kubevirt.io/api/core/v1"
 	"kubevirt.io/client-go/kubecli"
@@ -88,9 +89,11 @@ type SubresourceAPIApp struct {
 	clusterConfig           *virtconfig.ClusterConfig
 	instancetypeMethods     instancetype.Methods
 	handlerHttpClient       *http.Client
+	nodeInformer            cache.SharedIndexInformer
+	kubeVirtPodInformer     cache.SharedIndexInformer
 }
 
-func NewSubresourceAPIApp(virtCli kubecli.KubevirtClient, consoleServerPort int, tlsConfiguration *tls.Config, clusterConfig *virtconfig.ClusterConfig) *SubresourceAPIApp {
+func NewSubresourceAPIApp(virtCli kubecli.KubevirtClient, consoleServerPort int, tlsConfiguration *tls.Config, clusterConfig *virtconfig.ClusterConfig, nodeInformer, kubeVirtPodInformer cache.SharedIndexInformer) *SubresourceAPIApp {
 	// When this method is called from tools/openapispec.go when running 'make generate',
 	// the virtCli is nil, and accessing GeneratedKubeVirtClient() would cause nil dereference.
 	var instancetypeMethods instancetype.Methods
@@ -114,6 +117,8 @@ func NewSubresourceAPIApp(virtCli kubecli.KubevirtClient, consoleServerPort int,
 		clusterConfig:           clusterConfig,
 		instancetypeMethods:     instancetypeMethods,
 		handlerHttpClient:       httpClient,
+		nodeInformer:            nodeInformer,
+		kubeVirtPodInformer:     kubeVirtPodInformer,
 	}
 }
 
@@ -302,7 +307,9 @@ func (app *SubresourceAPIApp) MigrateVMRequestHandler(request *restful.Request,
 				GenerateName: "kubevirt-migrate-vm-",
 			},
 			Spec: v1.VirtualMachineInstanceMigrationSpec{
-				VMIName: name,
+				VMIName:           name,
+				AddedNodeSelector: bodyStruct.AddedNodeSelector,
+				AddedNodeAffinity: bodyStruct.AddedNodeAffinity,
 			},
 		}, k8smetav1.CreateOptions{DryRun: bodyStruct.DryRun})
 		if err != nil {
@@ -1491,6 +1498,11 @@ func (app *SubresourceAPIApp) MemoryDumpVMRequestHandler(request *restful.Reques
 		return
 	}
 
+	if memoryDumpReq.Format != "" && memoryDumpReq.Format != v1.MemoryDumpFormatRaw {
+		writeError(errors.NewBadRequest(fmt.Sprintf("Unsupported memory dump format %q", memoryDumpReq.Format)), response)
+		return
+	}
+
 	memoryDumpReq.Phase = v1.MemoryDumpAssociating
 	isRemoveRequest := false
 	if err := app.vmMemoryDumpRequestPatchStatus(name, namespace, memoryDumpReq, isRemoveRequest); err != nil {
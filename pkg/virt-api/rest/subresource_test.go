@@ -1397,6 +1397,14 @@ var _ = Describe("VirtualMachineInstance Subresources", func() {
 			Entry("VM with a memory dump request pvc size too small should fail", &v1.VirtualMachineMemoryDumpRequest{
 				ClaimName: testPVCName,
 			}, http.StatusConflict, true, true, createTestPVC("1Gi", fs, notReadOnly)),
+			Entry("VM with a raw memory dump request should succeed", &v1.VirtualMachineMemoryDumpRequest{
+				ClaimName: testPVCName,
+				Format:    v1.MemoryDumpFormatRaw,
+			}, http.StatusAccepted, true, true, createTestPVC("2Gi", fs, notReadOnly)),
+			Entry("VM with a savestate memory dump request should fail", &v1.VirtualMachineMemoryDumpRequest{
+				ClaimName: testPVCName,
+				Format:    v1.MemoryDumpFormatSaveState,
+			}, http.StatusBadRequest, true, true, createTestPVC("2Gi", fs, notReadOnly)),
 		)
 
 		DescribeTable("With memory dump request", func(memDumpReq, prevMemDumpReq *v1.VirtualMachineMemoryDumpRequest, statusCode int) {
@@ -1615,6 +1623,167 @@ var _ = Describe("VirtualMachineInstance Subresources", func() {
 		)
 	})
 
+	Context("Backup Subresource api", func() {
+		const testPVCName = "testPVC"
+
+		vmiCreated := k8smetav1.NewTime(time.Now().Add(-time.Hour))
+		beforeVMICreated := pointer.P(k8smetav1.NewTime(vmiCreated.Add(-time.Hour)))
+		afterVMICreated := pointer.P(k8smetav1.NewTime(vmiCreated.Add(time.Minute)))
+
+		newBackupBody := func(req *v1.VirtualMachineBackupRequest) io.ReadCloser {
+			reqJson, _ := json.Marshal(req)
+			return &readCloserWrapper{bytes.NewReader(reqJson)}
+		}
+
+		createTestPVC := func(blockMode bool) *k8sv1.PersistentVolumeClaim {
+			pvc := &k8sv1.PersistentVolumeClaim{
+				ObjectMeta: k8smetav1.ObjectMeta{
+					Name:      testPVCName,
+					Namespace: k8smetav1.NamespaceDefault,
+				},
+			}
+			if blockMode {
+				volumeMode := k8sv1.PersistentVolumeBlock
+				pvc.Spec.VolumeMode = &volumeMode
+			}
+			return pvc
+		}
+
+		BeforeEach(func() {
+			request.PathParameters()["name"] = testVMName
+			request.PathParameters()["namespace"] = k8smetav1.NamespaceDefault
+		})
+
+		DescribeTable("With backup request", func(backupReq, prevBackupReq *v1.VirtualMachineBackupRequest, statusCode int, enableGate bool, pvc *k8sv1.PersistentVolumeClaim, migrationEnd *k8smetav1.Time) {
+			if enableGate {
+				enableFeatureGate(featuregate.IncrementalBackupGate)
+			}
+			request.Request.Body = newBackupBody(backupReq)
+
+			vm := newMinimalVM(request.PathParameter("name"))
+			vm.Namespace = k8smetav1.NamespaceDefault
+			vm.Status.BackupRequest = prevBackupReq
+
+			vmClient.EXPECT().Get(context.Background(), vm.Name, k8smetav1.GetOptions{}).Return(vm, nil).AnyTimes()
+			vmi := api.NewMinimalVMI(testVMIName)
+			vmi.CreationTimestamp = vmiCreated
+			vmi.Status.Phase = v1.Running
+			if migrationEnd != nil {
+				vmi.Status.MigrationState = &v1.VirtualMachineInstanceMigrationState{EndTimestamp: migrationEnd}
+			}
+			vmiClient.EXPECT().Get(context.Background(), vm.Name, k8smetav1.GetOptions{}).Return(vmi, nil).AnyTimes()
+			kubeClient.Fake.PrependReactor("get", "persistentvolumeclaims", func(action testing.Action) (bool, runtime.Object, error) {
+				if pvc == nil {
+					return true, nil, errors.NewNotFound(v1.Resource("persistentvolumeclaim"), testPVCName)
+				}
+				return true, pvc, nil
+			})
+			if statusCode == http.StatusAccepted {
+				vmClient.EXPECT().PatchStatus(context.Background(), vm.Name, types.JSONPatchType, gomock.Any(), gomock.Any()).DoAndReturn(
+					func(ctx context.Context, name string, patchType types.PatchType, body []byte, opts k8smetav1.PatchOptions) (*v1.VirtualMachine, error) {
+						patchedReq := &v1.VirtualMachineBackupRequest{}
+						ops := []patch.PatchOperation{}
+						Expect(json.Unmarshal(body, &ops)).To(Succeed())
+						Expect(ops).ToNot(BeEmpty())
+						reqJson, err := json.Marshal(ops[len(ops)-1].Value)
+						Expect(err).ToNot(HaveOccurred())
+						Expect(json.Unmarshal(reqJson, patchedReq)).To(Succeed())
+						Expect(patchedReq.Phase).To(Equal(v1.BackupAssociating))
+						Expect(patchedReq.ClaimName).To(Equal(testPVCName))
+						Expect(patchedReq.BackupName).ToNot(BeEmpty())
+						Expect(patchedReq.Format).ToNot(BeEmpty())
+						return vm, nil
+					})
+			}
+
+			app.BackupVMRequestHandler(request, response)
+
+			Expect(response.StatusCode()).To(Equal(statusCode))
+		},
+			Entry("VM with a valid backup request should succeed",
+				&v1.VirtualMachineBackupRequest{ClaimName: testPVCName}, nil, http.StatusAccepted, true, createTestPVC(false), nil),
+			Entry("VM with a valid backup request but no feature gate should fail",
+				&v1.VirtualMachineBackupRequest{ClaimName: testPVCName}, nil, http.StatusBadRequest, false, createTestPVC(false), nil),
+			Entry("VM with a backup request with a non existing PVC should fail",
+				&v1.VirtualMachineBackupRequest{ClaimName: testPVCName}, nil, http.StatusNotFound, true, nil, nil),
+			Entry("VM with a backup request pvc block mode should fail",
+				&v1.VirtualMachineBackupRequest{ClaimName: testPVCName}, nil, http.StatusConflict, true, createTestPVC(true), nil),
+			Entry("VM with a backup request missing claim name without previous backup should fail",
+				&v1.VirtualMachineBackupRequest{}, nil, http.StatusBadRequest, true, createTestPVC(false), nil),
+			Entry("VM with a backup request without claim name with associated backup should succeed",
+				&v1.VirtualMachineBackupRequest{IncrementalFrom: "backup-1"},
+				&v1.VirtualMachineBackupRequest{ClaimName: testPVCName, BackupName: "backup-1", Phase: v1.BackupCompleted, StartTimestamp: afterVMICreated},
+				http.StatusAccepted, true, createTestPVC(false), nil),
+			Entry("VM with a backup request with claim name different then associated backup should fail",
+				&v1.VirtualMachineBackupRequest{ClaimName: "diffPVCName"},
+				&v1.VirtualMachineBackupRequest{ClaimName: testPVCName, BackupName: "backup-1", Phase: v1.BackupCompleted},
+				http.StatusConflict, true, createTestPVC(false), nil),
+			Entry("VM with a backup request reusing the previous backup name should fail",
+				&v1.VirtualMachineBackupRequest{BackupName: "backup-1"},
+				&v1.VirtualMachineBackupRequest{ClaimName: testPVCName, BackupName: "backup-1", Phase: v1.BackupCompleted},
+				http.StatusConflict, true, createTestPVC(false), nil),
+			Entry("VM with a backup request while a backup is in progress should fail",
+				&v1.VirtualMachineBackupRequest{BackupName: "backup-2"},
+				&v1.VirtualMachineBackupRequest{ClaimName: testPVCName, BackupName: "backup-1", Phase: v1.BackupInProgress},
+				http.StatusConflict, true, createTestPVC(false), nil),
+			Entry("VM with an incremental backup request from an unknown checkpoint should fail",
+				&v1.VirtualMachineBackupRequest{BackupName: "backup-3", IncrementalFrom: "backup-2"},
+				&v1.VirtualMachineBackupRequest{ClaimName: testPVCName, BackupName: "backup-1", Phase: v1.BackupCompleted, StartTimestamp: afterVMICreated},
+				http.StatusConflict, true, createTestPVC(false), nil),
+			Entry("VM with an incremental backup request from a failed backup should fail",
+				&v1.VirtualMachineBackupRequest{BackupName: "backup-2", IncrementalFrom: "backup-1"},
+				&v1.VirtualMachineBackupRequest{ClaimName: testPVCName, BackupName: "backup-1", Phase: v1.BackupFailed, StartTimestamp: afterVMICreated},
+				http.StatusConflict, true, createTestPVC(false), nil),
+			Entry("VM with an incremental backup request from a checkpoint of a previous VMI should fail",
+				&v1.VirtualMachineBackupRequest{BackupName: "backup-2", IncrementalFrom: "backup-1"},
+				&v1.VirtualMachineBackupRequest{ClaimName: testPVCName, BackupName: "backup-1", Phase: v1.BackupCompleted, StartTimestamp: beforeVMICreated},
+				http.StatusConflict, true, createTestPVC(false), nil),
+			Entry("VM with an incremental backup request from a checkpoint taken before the VMI migrated should fail",
+				&v1.VirtualMachineBackupRequest{BackupName: "backup-2", IncrementalFrom: "backup-1"},
+				&v1.VirtualMachineBackupRequest{ClaimName: testPVCName, BackupName: "backup-1", Phase: v1.BackupCompleted, StartTimestamp: afterVMICreated},
+				http.StatusConflict, true, createTestPVC(false), pointer.P(k8smetav1.NewTime(afterVMICreated.Add(time.Minute)))),
+		)
+
+		DescribeTable("Should validate backup options", func(backupReq *v1.VirtualMachineBackupRequest, expectError bool) {
+			statErr := validateBackupOptions(backupReq)
+			if expectError {
+				Expect(statErr).To(HaveOccurred())
+				Expect(statErr.ErrStatus.Code).To(Equal(int32(http.StatusBadRequest)))
+			} else {
+				Expect(statErr).To(BeNil())
+			}
+		},
+			Entry("full qcow2 backup", &v1.VirtualMachineBackupRequest{BackupName: "backup-1", Format: v1.BackupFormatQCOW2}, false),
+			Entry("full raw backup", &v1.VirtualMachineBackupRequest{BackupName: "backup-1", Format: v1.BackupFormatRaw}, false),
+			Entry("incremental qcow2 backup", &v1.VirtualMachineBackupRequest{BackupName: "backup-2", IncrementalFrom: "backup-1", Format: v1.BackupFormatQCOW2}, false),
+			Entry("incremental raw backup", &v1.VirtualMachineBackupRequest{BackupName: "backup-2", IncrementalFrom: "backup-1", Format: v1.BackupFormatRaw}, true),
+			Entry("backup incremental from itself", &v1.VirtualMachineBackupRequest{BackupName: "backup-1", IncrementalFrom: "backup-1", Format: v1.BackupFormatQCOW2}, true),
+			Entry("invalid backup name", &v1.VirtualMachineBackupRequest{BackupName: "Backup_1", Format: v1.BackupFormatQCOW2}, true),
+			Entry("unsupported format", &v1.VirtualMachineBackupRequest{BackupName: "backup-1", Format: "vmdk"}, true),
+		)
+
+		DescribeTable("Should handle remove backup request", func(existingBackupReq *v1.VirtualMachineBackupRequest, statusCode int) {
+			vm := newMinimalVM(request.PathParameter("name"))
+			vm.Namespace = k8smetav1.NamespaceDefault
+			vm.Status.BackupRequest = existingBackupReq
+
+			vmClient.EXPECT().Get(context.Background(), vm.Name, k8smetav1.GetOptions{}).Return(vm, nil).AnyTimes()
+			if statusCode == http.StatusAccepted {
+				vmClient.EXPECT().PatchStatus(context.Background(), vm.Name, types.JSONPatchType, gomock.Any(), gomock.Any()).Return(vm, nil)
+			}
+
+			app.RemoveBackupVMRequestHandler(request, response)
+
+			Expect(response.StatusCode()).To(Equal(statusCode))
+		},
+			Entry("with completed backup should succeed",
+				&v1.VirtualMachineBackupRequest{ClaimName: testPVCName, Phase: v1.BackupCompleted}, http.StatusAccepted),
+			Entry("without backup should fail", nil, http.StatusConflict),
+			Entry("with remove request already in progress should fail",
+				&v1.VirtualMachineBackupRequest{ClaimName: testPVCName, Phase: v1.BackupDissociating, Remove: true}, http.StatusConflict),
+		)
+	})
+
 	Context("Subresource api - error handling for StartVMRequestHandler", func() {
 		BeforeEach(func() {
 			request.PathParameters()["name"] = testVMName
@@ -1918,6 +2087,7 @@ var _ = Describe("VirtualMachineInstance Subresources", func() {
 			migrateClient.EXPECT().Create(context.Background(), gomock.Any(), gomock.Any()).Do(
 				func(ctx context.Context, obj interface{}, opts k8smetav1.CreateOptions) {
 					Expect(opts.DryRun).To(BeEquivalentTo(migrateOptions.DryRun))
+					Expect(obj.(*v1.VirtualMachineInstanceMigration).Spec.AddedNodeSelector).To(Equal(migrateOptions.AddedNodeSelector))
 				}).Return(&migration, nil)
 			app.MigrateVMRequestHandler(request, response)
 
@@ -1926,6 +2096,7 @@ var _ = Describe("VirtualMachineInstance Subresources", func() {
 		},
 			Entry("with default", &v1.MigrateOptions{}),
 			Entry("with dry-run option", &v1.MigrateOptions{DryRun: getDryRunOption()}),
+			Entry("with added node selector", &v1.MigrateOptions{AddedNodeSelector: map[string]string{k8sv1.LabelHostname: "node02"}}),
 		)
 	})
 
@@ -2262,6 +2433,66 @@ var _ = Describe("VirtualMachineInstance Subresources", func() {
 		})
 	})
 
+	Context("Guest exec", func() {
+		setGuestExecBody := func(opts *v1.VirtualMachineInstanceGuestExecOptions) {
+			bytesRepresentation, _ := json.Marshal(opts)
+			request.Request.Body = io.NopCloser(bytes.NewReader(bytesRepresentation))
+		}
+
+		It("Should execute a command in the guest and return its output", func() {
+			result := v1.VirtualMachineInstanceGuestExecResult{ExitCode: 1, Stdout: "out", Stderr: "err"}
+			backend.AppendHandlers(
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest("POST", "/v1/namespaces/default/virtualmachineinstances/testvmi/guestexec"),
+					ghttp.VerifyJSONRepresenting(v1.VirtualMachineInstanceGuestExecOptions{
+						Command:        "/usr/bin/cat",
+						Args:           []string{"/proc/uptime"},
+						TimeoutSeconds: pointer.P(int32(30)),
+					}),
+					ghttp.RespondWithJSONEncoded(http.StatusOK, result),
+				),
+			)
+			expectVMI(Running, UnPaused, guestAgentConnected)
+			setGuestExecBody(&v1.VirtualMachineInstanceGuestExecOptions{Command: "/usr/bin/cat", Args: []string{"/proc/uptime"}})
+			response.SetRequestAccepts(restful.MIME_JSON)
+
+			app.GuestExecRequestHandler(request, response)
+
+			Expect(response.StatusCode()).To(Equal(http.StatusOK))
+			Expect(recorder.Body.String()).To(MatchJSON(`{"exitCode":1,"stdout":"out","stderr":"err"}`))
+		})
+
+		It("Should fail if the guest agent is not connected", func() {
+			expectVMI(Running, UnPaused)
+			setGuestExecBody(&v1.VirtualMachineInstanceGuestExecOptions{Command: "/usr/bin/true"})
+
+			app.GuestExecRequestHandler(request, response)
+
+			ExpectStatusErrorWithCode(recorder, http.StatusConflict)
+		})
+
+		It("Should fail if the VMI is not running", func() {
+			expectVMI(NotRunning, UnPaused, guestAgentConnected)
+			setGuestExecBody(&v1.VirtualMachineInstanceGuestExecOptions{Command: "/usr/bin/true"})
+
+			app.GuestExecRequestHandler(request, response)
+
+			ExpectStatusErrorWithCode(recorder, http.StatusConflict)
+		})
+
+		DescribeTable("Should reject invalid options", func(opts *v1.VirtualMachineInstanceGuestExecOptions) {
+			setGuestExecBody(opts)
+
+			app.GuestExecRequestHandler(request, response)
+
+			ExpectStatusErrorWithCode(recorder, http.StatusBadRequest)
+		},
+			Entry("without a command", &v1.VirtualMachineInstanceGuestExecOptions{}),
+			Entry("with a too short timeout", &v1.VirtualMachineInstanceGuestExecOptions{Command: "/usr/bin/true", TimeoutSeconds: pointer.P(int32(0))}),
+			Entry("with a too long timeout", &v1.VirtualMachineInstanceGuestExecOptions{Command: "/usr/bin/true", TimeoutSeconds: pointer.P(int32(301))}),
+		)
+	})
+
 	Context("Pausing", func() {
 		DescribeTable("Should pause a running, not paused VMI according to options", func(pauseOptions *v1.PauseOptions, matchExpectation gomegatypes.GomegaMatcher) {
 
@@ -23,28 +23,42 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"net"
+	"sort"
+	"strconv"
+	"strings"
 
 	admissionv1 "k8s.io/api/admission/v1"
+	authenticationv1 "k8s.io/api/authentication/v1"
+	authorizationv1 "k8s.io/api/authorization/v1"
 	k8sv1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/util/validation"
 	k8sfield "k8s.io/apimachinery/pkg/util/validation/field"
+	authorizationclient "k8s.io/client-go/kubernetes/typed/authorization/v1"
 
 	v1 "kubevirt.io/api/core/v1"
 	"kubevirt.io/client-go/kubevirt"
 
 	webhookutils "kubevirt.io/kubevirt/pkg/util/webhooks"
 	"kubevirt.io/kubevirt/pkg/virt-api/webhooks"
+	virtconfig "kubevirt.io/kubevirt/pkg/virt-config"
+	"kubevirt.io/kubevirt/pkg/virt-config/featuregate"
 )
 
 type MigrationCreateAdmitter struct {
-	virtClient kubevirt.Interface
+	virtClient    kubevirt.Interface
+	sarClient     authorizationclient.SubjectAccessReviewInterface
+	clusterConfig *virtconfig.ClusterConfig
 }
 
-func NewMigrationCreateAdmitter(virtClient kubevirt.Interface) *MigrationCreateAdmitter {
+func NewMigrationCreateAdmitter(virtClient kubevirt.Interface, sarClient authorizationclient.SubjectAccessReviewInterface, clusterConfig *virtconfig.ClusterConfig) *MigrationCreateAdmitter {
 	return &MigrationCreateAdmitter{
-		virtClient: virtClient,
+		virtClient:    virtClient,
+		sarClient:     sarClient,
+		clusterConfig: clusterConfig,
 	}
 }
 
@@ -92,10 +106,19 @@ func (admitter *MigrationCreateAdmitter) Admit(ctx context.Context, ar *admissio
 	}
 
 	causes := ValidateVirtualMachineInstanceMigrationSpec(k8sfield.NewPath("spec"), &migration.Spec)
+	causes = append(causes, admitter.validateCrossClusterMigration(k8sfield.NewPath("spec"), &migration.Spec)...)
 	if len(causes) > 0 {
 		return webhookutils.ToAdmissionResponse(causes)
 	}
 
+	if resp := admitter.admitPriority(ctx, ar.Request.UserInfo, migration); resp != nil {
+		return resp
+	}
+
+	if migration.Spec.Receive != nil {
+		return admitter.admitReceive(ctx, ar.Request.UserInfo, migration)
+	}
+
 	vmi, err := admitter.virtClient.KubevirtV1().VirtualMachineInstances(migration.Namespace).Get(ctx, migration.Spec.VMIName, metav1.GetOptions{})
 	if errors.IsNotFound(err) {
 		// ensure VMI exists for the migration
@@ -127,6 +150,68 @@ func (admitter *MigrationCreateAdmitter) Admit(ctx context.Context, ar *admissio
 	return &reviewResponse
 }
 
+// admitPriority only lets users who are allowed to prioritize migrations cluster wide queue a migration
+// ahead of the ones with the default priority, as the queue of pending migrations is shared by all namespaces.
+func (admitter *MigrationCreateAdmitter) admitPriority(ctx context.Context, userInfo authenticationv1.UserInfo, migration *v1.VirtualMachineInstanceMigration) *admissionv1.AdmissionResponse {
+	if migration.Spec.Priority == nil || *migration.Spec.Priority <= 0 {
+		return nil
+	}
+
+	allowed, err := webhookutils.IsUserAllowed(ctx, admitter.sarClient, userInfo, &authorizationv1.ResourceAttributes{
+		Verb:     "prioritize",
+		Group:    v1.GroupVersion.Group,
+		Resource: "virtualmachineinstancemigrations",
+	})
+	if err != nil {
+		return webhookutils.ToAdmissionResponseError(err)
+	}
+	if !allowed {
+		return webhookutils.ToAdmissionResponse([]metav1.StatusCause{{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: fmt.Sprintf("user %s is not allowed to prioritize migrations, the priority must not be above 0", userInfo.Username),
+			Field:   k8sfield.NewPath("spec", "priority").String(),
+		}})
+	}
+	return nil
+}
+
+// admitReceive admits a migration from another cluster. Its VM is created by
+// virt-controller, so the user has to be allowed to create it.
+func (admitter *MigrationCreateAdmitter) admitReceive(ctx context.Context, userInfo authenticationv1.UserInfo, migration *v1.VirtualMachineInstanceMigration) *admissionv1.AdmissionResponse {
+	_, err := admitter.virtClient.KubevirtV1().VirtualMachineInstances(migration.Namespace).Get(ctx, migration.Spec.VMIName, metav1.GetOptions{})
+	if err == nil {
+		return webhookutils.ToAdmissionResponseError(fmt.Errorf("the VMI \"%s/%s\" already exists", migration.Namespace, migration.Spec.VMIName))
+	} else if !errors.IsNotFound(err) {
+		return webhookutils.ToAdmissionResponseError(err)
+	}
+
+	_, err = admitter.virtClient.KubevirtV1().VirtualMachines(migration.Namespace).Get(ctx, migration.Spec.VMIName, metav1.GetOptions{})
+	if err == nil {
+		return webhookutils.ToAdmissionResponseError(fmt.Errorf("the VM \"%s/%s\" already exists", migration.Namespace, migration.Spec.VMIName))
+	} else if !errors.IsNotFound(err) {
+		return webhookutils.ToAdmissionResponseError(err)
+	}
+
+	allowed, err := webhookutils.IsUserAllowed(ctx, admitter.sarClient, userInfo, &authorizationv1.ResourceAttributes{
+		Namespace: migration.Namespace,
+		Verb:      "create",
+		Group:     v1.GroupVersion.Group,
+		Resource:  "virtualmachines",
+	})
+	if err != nil {
+		return webhookutils.ToAdmissionResponseError(err)
+	}
+	if !allowed {
+		return webhookutils.ToAdmissionResponseError(fmt.Errorf("user %s is not allowed to create VirtualMachines in namespace %s", userInfo.Username, migration.Namespace))
+	}
+
+	if err := ensureNoMigrationConflict(ctx, admitter.virtClient, migration.Spec.VMIName, migration.Namespace); err != nil {
+		return webhookutils.ToAdmissionResponseError(err)
+	}
+
+	return &admissionv1.AdmissionResponse{Allowed: true}
+}
+
 func getAdmissionReviewMigration(ar *admissionv1.AdmissionReview) (new *v1.VirtualMachineInstanceMigration, old *v1.VirtualMachineInstanceMigration, err error) {
 
 	if !webhookutils.ValidateRequestResource(ar.Request.Resource, webhooks.MigrationGroupVersionResource.Group, webhooks.MigrationGroupVersionResource.Resource) {
@@ -165,5 +250,134 @@ func ValidateVirtualMachineInstanceMigrationSpec(field *k8sfield.Path, spec *v1.
 		})
 	}
 
+	causes = append(causes, validateAddedNodeSelector(field.Child("addedNodeSelector"), spec.AddedNodeSelector)...)
+
+	if affinity := spec.AddedNodeAffinity; affinity != nil && affinity.RequiredDuringSchedulingIgnoredDuringExecution != nil &&
+		len(affinity.RequiredDuringSchedulingIgnoredDuringExecution.NodeSelectorTerms) == 0 {
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueRequired,
+			Message: "at least one node selector term is required",
+			Field:   field.Child("addedNodeAffinity", "requiredDuringSchedulingIgnoredDuringExecution", "nodeSelectorTerms").String(),
+		})
+	}
+
+	return causes
+}
+
+func validateAddedNodeSelector(field *k8sfield.Path, nodeSelector map[string]string) []metav1.StatusCause {
+	var causes []metav1.StatusCause
+
+	keys := make([]string, 0, len(nodeSelector))
+	for key := range nodeSelector {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	for _, key := range keys {
+		if errs := validation.IsQualifiedName(key); len(errs) != 0 {
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueInvalid,
+				Message: fmt.Sprintf("%s is not a valid label key: %s", key, strings.Join(errs, ", ")),
+				Field:   field.Key(key).String(),
+			})
+		}
+		if errs := validation.IsValidLabelValue(nodeSelector[key]); len(errs) != 0 {
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueInvalid,
+				Message: fmt.Sprintf("%s is not a valid label value: %s", nodeSelector[key], strings.Join(errs, ", ")),
+				Field:   field.Key(key).String(),
+			})
+		}
+	}
+
+	return causes
+}
+
+func (admitter *MigrationCreateAdmitter) validateCrossClusterMigration(field *k8sfield.Path, spec *v1.VirtualMachineInstanceMigrationSpec) []metav1.StatusCause {
+	var causes []metav1.StatusCause
+
+	if spec.SendTo == nil && spec.Receive == nil {
+		return causes
+	}
+	if !admitter.clusterConfig.CrossClusterLiveMigrationEnabled() {
+		return append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueNotSupported,
+			Message: fmt.Sprintf("migrating to or from another cluster is not allowed: %s feature gate is not enabled", featuregate.CrossClusterLiveMigrationGate),
+			Field:   field.String(),
+		})
+	}
+	if spec.SendTo != nil && spec.Receive != nil {
+		return append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: "sendTo and receive are mutually exclusive",
+			Field:   field.String(),
+		})
+	}
+	if len(spec.AddedNodeSelector) > 0 || spec.AddedNodeAffinity != nil {
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueNotSupported,
+			Message: "node constraints are not supported when migrating to or from another cluster",
+			Field:   field.String(),
+		})
+	}
+
+	if spec.Receive != nil {
+		causes = append(causes, validateCrossClusterMigrationID(field.Child("receive", "migrationID"), spec.Receive.MigrationID)...)
+		return append(causes, admitter.validateReceivedVirtualMachine(field.Child("receive", "virtualMachine"), spec.Receive.VirtualMachine)...)
+	}
+
+	causes = append(causes, validateCrossClusterMigrationID(field.Child("sendTo", "migrationID"), spec.SendTo.MigrationID)...)
+	if _, port, err := net.SplitHostPort(spec.SendTo.ConnectURL); err != nil {
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: fmt.Sprintf("connectURL %s is not a valid host:port address: %v", spec.SendTo.ConnectURL, err),
+			Field:   field.Child("sendTo", "connectURL").String(),
+		})
+	} else if portNum, err := strconv.Atoi(port); err != nil || len(validation.IsValidPortNum(portNum)) != 0 {
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: fmt.Sprintf("connectURL %s has an invalid port", spec.SendTo.ConnectURL),
+			Field:   field.Child("sendTo", "connectURL").String(),
+		})
+	}
+
 	return causes
 }
+
+func (admitter *MigrationCreateAdmitter) validateReceivedVirtualMachine(field *k8sfield.Path, spec *v1.VirtualMachineSpec) []metav1.StatusCause {
+	if spec == nil {
+		return []metav1.StatusCause{{
+			Type:    metav1.CauseTypeFieldValueRequired,
+			Message: "virtualMachine is missing",
+			Field:   field.String(),
+		}}
+	}
+	// The disks are migrated into existing volumes, and the DataVolumes would
+	// be created with the privileges of virt-controller
+	if len(spec.DataVolumeTemplates) > 0 {
+		return []metav1.StatusCause{{
+			Type:    metav1.CauseTypeFieldValueNotSupported,
+			Message: "dataVolumeTemplates are not supported when receiving a migration",
+			Field:   field.Child("dataVolumeTemplates").String(),
+		}}
+	}
+	return ValidateVirtualMachineSpec(field, spec, admitter.clusterConfig, false)
+}
+
+func validateCrossClusterMigrationID(field *k8sfield.Path, migrationID string) []metav1.StatusCause {
+	if migrationID == "" {
+		return []metav1.StatusCause{{
+			Type:    metav1.CauseTypeFieldValueRequired,
+			Message: "migrationID is missing",
+			Field:   field.String(),
+		}}
+	}
+	if errs := validation.IsDNS1123Subdomain(migrationID); len(errs) != 0 {
+		return []metav1.StatusCause{{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: fmt.Sprintf("migrationID %s is invalid: %s", migrationID, strings.Join(errs, ", ")),
+			Field:   field.String(),
+		}}
+	}
+	return nil
+}
@@ -26,16 +26,29 @@ import (
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 	admissionv1 "k8s.io/api/admission/v1"
+	authenticationv1 "k8s.io/api/authentication/v1"
+	authorizationv1 "k8s.io/api/authorization/v1"
 	k8sv1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
+	k8sfake "k8s.io/client-go/kubernetes/fake"
+	"k8s.io/client-go/testing"
 
 	v1 "kubevirt.io/api/core/v1"
+	"kubevirt.io/client-go/kubevirt"
 	kubevirtfake "kubevirt.io/client-go/kubevirt/fake"
 
 	"kubevirt.io/kubevirt/pkg/libvmi"
+	"kubevirt.io/kubevirt/pkg/pointer"
+	"kubevirt.io/kubevirt/pkg/testutils"
 	"kubevirt.io/kubevirt/pkg/virt-api/webhooks"
 	"kubevirt.io/kubevirt/pkg/virt-api/webhooks/validating-webhook/admitters"
+	"kubevirt.io/kubevirt/pkg/virt-config/featuregate"
+)
+
+const (
+	vmAuthorizedUser     = "vm-authorized-user"
+	migrationPrioritizer = "migration-prioritizer"
 )
 
 var _ = Describe("Validating MigrationCreate Admitter", func() {
@@ -60,7 +73,7 @@ var _ = Describe("Validating MigrationCreate Admitter", func() {
 			},
 		}
 		virtClient := kubevirtfake.NewSimpleClientset(vmi, inFlightMigration)
-		migrationCreateAdmitter := admitters.NewMigrationCreateAdmitter(virtClient)
+		migrationCreateAdmitter := newMigrationCreateAdmitter(virtClient)
 		ar, err := newAdmissionReviewForVMIMCreation(migration)
 		Expect(err).ToNot(HaveOccurred())
 
@@ -80,7 +93,7 @@ var _ = Describe("Validating MigrationCreate Admitter", func() {
 			}
 
 			virtClient := kubevirtfake.NewSimpleClientset()
-			migrationCreateAdmitter := admitters.NewMigrationCreateAdmitter(virtClient)
+			migrationCreateAdmitter := newMigrationCreateAdmitter(virtClient)
 			ar, err := newAdmissionReviewForVMIMCreation(migration)
 			Expect(err).ToNot(HaveOccurred())
 
@@ -90,6 +103,40 @@ var _ = Describe("Validating MigrationCreate Admitter", func() {
 			Expect(resp.Result.Details.Causes[0].Field).To(Equal("spec.vmiName"))
 		})
 
+		DescribeTable("should reject invalid node constraints on create", func(spec v1.VirtualMachineInstanceMigrationSpec, expectedField string) {
+			migration := &v1.VirtualMachineInstanceMigration{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: "default",
+				},
+				Spec: spec,
+			}
+
+			virtClient := kubevirtfake.NewSimpleClientset()
+			migrationCreateAdmitter := newMigrationCreateAdmitter(virtClient)
+			ar, err := newAdmissionReviewForVMIMCreation(migration)
+			Expect(err).ToNot(HaveOccurred())
+
+			resp := migrationCreateAdmitter.Admit(context.Background(), ar)
+			Expect(resp.Allowed).To(BeFalse())
+			Expect(resp.Result.Details.Causes).To(HaveLen(1))
+			Expect(resp.Result.Details.Causes[0].Field).To(Equal(expectedField))
+		},
+			Entry("with an invalid node selector key",
+				v1.VirtualMachineInstanceMigrationSpec{VMIName: "testvmi", AddedNodeSelector: map[string]string{"in valid": "value"}},
+				"spec.addedNodeSelector[in valid]",
+			),
+			Entry("with an invalid node selector value",
+				v1.VirtualMachineInstanceMigrationSpec{VMIName: "testvmi", AddedNodeSelector: map[string]string{k8sv1.LabelHostname: "in valid"}},
+				"spec.addedNodeSelector[kubernetes.io/hostname]",
+			),
+			Entry("with a required node affinity without terms",
+				v1.VirtualMachineInstanceMigrationSpec{VMIName: "testvmi", AddedNodeAffinity: &k8sv1.NodeAffinity{
+					RequiredDuringSchedulingIgnoredDuringExecution: &k8sv1.NodeSelector{NodeSelectorTerms: []k8sv1.NodeSelectorTerm{}},
+				}},
+				"spec.addedNodeAffinity.requiredDuringSchedulingIgnoredDuringExecution.nodeSelectorTerms",
+			),
+		)
+
 		It("should accept valid Migration spec on create", func() {
 			vmi := libvmi.New(libvmi.WithNamespace(k8sv1.NamespaceDefault))
 
@@ -102,7 +149,7 @@ var _ = Describe("Validating MigrationCreate Admitter", func() {
 				},
 			}
 			virtClient := kubevirtfake.NewSimpleClientset(vmi)
-			migrationCreateAdmitter := admitters.NewMigrationCreateAdmitter(virtClient)
+			migrationCreateAdmitter := newMigrationCreateAdmitter(virtClient)
 			ar, err := newAdmissionReviewForVMIMCreation(migration)
 			Expect(err).ToNot(HaveOccurred())
 
@@ -110,6 +157,34 @@ var _ = Describe("Validating MigrationCreate Admitter", func() {
 			Expect(resp.Allowed).To(BeTrue())
 		})
 
+		DescribeTable("should only let users allowed to prioritize migrations raise the priority", func(user string, priority int32, allowed bool) {
+			vmi := libvmi.New(libvmi.WithNamespace(k8sv1.NamespaceDefault))
+			migration := &v1.VirtualMachineInstanceMigration{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: vmi.Namespace,
+				},
+				Spec: v1.VirtualMachineInstanceMigrationSpec{
+					VMIName:  vmi.Name,
+					Priority: pointer.P(priority),
+				},
+			}
+			migrationCreateAdmitter := newMigrationCreateAdmitter(kubevirtfake.NewSimpleClientset(vmi))
+			ar, err := newAdmissionReviewForVMIMCreation(migration)
+			Expect(err).ToNot(HaveOccurred())
+			ar.Request.UserInfo = authenticationv1.UserInfo{Username: user}
+
+			resp := migrationCreateAdmitter.Admit(context.Background(), ar)
+			Expect(resp.Allowed).To(Equal(allowed))
+			if !allowed {
+				Expect(resp.Result.Details.Causes).To(HaveLen(1))
+				Expect(resp.Result.Details.Causes[0].Field).To(Equal("spec.priority"))
+			}
+		},
+			Entry("with a lowered priority", "user", int32(-10), true),
+			Entry("with a raised priority", "user", int32(10), false),
+			Entry("with a raised priority by a user allowed to prioritize", migrationPrioritizer, int32(10), true),
+		)
+
 		It("should accept Migration spec on create when previous VMI migration completed", func() {
 			vmi := libvmi.New(libvmi.WithNamespace(k8sv1.NamespaceDefault))
 			vmi.Status.MigrationState = &v1.VirtualMachineInstanceMigrationState{
@@ -128,7 +203,7 @@ var _ = Describe("Validating MigrationCreate Admitter", func() {
 			}
 
 			virtClient := kubevirtfake.NewSimpleClientset(vmi)
-			migrationCreateAdmitter := admitters.NewMigrationCreateAdmitter(virtClient)
+			migrationCreateAdmitter := newMigrationCreateAdmitter(virtClient)
 			ar, err := newAdmissionReviewForVMIMCreation(migration)
 			Expect(err).ToNot(HaveOccurred())
 
@@ -150,7 +225,7 @@ var _ = Describe("Validating MigrationCreate Admitter", func() {
 			}
 
 			virtClient := kubevirtfake.NewSimpleClientset(vmi)
-			migrationCreateAdmitter := admitters.NewMigrationCreateAdmitter(virtClient)
+			migrationCreateAdmitter := newMigrationCreateAdmitter(virtClient)
 			ar, err := newAdmissionReviewForVMIMCreation(migration)
 			Expect(err).ToNot(HaveOccurred())
 
@@ -183,7 +258,7 @@ var _ = Describe("Validating MigrationCreate Admitter", func() {
 				},
 			}
 			virtClient := kubevirtfake.NewSimpleClientset(vmi)
-			migrationCreateAdmitter := admitters.NewMigrationCreateAdmitter(virtClient)
+			migrationCreateAdmitter := newMigrationCreateAdmitter(virtClient)
 
 			ar, err := newAdmissionReviewForVMIMCreation(migration)
 			Expect(err).ToNot(HaveOccurred())
@@ -193,6 +268,112 @@ var _ = Describe("Validating MigrationCreate Admitter", func() {
 			Expect(resp.Result.Message).To(ContainSubstring("DisksNotLiveMigratable"))
 		})
 
+		Context("across clusters", func() {
+			newCrossClusterMigration := func(vmiName string, spec v1.VirtualMachineInstanceMigrationSpec) *v1.VirtualMachineInstanceMigration {
+				spec.VMIName = vmiName
+				return &v1.VirtualMachineInstanceMigration{
+					ObjectMeta: metav1.ObjectMeta{Namespace: k8sv1.NamespaceDefault},
+					Spec:       spec,
+				}
+			}
+
+			DescribeTable("should reject invalid cross cluster migrations", func(spec v1.VirtualMachineInstanceMigrationSpec, featureGates []string, expectedField string) {
+				migrationCreateAdmitter := newMigrationCreateAdmitter(kubevirtfake.NewSimpleClientset(), featureGates...)
+				ar, err := newAdmissionReviewForVMIMCreation(newCrossClusterMigration("testvmi", spec))
+				Expect(err).ToNot(HaveOccurred())
+
+				resp := migrationCreateAdmitter.Admit(context.Background(), ar)
+				Expect(resp.Allowed).To(BeFalse())
+				Expect(resp.Result.Details.Causes).To(HaveLen(1))
+				Expect(resp.Result.Details.Causes[0].Field).To(Equal(expectedField))
+			},
+				Entry("without the feature gate",
+					v1.VirtualMachineInstanceMigrationSpec{Receive: &v1.VirtualMachineInstanceMigrationReceive{MigrationID: "mig", VirtualMachine: newReceivedVMSpec()}},
+					nil,
+					"spec",
+				),
+				Entry("when sending and receiving",
+					v1.VirtualMachineInstanceMigrationSpec{
+						SendTo:  &v1.VirtualMachineInstanceMigrationSendTo{MigrationID: "mig", ConnectURL: "10.0.0.1:49160"},
+						Receive: &v1.VirtualMachineInstanceMigrationReceive{MigrationID: "mig", VirtualMachine: newReceivedVMSpec()},
+					},
+					[]string{featuregate.CrossClusterLiveMigrationGate},
+					"spec",
+				),
+				Entry("with node constraints",
+					v1.VirtualMachineInstanceMigrationSpec{
+						SendTo:            &v1.VirtualMachineInstanceMigrationSendTo{MigrationID: "mig", ConnectURL: "10.0.0.1:49160"},
+						AddedNodeSelector: map[string]string{k8sv1.LabelHostname: "node01"},
+					},
+					[]string{featuregate.CrossClusterLiveMigrationGate},
+					"spec",
+				),
+				Entry("without a migration ID",
+					v1.VirtualMachineInstanceMigrationSpec{Receive: &v1.VirtualMachineInstanceMigrationReceive{VirtualMachine: newReceivedVMSpec()}},
+					[]string{featuregate.CrossClusterLiveMigrationGate},
+					"spec.receive.migrationID",
+				),
+				Entry("with a VM with DataVolumeTemplates",
+					v1.VirtualMachineInstanceMigrationSpec{Receive: &v1.VirtualMachineInstanceMigrationReceive{
+						MigrationID: "mig",
+						VirtualMachine: func() *v1.VirtualMachineSpec {
+							spec := newReceivedVMSpec()
+							spec.DataVolumeTemplates = []v1.DataVolumeTemplateSpec{{ObjectMeta: metav1.ObjectMeta{Name: "dv"}}}
+							return spec
+						}(),
+					}},
+					[]string{featuregate.CrossClusterLiveMigrationGate},
+					"spec.receive.virtualMachine.dataVolumeTemplates",
+				),
+				Entry("with an invalid migration ID",
+					v1.VirtualMachineInstanceMigrationSpec{SendTo: &v1.VirtualMachineInstanceMigrationSendTo{MigrationID: "in valid", ConnectURL: "10.0.0.1:49160"}},
+					[]string{featuregate.CrossClusterLiveMigrationGate},
+					"spec.sendTo.migrationID",
+				),
+				Entry("with a connect URL without port",
+					v1.VirtualMachineInstanceMigrationSpec{SendTo: &v1.VirtualMachineInstanceMigrationSendTo{MigrationID: "mig", ConnectURL: "receiver.example.com"}},
+					[]string{featuregate.CrossClusterLiveMigrationGate},
+					"spec.sendTo.connectURL",
+				),
+				Entry("with a connect URL with an invalid port",
+					v1.VirtualMachineInstanceMigrationSpec{SendTo: &v1.VirtualMachineInstanceMigrationSendTo{MigrationID: "mig", ConnectURL: "receiver.example.com:70000"}},
+					[]string{featuregate.CrossClusterLiveMigrationGate},
+					"spec.sendTo.connectURL",
+				),
+			)
+
+			It("should accept a migration to another cluster", func() {
+				vmi := libvmi.New(libvmi.WithNamespace(k8sv1.NamespaceDefault))
+				migrationCreateAdmitter := newMigrationCreateAdmitter(kubevirtfake.NewSimpleClientset(vmi), featuregate.CrossClusterLiveMigrationGate)
+				ar, err := newAdmissionReviewForVMIMCreation(newCrossClusterMigration(vmi.Name, v1.VirtualMachineInstanceMigrationSpec{
+					SendTo: &v1.VirtualMachineInstanceMigrationSendTo{MigrationID: "mig", ConnectURL: "receiver.example.com:49160"},
+				}))
+				Expect(err).ToNot(HaveOccurred())
+
+				resp := migrationCreateAdmitter.Admit(context.Background(), ar)
+				Expect(resp.Allowed).To(BeTrue())
+			})
+
+			DescribeTable("should admit a received migration", func(user string, objects []runtime.Object, allowed bool) {
+				migrationCreateAdmitter := newMigrationCreateAdmitter(kubevirtfake.NewSimpleClientset(objects...), featuregate.CrossClusterLiveMigrationGate)
+				ar, err := newAdmissionReviewForVMIMCreation(newCrossClusterMigration("testvmi", v1.VirtualMachineInstanceMigrationSpec{
+					Receive: &v1.VirtualMachineInstanceMigrationReceive{MigrationID: "mig", VirtualMachine: newReceivedVMSpec()},
+				}))
+				Expect(err).ToNot(HaveOccurred())
+				ar.Request.UserInfo = authenticationv1.UserInfo{Username: user}
+
+				resp := migrationCreateAdmitter.Admit(context.Background(), ar)
+				Expect(resp.Allowed).To(Equal(allowed))
+			},
+				Entry("when the user is allowed to create the VM", vmAuthorizedUser, nil, true),
+				Entry("when the user is not allowed to create the VM", "unauthorized-user", nil, false),
+				Entry("when the VMI exists already", vmAuthorizedUser,
+					[]runtime.Object{libvmi.New(libvmi.WithName("testvmi"), libvmi.WithNamespace(k8sv1.NamespaceDefault))}, false),
+				Entry("when the VM exists already", vmAuthorizedUser,
+					[]runtime.Object{libvmi.NewVirtualMachine(libvmi.New(libvmi.WithName("testvmi"), libvmi.WithNamespace(k8sv1.NamespaceDefault)))}, false),
+			)
+		})
+
 		DescribeTable("should reject documents containing unknown or missing fields for", func(data string, validationResult string, gvr metav1.GroupVersionResource, review func(ctx context.Context, ar *admissionv1.AdmissionReview) *admissionv1.AdmissionResponse) {
 			input := map[string]interface{}{}
 			json.Unmarshal([]byte(data), &input)
@@ -213,18 +394,43 @@ var _ = Describe("Validating MigrationCreate Admitter", func() {
 				`{"very": "unknown", "spec": { "extremely": "unknown" }}`,
 				`.very in body is a forbidden property, spec.extremely in body is a forbidden property`,
 				webhooks.MigrationGroupVersionResource,
-				admitters.NewMigrationCreateAdmitter(kubevirtfake.NewSimpleClientset()).Admit,
+				newMigrationCreateAdmitter(kubevirtfake.NewSimpleClientset()).Admit,
 			),
 			Entry("Migration update",
 				`{"very": "unknown", "spec": { "extremely": "unknown" }}`,
 				`.very in body is a forbidden property, spec.extremely in body is a forbidden property`,
 				webhooks.MigrationGroupVersionResource,
-				admitters.NewMigrationCreateAdmitter(kubevirtfake.NewSimpleClientset()).Admit,
+				newMigrationCreateAdmitter(kubevirtfake.NewSimpleClientset()).Admit,
 			),
 		)
 	})
 })
 
+func newMigrationCreateAdmitter(virtClient kubevirt.Interface, featureGates ...string) *admitters.MigrationCreateAdmitter {
+	config, _, _ := testutils.NewFakeClusterConfigUsingKVConfig(&v1.KubeVirtConfiguration{
+		DeveloperConfiguration: &v1.DeveloperConfiguration{FeatureGates: featureGates},
+	})
+	k8sClient := k8sfake.NewSimpleClientset()
+	k8sClient.Fake.PrependReactor("create", "subjectaccessreviews", func(action testing.Action) (handled bool, obj runtime.Object, err error) {
+		sar := action.(testing.CreateAction).GetObject().(*authorizationv1.SubjectAccessReview)
+		attributes := sar.Spec.ResourceAttributes
+		switch sar.Spec.User {
+		case vmAuthorizedUser:
+			sar.Status.Allowed = attributes.Verb == "create" && attributes.Resource == "virtualmachines"
+		case migrationPrioritizer:
+			sar.Status.Allowed = attributes.Verb == "prioritize" && attributes.Resource == "virtualmachineinstancemigrations" &&
+				attributes.Namespace == ""
+		}
+		return true, sar, nil
+	})
+	return admitters.NewMigrationCreateAdmitter(virtClient, k8sClient.AuthorizationV1().SubjectAccessReviews(), config)
+}
+
+func newReceivedVMSpec() *v1.VirtualMachineSpec {
+	vm := libvmi.NewVirtualMachine(libvmi.New(), libvmi.WithRunStrategy(v1.RunStrategyAlways))
+	return &vm.Spec
+}
+
 func newAdmissionReviewForVMIMCreation(migration *v1.VirtualMachineInstanceMigration) (*admissionv1.AdmissionReview, error) {
 	migrationBytes, err := json.Marshal(migration)
 	if err != nil {
@@ -34,15 +34,17 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	webhookutils "kubevirt.io/kubevirt/pkg/util/webhooks"
+	virtconfig "kubevirt.io/kubevirt/pkg/virt-config"
 )
 
 // MigrationPolicyAdmitter validates VirtualMachineSnapshots
 type MigrationPolicyAdmitter struct {
+	ClusterConfig *virtconfig.ClusterConfig
 }
 
 // NewMigrationPolicyAdmitter creates a MigrationPolicyAdmitter
-func NewMigrationPolicyAdmitter() *MigrationPolicyAdmitter {
-	return &MigrationPolicyAdmitter{}
+func NewMigrationPolicyAdmitter(clusterConfig *virtconfig.ClusterConfig) *MigrationPolicyAdmitter {
+	return &MigrationPolicyAdmitter{ClusterConfig: clusterConfig}
 }
 
 // Admit validates an AdmissionReview
@@ -87,6 +89,47 @@ func (admitter *MigrationPolicyAdmitter) Admit(_ context.Context, ar *admissionv
 		}
 	}
 
+	if spec.Network != nil {
+		if *spec.Network == "" {
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueInvalid,
+				Message: "must not be empty",
+				Field:   sourceField.Child("network").String(),
+			})
+		} else if !admitter.isMigrationNetwork(*spec.Network) {
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueNotSupported,
+				Message: fmt.Sprintf("%s is neither the migration network nor one of the additional migration networks of the cluster", *spec.Network),
+				Field:   sourceField.Child("network").String(),
+			})
+		}
+	}
+
+	if verification := spec.PostMigrationVerification; verification != nil {
+		verificationField := sourceField.Child("postMigrationVerification")
+		if len(verification.Checks) == 0 {
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueRequired,
+				Message: "must not be empty",
+				Field:   verificationField.Child("checks").String(),
+			})
+		}
+		if verification.TimeoutSeconds != nil && *verification.TimeoutSeconds <= 0 {
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueInvalid,
+				Message: "must be positive",
+				Field:   verificationField.Child("timeoutSeconds").String(),
+			})
+		}
+		if port := verification.NetworkCheckPort; port != nil && (*port < 1 || *port > 65535) {
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueInvalid,
+				Message: "must be a valid port number",
+				Field:   verificationField.Child("networkCheckPort").String(),
+			})
+		}
+	}
+
 	if len(causes) > 0 {
 		return webhookutils.ToAdmissionResponse(causes)
 	}
@@ -96,3 +139,17 @@ func (admitter *MigrationPolicyAdmitter) Admit(_ context.Context, ar *admissionv
 	}
 	return &reviewResponse
 }
+
+// isMigrationNetwork tells if virt-handler is connected to the network, so that migrations can use it
+func (admitter *MigrationPolicyAdmitter) isMigrationNetwork(network string) bool {
+	migrationConfiguration := admitter.ClusterConfig.GetMigrationConfiguration()
+	if migrationConfiguration.Network != nil && *migrationConfiguration.Network == network {
+		return true
+	}
+	for _, additionalNetwork := range migrationConfiguration.AdditionalNetworks {
+		if additionalNetwork == network {
+			return true
+		}
+	}
+	return false
+}
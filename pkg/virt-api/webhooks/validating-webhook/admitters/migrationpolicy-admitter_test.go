@@ -27,6 +27,7 @@ import (
 
 	"kubevirt.io/api/migrations"
 
+	v1 "kubevirt.io/api/core/v1"
 	migrationsv1 "kubevirt.io/api/migrations/v1alpha1"
 
 	. "github.com/onsi/ginkgo/v2"
@@ -38,6 +39,7 @@ import (
 	"kubevirt.io/client-go/kubecli"
 
 	"kubevirt.io/kubevirt/pkg/pointer"
+	"kubevirt.io/kubevirt/pkg/testutils"
 )
 
 var _ = Describe("Validating MigrationPolicy Admitter", func() {
@@ -45,7 +47,13 @@ var _ = Describe("Validating MigrationPolicy Admitter", func() {
 	var policyName string
 
 	BeforeEach(func() {
-		admitter = &MigrationPolicyAdmitter{}
+		config, _, _ := testutils.NewFakeClusterConfigUsingKVConfig(&v1.KubeVirtConfiguration{
+			MigrationConfiguration: &v1.MigrationConfiguration{
+				Network:            pointer.P("cluster-migration-net"),
+				AdditionalNetworks: []string{"migration-net"},
+			},
+		})
+		admitter = NewMigrationPolicyAdmitter(config)
 		policyName = "test-policy"
 	})
 
@@ -64,6 +72,32 @@ var _ = Describe("Validating MigrationPolicy Admitter", func() {
 		Entry("negative CompletionTimeoutPerGiB",
 			migrationsv1.MigrationPolicySpec{CompletionTimeoutPerGiB: pointer.P(int64(-1))},
 		),
+
+		Entry("empty Network",
+			migrationsv1.MigrationPolicySpec{Network: pointer.P("")},
+		),
+
+		Entry("Network virt-handler is not connected to",
+			migrationsv1.MigrationPolicySpec{Network: pointer.P("unknown-net")},
+		),
+
+		Entry("PostMigrationVerification without checks",
+			migrationsv1.MigrationPolicySpec{PostMigrationVerification: &v1.PostMigrationVerification{}},
+		),
+
+		Entry("zero PostMigrationVerification TimeoutSeconds",
+			migrationsv1.MigrationPolicySpec{PostMigrationVerification: &v1.PostMigrationVerification{
+				Checks:         []v1.PostMigrationCheck{v1.PostMigrationCheckGuestAgent},
+				TimeoutSeconds: pointer.P(int64(0)),
+			}},
+		),
+
+		Entry("invalid PostMigrationVerification NetworkCheckPort",
+			migrationsv1.MigrationPolicySpec{PostMigrationVerification: &v1.PostMigrationVerification{
+				Checks:           []v1.PostMigrationCheck{v1.PostMigrationCheckNetwork},
+				NetworkCheckPort: pointer.P(int32(70000)),
+			}},
+		),
 	)
 
 	DescribeTable("should accept migration policy with", func(policySpec migrationsv1.MigrationPolicySpec) {
@@ -90,6 +124,22 @@ var _ = Describe("Validating MigrationPolicy Admitter", func() {
 			migrationsv1.MigrationPolicySpec{BandwidthPerMigration: resource.NewScaledQuantity(0, 1)},
 		),
 
+		Entry("additional Network",
+			migrationsv1.MigrationPolicySpec{Network: pointer.P("migration-net")},
+		),
+
+		Entry("cluster Network",
+			migrationsv1.MigrationPolicySpec{Network: pointer.P("cluster-migration-net")},
+		),
+
+		Entry("PostMigrationVerification",
+			migrationsv1.MigrationPolicySpec{PostMigrationVerification: &v1.PostMigrationVerification{
+				Checks:         []v1.PostMigrationCheck{v1.PostMigrationCheckGuestAgent, v1.PostMigrationCheckNetwork},
+				TimeoutSeconds: pointer.P(int64(30)),
+				FailureAction:  pointer.P(v1.PostMigrationFailureActionMigrateBack),
+			}},
+		),
+
 		Entry("empty spec",
 			migrationsv1.MigrationPolicySpec{},
 		),
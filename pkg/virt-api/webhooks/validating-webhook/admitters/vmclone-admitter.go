@@ -33,6 +33,8 @@ import (
 	"kubevirt.io/kubevirt/pkg/storage/snapshot"
 
 	admissionv1 "k8s.io/api/admission/v1"
+	authenticationv1 "k8s.io/api/authentication/v1"
+	authorizationv1 "k8s.io/api/authorization/v1"
 	"k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	k8sfield "k8s.io/apimachinery/pkg/util/validation/field"
@@ -44,11 +46,13 @@ import (
 
 	webhookutils "kubevirt.io/kubevirt/pkg/util/webhooks"
 	virtconfig "kubevirt.io/kubevirt/pkg/virt-config"
+	"kubevirt.io/kubevirt/pkg/virt-config/featuregate"
 )
 
 const (
 	virtualMachineKind         = "VirtualMachine"
 	virtualMachineSnapshotKind = "VirtualMachineSnapshot"
+	virtualMachineExportKind   = "VirtualMachineExport"
 )
 
 // VirtualMachineCloneAdmitter validates VirtualMachineClones
@@ -111,6 +115,12 @@ func (admitter *VirtualMachineCloneAdmitter) Admit(ctx context.Context, ar *admi
 		causes = append(causes, newCauses...)
 	}
 
+	newCauses, err := admitter.validateTargetNamespace(ctx, ar.Request.UserInfo, vmClone)
+	if err != nil {
+		return webhookutils.ToAdmissionResponseError(err)
+	}
+	causes = append(causes, newCauses...)
+
 	if newCauses := validateNewMacAddresses(vmClone); newCauses != nil {
 		causes = append(causes, newCauses...)
 	}
@@ -167,7 +177,7 @@ func validateSourceAndTargetKind(vmClone *clone.VirtualMachineClone) []metav1.St
 	sourceField := k8sfield.NewPath("spec")
 
 	supportedSourceTypes := []string{virtualMachineKind, virtualMachineSnapshotKind}
-	supportedTargetTypes := []string{virtualMachineKind}
+	supportedTargetTypes := []string{virtualMachineKind, virtualMachineExportKind}
 
 	if !doesSliceContainStr(supportedSourceTypes, vmClone.Spec.Source.Kind) {
 		causes = []metav1.StatusCause{{
@@ -248,11 +258,13 @@ func validateTarget(vmClone *clone.VirtualMachineClone) []metav1.StatusCause {
 	source := vmClone.Spec.Source
 	target := vmClone.Spec.Target
 
+	targetNamespace := vmClone.Spec.TargetNamespace
 	if source != nil &&
 		target != nil &&
 		source.Kind == virtualMachineKind &&
 		target.Kind == virtualMachineKind &&
-		target.Name == source.Name {
+		target.Name == source.Name &&
+		(targetNamespace == "" || targetNamespace == vmClone.Namespace) {
 		causes = append(causes, metav1.StatusCause{
 			Type:    metav1.CauseTypeFieldValueInvalid,
 			Message: "Target name cannot be equal to source name when both are VirtualMachines",
@@ -263,6 +275,70 @@ func validateTarget(vmClone *clone.VirtualMachineClone) []metav1.StatusCause {
 	return causes
 }
 
+// validateTargetNamespace checks the clone may write its target where it is
+// asked to. A VirtualMachineExport is always created next to its snapshot,
+// a VirtualMachine in another namespace requires the user creating the clone
+// to be allowed to create it there.
+func (admitter *VirtualMachineCloneAdmitter) validateTargetNamespace(ctx context.Context, userInfo authenticationv1.UserInfo, vmClone *clone.VirtualMachineClone) ([]metav1.StatusCause, error) {
+	specField := k8sfield.NewPath("spec")
+	targetNamespace := vmClone.Spec.TargetNamespace
+
+	if vmClone.Spec.Target != nil && vmClone.Spec.Target.Kind == virtualMachineExportKind {
+		var causes []metav1.StatusCause
+		if !admitter.Config.VMExportEnabled() {
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueNotSupported,
+				Message: fmt.Sprintf("a VirtualMachineExport target requires the %s feature gate", featuregate.VMExportGate),
+				Field:   specField.Child("target").Child("kind").String(),
+			})
+		}
+		if targetNamespace != "" && targetNamespace != vmClone.Namespace {
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueNotSupported,
+				Message: "a VirtualMachineExport target cannot be created in another namespace",
+				Field:   specField.Child("targetNamespace").String(),
+			})
+		}
+		return causes, nil
+	}
+
+	if targetNamespace == "" || targetNamespace == vmClone.Namespace {
+		return nil, nil
+	}
+
+	namespaceField := specField.Child("targetNamespace")
+	if !admitter.Config.CrossNamespaceRestoreEnabled() {
+		return []metav1.StatusCause{{
+			Type:    metav1.CauseTypeFieldValueNotSupported,
+			Message: fmt.Sprintf("clone to another namespace requires the %s feature gate", featuregate.CrossNamespaceRestoreGate),
+			Field:   namespaceField.String(),
+		}}, nil
+	}
+
+	allowed, err := admitter.canCreateVM(ctx, userInfo, targetNamespace)
+	if err != nil {
+		return nil, err
+	}
+	if !allowed {
+		return []metav1.StatusCause{{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: fmt.Sprintf("user %s is not allowed to create VirtualMachines in namespace %s", userInfo.Username, targetNamespace),
+			Field:   namespaceField.String(),
+		}}, nil
+	}
+
+	return nil, nil
+}
+
+func (admitter *VirtualMachineCloneAdmitter) canCreateVM(ctx context.Context, userInfo authenticationv1.UserInfo, namespace string) (bool, error) {
+	return webhookutils.IsUserAllowed(ctx, admitter.Client.AuthorizationV1().SubjectAccessReviews(), userInfo, &authorizationv1.ResourceAttributes{
+		Namespace: namespace,
+		Verb:      "create",
+		Group:     v1.GroupVersion.Group,
+		Resource:  "virtualmachines",
+	})
+}
+
 func validateNewMacAddresses(vmClone *clone.VirtualMachineClone) []metav1.StatusCause {
 	var causes []metav1.StatusCause
 
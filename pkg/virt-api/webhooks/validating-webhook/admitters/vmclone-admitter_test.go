@@ -26,7 +26,11 @@ import (
 
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
+	authenticationv1 "k8s.io/api/authentication/v1"
+	authorizationv1 "k8s.io/api/authorization/v1"
+	k8sfake "k8s.io/client-go/kubernetes/fake"
 	"k8s.io/client-go/testing"
+	exportv1 "kubevirt.io/api/export/v1beta1"
 	snapshotv1 "kubevirt.io/api/snapshot/v1beta1"
 	"kubevirt.io/client-go/kubevirt/fake"
 
@@ -47,8 +51,11 @@ import (
 	"kubevirt.io/kubevirt/pkg/pointer"
 	"kubevirt.io/kubevirt/pkg/testutils"
 	virtconfig "kubevirt.io/kubevirt/pkg/virt-config"
+	"kubevirt.io/kubevirt/pkg/virt-config/featuregate"
 )
 
+const cloneAuthorizedUser = "clone-authorized-user"
+
 var _ = Describe("Validating VirtualMachineClone Admitter", func() {
 	var ctrl *gomock.Controller
 	var virtClient *kubecli.MockKubevirtClient
@@ -60,12 +67,12 @@ var _ = Describe("Validating VirtualMachineClone Admitter", func() {
 	var vmInterface *kubecli.MockVirtualMachineInterface
 	var vm *v1.VirtualMachine
 
-	enableFeatureGate := func(featureGate string) {
+	enableFeatureGate := func(featureGates ...string) {
 		testutils.UpdateFakeKubeVirtClusterConfig(kvStore, &v1.KubeVirt{
 			Spec: v1.KubeVirtSpec{
 				Configuration: v1.KubeVirtConfiguration{
 					DeveloperConfiguration: &v1.DeveloperConfiguration{
-						FeatureGates: []string{featureGate},
+						FeatureGates: featureGates,
 					},
 				},
 			},
@@ -141,6 +148,15 @@ var _ = Describe("Validating VirtualMachineClone Admitter", func() {
 		config, _, kvStore = testutils.NewFakeClusterConfigUsingKVConfig(&v1.KubeVirtConfiguration{})
 		vmInterface = kubecli.NewMockVirtualMachineInterface(ctrl)
 		kubevirtClient = fake.NewSimpleClientset()
+		k8sClient := k8sfake.NewSimpleClientset()
+		virtClient.EXPECT().AuthorizationV1().Return(k8sClient.AuthorizationV1()).AnyTimes()
+		k8sClient.Fake.PrependReactor("create", "subjectaccessreviews", func(action testing.Action) (handled bool, obj runtime.Object, err error) {
+			sar := action.(testing.CreateAction).GetObject().(*authorizationv1.SubjectAccessReview)
+			sar.Status.Allowed = sar.Spec.User == cloneAuthorizedUser &&
+				sar.Spec.ResourceAttributes.Verb == "create" &&
+				sar.Spec.ResourceAttributes.Resource == "virtualmachines"
+			return true, sar, nil
+		})
 		virtClient.
 			EXPECT().
 			VirtualMachine(metav1.NamespaceDefault).
@@ -294,6 +310,63 @@ var _ = Describe("Validating VirtualMachineClone Admitter", func() {
 		})
 	})
 
+	Context("with a target in another namespace", func() {
+		const targetNamespace = "target"
+
+		BeforeEach(func() {
+			vmClone.Spec.TargetNamespace = targetNamespace
+		})
+
+		It("should reject when the CrossNamespaceRestore feature gate is not enabled", func() {
+			admitter.admitAsAndExpect(vmClone, cloneAuthorizedUser, false)
+		})
+
+		Context("and the CrossNamespaceRestore feature gate enabled", func() {
+			BeforeEach(func() {
+				enableFeatureGate("Snapshot", featuregate.CrossNamespaceRestoreGate)
+			})
+
+			It("should allow when the user can create VirtualMachines in the target namespace", func() {
+				admitter.admitAsAndExpect(vmClone, cloneAuthorizedUser, true)
+			})
+
+			It("should reject when the user cannot create VirtualMachines in the target namespace", func() {
+				admitter.admitAsAndExpect(vmClone, "other-user", false)
+			})
+
+			It("should allow a target with the same name as the source", func() {
+				vmClone.Spec.Target.Name = vmClone.Spec.Source.Name
+				admitter.admitAsAndExpect(vmClone, cloneAuthorizedUser, true)
+			})
+		})
+	})
+
+	Context("with a VirtualMachineExport target", func() {
+		BeforeEach(func() {
+			vmClone.Spec.Target.Kind = virtualMachineExportKind
+			vmClone.Spec.Target.APIGroup = pointer.P(exportv1.SchemeGroupVersion.Group)
+		})
+
+		It("should reject when the VMExport feature gate is not enabled", func() {
+			admitter.admitAndExpect(vmClone, false)
+		})
+
+		Context("and the VMExport feature gate enabled", func() {
+			BeforeEach(func() {
+				enableFeatureGate("Snapshot", featuregate.VMExportGate, featuregate.CrossNamespaceRestoreGate)
+			})
+
+			It("should allow the clone", func() {
+				admitter.admitAndExpect(vmClone, true)
+			})
+
+			It("should reject a target namespace", func() {
+				vmClone.Spec.TargetNamespace = "target"
+				admitter.admitAsAndExpect(vmClone, cloneAuthorizedUser, false)
+			})
+		})
+	})
+
 	It("Should reject if snapshot feature gate is not enabled", func() {
 		disableFeatureGates()
 		admitter.admitAndExpect(vmClone, false)
@@ -447,6 +520,13 @@ func (admitter *VirtualMachineCloneAdmitter) admitAndExpect(clone *clone.Virtual
 	Expect(resp.Allowed).To(Equal(expectAllowed))
 }
 
+func (admitter *VirtualMachineCloneAdmitter) admitAsAndExpect(clone *clone.VirtualMachineClone, username string, expectAllowed bool) {
+	ar := createCloneAdmissionReview(clone)
+	ar.Request.UserInfo = authenticationv1.UserInfo{Username: username}
+	resp := admitter.Admit(context.Background(), ar)
+	Expect(resp.Allowed).To(Equal(expectAllowed))
+}
+
 func newValidClone() *clone.VirtualMachineClone {
 	vmClone := kubecli.NewMinimalCloneWithNS("testclone", metav1.NamespaceDefault)
 	vmClone.Spec.Source = newValidObjReference()
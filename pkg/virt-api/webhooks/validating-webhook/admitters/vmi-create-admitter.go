@@ -287,7 +287,7 @@ func validateVirtualMachineInstanceSpecVolumeDisks(field *k8sfield.Path, spec *v
 
 	// Validate that volumes match disks and filesystems correctly
 	for idx, volume := range spec.Volumes {
-		if volume.MemoryDump != nil {
+		if volume.MemoryDump != nil || volume.Backup != nil {
 			continue
 		}
 		if _, matchingDiskExists := diskAndFilesystemNames[volume.Name]; !matchingDiskExists {
@@ -1578,6 +1578,7 @@ func validateVolumes(field *k8sfield.Path, volumes []v1.Volume, config *virtconf
 	serviceAccountVolumeCount := 0
 	downwardMetricVolumeCount := 0
 	memoryDumpVolumeCount := 0
+	backupVolumeCount := 0
 
 	for idx, volume := range volumes {
 		// verify name is unique
@@ -1649,6 +1650,10 @@ func validateVolumes(field *k8sfield.Path, volumes []v1.Volume, config *virtconf
 			memoryDumpVolumeCount++
 			volumeSourceSetCount++
 		}
+		if volume.Backup != nil {
+			backupVolumeCount++
+			volumeSourceSetCount++
+		}
 
 		if volumeSourceSetCount != 1 {
 			causes = append(causes, metav1.StatusCause{
@@ -1862,6 +1867,13 @@ func validateVolumes(field *k8sfield.Path, volumes []v1.Volume, config *virtconf
 			Field:   field.String(),
 		})
 	}
+	if backupVolumeCount > 1 {
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: fmt.Sprintf("%s must have max one backup volume set", field.String()),
+			Field:   field.String(),
+		})
+	}
 
 	return causes
 }
@@ -132,12 +132,16 @@ func (admitter *VMIUpdateAdmitter) Admit(_ context.Context, ar *admissionv1.Admi
 
 func getExpectedDisksAndFilesystems(newVolumes []v1.Volume) int {
 	numMemoryDumpVolumes := 0
+	numBackupVolumes := 0
 	for _, volume := range newVolumes {
 		if volume.MemoryDump != nil {
 			numMemoryDumpVolumes = numMemoryDumpVolumes + 1
 		}
+		if volume.Backup != nil {
+			numBackupVolumes = numBackupVolumes + 1
+		}
 	}
-	return len(newVolumes) - numMemoryDumpVolumes
+	return len(newVolumes) - numMemoryDumpVolumes - numBackupVolumes
 }
 
 // admitStorageUpdate compares the old and new volumes and disks, and ensures that they match and are valid.
@@ -193,7 +197,7 @@ func verifyHotplugVolumes(newHotplugVolumeMap, oldHotplugVolumeMap map[string]v1
 					},
 				})
 			}
-			if v.MemoryDump == nil {
+			if v.MemoryDump == nil && v.Backup == nil {
 				if _, ok := newDisks[k]; !ok {
 					return webhookutils.ToAdmissionResponse([]metav1.StatusCause{
 						{
@@ -212,8 +216,8 @@ func verifyHotplugVolumes(newHotplugVolumeMap, oldHotplugVolumeMap map[string]v1
 				}
 			}
 		} else {
-			// This is a new volume, ensure that the volume is either DV, PVC or memoryDumpVolume
-			if v.DataVolume == nil && v.PersistentVolumeClaim == nil && v.MemoryDump == nil {
+			// This is a new volume, ensure that the volume is either DV, PVC, memoryDumpVolume or backupVolume
+			if v.DataVolume == nil && v.PersistentVolumeClaim == nil && v.MemoryDump == nil && v.Backup == nil {
 				return webhookutils.ToAdmissionResponse([]metav1.StatusCause{
 					{
 						Type:    metav1.CauseTypeFieldValueInvalid,
@@ -221,7 +225,7 @@ func verifyHotplugVolumes(newHotplugVolumeMap, oldHotplugVolumeMap map[string]v1
 					},
 				})
 			}
-			if v.MemoryDump == nil {
+			if v.MemoryDump == nil && v.Backup == nil {
 				// Also ensure the matching new disk exists and is of type scsi
 				if _, ok := newDisks[k]; !ok {
 					return webhookutils.ToAdmissionResponse([]metav1.StatusCause{
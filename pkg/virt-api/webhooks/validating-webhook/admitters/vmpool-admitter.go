@@ -29,6 +29,7 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/intstr"
 	k8sfield "k8s.io/apimachinery/pkg/util/validation/field"
 
 	poolv1 "kubevirt.io/api/pool/v1alpha1"
@@ -131,6 +132,10 @@ func ValidateVMPoolSpec(ar *admissionv1.AdmissionReview, field *k8sfield.Path, p
 		})
 	}
 
+	causes = append(causes, validateVMPoolUpdateStrategy(field.Child("updateStrategy"), spec.UpdateStrategy)...)
+	causes = append(causes, validateVMPoolScaleInStrategy(field.Child("scaleInStrategy"), spec.ScaleInStrategy)...)
+	causes = append(causes, validateVMPoolAutoscaling(field.Child("autoscaling"), spec.Autoscaling, config)...)
+
 	if ar.Request.Operation == admissionv1.Update {
 		oldPool := &poolv1.VirtualMachinePool{}
 		if err := json.Unmarshal(ar.Request.OldObject.Raw, oldPool); err != nil {
@@ -150,3 +155,156 @@ func ValidateVMPoolSpec(ar *admissionv1.AdmissionReview, field *k8sfield.Path, p
 	}
 	return causes
 }
+
+func validateVMPoolUpdateStrategy(field *k8sfield.Path, updateStrategy *poolv1.VirtualMachinePoolUpdateStrategy) []metav1.StatusCause {
+	if updateStrategy == nil || updateStrategy.RollingUpdate == nil {
+		return nil
+	}
+
+	var causes []metav1.StatusCause
+	rollingUpdate := updateStrategy.RollingUpdate
+	field = field.Child("rollingUpdate")
+
+	maxUnavailable, newCauses := validateIntOrPercent(field.Child("maxUnavailable"), rollingUpdate.MaxUnavailable)
+	causes = append(causes, newCauses...)
+	maxSurge, newCauses := validateIntOrPercent(field.Child("maxSurge"), rollingUpdate.MaxSurge)
+	causes = append(causes, newCauses...)
+	if len(causes) > 0 {
+		return causes
+	}
+
+	if rollingUpdate.Method != poolv1.VirtualMachinePoolUpdateMethodLiveMigrate && rollingUpdate.MaxUnavailable != nil && maxUnavailable == 0 {
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: "maxUnavailable cannot be 0 when VMIs are restarted to be updated",
+			Field:   field.Child("maxUnavailable").String(),
+		})
+	} else if rollingUpdate.MaxUnavailable != nil && rollingUpdate.MaxSurge != nil && maxUnavailable == 0 && maxSurge == 0 {
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: "maxUnavailable and maxSurge cannot both be 0",
+			Field:   field.Child("maxUnavailable").String(),
+		})
+	}
+
+	return causes
+}
+
+func validateVMPoolScaleInStrategy(field *k8sfield.Path, scaleInStrategy *poolv1.VirtualMachinePoolScaleInStrategy) []metav1.StatusCause {
+	if scaleInStrategy == nil || scaleInStrategy.Proactive == nil {
+		return nil
+	}
+
+	gracePeriodSeconds := scaleInStrategy.Proactive.PreStopGracePeriodSeconds
+	if gracePeriodSeconds != nil && *gracePeriodSeconds < 0 {
+		return []metav1.StatusCause{{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: fmt.Sprintf("preStopGracePeriodSeconds must not be negative, got %d", *gracePeriodSeconds),
+			Field:   field.Child("proactive", "preStopGracePeriodSeconds").String(),
+		}}
+	}
+	return nil
+}
+
+func validateVMPoolAutoscaling(field *k8sfield.Path, autoscaling *poolv1.VirtualMachinePoolAutoscaling, config *virtconfig.ClusterConfig) []metav1.StatusCause {
+	if autoscaling == nil {
+		return nil
+	}
+	if !config.VMPoolAutoscalingEnabled() {
+		return []metav1.StatusCause{{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: fmt.Sprintf("autoscaling is not allowed: %s feature gate is not enabled", featuregate.VMPoolAutoscalingGate),
+			Field:   field.String(),
+		}}
+	}
+
+	var causes []metav1.StatusCause
+	if autoscaling.MaxReplicas < 1 {
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: fmt.Sprintf("maxReplicas must be at least 1, got %d", autoscaling.MaxReplicas),
+			Field:   field.Child("maxReplicas").String(),
+		})
+	}
+	if minReplicas := autoscaling.MinReplicas; minReplicas != nil && (*minReplicas < 1 || *minReplicas > autoscaling.MaxReplicas) {
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: fmt.Sprintf("minReplicas must be between 1 and maxReplicas, got %d", *minReplicas),
+			Field:   field.Child("minReplicas").String(),
+		})
+	}
+
+	if autoscaling.TargetCPUUtilizationPercentage == nil && autoscaling.TargetMemoryUtilizationPercentage == nil {
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueRequired,
+			Message: "at least one of targetCPUUtilizationPercentage and targetMemoryUtilizationPercentage is required",
+			Field:   field.String(),
+		})
+	}
+	targets := []struct {
+		name  string
+		value *int32
+	}{
+		{"targetCPUUtilizationPercentage", autoscaling.TargetCPUUtilizationPercentage},
+		{"targetMemoryUtilizationPercentage", autoscaling.TargetMemoryUtilizationPercentage},
+	}
+	for _, target := range targets {
+		if target.value != nil && (*target.value < 1 || *target.value > 100) {
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueInvalid,
+				Message: fmt.Sprintf("%s must be between 1 and 100, got %d", target.name, *target.value),
+				Field:   field.Child(target.name).String(),
+			})
+		}
+	}
+
+	cooldowns := []struct {
+		name  string
+		value *int32
+	}{
+		{"scaleOutCooldownSeconds", autoscaling.ScaleOutCooldownSeconds},
+		{"scaleInCooldownSeconds", autoscaling.ScaleInCooldownSeconds},
+	}
+	for _, cooldown := range cooldowns {
+		if cooldown.value != nil && *cooldown.value < 0 {
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueInvalid,
+				Message: fmt.Sprintf("%s must not be negative, got %d", cooldown.name, *cooldown.value),
+				Field:   field.Child(cooldown.name).String(),
+			})
+		}
+	}
+	return causes
+}
+
+// validateIntOrPercent returns the value of an absolute number, or the
+// percentage of a percentage, after checking it is not negative.
+func validateIntOrPercent(field *k8sfield.Path, value *intstr.IntOrString) (int, []metav1.StatusCause) {
+	if value == nil {
+		return 0, nil
+	}
+
+	scaled, err := intstr.GetScaledValueFromIntOrPercent(value, 100, false)
+	if err != nil {
+		return 0, []metav1.StatusCause{{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: err.Error(),
+			Field:   field.String(),
+		}}
+	}
+	if scaled < 0 {
+		return 0, []metav1.StatusCause{{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: fmt.Sprintf("%s must not be negative", field.String()),
+			Field:   field.String(),
+		}}
+	}
+	if value.Type == intstr.String && scaled > 100 {
+		return 0, []metav1.StatusCause{{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: fmt.Sprintf("%s must not be greater than 100%%", field.String()),
+			Field:   field.String(),
+		}}
+	}
+	return scaled, nil
+}
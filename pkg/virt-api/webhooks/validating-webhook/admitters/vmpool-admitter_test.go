@@ -28,13 +28,16 @@ import (
 	admissionv1 "k8s.io/api/admission/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/intstr"
 
 	v1 "kubevirt.io/api/core/v1"
 	virtv1 "kubevirt.io/api/core/v1"
 	poolv1 "kubevirt.io/api/pool/v1alpha1"
 
+	"kubevirt.io/kubevirt/pkg/pointer"
 	"kubevirt.io/kubevirt/pkg/testutils"
 	"kubevirt.io/kubevirt/pkg/virt-api/webhooks"
+	"kubevirt.io/kubevirt/pkg/virt-config/featuregate"
 )
 
 var _ = Describe("Validating Pool Admitter", func() {
@@ -137,8 +140,8 @@ var _ = Describe("Validating Pool Admitter", func() {
 			"spec.selector",
 		}),
 	)
-	It("should accept valid vm spec", func() {
-		pool := &poolv1.VirtualMachinePool{
+	newValidPool := func() *poolv1.VirtualMachinePool {
+		return &poolv1.VirtualMachinePool{
 			Spec: poolv1.VirtualMachinePoolSpec{
 				Selector: &metav1.LabelSelector{
 					MatchLabels: map[string]string{"match": "me"},
@@ -166,6 +169,9 @@ var _ = Describe("Validating Pool Admitter", func() {
 				},
 			},
 		}
+	}
+
+	admitPool := func(pool *poolv1.VirtualMachinePool) *admissionv1.AdmissionResponse {
 		poolBytes, _ := json.Marshal(&pool)
 
 		ar := &admissionv1.AdmissionReview{
@@ -177,7 +183,134 @@ var _ = Describe("Validating Pool Admitter", func() {
 			},
 		}
 
-		resp := poolAdmitter.Admit(context.Background(), ar)
+		return poolAdmitter.Admit(context.Background(), ar)
+	}
+
+	It("should accept valid vm spec", func() {
+		resp := admitPool(newValidPool())
 		Expect(resp.Allowed).To(BeTrue())
 	})
+
+	DescribeTable("should validate the rolling update strategy", func(rollingUpdate *poolv1.VirtualMachinePoolRollingUpdate, expectedCause string) {
+		pool := newValidPool()
+		pool.Spec.UpdateStrategy = &poolv1.VirtualMachinePoolUpdateStrategy{RollingUpdate: rollingUpdate}
+
+		resp := admitPool(pool)
+		if expectedCause == "" {
+			Expect(resp.Allowed).To(BeTrue())
+			return
+		}
+		Expect(resp.Allowed).To(BeFalse())
+		Expect(resp.Result.Details.Causes).To(HaveLen(1))
+		Expect(resp.Result.Details.Causes[0].Field).To(Equal(expectedCause))
+	},
+		Entry("should accept the defaults", &poolv1.VirtualMachinePoolRollingUpdate{}, ""),
+		Entry("should accept absolute numbers and percentages", &poolv1.VirtualMachinePoolRollingUpdate{
+			MaxUnavailable: pointer.P(intstr.FromInt32(2)),
+			MaxSurge:       pointer.P(intstr.FromString("50%")),
+		}, ""),
+		Entry("should accept no unavailable VM when live migrating", &poolv1.VirtualMachinePoolRollingUpdate{
+			Method:         poolv1.VirtualMachinePoolUpdateMethodLiveMigrate,
+			MaxUnavailable: pointer.P(intstr.FromInt32(0)),
+		}, ""),
+		Entry("should reject no unavailable VM when restarting", &poolv1.VirtualMachinePoolRollingUpdate{
+			MaxUnavailable: pointer.P(intstr.FromString("0%")),
+		}, "spec.updateStrategy.rollingUpdate.maxUnavailable"),
+		Entry("should reject both limits being 0", &poolv1.VirtualMachinePoolRollingUpdate{
+			Method:         poolv1.VirtualMachinePoolUpdateMethodLiveMigrate,
+			MaxUnavailable: pointer.P(intstr.FromInt32(0)),
+			MaxSurge:       pointer.P(intstr.FromInt32(0)),
+		}, "spec.updateStrategy.rollingUpdate.maxUnavailable"),
+		Entry("should reject a negative limit", &poolv1.VirtualMachinePoolRollingUpdate{
+			MaxSurge: pointer.P(intstr.FromInt32(-1)),
+		}, "spec.updateStrategy.rollingUpdate.maxSurge"),
+		Entry("should reject an invalid percentage", &poolv1.VirtualMachinePoolRollingUpdate{
+			MaxUnavailable: pointer.P(intstr.FromString("ten")),
+		}, "spec.updateStrategy.rollingUpdate.maxUnavailable"),
+		Entry("should reject a percentage over 100%", &poolv1.VirtualMachinePoolRollingUpdate{
+			MaxSurge: pointer.P(intstr.FromString("150%")),
+		}, "spec.updateStrategy.rollingUpdate.maxSurge"),
+	)
+
+	DescribeTable("should validate the proactive scale-in strategy", func(gracePeriodSeconds *int64, expectAllowed bool) {
+		pool := newValidPool()
+		pool.Spec.ScaleInStrategy = &poolv1.VirtualMachinePoolScaleInStrategy{
+			Proactive: &poolv1.VirtualMachinePoolProactiveScaleInStrategy{PreStopGracePeriodSeconds: gracePeriodSeconds},
+		}
+
+		resp := admitPool(pool)
+		Expect(resp.Allowed).To(Equal(expectAllowed))
+		if !expectAllowed {
+			Expect(resp.Result.Details.Causes).To(HaveLen(1))
+			Expect(resp.Result.Details.Causes[0].Field).To(Equal("spec.scaleInStrategy.proactive.preStopGracePeriodSeconds"))
+		}
+	},
+		Entry("should accept the default grace period", nil, true),
+		Entry("should accept no grace period", pointer.P(int64(0)), true),
+		Entry("should reject a negative grace period", pointer.P(int64(-1)), false),
+	)
+
+	DescribeTable("should validate the autoscaling", func(autoscaling *poolv1.VirtualMachinePoolAutoscaling, featureGates []string, expectedCauses ...string) {
+		config, _, _ := testutils.NewFakeClusterConfigUsingKVConfig(&virtv1.KubeVirtConfiguration{
+			DeveloperConfiguration: &virtv1.DeveloperConfiguration{FeatureGates: featureGates},
+		})
+		pool := newValidPool()
+		pool.Spec.Autoscaling = autoscaling
+		poolBytes, _ := json.Marshal(pool)
+
+		admitter := &VMPoolAdmitter{
+			ClusterConfig:           config,
+			KubeVirtServiceAccounts: webhooks.KubeVirtServiceAccounts(kubeVirtNamespace),
+		}
+		resp := admitter.Admit(context.Background(), &admissionv1.AdmissionReview{
+			Request: &admissionv1.AdmissionRequest{
+				Resource: webhooks.VirtualMachinePoolGroupVersionResource,
+				Object:   runtime.RawExtension{Raw: poolBytes},
+			},
+		})
+		if len(expectedCauses) == 0 {
+			Expect(resp.Allowed).To(BeTrue())
+			return
+		}
+		Expect(resp.Allowed).To(BeFalse())
+		Expect(resp.Result.Details.Causes).To(HaveLen(len(expectedCauses)))
+		for i, cause := range expectedCauses {
+			Expect(resp.Result.Details.Causes[i].Field).To(Equal(cause))
+		}
+	},
+		Entry("should accept targets within the replica limits", &poolv1.VirtualMachinePoolAutoscaling{
+			MinReplicas:                       pointer.P(int32(2)),
+			MaxReplicas:                       5,
+			TargetCPUUtilizationPercentage:    pointer.P(int32(70)),
+			TargetMemoryUtilizationPercentage: pointer.P(int32(80)),
+			ScaleInCooldownSeconds:            pointer.P(int32(0)),
+		}, []string{featuregate.VMPoolAutoscalingGate}),
+		Entry("should reject autoscaling without the feature gate", &poolv1.VirtualMachinePoolAutoscaling{
+			MaxReplicas:                    5,
+			TargetCPUUtilizationPercentage: pointer.P(int32(70)),
+		}, nil, "spec.autoscaling"),
+		Entry("should reject invalid replica limits", &poolv1.VirtualMachinePoolAutoscaling{
+			MinReplicas:                    pointer.P(int32(2)),
+			MaxReplicas:                    0,
+			TargetCPUUtilizationPercentage: pointer.P(int32(70)),
+		}, []string{featuregate.VMPoolAutoscalingGate}, "spec.autoscaling.maxReplicas", "spec.autoscaling.minReplicas"),
+		Entry("should reject minReplicas above maxReplicas", &poolv1.VirtualMachinePoolAutoscaling{
+			MinReplicas:                    pointer.P(int32(6)),
+			MaxReplicas:                    5,
+			TargetCPUUtilizationPercentage: pointer.P(int32(70)),
+		}, []string{featuregate.VMPoolAutoscalingGate}, "spec.autoscaling.minReplicas"),
+		Entry("should reject missing targets", &poolv1.VirtualMachinePoolAutoscaling{
+			MaxReplicas: 5,
+		}, []string{featuregate.VMPoolAutoscalingGate}, "spec.autoscaling"),
+		Entry("should reject targets out of range", &poolv1.VirtualMachinePoolAutoscaling{
+			MaxReplicas:                       5,
+			TargetCPUUtilizationPercentage:    pointer.P(int32(0)),
+			TargetMemoryUtilizationPercentage: pointer.P(int32(101)),
+		}, []string{featuregate.VMPoolAutoscalingGate}, "spec.autoscaling.targetCPUUtilizationPercentage", "spec.autoscaling.targetMemoryUtilizationPercentage"),
+		Entry("should reject a negative cooldown", &poolv1.VirtualMachinePoolAutoscaling{
+			MaxReplicas:                    5,
+			TargetCPUUtilizationPercentage: pointer.P(int32(70)),
+			ScaleOutCooldownSeconds:        pointer.P(int32(-1)),
+		}, []string{featuregate.VMPoolAutoscalingGate}, "spec.autoscaling.scaleOutCooldownSeconds"),
+	)
 })
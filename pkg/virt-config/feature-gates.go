@@ -94,6 +94,30 @@ func (config *ClusterConfig) HotplugVolumesEnabled() bool {
 	return config.isFeatureGateEnabled(featuregate.HotplugVolumesGate)
 }
 
+func (config *ClusterConfig) IncrementalBackupEnabled() bool {
+	return config.isFeatureGateEnabled(featuregate.IncrementalBackupGate)
+}
+
+func (config *ClusterConfig) MemorySnapshotEnabled() bool {
+	return config.isFeatureGateEnabled(featuregate.MemorySnapshotGate)
+}
+
+func (config *ClusterConfig) CrossNamespaceRestoreEnabled() bool {
+	return config.isFeatureGateEnabled(featuregate.CrossNamespaceRestoreGate)
+}
+
+func (config *ClusterConfig) VMPoolAutoscalingEnabled() bool {
+	return config.isFeatureGateEnabled(featuregate.VMPoolAutoscalingGate)
+}
+
+func (config *ClusterConfig) CrossClusterLiveMigrationEnabled() bool {
+	return config.isFeatureGateEnabled(featuregate.CrossClusterLiveMigrationGate)
+}
+
+func (config *ClusterConfig) VMIEndpointsEnabled() bool {
+	return config.isFeatureGateEnabled(featuregate.VMIEndpointsGate)
+}
+
 func (config *ClusterConfig) HostDiskEnabled() bool {
 	return config.isFeatureGateEnabled(featuregate.HostDiskGate)
 }
@@ -67,6 +67,42 @@ const (
 
 	VirtIOFSConfigVolumesGate = "EnableVirtioFsConfigVolumes"
 	VirtIOFSStorageVolumeGate = "EnableVirtioFsStorageVolumes"
+
+	// Alpha: v1.6.0
+	//
+	// IncrementalBackup allows to take full and incremental backups of running VMs using
+	// libvirt checkpoints and QEMU dirty bitmaps, the backups are written to a hotplugged PVC.
+	IncrementalBackupGate = "IncrementalBackup"
+
+	// Alpha: v1.6.0
+	//
+	// MemorySnapshot allows VirtualMachineSnapshots to save the guest memory and device state,
+	// so that restored VMs resume where they were snapshotted.
+	MemorySnapshotGate = "MemorySnapshot"
+
+	// Alpha: v1.6.0
+	//
+	// CrossNamespaceRestore allows VirtualMachineRestores to restore VirtualMachineSnapshots of other
+	// namespaces and to import VirtualMachineExports of other clusters.
+	CrossNamespaceRestoreGate = "CrossNamespaceRestore"
+
+	// Alpha: v1.6.0
+	//
+	// VMPoolAutoscaling allows VirtualMachinePools to autoscale on the load of their guests,
+	// which virt-handler reports in the status of their VMIs.
+	VMPoolAutoscalingGate = "VMPoolAutoscaling"
+
+	// Alpha: v1.6.0
+	//
+	// CrossClusterLiveMigration allows to live migrate VMIs between KubeVirt clusters
+	// through a tunnelled migration endpoint exposed by the receiving cluster.
+	CrossClusterLiveMigrationGate = "CrossClusterLiveMigration"
+
+	// Alpha: v1.6.0
+	//
+	// VMIEndpoints allows virt-controller to publish the EndpointSlices of annotated Services
+	// from the readiness of the VMIs backing them.
+	VMIEndpointsGate = "VMIEndpoints"
 )
 
 func init() {
@@ -95,4 +131,10 @@ func init() {
 	RegisterFeatureGate(FeatureGate{Name: InstancetypeReferencePolicy, State: Alpha})
 	RegisterFeatureGate(FeatureGate{Name: VirtIOFSConfigVolumesGate, State: Alpha})
 	RegisterFeatureGate(FeatureGate{Name: VirtIOFSStorageVolumeGate, State: Alpha})
+	RegisterFeatureGate(FeatureGate{Name: IncrementalBackupGate, State: Alpha})
+	RegisterFeatureGate(FeatureGate{Name: MemorySnapshotGate, State: Alpha})
+	RegisterFeatureGate(FeatureGate{Name: CrossNamespaceRestoreGate, State: Alpha})
+	RegisterFeatureGate(FeatureGate{Name: VMPoolAutoscalingGate, State: Alpha})
+	RegisterFeatureGate(FeatureGate{Name: CrossClusterLiveMigrationGate, State: Alpha})
+	RegisterFeatureGate(FeatureGate{Name: VMIEndpointsGate, State: Alpha})
 }
@@ -426,6 +426,10 @@ func (c *ClusterConfig) GetVMStateStorageClass() string {
 	return c.GetConfig().VMStateStorageClass
 }
 
+func (c *ClusterConfig) GetVMRestoreImportAllowedHosts() []string {
+	return c.GetConfig().VMRestoreImportAllowedHosts
+}
+
 func (c *ClusterConfig) IsFreePageReportingDisabled() bool {
 	return c.GetConfig().VirtualMachineOptions != nil && c.GetConfig().VirtualMachineOptions.DisableFreePageReporting != nil
 }
@@ -432,6 +432,27 @@ func withBackendStorage(vmi *v1.VirtualMachineInstance, backendStoragePVCName st
 	}
 }
 
+func withMemoryState(claimName string) VolumeRendererOption {
+	return func(renderer *VolumeRenderer) error {
+		volumeName := "memory-state"
+		renderer.podVolumes = append(renderer.podVolumes, k8sv1.Volume{
+			Name: volumeName,
+			VolumeSource: k8sv1.VolumeSource{
+				PersistentVolumeClaim: &k8sv1.PersistentVolumeClaimVolumeSource{
+					ClaimName: claimName,
+					ReadOnly:  true,
+				},
+			},
+		})
+		renderer.podVolumeMounts = append(renderer.podVolumeMounts, k8sv1.VolumeMount{
+			Name:      volumeName,
+			ReadOnly:  true,
+			MountPath: util.MemoryStateDir,
+		})
+		return nil
+	}
+}
+
 func withSidecarVolumes(hookSidecars hooks.HookSidecarList) VolumeRendererOption {
 	return func(renderer *VolumeRenderer) error {
 		if len(hookSidecars) != 0 {
@@ -334,6 +334,44 @@ var _ = Describe("Container spec renderer", func() {
 			Expect(vsr.VolumeDevices()).To(BeEmpty())
 		})
 	})
+
+	Context("with memory state option", func() {
+		const (
+			memoryStateClaimName = "restored-memory-state"
+		)
+
+		BeforeEach(func() {
+			var err error
+			vsr, err = NewVolumeRenderer(namespace, ephemeralDisk, containerDisk, virtShareDir, withMemoryState(memoryStateClaimName))
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("should feature the default mount points plus the read-only memory state mount", func() {
+			Expect(vsr.Mounts()).To(ConsistOf(
+				append(
+					defaultVolumeMounts(),
+					k8sv1.VolumeMount{
+						Name:      "memory-state",
+						ReadOnly:  true,
+						MountPath: "/var/run/kubevirt-private/memory-state",
+					})))
+		})
+
+		It("should feature the default volumes plus the memory state volume", func() {
+			Expect(vsr.Volumes()).To(ConsistOf(
+				append(
+					defaultVolumes(),
+					k8sv1.Volume{
+						Name: "memory-state",
+						VolumeSource: k8sv1.VolumeSource{
+							PersistentVolumeClaim: &k8sv1.PersistentVolumeClaimVolumeSource{
+								ClaimName: memoryStateClaimName,
+								ReadOnly:  true,
+							},
+						},
+					})))
+		})
+	})
 })
 
 func vmiDiskPath(volumeName string) string {
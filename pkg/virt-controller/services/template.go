@@ -834,6 +834,11 @@ func (t *templateService) newVolumeRenderer(vmi *v1.VirtualMachineInstance, name
 		volumeOpts = append(volumeOpts, withVirioFS())
 	}
 
+	// the memory state is only needed to start the domain, migration targets don't need it
+	if claimName, exists := vmi.Annotations[v1.ResumeFromMemoryStateAnnotation]; exists && vmi.IsUnprocessed() {
+		volumeOpts = append(volumeOpts, withMemoryState(claimName))
+	}
+
 	volumeRenderer, err := NewVolumeRenderer(
 		namespace,
 		t.ephemeralDiskDir,
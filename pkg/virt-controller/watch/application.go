@@ -89,10 +89,12 @@ import (
 	"kubevirt.io/kubevirt/pkg/storage/snapshot"
 	"kubevirt.io/kubevirt/pkg/util"
 	virtconfig "kubevirt.io/kubevirt/pkg/virt-config"
+	"kubevirt.io/kubevirt/pkg/virt-config/featuregate"
 	"kubevirt.io/kubevirt/pkg/virt-controller/leaderelectionconfig"
 	"kubevirt.io/kubevirt/pkg/virt-controller/services"
 	"kubevirt.io/kubevirt/pkg/virt-controller/watch/drain/disruptionbudget"
 	"kubevirt.io/kubevirt/pkg/virt-controller/watch/drain/evacuation"
+	"kubevirt.io/kubevirt/pkg/virt-controller/watch/endpoints"
 	workloadupdater "kubevirt.io/kubevirt/pkg/virt-controller/watch/workload-updater"
 
 	netadmitter "kubevirt.io/kubevirt/pkg/network/admitter"
@@ -173,6 +175,10 @@ type VirtControllerApp struct {
 	poolController *pool.Controller
 	poolInformer   cache.SharedIndexInformer
 
+	endpointsController   *endpoints.Controller
+	serviceInformer       cache.SharedIndexInformer
+	endpointSliceInformer cache.SharedIndexInformer
+
 	vmController *vm.Controller
 	vmInformer   cache.SharedIndexInformer
 
@@ -195,6 +201,8 @@ type VirtControllerApp struct {
 	exportController             *export.VMExportController
 	snapshotController           *snapshot.VMSnapshotController
 	restoreController            *snapshot.VMRestoreController
+	snapshotScheduleController   *snapshot.VMSnapshotScheduleController
+	snapshotGroupController      *snapshot.VMSnapshotGroupController
 	vmExportInformer             cache.SharedIndexInformer
 	routeCache                   cache.Store
 	ingressCache                 cache.Store
@@ -202,6 +210,9 @@ type VirtControllerApp struct {
 	vmSnapshotInformer           cache.SharedIndexInformer
 	vmSnapshotContentInformer    cache.SharedIndexInformer
 	vmRestoreInformer            cache.SharedIndexInformer
+	vmSnapshotScheduleInformer   cache.SharedIndexInformer
+	vmSnapshotGroupInformer      cache.SharedIndexInformer
+	vmRestoreGroupInformer       cache.SharedIndexInformer
 	storageClassInformer         cache.SharedIndexInformer
 	allPodInformer               cache.SharedIndexInformer
 	resourceQuotaInformer        cache.SharedIndexInformer
@@ -238,6 +249,8 @@ type VirtControllerApp struct {
 
 	// indicates if controllers were started with or without CDI/DataVolume support
 	hasCDI bool
+
+	hasVMIEndpoints bool
 	// the channel used to trigger re-initialization.
 	reInitChan chan string
 
@@ -246,6 +259,7 @@ type VirtControllerApp struct {
 	vmiControllerThreads              int
 	rsControllerThreads               int
 	poolControllerThreads             int
+	endpointsControllerThreads        int
 	vmControllerThreads               int
 	migrationControllerThreads        int
 	evacuationControllerThreads       int
@@ -254,6 +268,8 @@ type VirtControllerApp struct {
 	exportControllerThreads           int
 	snapshotControllerThreads         int
 	restoreControllerThreads          int
+	snapshotScheduleControllerThreads int
+	snapshotGroupControllerThreads    int
 	snapshotControllerResyncPeriod    time.Duration
 	cloneControllerThreads            int
 
@@ -341,6 +357,7 @@ func Execute() {
 
 	app.reInitChan = make(chan string, 10)
 	app.hasCDI = app.clusterConfig.HasDataVolumeAPI()
+	app.hasVMIEndpoints = app.clusterConfig.VMIEndpointsEnabled()
 	app.clusterConfig.SetConfigModifiedCallback(app.configModificationCallback)
 	app.clusterConfig.SetConfigModifiedCallback(app.shouldChangeLogVerbosity)
 	app.clusterConfig.SetConfigModifiedCallback(app.shouldChangeRateLimiter)
@@ -368,6 +385,16 @@ func Execute() {
 	app.rsInformer = app.informerFactory.VMIReplicaSet()
 	app.poolInformer = app.informerFactory.VMPool()
 
+	if app.hasVMIEndpoints {
+		app.serviceInformer = app.informerFactory.Service()
+		app.endpointSliceInformer = app.informerFactory.ManagedEndpointSlice()
+	} else {
+		// Watching all Services of the cluster is only worth it when the
+		// endpoints controller has to serve them.
+		app.serviceInformer = app.informerFactory.DummyService()
+		app.endpointSliceInformer = app.informerFactory.DummyManagedEndpointSlice()
+	}
+
 	app.persistentVolumeClaimInformer = app.informerFactory.PersistentVolumeClaim()
 	app.persistentVolumeClaimCache = app.persistentVolumeClaimInformer.GetStore()
 
@@ -383,6 +410,9 @@ func Execute() {
 	app.vmSnapshotInformer = app.informerFactory.VirtualMachineSnapshot()
 	app.vmSnapshotContentInformer = app.informerFactory.VirtualMachineSnapshotContent()
 	app.vmRestoreInformer = app.informerFactory.VirtualMachineRestore()
+	app.vmSnapshotScheduleInformer = app.informerFactory.VirtualMachineSnapshotSchedule()
+	app.vmSnapshotGroupInformer = app.informerFactory.VirtualMachineSnapshotGroup()
+	app.vmRestoreGroupInformer = app.informerFactory.VirtualMachineRestoreGroup()
 	app.storageClassInformer = app.informerFactory.StorageClass()
 	app.caExportConfigMapInformer = app.informerFactory.KubeVirtExportCAConfigMap()
 	app.exportRouteConfigMapInformer = app.informerFactory.ExportRouteConfigMap()
@@ -455,11 +485,14 @@ func Execute() {
 	app.initCommon()
 	app.initReplicaSet()
 	app.initPool()
+	app.initEndpoints()
 	app.initVirtualMachines()
 	app.initDisruptionBudgetController()
 	app.initEvacuationController()
 	app.initSnapshotController()
 	app.initRestoreController()
+	app.initSnapshotScheduleController()
+	app.initSnapshotGroupController()
 	app.initExportController()
 	app.initWorkloadUpdaterController()
 	app.initCloneController()
@@ -481,6 +514,12 @@ func (vca *VirtControllerApp) configModificationCallback() {
 		}
 		vca.reInitChan <- "reinit"
 	}
+
+	newHasVMIEndpoints := vca.clusterConfig.VMIEndpointsEnabled()
+	if newHasVMIEndpoints != vca.hasVMIEndpoints {
+		log.Log.Infof("Reinitialize virt-controller, the %s feature gate has been toggled", featuregate.VMIEndpointsGate)
+		vca.reInitChan <- "reinit"
+	}
 }
 
 // Update virt-controller rate limiter
@@ -571,6 +610,7 @@ func (vca *VirtControllerApp) onStartedLeading() func(ctx context.Context) {
 		go vca.vmiController.Run(vca.vmiControllerThreads, stop)
 		go vca.rsController.Run(vca.rsControllerThreads, stop)
 		go vca.poolController.Run(vca.poolControllerThreads, stop)
+		go vca.endpointsController.Run(vca.endpointsControllerThreads, stop)
 		go vca.vmController.Run(vca.vmControllerThreads, stop)
 		go vca.migrationController.Run(vca.migrationControllerThreads, stop)
 		go func() {
@@ -583,6 +623,16 @@ func (vca *VirtControllerApp) onStartedLeading() func(ctx context.Context) {
 				log.Log.Warningf("error running the restore controller: %v", err)
 			}
 		}()
+		go func() {
+			if err := vca.snapshotScheduleController.Run(vca.snapshotScheduleControllerThreads, stop); err != nil {
+				log.Log.Warningf("error running the snapshot schedule controller: %v", err)
+			}
+		}()
+		go func() {
+			if err := vca.snapshotGroupController.Run(vca.snapshotGroupControllerThreads, stop); err != nil {
+				log.Log.Warningf("error running the snapshot group controller: %v", err)
+			}
+		}()
 		go func() {
 			if err := vca.exportController.Run(vca.exportControllerThreads, stop); err != nil {
 				log.Log.Warningf("error running the export controller: %v", err)
@@ -720,6 +770,7 @@ func (vca *VirtControllerApp) initPool() {
 		vca.vmInformer,
 		vca.poolInformer,
 		vca.controllerRevisionInformer,
+		vca.migrationInformer,
 		recorder,
 		controller.BurstReplicas)
 	if err != nil {
@@ -727,6 +778,18 @@ func (vca *VirtControllerApp) initPool() {
 	}
 }
 
+func (vca *VirtControllerApp) initEndpoints() {
+	var err error
+	vca.endpointsController, err = endpoints.NewController(vca.clientSet,
+		vca.serviceInformer,
+		vca.vmiInformer,
+		vca.vmInformer,
+		vca.endpointSliceInformer)
+	if err != nil {
+		panic(err)
+	}
+}
+
 func (vca *VirtControllerApp) initVirtualMachines() {
 	var err error
 	recorder := vca.newRecorder(k8sv1.NamespaceAll, "virtualmachine-controller")
@@ -820,6 +883,7 @@ func (vca *VirtControllerApp) initSnapshotController() {
 		Client:                    vca.clientSet,
 		VMSnapshotInformer:        vca.vmSnapshotInformer,
 		VMSnapshotContentInformer: vca.vmSnapshotContentInformer,
+		VMSnapshotGroupInformer:   vca.vmSnapshotGroupInformer,
 		VMInformer:                vca.vmInformer,
 		VMIInformer:               vca.vmiInformer,
 		StorageClassInformer:      vca.storageClassInformer,
@@ -852,12 +916,45 @@ func (vca *VirtControllerApp) initRestoreController() {
 		VolumeSnapshotProvider:    vca.snapshotController,
 		Recorder:                  recorder,
 		CRInformer:                vca.controllerRevisionInformer,
+		ClusterConfig:             vca.clusterConfig,
 	}
 	if err := vca.restoreController.Init(); err != nil {
 		panic(err)
 	}
 }
 
+func (vca *VirtControllerApp) initSnapshotScheduleController() {
+	recorder := vca.newRecorder(k8sv1.NamespaceAll, "snapshot-schedule-controller")
+	vca.snapshotScheduleController = &snapshot.VMSnapshotScheduleController{
+		Client:                     vca.clientSet,
+		VMSnapshotScheduleInformer: vca.vmSnapshotScheduleInformer,
+		VMSnapshotInformer:         vca.vmSnapshotInformer,
+		VMInformer:                 vca.vmInformer,
+		Recorder:                   recorder,
+	}
+	if err := vca.snapshotScheduleController.Init(); err != nil {
+		panic(err)
+	}
+}
+
+func (vca *VirtControllerApp) initSnapshotGroupController() {
+	recorder := vca.newRecorder(k8sv1.NamespaceAll, "snapshot-group-controller")
+	vca.snapshotGroupController = &snapshot.VMSnapshotGroupController{
+		Client:                    vca.clientSet,
+		VMSnapshotGroupInformer:   vca.vmSnapshotGroupInformer,
+		VMRestoreGroupInformer:    vca.vmRestoreGroupInformer,
+		VMSnapshotInformer:        vca.vmSnapshotInformer,
+		VMSnapshotContentInformer: vca.vmSnapshotContentInformer,
+		VMRestoreInformer:         vca.vmRestoreInformer,
+		VMInformer:                vca.vmInformer,
+		VMIInformer:               vca.vmiInformer,
+		Recorder:                  recorder,
+	}
+	if err := vca.snapshotGroupController.Init(); err != nil {
+		panic(err)
+	}
+}
+
 func (vca *VirtControllerApp) initExportController() {
 	recorder := vca.newRecorder(k8sv1.NamespaceAll, "export-controller")
 	vca.exportController = &export.VMExportController{
@@ -897,7 +994,7 @@ func (vca *VirtControllerApp) initCloneController() {
 	var err error
 	recorder := vca.newRecorder(k8sv1.NamespaceAll, "clone-controller")
 	vca.vmCloneController, err = clonecontroller.NewVmCloneController(
-		vca.clientSet, vca.vmCloneInformer, vca.vmSnapshotInformer, vca.vmRestoreInformer, vca.vmInformer, vca.vmSnapshotContentInformer, vca.persistentVolumeClaimInformer, recorder,
+		vca.clientSet, vca.vmCloneInformer, vca.vmSnapshotInformer, vca.vmRestoreInformer, vca.vmInformer, vca.vmSnapshotContentInformer, vca.persistentVolumeClaimInformer, vca.vmExportInformer, recorder,
 	)
 	if err != nil {
 		panic(err)
@@ -971,6 +1068,9 @@ func (vca *VirtControllerApp) AddFlags() {
 	flag.IntVar(&vca.poolControllerThreads, "pool-controller-threads", defaultControllerThreads,
 		"Number of goroutines to run for pool controller")
 
+	flag.IntVar(&vca.endpointsControllerThreads, "endpoints-controller-threads", defaultControllerThreads,
+		"Number of goroutines to run for endpoints controller")
+
 	flag.IntVar(&vca.vmControllerThreads, "vm-controller-threads", defaultControllerThreads,
 		"Number of goroutines to run for vm controller")
 
@@ -992,6 +1092,12 @@ func (vca *VirtControllerApp) AddFlags() {
 	flag.IntVar(&vca.restoreControllerThreads, "restore-controller-threads", defaultControllerThreads,
 		"Number of goroutines to run for restore controller")
 
+	flag.IntVar(&vca.snapshotScheduleControllerThreads, "snapshot-schedule-controller-threads", defaultControllerThreads,
+		"Number of goroutines to run for snapshot schedule controller")
+
+	flag.IntVar(&vca.snapshotGroupControllerThreads, "snapshot-group-controller-threads", defaultControllerThreads,
+		"Number of goroutines to run for snapshot group controller")
+
 	flag.IntVar(&vca.exportControllerThreads, "export-controller-threads", defaultControllerThreads,
 		"Number of goroutines to run for virtual machine export controller")
 
@@ -58,6 +58,7 @@ import (
 	"kubevirt.io/kubevirt/pkg/storage/snapshot"
 	"kubevirt.io/kubevirt/pkg/testutils"
 	virtconfig "kubevirt.io/kubevirt/pkg/virt-config"
+	"kubevirt.io/kubevirt/pkg/virt-config/featuregate"
 	"kubevirt.io/kubevirt/pkg/virt-controller/services"
 	clonecontroller "kubevirt.io/kubevirt/pkg/virt-controller/watch/clone"
 	"kubevirt.io/kubevirt/pkg/virt-controller/watch/drain/disruptionbudget"
@@ -114,6 +115,9 @@ var _ = Describe("Application", func() {
 		storageClassInformer, _ := testutils.NewFakeInformerFor(&storagev1.StorageClass{})
 		crdInformer, _ := testutils.NewFakeInformerFor(&extv1.CustomResourceDefinition{})
 		vmRestoreInformer, _ := testutils.NewFakeInformerFor(&snapshotv1.VirtualMachineRestore{})
+		vmSnapshotScheduleInformer, _ := testutils.NewFakeInformerFor(&snapshotv1.VirtualMachineSnapshotSchedule{})
+		vmSnapshotGroupInformer, _ := testutils.NewFakeInformerFor(&snapshotv1.VirtualMachineSnapshotGroup{})
+		vmRestoreGroupInformer, _ := testutils.NewFakeInformerFor(&snapshotv1.VirtualMachineRestoreGroup{})
 		vmExportInformer, _ := testutils.NewFakeInformerFor(&exportv1.VirtualMachineExport{})
 		configMapInformer, _ := testutils.NewFakeInformerFor(&k8sv1.ConfigMap{})
 		routeConfigMapInformer, _ := testutils.NewFakeInformerFor(&k8sv1.ConfigMap{})
@@ -189,6 +193,7 @@ var _ = Describe("Application", func() {
 			Client:                    virtClient,
 			VMSnapshotInformer:        vmSnapshotInformer,
 			VMSnapshotContentInformer: vmSnapshotContentInformer,
+			VMSnapshotGroupInformer:   vmSnapshotGroupInformer,
 			VMInformer:                vmInformer,
 			VMIInformer:               vmiInformer,
 			PodInformer:               podInformer,
@@ -214,6 +219,26 @@ var _ = Describe("Application", func() {
 			Recorder:                  recorder,
 		}
 		_ = app.restoreController.Init()
+		app.snapshotScheduleController = &snapshot.VMSnapshotScheduleController{
+			Client:                     virtClient,
+			VMSnapshotScheduleInformer: vmSnapshotScheduleInformer,
+			VMSnapshotInformer:         vmSnapshotInformer,
+			VMInformer:                 vmInformer,
+			Recorder:                   recorder,
+		}
+		_ = app.snapshotScheduleController.Init()
+		app.snapshotGroupController = &snapshot.VMSnapshotGroupController{
+			Client:                    virtClient,
+			VMSnapshotGroupInformer:   vmSnapshotGroupInformer,
+			VMRestoreGroupInformer:    vmRestoreGroupInformer,
+			VMSnapshotInformer:        vmSnapshotInformer,
+			VMSnapshotContentInformer: vmSnapshotContentInformer,
+			VMRestoreInformer:         vmRestoreInformer,
+			VMInformer:                vmInformer,
+			VMIInformer:               vmiInformer,
+			Recorder:                  recorder,
+		}
+		_ = app.snapshotGroupController.Init()
 		app.exportController = &export.VMExportController{
 			Client:                      virtClient,
 			ManifestRenderer:            services.NewTemplateService("a", 240, "b", "c", "d", "e", "f", pvcInformer.GetStore(), virtClient, config, qemuGid, "g", resourceQuotaInformer.GetStore(), namespaceInformer.GetStore()),
@@ -251,6 +276,7 @@ var _ = Describe("Application", func() {
 			vmInformer,
 			vmSnapshotContentInformer,
 			pvcInformer,
+			vmExportInformer,
 			recorder,
 		)
 
@@ -315,6 +341,40 @@ var _ = Describe("Application", func() {
 			Entry("not when nothing changed and cdi exists", true, true, false, false),
 			Entry("not when nothing changed and does not exist", false, false, true, false),
 		)
+
+		DescribeTable("Re-trigger initialization on the VMIEndpoints feature gate", func(enabledAtInit bool, enabled bool, expectReInit bool) {
+			var reInitTriggered bool
+
+			app := VirtControllerApp{}
+
+			kvConfig := &v1.KubeVirtConfiguration{}
+			if enabled {
+				kvConfig.DeveloperConfiguration = &v1.DeveloperConfiguration{
+					FeatureGates: []string{featuregate.VMIEndpointsGate},
+				}
+			}
+			clusterConfig, _, _ := testutils.NewFakeClusterConfigUsingKVConfig(kvConfig)
+			app.clusterConfig = clusterConfig
+			app.reInitChan = make(chan string, 10)
+			app.hasCDI = clusterConfig.HasDataVolumeAPI()
+			app.hasVMIEndpoints = enabledAtInit
+
+			app.clusterConfig.SetConfigModifiedCallback(app.configModificationCallback)
+
+			select {
+			case <-app.reInitChan:
+				reInitTriggered = true
+			case <-time.After(1 * time.Second):
+				reInitTriggered = false
+			}
+
+			Expect(reInitTriggered).To(Equal(expectReInit))
+		},
+			Entry("when the feature gate is enabled", false, true, true),
+			Entry("when the feature gate is disabled", true, false, true),
+			Entry("not when the feature gate stays enabled", true, true, false),
+			Entry("not when the feature gate stays disabled", false, false, false),
+		)
 	})
 
 	Describe("Readiness probe", func() {
@@ -28,6 +28,7 @@ import (
 	virtsnapshot "kubevirt.io/kubevirt/pkg/storage/snapshot"
 
 	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/types"
 
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/equality"
@@ -38,6 +39,7 @@ import (
 
 	clone "kubevirt.io/api/clone/v1beta1"
 	k6tv1 "kubevirt.io/api/core/v1"
+	exportv1 "kubevirt.io/api/export/v1beta1"
 	snapshotv1 "kubevirt.io/api/snapshot/v1beta1"
 	"kubevirt.io/client-go/log"
 )
@@ -52,8 +54,9 @@ const (
 type cloneTargetType string
 
 const (
-	targetTypeVM cloneTargetType = "VirtualMachine"
-	defaultType  cloneTargetType = targetTypeVM
+	targetTypeVM     cloneTargetType = "VirtualMachine"
+	targetTypeExport cloneTargetType = "VirtualMachineExport"
+	defaultType      cloneTargetType = targetTypeVM
 )
 
 type syncInfoType struct {
@@ -65,6 +68,8 @@ type syncInfoType struct {
 	targetVMName    string
 	targetVMCreated bool
 	pvcBound        bool
+	exportName      string
+	exportReady     bool
 
 	isCloneFailing bool
 	failEvent      Event
@@ -94,18 +99,18 @@ func (ctrl *VMCloneController) execute(key string) error {
 		vmClone = obj.(*clone.VirtualMachineClone)
 		logger = logger.Object(vmClone)
 	} else {
-		return nil
+		return ctrl.cleanupOrphanedRestores()
 	}
 
 	if vmClone.Status.Phase == clone.Succeeded {
-		_, vmExists, err := ctrl.vmStore.GetByKey(fmt.Sprintf("%s/%s", vmClone.Namespace, *vmClone.Status.TargetName))
+		targetExists, err := ctrl.targetExists(vmClone)
 		if err != nil {
 			return err
 		}
 
-		if !vmExists {
+		if !targetExists {
 			if vmClone.DeletionTimestamp == nil {
-				logger.V(3).Infof("Deleting vm clone for deleted vm %s/%s", vmClone.Namespace, *vmClone.Status.TargetName)
+				logger.V(3).Infof("Deleting vm clone for deleted target %s/%s", getTargetNamespace(vmClone), *vmClone.Status.TargetName)
 				return ctrl.client.VirtualMachineClone(vmClone.Namespace).Delete(context.Background(), vmClone.Name, v1.DeleteOptions{})
 			}
 			// nothing to process for a vm clone that's being deleted
@@ -136,8 +141,11 @@ func (ctrl *VMCloneController) sync(vmClone *clone.VirtualMachineClone) (syncInf
 		return syncInfoType{}, err
 	}
 
-	if ctrl.getTargetType(cloneInfo.vmClone) == targetTypeVM {
+	switch ctrl.getTargetType(cloneInfo.vmClone) {
+	case targetTypeVM:
 		return ctrl.syncTargetVM(cloneInfo), nil
+	case targetTypeExport:
+		return ctrl.syncTargetExport(cloneInfo), nil
 	}
 	return syncInfoType{err: fmt.Errorf("target type is unknown: %s", ctrl.getTargetType(cloneInfo.vmClone))}, nil
 }
@@ -222,7 +230,7 @@ func (ctrl *VMCloneController) syncTargetVM(vmCloneInfo *vmCloneInfo) syncInfoTy
 			return syncInfo
 		}
 
-		syncInfo = ctrl.verifyRestoreReady(vmClone, vmCloneInfo.vmClone.Namespace, syncInfo)
+		syncInfo = ctrl.verifyRestoreReady(vmClone, getTargetNamespace(vmClone), syncInfo)
 		if syncInfo.isFailingOrError() || !syncInfo.restoreReady {
 			return syncInfo
 		}
@@ -266,6 +274,45 @@ func (ctrl *VMCloneController) syncTargetVM(vmCloneInfo *vmCloneInfo) syncInfoTy
 	return syncInfo
 }
 
+// syncTargetExport exports a snapshot of the source, the VirtualMachineRestore
+// of a peer cluster importing the export creates the target there. The
+// snapshot is kept for the lifetime of the export.
+func (ctrl *VMCloneController) syncTargetExport(vmCloneInfo *vmCloneInfo) syncInfoType {
+	vmClone := vmCloneInfo.vmClone
+	syncInfo := syncInfoType{}
+
+	switch vmClone.Status.Phase {
+	case clone.PhaseUnset, clone.SnapshotInProgress:
+
+		if vmCloneInfo.sourceType == sourceTypeVM {
+			if vmClone.Status.SnapshotName == nil {
+				_, syncInfo = ctrl.createSnapshotFromVm(vmClone, vmCloneInfo.sourceVm, syncInfo)
+				return syncInfo
+			}
+		}
+
+		_, syncInfo = ctrl.verifySnapshotReady(vmClone, vmCloneInfo.snapshotName, vmClone.Namespace, syncInfo)
+		if syncInfo.isFailingOrError() || !syncInfo.snapshotReady {
+			return syncInfo
+		}
+
+		fallthrough
+
+	case clone.ExportInProgress:
+
+		if vmClone.Status.TargetName == nil {
+			return ctrl.createExport(vmClone, vmCloneInfo.snapshotName, syncInfo)
+		}
+
+		syncInfo = ctrl.verifyExportReady(vmClone, syncInfo)
+
+	default:
+		log.Log.Object(vmClone).Infof("clone %s is in phase %s - nothing to do", vmClone.Name, string(vmClone.Status.Phase))
+	}
+
+	return syncInfo
+}
+
 func (ctrl *VMCloneController) updateStatus(origClone *clone.VirtualMachineClone, syncInfo syncInfoType) error {
 	vmClone := origClone.DeepCopy()
 
@@ -298,7 +345,20 @@ func (ctrl *VMCloneController) updateStatus(origClone *clone.VirtualMachineClone
 		}
 
 		if syncInfo.snapshotReady {
-			assignPhase(clone.RestoreInProgress)
+			if ctrl.getTargetType(vmClone) == targetTypeExport {
+				assignPhase(clone.ExportInProgress)
+			} else {
+				assignPhase(clone.RestoreInProgress)
+			}
+		}
+	}
+	if isInPhase(vmClone, clone.ExportInProgress) {
+		if exportName := syncInfo.exportName; exportName != "" {
+			vmClone.Status.TargetName = pointer.P(exportName)
+		}
+
+		if syncInfo.exportReady {
+			assignPhase(clone.Succeeded)
 		}
 	}
 	if isInPhase(vmClone, clone.RestoreInProgress) {
@@ -418,7 +478,7 @@ func (ctrl *VMCloneController) createRestoreFromVm(vmClone *clone.VirtualMachine
 		syncInfo.setError(retErr)
 		return syncInfo
 	}
-	restore := generateRestore(vmClone.Spec.Target, vm.Name, vmClone.Namespace, vmClone.Name, snapshotName, vmClone.UID, patches)
+	restore := generateRestore(vmClone.Spec.Target, vm.Name, getTargetNamespace(vmClone), vmClone.Namespace, vmClone.Name, snapshotName, vmClone.UID, patches)
 	log.Log.Object(vmClone).Infof("creating restore %s for clone %s", restore.Name, vmClone.Name)
 	createdRestore, err := ctrl.client.VirtualMachineRestore(restore.Namespace).Create(context.Background(), restore, v1.CreateOptions{})
 	if err != nil {
@@ -442,6 +502,12 @@ func (ctrl *VMCloneController) createRestoreFromVm(vmClone *clone.VirtualMachine
 func (ctrl *VMCloneController) verifyRestoreReady(vmClone *clone.VirtualMachineClone, sourceNamespace string, syncInfo syncInfoType) syncInfoType {
 	obj, exists, err := ctrl.restoreStore.GetByKey(getKey(*vmClone.Status.RestoreName, sourceNamespace))
 	if !exists {
+		// The restore name is only recorded once the restore was created, it can still be
+		// missing from the cache, so ask the API server whether it was deleted
+		_, err = ctrl.client.VirtualMachineRestore(sourceNamespace).Get(context.Background(), *vmClone.Status.RestoreName, v1.GetOptions{})
+		if errors.IsNotFound(err) {
+			return ctrl.failMidRestore(vmClone, RestoreDeleted, fmt.Sprintf("restore %s does not exist anymore", *vmClone.Status.RestoreName), syncInfo)
+		}
 		syncInfo.setError(fmt.Errorf("restore %s is not created yet for clone %s", *vmClone.Status.RestoreName, vmClone.Name))
 		return syncInfo
 	} else if err != nil {
@@ -467,8 +533,16 @@ func (ctrl *VMCloneController) verifyRestoreReady(vmClone *clone.VirtualMachineC
 func (ctrl *VMCloneController) verifyVmReady(vmClone *clone.VirtualMachineClone, syncInfo syncInfoType) syncInfoType {
 	targetVMInfo := vmClone.Spec.Target
 
-	_, exists, err := ctrl.vmStore.GetByKey(getKey(targetVMInfo.Name, vmClone.Namespace))
+	_, exists, err := ctrl.vmStore.GetByKey(getKey(targetVMInfo.Name, getTargetNamespace(vmClone)))
 	if !exists {
+		// The restore completed in an earlier sync, so it already created the target,
+		// ask the API server whether it was deleted since
+		if isInPhase(vmClone, clone.CreatingTargetVM) {
+			_, err = ctrl.client.VirtualMachine(getTargetNamespace(vmClone)).Get(context.Background(), targetVMInfo.Name, v1.GetOptions{})
+			if errors.IsNotFound(err) {
+				return ctrl.failMidRestore(vmClone, TargetVMDeleted, fmt.Sprintf("target VM %s does not exist anymore", targetVMInfo.Name), syncInfo)
+			}
+		}
 		syncInfo.setError(fmt.Errorf("target VM %s is not created yet for clone %s", targetVMInfo.Name, vmClone.Name))
 		return syncInfo
 	} else if err != nil {
@@ -483,7 +557,8 @@ func (ctrl *VMCloneController) verifyVmReady(vmClone *clone.VirtualMachineClone,
 }
 
 func (ctrl *VMCloneController) verifyPVCBound(vmClone *clone.VirtualMachineClone, syncInfo syncInfoType) syncInfoType {
-	obj, exists, err := ctrl.restoreStore.GetByKey(getKey(*vmClone.Status.RestoreName, vmClone.Namespace))
+	targetNamespace := getTargetNamespace(vmClone)
+	obj, exists, err := ctrl.restoreStore.GetByKey(getKey(*vmClone.Status.RestoreName, targetNamespace))
 	if !exists {
 		syncInfo.setError(fmt.Errorf("restore %s is not created yet for clone %s", *vmClone.Status.RestoreName, vmClone.Name))
 		return syncInfo
@@ -494,7 +569,7 @@ func (ctrl *VMCloneController) verifyPVCBound(vmClone *clone.VirtualMachineClone
 
 	restore := obj.(*snapshotv1.VirtualMachineRestore)
 	for _, volumeRestore := range restore.Status.Restores {
-		obj, exists, err = ctrl.pvcStore.GetByKey(getKey(volumeRestore.PersistentVolumeClaimName, vmClone.Namespace))
+		obj, exists, err = ctrl.pvcStore.GetByKey(getKey(volumeRestore.PersistentVolumeClaimName, targetNamespace))
 		if !exists {
 			syncInfo.setError(fmt.Errorf("PVC %s is not created yet for clone %s", volumeRestore.PersistentVolumeClaimName, vmClone.Name))
 			return syncInfo
@@ -528,7 +603,7 @@ func (ctrl *VMCloneController) cleanupSnapshot(vmClone *clone.VirtualMachineClon
 }
 
 func (ctrl *VMCloneController) cleanupRestore(vmClone *clone.VirtualMachineClone, syncInfo syncInfoType) syncInfoType {
-	err := ctrl.client.VirtualMachineRestore(vmClone.Namespace).Delete(context.Background(), *vmClone.Status.RestoreName, v1.DeleteOptions{})
+	err := ctrl.client.VirtualMachineRestore(getTargetNamespace(vmClone)).Delete(context.Background(), *vmClone.Status.RestoreName, v1.DeleteOptions{})
 	if err != nil && !errors.IsNotFound(err) {
 		syncInfo.setError(fmt.Errorf("cannot clean up restore %s for clone %s", *vmClone.Status.RestoreName, vmClone.Name))
 		return syncInfo
@@ -537,6 +612,127 @@ func (ctrl *VMCloneController) cleanupRestore(vmClone *clone.VirtualMachineClone
 	return syncInfo
 }
 
+// failMidRestore fails a clone whose restore or target VM was deleted before the clone
+// succeeded, and cleans up what the restore left behind
+func (ctrl *VMCloneController) failMidRestore(vmClone *clone.VirtualMachineClone, event Event, reason string, syncInfo syncInfoType) syncInfoType {
+	syncInfo = ctrl.cleanupRestore(vmClone, syncInfo)
+	if syncInfo.isFailingOrError() {
+		return syncInfo
+	}
+	if err := ctrl.cleanupRestorePVCs(getTargetNamespace(vmClone), vmClone.Spec.Target.Name, vmClone.UID); err != nil {
+		syncInfo.setError(fmt.Errorf("cannot clean up restored PVCs for clone %s: %v", vmClone.Name, err))
+		return syncInfo
+	}
+
+	syncInfo.isCloneFailing = true
+	syncInfo.failEvent = event
+	syncInfo.failReason = reason
+	return syncInfo
+}
+
+// cleanupRestorePVCs deletes the PVCs restored for a clone, found by the label the restore
+// passes on to them. Once the target VM exists the PVCs are its volumes and are kept.
+func (ctrl *VMCloneController) cleanupRestorePVCs(namespace, targetName string, cloneUID types.UID) error {
+	_, targetExists, err := ctrl.vmStore.GetByKey(getKey(targetName, namespace))
+	if err != nil || targetExists {
+		return err
+	}
+
+	pvcs, err := ctrl.client.CoreV1().PersistentVolumeClaims(namespace).List(context.Background(), v1.ListOptions{
+		LabelSelector: fmt.Sprintf("%s=%s", virtsnapshot.RestoreCloneUIDLabel, cloneUID),
+	})
+	if err != nil {
+		return err
+	}
+	for _, pvc := range pvcs.Items {
+		err = ctrl.client.CoreV1().PersistentVolumeClaims(pvc.Namespace).Delete(context.Background(), pvc.Name, v1.DeleteOptions{})
+		if err != nil && !errors.IsNotFound(err) {
+			return err
+		}
+		log.Log.Object(&pvc).Infof("deleted restored PVC %s of deleted clone %s", pvc.Name, cloneUID)
+	}
+	return nil
+}
+
+// cleanupOrphanedRestores deletes the restores of clones that no longer exist, together with
+// the PVCs of restores that did not complete. Restores in another namespace than their clone
+// cannot be owned by it, the label with the UID of the clone is what finds them.
+func (ctrl *VMCloneController) cleanupOrphanedRestores() error {
+	cloneUIDs := map[types.UID]struct{}{}
+	for _, obj := range ctrl.vmCloneIndexer.List() {
+		cloneUIDs[obj.(*clone.VirtualMachineClone).UID] = struct{}{}
+	}
+
+	for _, obj := range ctrl.restoreStore.List() {
+		restore := obj.(*snapshotv1.VirtualMachineRestore)
+		cloneUID, isCloneRestore := restore.Labels[virtsnapshot.RestoreCloneUIDLabel]
+		if !isCloneRestore {
+			continue
+		}
+		if _, cloneExists := cloneUIDs[types.UID(cloneUID)]; cloneExists {
+			continue
+		}
+
+		if restore.DeletionTimestamp == nil {
+			err := ctrl.client.VirtualMachineRestore(restore.Namespace).Delete(context.Background(), restore.Name, v1.DeleteOptions{})
+			if err != nil && !errors.IsNotFound(err) {
+				return fmt.Errorf("cannot clean up restore %s of deleted clone %s: %v", restore.Name, cloneUID, err)
+			}
+			log.Log.Object(restore).Infof("deleted restore %s of deleted clone %s", restore.Name, cloneUID)
+		}
+		if restore.Status == nil || restore.Status.Complete == nil || !*restore.Status.Complete {
+			if err := ctrl.cleanupRestorePVCs(restore.Namespace, restore.Spec.Target.Name, types.UID(cloneUID)); err != nil {
+				return fmt.Errorf("cannot clean up restored PVCs of deleted clone %s: %v", cloneUID, err)
+			}
+		}
+	}
+	return nil
+}
+
+func (ctrl *VMCloneController) createExport(vmClone *clone.VirtualMachineClone, snapshotName string, syncInfo syncInfoType) syncInfoType {
+	export := generateExport(vmClone, snapshotName)
+	log.Log.Object(vmClone).Infof("creating export %s for clone %s", export.Name, vmClone.Name)
+
+	_, err := ctrl.client.VirtualMachineExport(export.Namespace).Create(context.Background(), export, v1.CreateOptions{})
+	if err != nil {
+		if !errors.IsAlreadyExists(err) {
+			syncInfo.setError(fmt.Errorf("failed creating export %s for clone %s: %v", export.Name, vmClone.Name, err))
+			return syncInfo
+		}
+		syncInfo.exportName = export.Name
+		return syncInfo
+	}
+
+	ctrl.logAndRecord(vmClone, ExportCreated, fmt.Sprintf("created export %s for clone %s", export.Name, vmClone.Name))
+	syncInfo.exportName = export.Name
+
+	return syncInfo
+}
+
+func (ctrl *VMCloneController) verifyExportReady(vmClone *clone.VirtualMachineClone, syncInfo syncInfoType) syncInfoType {
+	exportName := *vmClone.Status.TargetName
+
+	obj, exists, err := ctrl.exportStore.GetByKey(getKey(exportName, vmClone.Namespace))
+	if err != nil {
+		syncInfo.setError(fmt.Errorf("error getting export %s from cache for clone %s: %v", exportName, vmClone.Name, err))
+		return syncInfo
+	} else if !exists {
+		syncInfo.setError(fmt.Errorf("export %s is not created yet for clone %s", exportName, vmClone.Name))
+		return syncInfo
+	}
+
+	export := obj.(*exportv1.VirtualMachineExport)
+	if export.Status == nil || export.Status.Phase != exportv1.Ready {
+		log.Log.Object(vmClone).V(defaultVerbosityLevel).Infof("export %s for clone %s is not ready yet", exportName, vmClone.Name)
+		return syncInfo
+	}
+
+	ctrl.logAndRecord(vmClone, ExportReady, fmt.Sprintf("export %s for clone %s is ready", exportName, vmClone.Name))
+	syncInfo.exportReady = true
+
+	return syncInfo
+}
+
 func (ctrl *VMCloneController) logAndRecord(vmClone *clone.VirtualMachineClone, event Event, msg string) {
 	ctrl.recorder.Eventf(vmClone, corev1.EventTypeNormal, string(event), msg)
 	log.Log.Object(vmClone).Infof(msg)
@@ -550,6 +746,16 @@ func (ctrl *VMCloneController) getTargetType(vmClone *clone.VirtualMachineClone)
 	}
 }
 
+func (ctrl *VMCloneController) targetExists(vmClone *clone.VirtualMachineClone) (bool, error) {
+	store := ctrl.vmStore
+	if ctrl.getTargetType(vmClone) == targetTypeExport {
+		store = ctrl.exportStore
+	}
+
+	_, exists, err := store.GetByKey(getKey(*vmClone.Status.TargetName, getTargetNamespace(vmClone)))
+	return exists, err
+}
+
 func (ctrl *VMCloneController) getSource(vmClone *clone.VirtualMachineClone, name, namespace, sourceKind string, store cache.Store) (interface{}, error) {
 	key := getKey(name, namespace)
 	obj, exists, err := store.GetByKey(key)
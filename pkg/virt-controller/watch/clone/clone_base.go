@@ -14,6 +14,7 @@ import (
 	clonebase "kubevirt.io/api/clone"
 	clone "kubevirt.io/api/clone/v1beta1"
 	virtv1 "kubevirt.io/api/core/v1"
+	exportv1 "kubevirt.io/api/export/v1beta1"
 	snapshotv1 "kubevirt.io/api/snapshot/v1beta1"
 	"kubevirt.io/client-go/kubecli"
 	"kubevirt.io/client-go/log"
@@ -34,9 +35,13 @@ const (
 	RestoreReady          Event = "RestoreReady"
 	TargetVMCreated       Event = "TargetVMCreated"
 	PVCBound              Event = "PVCBound"
+	ExportCreated         Event = "ExportCreated"
+	ExportReady           Event = "ExportReady"
 
 	SnapshotDeleted    Event = "SnapshotDeleted"
 	SourceDoesNotExist Event = "SourceDoesNotExist"
+	RestoreDeleted     Event = "RestoreDeleted"
+	TargetVMDeleted    Event = "TargetVMDeleted"
 )
 
 type VMCloneController struct {
@@ -47,13 +52,14 @@ type VMCloneController struct {
 	vmStore              cache.Store
 	snapshotContentStore cache.Store
 	pvcStore             cache.Store
+	exportStore          cache.Store
 	recorder             record.EventRecorder
 
 	vmCloneQueue workqueue.TypedRateLimitingInterface[string]
 	hasSynced    func() bool
 }
 
-func NewVmCloneController(client kubecli.KubevirtClient, vmCloneInformer, snapshotInformer, restoreInformer, vmInformer, snapshotContentInformer, pvcInformer cache.SharedIndexInformer, recorder record.EventRecorder) (*VMCloneController, error) {
+func NewVmCloneController(client kubecli.KubevirtClient, vmCloneInformer, snapshotInformer, restoreInformer, vmInformer, snapshotContentInformer, pvcInformer, exportInformer cache.SharedIndexInformer, recorder record.EventRecorder) (*VMCloneController, error) {
 	ctrl := VMCloneController{
 		client:               client,
 		vmCloneIndexer:       vmCloneInformer.GetIndexer(),
@@ -62,6 +68,7 @@ func NewVmCloneController(client kubecli.KubevirtClient, vmCloneInformer, snapsh
 		vmStore:              vmInformer.GetStore(),
 		snapshotContentStore: snapshotContentInformer.GetStore(),
 		pvcStore:             pvcInformer.GetStore(),
+		exportStore:          exportInformer.GetStore(),
 		recorder:             recorder,
 		vmCloneQueue: workqueue.NewTypedRateLimitingQueueWithConfig[string](
 			workqueue.DefaultTypedControllerRateLimiter[string](),
@@ -71,7 +78,7 @@ func NewVmCloneController(client kubecli.KubevirtClient, vmCloneInformer, snapsh
 
 	ctrl.hasSynced = func() bool {
 		return vmCloneInformer.HasSynced() && snapshotInformer.HasSynced() && restoreInformer.HasSynced() &&
-			vmInformer.HasSynced() && snapshotInformer.HasSynced() && pvcInformer.HasSynced()
+			vmInformer.HasSynced() && snapshotInformer.HasSynced() && pvcInformer.HasSynced() && exportInformer.HasSynced()
 	}
 
 	_, err := vmCloneInformer.AddEventHandler(
@@ -122,6 +129,18 @@ func NewVmCloneController(client kubecli.KubevirtClient, vmCloneInformer, snapsh
 		return nil, err
 	}
 
+	_, err = exportInformer.AddEventHandler(
+		cache.ResourceEventHandlerFuncs{
+			AddFunc:    ctrl.handleExport,
+			UpdateFunc: func(oldObj, newObj interface{}) { ctrl.handleExport(newObj) },
+			DeleteFunc: ctrl.handleExport,
+		},
+	)
+
+	if err != nil {
+		return nil, err
+	}
+
 	_, err = vmInformer.AddEventHandler(
 		cache.ResourceEventHandlerFuncs{
 			DeleteFunc: ctrl.handleDeleteVM,
@@ -262,6 +281,22 @@ func (ctrl *VMCloneController) handlePVC(obj interface{}) {
 	}
 }
 
+func (ctrl *VMCloneController) handleExport(obj interface{}) {
+	if unknown, ok := obj.(cache.DeletedFinalStateUnknown); ok && unknown.Obj != nil {
+		obj = unknown.Obj
+	}
+
+	export, ok := obj.(*exportv1.VirtualMachineExport)
+	if !ok {
+		log.Log.Errorf(unknownTypeErrFmt, "virtualmachineexport")
+		return
+	}
+
+	if ownedByClone, key := isOwnedByClone(export); ownedByClone {
+		ctrl.vmCloneQueue.AddRateLimited(key)
+	}
+}
+
 func (ctrl *VMCloneController) handleDeleteVM(obj interface{}) {
 	vm, ok := obj.(*virtv1.VirtualMachine)
 	// When a delete is dropped, the relist will notice a vm in the store not
@@ -351,8 +386,10 @@ func (ctrl *VMCloneController) listVmCloneMatchingVM(namespace, name string) ([]
 	})
 }
 
+// filterVmClone returns the clones with a target in the namespace, which can
+// be another namespace than the one of the clone
 func (ctrl *VMCloneController) filterVmClone(namespace string, filter func(*clone.VirtualMachineClone) bool) ([]*clone.VirtualMachineClone, error) {
-	objs, err := ctrl.vmCloneIndexer.ByIndex(cache.NamespaceIndex, namespace)
+	objs, err := ctrl.vmCloneIndexer.ByIndex("targetNamespace", namespace)
 	if err != nil {
 		return nil, err
 	}
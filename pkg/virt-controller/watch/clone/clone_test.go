@@ -39,6 +39,7 @@ import (
 
 	clone "kubevirt.io/api/clone/v1beta1"
 	virtv1 "kubevirt.io/api/core/v1"
+	exportv1 "kubevirt.io/api/export/v1beta1"
 	snapshotv1 "kubevirt.io/api/snapshot/v1beta1"
 	"kubevirt.io/client-go/kubecli"
 	kubevirtfake "kubevirt.io/client-go/kubevirt/fake"
@@ -48,6 +49,7 @@ import (
 	controllertesting "kubevirt.io/kubevirt/pkg/controller/testing"
 	"kubevirt.io/kubevirt/pkg/libvmi"
 	"kubevirt.io/kubevirt/pkg/pointer"
+	virtsnapshot "kubevirt.io/kubevirt/pkg/storage/snapshot"
 	"kubevirt.io/kubevirt/pkg/testutils"
 )
 
@@ -59,6 +61,8 @@ const (
 	testSnapshotName        = "tmp-snapshot-clone-uid"
 	testSnapshotContentName = "vmsnapshot-content-snapshot-UID"
 	testRestoreName         = "tmp-restore-clone-uid"
+	testExportName          = "clone-export-clone-uid"
+	testTargetNamespace     = "target"
 )
 
 var _ = Describe("Clone", func() {
@@ -109,6 +113,14 @@ var _ = Describe("Clone", func() {
 		Expect(err).ToNot(HaveOccurred())
 	}
 
+	addExport := func(export *exportv1.VirtualMachineExport) {
+		var err error
+		export, err = client.ExportV1beta1().VirtualMachineExports(metav1.NamespaceDefault).Create(context.TODO(), export, metav1.CreateOptions{})
+		Expect(err).ToNot(HaveOccurred())
+		err = controller.exportStore.Add(export)
+		Expect(err).ToNot(HaveOccurred())
+	}
+
 	addPVC := func(pvc *k8sv1.PersistentVolumeClaim) {
 		err := controller.pvcStore.Add(pvc)
 		Expect(err).ShouldNot(HaveOccurred())
@@ -211,9 +223,10 @@ var _ = Describe("Clone", func() {
 		vmInformer, _ := testutils.NewFakeInformerFor(&virtv1.VirtualMachine{})
 		snapshotInformer, _ := testutils.NewFakeInformerFor(&snapshotv1.VirtualMachineSnapshot{})
 		restoreInformer, _ := testutils.NewFakeInformerFor(&snapshotv1.VirtualMachineRestore{})
-		cloneInformer, _ := testutils.NewFakeInformerFor(&clone.VirtualMachineClone{})
+		cloneInformer, _ := testutils.NewFakeInformerWithIndexersFor(&clone.VirtualMachineClone{}, kvcontroller.GetVirtualMachineCloneInformerIndexers())
 		snapshotContentInformer, _ := testutils.NewFakeInformerFor(&snapshotv1.VirtualMachineSnapshotContent{})
 		pvcInformer, _ := testutils.NewFakeInformerFor(&k8sv1.PersistentVolumeClaim{})
+		exportInformer, _ := testutils.NewFakeInformerFor(&exportv1.VirtualMachineExport{})
 
 		recorder = record.NewFakeRecorder(100)
 		recorder.IncludeObject = true
@@ -226,6 +239,7 @@ var _ = Describe("Clone", func() {
 			vmInformer,
 			snapshotContentInformer,
 			pvcInformer,
+			exportInformer,
 			recorder)
 		mockQueue = testutils.NewMockWorkQueue(controller.vmCloneQueue)
 		controller.vmCloneQueue = mockQueue
@@ -238,6 +252,8 @@ var _ = Describe("Clone", func() {
 		virtClient.EXPECT().VirtualMachineSnapshot(metav1.NamespaceDefault).Return(client.SnapshotV1beta1().VirtualMachineSnapshots(metav1.NamespaceDefault)).AnyTimes()
 		virtClient.EXPECT().VirtualMachineRestore(metav1.NamespaceDefault).Return(client.SnapshotV1beta1().VirtualMachineRestores(metav1.NamespaceDefault)).AnyTimes()
 		virtClient.EXPECT().VirtualMachineSnapshotContent(metav1.NamespaceDefault).Return(client.SnapshotV1beta1().VirtualMachineSnapshotContents(metav1.NamespaceDefault)).AnyTimes()
+		virtClient.EXPECT().VirtualMachineRestore(testTargetNamespace).Return(client.SnapshotV1beta1().VirtualMachineRestores(testTargetNamespace)).AnyTimes()
+		virtClient.EXPECT().VirtualMachineExport(metav1.NamespaceDefault).Return(client.ExportV1beta1().VirtualMachineExports(metav1.NamespaceDefault)).AnyTimes()
 
 		k8sClient = k8sfake.NewSimpleClientset()
 		k8sClient.Fake.PrependReactor("*", "*", func(action testing.Action) (handled bool, obj runtime.Object, err error) {
@@ -245,12 +261,30 @@ var _ = Describe("Clone", func() {
 			return true, nil, nil
 		})
 		virtClient.EXPECT().AppsV1().Return(k8sClient.AppsV1()).AnyTimes()
+		virtClient.EXPECT().CoreV1().Return(k8sClient.CoreV1()).AnyTimes()
+		virtClient.EXPECT().VirtualMachine(metav1.NamespaceDefault).Return(client.KubevirtV1().VirtualMachines(metav1.NamespaceDefault)).AnyTimes()
 	})
 
+	addRestorePVC := func(namespace string) *k8sv1.PersistentVolumeClaim {
+		pvc := createPVC(namespace, k8sv1.ClaimPending)
+		pvc.Labels = map[string]string{virtsnapshot.RestoreCloneUIDLabel: testCloneUID}
+		Expect(k8sClient.Tracker().Add(pvc)).To(Succeed())
+		pvcReaction := testing.ObjectReaction(k8sClient.Tracker())
+		k8sClient.Fake.PrependReactor("*", "persistentvolumeclaims", func(action testing.Action) (handled bool, obj runtime.Object, err error) {
+			return pvcReaction(action)
+		})
+		return pvc
+	}
+
+	expectPVCDeleted := func(pvc *k8sv1.PersistentVolumeClaim) {
+		_, err := k8sClient.Tracker().Get(k8sv1.SchemeGroupVersion.WithResource("persistentvolumeclaims"), pvc.Namespace, pvc.Name)
+		Expect(err).To(MatchError(errors.IsNotFound, "k8serrors.IsNotFound"))
+	}
+
 	sanityExecute := func() {
 		controllertesting.SanityExecute(controller, []cache.Store{
 			controller.vmCloneIndexer, controller.snapshotContentStore, controller.restoreStore, controller.vmStore,
-			controller.snapshotContentStore, controller.pvcStore,
+			controller.snapshotContentStore, controller.pvcStore, controller.exportStore,
 		}, Default)
 	}
 
@@ -379,6 +413,11 @@ var _ = Describe("Clone", func() {
 				})
 
 				It("and the target VM is not ready - should do nothing", func() {
+					targetVM := sourceVM.DeepCopy()
+					targetVM.Name = vmClone.Spec.Target.Name
+					_, err := client.KubevirtV1().VirtualMachines(targetVM.Namespace).Create(context.TODO(), targetVM, metav1.CreateOptions{})
+					Expect(err).ToNot(HaveOccurred())
+
 					addVM(sourceVM)
 					addClone(vmClone)
 					addSnapshot(snapshot)
@@ -389,6 +428,20 @@ var _ = Describe("Clone", func() {
 					expectCloneBeInPhase(clone.CreatingTargetVM)
 				})
 
+				It("and the target VM was deleted - should fail and clean up the restore and its PVCs", func() {
+					addVM(sourceVM)
+					addClone(vmClone)
+					addSnapshot(snapshot)
+					addRestore(restore)
+					pvc := addRestorePVC(metav1.NamespaceDefault)
+
+					sanityExecute()
+					expectEvent(TargetVMDeleted)
+					expectCloneBeInPhase(clone.Failed)
+					expectRestoreDoesNotExist()
+					expectPVCDeleted(pvc)
+				})
+
 				It("and the target VM ready should move to Succeeded phase", func() {
 					targetVM := sourceVM.DeepCopy()
 					targetVM.Name = vmClone.Spec.Target.Name
@@ -608,6 +661,215 @@ var _ = Describe("Clone", func() {
 				expectCloneBeInPhase(clone.RestoreInProgress)
 			})
 		})
+
+		Context("with a target in another namespace", func() {
+			var snapshot *snapshotv1.VirtualMachineSnapshot
+
+			BeforeEach(func() {
+				vmClone.Spec.TargetNamespace = testTargetNamespace
+
+				snapshot = createVirtualMachineSnapshot(sourceVM)
+				snapshot.Status.ReadyToUse = pointer.P(true)
+				vmClone.Status.SnapshotName = pointer.P(snapshot.Name)
+			})
+
+			It("when snapshot is ready - should create the restore in the target namespace", func() {
+				vmClone.Status.Phase = clone.SnapshotInProgress
+
+				addVM(sourceVM)
+				addClone(vmClone)
+				addSnapshot(snapshot)
+				addSnapshotContent(createVirtualMachineSnapshotContent(sourceVM))
+
+				sanityExecute()
+				expectEvent(SnapshotReady)
+				expectEvent(RestoreCreated)
+				expectCloneBeInPhase(clone.RestoreInProgress)
+
+				vmRestore, err := client.SnapshotV1beta1().VirtualMachineRestores(testTargetNamespace).Get(context.TODO(), testRestoreName, metav1.GetOptions{})
+				Expect(err).ToNot(HaveOccurred())
+				Expect(vmRestore.Spec.VirtualMachineSnapshotName).To(Equal(testSnapshotName))
+				Expect(vmRestore.Spec.VirtualMachineSnapshotNamespace).To(Equal(metav1.NamespaceDefault))
+				Expect(vmRestore.OwnerReferences).To(BeEmpty())
+				Expect(vmRestore.Labels).To(HaveKeyWithValue(virtsnapshot.RestoreCloneUIDLabel, testCloneUID))
+			})
+
+			It("when the target VM is ready - should move to Succeeded phase and clean up", func() {
+				restore := createVirtualMachineRestore(sourceVM, snapshot.Name)
+				restore.Namespace = testTargetNamespace
+				restore.Status.Complete = pointer.P(true)
+				vmClone.Status.RestoreName = pointer.P(restore.Name)
+				vmClone.Status.Phase = clone.RestoreInProgress
+
+				targetVM := sourceVM.DeepCopy()
+				targetVM.Name = vmClone.Spec.Target.Name
+				targetVM.Namespace = testTargetNamespace
+
+				addVM(sourceVM)
+				addVM(targetVM)
+				addClone(vmClone)
+				addSnapshot(snapshot)
+				_, err := client.SnapshotV1beta1().VirtualMachineRestores(testTargetNamespace).Create(context.TODO(), restore, metav1.CreateOptions{})
+				Expect(err).ToNot(HaveOccurred())
+				Expect(controller.restoreStore.Add(restore)).To(Succeed())
+
+				sanityExecute()
+				expectEvent(RestoreReady)
+				expectEvent(TargetVMCreated)
+				expectCloneBeInPhase(clone.Succeeded)
+				expectSnapshotDoesNotExist()
+				_, err = client.SnapshotV1beta1().VirtualMachineRestores(testTargetNamespace).Get(context.TODO(), testRestoreName, metav1.GetOptions{})
+				Expect(err).To(MatchError(errors.IsNotFound, "k8serrors.IsNotFound"))
+			})
+
+			It("when the restore was deleted - should fail and clean up its PVCs", func() {
+				vmClone.Status.RestoreName = pointer.P(testRestoreName)
+				vmClone.Status.Phase = clone.RestoreInProgress
+
+				addVM(sourceVM)
+				addClone(vmClone)
+				addSnapshot(snapshot)
+				pvc := addRestorePVC(testTargetNamespace)
+
+				sanityExecute()
+				expectEvent(RestoreDeleted)
+				expectCloneBeInPhase(clone.Failed)
+				expectPVCDeleted(pvc)
+			})
+
+			DescribeTable("should clean up the restore of a deleted clone", func(complete bool) {
+				restore := createVirtualMachineRestore(sourceVM, snapshot.Name)
+				restore.Namespace = testTargetNamespace
+				restore.Labels = map[string]string{virtsnapshot.RestoreCloneUIDLabel: testCloneUID}
+				restore.Spec.Target.Name = vmClone.Spec.Target.Name
+				restore.Status.Complete = pointer.P(complete)
+				_, err := client.SnapshotV1beta1().VirtualMachineRestores(testTargetNamespace).Create(context.TODO(), restore, metav1.CreateOptions{})
+				Expect(err).ToNot(HaveOccurred())
+				Expect(controller.restoreStore.Add(restore)).To(Succeed())
+				pvc := addRestorePVC(testTargetNamespace)
+				if complete {
+					targetVM := sourceVM.DeepCopy()
+					targetVM.Name = vmClone.Spec.Target.Name
+					targetVM.Namespace = testTargetNamespace
+					addVM(targetVM)
+				}
+				key, err := kvcontroller.KeyFunc(vmClone)
+				Expect(err).ToNot(HaveOccurred())
+				mockQueue.Add(key)
+
+				sanityExecute()
+				_, err = client.SnapshotV1beta1().VirtualMachineRestores(testTargetNamespace).Get(context.TODO(), testRestoreName, metav1.GetOptions{})
+				Expect(err).To(MatchError(errors.IsNotFound, "k8serrors.IsNotFound"))
+				_, err = k8sClient.Tracker().Get(k8sv1.SchemeGroupVersion.WithResource("persistentvolumeclaims"), pvc.Namespace, pvc.Name)
+				if complete {
+					Expect(err).ToNot(HaveOccurred())
+				} else {
+					Expect(err).To(MatchError(errors.IsNotFound, "k8serrors.IsNotFound"))
+				}
+			},
+				Entry("and its PVCs while it was in progress", false),
+				Entry("but keep the PVCs of the target once it completed", true),
+			)
+
+			It("should find the clone of a target VM by its namespace", func() {
+				addClone(vmClone)
+
+				clones, err := controller.listVmCloneMatchingVM(testTargetNamespace, vmClone.Spec.Target.Name)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(clones).To(HaveLen(1))
+
+				clones, err = controller.listVmCloneMatchingVM(metav1.NamespaceDefault, vmClone.Spec.Target.Name)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(clones).To(BeEmpty())
+			})
+		})
+
+		Context("with a VirtualMachineExport target", func() {
+			var snapshot *snapshotv1.VirtualMachineSnapshot
+
+			BeforeEach(func() {
+				vmClone.Spec.Target = &k8sv1.TypedLocalObjectReference{
+					APIGroup: pointer.P(exportv1.SchemeGroupVersion.Group),
+					Kind:     "VirtualMachineExport",
+				}
+
+				snapshot = createVirtualMachineSnapshot(sourceVM)
+				snapshot.Status.ReadyToUse = pointer.P(true)
+				vmClone.Status.SnapshotName = pointer.P(snapshot.Name)
+			})
+
+			newExport := func(phase exportv1.VirtualMachineExportPhase) *exportv1.VirtualMachineExport {
+				export := generateExport(vmClone, snapshot.Name)
+				export.Status = &exportv1.VirtualMachineExportStatus{Phase: phase}
+				return export
+			}
+
+			It("when snapshot is ready - should export it", func() {
+				vmClone.Status.Phase = clone.SnapshotInProgress
+
+				addVM(sourceVM)
+				addClone(vmClone)
+				addSnapshot(snapshot)
+
+				sanityExecute()
+				expectEvent(SnapshotReady)
+				expectEvent(ExportCreated)
+				expectCloneBeInPhase(clone.ExportInProgress)
+				expectRestoreDoesNotExist()
+
+				export, err := client.ExportV1beta1().VirtualMachineExports(metav1.NamespaceDefault).Get(context.TODO(), testExportName, metav1.GetOptions{})
+				Expect(err).ToNot(HaveOccurred())
+				Expect(export.Spec.Source.Kind).To(Equal("VirtualMachineSnapshot"))
+				Expect(export.Spec.Source.Name).To(Equal(testSnapshotName))
+				Expect(export.OwnerReferences).To(HaveLen(1))
+				validateOwnerReference(export.OwnerReferences[0], vmClone)
+
+				updatedClone, err := client.CloneV1beta1().VirtualMachineClones(metav1.NamespaceDefault).Get(context.TODO(), vmClone.Name, metav1.GetOptions{})
+				Expect(err).ToNot(HaveOccurred())
+				Expect(updatedClone.Status.TargetName).To(HaveValue(Equal(testExportName)))
+			})
+
+			It("when the export is not ready - should do nothing", func() {
+				vmClone.Status.Phase = clone.ExportInProgress
+				vmClone.Status.TargetName = pointer.P(testExportName)
+
+				addVM(sourceVM)
+				addClone(vmClone)
+				addSnapshot(snapshot)
+				addExport(newExport(exportv1.Pending))
+
+				sanityExecute()
+				Expect(recorder.Events).To(BeEmpty())
+				expectCloneBeInPhase(clone.ExportInProgress)
+			})
+
+			It("when the export is ready - should move to Succeeded phase and keep the snapshot", func() {
+				vmClone.Status.Phase = clone.ExportInProgress
+				vmClone.Status.TargetName = pointer.P(testExportName)
+
+				addVM(sourceVM)
+				addClone(vmClone)
+				addSnapshot(snapshot)
+				addExport(newExport(exportv1.Ready))
+
+				sanityExecute()
+				expectEvent(ExportReady)
+				expectCloneBeInPhase(clone.Succeeded)
+				_, err := client.SnapshotV1beta1().VirtualMachineSnapshots(metav1.NamespaceDefault).Get(context.TODO(), testSnapshotName, metav1.GetOptions{})
+				Expect(err).ToNot(HaveOccurred())
+			})
+
+			It("when the export is deleted - should delete the vm clone resource", func() {
+				vmClone.Status.Phase = clone.Succeeded
+				vmClone.Status.TargetName = pointer.P(testExportName)
+
+				addVM(sourceVM)
+				addClone(vmClone)
+
+				sanityExecute()
+				expectCloneDeletion()
+			})
+		})
 	})
 
 	Context("generation of target VM", func() {
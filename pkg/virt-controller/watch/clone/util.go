@@ -17,6 +17,9 @@ import (
 
 	clone "kubevirt.io/api/clone/v1beta1"
 	v1 "kubevirt.io/api/core/v1"
+	exportv1 "kubevirt.io/api/export/v1beta1"
+
+	virtsnapshot "kubevirt.io/kubevirt/pkg/storage/snapshot"
 )
 
 const (
@@ -64,6 +67,10 @@ func generateRestoreName(vmCloneUID types.UID) string {
 	return fmt.Sprintf("tmp-restore-%s", string(vmCloneUID))
 }
 
+func generateExportName(vmCloneUID types.UID) string {
+	return fmt.Sprintf("clone-export-%s", string(vmCloneUID))
+}
+
 func generateVMName(oldVMName string) string {
 	return generateNameWithRandomSuffix(oldVMName, "clone")
 }
@@ -91,18 +98,18 @@ func generateSnapshot(vmClone *clone.VirtualMachineClone, sourceVM *v1.VirtualMa
 	}
 }
 
-func generateRestore(targetInfo *corev1.TypedLocalObjectReference, sourceVMName, namespace, cloneName, snapshotName string, cloneUID types.UID, patches []string) *snapshotv1.VirtualMachineRestore {
+func generateRestore(targetInfo *corev1.TypedLocalObjectReference, sourceVMName, namespace, snapshotNamespace, cloneName, snapshotName string, cloneUID types.UID, patches []string) *snapshotv1.VirtualMachineRestore {
 	targetInfo = targetInfo.DeepCopy()
 	if targetInfo.Name == "" {
 		targetInfo.Name = generateVMName(sourceVMName)
 	}
 
-	return &snapshotv1.VirtualMachineRestore{
+	restore := &snapshotv1.VirtualMachineRestore{
 		ObjectMeta: metav1.ObjectMeta{
 			Name:      generateRestoreName(cloneUID),
 			Namespace: namespace,
-			OwnerReferences: []metav1.OwnerReference{
-				getCloneOwnerReference(cloneName, cloneUID),
+			Labels: map[string]string{
+				virtsnapshot.RestoreCloneUIDLabel: string(cloneUID),
 			},
 		},
 		Spec: snapshotv1.VirtualMachineRestoreSpec{
@@ -111,6 +118,49 @@ func generateRestore(targetInfo *corev1.TypedLocalObjectReference, sourceVMName,
 			Patches:                    patches,
 		},
 	}
+
+	// owner references cannot cross namespaces, the clone deletes the restore once it is done,
+	// or finds it by its label once the clone is gone
+	if namespace == snapshotNamespace {
+		restore.OwnerReferences = []metav1.OwnerReference{getCloneOwnerReference(cloneName, cloneUID)}
+	} else {
+		restore.Spec.VirtualMachineSnapshotNamespace = snapshotNamespace
+	}
+
+	return restore
+}
+
+func generateExport(vmClone *clone.VirtualMachineClone, snapshotName string) *exportv1.VirtualMachineExport {
+	name := vmClone.Spec.Target.Name
+	if name == "" {
+		name = generateExportName(vmClone.UID)
+	}
+
+	return &exportv1.VirtualMachineExport{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: vmClone.Namespace,
+			OwnerReferences: []metav1.OwnerReference{
+				getCloneOwnerReference(vmClone.Name, vmClone.UID),
+			},
+		},
+		Spec: exportv1.VirtualMachineExportSpec{
+			Source: corev1.TypedLocalObjectReference{
+				APIGroup: pointer.P(snapshotv1.SchemeGroupVersion.Group),
+				Kind:     "VirtualMachineSnapshot",
+				Name:     snapshotName,
+			},
+		},
+	}
+}
+
+// getTargetNamespace returns the namespace of the target, which defaults to
+// the namespace of the clone
+func getTargetNamespace(vmClone *clone.VirtualMachineClone) string {
+	if vmClone.Spec.TargetNamespace != "" {
+		return vmClone.Spec.TargetNamespace
+	}
+	return vmClone.Namespace
 }
 
 func getCloneOwnerReference(cloneName string, cloneUID types.UID) metav1.OwnerReference {
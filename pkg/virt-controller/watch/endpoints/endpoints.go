@@ -0,0 +1,483 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package endpoints
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"sort"
+	"time"
+
+	k8sv1 "k8s.io/api/core/v1"
+	discoveryv1 "k8s.io/api/discovery/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/client-go/util/workqueue"
+	"k8s.io/utils/ptr"
+
+	virtv1 "kubevirt.io/api/core/v1"
+	poolv1 "kubevirt.io/api/pool/v1alpha1"
+	"kubevirt.io/client-go/kubecli"
+	"kubevirt.io/client-go/log"
+
+	"kubevirt.io/kubevirt/pkg/controller"
+	"kubevirt.io/kubevirt/pkg/network/vmispec"
+)
+
+const serviceIndex = "service"
+
+// Controller publishes the EndpointSlices of selector-less Services which are annotated
+// to be backed by VMIs. Unlike the endpoints derived from the virt-launcher pods, the
+// published endpoints follow the readiness of the guest: they are not ready while the
+// VMI is paused, migrating or failing its readiness probes.
+type Controller struct {
+	clientset          kubecli.KubevirtClient
+	Queue              workqueue.TypedRateLimitingInterface[string]
+	serviceIndexer     cache.Indexer
+	vmiIndexer         cache.Indexer
+	vmStore            cache.Store
+	endpointSliceIndex cache.Indexer
+	conditionManager   *controller.VirtualMachineInstanceConditionManager
+	hasSynced          func() bool
+}
+
+func NewController(
+	clientset kubecli.KubevirtClient,
+	serviceInformer cache.SharedIndexInformer,
+	vmiInformer cache.SharedIndexInformer,
+	vmInformer cache.SharedIndexInformer,
+	endpointSliceInformer cache.SharedIndexInformer,
+) (*Controller, error) {
+	c := &Controller{
+		clientset: clientset,
+		Queue: workqueue.NewTypedRateLimitingQueueWithConfig[string](
+			workqueue.DefaultTypedControllerRateLimiter[string](),
+			workqueue.TypedRateLimitingQueueConfig[string]{Name: "virt-controller-endpoints"},
+		),
+		serviceIndexer:     serviceInformer.GetIndexer(),
+		vmiIndexer:         vmiInformer.GetIndexer(),
+		vmStore:            vmInformer.GetStore(),
+		endpointSliceIndex: endpointSliceInformer.GetIndexer(),
+		conditionManager:   controller.NewVirtualMachineInstanceConditionManager(),
+	}
+
+	c.hasSynced = func() bool {
+		return serviceInformer.HasSynced() && vmiInformer.HasSynced() &&
+			vmInformer.HasSynced() && endpointSliceInformer.HasSynced()
+	}
+
+	_, err := serviceInformer.AddEventHandler(cache.ResourceEventHandlerFuncs{
+		AddFunc:    c.enqueueService,
+		DeleteFunc: c.enqueueService,
+		UpdateFunc: func(_, curr interface{}) { c.enqueueService(curr) },
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = vmiInformer.AddEventHandler(cache.ResourceEventHandlerFuncs{
+		AddFunc:    c.enqueueServicesOfNamespace,
+		DeleteFunc: c.enqueueServicesOfNamespace,
+		UpdateFunc: func(_, curr interface{}) { c.enqueueServicesOfNamespace(curr) },
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = endpointSliceInformer.AddEventHandler(cache.ResourceEventHandlerFuncs{
+		DeleteFunc: c.enqueueServiceOfEndpointSlice,
+		UpdateFunc: func(_, curr interface{}) { c.enqueueServiceOfEndpointSlice(curr) },
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return c, nil
+}
+
+func (c *Controller) enqueueService(obj interface{}) {
+	key, err := controller.KeyFunc(obj)
+	if err != nil {
+		log.Log.Reason(err).Error("Failed to extract key from service.")
+		return
+	}
+	c.Queue.Add(key)
+}
+
+func (c *Controller) enqueueServicesOfNamespace(obj interface{}) {
+	if tombstone, ok := obj.(cache.DeletedFinalStateUnknown); ok {
+		obj = tombstone.Obj
+	}
+	vmi, ok := obj.(*virtv1.VirtualMachineInstance)
+	if !ok {
+		return
+	}
+
+	services, err := c.serviceIndexer.ByIndex(cache.NamespaceIndex, vmi.Namespace)
+	if err != nil {
+		log.Log.Object(vmi).Reason(err).Error("Failed to list the services of the namespace.")
+		return
+	}
+	for _, obj := range services {
+		if service := obj.(*k8sv1.Service); isAnnotated(service) {
+			c.enqueueService(service)
+		}
+	}
+}
+
+func (c *Controller) enqueueServiceOfEndpointSlice(obj interface{}) {
+	if tombstone, ok := obj.(cache.DeletedFinalStateUnknown); ok {
+		obj = tombstone.Obj
+	}
+	slice, ok := obj.(*discoveryv1.EndpointSlice)
+	if !ok {
+		return
+	}
+	if serviceName, exists := slice.Labels[discoveryv1.LabelServiceName]; exists {
+		c.Queue.Add(controller.NamespacedKey(slice.Namespace, serviceName))
+	}
+}
+
+// Run runs the passed in EndpointsController.
+func (c *Controller) Run(threadiness int, stopCh <-chan struct{}) {
+	defer controller.HandlePanic()
+	defer c.Queue.ShutDown()
+	log.Log.Info("Starting endpoints controller.")
+
+	// Wait for cache sync before we start the endpoints controller
+	cache.WaitForCacheSync(stopCh, c.hasSynced)
+
+	// Start the actual work
+	for i := 0; i < threadiness; i++ {
+		go wait.Until(c.runWorker, time.Second, stopCh)
+	}
+
+	<-stopCh
+	log.Log.Info("Stopping endpoints controller.")
+}
+
+func (c *Controller) runWorker() {
+	for c.Execute() {
+	}
+}
+
+// Execute runs commands from the controller queue, if there is
+// an error it requeues the command. Returns false if the queue
+// is empty.
+func (c *Controller) Execute() bool {
+	key, quit := c.Queue.Get()
+	if quit {
+		return false
+	}
+	defer c.Queue.Done(key)
+	err := c.execute(key)
+
+	if err != nil {
+		log.Log.Reason(err).Infof("reenqueuing service %v", key)
+		c.Queue.AddRateLimited(key)
+	} else {
+		log.Log.V(4).Infof("processed service %v", key)
+		c.Queue.Forget(key)
+	}
+	return true
+}
+
+func (c *Controller) execute(key string) error {
+	obj, exists, err := c.serviceIndexer.GetByKey(key)
+	if err != nil {
+		return err
+	}
+
+	existingSlices, err := c.endpointSliceIndex.ByIndex(serviceIndex, key)
+	if err != nil {
+		return err
+	}
+
+	var desiredSlices []*discoveryv1.EndpointSlice
+	if exists {
+		service := obj.(*k8sv1.Service)
+		if isManaged(service) {
+			vmis, err := c.selectVMIs(service)
+			if err != nil {
+				log.Log.Object(service).Reason(err).Error("Failed to select the VMIs backing the service, will not reenqueue")
+				return nil
+			}
+			desiredSlices = c.desiredEndpointSlices(service, vmis)
+		}
+	}
+
+	return c.reconcile(existingSlices, desiredSlices)
+}
+
+func (c *Controller) reconcile(existingSlices []interface{}, desiredSlices []*discoveryv1.EndpointSlice) error {
+	existingByName := map[string]*discoveryv1.EndpointSlice{}
+	for _, obj := range existingSlices {
+		slice := obj.(*discoveryv1.EndpointSlice)
+		existingByName[slice.Name] = slice
+	}
+
+	for _, desired := range desiredSlices {
+		existing, exists := existingByName[desired.Name]
+		delete(existingByName, desired.Name)
+
+		client := c.clientset.DiscoveryV1().EndpointSlices(desired.Namespace)
+		if !exists {
+			_, err := client.Create(context.Background(), desired, metav1.CreateOptions{})
+			if err != nil && !errors.IsAlreadyExists(err) {
+				return fmt.Errorf("failed to create endpointslice %s/%s: %v", desired.Namespace, desired.Name, err)
+			}
+			continue
+		}
+
+		if equality.Semantic.DeepEqual(existing.Labels, desired.Labels) &&
+			equality.Semantic.DeepEqual(existing.OwnerReferences, desired.OwnerReferences) &&
+			equality.Semantic.DeepEqual(existing.Ports, desired.Ports) &&
+			equality.Semantic.DeepEqual(existing.Endpoints, desired.Endpoints) {
+			continue
+		}
+		updated := existing.DeepCopy()
+		updated.Labels = desired.Labels
+		updated.OwnerReferences = desired.OwnerReferences
+		updated.Ports = desired.Ports
+		updated.Endpoints = desired.Endpoints
+		if _, err := client.Update(context.Background(), updated, metav1.UpdateOptions{}); err != nil {
+			return fmt.Errorf("failed to update endpointslice %s/%s: %v", updated.Namespace, updated.Name, err)
+		}
+	}
+
+	for _, stale := range existingByName {
+		err := c.clientset.DiscoveryV1().EndpointSlices(stale.Namespace).Delete(context.Background(), stale.Name, metav1.DeleteOptions{})
+		if err != nil && !errors.IsNotFound(err) {
+			return fmt.Errorf("failed to delete endpointslice %s/%s: %v", stale.Namespace, stale.Name, err)
+		}
+	}
+	return nil
+}
+
+// selectVMIs returns the VMIs backing the service, sorted by name.
+func (c *Controller) selectVMIs(service *k8sv1.Service) ([]*virtv1.VirtualMachineInstance, error) {
+	selector := labels.Everything()
+	if value, exists := service.Annotations[virtv1.VMIEndpointsSelectorAnnotation]; exists {
+		labelSelector, err := metav1.ParseToLabelSelector(value)
+		if err != nil {
+			return nil, err
+		}
+		selector, err = metav1.LabelSelectorAsSelector(labelSelector)
+		if err != nil {
+			return nil, err
+		}
+	}
+	poolName, selectsPool := service.Annotations[virtv1.VirtualMachinePoolEndpointsAnnotation]
+
+	objs, err := c.vmiIndexer.ByIndex(cache.NamespaceIndex, service.Namespace)
+	if err != nil {
+		return nil, err
+	}
+
+	var vmis []*virtv1.VirtualMachineInstance
+	for _, obj := range objs {
+		vmi := obj.(*virtv1.VirtualMachineInstance)
+		if vmi.IsFinal() || !selector.Matches(labels.Set(vmi.Labels)) {
+			continue
+		}
+		if selectsPool && !c.isOwnedByPool(vmi, poolName) {
+			continue
+		}
+		vmis = append(vmis, vmi)
+	}
+
+	sort.Slice(vmis, func(i, j int) bool {
+		return vmis[i].Name < vmis[j].Name
+	})
+	return vmis, nil
+}
+
+func (c *Controller) isOwnedByPool(vmi *virtv1.VirtualMachineInstance, poolName string) bool {
+	vmiControllerRef := metav1.GetControllerOf(vmi)
+	if vmiControllerRef == nil || vmiControllerRef.Kind != virtv1.VirtualMachineGroupVersionKind.Kind {
+		return false
+	}
+
+	obj, exists, err := c.vmStore.GetByKey(controller.NamespacedKey(vmi.Namespace, vmiControllerRef.Name))
+	if err != nil || !exists {
+		return false
+	}
+	vm := obj.(*virtv1.VirtualMachine)
+	if vm.UID != vmiControllerRef.UID {
+		return false
+	}
+
+	vmControllerRef := metav1.GetControllerOf(vm)
+	return vmControllerRef != nil &&
+		vmControllerRef.Kind == poolv1.VirtualMachinePoolKind &&
+		vmControllerRef.Name == poolName
+}
+
+func (c *Controller) desiredEndpointSlices(service *k8sv1.Service, vmis []*virtv1.VirtualMachineInstance) []*discoveryv1.EndpointSlice {
+	ipFamilies := service.Spec.IPFamilies
+	if len(ipFamilies) == 0 {
+		ipFamilies = []k8sv1.IPFamily{k8sv1.IPv4Protocol}
+	}
+
+	ports := endpointPorts(service)
+
+	var slices []*discoveryv1.EndpointSlice
+	for _, ipFamily := range ipFamilies {
+		addressType := discoveryv1.AddressTypeIPv4
+		if ipFamily == k8sv1.IPv6Protocol {
+			addressType = discoveryv1.AddressTypeIPv6
+		}
+
+		endpoints := []discoveryv1.Endpoint{}
+		for _, vmi := range vmis {
+			addresses := podNetworkAddresses(vmi, ipFamily)
+			if len(addresses) == 0 {
+				continue
+			}
+			endpoints = append(endpoints, c.endpoint(vmi, addresses))
+		}
+
+		slices = append(slices, &discoveryv1.EndpointSlice{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      EndpointSliceName(service.Name, ipFamily),
+				Namespace: service.Namespace,
+				Labels: map[string]string{
+					discoveryv1.LabelServiceName: service.Name,
+					discoveryv1.LabelManagedBy:   controller.EndpointSliceManagedBy,
+				},
+				OwnerReferences: []metav1.OwnerReference{
+					*metav1.NewControllerRef(service, k8sv1.SchemeGroupVersion.WithKind("Service")),
+				},
+			},
+			AddressType: addressType,
+			Endpoints:   endpoints,
+			Ports:       ports,
+		})
+	}
+	return slices
+}
+
+func (c *Controller) endpoint(vmi *virtv1.VirtualMachineInstance, addresses []string) discoveryv1.Endpoint {
+	endpoint := discoveryv1.Endpoint{
+		Addresses: addresses,
+		Conditions: discoveryv1.EndpointConditions{
+			Ready:       ptr.To(c.isReady(vmi)),
+			Serving:     ptr.To(c.conditionManager.HasConditionWithStatus(vmi, virtv1.VirtualMachineInstanceReady, k8sv1.ConditionTrue)),
+			Terminating: ptr.To(vmi.DeletionTimestamp != nil),
+		},
+		TargetRef: &k8sv1.ObjectReference{
+			Kind:      virtv1.VirtualMachineInstanceGroupVersionKind.Kind,
+			Namespace: vmi.Namespace,
+			Name:      vmi.Name,
+			UID:       vmi.UID,
+		},
+	}
+	if vmi.Status.NodeName != "" {
+		endpoint.NodeName = ptr.To(vmi.Status.NodeName)
+	}
+	return endpoint
+}
+
+// isReady reports whether the guest can serve traffic: the VMI is running, is neither paused nor
+// migrating, and its Ready condition (which reflects the guest readiness probes) is true.
+func (c *Controller) isReady(vmi *virtv1.VirtualMachineInstance) bool {
+	return vmi.IsRunning() &&
+		vmi.DeletionTimestamp == nil &&
+		c.conditionManager.HasConditionWithStatus(vmi, virtv1.VirtualMachineInstanceReady, k8sv1.ConditionTrue) &&
+		!c.conditionManager.HasConditionWithStatus(vmi, virtv1.VirtualMachineInstancePaused, k8sv1.ConditionTrue) &&
+		!isMigrating(vmi)
+}
+
+func isMigrating(vmi *virtv1.VirtualMachineInstance) bool {
+	migrationState := vmi.Status.MigrationState
+	return migrationState != nil && !migrationState.Completed && !migrationState.Failed
+}
+
+// podNetworkAddresses returns the addresses of the given family reported on the pod network interface.
+func podNetworkAddresses(vmi *virtv1.VirtualMachineInstance, ipFamily k8sv1.IPFamily) []string {
+	network := vmispec.LookUpDefaultNetwork(vmi.Spec.Networks)
+	if network == nil {
+		return nil
+	}
+	ifaceStatus := vmispec.LookupInterfaceStatusByName(vmi.Status.Interfaces, network.Name)
+	if ifaceStatus == nil {
+		return nil
+	}
+
+	var addresses []string
+	for _, ipAddress := range ifaceStatus.IPs {
+		ip := net.ParseIP(ipAddress)
+		if ip == nil || ip.IsLinkLocalUnicast() {
+			continue
+		}
+		if isIPv6 := ip.To4() == nil; isIPv6 == (ipFamily == k8sv1.IPv6Protocol) {
+			addresses = append(addresses, ipAddress)
+		}
+	}
+	return addresses
+}
+
+// endpointPorts maps the service ports to the ports exposed by the VMIs.
+// Named target ports cannot be resolved on a VMI and are skipped.
+func endpointPorts(service *k8sv1.Service) []discoveryv1.EndpointPort {
+	ports := []discoveryv1.EndpointPort{}
+	for _, servicePort := range service.Spec.Ports {
+		port := servicePort.TargetPort.IntVal
+		if servicePort.TargetPort.StrVal != "" {
+			continue
+		}
+		if port == 0 {
+			port = servicePort.Port
+		}
+		ports = append(ports, discoveryv1.EndpointPort{
+			Name:        ptr.To(servicePort.Name),
+			Protocol:    ptr.To(servicePort.Protocol),
+			Port:        ptr.To(port),
+			AppProtocol: servicePort.AppProtocol,
+		})
+	}
+	return ports
+}
+
+// EndpointSliceName returns the name of the EndpointSlice published for the service and IP family.
+func EndpointSliceName(serviceName string, ipFamily k8sv1.IPFamily) string {
+	family := "ipv4"
+	if ipFamily == k8sv1.IPv6Protocol {
+		family = "ipv6"
+	}
+	return fmt.Sprintf("%s-kubevirt-%s", serviceName, family)
+}
+
+func isAnnotated(service *k8sv1.Service) bool {
+	_, selectsVMIs := service.Annotations[virtv1.VMIEndpointsSelectorAnnotation]
+	_, selectsPool := service.Annotations[virtv1.VirtualMachinePoolEndpointsAnnotation]
+	return selectsVMIs || selectsPool
+}
+
+// isManaged reports whether the service endpoints are published by the controller.
+// Services with a selector have their endpoints published by Kubernetes.
+func isManaged(service *k8sv1.Service) bool {
+	return isAnnotated(service) && len(service.Spec.Selector) == 0
+}
@@ -0,0 +1,344 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package endpoints
+
+import (
+	"context"
+
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	k8sv1 "k8s.io/api/core/v1"
+	discoveryv1 "k8s.io/api/discovery/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/client-go/kubernetes/fake"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/utils/ptr"
+
+	virtv1 "kubevirt.io/api/core/v1"
+	poolv1 "kubevirt.io/api/pool/v1alpha1"
+	"kubevirt.io/client-go/kubecli"
+
+	"kubevirt.io/kubevirt/pkg/controller"
+	"kubevirt.io/kubevirt/pkg/testutils"
+)
+
+const (
+	testNamespace   = "default"
+	testServiceName = "web"
+)
+
+var _ = Describe("Endpoints controller", func() {
+	var (
+		kubeClient         *fake.Clientset
+		serviceInformer    cache.SharedIndexInformer
+		vmiInformer        cache.SharedIndexInformer
+		vmInformer         cache.SharedIndexInformer
+		endpointSliceStore cache.SharedIndexInformer
+		endpointsCtrl      *Controller
+	)
+
+	BeforeEach(func() {
+		virtClient := kubecli.NewMockKubevirtClient(gomock.NewController(GinkgoT()))
+		kubeClient = fake.NewSimpleClientset()
+		virtClient.EXPECT().DiscoveryV1().Return(kubeClient.DiscoveryV1()).AnyTimes()
+
+		serviceInformer, _ = testutils.NewFakeInformerFor(&k8sv1.Service{})
+		vmiInformer, _ = testutils.NewFakeInformerFor(&virtv1.VirtualMachineInstance{})
+		vmInformer, _ = testutils.NewFakeInformerFor(&virtv1.VirtualMachine{})
+		endpointSliceStore, _ = testutils.NewFakeInformerWithIndexersFor(&discoveryv1.EndpointSlice{}, controller.GetManagedEndpointSliceInformerIndexers())
+
+		var err error
+		endpointsCtrl, err = NewController(virtClient, serviceInformer, vmiInformer, vmInformer, endpointSliceStore)
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	addService := func(service *k8sv1.Service) {
+		Expect(serviceInformer.GetStore().Add(service)).To(Succeed())
+	}
+
+	addVMI := func(vmi *virtv1.VirtualMachineInstance) {
+		Expect(vmiInformer.GetStore().Add(vmi)).To(Succeed())
+	}
+
+	// sync reconciles the service and feeds the resulting slices back to the informer store.
+	sync := func() []discoveryv1.EndpointSlice {
+		Expect(endpointsCtrl.execute(controller.NamespacedKey(testNamespace, testServiceName))).To(Succeed())
+
+		sliceList, err := kubeClient.DiscoveryV1().EndpointSlices(testNamespace).List(context.Background(), metav1.ListOptions{})
+		Expect(err).ToNot(HaveOccurred())
+		for _, obj := range endpointSliceStore.GetStore().List() {
+			Expect(endpointSliceStore.GetStore().Delete(obj)).To(Succeed())
+		}
+		for i := range sliceList.Items {
+			Expect(endpointSliceStore.GetStore().Add(&sliceList.Items[i])).To(Succeed())
+		}
+		return sliceList.Items
+	}
+
+	It("should publish the endpoints of the VMIs matching the selector annotation", func() {
+		addService(newService(virtv1.VMIEndpointsSelectorAnnotation, "app=web"))
+		addVMI(newReadyVMI("web-b", map[string]string{"app": "web"}, "10.0.0.2"))
+		addVMI(newReadyVMI("web-a", map[string]string{"app": "web"}, "10.0.0.1", "fd10::1"))
+		addVMI(newReadyVMI("db", map[string]string{"app": "db"}, "10.0.0.3"))
+
+		slices := sync()
+		Expect(slices).To(HaveLen(1))
+		slice := slices[0]
+		Expect(slice.Name).To(Equal("web-kubevirt-ipv4"))
+		Expect(slice.AddressType).To(Equal(discoveryv1.AddressTypeIPv4))
+		Expect(slice.Labels).To(HaveKeyWithValue(discoveryv1.LabelServiceName, testServiceName))
+		Expect(slice.Labels).To(HaveKeyWithValue(discoveryv1.LabelManagedBy, controller.EndpointSliceManagedBy))
+		Expect(metav1.IsControlledBy(&slice, serviceInformer.GetStore().List()[0].(*k8sv1.Service))).To(BeTrue())
+		Expect(slice.Ports).To(ConsistOf(discoveryv1.EndpointPort{
+			Name:     ptr.To("http"),
+			Protocol: ptr.To(k8sv1.ProtocolTCP),
+			Port:     ptr.To(int32(8080)),
+		}))
+
+		Expect(slice.Endpoints).To(HaveLen(2))
+		Expect(slice.Endpoints[0].Addresses).To(ConsistOf("10.0.0.1"))
+		Expect(slice.Endpoints[0].TargetRef.Name).To(Equal("web-a"))
+		Expect(slice.Endpoints[0].NodeName).To(Equal(ptr.To("node01")))
+		Expect(slice.Endpoints[0].Conditions.Ready).To(Equal(ptr.To(true)))
+		Expect(slice.Endpoints[1].Addresses).To(ConsistOf("10.0.0.2"))
+	})
+
+	It("should publish a slice per IP family of a dual-stack service", func() {
+		service := newService(virtv1.VMIEndpointsSelectorAnnotation, "app=web")
+		service.Spec.IPFamilies = []k8sv1.IPFamily{k8sv1.IPv4Protocol, k8sv1.IPv6Protocol}
+		addService(service)
+		addVMI(newReadyVMI("web-a", map[string]string{"app": "web"}, "10.0.0.1", "fd10::1", "fe80::1"))
+
+		slices := sync()
+		Expect(slices).To(HaveLen(2))
+		addressesByName := map[string][]string{}
+		for _, slice := range slices {
+			Expect(slice.Endpoints).To(HaveLen(1))
+			addressesByName[slice.Name] = slice.Endpoints[0].Addresses
+		}
+		Expect(addressesByName).To(Equal(map[string][]string{
+			"web-kubevirt-ipv4": {"10.0.0.1"},
+			"web-kubevirt-ipv6": {"fd10::1"},
+		}))
+	})
+
+	It("should publish the endpoints of the VMIs of the pool", func() {
+		addService(newService(virtv1.VirtualMachinePoolEndpointsAnnotation, "web-pool"))
+
+		inPool := newReadyVMI("web-pool-0", nil, "10.0.0.1")
+		addPoolVM(vmInformer, inPool, "web-pool")
+		addVMI(inPool)
+		otherPool := newReadyVMI("other-pool-0", nil, "10.0.0.2")
+		addPoolVM(vmInformer, otherPool, "other-pool")
+		addVMI(otherPool)
+		addVMI(newReadyVMI("standalone", nil, "10.0.0.3"))
+
+		slices := sync()
+		Expect(slices).To(HaveLen(1))
+		Expect(slices[0].Endpoints).To(HaveLen(1))
+		Expect(slices[0].Endpoints[0].TargetRef.Name).To(Equal("web-pool-0"))
+	})
+
+	DescribeTable("should mark the endpoint not ready", func(mutate func(*virtv1.VirtualMachineInstance), expectServing bool) {
+		addService(newService(virtv1.VMIEndpointsSelectorAnnotation, "app=web"))
+		vmi := newReadyVMI("web-a", map[string]string{"app": "web"}, "10.0.0.1")
+		mutate(vmi)
+		addVMI(vmi)
+
+		slices := sync()
+		Expect(slices).To(HaveLen(1))
+		Expect(slices[0].Endpoints).To(HaveLen(1))
+		Expect(slices[0].Endpoints[0].Conditions.Ready).To(Equal(ptr.To(false)))
+		Expect(slices[0].Endpoints[0].Conditions.Serving).To(Equal(ptr.To(expectServing)))
+	},
+		Entry("when the VMI is paused", func(vmi *virtv1.VirtualMachineInstance) {
+			vmi.Status.Conditions = append(vmi.Status.Conditions, virtv1.VirtualMachineInstanceCondition{
+				Type:   virtv1.VirtualMachineInstancePaused,
+				Status: k8sv1.ConditionTrue,
+			})
+		}, true),
+		Entry("when the VMI is migrating", func(vmi *virtv1.VirtualMachineInstance) {
+			vmi.Status.MigrationState = &virtv1.VirtualMachineInstanceMigrationState{}
+		}, true),
+		Entry("when the guest fails its readiness probe", func(vmi *virtv1.VirtualMachineInstance) {
+			vmi.Status.Conditions = []virtv1.VirtualMachineInstanceCondition{{
+				Type:   virtv1.VirtualMachineInstanceReady,
+				Status: k8sv1.ConditionFalse,
+			}}
+		}, false),
+	)
+
+	It("should mark the endpoint ready once the migration completed", func() {
+		addService(newService(virtv1.VMIEndpointsSelectorAnnotation, "app=web"))
+		vmi := newReadyVMI("web-a", map[string]string{"app": "web"}, "10.0.0.1")
+		vmi.Status.MigrationState = &virtv1.VirtualMachineInstanceMigrationState{Completed: true}
+		addVMI(vmi)
+
+		slices := sync()
+		Expect(slices).To(HaveLen(1))
+		Expect(slices[0].Endpoints[0].Conditions.Ready).To(Equal(ptr.To(true)))
+	})
+
+	It("should update the slice when the readiness of a VMI changes", func() {
+		addService(newService(virtv1.VMIEndpointsSelectorAnnotation, "app=web"))
+		vmi := newReadyVMI("web-a", map[string]string{"app": "web"}, "10.0.0.1")
+		addVMI(vmi)
+		Expect(sync()[0].Endpoints[0].Conditions.Ready).To(Equal(ptr.To(true)))
+
+		paused := vmi.DeepCopy()
+		paused.Status.Conditions = append(paused.Status.Conditions, virtv1.VirtualMachineInstanceCondition{
+			Type:   virtv1.VirtualMachineInstancePaused,
+			Status: k8sv1.ConditionTrue,
+		})
+		Expect(vmiInformer.GetStore().Update(paused)).To(Succeed())
+
+		slices := sync()
+		Expect(slices).To(HaveLen(1))
+		Expect(slices[0].Endpoints[0].Conditions.Ready).To(Equal(ptr.To(false)))
+	})
+
+	It("should not update the slice when nothing changed", func() {
+		addService(newService(virtv1.VMIEndpointsSelectorAnnotation, "app=web"))
+		addVMI(newReadyVMI("web-a", map[string]string{"app": "web"}, "10.0.0.1"))
+		sync()
+		kubeClient.ClearActions()
+
+		sync()
+		for _, action := range kubeClient.Actions() {
+			Expect(action.GetVerb()).To(Equal("list"))
+		}
+	})
+
+	DescribeTable("should delete the published slices", func(mutate func(*k8sv1.Service)) {
+		service := newService(virtv1.VMIEndpointsSelectorAnnotation, "app=web")
+		addService(service)
+		addVMI(newReadyVMI("web-a", map[string]string{"app": "web"}, "10.0.0.1"))
+		Expect(sync()).To(HaveLen(1))
+
+		mutate(service)
+
+		Expect(sync()).To(BeEmpty())
+	},
+		Entry("when the service is removed", func(service *k8sv1.Service) {
+			Expect(serviceInformer.GetStore().Delete(service)).To(Succeed())
+		}),
+		Entry("when the annotation is removed", func(service *k8sv1.Service) {
+			updated := service.DeepCopy()
+			updated.Annotations = nil
+			Expect(serviceInformer.GetStore().Update(updated)).To(Succeed())
+		}),
+		Entry("when the service has a selector", func(service *k8sv1.Service) {
+			updated := service.DeepCopy()
+			updated.Spec.Selector = map[string]string{"app": "web"}
+			Expect(serviceInformer.GetStore().Update(updated)).To(Succeed())
+		}),
+	)
+
+	It("should skip named target ports", func() {
+		service := newService(virtv1.VMIEndpointsSelectorAnnotation, "app=web")
+		service.Spec.Ports = append(service.Spec.Ports,
+			k8sv1.ServicePort{Name: "metrics", Protocol: k8sv1.ProtocolTCP, Port: 9090, TargetPort: intstr.FromString("metrics")},
+			k8sv1.ServicePort{Name: "dns", Protocol: k8sv1.ProtocolUDP, Port: 53},
+		)
+		addService(service)
+
+		slices := sync()
+		Expect(slices).To(HaveLen(1))
+		Expect(slices[0].Ports).To(ConsistOf(
+			discoveryv1.EndpointPort{Name: ptr.To("http"), Protocol: ptr.To(k8sv1.ProtocolTCP), Port: ptr.To(int32(8080))},
+			discoveryv1.EndpointPort{Name: ptr.To("dns"), Protocol: ptr.To(k8sv1.ProtocolUDP), Port: ptr.To(int32(53))},
+		))
+		Expect(slices[0].Endpoints).To(BeEmpty())
+	})
+})
+
+func newService(annotation, value string) *k8sv1.Service {
+	return &k8sv1.Service{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        testServiceName,
+			Namespace:   testNamespace,
+			UID:         "service-uid",
+			Annotations: map[string]string{annotation: value},
+		},
+		Spec: k8sv1.ServiceSpec{
+			Ports: []k8sv1.ServicePort{{
+				Name:       "http",
+				Protocol:   k8sv1.ProtocolTCP,
+				Port:       80,
+				TargetPort: intstr.FromInt32(8080),
+			}},
+		},
+	}
+}
+
+func newReadyVMI(name string, labels map[string]string, ips ...string) *virtv1.VirtualMachineInstance {
+	vmi := &virtv1.VirtualMachineInstance{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: testNamespace,
+			UID:       types.UID(name + "-uid"),
+			Labels:    labels,
+		},
+		Spec: virtv1.VirtualMachineInstanceSpec{
+			Networks: []virtv1.Network{*virtv1.DefaultPodNetwork()},
+		},
+		Status: virtv1.VirtualMachineInstanceStatus{
+			Phase:    virtv1.Running,
+			NodeName: "node01",
+			Conditions: []virtv1.VirtualMachineInstanceCondition{{
+				Type:   virtv1.VirtualMachineInstanceReady,
+				Status: k8sv1.ConditionTrue,
+			}},
+			Interfaces: []virtv1.VirtualMachineInstanceNetworkInterface{{
+				Name: "default",
+				IPs:  ips,
+			}},
+		},
+	}
+	if len(ips) > 0 {
+		vmi.Status.Interfaces[0].IP = ips[0]
+	}
+	return vmi
+}
+
+func addPoolVM(vmInformer cache.SharedIndexInformer, vmi *virtv1.VirtualMachineInstance, poolName string) {
+	vm := &virtv1.VirtualMachine{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      vmi.Name,
+			Namespace: vmi.Namespace,
+			UID:       types.UID(vmi.Name + "-vm-uid"),
+		},
+	}
+	vm.OwnerReferences = []metav1.OwnerReference{{
+		APIVersion: poolv1.SchemeGroupVersion.String(),
+		Kind:       poolv1.VirtualMachinePoolKind,
+		Name:       poolName,
+		UID:        types.UID(poolName + "-uid"),
+		Controller: ptr.To(true),
+	}}
+	vmi.OwnerReferences = []metav1.OwnerReference{
+		*metav1.NewControllerRef(vm, virtv1.VirtualMachineGroupVersionKind),
+	}
+	Expect(vmInformer.GetStore().Add(vm)).To(Succeed())
+}
@@ -0,0 +1,398 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package migration
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"strconv"
+	"time"
+
+	k8sv1 "k8s.io/api/core/v1"
+	discoveryv1 "k8s.io/api/discovery/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+
+	virtv1 "kubevirt.io/api/core/v1"
+	"kubevirt.io/client-go/log"
+
+	"kubevirt.io/kubevirt/pkg/apimachinery/patch"
+	"kubevirt.io/kubevirt/pkg/controller"
+	"kubevirt.io/kubevirt/pkg/pointer"
+	"kubevirt.io/kubevirt/pkg/virt-controller/watch/common"
+	migrationproxy "kubevirt.io/kubevirt/pkg/virt-handler/migration-proxy"
+)
+
+const (
+	crossClusterMigrationServicePrefix = "kubevirt-migration-"
+	crossClusterMigrationPortName      = "migration"
+
+	// crossClusterMigrationManagedBy marks the EndpointSlices of migration target
+	// Services, which the endpoints controller must leave alone
+	crossClusterMigrationManagedBy = "migration-controller.kubevirt.io"
+
+	// crossClusterMigrationExposeInterval is how often a receiving migration
+	// checks whether the load balancer got an address
+	crossClusterMigrationExposeInterval = 5 * time.Second
+
+	successfulCrossClusterHandOverReason = "SuccessfulCrossClusterHandOver"
+	failedCrossClusterHandOverReason     = "FailedCrossClusterHandOver"
+)
+
+// syncCrossCluster drives the part of a migration to or from another cluster
+// which happens in this cluster. No target pod is involved: the VMI is either
+// sent through the tunnel of its virt-handler, or waits in its launcher pod for
+// the domain to arrive from the other cluster.
+func (c *Controller) syncCrossCluster(migration *virtv1.VirtualMachineInstanceMigration, vmi *virtv1.VirtualMachineInstance) error {
+	switch migration.Status.Phase {
+	case virtv1.MigrationPending:
+		if migration.DeletionTimestamp != nil {
+			return nil
+		}
+		return c.handleCrossClusterHandoff(migration, vmi)
+	case virtv1.MigrationSucceeded:
+		if migration.Spec.SendTo != nil {
+			return c.handOverCrossClusterVMI(migration, vmi)
+		}
+		return c.takeOverCrossClusterVM(migration, vmi)
+	}
+	return nil
+}
+
+// createCrossClusterReceiverVM creates the VM of a receiving migration. Its
+// template makes the VMI wait for the migration instead of being started.
+func (c *Controller) createCrossClusterReceiverVM(migration *virtv1.VirtualMachineInstanceMigration) error {
+	receive := migration.Spec.Receive
+	vmClient := c.clientset.VirtualMachine(migration.Namespace)
+
+	vm, err := vmClient.Get(context.Background(), migration.Spec.VMIName, v1.GetOptions{})
+	if err == nil {
+		if vm.Spec.Template != nil && vm.Spec.Template.ObjectMeta.Annotations[virtv1.CrossClusterMigrationReceiverAnnotation] == receive.MigrationID {
+			// the VM waits for its VMI to be created
+			return nil
+		}
+		c.recorder.Eventf(migration, k8sv1.EventTypeWarning, common.FailedCreateVirtualMachineReason, "VirtualMachine %s already exists", vm.Name)
+		return fmt.Errorf("VirtualMachine %s/%s already exists", vm.Namespace, vm.Name)
+	} else if !k8serrors.IsNotFound(err) {
+		return err
+	}
+
+	if receive.VirtualMachine == nil || receive.VirtualMachine.Template == nil {
+		return fmt.Errorf("the received VirtualMachine has no template")
+	}
+	vm = &virtv1.VirtualMachine{
+		ObjectMeta: v1.ObjectMeta{
+			Name:      migration.Spec.VMIName,
+			Namespace: migration.Namespace,
+		},
+		Spec: *receive.VirtualMachine.DeepCopy(),
+	}
+	if vm.Spec.Template.ObjectMeta.Annotations == nil {
+		vm.Spec.Template.ObjectMeta.Annotations = map[string]string{}
+	}
+	vm.Spec.Template.ObjectMeta.Annotations[virtv1.CrossClusterMigrationReceiverAnnotation] = receive.MigrationID
+
+	// The VMI has to be created for the migration to arrive in
+	if runStrategy, err := vm.RunStrategy(); err != nil ||
+		(runStrategy != virtv1.RunStrategyAlways && runStrategy != virtv1.RunStrategyRerunOnFailure) {
+		vm.Spec.RunStrategy = pointer.P(virtv1.RunStrategyAlways)
+	}
+	vm.Spec.Running = nil
+
+	if _, err := vmClient.Create(context.Background(), vm, v1.CreateOptions{}); err != nil && !k8serrors.IsAlreadyExists(err) {
+		c.recorder.Eventf(migration, k8sv1.EventTypeWarning, common.FailedCreateVirtualMachineReason, "Failed to create VirtualMachine %s: %v", vm.Name, err)
+		return err
+	}
+
+	c.recorder.Eventf(migration, k8sv1.EventTypeNormal, common.SuccessfulCreateVirtualMachineReason, "Created VirtualMachine %s to receive the migration", vm.Name)
+	return nil
+}
+
+// takeOverCrossClusterVM lets the VM of a received VMI start its VMIs
+// normally again, now that it owns the migrated one
+func (c *Controller) takeOverCrossClusterVM(migration *virtv1.VirtualMachineInstanceMigration, vmi *virtv1.VirtualMachineInstance) error {
+	owner := v1.GetControllerOf(vmi)
+	if owner == nil || owner.Kind != virtv1.VirtualMachineGroupVersionKind.Kind {
+		return nil
+	}
+
+	vm, err := c.clientset.VirtualMachine(vmi.Namespace).Get(context.Background(), owner.Name, v1.GetOptions{})
+	if k8serrors.IsNotFound(err) {
+		return nil
+	} else if err != nil {
+		return err
+	}
+	if vm.Spec.Template == nil {
+		return nil
+	}
+	migrationID, exists := vm.Spec.Template.ObjectMeta.Annotations[virtv1.CrossClusterMigrationReceiverAnnotation]
+	if !exists {
+		return nil
+	}
+
+	annotationPath := "/spec/template/metadata/annotations/" + patch.EscapeJSONPointer(virtv1.CrossClusterMigrationReceiverAnnotation)
+	patchBytes, err := patch.New(
+		patch.WithTest(annotationPath, migrationID),
+		patch.WithRemove(annotationPath),
+	).GeneratePayload()
+	if err != nil {
+		return err
+	}
+	if _, err := c.clientset.VirtualMachine(vm.Namespace).Patch(context.Background(), vm.Name, types.JSONPatchType, patchBytes, v1.PatchOptions{}); err != nil {
+		c.recorder.Eventf(migration, k8sv1.EventTypeWarning, failedCrossClusterHandOverReason, "Failed to take over the VM migrated from another cluster: %v", err)
+		return err
+	}
+
+	c.recorder.Eventf(migration, k8sv1.EventTypeNormal, successfulCrossClusterHandOverReason, "Took over the VM migrated from another cluster.")
+	return nil
+}
+
+func (c *Controller) handleCrossClusterHandoff(migration *virtv1.VirtualMachineInstanceMigration, vmi *virtv1.VirtualMachineInstance) error {
+	if vmi.Status.MigrationState != nil && vmi.Status.MigrationState.MigrationUID == migration.UID {
+		// already handed off
+		return nil
+	}
+
+	canMigrate, err := c.canMigrateVMI(migration, vmi)
+	if err != nil {
+		return err
+	}
+	if !canMigrate {
+		return fmt.Errorf("vmi is inelgible for migration because another migration job is running")
+	}
+
+	vmiCopy := vmi.DeepCopy()
+	if sendTo := migration.Spec.SendTo; sendTo != nil {
+		if !vmi.IsRunning() {
+			return nil
+		}
+		// The source virt-handler migrates the domain through the tunnel
+		// as soon as it sees a target address.
+		vmiCopy.Status.MigrationState = &virtv1.VirtualMachineInstanceMigrationState{
+			MigrationUID:      migration.UID,
+			SourceNode:        vmi.Status.NodeName,
+			TargetNodeAddress: sendTo.ConnectURL,
+			CrossCluster: &virtv1.VirtualMachineInstanceCrossClusterMigrationState{
+				MigrationID: sendTo.MigrationID,
+				ConnectURL:  sendTo.ConnectURL,
+			},
+		}
+		if migration.Status.MigrationState != nil {
+			vmiCopy.Status.MigrationState.SourcePod = migration.Status.MigrationState.SourcePod
+		}
+	} else {
+		if vmi.Status.Phase != virtv1.Scheduled {
+			return nil
+		}
+		pod, err := controller.CurrentVMIPod(vmi, c.podIndexer)
+		if err != nil {
+			return err
+		}
+		if !controller.PodExists(pod) {
+			return nil
+		}
+		// The virt-handler of the node the VMI is scheduled to prepares the
+		// launcher pod as migration target instead of starting the VMI.
+		vmiCopy.Status.MigrationState = &virtv1.VirtualMachineInstanceMigrationState{
+			MigrationUID: migration.UID,
+			TargetNode:   vmi.Status.NodeName,
+			TargetPod:    pod.Name,
+			CrossCluster: &virtv1.VirtualMachineInstanceCrossClusterMigrationState{
+				MigrationID: migration.Spec.Receive.MigrationID,
+			},
+		}
+	}
+
+	clusterMigrationConfigs := c.clusterConfig.GetMigrationConfiguration().DeepCopy()
+	if err := c.matchMigrationPolicy(vmiCopy, clusterMigrationConfigs); err != nil {
+		return fmt.Errorf("failed to match migration policy: %v", err)
+	}
+	if !c.isMigrationPolicyMatched(vmiCopy) {
+		vmiCopy.Status.MigrationState.MigrationConfiguration = clusterMigrationConfigs
+	}
+
+	if err := c.patchVMI(vmi, vmiCopy); err != nil {
+		c.recorder.Eventf(migration, k8sv1.EventTypeWarning, controller.FailedHandOverPodReason, fmt.Sprintf("Failed to set MigrationStat in VMI status. :%v", err))
+		return err
+	}
+
+	log.Log.Object(vmi).Infof("Handed off cross cluster migration %s/%s to virt-handler.", migration.Namespace, migration.Name)
+	c.recorder.Eventf(migration, k8sv1.EventTypeNormal, controller.SuccessfulHandOverPodReason, "Cross cluster migration was handed over to virt-handler.")
+	return nil
+}
+
+// handOverCrossClusterVMI stops the VM in this cluster once its VMI runs in the
+// other cluster. A VMI without a VM is removed.
+func (c *Controller) handOverCrossClusterVMI(migration *virtv1.VirtualMachineInstanceMigration, vmi *virtv1.VirtualMachineInstance) error {
+	if vmi.DeletionTimestamp != nil {
+		return nil
+	}
+
+	var err error
+	if owner := v1.GetControllerOf(vmi); owner != nil && owner.Kind == virtv1.VirtualMachineGroupVersionKind.Kind {
+		patchBytes := []byte(`{"spec":{"runStrategy":"` + string(virtv1.RunStrategyHalted) + `","running":null}}`)
+		_, err = c.clientset.VirtualMachine(vmi.Namespace).Patch(context.Background(), owner.Name, types.MergePatchType, patchBytes, v1.PatchOptions{})
+	} else {
+		err = c.clientset.VirtualMachineInstance(vmi.Namespace).Delete(context.Background(), vmi.Name, v1.DeleteOptions{})
+	}
+	if err != nil && !k8serrors.IsNotFound(err) {
+		c.recorder.Eventf(migration, k8sv1.EventTypeWarning, failedCrossClusterHandOverReason, "Failed to stop the VMI migrated to another cluster: %v", err)
+		return err
+	}
+
+	c.recorder.Eventf(migration, k8sv1.EventTypeNormal, successfulCrossClusterHandOverReason, "Stopped the VMI migrated to another cluster.")
+	return nil
+}
+
+// isSentToOtherCluster returns true if the source reported that the VMI was
+// migrated to the other cluster. The VMI may stop in this cluster right after.
+func isSentToOtherCluster(migration *virtv1.VirtualMachineInstanceMigration, vmi *virtv1.VirtualMachineInstance) bool {
+	return migration.Spec.SendTo != nil &&
+		vmi.Status.MigrationState != nil &&
+		vmi.Status.MigrationState.MigrationUID == migration.UID &&
+		vmi.Status.MigrationState.Completed &&
+		!vmi.Status.MigrationState.Failed
+}
+
+// processCrossClusterMigrationPhase progresses the phases of a migration to or
+// from another cluster. The scheduling phases of a target pod are skipped.
+func (c *Controller) processCrossClusterMigrationPhase(migration, migrationCopy *virtv1.VirtualMachineInstanceMigration, vmi *virtv1.VirtualMachineInstance) error {
+	migrationState := vmi.Status.MigrationState
+	if migrationState == nil || migrationState.MigrationUID != migration.UID {
+		return nil
+	}
+
+	switch migration.Status.Phase {
+	case virtv1.MigrationPending:
+		if migration.Spec.SendTo != nil {
+			migrationCopy.Status.Phase = virtv1.MigrationTargetReady
+		} else {
+			migrationCopy.Status.Phase = virtv1.MigrationPreparingTarget
+		}
+	case virtv1.MigrationPreparingTarget:
+		if migrationState.TargetNodeAddress == "" {
+			return nil
+		}
+		connectURL, err := c.exposeCrossClusterMigrationTarget(migration, migrationState.TargetNodeAddress)
+		if err != nil {
+			return err
+		}
+		if connectURL == "" {
+			log.Log.Object(migration).V(3).Infof("Waiting for the load balancer of the migration target.")
+			c.Queue.AddAfter(controller.MigrationKey(migration), crossClusterMigrationExposeInterval)
+			return nil
+		}
+		migrationCopy.Status.ConnectURL = connectURL
+		migrationCopy.Status.Phase = virtv1.MigrationTargetReady
+	case virtv1.MigrationTargetReady:
+		if migrationState.StartTimestamp != nil || migrationState.TargetNodeDomainDetected {
+			migrationCopy.Status.Phase = virtv1.MigrationRunning
+		}
+	case virtv1.MigrationRunning:
+		if migrationState.Completed {
+			migrationCopy.Status.Phase = virtv1.MigrationSucceeded
+			if migration.Spec.SendTo != nil {
+				c.recorder.Eventf(migration, k8sv1.EventTypeNormal, controller.SuccessfulMigrationReason, "Source node reported migration to another cluster succeeded")
+			} else {
+				c.recorder.Eventf(migration, k8sv1.EventTypeNormal, controller.SuccessfulMigrationReason, "Target node reported migration from another cluster succeeded")
+			}
+			log.Log.Object(migration).Infof("VMI reported cross cluster migration succeeded.")
+		}
+	}
+	return nil
+}
+
+// exposeCrossClusterMigrationTarget creates a LoadBalancer Service which
+// routes to the migration tunnel of the virt-handler preparing the target, and
+// returns its address once the load balancer has one.
+func (c *Controller) exposeCrossClusterMigrationTarget(migration *virtv1.VirtualMachineInstanceMigration, targetNodeAddress string) (string, error) {
+	name := crossClusterMigrationServicePrefix + migration.Name
+	ownerReferences := []v1.OwnerReference{*v1.NewControllerRef(migration, virtv1.VirtualMachineInstanceMigrationGroupVersionKind)}
+
+	// The Service has no selector, virt-handler pods serve many migrations
+	service := &k8sv1.Service{
+		ObjectMeta: v1.ObjectMeta{
+			Name:            name,
+			Namespace:       migration.Namespace,
+			Labels:          map[string]string{virtv1.MigrationJobLabel: string(migration.UID)},
+			OwnerReferences: ownerReferences,
+		},
+		Spec: k8sv1.ServiceSpec{
+			Type: k8sv1.ServiceTypeLoadBalancer,
+			Ports: []k8sv1.ServicePort{{
+				Name:     crossClusterMigrationPortName,
+				Protocol: k8sv1.ProtocolTCP,
+				Port:     migrationproxy.CrossClusterMigrationTunnelPort,
+			}},
+		},
+	}
+	serviceClient := c.clientset.CoreV1().Services(migration.Namespace)
+	service, err := serviceClient.Create(context.Background(), service, v1.CreateOptions{})
+	if k8serrors.IsAlreadyExists(err) {
+		service, err = serviceClient.Get(context.Background(), name, v1.GetOptions{})
+	}
+	if err != nil {
+		return "", fmt.Errorf("failed to create the migration target service: %v", err)
+	}
+
+	addressType := discoveryv1.AddressTypeIPv4
+	if ip := net.ParseIP(targetNodeAddress); ip != nil && ip.To4() == nil {
+		addressType = discoveryv1.AddressTypeIPv6
+	}
+	endpointSlice := &discoveryv1.EndpointSlice{
+		ObjectMeta: v1.ObjectMeta{
+			Name:      name,
+			Namespace: migration.Namespace,
+			Labels: map[string]string{
+				virtv1.MigrationJobLabel:     string(migration.UID),
+				discoveryv1.LabelServiceName: name,
+				discoveryv1.LabelManagedBy:   crossClusterMigrationManagedBy,
+			},
+			OwnerReferences: ownerReferences,
+		},
+		AddressType: addressType,
+		Endpoints: []discoveryv1.Endpoint{{
+			Addresses:  []string{targetNodeAddress},
+			Conditions: discoveryv1.EndpointConditions{Ready: pointer.P(true)},
+		}},
+		Ports: []discoveryv1.EndpointPort{{
+			Name:     pointer.P(crossClusterMigrationPortName),
+			Protocol: pointer.P(k8sv1.ProtocolTCP),
+			Port:     pointer.P(int32(migrationproxy.CrossClusterMigrationTunnelPort)),
+		}},
+	}
+	_, err = c.clientset.DiscoveryV1().EndpointSlices(migration.Namespace).Create(context.Background(), endpointSlice, v1.CreateOptions{})
+	if err != nil && !k8serrors.IsAlreadyExists(err) {
+		return "", fmt.Errorf("failed to create the migration target endpointslice: %v", err)
+	}
+
+	for _, ingress := range service.Status.LoadBalancer.Ingress {
+		host := ingress.IP
+		if host == "" {
+			host = ingress.Hostname
+		}
+		if host != "" {
+			return net.JoinHostPort(host, strconv.Itoa(migrationproxy.CrossClusterMigrationTunnelPort)), nil
+		}
+	}
+	return "", nil
+}
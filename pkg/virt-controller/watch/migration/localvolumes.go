@@ -0,0 +1,455 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package migration
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/openshift/library-go/pkg/build/naming"
+
+	k8sv1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/validation"
+
+	virtv1 "kubevirt.io/api/core/v1"
+	"kubevirt.io/client-go/log"
+
+	"kubevirt.io/kubevirt/pkg/apimachinery/patch"
+	"kubevirt.io/kubevirt/pkg/controller"
+	storagetypes "kubevirt.io/kubevirt/pkg/storage/types"
+)
+
+const (
+	localVolumesCopyReason       = "LocalVolumesCopy"
+	failedLocalVolumesCopyReason = "FailedLocalVolumesCopy"
+)
+
+func (c *Controller) blockMigrateLocalVolumes() bool {
+	migrationConfiguration := c.clusterConfig.GetMigrationConfiguration()
+	return migrationConfiguration.BlockMigrateLocalVolumes != nil && *migrationConfiguration.BlockMigrateLocalVolumes
+}
+
+// destinationPVCName returns the name of the PVC a migration copies a local volume to. The suffix a previous
+// copy added to the name of the source PVC is replaced, so that the name does not grow with every migration.
+func destinationPVCName(migration *virtv1.VirtualMachineInstanceMigration, sourcePVC *k8sv1.PersistentVolumeClaim) string {
+	base := sourcePVC.Name
+	if _, isCopy := sourcePVC.Annotations[virtv1.MigrationSourcePVCAnnotation]; isCopy {
+		if i := strings.LastIndex(base, "-"); i > 0 {
+			base = base[:i]
+		}
+	}
+	suffix := string(migration.UID)
+	if len(suffix) > 8 {
+		suffix = suffix[:8]
+	}
+	return naming.GetName(base, suffix, validation.DNS1123SubdomainMaxLength)
+}
+
+// newDestinationPVC returns a PVC like the source PVC, which is provisioned for the target of the migration
+func newDestinationPVC(migration *virtv1.VirtualMachineInstanceMigration, sourcePVC *k8sv1.PersistentVolumeClaim) *k8sv1.PersistentVolumeClaim {
+	resources := *sourcePVC.Spec.Resources.DeepCopy()
+	if capacity, exists := sourcePVC.Status.Capacity[k8sv1.ResourceStorage]; exists {
+		if resources.Requests == nil {
+			resources.Requests = k8sv1.ResourceList{}
+		}
+		if request := resources.Requests[k8sv1.ResourceStorage]; capacity.Cmp(request) > 0 {
+			resources.Requests[k8sv1.ResourceStorage] = capacity
+		}
+	}
+
+	return &k8sv1.PersistentVolumeClaim{
+		ObjectMeta: v1.ObjectMeta{
+			Name:      destinationPVCName(migration, sourcePVC),
+			Namespace: sourcePVC.Namespace,
+			Labels: map[string]string{
+				virtv1.MigrationNameLabel: migration.Name,
+			},
+			Annotations: map[string]string{
+				virtv1.MigrationSourcePVCAnnotation: sourcePVC.Name,
+			},
+		},
+		Spec: k8sv1.PersistentVolumeClaimSpec{
+			AccessModes:      sourcePVC.Spec.AccessModes,
+			VolumeMode:       sourcePVC.Spec.VolumeMode,
+			StorageClassName: sourcePVC.Spec.StorageClassName,
+			Resources:        resources,
+		},
+	}
+}
+
+// provisionDestinationPVC creates the PVC a local volume of the vmi is copied to, and returns
+// the source and the destination of the volume
+func (c *Controller) provisionDestinationPVC(migration *virtv1.VirtualMachineInstanceMigration, vmi *virtv1.VirtualMachineInstance, volume *virtv1.Volume) (*virtv1.StorageMigratedVolumeInfo, error) {
+	claimName := storagetypes.PVCNameFromVirtVolume(volume)
+	sourcePVC, err := storagetypes.GetPersistentVolumeClaimFromCache(vmi.Namespace, claimName, c.pvcStore)
+	if err != nil {
+		return nil, err
+	}
+	if sourcePVC == nil {
+		return nil, fmt.Errorf("PVC %s of volume %s not found", claimName, volume.Name)
+	}
+
+	pvc := newDestinationPVC(migration, sourcePVC)
+	_, exists, err := c.pvcStore.GetByKey(controller.NamespacedKey(pvc.Namespace, pvc.Name))
+	if err != nil {
+		return nil, err
+	}
+	if !exists {
+		_, err = c.clientset.CoreV1().PersistentVolumeClaims(pvc.Namespace).Create(context.Background(), pvc, v1.CreateOptions{})
+		if err != nil && !k8serrors.IsAlreadyExists(err) {
+			return nil, err
+		}
+		log.Log.Object(migration).Infof("Created PVC %s to copy the volume %s to", pvc.Name, volume.Name)
+	}
+
+	sourceInfo := &virtv1.PersistentVolumeClaimInfo{ClaimName: claimName}
+	for _, volumeStatus := range vmi.Status.VolumeStatus {
+		if volumeStatus.Name == volume.Name && volumeStatus.PersistentVolumeClaimInfo != nil {
+			sourceInfo = volumeStatus.PersistentVolumeClaimInfo.DeepCopy()
+		}
+	}
+	destinationInfo := sourceInfo.DeepCopy()
+	destinationInfo.ClaimName = pvc.Name
+	destinationInfo.AccessModes = pvc.Spec.AccessModes
+	destinationInfo.VolumeMode = pvc.Spec.VolumeMode
+	destinationInfo.Requests = pvc.Spec.Resources.Requests
+	destinationInfo.Capacity = pvc.Spec.Resources.Requests
+
+	return &virtv1.StorageMigratedVolumeInfo{
+		VolumeName:         volume.Name,
+		SourcePVCInfo:      sourceInfo,
+		DestinationPVCInfo: destinationInfo,
+	}, nil
+}
+
+// prepareLocalVolumesCopy provisions new PVCs for the local volumes of the vmi and switches the vmi over to them
+// through its migrated volumes. The target pod then uses the new PVCs and the migration copies the local volumes
+// to them. A VM owning the vmi is only switched over once the migration succeeded. It returns true once the vmi
+// uses the new PVCs.
+func (c *Controller) prepareLocalVolumesCopy(migration *virtv1.VirtualMachineInstanceMigration, vmi *virtv1.VirtualMachineInstance) (bool, error) {
+	if !c.blockMigrateLocalVolumes() || migration.IsCrossCluster() {
+		return true, nil
+	}
+
+	// Wait for the volumes of the vmi to be replaced, if the vmi or its VM was already switched over
+	volumes := storagetypes.GetVolumesByName(&vmi.Spec)
+	for _, migratedVolume := range vmi.Status.MigratedVolumes {
+		volume, exists := volumes[migratedVolume.VolumeName]
+		if exists && migratedVolume.DestinationPVCInfo != nil &&
+			storagetypes.PVCNameFromVirtVolume(volume) != migratedVolume.DestinationPVCInfo.ClaimName {
+			return false, nil
+		}
+	}
+
+	var migratedVolumes []virtv1.StorageMigratedVolumeInfo
+	for i := range vmi.Spec.Volumes {
+		volume := &vmi.Spec.Volumes[i]
+		if !storagetypes.IsLocalVolumeToCopy(vmi, volume) {
+			continue
+		}
+		migratedVolume, err := c.provisionDestinationPVC(migration, vmi, volume)
+		if err != nil {
+			c.recorder.Eventf(migration, k8sv1.EventTypeWarning, failedLocalVolumesCopyReason, "Failed to provision a PVC to copy the volume %s to: %v", volume.Name, err)
+			return false, err
+		}
+		migratedVolumes = append(migratedVolumes, *migratedVolume)
+	}
+	if len(migratedVolumes) == 0 {
+		return true, nil
+	}
+
+	claims := map[string]string{}
+	for _, migratedVolume := range migratedVolumes {
+		claims[migratedVolume.SourcePVCInfo.ClaimName] = migratedVolume.DestinationPVCInfo.ClaimName
+	}
+	switched, err := c.replaceVMIClaims(vmi, claims, migratedVolumes, migration.Name)
+	if err != nil {
+		c.recorder.Eventf(migration, k8sv1.EventTypeWarning, failedLocalVolumesCopyReason, "Failed to switch the VMI to the PVCs the local volumes are copied to: %v", err)
+		return false, err
+	}
+	if switched {
+		c.recorder.Eventf(migration, k8sv1.EventTypeNormal, localVolumesCopyReason, "Copying %d local volume(s) of the VMI to new PVCs", len(migratedVolumes))
+	}
+	return false, nil
+}
+
+func isOwnedByVM(vmi *virtv1.VirtualMachineInstance) bool {
+	owner := v1.GetControllerOf(vmi)
+	return owner != nil && owner.Kind == virtv1.VirtualMachineGroupVersionKind.Kind
+}
+
+// claimVolumeSource returns the volume source for a claim of the VM, which refers to the
+// DataVolume of the VM if the claim belongs to one
+func claimVolumeSource(vm *virtv1.VirtualMachine, claimName string) virtv1.VolumeSource {
+	for _, dataVolumeTemplate := range vm.Spec.DataVolumeTemplates {
+		if dataVolumeTemplate.Name == claimName {
+			return virtv1.VolumeSource{DataVolume: &virtv1.DataVolumeSource{Name: claimName}}
+		}
+	}
+	return virtv1.VolumeSource{
+		PersistentVolumeClaim: &virtv1.PersistentVolumeClaimVolumeSource{
+			PersistentVolumeClaimVolumeSource: k8sv1.PersistentVolumeClaimVolumeSource{ClaimName: claimName},
+		},
+	}
+}
+
+// replaceVMClaims replaces the claims of the VM volumes with the claims they are mapped to. The
+// DataVolumeTemplates of the replaced claims are dropped, so that the VM does not recreate them.
+func (c *Controller) replaceVMClaims(namespace, name string, claims map[string]string) (bool, error) {
+	vm, err := c.clientset.VirtualMachine(namespace).Get(context.Background(), name, v1.GetOptions{})
+	if err != nil {
+		return false, err
+	}
+
+	volumes := make([]virtv1.Volume, len(vm.Spec.Template.Spec.Volumes))
+	for i, volume := range vm.Spec.Template.Spec.Volumes {
+		volumes[i] = *volume.DeepCopy()
+		if claim, exists := claims[storagetypes.PVCNameFromVirtVolume(&volume)]; exists {
+			volumes[i].VolumeSource = claimVolumeSource(vm, claim)
+		}
+	}
+	dataVolumeTemplates := []virtv1.DataVolumeTemplateSpec{}
+	for _, dataVolumeTemplate := range vm.Spec.DataVolumeTemplates {
+		if _, replaced := claims[dataVolumeTemplate.Name]; !replaced {
+			dataVolumeTemplates = append(dataVolumeTemplates, dataVolumeTemplate)
+		}
+	}
+	if equality.Semantic.DeepEqual(volumes, vm.Spec.Template.Spec.Volumes) &&
+		len(dataVolumeTemplates) == len(vm.Spec.DataVolumeTemplates) {
+		return false, nil
+	}
+
+	patchSet := patch.New(
+		patch.WithTest("/spec/template/spec/volumes", vm.Spec.Template.Spec.Volumes),
+		patch.WithReplace("/spec/template/spec/volumes", volumes),
+	)
+	if len(dataVolumeTemplates) != len(vm.Spec.DataVolumeTemplates) {
+		patchSet.AddOption(
+			patch.WithTest("/spec/dataVolumeTemplates", vm.Spec.DataVolumeTemplates),
+			patch.WithReplace("/spec/dataVolumeTemplates", dataVolumeTemplates),
+		)
+	}
+	patchBytes, err := patchSet.GeneratePayload()
+	if err != nil {
+		return false, err
+	}
+	_, err = c.clientset.VirtualMachine(namespace).Patch(context.Background(), name, types.JSONPatchType, patchBytes, v1.PatchOptions{})
+	return err == nil, err
+}
+
+// replaceVMIClaims replaces the claims of the volumes of a vmi with the claims they are mapped to. The migrated
+// volumes of the vmi are replaced as well, which cancels the volume migration if nil. The vmi is marked as copied
+// by the migration, so that a VM owning it does not sync its volumes back, until the volume migration is canceled.
+func (c *Controller) replaceVMIClaims(vmi *virtv1.VirtualMachineInstance, claims map[string]string, migratedVolumes []virtv1.StorageMigratedVolumeInfo, migrationName string) (bool, error) {
+	vmiCopy := vmi.DeepCopy()
+	if vmiCopy.Annotations == nil {
+		vmiCopy.Annotations = map[string]string{}
+	}
+	for i, volume := range vmiCopy.Spec.Volumes {
+		if claim, exists := claims[storagetypes.PVCNameFromVirtVolume(&volume)]; exists {
+			vmiCopy.Spec.Volumes[i].VolumeSource = virtv1.VolumeSource{
+				PersistentVolumeClaim: &virtv1.PersistentVolumeClaimVolumeSource{
+					PersistentVolumeClaimVolumeSource: k8sv1.PersistentVolumeClaimVolumeSource{ClaimName: claim},
+				},
+			}
+		}
+	}
+	vmiCopy.Status.MigratedVolumes = migratedVolumes
+	if migratedVolumes != nil {
+		vmiCopy.Annotations[virtv1.LocalVolumesCopyAnnotation] = migrationName
+	} else {
+		delete(vmiCopy.Annotations, virtv1.LocalVolumesCopyAnnotation)
+		controller.NewVirtualMachineInstanceConditionManager().UpdateCondition(vmiCopy, &virtv1.VirtualMachineInstanceCondition{
+			Type:               virtv1.VirtualMachineInstanceVolumesChange,
+			LastTransitionTime: v1.Now(),
+			Status:             k8sv1.ConditionFalse,
+			Reason:             virtv1.VirtualMachineInstanceReasonVolumesChangeCancellation,
+		})
+	}
+	if equality.Semantic.DeepEqual(vmi.Spec.Volumes, vmiCopy.Spec.Volumes) &&
+		vmi.Annotations[virtv1.LocalVolumesCopyAnnotation] == vmiCopy.Annotations[virtv1.LocalVolumesCopyAnnotation] {
+		return false, nil
+	}
+
+	patchSet := patch.New()
+	if vmi.Annotations == nil {
+		patchSet.AddOption(patch.WithAdd("/metadata/annotations", vmiCopy.Annotations))
+	} else {
+		patchSet.AddOption(
+			patch.WithTest("/metadata/annotations", vmi.Annotations),
+			patch.WithReplace("/metadata/annotations", vmiCopy.Annotations),
+		)
+	}
+	patchSet.AddOption(
+		patch.WithTest("/spec/volumes", vmi.Spec.Volumes),
+		patch.WithReplace("/spec/volumes", vmiCopy.Spec.Volumes),
+		patch.WithTest("/status/migratedVolumes", vmi.Status.MigratedVolumes),
+		patch.WithAdd("/status/migratedVolumes", vmiCopy.Status.MigratedVolumes),
+	)
+	if migratedVolumes == nil {
+		patchSet.AddOption(
+			patch.WithTest("/status/conditions", vmi.Status.Conditions),
+			patch.WithAdd("/status/conditions", vmiCopy.Status.Conditions),
+		)
+	}
+	patchBytes, err := patchSet.GeneratePayload()
+	if err != nil {
+		return false, err
+	}
+	_, err = c.clientset.VirtualMachineInstance(vmi.Namespace).Patch(context.Background(), vmi.Name, types.JSONPatchType, patchBytes, v1.PatchOptions{})
+	return err == nil, err
+}
+
+// listDestinationPVCs returns the PVCs the migration copies local volumes to
+func (c *Controller) listDestinationPVCs(migration *virtv1.VirtualMachineInstanceMigration) []*k8sv1.PersistentVolumeClaim {
+	var pvcs []*k8sv1.PersistentVolumeClaim
+	for _, obj := range c.pvcStore.List() {
+		pvc := obj.(*k8sv1.PersistentVolumeClaim)
+		if pvc.Namespace != migration.Namespace || pvc.DeletionTimestamp != nil ||
+			pvc.Labels[virtv1.MigrationNameLabel] != migration.Name {
+			continue
+		}
+		if _, exists := pvc.Annotations[virtv1.MigrationSourcePVCAnnotation]; exists {
+			pvcs = append(pvcs, pvc)
+		}
+	}
+	return pvcs
+}
+
+// finalizeLocalVolumesCopy keeps the PVCs the local volumes were copied to once the migration succeeded, and
+// switches the VM owning the vmi over to them. The source volumes are deleted then, as they are local to the node
+// the vmi left. Otherwise the vmi is switched back to the source PVCs and the new PVCs are deleted. The VM is never
+// changed then.
+func (c *Controller) finalizeLocalVolumesCopy(migration *virtv1.VirtualMachineInstanceMigration, vmi *virtv1.VirtualMachineInstance) error {
+	pvcs := c.listDestinationPVCs(migration)
+	if len(pvcs) == 0 {
+		return nil
+	}
+
+	if migration.Status.Phase == virtv1.MigrationSucceeded {
+		claims := map[string]string{}
+		for _, pvc := range pvcs {
+			claims[pvc.Annotations[virtv1.MigrationSourcePVCAnnotation]] = pvc.Name
+		}
+		// A VM and its vmi share the name, and the VM has to take over the copies even when the vmi is gone
+		if vmi == nil || isOwnedByVM(vmi) {
+			if _, err := c.replaceVMClaims(migration.Namespace, migration.Spec.VMIName, claims); err != nil && !k8serrors.IsNotFound(err) {
+				return err
+			}
+		}
+		if vmi != nil {
+			if err := c.removeLocalVolumesCopyAnnotation(vmi); err != nil {
+				return err
+			}
+		}
+		// The destination PVCs keep the migration label until the source volumes are gone, so this is retried
+		if err := c.deleteSourceVolumes(migration, pvcs); err != nil {
+			c.recorder.Eventf(migration, k8sv1.EventTypeWarning, failedLocalVolumesCopyReason, "Failed to delete the volumes the local volumes were copied from: %v", err)
+			return err
+		}
+
+		for _, pvc := range pvcs {
+			labelPath := "/metadata/labels/" + patch.EscapeJSONPointer(virtv1.MigrationNameLabel)
+			patchBytes, err := patch.New(
+				patch.WithTest(labelPath, migration.Name),
+				patch.WithRemove(labelPath),
+			).GeneratePayload()
+			if err != nil {
+				return err
+			}
+			_, err = c.clientset.CoreV1().PersistentVolumeClaims(pvc.Namespace).Patch(context.Background(), pvc.Name, types.JSONPatchType, patchBytes, v1.PatchOptions{})
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+
+	if vmi != nil {
+		claims := map[string]string{}
+		for _, pvc := range pvcs {
+			claims[pvc.Name] = pvc.Annotations[virtv1.MigrationSourcePVCAnnotation]
+		}
+		if _, err := c.replaceVMIClaims(vmi, claims, nil, ""); err != nil {
+			return err
+		}
+	}
+
+	for _, pvc := range pvcs {
+		err := c.clientset.CoreV1().PersistentVolumeClaims(pvc.Namespace).Delete(context.Background(), pvc.Name, v1.DeleteOptions{})
+		if err != nil && !k8serrors.IsNotFound(err) {
+			return err
+		}
+	}
+	c.recorder.Eventf(migration, k8sv1.EventTypeNormal, localVolumesCopyReason, "Switched the VMI back to its local volumes and deleted %d new PVC(s)", len(pvcs))
+	return nil
+}
+
+// deleteSourceVolumes deletes the source PVCs of the PVCs a migration copied local volumes to. A source PVC which
+// belongs to a DataVolume is deleted through the DataVolume.
+func (c *Controller) deleteSourceVolumes(migration *virtv1.VirtualMachineInstanceMigration, pvcs []*k8sv1.PersistentVolumeClaim) error {
+	deleted := 0
+	for _, pvc := range pvcs {
+		sourcePVC, err := storagetypes.GetPersistentVolumeClaimFromCache(pvc.Namespace, pvc.Annotations[virtv1.MigrationSourcePVCAnnotation], c.pvcStore)
+		if err != nil {
+			return err
+		}
+		if sourcePVC == nil || sourcePVC.DeletionTimestamp != nil {
+			continue
+		}
+
+		if owner := v1.GetControllerOf(sourcePVC); owner != nil && owner.Kind == "DataVolume" {
+			err = c.clientset.CdiClient().CdiV1beta1().DataVolumes(sourcePVC.Namespace).Delete(context.Background(), owner.Name, v1.DeleteOptions{})
+		} else {
+			err = c.clientset.CoreV1().PersistentVolumeClaims(sourcePVC.Namespace).Delete(context.Background(), sourcePVC.Name, v1.DeleteOptions{})
+		}
+		if err != nil && !k8serrors.IsNotFound(err) {
+			return err
+		}
+		deleted++
+	}
+	if deleted > 0 {
+		c.recorder.Eventf(migration, k8sv1.EventTypeNormal, localVolumesCopyReason, "Deleted %d volume(s) the local volumes were copied from", deleted)
+	}
+	return nil
+}
+
+// removeLocalVolumesCopyAnnotation hands the volumes of the vmi back to the VM owning it
+func (c *Controller) removeLocalVolumesCopyAnnotation(vmi *virtv1.VirtualMachineInstance) error {
+	migrationName, exists := vmi.Annotations[virtv1.LocalVolumesCopyAnnotation]
+	if !exists {
+		return nil
+	}
+	annotationPath := "/metadata/annotations/" + patch.EscapeJSONPointer(virtv1.LocalVolumesCopyAnnotation)
+	patchBytes, err := patch.New(
+		patch.WithTest(annotationPath, migrationName),
+		patch.WithRemove(annotationPath),
+	).GeneratePayload()
+	if err != nil {
+		return err
+	}
+	_, err = c.clientset.VirtualMachineInstance(vmi.Namespace).Patch(context.Background(), vmi.Name, types.JSONPatchType, patchBytes, v1.PatchOptions{})
+	return err
+}
@@ -118,6 +118,9 @@ type Controller struct {
 	handOffLock sync.Mutex
 	handOffMap  map[string]struct{}
 
+	// the queue of pending migrations waiting for a free slot
+	pendingMigrations migrationQueueCache
+
 	unschedulablePendingTimeoutSeconds int64
 	catchAllPendingTimeoutSeconds      int64
 }
@@ -335,6 +338,15 @@ func (c *Controller) execute(key string) error {
 	if !vmiExists {
 		var err error
 
+		if migration.Spec.Receive != nil && migration.DeletionTimestamp == nil && !migration.IsFinal() {
+			// the VMI of a received migration is created by its VM
+			return c.createCrossClusterReceiverVM(migration)
+		}
+
+		if err := c.finalizeLocalVolumesCopy(migration, nil); err != nil {
+			return err
+		}
+
 		if migration.DeletionTimestamp == nil {
 			logger.V(3).Infof("Deleting migration for deleted vmi %s/%s", migration.Namespace, migration.Spec.VMIName)
 			err = c.clientset.VirtualMachineInstanceMigration(migration.Namespace).Delete(context.Background(), migration.Name, v1.DeleteOptions{})
@@ -369,6 +381,21 @@ func (c *Controller) execute(key string) error {
 	}
 
 	if migration.IsFinal() {
+		err = c.finalizeLocalVolumesCopy(migration, vmi)
+		if err != nil {
+			return err
+		}
+
+		err = c.handleMigrationRetry(key, migration, vmi)
+		if err != nil {
+			return err
+		}
+
+		err = c.handlePostMigrationVerification(key, migration, vmi)
+		if err != nil {
+			return err
+		}
+
 		err = c.garbageCollectFinalizedMigrations(vmi)
 		if err != nil {
 			return err
@@ -465,7 +492,7 @@ func (c *Controller) updateStatus(migration *virtv1.VirtualMachineInstanceMigrat
 		}
 		c.recorder.Eventf(migration, k8sv1.EventTypeWarning, controller.FailedMigrationReason, "Migration failed because vmi does not exist.")
 		log.Log.Object(migration).Error("vmi does not exist")
-	} else if vmi.IsFinal() {
+	} else if vmi.IsFinal() && !isSentToOtherCluster(migration, vmi) {
 		err := c.failMigration(migrationCopy)
 		if err != nil {
 			return err
@@ -493,7 +520,7 @@ func (c *Controller) updateStatus(migration *virtv1.VirtualMachineInstanceMigrat
 		}
 		c.recorder.Eventf(migration, k8sv1.EventTypeWarning, controller.FailedMigrationReason, "Migration failed because target pod shutdown during migration")
 		log.Log.Object(migration).Errorf("target pod %s/%s shutdown during migration", pod.Namespace, pod.Name)
-	} else if migration.TargetIsCreated() && !podExists {
+	} else if migration.TargetIsCreated() && !podExists && !migration.IsCrossCluster() {
 		err := c.failMigration(migrationCopy)
 		if err != nil {
 			return err
@@ -554,6 +581,13 @@ func (c *Controller) updateStatus(migration *virtv1.VirtualMachineInstanceMigrat
 		}
 	}
 
+	if migrationCopy.Status.Phase != virtv1.MigrationPending {
+		migrationCopy.Status.QueuePosition = nil
+	}
+
+	c.recordMigrationAttempt(migration, migrationCopy, vmi, pod)
+	c.verifyPostMigration(migration, migrationCopy, vmi)
+
 	controller.SetVMIMigrationPhaseTransitionTimestamp(migration, migrationCopy)
 	controller.SetSourcePod(migrationCopy, vmi, c.podIndexer)
 
@@ -578,6 +612,10 @@ func (c *Controller) processMigrationPhase(
 	vmi *virtv1.VirtualMachineInstance,
 	syncError error,
 ) error {
+	if migration.IsCrossCluster() && migration.Status.Phase != virtv1.MigrationPhaseUnset {
+		return c.processCrossClusterMigrationPhase(migration, migrationCopy, vmi)
+	}
+
 	conditionManager := controller.NewVirtualMachineInstanceMigrationConditionManager()
 	vmiConditionManager := controller.NewVirtualMachineInstanceConditionManager()
 	switch migration.Status.Phase {
@@ -608,13 +646,20 @@ func (c *Controller) processMigrationPhase(
 			} else {
 				migrationCopy.Status.Phase = virtv1.MigrationScheduling
 			}
-		} else if syncError != nil && strings.Contains(syncError.Error(), "exceeded quota") && !conditionManager.HasCondition(migration, virtv1.VirtualMachineInstanceMigrationRejectedByResourceQuota) {
-			condition := virtv1.VirtualMachineInstanceMigrationCondition{
-				Type:          virtv1.VirtualMachineInstanceMigrationRejectedByResourceQuota,
-				Status:        k8sv1.ConditionTrue,
-				LastProbeTime: v1.Now(),
+		} else {
+			if syncError != nil && strings.Contains(syncError.Error(), "exceeded quota") && !conditionManager.HasCondition(migration, virtv1.VirtualMachineInstanceMigrationRejectedByResourceQuota) {
+				condition := virtv1.VirtualMachineInstanceMigrationCondition{
+					Type:          virtv1.VirtualMachineInstanceMigrationRejectedByResourceQuota,
+					Status:        k8sv1.ConditionTrue,
+					LastProbeTime: v1.Now(),
+				}
+				migrationCopy.Status.Conditions = append(migrationCopy.Status.Conditions, condition)
 			}
-			migrationCopy.Status.Conditions = append(migrationCopy.Status.Conditions, condition)
+			queuePosition, err := c.migrationQueuePosition(migration)
+			if err != nil {
+				return err
+			}
+			migrationCopy.Status.QueuePosition = queuePosition
 		}
 	case virtv1.MigrationScheduling:
 		if conditionManager.HasCondition(migrationCopy, virtv1.VirtualMachineInstanceMigrationRejectedByResourceQuota) {
@@ -743,6 +788,8 @@ func (c *Controller) createTargetPod(migration *virtv1.VirtualMachineInstanceMig
 	templatePod.ObjectMeta.Labels[virtv1.MigrationJobLabel] = string(migration.UID)
 	templatePod.ObjectMeta.Annotations[virtv1.MigrationJobNameAnnotation] = migration.Name
 
+	migrations.AddMigrationNodeConstraints(templatePod, &migration.Spec)
+
 	// If cpu model is "host model" allow migration only to nodes that supports this cpu model
 	if cpu := vmi.Spec.Domain.CPU; cpu != nil && cpu.Model == virtv1.CPUModeHostModel {
 		node, err := c.getNodeForVMI(vmi)
@@ -820,27 +867,43 @@ func (c *Controller) expandPDB(pdb *policyv1.PodDisruptionBudget, vmi *virtv1.Vi
 // handleMigrationBackoff introduce a backoff (when needed) only for migrations
 // created by the evacuation controller.
 func (c *Controller) handleMigrationBackoff(key string, vmi *virtv1.VirtualMachineInstance, migration *virtv1.VirtualMachineInstanceMigration) error {
+	backoff, err := c.remainingMigrationBackoff(vmi, migration)
+	if err != nil {
+		return err
+	}
+
+	if backoff > 0 {
+		log.Log.Object(vmi).Errorf("vmi in migration backoff, re-enqueueing after %v", backoff)
+		c.Queue.AddAfter(key, backoff)
+		return migrationBackoffError
+	}
+	return nil
+}
+
+// remainingMigrationBackoff returns how long an evacuation or workload update migration
+// has to wait after previous failed attempts to migrate the vmi
+func (c *Controller) remainingMigrationBackoff(vmi *virtv1.VirtualMachineInstance, migration *virtv1.VirtualMachineInstanceMigration) (time.Duration, error) {
 	if _, exists := migration.Annotations[virtv1.FuncTestForceIgnoreMigrationBackoffAnnotation]; exists {
-		return nil
+		return 0, nil
 	}
 	_, existsEvacMig := migration.Annotations[virtv1.EvacuationMigrationAnnotation]
 	_, existsWorkUpdMig := migration.Annotations[virtv1.WorkloadUpdateMigrationAnnotation]
 	if !existsEvacMig && !existsWorkUpdMig {
-		return nil
+		return 0, nil
 	}
 
 	migrations, err := c.listBackoffEligibleMigrations(vmi.Namespace, vmi.Name)
 	if err != nil {
-		return err
+		return 0, err
 	}
 	if len(migrations) < 2 {
-		return nil
+		return 0, nil
 	}
 
 	// Newest first
 	sort.Sort(sort.Reverse(vmimCollection(migrations)))
 	if migrations[0].UID != migration.UID {
-		return nil
+		return 0, nil
 	}
 
 	backoff := time.Second * 0
@@ -861,7 +924,7 @@ func (c *Controller) handleMigrationBackoff(key string, vmi *virtv1.VirtualMachi
 		}
 	}
 	if backoff == 0 {
-		return nil
+		return 0, nil
 	}
 
 	getFailedTS := func(migration *virtv1.VirtualMachineInstanceMigration) metav1.Time {
@@ -874,14 +937,7 @@ func (c *Controller) handleMigrationBackoff(key string, vmi *virtv1.VirtualMachi
 	}
 
 	outOffBackoffTS := getFailedTS(migrations[1]).Add(backoff)
-	backoff = outOffBackoffTS.Sub(time.Now())
-
-	if backoff > 0 {
-		log.Log.Object(vmi).Errorf("vmi in migration backoff, re-enqueueing after %v", backoff)
-		c.Queue.AddAfter(key, backoff)
-		return migrationBackoffError
-	}
-	return nil
+	return outOffBackoffTS.Sub(time.Now()), nil
 }
 
 func (c *Controller) handleMarkMigrationFailedOnVMI(migration *virtv1.VirtualMachineInstanceMigration, vmi *virtv1.VirtualMachineInstance) error {
@@ -979,6 +1035,7 @@ func (c *Controller) handleTargetPodHandoff(migration *virtv1.VirtualMachineInst
 	if !c.isMigrationPolicyMatched(vmiCopy) {
 		vmiCopy.Status.MigrationState.MigrationConfiguration = clusterMigrationConfigs
 	}
+	applyRetryEscalation(migration, vmiCopy.Status.MigrationState.MigrationConfiguration)
 
 	if controller.VMIHasHotplugCPU(vmi) && vmi.IsCPUDedicated() {
 		cpuLimitsCount, err := getTargetPodLimitsCount(pod)
@@ -1084,24 +1141,23 @@ func (c *Controller) handleTargetPodCreation(key string, migration *virtv1.Virtu
 		return fmt.Errorf("failed to determin the number of running migrations: %v", err)
 	}
 
-	// XXX: Make this configurable, think about limit per node, bandwidth per migration, and so on.
-	if len(runningMigrations) >= int(*c.clusterConfig.GetMigrationConfiguration().ParallelMigrationsPerCluster) {
-		log.Log.Object(migration).Infof("Waiting to schedule target pod for vmi [%s/%s] migration because total running parallel migration count [%d] is currently at the global cluster limit.", vmi.Namespace, vmi.Name, len(runningMigrations))
+	// Migrations queued before this one get their slots first
+	clusterMigrations, outboundMigrations, err := c.claimedMigrationSlots(migration, runningMigrations)
+	if err != nil {
+		return fmt.Errorf("failed to determine the migrations queued before this one: %v", err)
+	}
+
+	if clusterMigrations >= int(*c.clusterConfig.GetMigrationConfiguration().ParallelMigrationsPerCluster) {
+		log.Log.Object(migration).Infof("Waiting to schedule target pod for vmi [%s/%s] migration because total running parallel migration count [%d] and migrations queued before it [%d] are currently at the global cluster limit.", vmi.Namespace, vmi.Name, len(runningMigrations), clusterMigrations-len(runningMigrations))
 		// Let's wait until some migrations are done
 		c.Queue.AddAfter(key, time.Second*5)
 		return nil
 	}
 
-	outboundMigrations, err := c.outboundMigrationsOnNode(vmi.Status.NodeName, runningMigrations)
-
-	if err != nil {
-		return err
-	}
-
-	if outboundMigrations >= int(*c.clusterConfig.GetMigrationConfiguration().ParallelOutboundMigrationsPerNode) {
+	if outboundMigrations[vmi.Status.NodeName] >= int(*c.clusterConfig.GetMigrationConfiguration().ParallelOutboundMigrationsPerNode) {
 		// Let's ensure that we only have two outbound migrations per node
 		// XXX: Make this configurable, thinkg about inbound migration limit, bandwidh per migration, and so on.
-		log.Log.Object(migration).Infof("Waiting to schedule target pod for vmi [%s/%s] migration because total running parallel outbound migrations on target node [%d] has hit outbound migrations per node limit.", vmi.Namespace, vmi.Name, outboundMigrations)
+		log.Log.Object(migration).Infof("Waiting to schedule target pod for vmi [%s/%s] migration because total running parallel outbound migrations on target node [%d] has hit outbound migrations per node limit.", vmi.Namespace, vmi.Name, outboundMigrations[vmi.Status.NodeName])
 		c.Queue.AddAfter(key, time.Second*5)
 		return nil
 	}
@@ -1134,6 +1190,10 @@ func (c *Controller) handleTargetPodCreation(key string, migration *virtv1.Virtu
 				return nil
 			}
 		}
+		ready, err := c.prepareLocalVolumesCopy(migration, vmi)
+		if err != nil || !ready {
+			return err
+		}
 		err = c.handleBackendStorage(migration, vmi)
 		if err != nil {
 			return err
@@ -1362,6 +1422,10 @@ func (c *Controller) sync(key string, migration *virtv1.VirtualMachineInstanceMi
 		return nil
 	}
 
+	if migration.IsCrossCluster() {
+		return c.syncCrossCluster(migration, vmi)
+	}
+
 	if migrationFinalizedOnVMI := vmi.Status.MigrationState != nil && vmi.Status.MigrationState.MigrationUID == migration.UID &&
 		vmi.Status.MigrationState.EndTimestamp != nil; migrationFinalizedOnVMI {
 		return nil
@@ -1544,10 +1608,12 @@ func (c *Controller) listMatchingTargetPods(migration *virtv1.VirtualMachineInst
 }
 
 func (c *Controller) addMigration(obj interface{}) {
+	c.pendingMigrations.invalidate()
 	c.enqueueMigration(obj)
 }
 
 func (c *Controller) deleteMigration(obj interface{}) {
+	c.pendingMigrations.invalidate()
 	c.enqueueMigration(obj)
 }
 
@@ -1962,19 +2028,6 @@ func (c *Controller) deleteVMI(obj interface{}) {
 	}
 }
 
-func (c *Controller) outboundMigrationsOnNode(node string, runningMigrations []*virtv1.VirtualMachineInstanceMigration) (int, error) {
-	sum := 0
-	for _, migration := range runningMigrations {
-		key := controller.NamespacedKey(migration.Namespace, migration.Spec.VMIName)
-		if vmi, exists, _ := c.vmiStore.GetByKey(key); exists {
-			if vmi.(*virtv1.VirtualMachineInstance).Status.NodeName == node {
-				sum = sum + 1
-			}
-		}
-	}
-	return sum, nil
-}
-
 // findRunningMigrations calcules how many migrations are running or in flight to be triggered to running
 // Migrations which are in running phase are added alongside with migrations which are still pending but
 // where we already see a target pod.
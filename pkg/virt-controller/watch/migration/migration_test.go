@@ -34,6 +34,7 @@ import (
 	gomegaTypes "github.com/onsi/gomega/types"
 
 	k8sv1 "k8s.io/api/core/v1"
+	discoveryv1 "k8s.io/api/discovery/v1"
 	policyv1 "k8s.io/api/policy/v1"
 	storagev1 "k8s.io/api/storage/v1"
 	k8serrors "k8s.io/apimachinery/pkg/api/errors"
@@ -43,6 +44,7 @@ import (
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/apimachinery/pkg/util/intstr"
 	"k8s.io/apimachinery/pkg/util/rand"
+	"k8s.io/apimachinery/pkg/util/validation"
 	"k8s.io/client-go/kubernetes/fake"
 	"k8s.io/client-go/tools/cache"
 	"k8s.io/client-go/tools/record"
@@ -50,6 +52,7 @@ import (
 	virtv1 "kubevirt.io/api/core/v1"
 	migrationsv1 "kubevirt.io/api/migrations/v1alpha1"
 	"kubevirt.io/client-go/api"
+	cdifake "kubevirt.io/client-go/containerizeddataimporter/fake"
 	"kubevirt.io/client-go/kubecli"
 	kubevirtfake "kubevirt.io/client-go/kubevirt/fake"
 	fakenetworkclient "kubevirt.io/client-go/networkattachmentdefinitionclient/fake"
@@ -60,7 +63,9 @@ import (
 	"kubevirt.io/kubevirt/pkg/testutils"
 	virtconfig "kubevirt.io/kubevirt/pkg/virt-config"
 	"kubevirt.io/kubevirt/pkg/virt-controller/services"
+	"kubevirt.io/kubevirt/pkg/virt-controller/watch/common"
 	"kubevirt.io/kubevirt/pkg/virt-controller/watch/descheduler"
+	migrationproxy "kubevirt.io/kubevirt/pkg/virt-handler/migration-proxy"
 )
 
 var _ = Describe("Migration watcher", func() {
@@ -71,6 +76,7 @@ var _ = Describe("Migration watcher", func() {
 		mockQueue     *testutils.MockWorkQueue[string]
 		virtClientset *kubevirtfake.Clientset
 		kubeClient    *fake.Clientset
+		cdiClient     *cdifake.Clientset
 		networkClient *fakenetworkclient.Clientset
 		namespace     k8sv1.Namespace
 	)
@@ -282,11 +288,15 @@ var _ = Describe("Migration watcher", func() {
 		kubeClient = fake.NewSimpleClientset(&namespace)
 		virtClient.EXPECT().VirtualMachineInstanceMigration(k8sv1.NamespaceDefault).Return(virtClientset.KubevirtV1().VirtualMachineInstanceMigrations(k8sv1.NamespaceDefault)).AnyTimes()
 		virtClient.EXPECT().VirtualMachineInstance(k8sv1.NamespaceDefault).Return(virtClientset.KubevirtV1().VirtualMachineInstances(k8sv1.NamespaceDefault)).AnyTimes()
+		virtClient.EXPECT().VirtualMachine(k8sv1.NamespaceDefault).Return(virtClientset.KubevirtV1().VirtualMachines(k8sv1.NamespaceDefault)).AnyTimes()
 		virtClient.EXPECT().CoreV1().Return(kubeClient.CoreV1()).AnyTimes()
+		virtClient.EXPECT().DiscoveryV1().Return(kubeClient.DiscoveryV1()).AnyTimes()
 		virtClient.EXPECT().PolicyV1().Return(kubeClient.PolicyV1()).AnyTimes()
 		networkClient = fakenetworkclient.NewSimpleClientset()
 		virtClient.EXPECT().NetworkClient().Return(networkClient).AnyTimes()
 		virtClient.EXPECT().MigrationPolicy().Return(virtClientset.MigrationsV1alpha1().MigrationPolicies()).AnyTimes()
+		cdiClient = cdifake.NewSimpleClientset()
+		virtClient.EXPECT().CdiClient().Return(cdiClient).AnyTimes()
 	})
 
 	AfterEach(func() {
@@ -825,6 +835,124 @@ var _ = Describe("Migration watcher", func() {
 			expectPodDoesNotExist(vmi.Namespace, fmt.Sprintf("testvmi"), "testmigration")
 		})
 
+		Context("with a queue of pending migrations", func() {
+			addRunningMigrations := func(nodes ...string) {
+				for i, node := range nodes {
+					vmi := newVirtualMachine(fmt.Sprintf("runningvmi%v", i), virtv1.Running)
+					addNodeNameToVMI(vmi, node)
+					addMigration(newMigration(fmt.Sprintf("runningmigration%v", i), vmi.Name, virtv1.MigrationScheduling))
+					addVirtualMachineInstance(vmi)
+				}
+			}
+
+			addPendingMigration := func(name, node string, created metav1.Time, priority int32, annotations ...string) {
+				vmi := newVirtualMachine(name+"vmi", virtv1.Running)
+				addNodeNameToVMI(vmi, node)
+				migration := newMigration(name, vmi.Name, virtv1.MigrationPending)
+				migration.CreationTimestamp = created
+				migration.Spec.Priority = pointer.P(priority)
+				for _, annotation := range annotations {
+					setAnnotation(annotation, migration)
+				}
+				addMigration(migration)
+				addVirtualMachineInstance(vmi)
+			}
+
+			expectQueuePosition := func(migration *virtv1.VirtualMachineInstanceMigration, position int32) {
+				updatedVMIM, err := virtClientset.KubevirtV1().VirtualMachineInstanceMigrations(migration.Namespace).Get(context.Background(), migration.Name, metav1.GetOptions{})
+				Expect(err).ToNot(HaveOccurred())
+				Expect(updatedVMIM.Status.QueuePosition).To(HaveValue(Equal(position)))
+			}
+
+			It("should start migrations with a higher priority first", func() {
+				now := metav1.Now()
+				vmi := newVirtualMachine("testvmi", virtv1.Running)
+				migration := newMigration("testmigration", vmi.Name, virtv1.MigrationPending)
+				migration.CreationTimestamp = now
+				addMigration(migration)
+				addVirtualMachineInstance(vmi)
+				addPod(newSourcePodForVirtualMachine(vmi))
+
+				addRunningMigrations("node0", "node1", "node2", "node3")
+				addPendingMigration("urgentmigration", "node4", metav1.NewTime(now.Add(time.Minute)), 10)
+
+				sanityExecute()
+
+				expectPodDoesNotExist(vmi.Namespace, string(vmi.UID), string(migration.UID))
+				expectQueuePosition(migration, 2)
+			})
+
+			It("should take turns between evacuations and user initiated migrations", func() {
+				now := metav1.Now()
+				vmi := newVirtualMachine("testvmi", virtv1.Running)
+				migration := newMigration("testmigration", vmi.Name, virtv1.MigrationPending)
+				migration.CreationTimestamp = metav1.NewTime(now.Add(time.Minute))
+				addMigration(migration)
+				addVirtualMachineInstance(vmi)
+				addPod(newSourcePodForVirtualMachine(vmi))
+
+				addRunningMigrations("node0", "node1", "node2")
+				addPendingMigration("evacuation0", "node3", now, 0, virtv1.EvacuationMigrationAnnotation)
+				addPendingMigration("evacuation1", "node4", metav1.NewTime(now.Add(time.Second)), 0, virtv1.EvacuationMigrationAnnotation)
+
+				sanityExecute()
+
+				testutils.ExpectEvent(recorder, virtcontroller.SuccessfulCreatePodReason)
+				expectPodCreation(vmi.Namespace, vmi.UID, migration.UID, 1, 0, 0)
+				expectQueuePosition(migration, 2)
+			})
+
+			It("should not hold back migrations behind one which waits for its own node", func() {
+				now := metav1.Now()
+				vmi := newVirtualMachine("testvmi", virtv1.Running)
+				migration := newMigration("testmigration", vmi.Name, virtv1.MigrationPending)
+				migration.CreationTimestamp = metav1.NewTime(now.Add(time.Minute))
+				addMigration(migration)
+				addVirtualMachineInstance(vmi)
+				addPod(newSourcePodForVirtualMachine(vmi))
+
+				addRunningMigrations("node0", "node0", "node1", "node2")
+				addPendingMigration("blockedmigration", "node0", now, 0)
+
+				sanityExecute()
+
+				testutils.ExpectEvent(recorder, virtcontroller.SuccessfulCreatePodReason)
+				expectPodCreation(vmi.Namespace, vmi.UID, migration.UID, 1, 0, 0)
+			})
+
+			It("should reuse the queue until a migration is added", func() {
+				now := metav1.Now()
+				addPendingMigration("firstmigration", "node0", now, 0)
+				queue, err := controller.pendingMigrationQueue(nil)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(queue).To(HaveLen(1))
+
+				addPendingMigration("secondmigration", "node1", metav1.NewTime(now.Add(time.Second)), 0)
+				queue, err = controller.pendingMigrationQueue(nil)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(queue).To(HaveLen(1))
+
+				obj, exists, err := controller.migrationIndexer.GetByKey(k8sv1.NamespaceDefault + "/secondmigration")
+				Expect(err).ToNot(HaveOccurred())
+				Expect(exists).To(BeTrue())
+				controller.addMigration(obj)
+				queue, err = controller.pendingMigrationQueue(nil)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(queue).To(HaveLen(2))
+			})
+
+			It("should leave migrations which started since the queue was built out", func() {
+				addPendingMigration("firstmigration", "node0", metav1.Now(), 0)
+				queue, err := controller.pendingMigrationQueue(nil)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(queue).To(HaveLen(1))
+
+				queue, err = controller.pendingMigrationQueue([]*virtv1.VirtualMachineInstanceMigration{queue[0].migration})
+				Expect(err).ToNot(HaveOccurred())
+				Expect(queue).To(BeEmpty())
+			})
+		})
+
 		It("should create target pod and not override existing affinity rules", func() {
 			vmi := newVirtualMachine("testvmi", virtv1.Running)
 			antiAffinityTerm := k8sv1.PodAffinityTerm{
@@ -884,6 +1012,64 @@ var _ = Describe("Migration watcher", func() {
 			expectPodCreation(vmi.Namespace, vmi.UID, migration.UID, 2, 1, 1)
 		})
 
+		It("should restrict the target pod to the nodes requested by the migration", func() {
+			vmi := newVirtualMachine("testvmi", virtv1.Running)
+			vmi.Spec.NodeSelector = map[string]string{"zone": "east"}
+			vmi.Spec.Affinity = &k8sv1.Affinity{
+				NodeAffinity: &k8sv1.NodeAffinity{
+					RequiredDuringSchedulingIgnoredDuringExecution: &k8sv1.NodeSelector{
+						NodeSelectorTerms: []k8sv1.NodeSelectorTerm{
+							{MatchExpressions: []k8sv1.NodeSelectorRequirement{{Key: "gpu", Operator: k8sv1.NodeSelectorOpExists}}},
+							{MatchExpressions: []k8sv1.NodeSelectorRequirement{{Key: "fpga", Operator: k8sv1.NodeSelectorOpExists}}},
+						},
+					},
+				},
+			}
+			migration := newMigration("testmigration", vmi.Name, virtv1.MigrationPending)
+			migration.Spec.AddedNodeSelector = map[string]string{
+				"zone":              "west",
+				k8sv1.LabelHostname: "node02",
+			}
+			rackRequirement := k8sv1.NodeSelectorRequirement{Key: "rack", Operator: k8sv1.NodeSelectorOpIn, Values: []string{"r1"}}
+			preferredTerm := k8sv1.PreferredSchedulingTerm{
+				Weight:     10,
+				Preference: k8sv1.NodeSelectorTerm{MatchExpressions: []k8sv1.NodeSelectorRequirement{rackRequirement}},
+			}
+			migration.Spec.AddedNodeAffinity = &k8sv1.NodeAffinity{
+				RequiredDuringSchedulingIgnoredDuringExecution: &k8sv1.NodeSelector{
+					NodeSelectorTerms: []k8sv1.NodeSelectorTerm{
+						{MatchExpressions: []k8sv1.NodeSelectorRequirement{rackRequirement}},
+					},
+				},
+				PreferredDuringSchedulingIgnoredDuringExecution: []k8sv1.PreferredSchedulingTerm{preferredTerm},
+			}
+
+			addMigration(migration)
+			addVirtualMachineInstance(vmi)
+			addPod(newSourcePodForVirtualMachine(vmi))
+
+			sanityExecute()
+
+			testutils.ExpectEvent(recorder, virtcontroller.SuccessfulCreatePodReason)
+			pods, err := kubeClient.CoreV1().Pods(vmi.Namespace).List(context.Background(), metav1.ListOptions{
+				LabelSelector: fmt.Sprintf("%s=%s", virtv1.MigrationJobLabel, string(migration.UID)),
+			})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(pods.Items).To(HaveLen(1))
+			pod := pods.Items[0]
+			By("preserving the node selector of the VMI")
+			Expect(pod.Spec.NodeSelector).To(HaveKeyWithValue("zone", "east"))
+			Expect(pod.Spec.NodeSelector).To(HaveKeyWithValue(k8sv1.LabelHostname, "node02"))
+			By("combining every required term of the VMI with the added ones")
+			terms := pod.Spec.Affinity.NodeAffinity.RequiredDuringSchedulingIgnoredDuringExecution.NodeSelectorTerms
+			Expect(terms).To(HaveLen(2))
+			Expect(terms[0].MatchExpressions).To(ContainElements(vmi.Spec.Affinity.NodeAffinity.RequiredDuringSchedulingIgnoredDuringExecution.NodeSelectorTerms[0].MatchExpressions[0], rackRequirement))
+			Expect(terms[1].MatchExpressions).To(ContainElements(vmi.Spec.Affinity.NodeAffinity.RequiredDuringSchedulingIgnoredDuringExecution.NodeSelectorTerms[1].MatchExpressions[0], rackRequirement))
+			Expect(pod.Spec.Affinity.NodeAffinity.PreferredDuringSchedulingIgnoredDuringExecution).To(ContainElement(preferredTerm))
+			By("not modifying the VMI")
+			Expect(vmi.Spec.Affinity.NodeAffinity.RequiredDuringSchedulingIgnoredDuringExecution.NodeSelectorTerms[0].MatchExpressions).To(HaveLen(1))
+		})
+
 		It("should place migration in scheduling state if pod exists", func() {
 			vmi := newVirtualMachine("testvmi", virtv1.Running)
 			migration := newMigration("testmigration", vmi.Name, virtv1.MigrationPending)
@@ -1923,6 +2109,70 @@ var _ = Describe("Migration watcher", func() {
 				},
 				true,
 			),
+			Entry("set parallel migration threads",
+				func(p *migrationsv1.MigrationPolicySpec) { p.ParallelMigrationThreads = pointer.P(uint32(4)) },
+				func(c *virtv1.MigrationConfiguration) {
+					Expect(c.ParallelMigrationThreads).To(HaveValue(BeEquivalentTo(4)))
+				},
+				true,
+			),
+			Entry("set compression",
+				func(p *migrationsv1.MigrationPolicySpec) { p.Compression = pointer.P(virtv1.MigrationCompressionZstd) },
+				func(c *virtv1.MigrationConfiguration) {
+					Expect(c.Compression).To(HaveValue(Equal(virtv1.MigrationCompressionZstd)))
+				},
+				true,
+			),
+			Entry("set XBZRLE cache size",
+				func(p *migrationsv1.MigrationPolicySpec) { p.XBZRLECacheSize = &stubResourceQuantity },
+				func(c *virtv1.MigrationConfiguration) {
+					Expect(c.XBZRLECacheSize).ToNot(BeNil())
+					Expect(c.XBZRLECacheSize.Equal(stubResourceQuantity)).To(BeTrue())
+				},
+				true,
+			),
+			Entry("set max downtime",
+				func(p *migrationsv1.MigrationPolicySpec) { p.MaxDowntimeMilliseconds = pointer.P(uint64(500)) },
+				func(c *virtv1.MigrationConfiguration) {
+					Expect(c.MaxDowntimeMilliseconds).To(HaveValue(BeEquivalentTo(500)))
+				},
+				true,
+			),
+			Entry("set retries",
+				func(p *migrationsv1.MigrationPolicySpec) {
+					p.MaxRetries = pointer.P(int32(3))
+					p.RetryBackoffSeconds = pointer.P(int64(30))
+					p.NonConvergenceEscalation = pointer.P(virtv1.MigrationRetryEscalationAutoConverge)
+				},
+				func(c *virtv1.MigrationConfiguration) {
+					Expect(c.MaxRetries).To(HaveValue(BeEquivalentTo(3)))
+					Expect(c.RetryBackoffSeconds).To(HaveValue(BeEquivalentTo(30)))
+					Expect(c.NonConvergenceEscalation).To(HaveValue(Equal(virtv1.MigrationRetryEscalationAutoConverge)))
+				},
+				true,
+			),
+			Entry("set network",
+				func(p *migrationsv1.MigrationPolicySpec) { p.Network = pointer.P("storage-migration-net") },
+				func(c *virtv1.MigrationConfiguration) {
+					Expect(c.Network).To(HaveValue(Equal("storage-migration-net")))
+				},
+				true,
+			),
+			Entry("set post migration verification",
+				func(p *migrationsv1.MigrationPolicySpec) {
+					p.PostMigrationVerification = &virtv1.PostMigrationVerification{
+						Checks:        []virtv1.PostMigrationCheck{virtv1.PostMigrationCheckReadinessProbe},
+						FailureAction: pointer.P(virtv1.PostMigrationFailureActionMigrateBack),
+					}
+				},
+				func(c *virtv1.MigrationConfiguration) {
+					Expect(c.PostMigrationVerification).To(Equal(&virtv1.PostMigrationVerification{
+						Checks:        []virtv1.PostMigrationCheck{virtv1.PostMigrationCheckReadinessProbe},
+						FailureAction: pointer.P(virtv1.PostMigrationFailureActionMigrateBack),
+					}))
+				},
+				true,
+			),
 			Entry("nothing is changed",
 				func(p *migrationsv1.MigrationPolicySpec) {},
 				func(c *virtv1.MigrationConfiguration) {},
@@ -2099,76 +2349,1143 @@ var _ = Describe("Migration watcher", func() {
 		)
 	})
 
-	Context("Descheduler annotations", func() {
+	Context("Block migration of local volumes", func() {
+		const (
+			sourceClaim      = "local-pvc"
+			destinationClaim = "local-pvc-testmigr"
+		)
 		var vmi *virtv1.VirtualMachineInstance
 
-		It("should not add eviction-in-progress annotation in case of non evacuation migration", func() {
+		BeforeEach(func() {
+			setConfig(&virtv1.KubeVirtConfiguration{
+				MigrationConfiguration: &virtv1.MigrationConfiguration{BlockMigrateLocalVolumes: pointer.P(true)},
+			})
+
 			vmi = newVirtualMachine("testvmi", virtv1.Running)
+			vmi.Spec.Domain.Devices.Disks = []virtv1.Disk{{
+				Name:       "disk0",
+				DiskDevice: virtv1.DiskDevice{Disk: &virtv1.DiskTarget{}},
+			}}
+			vmi.Spec.Volumes = []virtv1.Volume{{
+				Name: "disk0",
+				VolumeSource: virtv1.VolumeSource{
+					PersistentVolumeClaim: &virtv1.PersistentVolumeClaimVolumeSource{
+						PersistentVolumeClaimVolumeSource: k8sv1.PersistentVolumeClaimVolumeSource{ClaimName: sourceClaim},
+					},
+				},
+			}}
+			vmi.Status.VolumeStatus = []virtv1.VolumeStatus{{
+				Name: "disk0",
+				PersistentVolumeClaimInfo: &virtv1.PersistentVolumeClaimInfo{
+					ClaimName:   sourceClaim,
+					AccessModes: []k8sv1.PersistentVolumeAccessMode{k8sv1.ReadWriteOnce},
+				},
+			}}
+
+			Expect(controller.pvcStore.Add(&k8sv1.PersistentVolumeClaim{
+				ObjectMeta: metav1.ObjectMeta{Name: sourceClaim, Namespace: vmi.Namespace},
+				Spec: k8sv1.PersistentVolumeClaimSpec{
+					AccessModes:      []k8sv1.PersistentVolumeAccessMode{k8sv1.ReadWriteOnce},
+					StorageClassName: pointer.P("local-nvme"),
+					Resources: k8sv1.VolumeResourceRequirements{
+						Requests: k8sv1.ResourceList{k8sv1.ResourceStorage: resource.MustParse("10Gi")},
+					},
+				},
+				Status: k8sv1.PersistentVolumeClaimStatus{
+					Capacity: k8sv1.ResourceList{k8sv1.ResourceStorage: resource.MustParse("12Gi")},
+				},
+			})).To(Succeed())
+		})
+
+		newVM := func() *virtv1.VirtualMachine {
+			vm := &virtv1.VirtualMachine{
+				ObjectMeta: metav1.ObjectMeta{Name: vmi.Name, Namespace: vmi.Namespace},
+				Spec: virtv1.VirtualMachineSpec{
+					Template: &virtv1.VirtualMachineInstanceTemplateSpec{Spec: *vmi.Spec.DeepCopy()},
+				},
+			}
+			vmi.OwnerReferences = []metav1.OwnerReference{*metav1.NewControllerRef(vm, virtv1.VirtualMachineGroupVersionKind)}
+			return vm
+		}
+
+		addDestinationPVC := func(migrationName string) {
+			pvc := &k8sv1.PersistentVolumeClaim{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:        destinationClaim,
+					Namespace:   vmi.Namespace,
+					Labels:      map[string]string{virtv1.MigrationNameLabel: migrationName},
+					Annotations: map[string]string{virtv1.MigrationSourcePVCAnnotation: sourceClaim},
+				},
+			}
+			Expect(controller.pvcStore.Add(pvc)).To(Succeed())
+			_, err := kubeClient.CoreV1().PersistentVolumeClaims(pvc.Namespace).Create(context.Background(), pvc, metav1.CreateOptions{})
+			Expect(err).ToNot(HaveOccurred())
+		}
+
+		It("should provision a PVC for the target and switch a VMI over to it", func() {
 			migration := newMigration("testmigration", vmi.Name, virtv1.MigrationPending)
+			addMigration(migration)
+			addVirtualMachineInstance(vmi)
+			addPod(newSourcePodForVirtualMachine(vmi))
+
+			sanityExecute()
+
+			testutils.ExpectEvent(recorder, localVolumesCopyReason)
+			expectPodDoesNotExist(vmi.Namespace, string(vmi.UID), string(migration.UID))
+
+			pvc, err := kubeClient.CoreV1().PersistentVolumeClaims(vmi.Namespace).Get(context.Background(), destinationClaim, metav1.GetOptions{})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(pvc.Labels).To(HaveKeyWithValue(virtv1.MigrationNameLabel, migration.Name))
+			Expect(pvc.Annotations).To(HaveKeyWithValue(virtv1.MigrationSourcePVCAnnotation, sourceClaim))
+			Expect(pvc.Spec.StorageClassName).To(HaveValue(Equal("local-nvme")))
+			Expect(pvc.Spec.AccessModes).To(ConsistOf(k8sv1.ReadWriteOnce))
+			Expect(pvc.Spec.Resources.Requests.Storage().String()).To(Equal("12Gi"))
+
+			updatedVMI, err := virtClientset.KubevirtV1().VirtualMachineInstances(vmi.Namespace).Get(context.Background(), vmi.Name, metav1.GetOptions{})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(updatedVMI.Spec.Volumes[0].PersistentVolumeClaim.ClaimName).To(Equal(destinationClaim))
+			Expect(updatedVMI.Annotations).To(HaveKeyWithValue(virtv1.LocalVolumesCopyAnnotation, migration.Name))
+			Expect(updatedVMI.Status.MigratedVolumes).To(ConsistOf(MatchFields(IgnoreExtras, Fields{
+				"VolumeName":         Equal("disk0"),
+				"SourcePVCInfo":      PointTo(MatchFields(IgnoreExtras, Fields{"ClaimName": Equal(sourceClaim)})),
+				"DestinationPVCInfo": PointTo(MatchFields(IgnoreExtras, Fields{"ClaimName": Equal(destinationClaim)})),
+			})))
+		})
 
+		It("should switch only the VMI over to the PVC for the target if a VM owns it", func() {
+			vm := newVM()
+			_, err := virtClientset.KubevirtV1().VirtualMachines(vm.Namespace).Create(context.Background(), vm, metav1.CreateOptions{})
+			Expect(err).ToNot(HaveOccurred())
+			migration := newMigration("testmigration", vmi.Name, virtv1.MigrationPending)
 			addMigration(migration)
 			addVirtualMachineInstance(vmi)
-			sourcePod := newSourcePodForVirtualMachine(vmi)
-			addPod(sourcePod)
+			addPod(newSourcePodForVirtualMachine(vmi))
 
 			sanityExecute()
 
-			testutils.ExpectEvents(recorder, virtcontroller.SuccessfulCreatePodReason)
-			expectPodCreation(vmi.Namespace, vmi.UID, migration.UID, 1, 0, 0)
-			updatedSourcePod, err := kubeClient.CoreV1().Pods(vmi.Namespace).Get(context.Background(), sourcePod.Name, metav1.GetOptions{})
+			testutils.ExpectEvent(recorder, localVolumesCopyReason)
+			expectPodDoesNotExist(vmi.Namespace, string(vmi.UID), string(migration.UID))
+
+			updatedVM, err := virtClientset.KubevirtV1().VirtualMachines(vm.Namespace).Get(context.Background(), vm.Name, metav1.GetOptions{})
 			Expect(err).ToNot(HaveOccurred())
-			Expect(updatedSourcePod.Annotations).ToNot(HaveKey(descheduler.EvictionInProgressAnnotation))
+			Expect(updatedVM.Spec).To(Equal(vm.Spec))
+
+			updatedVMI, err := virtClientset.KubevirtV1().VirtualMachineInstances(vmi.Namespace).Get(context.Background(), vmi.Name, metav1.GetOptions{})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(updatedVMI.Spec.Volumes[0].PersistentVolumeClaim.ClaimName).To(Equal(destinationClaim))
+			Expect(updatedVMI.Annotations).To(HaveKeyWithValue(virtv1.LocalVolumesCopyAnnotation, migration.Name))
 		})
 
-		Context("with an evacuation migration", func() {
-			It("should add eviction-in-progress annotation only to source virt-launcher pod", func() {
-				vmi = newVirtualMachine("testvmi", virtv1.Running)
-				migration := newMigration("testmigration", vmi.Name, virtv1.MigrationPending)
-				setAnnotation(virtv1.EvacuationMigrationAnnotation, migration)
+		It("should create the target pod once the VMI uses the PVC for the target", func() {
+			vmi.Spec.Volumes[0].PersistentVolumeClaim.ClaimName = destinationClaim
+			vmi.Status.MigratedVolumes = []virtv1.StorageMigratedVolumeInfo{{
+				VolumeName:         "disk0",
+				SourcePVCInfo:      &virtv1.PersistentVolumeClaimInfo{ClaimName: sourceClaim},
+				DestinationPVCInfo: &virtv1.PersistentVolumeClaimInfo{ClaimName: destinationClaim},
+			}}
+			addDestinationPVC("testmigration")
+			migration := newMigration("testmigration", vmi.Name, virtv1.MigrationPending)
+			addMigration(migration)
+			addVirtualMachineInstance(vmi)
+			addPod(newSourcePodForVirtualMachine(vmi))
 
-				addMigration(migration)
-				addVirtualMachineInstance(vmi)
-				sourcePod := newSourcePodForVirtualMachine(vmi)
-				addPod(sourcePod)
+			sanityExecute()
 
-				sanityExecute()
+			testutils.ExpectEvent(recorder, virtcontroller.SuccessfulCreatePodReason)
+			expectPodCreation(vmi.Namespace, vmi.UID, migration.UID, 1, 0, 0)
+		})
 
-				testutils.ExpectEvents(recorder, virtcontroller.SuccessfulCreatePodReason)
-				expectPodCreation(vmi.Namespace, vmi.UID, migration.UID, 1, 0, 0)
-				updatedSourcePod, err := kubeClient.CoreV1().Pods(vmi.Namespace).Get(context.Background(), sourcePod.Name, metav1.GetOptions{})
-				Expect(err).ToNot(HaveOccurred())
-				Expect(updatedSourcePod.Annotations).To(HaveKeyWithValue(descheduler.EvictionInProgressAnnotation, "kubevirt"))
+		It("should switch the VMI back and delete the PVC for the target when the migration failed", func() {
+			vm := newVM()
+			_, err := virtClientset.KubevirtV1().VirtualMachines(vm.Namespace).Create(context.Background(), vm, metav1.CreateOptions{})
+			Expect(err).ToNot(HaveOccurred())
+			vmi.Spec.Volumes[0].PersistentVolumeClaim.ClaimName = destinationClaim
+			vmi.Annotations = map[string]string{virtv1.LocalVolumesCopyAnnotation: "testmigration"}
+			addDestinationPVC("testmigration")
+			migration := newMigration("testmigration", vmi.Name, virtv1.MigrationFailed)
+			addMigration(migration)
+			addVirtualMachineInstance(vmi)
 
-				pods, err := kubeClient.CoreV1().Pods(migration.Namespace).List(context.Background(), metav1.ListOptions{
-					LabelSelector: fmt.Sprintf("%s=%s,%s=%s", virtv1.MigrationJobLabel, string(migration.UID), virtv1.CreatedByLabel, string(vmi.UID)),
-				})
-				Expect(err).ToNot(HaveOccurred())
-				Expect(pods.Items).To(HaveLen(1))
-				targetPod := pods.Items[0]
-				Expect(targetPod.Annotations).ToNot(HaveKey(descheduler.EvictionInProgressAnnotation))
-			})
+			sanityExecute()
 
-			It("should remove eviction-in-progress annotation from source virt-launcher pod in case of failure", func() {
-				By("Create a pending migration")
-				vmi = newVirtualMachine("testvmi", virtv1.Running)
-				sourcePod := newSourcePodForVirtualMachine(vmi)
-				sourcePod.Annotations[descheduler.EvictionInProgressAnnotation] = "kubevirt"
-				migration := newMigration("testmigration", vmi.Name, virtv1.MigrationFailed)
-				migration.Status.MigrationState = &virtv1.VirtualMachineInstanceMigrationState{
-					SourcePod: sourcePod.Name,
-				}
-				setAnnotation(virtv1.EvacuationMigrationAnnotation, migration)
+			testutils.ExpectEvent(recorder, localVolumesCopyReason)
+			updatedVMI, err := virtClientset.KubevirtV1().VirtualMachineInstances(vmi.Namespace).Get(context.Background(), vmi.Name, metav1.GetOptions{})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(updatedVMI.Spec.Volumes[0].PersistentVolumeClaim.ClaimName).To(Equal(sourceClaim))
+			Expect(updatedVMI.Annotations).ToNot(HaveKey(virtv1.LocalVolumesCopyAnnotation))
+			updatedVM, err := virtClientset.KubevirtV1().VirtualMachines(vm.Namespace).Get(context.Background(), vm.Name, metav1.GetOptions{})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(updatedVM.Spec).To(Equal(vm.Spec))
+			_, err = kubeClient.CoreV1().PersistentVolumeClaims(vmi.Namespace).Get(context.Background(), destinationClaim, metav1.GetOptions{})
+			Expect(err).To(MatchError(k8serrors.IsNotFound, "IsNotFound"))
+		})
 
-				addMigration(migration)
-				addVirtualMachineInstance(vmi)
-				addPod(sourcePod)
+		It("should keep the PVC for the target and switch the VM over to it when the migration succeeded", func() {
+			vm := newVM()
+			_, err := virtClientset.KubevirtV1().VirtualMachines(vm.Namespace).Create(context.Background(), vm, metav1.CreateOptions{})
+			Expect(err).ToNot(HaveOccurred())
+			_, err = kubeClient.CoreV1().PersistentVolumeClaims(vmi.Namespace).Create(context.Background(), &k8sv1.PersistentVolumeClaim{
+				ObjectMeta: metav1.ObjectMeta{Name: sourceClaim, Namespace: vmi.Namespace},
+			}, metav1.CreateOptions{})
+			Expect(err).ToNot(HaveOccurred())
+			vmi.Spec.Volumes[0].PersistentVolumeClaim.ClaimName = destinationClaim
+			vmi.Annotations = map[string]string{virtv1.LocalVolumesCopyAnnotation: "testmigration"}
+			addDestinationPVC("testmigration")
+			migration := newMigration("testmigration", vmi.Name, virtv1.MigrationSucceeded)
+			addMigration(migration)
+			addVirtualMachineInstance(vmi)
 
-				sanityExecute()
+			sanityExecute()
 
-				updatedSourcePod, err := kubeClient.CoreV1().Pods(vmi.Namespace).Get(context.Background(), sourcePod.Name, metav1.GetOptions{})
-				Expect(err).ToNot(HaveOccurred())
-				Expect(updatedSourcePod.Annotations).ToNot(HaveKeyWithValue(descheduler.EvictionInProgressAnnotation, ""))
-			})
+			pvc, err := kubeClient.CoreV1().PersistentVolumeClaims(vmi.Namespace).Get(context.Background(), destinationClaim, metav1.GetOptions{})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(pvc.Labels).ToNot(HaveKey(virtv1.MigrationNameLabel))
+			updatedVM, err := virtClientset.KubevirtV1().VirtualMachines(vm.Namespace).Get(context.Background(), vm.Name, metav1.GetOptions{})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(updatedVM.Spec.Template.Spec.Volumes[0].PersistentVolumeClaim.ClaimName).To(Equal(destinationClaim))
+			Expect(updatedVM.Spec.UpdateVolumesStrategy).To(BeNil())
+			updatedVMI, err := virtClientset.KubevirtV1().VirtualMachineInstances(vmi.Namespace).Get(context.Background(), vmi.Name, metav1.GetOptions{})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(updatedVMI.Annotations).ToNot(HaveKey(virtv1.LocalVolumesCopyAnnotation))
+			testutils.ExpectEvent(recorder, localVolumesCopyReason)
+			_, err = kubeClient.CoreV1().PersistentVolumeClaims(vmi.Namespace).Get(context.Background(), sourceClaim, metav1.GetOptions{})
+			Expect(err).To(MatchError(k8serrors.IsNotFound, "IsNotFound"))
+		})
+
+		It("should drop the DataVolumeTemplate and delete the DataVolume of the source when the migration succeeded", func() {
+			vm := newVM()
+			vm.Spec.DataVolumeTemplates = []virtv1.DataVolumeTemplateSpec{
+				{ObjectMeta: metav1.ObjectMeta{Name: sourceClaim}},
+				{ObjectMeta: metav1.ObjectMeta{Name: "other-dv"}},
+			}
+			vm.Spec.Template.Spec.Volumes[0].VolumeSource = virtv1.VolumeSource{
+				DataVolume: &virtv1.DataVolumeSource{Name: sourceClaim},
+			}
+			_, err := virtClientset.KubevirtV1().VirtualMachines(vm.Namespace).Create(context.Background(), vm, metav1.CreateOptions{})
+			Expect(err).ToNot(HaveOccurred())
+			dv := &cdiv1.DataVolume{ObjectMeta: metav1.ObjectMeta{Name: sourceClaim, Namespace: vmi.Namespace}}
+			_, err = cdiClient.CdiV1beta1().DataVolumes(dv.Namespace).Create(context.Background(), dv, metav1.CreateOptions{})
+			Expect(err).ToNot(HaveOccurred())
+			obj, exists, err := controller.pvcStore.GetByKey(vmi.Namespace + "/" + sourceClaim)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(exists).To(BeTrue())
+			sourcePVC := obj.(*k8sv1.PersistentVolumeClaim).DeepCopy()
+			sourcePVC.OwnerReferences = []metav1.OwnerReference{{
+				APIVersion: cdiv1.SchemeGroupVersion.String(),
+				Kind:       "DataVolume",
+				Name:       dv.Name,
+				Controller: pointer.P(true),
+			}}
+			Expect(controller.pvcStore.Update(sourcePVC)).To(Succeed())
+			vmi.Spec.Volumes[0].PersistentVolumeClaim.ClaimName = destinationClaim
+			vmi.Annotations = map[string]string{virtv1.LocalVolumesCopyAnnotation: "testmigration"}
+			addDestinationPVC("testmigration")
+			migration := newMigration("testmigration", vmi.Name, virtv1.MigrationSucceeded)
+			addMigration(migration)
+			addVirtualMachineInstance(vmi)
+
+			sanityExecute()
+
+			testutils.ExpectEvent(recorder, localVolumesCopyReason)
+			updatedVM, err := virtClientset.KubevirtV1().VirtualMachines(vm.Namespace).Get(context.Background(), vm.Name, metav1.GetOptions{})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(updatedVM.Spec.Template.Spec.Volumes[0].PersistentVolumeClaim.ClaimName).To(Equal(destinationClaim))
+			Expect(updatedVM.Spec.DataVolumeTemplates).To(ConsistOf(vm.Spec.DataVolumeTemplates[1]))
+			_, err = cdiClient.CdiV1beta1().DataVolumes(dv.Namespace).Get(context.Background(), dv.Name, metav1.GetOptions{})
+			Expect(err).To(MatchError(k8serrors.IsNotFound, "IsNotFound"))
+		})
+
+		DescribeTable("should name the PVC for the target after the source", func(sourceName string, isCopy bool, expectedName string) {
+			migration := newMigration("testmigration", vmi.Name, virtv1.MigrationPending)
+			migration.UID = "0123456789abcdef"
+			sourcePVC := &k8sv1.PersistentVolumeClaim{ObjectMeta: metav1.ObjectMeta{Name: sourceName}}
+			if isCopy {
+				sourcePVC.Annotations = map[string]string{virtv1.MigrationSourcePVCAnnotation: "earlier"}
+			}
+			Expect(destinationPVCName(migration, sourcePVC)).To(Equal(expectedName))
+		},
+			Entry("by appending the migration", "disk", false, "disk-01234567"),
+			Entry("by replacing the migration a copy was made by", "disk-fedcba98", true, "disk-01234567"),
+			Entry("by keeping the suffix of a source which is not a copy", "disk-fedcba98", false, "disk-fedcba98-01234567"),
+		)
+
+		It("should keep the name of the PVC for the target within the name length limit", func() {
+			migration := newMigration("testmigration", vmi.Name, virtv1.MigrationPending)
+			migration.UID = "0123456789abcdef"
+			sourcePVC := &k8sv1.PersistentVolumeClaim{ObjectMeta: metav1.ObjectMeta{
+				Name: strings.Repeat("a", validation.DNS1123SubdomainMaxLength),
+			}}
+
+			name := destinationPVCName(migration, sourcePVC)
+			Expect(name).To(HaveLen(validation.DNS1123SubdomainMaxLength))
+			Expect(name).To(HaveSuffix("-01234567"))
+
+			sourcePVC.Name = name
+			sourcePVC.Annotations = map[string]string{virtv1.MigrationSourcePVCAnnotation: "earlier"}
+			migration.UID = "fedcba9876543210"
+			Expect(destinationPVCName(migration, sourcePVC)).To(HaveLen(validation.DNS1123SubdomainMaxLength))
+		})
+	})
+
+	Context("Migration retry", func() {
+		var vmi *virtv1.VirtualMachineInstance
+
+		BeforeEach(func() {
+			vmi = newVirtualMachine("testvmi", virtv1.Running)
+		})
+
+		newFailedMigration := func(class virtv1.MigrationFailureClass, attempts int, failedAt metav1.Time) *virtv1.VirtualMachineInstanceMigration {
+			migration := newMigration("testmigration", vmi.Name, virtv1.MigrationFailed)
+			migration.Status.Failure = &virtv1.VirtualMachineInstanceMigrationFailure{Class: class}
+			for i := 0; i < attempts; i++ {
+				migration.Status.AttemptHistory = append(migration.Status.AttemptHistory, virtv1.VirtualMachineInstanceMigrationAttempt{
+					MigrationName: "testmigration",
+					EndTimestamp:  pointer.P(failedAt),
+					Phase:         virtv1.MigrationFailed,
+					Failure:       migration.Status.Failure,
+				})
+			}
+			return migration
+		}
+
+		getRetry := func(name string) (*virtv1.VirtualMachineInstanceMigration, error) {
+			return virtClientset.KubevirtV1().VirtualMachineInstanceMigrations(vmi.Namespace).Get(context.Background(), name, metav1.GetOptions{})
+		}
+
+		DescribeTable("should classify a failure reported by the source", func(reason string, reportedClass, expectedClass virtv1.MigrationFailureClass) {
+			migration := newMigration("testmigration", vmi.Name, virtv1.MigrationRunning)
+			vmi.Status.MigrationState = &virtv1.VirtualMachineInstanceMigrationState{
+				MigrationUID:  migration.UID,
+				TargetNode:    "node01",
+				SourceNode:    "node02",
+				Failed:        true,
+				FailureReason: reason,
+				FailureClass:  reportedClass,
+			}
+
+			addMigration(migration)
+			addVirtualMachineInstance(vmi)
+			addPod(newSourcePodForVirtualMachine(vmi))
+			addPod(newTargetPodForVirtualMachine(vmi, migration, k8sv1.PodRunning))
+
+			sanityExecute()
+
+			testutils.ExpectEvent(recorder, virtcontroller.FailedMigrationReason)
+			updatedMigration, err := virtClientset.KubevirtV1().VirtualMachineInstanceMigrations(migration.Namespace).Get(context.Background(), migration.Name, metav1.GetOptions{})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(updatedMigration.Status.Phase).To(Equal(virtv1.MigrationFailed))
+			Expect(updatedMigration.Status.Failure).To(Equal(&virtv1.VirtualMachineInstanceMigrationFailure{Class: expectedClass, Message: reason}))
+			Expect(updatedMigration.Status.AttemptHistory).To(ConsistOf(MatchFields(IgnoreExtras, Fields{
+				"MigrationName": Equal(migration.Name),
+				"SourceNode":    Equal("node02"),
+				"TargetNode":    Equal("node01"),
+				"Phase":         Equal(virtv1.MigrationFailed),
+				"Failure":       Equal(updatedMigration.Status.Failure),
+			})))
+		},
+			Entry("as non-convergence", "Live migration is not completed after 300 seconds and has been aborted",
+				virtv1.MigrationFailureNonConvergence, virtv1.MigrationFailureNonConvergence),
+			Entry("as timeout", "Live migration stuck for 150 seconds and has been aborted",
+				virtv1.MigrationFailureTimeout, virtv1.MigrationFailureTimeout),
+			Entry("as network failure", "Live migration failed virError(Message='unable to connect to server: Connection refused')",
+				virtv1.MigrationFailureNetwork, virtv1.MigrationFailureNetwork),
+			Entry("as guest failure", "Live migration failed virError(Message='guest crashed')",
+				virtv1.MigrationFailureGuest, virtv1.MigrationFailureGuest),
+			Entry("as unknown failure if the source did not classify it", "Live migration failed virError(Message='unable to connect to server')",
+				virtv1.MigrationFailureClass(""), virtv1.MigrationFailureUnknown),
+		)
+
+		It("should classify a failure before the hand-off as target scheduling failure", func() {
+			migration := newMigration("testmigration", vmi.Name, virtv1.MigrationScheduling)
+			vmi.Status.MigrationState = &virtv1.VirtualMachineInstanceMigrationState{
+				MigrationUID: migration.UID,
+			}
+
+			addMigration(migration)
+			addVirtualMachineInstance(vmi)
+			addPod(newSourcePodForVirtualMachine(vmi))
+			addPod(newTargetPodForVirtualMachine(vmi, migration, k8sv1.PodFailed))
+
+			sanityExecute()
+
+			testutils.ExpectEvent(recorder, virtcontroller.FailedMigrationReason)
+			updatedMigration, err := virtClientset.KubevirtV1().VirtualMachineInstanceMigrations(migration.Namespace).Get(context.Background(), migration.Name, metav1.GetOptions{})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(updatedMigration.Status.Failure.Class).To(Equal(virtv1.MigrationFailureTargetScheduling))
+		})
+
+		It("should retry a failed migration once the backoff passed", func() {
+			setConfig(&virtv1.KubeVirtConfiguration{
+				MigrationConfiguration: &virtv1.MigrationConfiguration{MaxRetries: pointer.P(int32(2))},
+			})
+			migration := newFailedMigration(virtv1.MigrationFailureNetwork, 1, metav1.NewTime(time.Now().Add(-time.Minute)))
+			migration.Spec.Priority = pointer.P(int32(5))
+			setAnnotation(virtv1.EvacuationMigrationAnnotation, migration)
+
+			addMigration(migration)
+			addVirtualMachineInstance(vmi)
+
+			sanityExecute()
+
+			testutils.ExpectEvent(recorder, migrationRetryReason)
+			retry, err := getRetry("testmigration-retry-1")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(retry.Annotations).To(HaveKeyWithValue(virtv1.MigrationRetryOfAnnotation, migration.Name))
+			Expect(retry.Annotations).To(HaveKey(virtv1.EvacuationMigrationAnnotation))
+			Expect(retry.Annotations).ToNot(HaveKey(virtv1.MigrationRetryEscalationAnnotation))
+			Expect(retry.Spec).To(Equal(migration.Spec))
+		})
+
+		It("should escalate the retry of a migration which did not converge", func() {
+			setConfig(&virtv1.KubeVirtConfiguration{
+				MigrationConfiguration: &virtv1.MigrationConfiguration{
+					MaxRetries:               pointer.P(int32(1)),
+					NonConvergenceEscalation: pointer.P(virtv1.MigrationRetryEscalationPostCopy),
+				},
+			})
+			migration := newFailedMigration(virtv1.MigrationFailureNonConvergence, 1, metav1.NewTime(time.Now().Add(-time.Minute)))
+
+			addMigration(migration)
+			addVirtualMachineInstance(vmi)
+
+			sanityExecute()
+
+			testutils.ExpectEvent(recorder, migrationRetryReason)
+			retry, err := getRetry("testmigration-retry-1")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(retry.Annotations).To(HaveKeyWithValue(virtv1.MigrationRetryEscalationAnnotation, string(virtv1.MigrationRetryEscalationPostCopy)))
+		})
+
+		It("should keep the name of a retry within the name length limit", func() {
+			setConfig(&virtv1.KubeVirtConfiguration{
+				MigrationConfiguration: &virtv1.MigrationConfiguration{MaxRetries: pointer.P(int32(1))},
+			})
+			migration := newFailedMigration(virtv1.MigrationFailureNetwork, 1, metav1.NewTime(time.Now().Add(-time.Minute)))
+			migration.Name = strings.Repeat("a", validation.DNS1123SubdomainMaxLength)
+			migration.Status.AttemptHistory[0].MigrationName = migration.Name
+
+			addMigration(migration)
+			addVirtualMachineInstance(vmi)
+
+			sanityExecute()
+
+			testutils.ExpectEvent(recorder, migrationRetryReason)
+			retries, err := virtClientset.KubevirtV1().VirtualMachineInstanceMigrations(vmi.Namespace).List(context.Background(), metav1.ListOptions{})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(retries.Items).To(ContainElement(MatchFields(IgnoreExtras, Fields{
+				"ObjectMeta": MatchFields(IgnoreExtras, Fields{
+					"Name": And(HaveSuffix("-retry-1"), HaveLen(validation.DNS1123SubdomainMaxLength)),
+				}),
+			})))
+		})
+
+		DescribeTable("should cap the retry backoff", func(retries int, expected time.Duration) {
+			Expect(migrationRetryBackoff(&virtv1.MigrationConfiguration{}, retries)).To(Equal(expected))
+		},
+			Entry("not on the first retry", 0, 10*time.Second),
+			Entry("not while doubling stays below the maximum", 3, 80*time.Second),
+			Entry("at the maximum", 20, maxMigrationRetryBackoff),
+			Entry("without overflowing", 100, maxMigrationRetryBackoff),
+		)
+
+		DescribeTable("should not retry a failed migration", func(maxRetries *int32, class virtv1.MigrationFailureClass, attempts int, failedAt metav1.Time) {
+			setConfig(&virtv1.KubeVirtConfiguration{
+				MigrationConfiguration: &virtv1.MigrationConfiguration{MaxRetries: maxRetries},
+			})
+			migration := newFailedMigration(class, attempts, failedAt)
+
+			addMigration(migration)
+			addVirtualMachineInstance(vmi)
+
+			sanityExecute()
+
+			_, err := getRetry(fmt.Sprintf("testmigration-retry-%d", attempts))
+			Expect(err).To(MatchError(k8serrors.IsNotFound, "IsNotFound"))
+		},
+			Entry("if retries are not configured", nil, virtv1.MigrationFailureNetwork, 1, metav1.NewTime(time.Now().Add(-time.Hour))),
+			Entry("if the guest failed", pointer.P(int32(3)), virtv1.MigrationFailureGuest, 1, metav1.NewTime(time.Now().Add(-time.Hour))),
+			Entry("if it was aborted", pointer.P(int32(3)), virtv1.MigrationFailureAborted, 1, metav1.NewTime(time.Now().Add(-time.Hour))),
+			Entry("if all retries are used up", pointer.P(int32(2)), virtv1.MigrationFailureNetwork, 3, metav1.NewTime(time.Now().Add(-time.Hour))),
+			Entry("before the backoff passed", pointer.P(int32(3)), virtv1.MigrationFailureNetwork, 2, metav1.Now()),
+		)
+
+		It("should not retry a failed migration if a newer migration took over", func() {
+			setConfig(&virtv1.KubeVirtConfiguration{
+				MigrationConfiguration: &virtv1.MigrationConfiguration{MaxRetries: pointer.P(int32(2))},
+			})
+			migration := newFailedMigration(virtv1.MigrationFailureNetwork, 1, metav1.NewTime(time.Now().Add(-time.Minute)))
+			migration.CreationTimestamp = metav1.NewTime(time.Now().Add(-time.Hour))
+
+			addMigration(migration)
+			addVirtualMachineInstance(vmi)
+			addMigration(newMigration("evacuation", vmi.Name, virtv1.MigrationPending))
+
+			sanityExecute()
+
+			_, err := getRetry("testmigration-retry-1")
+			Expect(err).To(MatchError(k8serrors.IsNotFound, "IsNotFound"))
+		})
+
+		It("should take over the attempt history of the retried migration", func() {
+			failedMigration := newFailedMigration(virtv1.MigrationFailureTimeout, 2, metav1.Now())
+			retry := newMigration("testmigration-retry-2", vmi.Name, virtv1.MigrationPhaseUnset)
+			retry.Annotations[virtv1.MigrationRetryOfAnnotation] = failedMigration.Name
+
+			addMigration(retry)
+			addVirtualMachineInstance(vmi)
+			addPod(newSourcePodForVirtualMachine(vmi))
+			addMigration(failedMigration)
+
+			sanityExecute()
+
+			updatedRetry, err := getRetry(retry.Name)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(updatedRetry.Status.Phase).To(Equal(virtv1.MigrationPending))
+			Expect(updatedRetry.Status.AttemptHistory).To(Equal(failedMigration.Status.AttemptHistory))
+		})
+
+		DescribeTable("should apply the escalation of a retry", func(escalation virtv1.MigrationRetryEscalation, configured *virtv1.MigrationRetryEscalation, expected *virtv1.MigrationConfiguration) {
+			migration := newMigration("testmigration-retry-1", vmi.Name, virtv1.MigrationScheduled)
+			migration.Annotations[virtv1.MigrationRetryEscalationAnnotation] = string(escalation)
+			migrationConfiguration := &virtv1.MigrationConfiguration{NonConvergenceEscalation: configured}
+
+			applyRetryEscalation(migration, migrationConfiguration)
+
+			expected.NonConvergenceEscalation = configured
+			Expect(migrationConfiguration).To(Equal(expected))
+		},
+			Entry("switching to post-copy", virtv1.MigrationRetryEscalationPostCopy, pointer.P(virtv1.MigrationRetryEscalationPostCopy),
+				&virtv1.MigrationConfiguration{AllowPostCopy: pointer.P(true), AllowWorkloadDisruption: pointer.P(true)}),
+			Entry("enabling auto-converge", virtv1.MigrationRetryEscalationAutoConverge, pointer.P(virtv1.MigrationRetryEscalationAutoConverge),
+				&virtv1.MigrationConfiguration{AllowAutoConverge: pointer.P(true)}),
+			Entry("not if it is no longer configured", virtv1.MigrationRetryEscalationPostCopy, nil, &virtv1.MigrationConfiguration{}),
+		)
+	})
+
+	Context("Post migration verification", func() {
+		var vmi *virtv1.VirtualMachineInstance
+		var migration *virtv1.VirtualMachineInstanceMigration
+
+		newVerifiedMigration := func(verification *virtv1.PostMigrationVerification, endedAgo time.Duration) {
+			migration = newMigration("testmigration", vmi.Name, virtv1.MigrationSucceeded)
+			addNodeNameToVMI(vmi, "node01")
+			vmi.Status.MigrationState = &virtv1.VirtualMachineInstanceMigrationState{
+				MigrationUID: migration.UID,
+				SourceNode:   "node02",
+				TargetNode:   "node01",
+				Completed:    true,
+				EndTimestamp: pointer.P(metav1.NewTime(time.Now().Add(-endedAgo))),
+				MigrationConfiguration: &virtv1.MigrationConfiguration{
+					PostMigrationVerification: verification,
+				},
+			}
+		}
+
+		getMigration := func(name string) (*virtv1.VirtualMachineInstanceMigration, error) {
+			return virtClientset.KubevirtV1().VirtualMachineInstanceMigrations(vmi.Namespace).Get(context.Background(), name, metav1.GetOptions{})
+		}
+
+		getVMI := func() *virtv1.VirtualMachineInstance {
+			updatedVMI, err := virtClientset.KubevirtV1().VirtualMachineInstances(vmi.Namespace).Get(context.Background(), vmi.Name, metav1.GetOptions{})
+			Expect(err).ToNot(HaveOccurred())
+			return updatedVMI
+		}
+
+		guestAgentVerification := func(action virtv1.PostMigrationFailureAction) *virtv1.PostMigrationVerification {
+			return &virtv1.PostMigrationVerification{
+				Checks:        []virtv1.PostMigrationCheck{virtv1.PostMigrationCheckGuestAgent},
+				FailureAction: pointer.P(action),
+			}
+		}
+
+		BeforeEach(func() {
+			vmi = newVirtualMachine("testvmi", virtv1.Running)
+		})
+
+		It("should succeed once the guest passes the checks", func() {
+			newVerifiedMigration(guestAgentVerification(virtv1.PostMigrationFailureActionReport), time.Second)
+			vmi.Status.Conditions = []virtv1.VirtualMachineInstanceCondition{
+				{Type: virtv1.VirtualMachineInstanceAgentConnected, Status: k8sv1.ConditionTrue},
+			}
+
+			addMigration(migration)
+			addVirtualMachineInstance(vmi)
+
+			sanityExecute()
+
+			updatedMigration, err := getMigration(migration.Name)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(updatedMigration.Status.PostMigrationVerification).ToNot(BeNil())
+			Expect(updatedMigration.Status.PostMigrationVerification.Phase).To(Equal(virtv1.PostMigrationVerificationSucceeded))
+			Expect(updatedMigration.Status.PostMigrationVerification.FailedChecks).To(BeEmpty())
+		})
+
+		It("should keep verifying the guest within the timeout", func() {
+			newVerifiedMigration(guestAgentVerification(virtv1.PostMigrationFailureActionReport), time.Second)
+			migration.Status.PostMigrationVerification = &virtv1.PostMigrationVerificationStatus{
+				Phase:          virtv1.PostMigrationVerificationPending,
+				StartTimestamp: vmi.Status.MigrationState.EndTimestamp,
+			}
+
+			addMigration(migration)
+			addVirtualMachineInstance(vmi)
+
+			sanityExecute()
+
+			updatedMigration, err := getMigration(migration.Name)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(updatedMigration.Status.PostMigrationVerification.Phase).To(Equal(virtv1.PostMigrationVerificationPending))
+			Expect(updatedMigration.Status.PostMigrationVerification.FailedChecks).To(ConsistOf(virtv1.PostMigrationCheckGuestAgent))
+			Expect(mockQueue.GetAddAfterEnqueueCount()).To(Equal(1))
+		})
+
+		It("should fail once the timeout expired", func() {
+			newVerifiedMigration(guestAgentVerification(virtv1.PostMigrationFailureActionReport), 2*time.Minute)
+
+			addMigration(migration)
+			addVirtualMachineInstance(vmi)
+
+			sanityExecute()
+
+			updatedMigration, err := getMigration(migration.Name)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(updatedMigration.Status.PostMigrationVerification.Phase).To(Equal(virtv1.PostMigrationVerificationFailed))
+			Expect(updatedMigration.Status.PostMigrationVerification.FailedChecks).To(ConsistOf(virtv1.PostMigrationCheckGuestAgent))
+			Expect(updatedMigration.Status.PostMigrationVerification.Message).To(ContainSubstring("GuestAgent"))
+			Expect(updatedMigration.Status.PostMigrationVerification.EndTimestamp).ToNot(BeNil())
+		})
+
+		DescribeTable("should check the network of the guest", func(condition *virtv1.VirtualMachineInstanceCondition, expectedPhase virtv1.PostMigrationVerificationPhase) {
+			newVerifiedMigration(&virtv1.PostMigrationVerification{
+				Checks: []virtv1.PostMigrationCheck{virtv1.PostMigrationCheckNetwork},
+			}, 2*time.Minute)
+			if condition != nil {
+				vmi.Status.Conditions = []virtv1.VirtualMachineInstanceCondition{*condition}
+			}
+
+			addMigration(migration)
+			addVirtualMachineInstance(vmi)
+
+			sanityExecute()
+
+			updatedMigration, err := getMigration(migration.Name)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(updatedMigration.Status.PostMigrationVerification.Phase).To(Equal(expectedPhase))
+		},
+			Entry("passing if virt-handler reached the guest after the migration", &virtv1.VirtualMachineInstanceCondition{
+				Type:          virtv1.VirtualMachineInstanceGuestNetworkReachable,
+				Status:        k8sv1.ConditionTrue,
+				LastProbeTime: metav1.Now(),
+			}, virtv1.PostMigrationVerificationSucceeded),
+			Entry("failing if virt-handler only reached the guest before the migration", &virtv1.VirtualMachineInstanceCondition{
+				Type:          virtv1.VirtualMachineInstanceGuestNetworkReachable,
+				Status:        k8sv1.ConditionTrue,
+				LastProbeTime: metav1.NewTime(time.Now().Add(-time.Hour)),
+			}, virtv1.PostMigrationVerificationFailed),
+			Entry("failing if virt-handler did not reach the guest", &virtv1.VirtualMachineInstanceCondition{
+				Type:          virtv1.VirtualMachineInstanceGuestNetworkReachable,
+				Status:        k8sv1.ConditionFalse,
+				LastProbeTime: metav1.Now(),
+			}, virtv1.PostMigrationVerificationFailed),
+			Entry("failing if virt-handler did not probe the guest", nil, virtv1.PostMigrationVerificationFailed),
+		)
+
+		It("should report a failed verification on the VMI", func() {
+			newVerifiedMigration(guestAgentVerification(virtv1.PostMigrationFailureActionReport), 2*time.Minute)
+			migration.Status.PostMigrationVerification = &virtv1.PostMigrationVerificationStatus{
+				Phase:        virtv1.PostMigrationVerificationFailed,
+				FailedChecks: []virtv1.PostMigrationCheck{virtv1.PostMigrationCheckGuestAgent},
+				Message:      "the guest did not pass the GuestAgent checks",
+			}
+
+			addMigration(migration)
+			addVirtualMachineInstance(vmi)
+
+			sanityExecute()
+
+			testutils.ExpectEvent(recorder, postMigrationVerificationFailedReason)
+			Expect(getVMI().Status.Conditions).To(ContainElement(MatchFields(IgnoreExtras, Fields{
+				"Type":    Equal(virtv1.VirtualMachineInstancePostMigrationVerificationFailed),
+				"Status":  Equal(k8sv1.ConditionTrue),
+				"Reason":  Equal(postMigrationChecksFailedReason),
+				"Message": ContainSubstring(migration.Name),
+			})))
+			_, err := getMigration(migration.Name + "-rollback")
+			Expect(err).To(MatchError(k8serrors.IsNotFound, "IsNotFound"))
+		})
+
+		It("should migrate the VMI back to its source node if the verification failed", func() {
+			newVerifiedMigration(guestAgentVerification(virtv1.PostMigrationFailureActionMigrateBack), 2*time.Minute)
+			migration.Status.PostMigrationVerification = &virtv1.PostMigrationVerificationStatus{
+				Phase: virtv1.PostMigrationVerificationFailed,
+			}
+			addNode(&k8sv1.Node{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:   "node02",
+					Labels: map[string]string{k8sv1.LabelHostname: "node02-hostname"},
+				},
+			})
+
+			addMigration(migration)
+			addVirtualMachineInstance(vmi)
+
+			sanityExecute()
+
+			testutils.ExpectEvent(recorder, postMigrationVerificationFailedReason)
+			testutils.ExpectEvent(recorder, migrationRollbackReason)
+			rollback, err := getMigration(migration.Name + "-rollback")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(rollback.Annotations).To(HaveKeyWithValue(virtv1.MigrationRollbackOfAnnotation, migration.Name))
+			Expect(rollback.Spec.VMIName).To(Equal(vmi.Name))
+			Expect(rollback.Spec.AddedNodeSelector).To(Equal(map[string]string{k8sv1.LabelHostname: "node02-hostname"}))
+		})
+
+		It("should keep the name of a rollback within the name length limit", func() {
+			newVerifiedMigration(guestAgentVerification(virtv1.PostMigrationFailureActionMigrateBack), 2*time.Minute)
+			migration.Name = strings.Repeat("a", validation.DNS1123SubdomainMaxLength)
+			migration.Status.PostMigrationVerification = &virtv1.PostMigrationVerificationStatus{
+				Phase: virtv1.PostMigrationVerificationFailed,
+			}
+			addNode(&k8sv1.Node{ObjectMeta: metav1.ObjectMeta{Name: "node02"}})
+
+			addMigration(migration)
+			addVirtualMachineInstance(vmi)
+
+			sanityExecute()
+
+			testutils.ExpectEvent(recorder, postMigrationVerificationFailedReason)
+			testutils.ExpectEvent(recorder, migrationRollbackReason)
+			migrations, err := virtClientset.KubevirtV1().VirtualMachineInstanceMigrations(vmi.Namespace).List(context.Background(), metav1.ListOptions{})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(migrations.Items).To(ContainElement(MatchFields(IgnoreExtras, Fields{
+				"ObjectMeta": MatchFields(IgnoreExtras, Fields{
+					"Name":        And(HaveSuffix("-rollback"), HaveLen(validation.DNS1123SubdomainMaxLength)),
+					"Annotations": HaveKeyWithValue(virtv1.MigrationRollbackOfAnnotation, migration.Name),
+				}),
+			})))
+		})
+
+		DescribeTable("should not migrate the VMI back but report why", func(annotation string, node *k8sv1.Node, expectedMessage string) {
+			newVerifiedMigration(guestAgentVerification(virtv1.PostMigrationFailureActionMigrateBack), 2*time.Minute)
+			if annotation != "" {
+				migration.Annotations[annotation] = ""
+			}
+			migration.Status.PostMigrationVerification = &virtv1.PostMigrationVerificationStatus{
+				Phase: virtv1.PostMigrationVerificationFailed,
+			}
+			if node != nil {
+				addNode(node)
+			}
+
+			addMigration(migration)
+			addVirtualMachineInstance(vmi)
+
+			sanityExecute()
+
+			testutils.ExpectEvent(recorder, postMigrationVerificationFailedReason)
+			Expect(getVMI().Status.Conditions).To(ContainElement(MatchFields(IgnoreExtras, Fields{
+				"Type":    Equal(virtv1.VirtualMachineInstancePostMigrationVerificationFailed),
+				"Status":  Equal(k8sv1.ConditionTrue),
+				"Reason":  Equal(postMigrationRollbackSkippedReason),
+				"Message": ContainSubstring(expectedMessage),
+			})))
+			_, err := getMigration(migration.Name + "-rollback")
+			Expect(err).To(MatchError(k8serrors.IsNotFound, "IsNotFound"))
+		},
+			Entry("after an evacuation", virtv1.EvacuationMigrationAnnotation,
+				&k8sv1.Node{ObjectMeta: metav1.ObjectMeta{Name: "node02"}}, "evacuated the source node"),
+			Entry("after a workload update", virtv1.WorkloadUpdateMigrationAnnotation,
+				&k8sv1.Node{ObjectMeta: metav1.ObjectMeta{Name: "node02"}}, "updated the workload"),
+			Entry("if the source node is unschedulable", "",
+				&k8sv1.Node{ObjectMeta: metav1.ObjectMeta{Name: "node02"}, Spec: k8sv1.NodeSpec{Unschedulable: true}}, "node02 is unschedulable"),
+			Entry("if the source node is gone", "", nil, "node02 no longer exists"),
+		)
+
+		It("should not migrate back a rollback", func() {
+			newVerifiedMigration(guestAgentVerification(virtv1.PostMigrationFailureActionMigrateBack), 2*time.Minute)
+			migration.Annotations[virtv1.MigrationRollbackOfAnnotation] = "previousmigration"
+			migration.Status.PostMigrationVerification = &virtv1.PostMigrationVerificationStatus{
+				Phase: virtv1.PostMigrationVerificationFailed,
+			}
+
+			addMigration(migration)
+			addVirtualMachineInstance(vmi)
+
+			sanityExecute()
+
+			testutils.ExpectEvent(recorder, postMigrationVerificationFailedReason)
+			_, err := getMigration(migration.Name + "-rollback")
+			Expect(err).To(MatchError(k8serrors.IsNotFound, "IsNotFound"))
+		})
+
+		It("should clear a reported failure once a verification succeeded", func() {
+			newVerifiedMigration(guestAgentVerification(virtv1.PostMigrationFailureActionReport), time.Second)
+			migration.Status.PostMigrationVerification = &virtv1.PostMigrationVerificationStatus{
+				Phase: virtv1.PostMigrationVerificationSucceeded,
+			}
+			vmi.Status.Conditions = []virtv1.VirtualMachineInstanceCondition{
+				{Type: virtv1.VirtualMachineInstancePostMigrationVerificationFailed, Status: k8sv1.ConditionTrue},
+			}
+
+			addMigration(migration)
+			addVirtualMachineInstance(vmi)
+
+			sanityExecute()
+
+			Expect(getVMI().Status.Conditions).To(BeEmpty())
+		})
+
+		It("should not verify the guest if a newer migration took over", func() {
+			newVerifiedMigration(guestAgentVerification(virtv1.PostMigrationFailureActionMigrateBack), 2*time.Minute)
+			vmi.Status.MigrationState.MigrationUID = "newermigration"
+
+			addMigration(migration)
+			addVirtualMachineInstance(vmi)
+
+			sanityExecute()
+
+			updatedMigration, err := getMigration(migration.Name)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(updatedMigration.Status.PostMigrationVerification).To(BeNil())
+		})
+	})
+
+	Context("Descheduler annotations", func() {
+		var vmi *virtv1.VirtualMachineInstance
+
+		It("should not add eviction-in-progress annotation in case of non evacuation migration", func() {
+			vmi = newVirtualMachine("testvmi", virtv1.Running)
+			migration := newMigration("testmigration", vmi.Name, virtv1.MigrationPending)
+
+			addMigration(migration)
+			addVirtualMachineInstance(vmi)
+			sourcePod := newSourcePodForVirtualMachine(vmi)
+			addPod(sourcePod)
+
+			sanityExecute()
+
+			testutils.ExpectEvents(recorder, virtcontroller.SuccessfulCreatePodReason)
+			expectPodCreation(vmi.Namespace, vmi.UID, migration.UID, 1, 0, 0)
+			updatedSourcePod, err := kubeClient.CoreV1().Pods(vmi.Namespace).Get(context.Background(), sourcePod.Name, metav1.GetOptions{})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(updatedSourcePod.Annotations).ToNot(HaveKey(descheduler.EvictionInProgressAnnotation))
+		})
+
+		Context("with an evacuation migration", func() {
+			It("should add eviction-in-progress annotation only to source virt-launcher pod", func() {
+				vmi = newVirtualMachine("testvmi", virtv1.Running)
+				migration := newMigration("testmigration", vmi.Name, virtv1.MigrationPending)
+				setAnnotation(virtv1.EvacuationMigrationAnnotation, migration)
+
+				addMigration(migration)
+				addVirtualMachineInstance(vmi)
+				sourcePod := newSourcePodForVirtualMachine(vmi)
+				addPod(sourcePod)
+
+				sanityExecute()
+
+				testutils.ExpectEvents(recorder, virtcontroller.SuccessfulCreatePodReason)
+				expectPodCreation(vmi.Namespace, vmi.UID, migration.UID, 1, 0, 0)
+				updatedSourcePod, err := kubeClient.CoreV1().Pods(vmi.Namespace).Get(context.Background(), sourcePod.Name, metav1.GetOptions{})
+				Expect(err).ToNot(HaveOccurred())
+				Expect(updatedSourcePod.Annotations).To(HaveKeyWithValue(descheduler.EvictionInProgressAnnotation, "kubevirt"))
+
+				pods, err := kubeClient.CoreV1().Pods(migration.Namespace).List(context.Background(), metav1.ListOptions{
+					LabelSelector: fmt.Sprintf("%s=%s,%s=%s", virtv1.MigrationJobLabel, string(migration.UID), virtv1.CreatedByLabel, string(vmi.UID)),
+				})
+				Expect(err).ToNot(HaveOccurred())
+				Expect(pods.Items).To(HaveLen(1))
+				targetPod := pods.Items[0]
+				Expect(targetPod.Annotations).ToNot(HaveKey(descheduler.EvictionInProgressAnnotation))
+			})
+
+			It("should remove eviction-in-progress annotation from source virt-launcher pod in case of failure", func() {
+				By("Create a pending migration")
+				vmi = newVirtualMachine("testvmi", virtv1.Running)
+				sourcePod := newSourcePodForVirtualMachine(vmi)
+				sourcePod.Annotations[descheduler.EvictionInProgressAnnotation] = "kubevirt"
+				migration := newMigration("testmigration", vmi.Name, virtv1.MigrationFailed)
+				migration.Status.MigrationState = &virtv1.VirtualMachineInstanceMigrationState{
+					SourcePod: sourcePod.Name,
+				}
+				setAnnotation(virtv1.EvacuationMigrationAnnotation, migration)
+
+				addMigration(migration)
+				addVirtualMachineInstance(vmi)
+				addPod(sourcePod)
+
+				sanityExecute()
+
+				updatedSourcePod, err := kubeClient.CoreV1().Pods(vmi.Namespace).Get(context.Background(), sourcePod.Name, metav1.GetOptions{})
+				Expect(err).ToNot(HaveOccurred())
+				Expect(updatedSourcePod.Annotations).ToNot(HaveKeyWithValue(descheduler.EvictionInProgressAnnotation, ""))
+			})
+		})
+	})
+
+	Context("Cross cluster migration", func() {
+		const (
+			migrationID = "migration-1"
+			connectURL  = "migration.example.com:443"
+		)
+
+		newSendToMigration := func(vmiName string, phase virtv1.VirtualMachineInstanceMigrationPhase) *virtv1.VirtualMachineInstanceMigration {
+			migration := newMigration("testmigration", vmiName, phase)
+			migration.Spec.SendTo = &virtv1.VirtualMachineInstanceMigrationSendTo{MigrationID: migrationID, ConnectURL: connectURL}
+			return migration
+		}
+
+		newReceiveMigration := func(vmiName string, phase virtv1.VirtualMachineInstanceMigrationPhase) *virtv1.VirtualMachineInstanceMigration {
+			migration := newMigration("testmigration", vmiName, phase)
+			migration.Spec.Receive = &virtv1.VirtualMachineInstanceMigrationReceive{
+				MigrationID: migrationID,
+				VirtualMachine: &virtv1.VirtualMachineSpec{
+					RunStrategy: pointer.P(virtv1.RunStrategyHalted),
+					Template: &virtv1.VirtualMachineInstanceTemplateSpec{
+						ObjectMeta: metav1.ObjectMeta{Labels: map[string]string{"app": "test"}},
+					},
+				},
+			}
+			return migration
+		}
+
+		It("should hand the migration to the source virt-handler without creating a target pod", func() {
+			vmi := newVirtualMachine("testvmi", virtv1.Running)
+			migration := newSendToMigration(vmi.Name, virtv1.MigrationPending)
+			addMigration(migration)
+			addVirtualMachineInstance(vmi)
+			addPod(newSourcePodForVirtualMachine(vmi))
+
+			sanityExecute()
+
+			testutils.ExpectEvent(recorder, virtcontroller.SuccessfulHandOverPodReason)
+			expectPodDoesNotExist(vmi.Namespace, string(vmi.UID), string(migration.UID))
+			expectVirtualMachineInstanceMigrationState(vmi.Namespace, vmi.Name,
+				HaveField("MigrationUID", Equal(migration.UID)),
+				HaveField("SourceNode", Equal(vmi.Status.NodeName)),
+				HaveField("TargetNodeAddress", Equal(connectURL)),
+				HaveField("CrossCluster", Equal(&virtv1.VirtualMachineInstanceCrossClusterMigrationState{
+					MigrationID: migrationID,
+					ConnectURL:  connectURL,
+				})),
+			)
+		})
+
+		It("should hand the migration to the virt-handler of the receiving VMI", func() {
+			vmi := newVirtualMachine("testvmi", virtv1.Scheduled)
+			vmi.Annotations[virtv1.CrossClusterMigrationReceiverAnnotation] = migrationID
+			migration := newReceiveMigration(vmi.Name, virtv1.MigrationPending)
+			pod := newSourcePodForVirtualMachine(vmi)
+			addMigration(migration)
+			addVirtualMachineInstance(vmi)
+			addPod(pod)
+
+			sanityExecute()
+
+			testutils.ExpectEvent(recorder, virtcontroller.SuccessfulHandOverPodReason)
+			expectVirtualMachineInstanceMigrationState(vmi.Namespace, vmi.Name,
+				HaveField("MigrationUID", Equal(migration.UID)),
+				HaveField("TargetNode", Equal(vmi.Status.NodeName)),
+				HaveField("TargetPod", Equal(pod.Name)),
+				HaveField("CrossCluster", Equal(&virtv1.VirtualMachineInstanceCrossClusterMigrationState{MigrationID: migrationID})),
+			)
+		})
+
+		It("should create the VM of a received migration", func() {
+			migration := newReceiveMigration("testvmi", virtv1.MigrationPending)
+			addMigration(migration)
+
+			sanityExecute()
+
+			testutils.ExpectEvent(recorder, common.SuccessfulCreateVirtualMachineReason)
+			vm, err := virtClientset.KubevirtV1().VirtualMachines(migration.Namespace).Get(context.Background(), "testvmi", metav1.GetOptions{})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(vm.Spec.RunStrategy).To(HaveValue(Equal(virtv1.RunStrategyAlways)))
+			Expect(vm.Spec.Template.ObjectMeta.Labels).To(HaveKeyWithValue("app", "test"))
+			Expect(vm.Spec.Template.ObjectMeta.Annotations).To(HaveKeyWithValue(virtv1.CrossClusterMigrationReceiverAnnotation, migrationID))
+
+			_, err = virtClientset.KubevirtV1().VirtualMachineInstanceMigrations(migration.Namespace).Get(context.Background(), migration.Name, metav1.GetOptions{})
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		It("should not take over an existing VM for a received migration", func() {
+			migration := newReceiveMigration("testvmi", virtv1.MigrationPending)
+			vm := &virtv1.VirtualMachine{
+				ObjectMeta: metav1.ObjectMeta{Name: "testvmi", Namespace: migration.Namespace},
+				Spec:       virtv1.VirtualMachineSpec{Template: &virtv1.VirtualMachineInstanceTemplateSpec{}},
+			}
+			_, err := virtClientset.KubevirtV1().VirtualMachines(vm.Namespace).Create(context.Background(), vm, metav1.CreateOptions{})
+			Expect(err).ToNot(HaveOccurred())
+			addMigration(migration)
+
+			controller.Execute()
+
+			testutils.ExpectEvent(recorder, common.FailedCreateVirtualMachineReason)
+			Expect(mockQueue.Len()).To(Equal(0))
+			Expect(mockQueue.GetRateLimitedEnqueueCount()).To(Equal(1))
+		})
+
+		Context("exposing the migration target", func() {
+			var migration *virtv1.VirtualMachineInstanceMigration
+
+			BeforeEach(func() {
+				vmi := newVirtualMachine("testvmi", virtv1.Scheduled)
+				vmi.Annotations[virtv1.CrossClusterMigrationReceiverAnnotation] = migrationID
+				migration = newReceiveMigration(vmi.Name, virtv1.MigrationPreparingTarget)
+				vmi.Status.MigrationState = &virtv1.VirtualMachineInstanceMigrationState{
+					MigrationUID:      migration.UID,
+					TargetNode:        vmi.Status.NodeName,
+					TargetNodeAddress: "10.0.0.1",
+					CrossCluster:      &virtv1.VirtualMachineInstanceCrossClusterMigrationState{MigrationID: migrationID},
+				}
+				addMigration(migration)
+				addVirtualMachineInstance(vmi)
+				addPod(newSourcePodForVirtualMachine(vmi))
+			})
+
+			It("should create a load balancer routing to the virt-handler of the target", func() {
+				sanityExecute()
+
+				expectMigrationPreparingTargetState(migration.Namespace, migration.Name)
+				Expect(mockQueue.GetAddAfterEnqueueCount()).To(Equal(1))
+
+				service, err := kubeClient.CoreV1().Services(migration.Namespace).Get(context.Background(), "kubevirt-migration-testmigration", metav1.GetOptions{})
+				Expect(err).ToNot(HaveOccurred())
+				Expect(service.Spec.Type).To(Equal(k8sv1.ServiceTypeLoadBalancer))
+				Expect(service.Spec.Selector).To(BeEmpty())
+				Expect(service.OwnerReferences).To(ConsistOf(HaveField("UID", Equal(migration.UID))))
+
+				slice, err := kubeClient.DiscoveryV1().EndpointSlices(migration.Namespace).Get(context.Background(), "kubevirt-migration-testmigration", metav1.GetOptions{})
+				Expect(err).ToNot(HaveOccurred())
+				Expect(slice.Labels).To(HaveKeyWithValue(discoveryv1.LabelServiceName, service.Name))
+				Expect(slice.Labels).To(HaveKeyWithValue(discoveryv1.LabelManagedBy, crossClusterMigrationManagedBy))
+				Expect(slice.AddressType).To(Equal(discoveryv1.AddressTypeIPv4))
+				Expect(slice.Endpoints).To(ConsistOf(HaveField("Addresses", ConsistOf("10.0.0.1"))))
+				Expect(slice.Ports).To(ConsistOf(HaveField("Port", HaveValue(BeEquivalentTo(migrationproxy.CrossClusterMigrationTunnelPort)))))
+			})
+
+			It("should report the address of the load balancer", func() {
+				service := &k8sv1.Service{
+					ObjectMeta: metav1.ObjectMeta{Name: "kubevirt-migration-testmigration", Namespace: migration.Namespace},
+					Status: k8sv1.ServiceStatus{LoadBalancer: k8sv1.LoadBalancerStatus{
+						Ingress: []k8sv1.LoadBalancerIngress{{Hostname: "migration.example.com"}},
+					}},
+				}
+				_, err := kubeClient.CoreV1().Services(service.Namespace).Create(context.Background(), service, metav1.CreateOptions{})
+				Expect(err).ToNot(HaveOccurred())
+
+				sanityExecute()
+
+				expectMigrationTargetReadyState(migration.Namespace, migration.Name)
+				updatedVMIM, err := virtClientset.KubevirtV1().VirtualMachineInstanceMigrations(migration.Namespace).Get(context.Background(), migration.Name, metav1.GetOptions{})
+				Expect(err).ToNot(HaveOccurred())
+				Expect(updatedVMIM.Status.ConnectURL).To(Equal("migration.example.com:49160"))
+			})
+		})
+
+		DescribeTable("should succeed once the source reported the migration completed", func(vmiPhase virtv1.VirtualMachineInstancePhase) {
+			vmi := newVirtualMachine("testvmi", vmiPhase)
+			migration := newSendToMigration(vmi.Name, virtv1.MigrationRunning)
+			now := metav1.Now()
+			vmi.Status.MigrationState = &virtv1.VirtualMachineInstanceMigrationState{
+				MigrationUID:      migration.UID,
+				SourceNode:        vmi.Status.NodeName,
+				TargetNodeAddress: connectURL,
+				StartTimestamp:    &now,
+				EndTimestamp:      &now,
+				Completed:         true,
+				CrossCluster:      &virtv1.VirtualMachineInstanceCrossClusterMigrationState{MigrationID: migrationID, ConnectURL: connectURL},
+			}
+			addMigration(migration)
+			addVirtualMachineInstance(vmi)
+
+			sanityExecute()
+
+			testutils.ExpectEvent(recorder, virtcontroller.SuccessfulMigrationReason)
+			expectMigrationCompletedState(migration.Namespace, migration.Name)
+		},
+			Entry("while the VMI still runs", virtv1.Running),
+			Entry("when the VMI already stopped", virtv1.Succeeded),
+		)
+
+		Context("after the VMI was sent", func() {
+			var vmi *virtv1.VirtualMachineInstance
+			var migration *virtv1.VirtualMachineInstanceMigration
+
+			BeforeEach(func() {
+				vmi = newVirtualMachine("testvmi", virtv1.Running)
+				migration = newSendToMigration(vmi.Name, virtv1.MigrationSucceeded)
+				now := metav1.Now()
+				vmi.Status.MigrationState = &virtv1.VirtualMachineInstanceMigrationState{
+					MigrationUID: migration.UID,
+					EndTimestamp: &now,
+					Completed:    true,
+					CrossCluster: &virtv1.VirtualMachineInstanceCrossClusterMigrationState{MigrationID: migrationID, ConnectURL: connectURL},
+				}
+			})
+
+			It("should stop the VM", func() {
+				vm := &virtv1.VirtualMachine{
+					ObjectMeta: metav1.ObjectMeta{Name: vmi.Name, Namespace: vmi.Namespace, UID: "vm-uid"},
+					Spec:       virtv1.VirtualMachineSpec{RunStrategy: pointer.P(virtv1.RunStrategyAlways)},
+				}
+				_, err := virtClientset.KubevirtV1().VirtualMachines(vm.Namespace).Create(context.Background(), vm, metav1.CreateOptions{})
+				Expect(err).ToNot(HaveOccurred())
+				vmi.OwnerReferences = []metav1.OwnerReference{*metav1.NewControllerRef(vm, virtv1.VirtualMachineGroupVersionKind)}
+				addMigration(migration)
+				addVirtualMachineInstance(vmi)
+
+				sanityExecute()
+
+				testutils.ExpectEvent(recorder, successfulCrossClusterHandOverReason)
+				updatedVM, err := virtClientset.KubevirtV1().VirtualMachines(vm.Namespace).Get(context.Background(), vm.Name, metav1.GetOptions{})
+				Expect(err).ToNot(HaveOccurred())
+				Expect(updatedVM.Spec.RunStrategy).To(HaveValue(Equal(virtv1.RunStrategyHalted)))
+			})
+
+			It("should delete a VMI without VM", func() {
+				addMigration(migration)
+				addVirtualMachineInstance(vmi)
+
+				sanityExecute()
+
+				testutils.ExpectEvent(recorder, successfulCrossClusterHandOverReason)
+				_, err := virtClientset.KubevirtV1().VirtualMachineInstances(vmi.Namespace).Get(context.Background(), vmi.Name, metav1.GetOptions{})
+				Expect(err).To(MatchError(k8serrors.IsNotFound, "IsNotFound"))
+			})
+		})
+
+		It("should let the VM of a received VMI start normally again", func() {
+			vmi := newVirtualMachine("testvmi", virtv1.Running)
+			migration := newReceiveMigration(vmi.Name, virtv1.MigrationSucceeded)
+			vm := &virtv1.VirtualMachine{
+				ObjectMeta: metav1.ObjectMeta{Name: vmi.Name, Namespace: vmi.Namespace, UID: "vm-uid"},
+				Spec: virtv1.VirtualMachineSpec{
+					RunStrategy: pointer.P(virtv1.RunStrategyAlways),
+					Template: &virtv1.VirtualMachineInstanceTemplateSpec{
+						ObjectMeta: metav1.ObjectMeta{Annotations: map[string]string{virtv1.CrossClusterMigrationReceiverAnnotation: migrationID}},
+					},
+				},
+			}
+			_, err := virtClientset.KubevirtV1().VirtualMachines(vm.Namespace).Create(context.Background(), vm, metav1.CreateOptions{})
+			Expect(err).ToNot(HaveOccurred())
+			vmi.OwnerReferences = []metav1.OwnerReference{*metav1.NewControllerRef(vm, virtv1.VirtualMachineGroupVersionKind)}
+			now := metav1.Now()
+			vmi.Status.MigrationState = &virtv1.VirtualMachineInstanceMigrationState{
+				MigrationUID: migration.UID,
+				EndTimestamp: &now,
+				Completed:    true,
+				CrossCluster: &virtv1.VirtualMachineInstanceCrossClusterMigrationState{MigrationID: migrationID},
+			}
+			addMigration(migration)
+			addVirtualMachineInstance(vmi)
+
+			sanityExecute()
+
+			testutils.ExpectEvent(recorder, successfulCrossClusterHandOverReason)
+			updatedVM, err := virtClientset.KubevirtV1().VirtualMachines(vm.Namespace).Get(context.Background(), vm.Name, metav1.GetOptions{})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(updatedVM.Spec.Template.ObjectMeta.Annotations).ToNot(HaveKey(virtv1.CrossClusterMigrationReceiverAnnotation))
 		})
 	})
 
@@ -0,0 +1,266 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package migration
+
+import (
+	"sort"
+	"sync"
+	"time"
+
+	"k8s.io/apimachinery/pkg/types"
+
+	virtv1 "kubevirt.io/api/core/v1"
+
+	"kubevirt.io/kubevirt/pkg/controller"
+	"kubevirt.io/kubevirt/pkg/util/migrations"
+)
+
+// migrationSource tells who requested a migration. Pending migrations of
+// the same priority are started in turns for every source.
+type migrationSource int
+
+const (
+	migrationSourceUser migrationSource = iota
+	migrationSourceEvacuation
+	migrationSourceWorkloadUpdate
+)
+
+var migrationSources = []migrationSource{
+	migrationSourceUser,
+	migrationSourceEvacuation,
+	migrationSourceWorkloadUpdate,
+}
+
+func getMigrationSource(migration *virtv1.VirtualMachineInstanceMigration) migrationSource {
+	if _, exists := migration.Annotations[virtv1.EvacuationMigrationAnnotation]; exists {
+		return migrationSourceEvacuation
+	}
+	if _, exists := migration.Annotations[virtv1.WorkloadUpdateMigrationAnnotation]; exists {
+		return migrationSourceWorkloadUpdate
+	}
+	return migrationSourceUser
+}
+
+func getMigrationPriority(migration *virtv1.VirtualMachineInstanceMigration) int32 {
+	if migration.Spec.Priority == nil {
+		return 0
+	}
+	return *migration.Spec.Priority
+}
+
+// migrationQueueCacheTTL is how long the queue of pending migrations is reused before it is rebuilt.
+// Every sync of a pending migration needs the queue, rebuilding it each time would be quadratic in the
+// number of pending migrations.
+const migrationQueueCacheTTL = time.Second
+
+// migrationQueueCache holds the last built queue of pending migrations. It is invalidated when
+// migrations are added or deleted, other changes are picked up once it expired.
+type migrationQueueCache struct {
+	lock      sync.Mutex
+	queue     []queuedMigration
+	expiresAt time.Time
+}
+
+func (q *migrationQueueCache) invalidate() {
+	q.lock.Lock()
+	defer q.lock.Unlock()
+	q.queue = nil
+	q.expiresAt = time.Time{}
+}
+
+// queuedMigration is a pending migration together with the node of the vmi it moves away from
+type queuedMigration struct {
+	migration  *virtv1.VirtualMachineInstanceMigration
+	sourceNode string
+}
+
+func queuedBefore(a, b queuedMigration) bool {
+	if !a.migration.CreationTimestamp.Equal(&b.migration.CreationTimestamp) {
+		return a.migration.CreationTimestamp.Before(&b.migration.CreationTimestamp)
+	}
+	if a.migration.Namespace != b.migration.Namespace {
+		return a.migration.Namespace < b.migration.Namespace
+	}
+	return a.migration.Name < b.migration.Name
+}
+
+// sortMigrationQueue returns the pending migrations in the order in which they are allowed to start.
+// Migrations with a higher priority come first. Migrations with the same priority are taken in turns
+// from user requests, evacuations and workload updates, in the order they were created, so that a node
+// drain can not starve user initiated migrations and the other way around.
+func sortMigrationQueue(queue []queuedMigration) []queuedMigration {
+	byPriority := map[int32]map[migrationSource][]queuedMigration{}
+	for _, queued := range queue {
+		priority := getMigrationPriority(queued.migration)
+		if _, exists := byPriority[priority]; !exists {
+			byPriority[priority] = map[migrationSource][]queuedMigration{}
+		}
+		source := getMigrationSource(queued.migration)
+		byPriority[priority][source] = append(byPriority[priority][source], queued)
+	}
+
+	priorities := make([]int32, 0, len(byPriority))
+	for priority := range byPriority {
+		priorities = append(priorities, priority)
+	}
+	sort.Slice(priorities, func(i, j int) bool { return priorities[i] > priorities[j] })
+
+	sorted := make([]queuedMigration, 0, len(queue))
+	for _, priority := range priorities {
+		bySource := byPriority[priority]
+		for _, source := range migrationSources {
+			sort.SliceStable(bySource[source], func(i, j int) bool {
+				return queuedBefore(bySource[source][i], bySource[source][j])
+			})
+		}
+		for turn := 0; ; turn++ {
+			var round []queuedMigration
+			for _, source := range migrationSources {
+				if turn < len(bySource[source]) {
+					round = append(round, bySource[source][turn])
+				}
+			}
+			if len(round) == 0 {
+				break
+			}
+			sort.SliceStable(round, func(i, j int) bool { return queuedBefore(round[i], round[j]) })
+			sorted = append(sorted, round...)
+		}
+	}
+	return sorted
+}
+
+// pendingMigrationQueue returns the migrations which wait for a free slot to create their target pod,
+// in the order in which they are allowed to start. Migrations which can not start anyway, because
+// they are canceled, in backoff or their vmi is not running, are left out.
+func (c *Controller) pendingMigrationQueue(runningMigrations []*virtv1.VirtualMachineInstanceMigration) ([]queuedMigration, error) {
+	c.pendingMigrations.lock.Lock()
+	defer c.pendingMigrations.lock.Unlock()
+
+	if now := time.Now(); !now.Before(c.pendingMigrations.expiresAt) {
+		queue, err := c.buildPendingMigrationQueue()
+		if err != nil {
+			return nil, err
+		}
+		c.pendingMigrations.queue = queue
+		c.pendingMigrations.expiresAt = now.Add(migrationQueueCacheTTL)
+	}
+
+	// Migrations which started since the queue was built do not wait anymore
+	running := map[types.UID]bool{}
+	for _, migration := range runningMigrations {
+		running[migration.UID] = true
+	}
+	queue := make([]queuedMigration, 0, len(c.pendingMigrations.queue))
+	for _, queued := range c.pendingMigrations.queue {
+		if !running[queued.migration.UID] {
+			queue = append(queue, queued)
+		}
+	}
+	return queue, nil
+}
+
+func (c *Controller) buildPendingMigrationQueue() ([]queuedMigration, error) {
+	var queue []queuedMigration
+	for _, migration := range migrations.ListUnfinishedMigrations(c.migrationIndexer) {
+		if migration.Status.Phase != virtv1.MigrationPending ||
+			migration.DeletionTimestamp != nil || migration.IsCrossCluster() {
+			continue
+		}
+		obj, exists, err := c.vmiStore.GetByKey(controller.NamespacedKey(migration.Namespace, migration.Spec.VMIName))
+		if err != nil {
+			return nil, err
+		}
+		if !exists {
+			continue
+		}
+		vmi := obj.(*virtv1.VirtualMachineInstance)
+		if !vmi.IsRunning() || vmi.DeletionTimestamp != nil {
+			continue
+		}
+		backoff, err := c.remainingMigrationBackoff(vmi, migration)
+		if err != nil {
+			return nil, err
+		}
+		if backoff > 0 {
+			continue
+		}
+		queue = append(queue, queuedMigration{migration: migration, sourceNode: vmi.Status.NodeName})
+	}
+	return sortMigrationQueue(queue), nil
+}
+
+// migrationQueuePosition returns the position of the migration in the queue of pending migrations,
+// starting with 1, or nil if the migration does not wait in the queue.
+func (c *Controller) migrationQueuePosition(migration *virtv1.VirtualMachineInstanceMigration) (*int32, error) {
+	runningMigrations, err := c.findRunningMigrations()
+	if err != nil {
+		return nil, err
+	}
+	queue, err := c.pendingMigrationQueue(runningMigrations)
+	if err != nil {
+		return nil, err
+	}
+	for i, queued := range queue {
+		if queued.migration.UID == migration.UID {
+			position := int32(i + 1)
+			return &position, nil
+		}
+	}
+	return nil, nil
+}
+
+// claimedMigrationSlots returns how many migrations run in the cluster and how many leave every node,
+// once the migrations queued before the given migration got the slots they are allowed to take.
+func (c *Controller) claimedMigrationSlots(migration *virtv1.VirtualMachineInstanceMigration, runningMigrations []*virtv1.VirtualMachineInstanceMigration) (int, map[string]int, error) {
+	migrationConfiguration := c.clusterConfig.GetMigrationConfiguration()
+	clusterLimit := int(*migrationConfiguration.ParallelMigrationsPerCluster)
+	nodeLimit := int(*migrationConfiguration.ParallelOutboundMigrationsPerNode)
+
+	outboundMigrations := map[string]int{}
+	for _, running := range runningMigrations {
+		obj, exists, err := c.vmiStore.GetByKey(controller.NamespacedKey(running.Namespace, running.Spec.VMIName))
+		if err != nil {
+			return 0, nil, err
+		}
+		if exists {
+			outboundMigrations[obj.(*virtv1.VirtualMachineInstance).Status.NodeName]++
+		}
+	}
+
+	queue, err := c.pendingMigrationQueue(runningMigrations)
+	if err != nil {
+		return 0, nil, err
+	}
+
+	clusterMigrations := len(runningMigrations)
+	for _, queued := range queue {
+		if queued.migration.UID == migration.UID || clusterMigrations >= clusterLimit {
+			break
+		}
+		// A migration ahead which has to wait for its own node does not hold back the others
+		if outboundMigrations[queued.sourceNode] >= nodeLimit {
+			continue
+		}
+		outboundMigrations[queued.sourceNode]++
+		clusterMigrations++
+	}
+	return clusterMigrations, outboundMigrations, nil
+}
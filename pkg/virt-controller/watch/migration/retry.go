@@ -0,0 +1,277 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package migration
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/openshift/library-go/pkg/build/naming"
+	k8sv1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/validation"
+
+	virtv1 "kubevirt.io/api/core/v1"
+	"kubevirt.io/client-go/log"
+
+	"kubevirt.io/kubevirt/pkg/controller"
+	"kubevirt.io/kubevirt/pkg/pointer"
+)
+
+const (
+	migrationRetryReason       = "MigrationRetry"
+	failedMigrationRetryReason = "FailedMigrationRetry"
+)
+
+const (
+	defaultMigrationRetryBackoffSeconds = int64(10)
+	maxMigrationRetryBackoff            = 30 * time.Minute
+)
+
+// classifyMigrationFailure tells why a migration failed, based on the state before the failure
+func classifyMigrationFailure(migration *virtv1.VirtualMachineInstanceMigration, vmi *virtv1.VirtualMachineInstance) *virtv1.VirtualMachineInstanceMigrationFailure {
+	conditionManager := controller.NewVirtualMachineInstanceMigrationConditionManager()
+
+	switch {
+	case migration.Status.Phase == virtv1.MigrationPhaseUnset:
+		return &virtv1.VirtualMachineInstanceMigrationFailure{
+			Class:   virtv1.MigrationFailureAborted,
+			Message: "another migration of the VMI is in progress",
+		}
+	case migration.DeletionTimestamp != nil || conditionManager.HasCondition(migration, virtv1.VirtualMachineInstanceMigrationAbortRequested):
+		return &virtv1.VirtualMachineInstanceMigrationFailure{
+			Class:   virtv1.MigrationFailureAborted,
+			Message: "the migration was canceled",
+		}
+	case vmi == nil || vmi.IsFinal():
+		return &virtv1.VirtualMachineInstanceMigrationFailure{
+			Class:   virtv1.MigrationFailureGuest,
+			Message: "the VMI stopped during the migration",
+		}
+	}
+
+	var reason string
+	class := virtv1.MigrationFailureUnknown
+	if state := vmi.Status.MigrationState; state != nil && state.MigrationUID == migration.UID {
+		if state.AbortRequested {
+			return &virtv1.VirtualMachineInstanceMigrationFailure{
+				Class:   virtv1.MigrationFailureAborted,
+				Message: "the migration was canceled",
+			}
+		}
+		reason = state.FailureReason
+		if state.FailureClass != "" {
+			class = state.FailureClass
+		}
+	}
+
+	if !migration.TargetIsHandedOff() {
+		if reason == "" {
+			reason = "the target pod could not be scheduled or started"
+		}
+		return &virtv1.VirtualMachineInstanceMigrationFailure{
+			Class:   virtv1.MigrationFailureTargetScheduling,
+			Message: reason,
+		}
+	}
+
+	return &virtv1.VirtualMachineInstanceMigrationFailure{
+		Class:   class,
+		Message: reason,
+	}
+}
+
+func isRetryableMigrationFailure(failure *virtv1.VirtualMachineInstanceMigrationFailure) bool {
+	if failure == nil {
+		return false
+	}
+	switch failure.Class {
+	case virtv1.MigrationFailureGuest, virtv1.MigrationFailureAborted:
+		return false
+	}
+	return true
+}
+
+// recordMigrationAttempt adds the attempt history of the retried migration to a retry,
+// and appends the attempt of the migration once it is finished
+func (c *Controller) recordMigrationAttempt(migration, migrationCopy *virtv1.VirtualMachineInstanceMigration, vmi *virtv1.VirtualMachineInstance, pod *k8sv1.Pod) {
+	if retryOf, isRetry := migration.Annotations[virtv1.MigrationRetryOfAnnotation]; isRetry && len(migrationCopy.Status.AttemptHistory) == 0 {
+		obj, exists, err := c.migrationIndexer.GetByKey(controller.NamespacedKey(migration.Namespace, retryOf))
+		if err != nil {
+			log.Log.Object(migration).Reason(err).Warningf("Failed to look up the retried migration %s", retryOf)
+		} else if exists {
+			retried := obj.(*virtv1.VirtualMachineInstanceMigration)
+			migrationCopy.Status.AttemptHistory = append([]virtv1.VirtualMachineInstanceMigrationAttempt{}, retried.Status.AttemptHistory...)
+		}
+	}
+
+	if migration.IsFinal() || !migrationCopy.IsFinal() {
+		return
+	}
+
+	if migrationCopy.Status.Phase == virtv1.MigrationFailed {
+		migrationCopy.Status.Failure = classifyMigrationFailure(migration, vmi)
+	}
+
+	now := v1.Now()
+	attempt := virtv1.VirtualMachineInstanceMigrationAttempt{
+		MigrationName:  migration.Name,
+		StartTimestamp: migration.CreationTimestamp.DeepCopy(),
+		EndTimestamp:   &now,
+		Phase:          migrationCopy.Status.Phase,
+		Escalation:     virtv1.MigrationRetryEscalation(migration.Annotations[virtv1.MigrationRetryEscalationAnnotation]),
+		Failure:        migrationCopy.Status.Failure,
+	}
+	if vmi != nil {
+		attempt.SourceNode = vmi.Status.NodeName
+		if state := vmi.Status.MigrationState; state != nil && state.MigrationUID == migration.UID {
+			if state.StartTimestamp != nil {
+				attempt.StartTimestamp = state.StartTimestamp.DeepCopy()
+			}
+			if state.SourceNode != "" {
+				attempt.SourceNode = state.SourceNode
+			}
+			attempt.TargetNode = state.TargetNode
+		}
+	}
+	if attempt.TargetNode == "" && pod != nil {
+		attempt.TargetNode = pod.Spec.NodeName
+	}
+	migrationCopy.Status.AttemptHistory = append(migrationCopy.Status.AttemptHistory, attempt)
+}
+
+// migrationConfigurationForVMI returns the migration configuration of the cluster,
+// overridden by the migration policy matching the vmi
+func (c *Controller) migrationConfigurationForVMI(vmi *virtv1.VirtualMachineInstance) (*virtv1.MigrationConfiguration, error) {
+	migrationConfiguration := c.clusterConfig.GetMigrationConfiguration().DeepCopy()
+	vmiCopy := vmi.DeepCopy()
+	vmiCopy.Status.MigrationState = &virtv1.VirtualMachineInstanceMigrationState{}
+	if err := c.matchMigrationPolicy(vmiCopy, migrationConfiguration); err != nil {
+		return nil, err
+	}
+	return migrationConfiguration, nil
+}
+
+func migrationRetryBackoff(migrationConfiguration *virtv1.MigrationConfiguration, retries int) time.Duration {
+	backoffSeconds := defaultMigrationRetryBackoffSeconds
+	if migrationConfiguration.RetryBackoffSeconds != nil {
+		backoffSeconds = *migrationConfiguration.RetryBackoffSeconds
+	}
+	backoff := time.Duration(backoffSeconds) * time.Second
+	for ; retries > 0 && backoff < maxMigrationRetryBackoff; retries-- {
+		backoff <<= 1
+	}
+	return min(backoff, maxMigrationRetryBackoff)
+}
+
+// handleMigrationRetry creates a new migration for a failed one, if the failure is worth a retry
+// and the migration configuration of the vmi allows for more retries. The retry is created once
+// the backoff since the failure has passed.
+func (c *Controller) handleMigrationRetry(key string, migration *virtv1.VirtualMachineInstanceMigration, vmi *virtv1.VirtualMachineInstance) error {
+	if migration.Status.Phase != virtv1.MigrationFailed || migration.DeletionTimestamp != nil ||
+		migration.IsCrossCluster() || !isRetryableMigrationFailure(migration.Status.Failure) {
+		return nil
+	}
+	if !vmi.IsRunning() || vmi.DeletionTimestamp != nil {
+		return nil
+	}
+	history := migration.Status.AttemptHistory
+	if len(history) == 0 {
+		return nil
+	}
+
+	// Once a newer migration took over, e.g. an evacuation or the retry itself, there is nothing left to do
+	vmiMigrations, err := c.listMigrationsMatchingVMI(vmi.Namespace, vmi.Name)
+	if err != nil {
+		return err
+	}
+	for _, other := range vmiMigrations {
+		if other.UID != migration.UID && other.CreationTimestamp.After(migration.CreationTimestamp.Time) {
+			return nil
+		}
+	}
+
+	migrationConfiguration, err := c.migrationConfigurationForVMI(vmi)
+	if err != nil {
+		return err
+	}
+	retries := len(history) - 1
+	if migrationConfiguration.MaxRetries == nil || retries >= int(*migrationConfiguration.MaxRetries) {
+		return nil
+	}
+
+	if failedAt := history[len(history)-1].EndTimestamp; failedAt != nil {
+		if backoff := time.Until(failedAt.Add(migrationRetryBackoff(migrationConfiguration, retries))); backoff > 0 {
+			c.Queue.AddAfter(key, backoff)
+			return nil
+		}
+	}
+
+	// The name of the retry is derived from the first attempt, so that it is only created once
+	retry := &virtv1.VirtualMachineInstanceMigration{
+		ObjectMeta: v1.ObjectMeta{
+			Name:      naming.GetName(history[0].MigrationName, fmt.Sprintf("retry-%d", retries+1), validation.DNS1123SubdomainMaxLength),
+			Namespace: migration.Namespace,
+			Labels:    migration.Labels,
+			Annotations: map[string]string{
+				virtv1.MigrationRetryOfAnnotation: migration.Name,
+			},
+		},
+		Spec: *migration.Spec.DeepCopy(),
+	}
+	for _, annotation := range []string{virtv1.EvacuationMigrationAnnotation, virtv1.WorkloadUpdateMigrationAnnotation} {
+		if value, exists := migration.Annotations[annotation]; exists {
+			retry.Annotations[annotation] = value
+		}
+	}
+	if migration.Status.Failure.Class == virtv1.MigrationFailureNonConvergence && migrationConfiguration.NonConvergenceEscalation != nil {
+		retry.Annotations[virtv1.MigrationRetryEscalationAnnotation] = string(*migrationConfiguration.NonConvergenceEscalation)
+	}
+
+	retry, err = c.clientset.VirtualMachineInstanceMigration(retry.Namespace).Create(context.Background(), retry, v1.CreateOptions{})
+	if k8serrors.IsAlreadyExists(err) {
+		return nil
+	} else if err != nil {
+		c.recorder.Eventf(migration, k8sv1.EventTypeWarning, failedMigrationRetryReason, "Failed to retry the migration: %v", err)
+		return err
+	}
+	c.recorder.Eventf(migration, k8sv1.EventTypeNormal, migrationRetryReason, "Retrying the migration after a %s failure as %s", migration.Status.Failure.Class, retry.Name)
+	return nil
+}
+
+// applyRetryEscalation makes the retry of a migration which did not converge more aggressive,
+// as long as the migration configuration still asks for it
+func applyRetryEscalation(migration *virtv1.VirtualMachineInstanceMigration, migrationConfiguration *virtv1.MigrationConfiguration) {
+	escalation := virtv1.MigrationRetryEscalation(migration.Annotations[virtv1.MigrationRetryEscalationAnnotation])
+	if escalation == "" || migrationConfiguration == nil ||
+		migrationConfiguration.NonConvergenceEscalation == nil || *migrationConfiguration.NonConvergenceEscalation != escalation {
+		return
+	}
+
+	switch escalation {
+	case virtv1.MigrationRetryEscalationPostCopy:
+		migrationConfiguration.AllowPostCopy = pointer.P(true)
+		migrationConfiguration.AllowWorkloadDisruption = pointer.P(true)
+	case virtv1.MigrationRetryEscalationAutoConverge:
+		migrationConfiguration.AllowAutoConverge = pointer.P(true)
+	}
+}
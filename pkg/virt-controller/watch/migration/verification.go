@@ -0,0 +1,318 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package migration
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/openshift/library-go/pkg/build/naming"
+	k8sv1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/validation"
+
+	virtv1 "kubevirt.io/api/core/v1"
+	"kubevirt.io/client-go/log"
+
+	"kubevirt.io/kubevirt/pkg/apimachinery/patch"
+	"kubevirt.io/kubevirt/pkg/controller"
+	migrationsutil "kubevirt.io/kubevirt/pkg/util/migrations"
+)
+
+const (
+	postMigrationVerificationFailedReason = "PostMigrationVerificationFailed"
+	postMigrationChecksFailedReason       = "PostMigrationChecksFailed"
+	migrationRollbackReason               = "MigrationRollback"
+	failedMigrationRollbackReason         = "FailedMigrationRollback"
+	postMigrationRollbackSkippedReason    = "PostMigrationRollbackSkipped"
+)
+
+const postMigrationVerificationInterval = 5 * time.Second
+
+// postMigrationVerificationFor returns the verification configuration the migration ran with,
+// or nil if there is none or if a newer migration of the vmi took over
+func postMigrationVerificationFor(migration *virtv1.VirtualMachineInstanceMigration, vmi *virtv1.VirtualMachineInstance) *virtv1.PostMigrationVerification {
+	state := vmi.Status.MigrationState
+	if state == nil || state.MigrationUID != migration.UID || state.MigrationConfiguration == nil {
+		return nil
+	}
+	verification := state.MigrationConfiguration.PostMigrationVerification
+	if verification == nil || len(verification.Checks) == 0 {
+		return nil
+	}
+	return verification
+}
+
+// verifyPostMigration runs the checks of the guest on the target of a succeeded migration, until
+// they all pass or the timeout since the end of the migration expires
+func (c *Controller) verifyPostMigration(migration, migrationCopy *virtv1.VirtualMachineInstanceMigration, vmi *virtv1.VirtualMachineInstance) {
+	if migrationCopy.Status.Phase != virtv1.MigrationSucceeded || migration.IsCrossCluster() {
+		return
+	}
+	verification := postMigrationVerificationFor(migration, vmi)
+	if verification == nil {
+		return
+	}
+
+	status := migrationCopy.Status.PostMigrationVerification
+	if status != nil && status.Phase != virtv1.PostMigrationVerificationPending {
+		return
+	}
+	now := v1.Now()
+	if status == nil {
+		status = &virtv1.PostMigrationVerificationStatus{
+			Phase:          virtv1.PostMigrationVerificationPending,
+			StartTimestamp: &now,
+		}
+		if endTimestamp := vmi.Status.MigrationState.EndTimestamp; endTimestamp != nil {
+			status.StartTimestamp = endTimestamp.DeepCopy()
+		}
+		migrationCopy.Status.PostMigrationVerification = status
+	}
+
+	status.FailedChecks = failedPostMigrationChecks(vmi, verification)
+	timeout := migrationsutil.PostMigrationVerificationTimeout(verification)
+	switch {
+	case len(status.FailedChecks) == 0:
+		status.Phase = virtv1.PostMigrationVerificationSucceeded
+		status.EndTimestamp = &now
+	case !vmi.IsRunning():
+		status.Phase = virtv1.PostMigrationVerificationFailed
+		status.Message = "the VMI stopped running on the target"
+		status.EndTimestamp = &now
+	case !now.Time.Before(status.StartTimestamp.Add(timeout)):
+		checks := make([]string, 0, len(status.FailedChecks))
+		for _, check := range status.FailedChecks {
+			checks = append(checks, string(check))
+		}
+		status.Phase = virtv1.PostMigrationVerificationFailed
+		status.Message = fmt.Sprintf("the guest did not pass the %s checks within %s on node %s",
+			strings.Join(checks, ", "), timeout, vmi.Status.MigrationState.TargetNode)
+		status.EndTimestamp = &now
+	}
+}
+
+// failedPostMigrationChecks returns the checks of the verification the guest does not pass
+func failedPostMigrationChecks(vmi *virtv1.VirtualMachineInstance, verification *virtv1.PostMigrationVerification) []virtv1.PostMigrationCheck {
+	conditionManager := controller.NewVirtualMachineInstanceConditionManager()
+
+	var failed []virtv1.PostMigrationCheck
+	for _, check := range verification.Checks {
+		passed := false
+		switch check {
+		case virtv1.PostMigrationCheckGuestAgent:
+			passed = conditionManager.HasConditionWithStatus(vmi, virtv1.VirtualMachineInstanceAgentConnected, k8sv1.ConditionTrue)
+		case virtv1.PostMigrationCheckReadinessProbe:
+			passed = conditionManager.HasConditionWithStatus(vmi, virtv1.VirtualMachineInstanceReady, k8sv1.ConditionTrue)
+		case virtv1.PostMigrationCheckNetwork:
+			passed = guestNetworkReachedAfter(vmi, vmi.Status.MigrationState.EndTimestamp)
+		default:
+			log.Log.Object(vmi).Warningf("Ignoring unknown post migration check %s", check)
+			passed = true
+		}
+		if !passed {
+			failed = append(failed, check)
+		}
+	}
+	return failed
+}
+
+// guestNetworkReachedAfter tells if virt-handler reached the guest over the pod network since the time.
+// virt-handler on the node of the vmi probes the guest while the Network check runs.
+func guestNetworkReachedAfter(vmi *virtv1.VirtualMachineInstance, since *v1.Time) bool {
+	condition := controller.NewVirtualMachineInstanceConditionManager().GetCondition(vmi, virtv1.VirtualMachineInstanceGuestNetworkReachable)
+	if condition == nil || condition.Status != k8sv1.ConditionTrue {
+		return false
+	}
+	return since == nil || !condition.LastProbeTime.Before(since)
+}
+
+// handlePostMigrationVerification waits for the verification of a succeeded migration to finish,
+// and reports the outcome on the vmi. The vmi is migrated back to its source node if the
+// verification failed and the configuration asks for it.
+func (c *Controller) handlePostMigrationVerification(key string, migration *virtv1.VirtualMachineInstanceMigration, vmi *virtv1.VirtualMachineInstance) error {
+	status := migration.Status.PostMigrationVerification
+	if status == nil || migration.DeletionTimestamp != nil {
+		return nil
+	}
+	verification := postMigrationVerificationFor(migration, vmi)
+	if verification == nil {
+		return nil
+	}
+
+	switch status.Phase {
+	case virtv1.PostMigrationVerificationPending:
+		c.Queue.AddAfter(key, postMigrationVerificationInterval)
+	case virtv1.PostMigrationVerificationSucceeded:
+		return c.clearPostMigrationVerificationFailure(vmi)
+	case virtv1.PostMigrationVerificationFailed:
+		migrateBack := verification.FailureAction != nil && *verification.FailureAction == virtv1.PostMigrationFailureActionMigrateBack
+		skipReason := ""
+		if migrateBack {
+			var err error
+			if skipReason, err = c.rollbackSkipReason(migration, vmi); err != nil {
+				return err
+			}
+		}
+		if err := c.reportPostMigrationVerificationFailure(migration, vmi, skipReason); err != nil {
+			return err
+		}
+		if migrateBack && skipReason == "" {
+			return c.rollbackMigration(migration, vmi)
+		}
+	}
+	return nil
+}
+
+// rollbackSkipReason tells why the vmi must not be migrated back to the source node of the
+// migration, or returns an empty string if it can be. Migrations that moved the vmi off
+// its node on purpose are not undone, and neither is any migration whose source node can
+// no longer take the vmi.
+func (c *Controller) rollbackSkipReason(migration *virtv1.VirtualMachineInstanceMigration, vmi *virtv1.VirtualMachineInstance) (string, error) {
+	if _, isEvacuation := migration.Annotations[virtv1.EvacuationMigrationAnnotation]; isEvacuation {
+		return "the migration evacuated the source node", nil
+	}
+	if _, isWorkloadUpdate := migration.Annotations[virtv1.WorkloadUpdateMigrationAnnotation]; isWorkloadUpdate {
+		return "the migration updated the workload", nil
+	}
+
+	sourceNode := vmi.Status.MigrationState.SourceNode
+	if sourceNode == "" {
+		return "", nil
+	}
+	obj, exists, err := c.nodeStore.GetByKey(sourceNode)
+	if err != nil {
+		return "", err
+	}
+	if !exists {
+		return fmt.Sprintf("source node %s no longer exists", sourceNode), nil
+	}
+	if obj.(*k8sv1.Node).Spec.Unschedulable {
+		return fmt.Sprintf("source node %s is unschedulable", sourceNode), nil
+	}
+	return "", nil
+}
+
+// reportPostMigrationVerificationFailure sets the failure condition on the vmi, including why
+// it was not migrated back if a rollback was asked for but skipped
+func (c *Controller) reportPostMigrationVerificationFailure(migration *virtv1.VirtualMachineInstanceMigration, vmi *virtv1.VirtualMachineInstance, skipReason string) error {
+	conditionManager := controller.NewVirtualMachineInstanceConditionManager()
+	message := fmt.Sprintf("Migration %s: %s", migration.Name, migration.Status.PostMigrationVerification.Message)
+	reason := postMigrationChecksFailedReason
+	if skipReason != "" {
+		message = fmt.Sprintf("%s; not migrated back: %s", message, skipReason)
+		reason = postMigrationRollbackSkippedReason
+	}
+	if condition := conditionManager.GetCondition(vmi, virtv1.VirtualMachineInstancePostMigrationVerificationFailed); condition != nil &&
+		condition.Status == k8sv1.ConditionTrue && condition.Message == message {
+		return nil
+	}
+
+	vmiCopy := vmi.DeepCopy()
+	conditionManager.UpdateCondition(vmiCopy, &virtv1.VirtualMachineInstanceCondition{
+		Type:               virtv1.VirtualMachineInstancePostMigrationVerificationFailed,
+		Status:             k8sv1.ConditionTrue,
+		LastTransitionTime: v1.Now(),
+		Reason:             reason,
+		Message:            message,
+	})
+	if err := c.patchVMIConditions(vmi, vmiCopy); err != nil {
+		return err
+	}
+	c.recorder.Eventf(vmi, k8sv1.EventTypeWarning, postMigrationVerificationFailedReason, "%s", message)
+	return nil
+}
+
+func (c *Controller) clearPostMigrationVerificationFailure(vmi *virtv1.VirtualMachineInstance) error {
+	conditionManager := controller.NewVirtualMachineInstanceConditionManager()
+	if !conditionManager.HasCondition(vmi, virtv1.VirtualMachineInstancePostMigrationVerificationFailed) {
+		return nil
+	}
+	vmiCopy := vmi.DeepCopy()
+	conditionManager.RemoveCondition(vmiCopy, virtv1.VirtualMachineInstancePostMigrationVerificationFailed)
+	return c.patchVMIConditions(vmi, vmiCopy)
+}
+
+func (c *Controller) patchVMIConditions(vmi, vmiCopy *virtv1.VirtualMachineInstance) error {
+	patchBytes, err := patch.New(
+		patch.WithTest("/status/conditions", vmi.Status.Conditions),
+		patch.WithAdd("/status/conditions", vmiCopy.Status.Conditions),
+	).GeneratePayload()
+	if err != nil {
+		return err
+	}
+	_, err = c.clientset.VirtualMachineInstance(vmi.Namespace).Patch(context.Background(), vmi.Name, types.JSONPatchType, patchBytes, v1.PatchOptions{})
+	return err
+}
+
+// rollbackMigration creates a migration of the vmi back to the source node of the migration.
+// Rollbacks themselves are never rolled back.
+func (c *Controller) rollbackMigration(migration *virtv1.VirtualMachineInstanceMigration, vmi *virtv1.VirtualMachineInstance) error {
+	if _, isRollback := migration.Annotations[virtv1.MigrationRollbackOfAnnotation]; isRollback {
+		return nil
+	}
+	if !vmi.IsRunning() || vmi.DeletionTimestamp != nil {
+		return nil
+	}
+	sourceNode := vmi.Status.MigrationState.SourceNode
+	if sourceNode == "" {
+		return nil
+	}
+
+	hostname := sourceNode
+	if obj, exists, err := c.nodeStore.GetByKey(sourceNode); err != nil {
+		return err
+	} else if exists {
+		if label, ok := obj.(*k8sv1.Node).Labels[k8sv1.LabelHostname]; ok {
+			hostname = label
+		}
+	}
+
+	rollback := &virtv1.VirtualMachineInstanceMigration{
+		ObjectMeta: v1.ObjectMeta{
+			Name:      naming.GetName(migration.Name, "rollback", validation.DNS1123SubdomainMaxLength),
+			Namespace: migration.Namespace,
+			Labels:    migration.Labels,
+			Annotations: map[string]string{
+				virtv1.MigrationRollbackOfAnnotation: migration.Name,
+			},
+		},
+		Spec: virtv1.VirtualMachineInstanceMigrationSpec{
+			VMIName: vmi.Name,
+			AddedNodeSelector: map[string]string{
+				k8sv1.LabelHostname: hostname,
+			},
+		},
+	}
+
+	rollback, err := c.clientset.VirtualMachineInstanceMigration(rollback.Namespace).Create(context.Background(), rollback, v1.CreateOptions{})
+	if k8serrors.IsAlreadyExists(err) {
+		return nil
+	} else if err != nil {
+		c.recorder.Eventf(migration, k8sv1.EventTypeWarning, failedMigrationRollbackReason, "Failed to migrate the VMI back to node %s: %v", sourceNode, err)
+		return err
+	}
+	c.recorder.Eventf(migration, k8sv1.EventTypeNormal, migrationRollbackReason, "Migrating the VMI back to node %s as %s", sourceNode, rollback.Name)
+	return nil
+}
@@ -0,0 +1,211 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package pool
+
+import (
+	"context"
+	"math"
+	"time"
+
+	k8score "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+
+	virtv1 "kubevirt.io/api/core/v1"
+	poolv1 "kubevirt.io/api/pool/v1alpha1"
+	"kubevirt.io/client-go/log"
+
+	"kubevirt.io/kubevirt/pkg/apimachinery/patch"
+	"kubevirt.io/kubevirt/pkg/controller"
+)
+
+const (
+	SuccessfulAutoscalePoolReason = "SuccessfulAutoscale"
+
+	defaultScaleOutCooldownSeconds = 60
+	defaultScaleInCooldownSeconds  = 300
+
+	// guestLoadMaxAge is the age after which the guest load reported for a
+	// VMI is not trusted anymore. virt-handler refreshes it more often.
+	guestLoadMaxAge = 5 * time.Minute
+
+	// autoscalingTolerance is the relative distance to the target utilization
+	// within which the pool is not scaled, to avoid flapping.
+	autoscalingTolerance = 0.1
+)
+
+// replicasForUtilization calculates the replicas the average of the
+// utilizations is at the target with, scaling the current replicas by the
+// ratio of the average to the target like the HorizontalPodAutoscaler does.
+// It returns false if there is no utilization to scale on.
+func replicasForUtilization(currentReplicas int32, utilizations []int32, target int32) (int32, bool) {
+	if len(utilizations) == 0 || target <= 0 {
+		return 0, false
+	}
+
+	total := int64(0)
+	for _, utilization := range utilizations {
+		total += int64(utilization)
+	}
+	ratio := float64(total) / float64(len(utilizations)) / float64(target)
+	if math.Abs(ratio-1.0) <= autoscalingTolerance {
+		return currentReplicas, true
+	}
+	return int32(math.Ceil(float64(currentReplicas) * ratio)), true
+}
+
+// autoscaledReplicas returns the replicas the pool should have according to
+// the guest load of its running VMIs, within the limits of its autoscaling.
+// The pool is not scaled in while the load of a running VMI is unknown.
+func (c *Controller) autoscaledReplicas(pool *poolv1.VirtualMachinePool, vms []*virtv1.VirtualMachine, now time.Time) int32 {
+	autoscaling := pool.Spec.Autoscaling
+	currentReplicas := int32(desiredReplicas(pool))
+
+	var cpuUtilizations, memoryUtilizations []int32
+	missingLoad := false
+	for _, vm := range filterDeletingVMs(vms) {
+		if isScaleInRequested(vm) {
+			continue
+		}
+		vmi := c.getVMI(vm)
+		if vmi == nil || !vmi.IsRunning() {
+			continue
+		}
+		load := vmi.Status.GuestLoad
+		if load == nil || now.Sub(load.LastProbeTime.Time) > guestLoadMaxAge {
+			missingLoad = true
+			continue
+		}
+		if autoscaling.TargetCPUUtilizationPercentage != nil {
+			if load.CPUUtilizationPercentage != nil {
+				cpuUtilizations = append(cpuUtilizations, *load.CPUUtilizationPercentage)
+			} else {
+				missingLoad = true
+			}
+		}
+		if autoscaling.TargetMemoryUtilizationPercentage != nil {
+			if load.MemoryUtilizationPercentage != nil {
+				memoryUtilizations = append(memoryUtilizations, *load.MemoryUtilizationPercentage)
+			} else {
+				missingLoad = true
+			}
+		}
+	}
+
+	// scale on the metric which needs the most replicas
+	replicas := int32(-1)
+	if autoscaling.TargetCPUUtilizationPercentage != nil {
+		if cpuReplicas, ok := replicasForUtilization(currentReplicas, cpuUtilizations, *autoscaling.TargetCPUUtilizationPercentage); ok {
+			replicas = max(replicas, cpuReplicas)
+		}
+	}
+	if autoscaling.TargetMemoryUtilizationPercentage != nil {
+		if memoryReplicas, ok := replicasForUtilization(currentReplicas, memoryUtilizations, *autoscaling.TargetMemoryUtilizationPercentage); ok {
+			replicas = max(replicas, memoryReplicas)
+		}
+	}
+	if replicas < 0 || (missingLoad && replicas < currentReplicas) {
+		replicas = currentReplicas
+	}
+
+	minReplicas := int32(1)
+	if autoscaling.MinReplicas != nil {
+		minReplicas = *autoscaling.MinReplicas
+	}
+	return min(max(replicas, minReplicas), autoscaling.MaxReplicas)
+}
+
+func autoscalingCooldown(autoscaling *poolv1.VirtualMachinePoolAutoscaling, scaleOut bool) time.Duration {
+	cooldownSeconds := int32(defaultScaleInCooldownSeconds)
+	if scaleOut {
+		cooldownSeconds = defaultScaleOutCooldownSeconds
+		if autoscaling.ScaleOutCooldownSeconds != nil {
+			cooldownSeconds = *autoscaling.ScaleOutCooldownSeconds
+		}
+	} else if autoscaling.ScaleInCooldownSeconds != nil {
+		cooldownSeconds = *autoscaling.ScaleInCooldownSeconds
+	}
+	return time.Duration(cooldownSeconds) * time.Second
+}
+
+// autoscale sets the replicas of the pool according to the guest load of its
+// VMIs, unless the pool was scaled or created within the cooldown. It returns
+// the pool with the new replicas.
+func (c *Controller) autoscale(pool *poolv1.VirtualMachinePool, vms []*virtv1.VirtualMachine) (*poolv1.VirtualMachinePool, error) {
+	if pool.Spec.Autoscaling == nil {
+		return pool, nil
+	}
+
+	now := time.Now()
+	currentReplicas := int32(desiredReplicas(pool))
+	replicas := c.autoscaledReplicas(pool, vms, now)
+	if replicas == currentReplicas {
+		return pool, nil
+	}
+
+	// a pool which was never scaled is stabilized from its creation,
+	// its VMIs did not report a meaningful load yet
+	lastScaleTime := pool.CreationTimestamp
+	if pool.Status.LastScaleTime != nil {
+		lastScaleTime = *pool.Status.LastScaleTime
+	}
+	cooldown := autoscalingCooldown(pool.Spec.Autoscaling, replicas > currentReplicas)
+	if remaining := lastScaleTime.Add(cooldown).Sub(now); remaining > 0 {
+		poolKey, err := controller.KeyFunc(pool)
+		if err != nil {
+			return pool, err
+		}
+		c.queue.AddAfter(poolKey, remaining)
+		return pool, nil
+	}
+
+	// The scale time is recorded before the replicas are changed. A failed
+	// patch then only delays the next scale, while a scale which is not
+	// recorded would let the pool be scaled again without a cooldown.
+	poolCopy := pool.DeepCopy()
+	poolCopy.Status.LastScaleTime = &metav1.Time{Time: now}
+	updatedPool, err := c.clientset.VirtualMachinePool(pool.Namespace).UpdateStatus(context.Background(), poolCopy, metav1.UpdateOptions{})
+	if err != nil {
+		return pool, err
+	}
+
+	patchSet := patch.New()
+	if pool.Spec.Replicas == nil {
+		patchSet.AddOption(patch.WithAdd("/spec/replicas", replicas))
+	} else {
+		patchSet.AddOption(
+			patch.WithTest("/spec/replicas", *pool.Spec.Replicas),
+			patch.WithReplace("/spec/replicas", replicas),
+		)
+	}
+	patchBytes, err := patchSet.GeneratePayload()
+	if err != nil {
+		return updatedPool, err
+	}
+
+	scaledPool, err := c.clientset.VirtualMachinePool(pool.Namespace).Patch(context.Background(), pool.Name, types.JSONPatchType, patchBytes, metav1.PatchOptions{})
+	if err != nil {
+		return updatedPool, err
+	}
+	c.recorder.Eventf(pool, k8score.EventTypeNormal, SuccessfulAutoscalePoolReason, "Autoscaled pool from %d to %d replicas", currentReplicas, replicas)
+	log.Log.Object(pool).Infof("Autoscaled pool from %d to %d replicas", currentReplicas, replicas)
+
+	return scaledPool, nil
+}
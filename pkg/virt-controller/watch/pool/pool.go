@@ -7,12 +7,14 @@ import (
 	"maps"
 	"math"
 	"math/rand"
+	"slices"
 	"strconv"
 	"strings"
 	"sync"
 	"time"
 
 	"k8s.io/apimachinery/pkg/api/equality"
+	"k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/utils/trace"
@@ -25,6 +27,7 @@ import (
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/intstr"
 	"k8s.io/apimachinery/pkg/util/wait"
 	"k8s.io/client-go/tools/cache"
 	"k8s.io/client-go/tools/record"
@@ -49,6 +52,7 @@ type Controller struct {
 	vmiStore        cache.Store
 	poolIndexer     cache.Indexer
 	revisionIndexer cache.Indexer
+	migrationStore  cache.Store
 	recorder        record.EventRecorder
 	expectations    *controller.UIDTrackingControllerExpectations
 	burstReplicas   uint
@@ -59,12 +63,22 @@ const (
 	FailedUpdateVirtualMachineReason     = "FailedUpdate"
 	SuccessfulUpdateVirtualMachineReason = "SuccessfulUpdate"
 
+	FailedMigrateVirtualMachineReason     = "FailedMigrate"
+	SuccessfulMigrateVirtualMachineReason = "SuccessfulMigrate"
+
+	FailedScaleInVirtualMachineReason            = "FailedScaleIn"
+	SuccessfulRequestScaleInVirtualMachineReason = "SuccessfulRequestScaleIn"
+	SuccessfulCancelScaleInVirtualMachineReason  = "SuccessfulCancelScaleIn"
+
+	defaultPreStopGracePeriodSeconds = 30
+
 	defaultAddDelay = 1 * time.Second
 )
 
 const (
 	FailedScaleOutReason        = "FailedScaleOut"
 	FailedScaleInReason         = "FailedScaleIn"
+	FailedAutoscaleReason       = "FailedAutoscale"
 	FailedUpdateReason          = "FailedUpdate"
 	FailedRevisionPruningReason = "FailedRevisionPruning"
 
@@ -80,6 +94,7 @@ func NewController(clientset kubecli.KubevirtClient,
 	vmInformer cache.SharedIndexInformer,
 	poolInformer cache.SharedIndexInformer,
 	revisionInformer cache.SharedIndexInformer,
+	migrationInformer cache.SharedIndexInformer,
 	recorder record.EventRecorder,
 	burstReplicas uint) (*Controller, error) {
 	c := &Controller{
@@ -92,13 +107,14 @@ func NewController(clientset kubecli.KubevirtClient,
 		vmiStore:        vmiInformer.GetStore(),
 		vmIndexer:       vmInformer.GetIndexer(),
 		revisionIndexer: revisionInformer.GetIndexer(),
+		migrationStore:  migrationInformer.GetStore(),
 		recorder:        recorder,
 		expectations:    controller.NewUIDTrackingControllerExpectations(controller.NewControllerExpectations()),
 		burstReplicas:   burstReplicas,
 	}
 
 	c.hasSynced = func() bool {
-		return poolInformer.HasSynced() && vmInformer.HasSynced() && vmiInformer.HasSynced() && revisionInformer.HasSynced()
+		return poolInformer.HasSynced() && vmInformer.HasSynced() && vmiInformer.HasSynced() && revisionInformer.HasSynced() && migrationInformer.HasSynced()
 	}
 
 	_, err := poolInformer.AddEventHandler(cache.ResourceEventHandlerFuncs{
@@ -135,6 +151,14 @@ func NewController(clientset kubecli.KubevirtClient,
 		return nil, err
 	}
 
+	_, err = migrationInformer.AddEventHandler(cache.ResourceEventHandlerFuncs{
+		AddFunc:    c.addMigrationHandler,
+		UpdateFunc: c.updateMigrationHandler,
+	})
+	if err != nil {
+		return nil, err
+	}
+
 	return c, nil
 }
 
@@ -206,6 +230,33 @@ func (c *Controller) updateVMIHandler(old, cur interface{}) {
 	c.addVMIHandler(cur)
 }
 
+// When a migration of a rolling update progresses, enqueue the pool of the migrated VMI.
+func (c *Controller) addMigrationHandler(obj interface{}) {
+	migration := obj.(*virtv1.VirtualMachineInstanceMigration)
+
+	if _, exists := migration.Labels[virtv1.VirtualMachinePoolRevisionName]; !exists {
+		return
+	}
+
+	obj, exists, err := c.vmIndexer.GetByKey(controller.NamespacedKey(migration.Namespace, migration.Spec.VMIName))
+	if err != nil || !exists {
+		return
+	}
+	vm := obj.(*virtv1.VirtualMachine)
+
+	if controllerRef := metav1.GetControllerOf(vm); controllerRef != nil {
+		pool := c.resolveControllerRef(vm.Namespace, controllerRef)
+		if pool == nil {
+			return
+		}
+		c.enqueuePool(pool)
+	}
+}
+
+func (c *Controller) updateMigrationHandler(old, cur interface{}) {
+	c.addMigrationHandler(cur)
+}
+
 // When a revision is created, enqueue the pool that manages it and update its expectations.
 func (c *Controller) addRevisionHandler(obj interface{}) {
 	cr := obj.(*appsv1.ControllerRevision)
@@ -471,13 +522,16 @@ func (c *Controller) listVMsFromNamespace(namespace string) ([]*virtv1.VirtualMa
 	return vms, nil
 }
 
-func (c *Controller) calcDiff(pool *poolv1.VirtualMachinePool, vms []*virtv1.VirtualMachine) int {
+func desiredReplicas(pool *poolv1.VirtualMachinePool) int {
 	wantedReplicas := int32(1)
 	if pool.Spec.Replicas != nil {
 		wantedReplicas = *pool.Spec.Replicas
 	}
+	return int(wantedReplicas)
+}
 
-	return len(vms) - int(wantedReplicas)
+func (c *Controller) calcDiff(pool *poolv1.VirtualMachinePool, vms []*virtv1.VirtualMachine) int {
+	return len(vms) - desiredReplicas(pool)
 }
 
 func filterDeletingVMs(vms []*virtv1.VirtualMachine) []*virtv1.VirtualMachine {
@@ -508,34 +562,131 @@ func filterVMs(vms []*virtv1.VirtualMachine, f func(vmi *virtv1.VirtualMachine)
 	return filtered
 }
 
-func (c *Controller) scaleIn(pool *poolv1.VirtualMachinePool, vms []*virtv1.VirtualMachine, count int) error {
+func isScaleInRequested(vm *virtv1.VirtualMachine) bool {
+	_, exists := vm.Annotations[virtv1.VirtualMachinePoolScaleInAnnotation]
+	return exists
+}
 
-	poolKey, err := controller.KeyFunc(pool)
+func filterScaleInRequestedVMs(vms []*virtv1.VirtualMachine) []*virtv1.VirtualMachine {
+	return filterVMs(filterDeletingVMs(vms), isScaleInRequested)
+}
+
+func scaleInSelectionPolicy(pool *poolv1.VirtualMachinePool) poolv1.VirtualMachinePoolSelectionPolicy {
+	if pool.Spec.ScaleInStrategy == nil || pool.Spec.ScaleInStrategy.SelectionPolicy == "" {
+		return poolv1.VirtualMachinePoolSelectionPolicyRandom
+	}
+	return pool.Spec.ScaleInStrategy.SelectionPolicy
+}
+
+func isProactiveScaleIn(pool *poolv1.VirtualMachinePool) bool {
+	return pool.Spec.ScaleInStrategy != nil && pool.Spec.ScaleInStrategy.Proactive != nil
+}
+
+// scaleInGracePeriod returns how long a VM selected for removal is announced
+// before it is deleted. VMs still announced after the proactive strategy got
+// removed from the pool are deleted right away.
+func scaleInGracePeriod(pool *poolv1.VirtualMachinePool) time.Duration {
+	if !isProactiveScaleIn(pool) {
+		return 0
+	}
+	gracePeriodSeconds := int64(defaultPreStopGracePeriodSeconds)
+	if pool.Spec.ScaleInStrategy.Proactive.PreStopGracePeriodSeconds != nil {
+		gracePeriodSeconds = *pool.Spec.ScaleInStrategy.Proactive.PreStopGracePeriodSeconds
+	}
+	return time.Duration(gracePeriodSeconds) * time.Second
+}
+
+func retainsVolumes(pool *poolv1.VirtualMachinePool) bool {
+	return pool.Spec.ScaleInStrategy != nil &&
+		pool.Spec.ScaleInStrategy.VolumeRetentionPolicy == poolv1.VirtualMachinePoolVolumeRetentionPolicyRetain
+}
+
+func ordinalFromName(name string) int {
+	index, err := indexFromName(name)
 	if err != nil {
-		return err
+		return -1
 	}
+	return index
+}
 
-	elgibleVMs := filterDeletingVMs(vms)
+// sortVMsForScaleIn orders the VMs of the pool in the order they are removed
+// according to the selection policy of the pool.
+func (c *Controller) sortVMsForScaleIn(pool *poolv1.VirtualMachinePool, vms []*virtv1.VirtualMachine) {
+	byDescendingOrdinal := func(a, b *virtv1.VirtualMachine) int {
+		return ordinalFromName(b.Name) - ordinalFromName(a.Name)
+	}
 
-	// make sure we count already deleting VMs here during scale in.
+	switch scaleInSelectionPolicy(pool) {
+	case poolv1.VirtualMachinePoolSelectionPolicyNewest:
+		slices.SortStableFunc(vms, func(a, b *virtv1.VirtualMachine) int {
+			if cmp := b.CreationTimestamp.Compare(a.CreationTimestamp.Time); cmp != 0 {
+				return cmp
+			}
+			return byDescendingOrdinal(a, b)
+		})
+	case poolv1.VirtualMachinePoolSelectionPolicyOldest:
+		slices.SortStableFunc(vms, func(a, b *virtv1.VirtualMachine) int {
+			if cmp := a.CreationTimestamp.Compare(b.CreationTimestamp.Time); cmp != 0 {
+				return cmp
+			}
+			return -byDescendingOrdinal(a, b)
+		})
+	case poolv1.VirtualMachinePoolSelectionPolicyUnhealthyFirst:
+		readyVMs := c.filterReadyVMs(vms)
+		slices.SortStableFunc(vms, func(a, b *virtv1.VirtualMachine) int {
+			aReady, bReady := slices.Contains(readyVMs, a), slices.Contains(readyVMs, b)
+			if aReady != bReady {
+				if bReady {
+					return -1
+				}
+				return 1
+			}
+			return byDescendingOrdinal(a, b)
+		})
+	case poolv1.VirtualMachinePoolSelectionPolicyDescendingOrdinal:
+		slices.SortStableFunc(vms, byDescendingOrdinal)
+	default:
+		rand.Shuffle(len(vms), func(i, j int) {
+			vms[i], vms[j] = vms[j], vms[i]
+		})
+	}
+}
+
+func (c *Controller) scaleIn(pool *poolv1.VirtualMachinePool, vms []*virtv1.VirtualMachine, count int) error {
+
+	elgibleVMs := filterVMs(filterDeletingVMs(vms), func(vm *virtv1.VirtualMachine) bool {
+		return !isScaleInRequested(vm)
+	})
+
+	// make sure we count already deleting VMs and VMs already selected for
+	// removal here during scale in.
 	count = count - (len(vms) - len(elgibleVMs))
 
-	if len(elgibleVMs) == 0 || count == 0 {
+	if len(elgibleVMs) == 0 || count <= 0 {
 		return nil
 	} else if count > len(elgibleVMs) {
 		count = len(elgibleVMs)
 	}
 
-	// random delete strategy
-	rand.Shuffle(len(elgibleVMs), func(i, j int) {
-		elgibleVMs[i], elgibleVMs[j] = elgibleVMs[j], elgibleVMs[i]
-	})
+	c.sortVMsForScaleIn(pool, elgibleVMs)
 
 	log.Log.Object(pool).Infof("Removing %d VMs from pool", count)
 
+	deleteList := elgibleVMs[0:count]
+	if isProactiveScaleIn(pool) {
+		return c.requestScaleIn(pool, deleteList)
+	}
+	return c.deleteVMs(pool, deleteList)
+}
+
+func (c *Controller) deleteVMs(pool *poolv1.VirtualMachinePool, deleteList []*virtv1.VirtualMachine) error {
+	poolKey, err := controller.KeyFunc(pool)
+	if err != nil {
+		return err
+	}
+
 	var wg sync.WaitGroup
 
-	deleteList := elgibleVMs[0:count]
 	c.expectations.ExpectDeletions(poolKey, controller.VirtualMachineKeys(deleteList))
 	wg.Add(len(deleteList))
 	errChan := make(chan error, len(deleteList))
@@ -544,6 +695,15 @@ func (c *Controller) scaleIn(pool *poolv1.VirtualMachinePool, vms []*virtv1.Virt
 			defer wg.Done()
 			vm := deleteList[idx]
 
+			if retainsVolumes(pool) {
+				if err := c.releaseVolumes(pool, vm); err != nil {
+					c.expectations.DeletionObserved(poolKey, controller.VirtualMachineKey(vm))
+					c.recorder.Eventf(pool, k8score.EventTypeWarning, common.FailedDeleteVirtualMachineReason, "Error retaining volumes of virtual machine %s: %v", vm.ObjectMeta.Name, err)
+					errChan <- err
+					return
+				}
+			}
+
 			foreGround := metav1.DeletePropagationForeground
 			err := c.clientset.VirtualMachine(vm.Namespace).Delete(context.Background(), vm.Name, metav1.DeleteOptions{PropagationPolicy: &foreGround})
 			if err != nil {
@@ -569,6 +729,223 @@ func (c *Controller) scaleIn(pool *poolv1.VirtualMachinePool, vms []*virtv1.Virt
 	return nil
 }
 
+// ownerRefHandoverPatch returns a patch replacing the owner reference to the
+// given owner of an object by a reference to the pool, or nil if the object
+// is not owned by it. The pool reference is not a controller reference, so
+// the object can be adopted again while it keeps being garbage collected
+// with the pool.
+func ownerRefHandoverPatch(obj metav1.Object, ownerUID types.UID, pool *poolv1.VirtualMachinePool) ([]byte, error) {
+	ownerRefs := obj.GetOwnerReferences()
+	remainingRefs := []metav1.OwnerReference{}
+	ownedByPool := false
+	for _, ref := range ownerRefs {
+		if ref.UID == pool.UID {
+			ownedByPool = true
+		}
+		if ref.UID != ownerUID {
+			remainingRefs = append(remainingRefs, ref)
+		}
+	}
+	if len(remainingRefs) == len(ownerRefs) {
+		return nil, nil
+	}
+	if !ownedByPool {
+		poolRef := poolOwnerRef(pool)
+		poolRef.Controller = nil
+		remainingRefs = append(remainingRefs, poolRef)
+	}
+
+	return patch.New(
+		patch.WithTest("/metadata/ownerReferences", ownerRefs),
+		patch.WithReplace("/metadata/ownerReferences", remainingRefs),
+	).GeneratePayload()
+}
+
+// releaseVolumes hands the DataVolumes of the DataVolumeTemplates of a VM,
+// and the PVCs left behind by garbage collected DataVolumes, over from the VM
+// to the pool. They outlive the VM and are adopted again by the VM created
+// with the same ordinal, as it uses the same DataVolume names, or deleted
+// with the pool.
+func (c *Controller) releaseVolumes(pool *poolv1.VirtualMachinePool, vm *virtv1.VirtualMachine) error {
+	for _, template := range vm.Spec.DataVolumeTemplates {
+		dv, err := c.clientset.CdiClient().CdiV1beta1().DataVolumes(vm.Namespace).Get(context.Background(), template.Name, metav1.GetOptions{})
+		if err != nil && !errors.IsNotFound(err) {
+			return err
+		} else if err == nil {
+			patchBytes, err := ownerRefHandoverPatch(dv, vm.UID, pool)
+			if err != nil {
+				return err
+			}
+			if patchBytes != nil {
+				_, err = c.clientset.CdiClient().CdiV1beta1().DataVolumes(vm.Namespace).Patch(context.Background(), dv.Name, types.JSONPatchType, patchBytes, metav1.PatchOptions{})
+				if err != nil {
+					return err
+				}
+			}
+		}
+
+		pvc, err := c.clientset.CoreV1().PersistentVolumeClaims(vm.Namespace).Get(context.Background(), template.Name, metav1.GetOptions{})
+		if err != nil && !errors.IsNotFound(err) {
+			return err
+		} else if err == nil {
+			patchBytes, err := ownerRefHandoverPatch(pvc, vm.UID, pool)
+			if err != nil {
+				return err
+			}
+			if patchBytes != nil {
+				_, err = c.clientset.CoreV1().PersistentVolumeClaims(vm.Namespace).Patch(context.Background(), pvc.Name, types.JSONPatchType, patchBytes, metav1.PatchOptions{})
+				if err != nil {
+					return err
+				}
+			}
+		}
+	}
+	log.Log.Object(vm).Infof("Retaining the volumes of vm %s/%s removed from pool", vm.Namespace, vm.Name)
+	return nil
+}
+
+func scaleInAnnotationPatch(vm *virtv1.VirtualMachine, requested bool) ([]byte, error) {
+	annotations := maps.Clone(vm.Annotations)
+	if annotations == nil {
+		annotations = map[string]string{}
+	}
+	if requested {
+		annotations[virtv1.VirtualMachinePoolScaleInAnnotation] = time.Now().UTC().Format(time.RFC3339)
+	} else {
+		delete(annotations, virtv1.VirtualMachinePoolScaleInAnnotation)
+	}
+
+	patchSet := patch.New()
+	if vm.Annotations == nil {
+		patchSet.AddOption(patch.WithAdd("/metadata/annotations", annotations))
+	} else {
+		patchSet.AddOption(
+			patch.WithTest("/metadata/annotations", vm.Annotations),
+			patch.WithReplace("/metadata/annotations", annotations),
+		)
+	}
+	return patchSet.GeneratePayload()
+}
+
+func (c *Controller) patchScaleInAnnotation(pool *poolv1.VirtualMachinePool, vms []*virtv1.VirtualMachine, requested bool) error {
+	var wg sync.WaitGroup
+
+	wg.Add(len(vms))
+	errChan := make(chan error, len(vms))
+	for _, vm := range vms {
+		go func(vm *virtv1.VirtualMachine) {
+			defer wg.Done()
+
+			patchBytes, err := scaleInAnnotationPatch(vm, requested)
+			if err != nil {
+				errChan <- err
+				return
+			}
+
+			_, err = c.clientset.VirtualMachine(vm.Namespace).Patch(context.Background(), vm.Name, types.JSONPatchType, patchBytes, metav1.PatchOptions{})
+			if err != nil {
+				c.recorder.Eventf(pool, k8score.EventTypeWarning, FailedScaleInVirtualMachineReason, "Error patching scale in annotation of virtual machine %s: %v", vm.ObjectMeta.Name, err)
+				errChan <- err
+				return
+			}
+
+			if requested {
+				c.recorder.Eventf(pool, k8score.EventTypeNormal, SuccessfulRequestScaleInVirtualMachineReason, "Requested removal of VM %s/%s from pool", vm.Namespace, vm.Name)
+				log.Log.Object(pool).Infof("Requested removal of vm %s/%s from pool", vm.Namespace, vm.Name)
+			} else {
+				c.recorder.Eventf(pool, k8score.EventTypeNormal, SuccessfulCancelScaleInVirtualMachineReason, "Canceled removal of VM %s/%s from pool", vm.Namespace, vm.Name)
+				log.Log.Object(pool).Infof("Canceled removal of vm %s/%s from pool", vm.Namespace, vm.Name)
+			}
+		}(vm)
+	}
+
+	wg.Wait()
+
+	select {
+	case err := <-errChan:
+		// Only return the first error which occurred. We log the rest
+		return err
+	default:
+	}
+
+	return nil
+}
+
+// requestScaleIn announces the removal of VMs selected by a proactive
+// scale-in. They are deleted once the pre-stop grace period expired.
+func (c *Controller) requestScaleIn(pool *poolv1.VirtualMachinePool, vms []*virtv1.VirtualMachine) error {
+	if err := c.patchScaleInAnnotation(pool, vms, true); err != nil {
+		return err
+	}
+
+	if gracePeriod := scaleInGracePeriod(pool); gracePeriod > 0 {
+		poolKey, err := controller.KeyFunc(pool)
+		if err != nil {
+			return err
+		}
+		c.queue.AddAfter(poolKey, gracePeriod)
+	}
+	return nil
+}
+
+func scaleInRequestExpiry(pool *poolv1.VirtualMachinePool, vm *virtv1.VirtualMachine) time.Time {
+	requested, err := time.Parse(time.RFC3339, vm.Annotations[virtv1.VirtualMachinePoolScaleInAnnotation])
+	if err != nil {
+		// an invalid request can't be waited on
+		return time.Time{}
+	}
+	return requested.Add(scaleInGracePeriod(pool))
+}
+
+// reconcileScaleInRequests cancels the removal of announced VMs which are
+// needed again by the pool and deletes the others once their pre-stop grace
+// period expired. It returns whether there are announced VMs left.
+func (c *Controller) reconcileScaleInRequests(pool *poolv1.VirtualMachinePool, vms []*virtv1.VirtualMachine, count int) (bool, error) {
+	requestedVMs := filterScaleInRequestedVMs(vms)
+	if len(requestedVMs) == 0 {
+		return false, nil
+	}
+
+	// VMs which are already being deleted are removed first
+	toRemove := count - (len(vms) - len(filterDeletingVMs(vms)))
+	if toRemove < len(requestedVMs) {
+		// keep the VMs which would be removed last
+		c.sortVMsForScaleIn(pool, requestedVMs)
+		toKeep := requestedVMs[max(toRemove, 0):]
+		if err := c.patchScaleInAnnotation(pool, toKeep, false); err != nil {
+			return true, err
+		}
+		requestedVMs = requestedVMs[:max(toRemove, 0)]
+	}
+
+	now := time.Now()
+	var expiredVMs []*virtv1.VirtualMachine
+	var nextExpiry time.Time
+	for _, vm := range requestedVMs {
+		expiry := scaleInRequestExpiry(pool, vm)
+		if !expiry.After(now) {
+			expiredVMs = append(expiredVMs, vm)
+		} else if nextExpiry.IsZero() || expiry.Before(nextExpiry) {
+			nextExpiry = expiry
+		}
+	}
+
+	if !nextExpiry.IsZero() {
+		poolKey, err := controller.KeyFunc(pool)
+		if err != nil {
+			return true, err
+		}
+		c.queue.AddAfter(poolKey, nextExpiry.Sub(now))
+	}
+
+	if len(expiredVMs) > 0 {
+		if err := c.deleteVMs(pool, expiredVMs); err != nil {
+			return true, err
+		}
+	}
+	return true, nil
+}
+
 func generateVMName(index int, baseName string) string {
 	return fmt.Sprintf("%s-%d", baseName, index)
 }
@@ -799,6 +1176,15 @@ func (c *Controller) scaleOut(pool *poolv1.VirtualMachinePool, count int) error
 
 func (c *Controller) scale(pool *poolv1.VirtualMachinePool, vms []*virtv1.VirtualMachine) (common.SyncError, bool) {
 	diff := c.calcDiff(pool, vms)
+
+	pendingScaleIn, err := c.reconcileScaleInRequests(pool, vms, diff)
+	if err != nil {
+		return common.NewSyncError(fmt.Errorf("Error during scale in: %v", err), FailedScaleInReason), false
+	} else if pendingScaleIn {
+		// wait for the announced VMs to be removed or kept
+		return nil, false
+	}
+
 	if diff == 0 {
 		// nothing to do
 		return nil, true
@@ -913,40 +1299,284 @@ func (c *Controller) proactiveUpdate(pool *poolv1.VirtualMachinePool, vmUpdatedL
 				log.Log.Object(pool).Infof("Proactively updating vm %s/%s in pool via vmi deletion", vm.Namespace, vm.Name)
 				c.recorder.Eventf(pool, k8score.EventTypeNormal, common.SuccessfulDeleteVirtualMachineReason, "Proactive update of VM %s/%s by deleting outdated VMI", vm.Namespace, vm.Name)
 			case proactiveUpdateTypePatchRevisionLabel:
-				patchSet := patch.New()
-				vmiCopy := vmi.DeepCopy()
-				if vmiCopy.Labels == nil {
-					vmiCopy.Labels = make(map[string]string)
-				}
-				revisionName, exists := vm.Labels[virtv1.VirtualMachinePoolRevisionName]
-				if !exists {
-					// nothing to do
-					return
-				}
-				vmiCopy.Labels[virtv1.VirtualMachinePoolRevisionName] = revisionName
-
-				if vmi.Labels == nil {
-					patchSet.AddOption(patch.WithAdd("/metadata/labels", vmi.Labels))
-				} else {
-					patchSet.AddOption(
-						patch.WithTest("/metadata/labels", vmiCopy.Labels),
-						patch.WithReplace("/metadata/labels", vmi.Labels),
-					)
-				}
-
-				patchBytes, err := patchSet.GeneratePayload()
-				if err != nil {
+				if err := c.patchVMIRevisionLabel(pool, vm, vmi); err != nil {
 					errChan <- err
-					return
 				}
+			}
+		}(i)
+	}
+	wg.Wait()
 
-				_, err = c.clientset.VirtualMachineInstance(vmi.Namespace).Patch(context.Background(), vmi.Name, types.JSONPatchType, patchBytes, v1.PatchOptions{})
-				if err != nil {
-					errChan <- fmt.Errorf("patching of vmi labels with new pool revision name: %v", err)
-					return
+	select {
+	case err := <-errChan:
+		// Only return the first error which occurred. We log the rest
+		return err
+	default:
+	}
+
+	return nil
+}
+
+func (c *Controller) patchVMIRevisionLabel(pool *poolv1.VirtualMachinePool, vm *virtv1.VirtualMachine, vmi *virtv1.VirtualMachineInstance) error {
+	patchSet := patch.New()
+	vmiCopy := vmi.DeepCopy()
+	if vmiCopy.Labels == nil {
+		vmiCopy.Labels = make(map[string]string)
+	}
+	revisionName, exists := vm.Labels[virtv1.VirtualMachinePoolRevisionName]
+	if !exists {
+		// nothing to do
+		return nil
+	}
+	vmiCopy.Labels[virtv1.VirtualMachinePoolRevisionName] = revisionName
+
+	if vmi.Labels == nil {
+		patchSet.AddOption(patch.WithAdd("/metadata/labels", vmiCopy.Labels))
+	} else {
+		patchSet.AddOption(
+			patch.WithTest("/metadata/labels", vmi.Labels),
+			patch.WithReplace("/metadata/labels", vmiCopy.Labels),
+		)
+	}
+
+	patchBytes, err := patchSet.GeneratePayload()
+	if err != nil {
+		return err
+	}
+
+	_, err = c.clientset.VirtualMachineInstance(vmi.Namespace).Patch(context.Background(), vmi.Name, types.JSONPatchType, patchBytes, v1.PatchOptions{})
+	if err != nil {
+		return fmt.Errorf("patching of vmi labels with new pool revision name: %v", err)
+	}
+	log.Log.Object(pool).Infof("Proactively updating vm %s/%s in pool via label patch", vm.Namespace, vm.Name)
+	return nil
+}
+
+func isRollingUpdate(pool *poolv1.VirtualMachinePool) bool {
+	return pool.Spec.UpdateStrategy != nil && pool.Spec.UpdateStrategy.RollingUpdate != nil
+}
+
+// resolveRollingUpdateLimits returns how many VMs of the pool can be restarted
+// and live migrated at the same time. Like for a Deployment at least one VM can
+// be unavailable if nothing could progress otherwise, a restart always makes
+// the VM unavailable.
+func resolveRollingUpdateLimits(pool *poolv1.VirtualMachinePool) (int, int, error) {
+	rollingUpdate := pool.Spec.UpdateStrategy.RollingUpdate
+	replicas := desiredReplicas(pool)
+	defaultLimit := intstr.FromInt32(1)
+
+	maxUnavailableValue := rollingUpdate.MaxUnavailable
+	if maxUnavailableValue == nil {
+		maxUnavailableValue = &defaultLimit
+	}
+	maxUnavailable, err := intstr.GetScaledValueFromIntOrPercent(maxUnavailableValue, replicas, false)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	maxSurgeValue := rollingUpdate.MaxSurge
+	if maxSurgeValue == nil {
+		maxSurgeValue = &defaultLimit
+	}
+	maxSurge, err := intstr.GetScaledValueFromIntOrPercent(maxSurgeValue, replicas, true)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	if maxUnavailable == 0 && (maxSurge == 0 || rollingUpdate.Method != poolv1.VirtualMachinePoolUpdateMethodLiveMigrate) {
+		maxUnavailable = 1
+	}
+	return maxUnavailable, maxSurge, nil
+}
+
+func (c *Controller) getVMI(vm *virtv1.VirtualMachine) *virtv1.VirtualMachineInstance {
+	obj, exists, _ := c.vmiStore.GetByKey(controller.NamespacedKey(vm.Namespace, vm.Name))
+	if !exists {
+		return nil
+	}
+	return obj.(*virtv1.VirtualMachineInstance)
+}
+
+// countUnavailableVMs counts the VMs of the pool which should run but are not
+// ready, or are being restarted.
+func (c *Controller) countUnavailableVMs(vms []*virtv1.VirtualMachine) int {
+	unavailable := 0
+	readyVMs := c.filterReadyVMs(vms)
+	for _, vm := range filterDeletingVMs(vms) {
+		if vmi := c.getVMI(vm); vmi != nil && vmi.DeletionTimestamp != nil {
+			unavailable++
+			continue
+		}
+		if runStrategy, err := vm.RunStrategy(); err == nil && runStrategy == virtv1.RunStrategyHalted {
+			continue
+		}
+		if !slices.Contains(readyVMs, vm) {
+			unavailable++
+		}
+	}
+	return unavailable
+}
+
+func updateMigrationName(vm *virtv1.VirtualMachine) string {
+	return fmt.Sprintf("%s-update-%s", vm.Name, vm.Labels[virtv1.VirtualMachinePoolRevisionName])
+}
+
+func (c *Controller) getUpdateMigration(vm *virtv1.VirtualMachine) *virtv1.VirtualMachineInstanceMigration {
+	obj, exists, _ := c.migrationStore.GetByKey(controller.NamespacedKey(vm.Namespace, updateMigrationName(vm)))
+	if !exists {
+		return nil
+	}
+	return obj.(*virtv1.VirtualMachineInstanceMigration)
+}
+
+// isVMTemplateProcessed tells whether the VM controller has seen the current
+// template of the VM, and so whether its RestartRequired condition is up to date.
+func isVMTemplateProcessed(vm *virtv1.VirtualMachine) bool {
+	return vm.Status.DesiredGeneration == vm.Generation
+}
+
+// isLiveUpdateApplied tells whether the VM controller applied the current
+// template of the VM to its running VMI without requiring a restart.
+func isLiveUpdateApplied(vm *virtv1.VirtualMachine) bool {
+	return isVMTemplateProcessed(vm) &&
+		!controller.NewVirtualMachineConditionManager().HasConditionWithStatus(vm, virtv1.VirtualMachineRestartRequired, k8score.ConditionTrue)
+}
+
+// rollingUpdate propagates the update to the running VMIs of the pool a few at
+// a time. Restarts are limited by maxUnavailable and live migrations by maxSurge.
+// With the LiveMigrate method a VMI is only migrated once the VM controller
+// applied the update to it live. A VMI which can't be live migrated, or whose VM
+// requires a restart to apply the update, is restarted.
+func (c *Controller) rollingUpdate(pool *poolv1.VirtualMachinePool, vms []*virtv1.VirtualMachine, vmUpdatedList []*virtv1.VirtualMachine) error {
+	maxUnavailable, maxSurge, err := resolveRollingUpdateLimits(pool)
+	if err != nil {
+		return err
+	}
+	liveMigrate := pool.Spec.UpdateStrategy.RollingUpdate.Method == poolv1.VirtualMachinePoolUpdateMethodLiveMigrate
+
+	migrating := 0
+	var migrateList, restartList []*virtv1.VirtualMachine
+	for _, vm := range vmUpdatedList {
+		vmi := c.getVMI(vm)
+		if vmi == nil || vmi.DeletionTimestamp != nil {
+			continue
+		}
+
+		updateType, err := c.isOutdatedVMI(vm, vmi)
+		if err != nil {
+			return err
+		}
+		switch updateType {
+		case proactiveUpdateTypeNone:
+			continue
+		case proactiveUpdateTypePatchRevisionLabel:
+			if err := c.patchVMIRevisionLabel(pool, vm, vmi); err != nil {
+				return err
+			}
+			continue
+		}
+
+		if liveMigrate {
+			if !isVMTemplateProcessed(vm) {
+				// Wait for the VM controller to tell whether the update can be applied live
+				continue
+			}
+			if isLiveUpdateApplied(vm) {
+				migration := c.getUpdateMigration(vm)
+				if migration == nil && vmi.IsMigratable() {
+					migrateList = append(migrateList, vm)
+					continue
+				} else if migration != nil && !migration.IsFinal() {
+					migrating++
+					continue
+				} else if migration != nil && migration.Status.Phase == virtv1.MigrationSucceeded {
+					// The VM controller applied the update to the VMI and the migration moved it
+					// to a pod following it, a migration alone never applies the template.
+					if err := c.patchVMIRevisionLabel(pool, vm, vmi); err != nil {
+						return err
+					}
+					continue
 				}
-				log.Log.Object(pool).Infof("Proactively updating vm %s/%s in pool via label patch", vm.Namespace, vm.Name)
 			}
+		}
+		restartList = append(restartList, vm)
+	}
+
+	if count := min(len(migrateList), maxSurge-migrating); count > 0 {
+		if err := c.migrateVMIs(pool, migrateList[:count]); err != nil {
+			return err
+		}
+	}
+
+	if count := min(len(restartList), maxUnavailable-c.countUnavailableVMs(vms)); count > 0 {
+		if err := c.restartVMIs(pool, restartList[:count]); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (c *Controller) migrateVMIs(pool *poolv1.VirtualMachinePool, vms []*virtv1.VirtualMachine) error {
+	var wg sync.WaitGroup
+	wg.Add(len(vms))
+	errChan := make(chan error, len(vms))
+	for i := 0; i < len(vms); i++ {
+		go func(idx int) {
+			defer wg.Done()
+			vm := vms[idx]
+
+			migration := &virtv1.VirtualMachineInstanceMigration{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      updateMigrationName(vm),
+					Namespace: vm.Namespace,
+					Labels: map[string]string{
+						virtv1.VirtualMachinePoolRevisionName: vm.Labels[virtv1.VirtualMachinePoolRevisionName],
+					},
+				},
+				Spec: virtv1.VirtualMachineInstanceMigrationSpec{
+					VMIName: vm.Name,
+				},
+			}
+			_, err := c.clientset.VirtualMachineInstanceMigration(vm.Namespace).Create(context.Background(), migration, metav1.CreateOptions{})
+			if err != nil && !errors.IsAlreadyExists(err) {
+				c.recorder.Eventf(pool, k8score.EventTypeWarning, FailedMigrateVirtualMachineReason, "Error updating VM %s/%s by migrating outdated VMI: %v", vm.Namespace, vm.Name, err)
+				errChan <- err
+				return
+			}
+			log.Log.Object(pool).Infof("Updating vm %s/%s in pool via vmi migration", vm.Namespace, vm.Name)
+			c.recorder.Eventf(pool, k8score.EventTypeNormal, SuccessfulMigrateVirtualMachineReason, "Update of VM %s/%s by migrating outdated VMI", vm.Namespace, vm.Name)
+		}(i)
+	}
+	wg.Wait()
+
+	select {
+	case err := <-errChan:
+		// Only return the first error which occurred. We log the rest
+		return err
+	default:
+	}
+
+	return nil
+}
+
+func (c *Controller) restartVMIs(pool *poolv1.VirtualMachinePool, vms []*virtv1.VirtualMachine) error {
+	var wg sync.WaitGroup
+	wg.Add(len(vms))
+	errChan := make(chan error, len(vms))
+	for i := 0; i < len(vms); i++ {
+		go func(idx int) {
+			defer wg.Done()
+			vm := vms[idx]
+
+			err := c.clientset.VirtualMachineInstance(vm.Namespace).Delete(context.Background(), vm.Name, v1.DeleteOptions{})
+			if err != nil {
+				c.recorder.Eventf(pool, k8score.EventTypeWarning, FailedUpdateVirtualMachineReason, "Error updating VM %s/%s by deleting outdated VMI: %v", vm.Namespace, vm.Name, err)
+				errChan <- err
+				return
+			}
+			log.Log.Object(pool).Infof("Updating vm %s/%s in pool via vmi deletion", vm.Namespace, vm.Name)
+			c.recorder.Eventf(pool, k8score.EventTypeNormal, common.SuccessfulDeleteVirtualMachineReason, "Update of VM %s/%s by deleting outdated VMI", vm.Namespace, vm.Name)
 		}(i)
 	}
 	wg.Wait()
@@ -1137,6 +1767,11 @@ func (c *Controller) update(pool *poolv1.VirtualMachinePool, vms []*virtv1.Virtu
 	vmUpdatedList := []*virtv1.VirtualMachine{}
 
 	for _, vm := range vms {
+		if isScaleInRequested(vm) {
+			// VMs about to be removed are not updated anymore
+			continue
+		}
+
 		outdated, err := c.isOutdatedVM(pool, vm)
 		if err != nil {
 			return common.NewSyncError(fmt.Errorf("Error while detected outdated VMs: %v", err), FailedUpdateReason), false
@@ -1154,7 +1789,11 @@ func (c *Controller) update(pool *poolv1.VirtualMachinePool, vms []*virtv1.Virtu
 		return common.NewSyncError(fmt.Errorf("Error during VM update: %v", err), FailedUpdateReason), false
 	}
 
-	err = c.proactiveUpdate(pool, vmUpdatedList)
+	if isRollingUpdate(pool) {
+		err = c.rollingUpdate(pool, vms, vmUpdatedList)
+	} else {
+		err = c.proactiveUpdate(pool, vmUpdatedList)
+	}
 	if err != nil {
 		return common.NewSyncError(fmt.Errorf("Error during VMI update: %v", err), FailedUpdateReason), false
 	}
@@ -1243,6 +1882,7 @@ func (c *Controller) updateStatus(origPool *poolv1.VirtualMachinePool, vms []*vi
 
 	pool.Status.Replicas = int32(len(vms))
 	pool.Status.ReadyReplicas = int32(len(c.filterReadyVMs(vms)))
+	c.updateRevisionStatus(pool, vms)
 
 	if !equality.Semantic.DeepEqual(pool.Status, origPool.Status) || pool.Status.Replicas != pool.Status.ReadyReplicas {
 		_, err := c.clientset.VirtualMachinePool(pool.Namespace).UpdateStatus(context.Background(), pool, metav1.UpdateOptions{})
@@ -1255,6 +1895,44 @@ func (c *Controller) updateStatus(origPool *poolv1.VirtualMachinePool, vms []*vi
 
 }
 
+// updateRevisionStatus reports the progress of updating the VMs of the pool,
+// and their running VMIs, to the current VirtualMachine template.
+func (c *Controller) updateRevisionStatus(pool *poolv1.VirtualMachinePool, vms []*virtv1.VirtualMachine) {
+	updateRevision := ""
+	updateGeneration := -1
+	updated := int32(0)
+
+	for _, vm := range vms {
+		revisionName, exists := vm.Labels[virtv1.VirtualMachinePoolRevisionName]
+		if !exists {
+			continue
+		}
+		revisionSpec, exists, err := c.getControllerRevision(pool.Namespace, revisionName)
+		if err != nil || !exists || !equality.Semantic.DeepEqual(revisionSpec.VirtualMachineTemplate, pool.Spec.VirtualMachineTemplate) {
+			continue
+		}
+
+		// VMs added by a scale out can use a newer revision of the same template
+		if generation, err := indexFromName(revisionName); err == nil && generation > updateGeneration {
+			updateRevision = revisionName
+			updateGeneration = generation
+		}
+
+		if vmi := c.getVMI(vm); vmi != nil && vmi.Labels[virtv1.VirtualMachinePoolRevisionName] != revisionName {
+			continue
+		}
+		updated++
+	}
+
+	if updateRevision != "" {
+		pool.Status.UpdateRevision = updateRevision
+	}
+	pool.Status.UpdatedReplicas = updated
+	if updated == pool.Status.Replicas && pool.Status.UpdateRevision != "" {
+		pool.Status.CurrentRevision = pool.Status.UpdateRevision
+	}
+}
+
 func (c *Controller) execute(key string) error {
 	logger := log.DefaultLogger()
 
@@ -1313,7 +1991,12 @@ func (c *Controller) execute(key string) error {
 		scaleIsStable := false
 		updateIsStable := false
 
-		syncErr, scaleIsStable = c.scale(pool, vms)
+		pool, err = c.autoscale(pool, vms)
+		if err != nil {
+			syncErr = common.NewSyncError(fmt.Errorf("Error during autoscaling: %v", err), FailedAutoscaleReason)
+		} else {
+			syncErr, scaleIsStable = c.scale(pool, vms)
+		}
 		if syncErr != nil {
 			logger.Reason(err).Error("Scaling the pool failed.")
 		}
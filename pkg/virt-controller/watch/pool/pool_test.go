@@ -19,9 +19,11 @@
 package pool
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"maps"
+	"time"
 
 	"github.com/golang/mock/gomock"
 	. "github.com/onsi/ginkgo/v2"
@@ -29,8 +31,11 @@ import (
 	"github.com/onsi/gomega/types"
 	appsv1 "k8s.io/api/apps/v1"
 	k8sv1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
+	k8stypes "k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/intstr"
 	k8sfake "k8s.io/client-go/kubernetes/fake"
 	k8stesting "k8s.io/client-go/testing"
 	"k8s.io/client-go/tools/cache"
@@ -39,9 +44,11 @@ import (
 	v1 "kubevirt.io/api/core/v1"
 	poolv1 "kubevirt.io/api/pool/v1alpha1"
 	"kubevirt.io/client-go/api"
+	cdifake "kubevirt.io/client-go/containerizeddataimporter/fake"
 	"kubevirt.io/client-go/kubecli"
 	kubevirtfake "kubevirt.io/client-go/kubevirt/fake"
 	"kubevirt.io/client-go/testing"
+	cdiv1 "kubevirt.io/containerized-data-importer-api/pkg/apis/core/v1beta1"
 
 	virtcontroller "kubevirt.io/kubevirt/pkg/controller"
 	controllertesting "kubevirt.io/kubevirt/pkg/controller/testing"
@@ -83,6 +90,51 @@ var _ = Describe("Pool", func() {
 			2),
 	)
 
+	DescribeTable("Resolve rolling update limits", func(rollingUpdate *poolv1.VirtualMachinePoolRollingUpdate, replicas int32, expectedMaxUnavailable, expectedMaxSurge int) {
+		pool, _ := DefaultPool(replicas)
+		pool.Spec.UpdateStrategy = &poolv1.VirtualMachinePoolUpdateStrategy{RollingUpdate: rollingUpdate}
+
+		maxUnavailable, maxSurge, err := resolveRollingUpdateLimits(pool)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(maxUnavailable).To(Equal(expectedMaxUnavailable))
+		Expect(maxSurge).To(Equal(expectedMaxSurge))
+	},
+		Entry("should default to one",
+			&poolv1.VirtualMachinePoolRollingUpdate{}, int32(10), 1, 1),
+		Entry("should round down maxUnavailable and round up maxSurge",
+			&poolv1.VirtualMachinePoolRollingUpdate{
+				MaxUnavailable: pointer.P(intstr.FromString("25%")),
+				MaxSurge:       pointer.P(intstr.FromString("25%")),
+			}, int32(10), 2, 3),
+		Entry("should allow one unavailable VM when restarting",
+			&poolv1.VirtualMachinePoolRollingUpdate{
+				MaxUnavailable: pointer.P(intstr.FromString("10%")),
+			}, int32(5), 1, 1),
+		Entry("should allow no unavailable VM when live migrating",
+			&poolv1.VirtualMachinePoolRollingUpdate{
+				Method:         poolv1.VirtualMachinePoolUpdateMethodLiveMigrate,
+				MaxUnavailable: pointer.P(intstr.FromInt32(0)),
+			}, int32(5), 0, 1),
+		Entry("should allow one unavailable VM when nothing could progress",
+			&poolv1.VirtualMachinePoolRollingUpdate{
+				Method:         poolv1.VirtualMachinePoolUpdateMethodLiveMigrate,
+				MaxUnavailable: pointer.P(intstr.FromInt32(0)),
+				MaxSurge:       pointer.P(intstr.FromInt32(0)),
+			}, int32(5), 1, 0),
+	)
+
+	DescribeTable("Calculate replicas for the guest utilization", func(currentReplicas int32, utilizations []int32, expectedReplicas int32, expectedOK bool) {
+		replicas, ok := replicasForUtilization(currentReplicas, utilizations, 50)
+		Expect(ok).To(Equal(expectedOK))
+		Expect(replicas).To(Equal(expectedReplicas))
+	},
+		Entry("no utilization", int32(2), nil, int32(0), false),
+		Entry("utilization above the target", int32(2), []int32{90, 70}, int32(4), true),
+		Entry("utilization below the target", int32(4), []int32{10, 20, 10, 20}, int32(2), true),
+		Entry("utilization within the tolerance", int32(3), []int32{52, 54, 50}, int32(3), true),
+		Entry("utilization of part of the replicas", int32(4), []int32{90, 90}, int32(8), true),
+	)
+
 	Context("One valid Pool controller given", func() {
 
 		const (
@@ -94,6 +146,7 @@ var _ = Describe("Pool", func() {
 		var mockQueue *testutils.MockWorkQueue[string]
 		var fakeVirtClient *kubevirtfake.Clientset
 		var k8sClient *k8sfake.Clientset
+		var virtClient *kubecli.MockKubevirtClient
 
 		addCR := func(cr *appsv1.ControllerRevision) {
 			controller.revisionIndexer.Add(cr)
@@ -117,11 +170,12 @@ var _ = Describe("Pool", func() {
 		}
 
 		BeforeEach(func() {
-			virtClient := kubecli.NewMockKubevirtClient(gomock.NewController(GinkgoT()))
+			virtClient = kubecli.NewMockKubevirtClient(gomock.NewController(GinkgoT()))
 
 			vmiInformer, _ := testutils.NewFakeInformerFor(&v1.VirtualMachineInstance{})
 			vmInformer, _ := testutils.NewFakeInformerFor(&v1.VirtualMachine{})
 			poolInformer, _ := testutils.NewFakeInformerFor(&poolv1.VirtualMachinePool{})
+			migrationInformer, _ := testutils.NewFakeInformerFor(&v1.VirtualMachineInstanceMigration{})
 			recorder = record.NewFakeRecorder(100)
 			recorder.IncludeObject = true
 
@@ -142,6 +196,7 @@ var _ = Describe("Pool", func() {
 				vmInformer,
 				poolInformer,
 				crInformer,
+				migrationInformer,
 				recorder,
 				uint(10))
 			// Wrap our workqueue to have a way to detect when we are done processing updates
@@ -238,7 +293,7 @@ var _ = Describe("Pool", func() {
 
 		sanityExecute := func() {
 			controllertesting.SanityExecute(controller, []cache.Store{
-				controller.vmiStore, controller.vmIndexer, controller.poolIndexer, controller.revisionIndexer,
+				controller.vmiStore, controller.vmIndexer, controller.poolIndexer, controller.revisionIndexer, controller.migrationStore,
 			}, Default)
 		}
 
@@ -270,6 +325,7 @@ var _ = Describe("Pool", func() {
 			vm.Name = fmt.Sprintf("%s-0", pool.Name)
 
 			vm = injectPoolRevisionLabelsIntoVM(vm, poolRevision.Name)
+			setUpdatedStatus(pool, poolRevision.Name, 1)
 
 			vmi := api.NewMinimalVMI(vm.Name)
 			vmi.Spec = vm.Spec.Template.Spec
@@ -331,6 +387,7 @@ var _ = Describe("Pool", func() {
 			vm = injectPoolRevisionLabelsIntoVM(vm, newPoolRevision.Name)
 			vm.Name = fmt.Sprintf("%s-0", pool.Name)
 			markVmAsReady(vm)
+			pool.Status.UpdateRevision = newPoolRevision.Name
 
 			vmi := api.NewMinimalVMI(vm.Name)
 			vmi.Spec = vm.Spec.Template.Spec
@@ -375,6 +432,7 @@ var _ = Describe("Pool", func() {
 
 			pool.Status.Replicas = 1
 			pool.Status.ReadyReplicas = 1
+			setUpdatedStatus(pool, poolRevision.Name, 1)
 			addPool(pool)
 			addVM(vm)
 			addCR(poolRevision)
@@ -395,6 +453,7 @@ var _ = Describe("Pool", func() {
 
 			pool.Status.Replicas = 1
 			pool.Status.ReadyReplicas = 1
+			setUpdatedStatus(pool, poolRevision.Name, 1)
 			addPool(pool)
 			addVM(vm)
 			addCR(poolRevision)
@@ -676,6 +735,639 @@ var _ = Describe("Pool", func() {
 
 		})
 
+		Context("with a rolling update strategy", func() {
+			var pool *poolv1.VirtualMachinePool
+			var vms []*v1.VirtualMachine
+			var vmis []*v1.VirtualMachineInstance
+			var oldPoolRevision, newPoolRevision *appsv1.ControllerRevision
+
+			BeforeEach(func() {
+				var vm *v1.VirtualMachine
+				pool, vm = DefaultPool(3)
+				oldPoolRevision = createPoolRevision(pool)
+
+				pool.Generation = 123
+				pool.Spec.VirtualMachineTemplate.Spec.Template.ObjectMeta.Labels = map[string]string{"newkey": "newval"}
+				pool.Spec.UpdateStrategy = &poolv1.VirtualMachinePoolUpdateStrategy{
+					RollingUpdate: &poolv1.VirtualMachinePoolRollingUpdate{},
+				}
+				newPoolRevision = createPoolRevision(pool)
+				pool.Status.Replicas = 3
+				pool.Status.ReadyReplicas = 3
+				pool.Status.UpdateRevision = newPoolRevision.Name
+
+				vms = nil
+				vmis = nil
+				for i := 0; i < 3; i++ {
+					newVM := vm.DeepCopy()
+					newVM.Name = fmt.Sprintf("%s-%d", pool.Name, i)
+					newVM = injectPoolRevisionLabelsIntoVM(newVM, newPoolRevision.Name)
+					markVmAsReady(newVM)
+
+					vmi := api.NewMinimalVMI(newVM.Name)
+					vmi.Namespace = newVM.Namespace
+					vmi.Labels = map[string]string{v1.VirtualMachinePoolRevisionName: oldPoolRevision.Name}
+					vmi.Status.Conditions = []v1.VirtualMachineInstanceCondition{{
+						Type:   v1.VirtualMachineInstanceIsMigratable,
+						Status: k8sv1.ConditionTrue,
+					}}
+
+					vms = append(vms, newVM)
+					vmis = append(vmis, vmi)
+				}
+
+				addPool(pool)
+				addCR(oldPoolRevision)
+				addCR(newPoolRevision)
+
+				fakeVirtClient.Fake.PrependReactor("delete", "virtualmachineinstances", func(action k8stesting.Action) (handled bool, obj runtime.Object, err error) {
+					return true, nil, nil
+				})
+			})
+
+			addVMsAndVMIs := func() {
+				for i := range vms {
+					addVM(vms[i])
+					addVMI(vmis[i])
+				}
+			}
+
+			It("should restart at most maxUnavailable VMIs", func() {
+				pool.Spec.UpdateStrategy.RollingUpdate.MaxUnavailable = pointer.P(intstr.FromInt32(2))
+				addVMsAndVMIs()
+
+				sanityExecute()
+
+				testutils.ExpectEvent(recorder, common.SuccessfulDeleteVirtualMachineReason)
+				testutils.ExpectEvent(recorder, common.SuccessfulDeleteVirtualMachineReason)
+				Expect(testing.FilterActions(&fakeVirtClient.Fake, "delete", "virtualmachineinstances")).To(HaveLen(2))
+			})
+
+			It("should count unavailable VMs against maxUnavailable", func() {
+				virtcontroller.NewVirtualMachineConditionManager().RemoveCondition(vms[0], v1.VirtualMachineReady)
+				addVMsAndVMIs()
+
+				fakeVirtClient.Fake.PrependReactor("update", "virtualmachinepools", func(action k8stesting.Action) (handled bool, obj runtime.Object, err error) {
+					return true, action.(k8stesting.UpdateAction).GetObject(), nil
+				})
+
+				sanityExecute()
+
+				Expect(testing.FilterActions(&fakeVirtClient.Fake, "delete", "virtualmachineinstances")).To(BeEmpty())
+			})
+
+			It("should report the progress of the update", func() {
+				vmis[0].Labels[v1.VirtualMachinePoolRevisionName] = newPoolRevision.Name
+				addVMsAndVMIs()
+
+				fakeVirtClient.Fake.PrependReactor("update", "virtualmachinepools", func(action k8stesting.Action) (handled bool, obj runtime.Object, err error) {
+					updateObj := action.(k8stesting.UpdateAction).GetObject().(*poolv1.VirtualMachinePool)
+					Expect(updateObj.Status.UpdateRevision).To(Equal(newPoolRevision.Name))
+					Expect(updateObj.Status.CurrentRevision).To(BeEmpty())
+					Expect(updateObj.Status.UpdatedReplicas).To(Equal(int32(1)))
+					return true, updateObj, nil
+				})
+
+				sanityExecute()
+
+				testutils.ExpectEvent(recorder, common.SuccessfulDeleteVirtualMachineReason)
+				Expect(testing.FilterActions(&fakeVirtClient.Fake, "update", "virtualmachinepools")).To(HaveLen(1))
+			})
+
+			Context("and the LiveMigrate method", func() {
+				BeforeEach(func() {
+					pool.Spec.UpdateStrategy.RollingUpdate.Method = poolv1.VirtualMachinePoolUpdateMethodLiveMigrate
+					for _, vm := range vms {
+						vm.Generation = 2
+						vm.Status.DesiredGeneration = 2
+					}
+					virtClient.EXPECT().VirtualMachineInstanceMigration(metav1.NamespaceDefault).Return(fakeVirtClient.KubevirtV1().VirtualMachineInstanceMigrations(metav1.NamespaceDefault)).AnyTimes()
+					fakeVirtClient.Fake.PrependReactor("create", "virtualmachineinstancemigrations", func(action k8stesting.Action) (handled bool, obj runtime.Object, err error) {
+						migration := action.(k8stesting.CreateAction).GetObject().(*v1.VirtualMachineInstanceMigration)
+						Expect(migration.Labels).To(HaveKeyWithValue(v1.VirtualMachinePoolRevisionName, newPoolRevision.Name))
+						return true, migration, nil
+					})
+				})
+
+				It("should migrate at most maxSurge VMIs", func() {
+					pool.Spec.UpdateStrategy.RollingUpdate.MaxSurge = pointer.P(intstr.FromString("50%"))
+					addVMsAndVMIs()
+
+					sanityExecute()
+
+					testutils.ExpectEvent(recorder, SuccessfulMigrateVirtualMachineReason)
+					testutils.ExpectEvent(recorder, SuccessfulMigrateVirtualMachineReason)
+					Expect(testing.FilterActions(&fakeVirtClient.Fake, "create", "virtualmachineinstancemigrations")).To(HaveLen(2))
+					Expect(testing.FilterActions(&fakeVirtClient.Fake, "delete", "virtualmachineinstances")).To(BeEmpty())
+				})
+
+				It("should count running migrations against maxSurge", func() {
+					controller.migrationStore.Add(&v1.VirtualMachineInstanceMigration{
+						ObjectMeta: metav1.ObjectMeta{Name: updateMigrationName(vms[0]), Namespace: vms[0].Namespace},
+						Spec:       v1.VirtualMachineInstanceMigrationSpec{VMIName: vms[0].Name},
+						Status:     v1.VirtualMachineInstanceMigrationStatus{Phase: v1.MigrationRunning},
+					})
+					addVMsAndVMIs()
+
+					sanityExecute()
+
+					Expect(testing.FilterActions(&fakeVirtClient.Fake, "create", "virtualmachineinstancemigrations")).To(BeEmpty())
+				})
+
+				It("should update the revision of a migrated VMI", func() {
+					pool.Spec.Replicas = pointer.P(int32(1))
+					pool.Status.Replicas = 1
+					pool.Status.ReadyReplicas = 1
+					vms, vmis = vms[:1], vmis[:1]
+					controller.migrationStore.Add(&v1.VirtualMachineInstanceMigration{
+						ObjectMeta: metav1.ObjectMeta{Name: updateMigrationName(vms[0]), Namespace: vms[0].Namespace},
+						Spec:       v1.VirtualMachineInstanceMigrationSpec{VMIName: vms[0].Name},
+						Status:     v1.VirtualMachineInstanceMigrationStatus{Phase: v1.MigrationSucceeded},
+					})
+					addVMsAndVMIs()
+
+					fakeVirtClient.Fake.PrependReactor("patch", "virtualmachineinstances", func(action k8stesting.Action) (handled bool, obj runtime.Object, err error) {
+						patchAction := action.(k8stesting.PatchAction)
+						Expect(patchAction.GetName()).To(Equal(vmis[0].Name))
+						Expect(string(patchAction.GetPatch())).To(ContainSubstring(newPoolRevision.Name))
+						return true, vmis[0], nil
+					})
+
+					sanityExecute()
+
+					Expect(testing.FilterActions(&fakeVirtClient.Fake, "patch", "virtualmachineinstances")).To(HaveLen(1))
+					Expect(testing.FilterActions(&fakeVirtClient.Fake, "delete", "virtualmachineinstances")).To(BeEmpty())
+				})
+
+				It("should wait for the VM controller to process the update before migrating", func() {
+					for _, vm := range vms {
+						vm.Status.DesiredGeneration = 1
+					}
+					addVMsAndVMIs()
+
+					sanityExecute()
+
+					Expect(testing.FilterActions(&fakeVirtClient.Fake, "create", "virtualmachineinstancemigrations")).To(BeEmpty())
+					Expect(testing.FilterActions(&fakeVirtClient.Fake, "delete", "virtualmachineinstances")).To(BeEmpty())
+				})
+
+				It("should not update the revision of a migrated VMI before the VM controller processed the update", func() {
+					pool.Spec.Replicas = pointer.P(int32(1))
+					pool.Status.Replicas = 1
+					pool.Status.ReadyReplicas = 1
+					vms, vmis = vms[:1], vmis[:1]
+					vms[0].Status.DesiredGeneration = 1
+					controller.migrationStore.Add(&v1.VirtualMachineInstanceMigration{
+						ObjectMeta: metav1.ObjectMeta{Name: updateMigrationName(vms[0]), Namespace: vms[0].Namespace},
+						Spec:       v1.VirtualMachineInstanceMigrationSpec{VMIName: vms[0].Name},
+						Status:     v1.VirtualMachineInstanceMigrationStatus{Phase: v1.MigrationSucceeded},
+					})
+					addVMsAndVMIs()
+
+					sanityExecute()
+
+					Expect(testing.FilterActions(&fakeVirtClient.Fake, "patch", "virtualmachineinstances")).To(BeEmpty())
+					Expect(testing.FilterActions(&fakeVirtClient.Fake, "delete", "virtualmachineinstances")).To(BeEmpty())
+				})
+
+				It("should restart a migrated VMI whose VM requires a restart", func() {
+					pool.Spec.Replicas = pointer.P(int32(1))
+					pool.Status.Replicas = 1
+					pool.Status.ReadyReplicas = 1
+					vms, vmis = vms[:1], vmis[:1]
+					controller.migrationStore.Add(&v1.VirtualMachineInstanceMigration{
+						ObjectMeta: metav1.ObjectMeta{Name: updateMigrationName(vms[0]), Namespace: vms[0].Namespace},
+						Spec:       v1.VirtualMachineInstanceMigrationSpec{VMIName: vms[0].Name},
+						Status:     v1.VirtualMachineInstanceMigrationStatus{Phase: v1.MigrationSucceeded},
+					})
+					virtcontroller.NewVirtualMachineConditionManager().UpdateCondition(vms[0], &v1.VirtualMachineCondition{
+						Type:   v1.VirtualMachineRestartRequired,
+						Status: k8sv1.ConditionTrue,
+					})
+					addVMsAndVMIs()
+
+					sanityExecute()
+
+					testutils.ExpectEvent(recorder, common.SuccessfulDeleteVirtualMachineReason)
+					Expect(testing.FilterActions(&fakeVirtClient.Fake, "patch", "virtualmachineinstances")).To(BeEmpty())
+					Expect(testing.FilterActions(&fakeVirtClient.Fake, "delete", "virtualmachineinstances")).To(HaveLen(1))
+				})
+
+				It("should restart a VMI whose VM requires a restart", func() {
+					for _, vm := range vms {
+						virtcontroller.NewVirtualMachineConditionManager().UpdateCondition(vm, &v1.VirtualMachineCondition{
+							Type:   v1.VirtualMachineRestartRequired,
+							Status: k8sv1.ConditionTrue,
+						})
+					}
+					addVMsAndVMIs()
+
+					sanityExecute()
+
+					testutils.ExpectEvent(recorder, common.SuccessfulDeleteVirtualMachineReason)
+					Expect(testing.FilterActions(&fakeVirtClient.Fake, "create", "virtualmachineinstancemigrations")).To(BeEmpty())
+					Expect(testing.FilterActions(&fakeVirtClient.Fake, "delete", "virtualmachineinstances")).To(HaveLen(1))
+				})
+			})
+		})
+
+		Context("with a scale-in strategy", func() {
+			var pool *poolv1.VirtualMachinePool
+			var vms []*v1.VirtualMachine
+
+			BeforeEach(func() {
+				var vm *v1.VirtualMachine
+				pool, vm = DefaultPool(2)
+				pool.Spec.ScaleInStrategy = &poolv1.VirtualMachinePoolScaleInStrategy{}
+
+				vms = nil
+				for i := 0; i < 4; i++ {
+					newVM := vm.DeepCopy()
+					newVM.Name = fmt.Sprintf("%s-%d", pool.Name, i)
+					newVM.UID = k8stypes.UID(newVM.Name)
+					newVM.CreationTimestamp = metav1.NewTime(time.Now().Add(time.Duration(i) * time.Minute))
+					markVmAsReady(newVM)
+					vms = append(vms, newVM)
+				}
+
+				fakeVirtClient.Fake.PrependReactor("update", "virtualmachinepools", func(action k8stesting.Action) (handled bool, obj runtime.Object, err error) {
+					return true, action.(k8stesting.UpdateAction).GetObject(), nil
+				})
+				fakeVirtClient.Fake.PrependReactor("delete", "virtualmachines", func(action k8stesting.Action) (handled bool, obj runtime.Object, err error) {
+					return true, nil, nil
+				})
+			})
+
+			addVMs := func() {
+				addPool(pool)
+				for _, vm := range vms {
+					addVM(vm)
+				}
+			}
+
+			deletedVMNames := func() []string {
+				var names []string
+				for _, action := range testing.FilterActions(&fakeVirtClient.Fake, "delete", "virtualmachines") {
+					names = append(names, action.(k8stesting.DeleteAction).GetName())
+				}
+				return names
+			}
+
+			requestScaleIn := func(vm *v1.VirtualMachine, requested time.Time) {
+				vm.Annotations[v1.VirtualMachinePoolScaleInAnnotation] = requested.UTC().Format(time.RFC3339)
+			}
+
+			DescribeTable("should delete VMs in the order of the selection policy", func(policy poolv1.VirtualMachinePoolSelectionPolicy, expectedIndexes ...int) {
+				pool.Spec.ScaleInStrategy.SelectionPolicy = policy
+				virtcontroller.NewVirtualMachineConditionManager().RemoveCondition(vms[1], v1.VirtualMachineReady)
+				addVMs()
+
+				sanityExecute()
+
+				var expectedNames []string
+				for _, index := range expectedIndexes {
+					expectedNames = append(expectedNames, vms[index].Name)
+				}
+				Expect(deletedVMNames()).To(ConsistOf(expectedNames))
+			},
+				Entry("newest", poolv1.VirtualMachinePoolSelectionPolicyNewest, 3, 2),
+				Entry("oldest", poolv1.VirtualMachinePoolSelectionPolicyOldest, 0, 1),
+				Entry("unhealthy first", poolv1.VirtualMachinePoolSelectionPolicyUnhealthyFirst, 1, 3),
+				Entry("descending ordinal", poolv1.VirtualMachinePoolSelectionPolicyDescendingOrdinal, 3, 2),
+			)
+
+			Context("and proactive scale-in", func() {
+				BeforeEach(func() {
+					pool.Spec.ScaleInStrategy.SelectionPolicy = poolv1.VirtualMachinePoolSelectionPolicyDescendingOrdinal
+					pool.Spec.ScaleInStrategy.Proactive = &poolv1.VirtualMachinePoolProactiveScaleInStrategy{
+						PreStopGracePeriodSeconds: pointer.P(int64(60)),
+					}
+				})
+
+				patchedVMNames := func() []string {
+					var names []string
+					for _, action := range testing.FilterActions(&fakeVirtClient.Fake, "patch", "virtualmachines") {
+						names = append(names, action.(k8stesting.PatchAction).GetName())
+					}
+					return names
+				}
+
+				expectScaleInAnnotationPatch := func(requested bool) {
+					fakeVirtClient.Fake.PrependReactor("patch", "virtualmachines", func(action k8stesting.Action) (handled bool, obj runtime.Object, err error) {
+						patchAction := action.(k8stesting.PatchAction)
+						if requested {
+							Expect(string(patchAction.GetPatch())).To(ContainSubstring(v1.VirtualMachinePoolScaleInAnnotation))
+						} else {
+							Expect(string(patchAction.GetPatch())).ToNot(ContainSubstring(`"value":{"` + v1.VirtualMachinePoolScaleInAnnotation))
+						}
+						return true, nil, nil
+					})
+				}
+
+				It("should announce the removal of VMs instead of deleting them", func() {
+					expectScaleInAnnotationPatch(true)
+					addVMs()
+
+					sanityExecute()
+
+					testutils.ExpectEvent(recorder, SuccessfulRequestScaleInVirtualMachineReason)
+					testutils.ExpectEvent(recorder, SuccessfulRequestScaleInVirtualMachineReason)
+					Expect(patchedVMNames()).To(ConsistOf(vms[3].Name, vms[2].Name))
+					Expect(deletedVMNames()).To(BeEmpty())
+					Expect(mockQueue.GetAddAfterEnqueueCount()).To(Equal(1))
+				})
+
+				It("should not delete announced VMs before the grace period expired", func() {
+					requestScaleIn(vms[2], time.Now())
+					requestScaleIn(vms[3], time.Now())
+					addVMs()
+
+					sanityExecute()
+
+					Expect(deletedVMNames()).To(BeEmpty())
+					Expect(patchedVMNames()).To(BeEmpty())
+					Expect(mockQueue.GetAddAfterEnqueueCount()).To(Equal(1))
+				})
+
+				It("should delete announced VMs once the grace period expired", func() {
+					requestScaleIn(vms[2], time.Now().Add(-2*time.Minute))
+					requestScaleIn(vms[3], time.Now())
+					addVMs()
+
+					sanityExecute()
+
+					testutils.ExpectEvent(recorder, common.SuccessfulDeleteVirtualMachineReason)
+					Expect(deletedVMNames()).To(ConsistOf(vms[2].Name))
+				})
+
+				It("should keep announced VMs when the pool scales out again", func() {
+					pool.Spec.Replicas = pointer.P(int32(3))
+					requestScaleIn(vms[2], time.Now())
+					requestScaleIn(vms[3], time.Now())
+					expectScaleInAnnotationPatch(false)
+					addVMs()
+
+					sanityExecute()
+
+					testutils.ExpectEvent(recorder, SuccessfulCancelScaleInVirtualMachineReason)
+					Expect(patchedVMNames()).To(ConsistOf(vms[2].Name))
+					Expect(deletedVMNames()).To(BeEmpty())
+				})
+			})
+
+			Context("and retained volumes", func() {
+				var cdiClient *cdifake.Clientset
+
+				expectedPoolRef := func() metav1.OwnerReference {
+					return metav1.OwnerReference{
+						APIVersion:         poolv1.SchemeGroupVersion.String(),
+						Kind:               poolv1.VirtualMachinePoolKind,
+						Name:               pool.Name,
+						UID:                pool.UID,
+						BlockOwnerDeletion: pointer.P(true),
+					}
+				}
+
+				BeforeEach(func() {
+					pool.Spec.Replicas = pointer.P(int32(3))
+					pool.Spec.ScaleInStrategy.SelectionPolicy = poolv1.VirtualMachinePoolSelectionPolicyDescendingOrdinal
+					pool.Spec.ScaleInStrategy.VolumeRetentionPolicy = poolv1.VirtualMachinePoolVolumeRetentionPolicyRetain
+					for i, vm := range vms {
+						vm.Spec.DataVolumeTemplates = []v1.DataVolumeTemplateSpec{{
+							ObjectMeta: metav1.ObjectMeta{Name: fmt.Sprintf("dv-%d", i)},
+						}}
+					}
+
+					cdiClient = cdifake.NewSimpleClientset()
+					virtClient.EXPECT().CdiClient().Return(cdiClient).AnyTimes()
+					virtClient.EXPECT().CoreV1().Return(k8sClient.CoreV1()).AnyTimes()
+					k8sClient.Fake.PrependReactor("get", "persistentvolumeclaims", func(action k8stesting.Action) (handled bool, obj runtime.Object, err error) {
+						return true, nil, k8serrors.NewNotFound(k8sv1.Resource("persistentvolumeclaims"), action.(k8stesting.GetAction).GetName())
+					})
+				})
+
+				It("should release the DataVolumes of removed VMs before deleting them", func() {
+					dv := &cdiv1.DataVolume{
+						ObjectMeta: metav1.ObjectMeta{
+							Name:      "dv-3",
+							Namespace: vms[3].Namespace,
+							OwnerReferences: []metav1.OwnerReference{{
+								APIVersion: v1.VirtualMachineGroupVersionKind.GroupVersion().String(),
+								Kind:       v1.VirtualMachineGroupVersionKind.Kind,
+								Name:       vms[3].Name,
+								UID:        vms[3].UID,
+								Controller: pointer.P(true),
+							}},
+						},
+					}
+					_, err := cdiClient.CdiV1beta1().DataVolumes(dv.Namespace).Create(context.Background(), dv, metav1.CreateOptions{})
+					Expect(err).ToNot(HaveOccurred())
+					addVMs()
+
+					sanityExecute()
+
+					testutils.ExpectEvent(recorder, common.SuccessfulDeleteVirtualMachineReason)
+					Expect(deletedVMNames()).To(ConsistOf(vms[3].Name))
+					dv, err = cdiClient.CdiV1beta1().DataVolumes(dv.Namespace).Get(context.Background(), dv.Name, metav1.GetOptions{})
+					Expect(err).ToNot(HaveOccurred())
+					Expect(dv.OwnerReferences).To(ConsistOf(expectedPoolRef()))
+				})
+
+				It("should hand the PVCs of garbage collected DataVolumes over to the pool", func() {
+					pvc := &k8sv1.PersistentVolumeClaim{
+						ObjectMeta: metav1.ObjectMeta{
+							Name:      "dv-3",
+							Namespace: vms[3].Namespace,
+							OwnerReferences: []metav1.OwnerReference{{
+								APIVersion: v1.VirtualMachineGroupVersionKind.GroupVersion().String(),
+								Kind:       v1.VirtualMachineGroupVersionKind.Kind,
+								Name:       vms[3].Name,
+								UID:        vms[3].UID,
+								Controller: pointer.P(true),
+							}},
+						},
+					}
+					Expect(k8sClient.Tracker().Add(pvc)).To(Succeed())
+					pvcReaction := k8stesting.ObjectReaction(k8sClient.Tracker())
+					k8sClient.Fake.PrependReactor("*", "persistentvolumeclaims", func(action k8stesting.Action) (handled bool, obj runtime.Object, err error) {
+						return pvcReaction(action)
+					})
+					addVMs()
+
+					sanityExecute()
+
+					testutils.ExpectEvent(recorder, common.SuccessfulDeleteVirtualMachineReason)
+					Expect(deletedVMNames()).To(ConsistOf(vms[3].Name))
+					obj, err := k8sClient.Tracker().Get(k8sv1.SchemeGroupVersion.WithResource("persistentvolumeclaims"), pvc.Namespace, pvc.Name)
+					Expect(err).ToNot(HaveOccurred())
+					Expect(obj.(*k8sv1.PersistentVolumeClaim).OwnerReferences).To(ConsistOf(expectedPoolRef()))
+				})
+			})
+		})
+
+		Context("with autoscaling", func() {
+			var pool *poolv1.VirtualMachinePool
+			var poolRevision *appsv1.ControllerRevision
+			var vms []*v1.VirtualMachine
+			var vmis []*v1.VirtualMachineInstance
+
+			BeforeEach(func() {
+				var vm *v1.VirtualMachine
+				pool, vm = DefaultPool(2)
+				pool.Spec.Autoscaling = &poolv1.VirtualMachinePoolAutoscaling{
+					MaxReplicas:                    5,
+					TargetCPUUtilizationPercentage: pointer.P(int32(50)),
+				}
+				poolRevision = createPoolRevision(pool)
+				pool.Status.Replicas = 2
+				pool.Status.ReadyReplicas = 2
+				setUpdatedStatus(pool, poolRevision.Name, 2)
+
+				vms = nil
+				vmis = nil
+				for i := 0; i < 2; i++ {
+					newVM := vm.DeepCopy()
+					newVM.Name = fmt.Sprintf("%s-%d", pool.Name, i)
+					newVM = injectPoolRevisionLabelsIntoVM(newVM, poolRevision.Name)
+					markVmAsReady(newVM)
+
+					vmi := api.NewMinimalVMI(newVM.Name)
+					vmi.Namespace = newVM.Namespace
+					vmi.Labels = map[string]string{v1.VirtualMachinePoolRevisionName: poolRevision.Name}
+					vmi.Status.Phase = v1.Running
+
+					vms = append(vms, newVM)
+					vmis = append(vmis, vmi)
+				}
+
+				fakeVirtClient.Fake.PrependReactor("update", "virtualmachinepools", func(action k8stesting.Action) (handled bool, obj runtime.Object, err error) {
+					return true, action.(k8stesting.UpdateAction).GetObject(), nil
+				})
+			})
+
+			setGuestLoad := func(cpuUtilization int32) {
+				for _, vmi := range vmis {
+					vmi.Status.GuestLoad = &v1.GuestLoadStatus{
+						CPUUtilizationPercentage: pointer.P(cpuUtilization),
+						LastProbeTime:            metav1.Now(),
+					}
+				}
+			}
+
+			addAll := func() {
+				addPool(pool)
+				addCR(poolRevision)
+				for i := range vms {
+					addVM(vms[i])
+					addVMI(vmis[i])
+				}
+			}
+
+			expectReplicasPatch := func(replicas int32) {
+				fakeVirtClient.Fake.PrependReactor("patch", "virtualmachinepools", func(action k8stesting.Action) (handled bool, obj runtime.Object, err error) {
+					patchAction := action.(k8stesting.PatchAction)
+					Expect(patchAction.GetPatchType()).To(Equal(k8stypes.JSONPatchType))
+					Expect(string(patchAction.GetPatch())).To(ContainSubstring(fmt.Sprintf(`{"op":"replace","path":"/spec/replicas","value":%d}`, replicas)))
+					patchedPool := pool.DeepCopy()
+					patchedPool.Spec.Replicas = pointer.P(replicas)
+					return true, patchedPool, nil
+				})
+			}
+
+			It("should scale out when the guests are loaded above the target", func() {
+				setGuestLoad(90)
+				expectReplicasPatch(4)
+				expectVMCreation(HavePrefix(fmt.Sprintf("%s-", pool.Name)))
+				addAll()
+
+				sanityExecute()
+
+				testutils.ExpectEvent(recorder, SuccessfulAutoscalePoolReason)
+				testutils.ExpectEvent(recorder, common.SuccessfulCreateVirtualMachineReason)
+				testutils.ExpectEvent(recorder, common.SuccessfulCreateVirtualMachineReason)
+				var poolActions []k8stesting.Action
+				for _, action := range fakeVirtClient.Fake.Actions() {
+					if action.GetResource().Resource == "virtualmachinepools" && (action.GetVerb() == "update" || action.GetVerb() == "patch") {
+						poolActions = append(poolActions, action)
+					}
+				}
+				Expect(len(poolActions)).To(BeNumerically(">=", 2))
+				Expect(poolActions[0].GetVerb()).To(Equal("update"), "the scale time should be recorded before the replicas are patched")
+				Expect(poolActions[0].GetSubresource()).To(Equal("status"))
+				updatedPool := poolActions[0].(k8stesting.UpdateAction).GetObject().(*poolv1.VirtualMachinePool)
+				Expect(updatedPool.Status.LastScaleTime).ToNot(BeNil())
+				Expect(poolActions[1].GetVerb()).To(Equal("patch"))
+			})
+
+			It("should not scale in while a running VMI did not report its load", func() {
+				setGuestLoad(10)
+				vmis[1].Status.GuestLoad = nil
+				addAll()
+
+				sanityExecute()
+
+				Expect(testing.FilterActions(&fakeVirtClient.Fake, "patch", "virtualmachinepools")).To(BeEmpty())
+			})
+
+			It("should scale out while a running VMI did not report its load", func() {
+				setGuestLoad(90)
+				vmis[1].Status.GuestLoad = nil
+				expectReplicasPatch(4)
+				expectVMCreation(HavePrefix(fmt.Sprintf("%s-", pool.Name)))
+				addAll()
+
+				sanityExecute()
+
+				testutils.ExpectEvent(recorder, SuccessfulAutoscalePoolReason)
+				testutils.ExpectEvent(recorder, common.SuccessfulCreateVirtualMachineReason)
+				testutils.ExpectEvent(recorder, common.SuccessfulCreateVirtualMachineReason)
+			})
+
+			It("should not scale a new pool within the cooldown", func() {
+				pool.CreationTimestamp = metav1.Now()
+				setGuestLoad(90)
+				addAll()
+
+				sanityExecute()
+
+				Expect(testing.FilterActions(&fakeVirtClient.Fake, "patch", "virtualmachinepools")).To(BeEmpty())
+				Expect(mockQueue.GetAddAfterEnqueueCount()).To(Equal(1))
+			})
+
+			It("should not scale below minReplicas", func() {
+				pool.Spec.Autoscaling.MinReplicas = pointer.P(int32(2))
+				setGuestLoad(10)
+				addAll()
+
+				sanityExecute()
+
+				Expect(testing.FilterActions(&fakeVirtClient.Fake, "patch", "virtualmachinepools")).To(BeEmpty())
+			})
+
+			It("should ignore a stale guest load", func() {
+				setGuestLoad(90)
+				for _, vmi := range vmis {
+					vmi.Status.GuestLoad.LastProbeTime = metav1.NewTime(time.Now().Add(-time.Hour))
+				}
+				addAll()
+
+				sanityExecute()
+
+				Expect(testing.FilterActions(&fakeVirtClient.Fake, "patch", "virtualmachinepools")).To(BeEmpty())
+			})
+
+			It("should not scale again within the cooldown", func() {
+				pool.Status.LastScaleTime = pointer.P(metav1.Now())
+				setGuestLoad(90)
+				addAll()
+
+				sanityExecute()
+
+				Expect(testing.FilterActions(&fakeVirtClient.Fake, "patch", "virtualmachinepools")).To(BeEmpty())
+				Expect(mockQueue.GetAddAfterEnqueueCount()).To(Equal(1))
+			})
+		})
+
 		It("should detect a VM is detached, then release and replace it", func() {
 			pool, vm := DefaultPool(3)
 			vm.Labels = map[string]string{}
@@ -750,6 +1442,12 @@ func DefaultPool(replicas int32) (*poolv1.VirtualMachinePool, *v1.VirtualMachine
 	return pool, vm.DeepCopy()
 }
 
+func setUpdatedStatus(pool *poolv1.VirtualMachinePool, revisionName string, updatedReplicas int32) {
+	pool.Status.UpdateRevision = revisionName
+	pool.Status.CurrentRevision = revisionName
+	pool.Status.UpdatedReplicas = updatedReplicas
+}
+
 func markVmAsReady(vm *v1.VirtualMachine) {
 	virtcontroller.NewVirtualMachineConditionManager().UpdateCondition(vm, &v1.VirtualMachineCondition{Type: v1.VirtualMachineReady, Status: k8sv1.ConditionTrue})
 }
@@ -63,6 +63,7 @@ import (
 
 	"kubevirt.io/kubevirt/pkg/apimachinery/patch"
 	"kubevirt.io/kubevirt/pkg/controller"
+	"kubevirt.io/kubevirt/pkg/storage/backup"
 	"kubevirt.io/kubevirt/pkg/storage/memorydump"
 	storagetypes "kubevirt.io/kubevirt/pkg/storage/types"
 	"kubevirt.io/kubevirt/pkg/util"
@@ -809,6 +810,10 @@ func (c *Controller) handleVolumeUpdateRequest(vm *virtv1.VirtualMachine, vmi *v
 	if vmi == nil {
 		return nil
 	}
+	// A migration copying the local volumes of the VMI owns its volumes until it finished
+	if _, exists := vmi.Annotations[virtv1.LocalVolumesCopyAnnotation]; exists {
+		return nil
+	}
 
 	// The pull policy for container disks are only set on the VMI spec and not on the VM spec.
 	// In order to correctly compare the volumes set, we need to set the pull policy on the VM spec as well.
@@ -1815,6 +1820,13 @@ func (c *Controller) setupVMIFromVM(vm *virtv1.VirtualMachine) *virtv1.VirtualMa
 		vmi.Spec = *memorydump.RemoveMemoryDumpVolumeFromVMISpec(&vmi.Spec, vm.Status.MemoryDumpRequest.ClaimName)
 	}
 
+	// prevent from retriggering a backup after shutdown if the backup is complete
+	if backup.HasCompleted(vm) {
+		vmi.Spec = *backup.RemoveBackupVolumeFromVMISpec(&vmi.Spec, vm.Status.BackupRequest.ClaimName)
+	}
+
+	memorydump.ApplyResumeFromMemoryState(vm, vmi)
+
 	setupStableFirmwareUUID(vm, vmi)
 
 	// TODO check if vmi labels exist, and when make sure that they match. For now just override them
@@ -2386,6 +2398,7 @@ func (c *Controller) updateStatus(vm, vmOrig *virtv1.VirtualMachine, vmi *virtv1
 
 	c.trimDoneVolumeRequests(vm)
 	memorydump.UpdateRequest(vm, vmi)
+	backup.UpdateRequest(vm, vmi)
 
 	if c.isTrimFirstChangeRequestNeeded(vm, vmi) {
 		popStateChangeRequest(vm)
@@ -2894,7 +2907,8 @@ func (c *Controller) addRestartRequiredIfNeeded(lastSeenVMSpec *virtv1.VirtualMa
 	// Note: this list needs to stay up-to-date with everything that can be live-updated
 	// Note2: destroying lastSeenVMSpec here is fine, we don't need it later
 	if c.clusterConfig.IsVMRolloutStrategyLiveUpdate() {
-		if validLiveUpdateVolumes(lastSeenVMSpec, currentVM) {
+		if validLiveUpdateVolumes(lastSeenVMSpec, currentVM) ||
+			equality.Semantic.DeepEqual(currentVM.Spec.Template.Spec.Volumes, vmi.Spec.Volumes) {
 			lastSeenVMSpec.Template.Spec.Volumes = currentVM.Spec.Template.Spec.Volumes
 		}
 		if validLiveUpdateDisks(lastSeenVMSpec, currentVM) {
@@ -3048,6 +3062,11 @@ func (c *Controller) sync(vm *virtv1.VirtualMachine, vmi *virtv1.VirtualMachineI
 	if err := memorydump.HandleRequest(c.clientset, vmCopy, vmi, c.pvcStore); err != nil {
 		return vm, vmi, common.NewSyncError(fmt.Errorf("Error encountered while handling memory dump request: %v", err), memorydump.ErrorReason), nil
 	}
+	memorydump.ClearResumeFromMemoryState(vmCopy, vmi)
+
+	if err := backup.HandleRequest(c.clientset, vmCopy, vmi, c.pvcStore); err != nil {
+		return vm, vmi, common.NewSyncError(fmt.Errorf("Error encountered while handling backup request: %v", err), backup.ErrorReason), nil
+	}
 
 	conditionManager := controller.NewVirtualMachineConditionManager()
 	if c.clusterConfig.IsVMRolloutStrategyLiveUpdate() && !restartRequired && !conditionManager.HasCondition(vm, virtv1.VirtualMachineRestartRequired) {
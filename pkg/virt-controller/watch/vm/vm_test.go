@@ -6448,6 +6448,52 @@ var _ = Describe("VirtualMachine", func() {
 					Expect(cond.Status).To(Equal(k8sv1.ConditionTrue))
 					Expect(cond.Message).To(ContainSubstring("invalid volumes to update with migration:"))
 				})
+
+				It("should not update the volumes of a VMI whose local volumes a migration copies", func() {
+					vm, vmi := watchtesting.DefaultVirtualMachine(true)
+					vm.Spec.Template.Spec.Volumes = append(vm.Spec.Template.Spec.Volumes, v1.Volume{
+						Name: "vol1",
+						VolumeSource: v1.VolumeSource{
+							PersistentVolumeClaim: &v1.PersistentVolumeClaimVolumeSource{
+								PersistentVolumeClaimVolumeSource: k8sv1.PersistentVolumeClaimVolumeSource{ClaimName: "local-pvc"}},
+						},
+					})
+					vmi.Spec.Volumes = append(vmi.Spec.Volumes, v1.Volume{
+						Name: "vol1",
+						VolumeSource: v1.VolumeSource{
+							PersistentVolumeClaim: &v1.PersistentVolumeClaimVolumeSource{
+								PersistentVolumeClaimVolumeSource: k8sv1.PersistentVolumeClaimVolumeSource{ClaimName: "local-pvc-copy"}},
+						},
+					})
+					vmi.Annotations = map[string]string{v1.LocalVolumesCopyAnnotation: "testmigration"}
+
+					Expect(controller.handleVolumeUpdateRequest(vm, vmi)).To(Succeed())
+					Expect(virtcontroller.NewVirtualMachineConditionManager().HasCondition(vm, v1.VirtualMachineRestartRequired)).To(BeFalse())
+				})
+
+				It("should not require a restart if the changed volumes are the volumes of the VMI", func() {
+					testutils.UpdateFakeKubeVirtClusterConfig(kvStore, &v1.KubeVirt{
+						Spec: v1.KubeVirtSpec{
+							Configuration: v1.KubeVirtConfiguration{
+								VMRolloutStrategy: &liveUpdate,
+							},
+						},
+					})
+					originalVM, vmi := watchtesting.DefaultVirtualMachine(true)
+					originalVM.Spec.Template.Spec.Volumes = []v1.Volume{{
+						Name: "vol1",
+						VolumeSource: v1.VolumeSource{
+							PersistentVolumeClaim: &v1.PersistentVolumeClaimVolumeSource{
+								PersistentVolumeClaimVolumeSource: k8sv1.PersistentVolumeClaimVolumeSource{ClaimName: "local-pvc"}},
+						},
+					}}
+					updatedVM := originalVM.DeepCopy()
+					updatedVM.Spec.Template.Spec.Volumes[0].PersistentVolumeClaim.ClaimName = "local-pvc-copy"
+					vmi.Spec.Volumes = updatedVM.Spec.Template.Spec.Volumes
+
+					Expect(controller.addRestartRequiredIfNeeded(&originalVM.Spec, updatedVM, vmi)).To(BeFalse())
+					Expect(virtcontroller.NewVirtualMachineConditionManager().HasCondition(updatedVM, v1.VirtualMachineRestartRequired)).To(BeFalse())
+				})
 			})
 
 			Context("Instance Types and Preferences", func() {
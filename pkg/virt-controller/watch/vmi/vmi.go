@@ -2030,6 +2030,12 @@ func (c *Controller) updateVolumeStatus(vmi *virtv1.VirtualMachineInstance, virt
 					ClaimName: volume.Name,
 				}
 			}
+			if volume.Backup != nil && status.BackupVolume == nil {
+				status.BackupVolume = &virtv1.DomainBackupInfo{
+					ClaimName:  volume.Name,
+					BackupName: volume.Backup.BackupName,
+				}
+			}
 			if attachmentPod == nil {
 				if !c.volumeReady(status.Phase) {
 					status.HotplugVolume.AttachPodUID = ""
@@ -2056,7 +2062,7 @@ func (c *Controller) updateVolumeStatus(vmi *virtv1.VirtualMachineInstance, virt
 			}
 		}
 
-		if volume.VolumeSource.PersistentVolumeClaim != nil || volume.VolumeSource.DataVolume != nil || volume.VolumeSource.MemoryDump != nil {
+		if volume.VolumeSource.PersistentVolumeClaim != nil || volume.VolumeSource.DataVolume != nil || volume.VolumeSource.MemoryDump != nil || volume.VolumeSource.Backup != nil {
 
 			pvcName := storagetypes.PVCNameFromVirtVolume(&volume)
 
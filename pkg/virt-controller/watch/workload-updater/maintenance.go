@@ -0,0 +1,181 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package workloadupdater
+
+import (
+	"sort"
+	"time"
+
+	"github.com/robfig/cron/v3"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+
+	virtv1 "kubevirt.io/api/core/v1"
+	"kubevirt.io/client-go/log"
+)
+
+const defaultMaintenanceWindowDuration = time.Hour
+
+// maxHeldWorkloadUpdates limits the number of held back updates reported on the KubeVirt CR
+const maxHeldWorkloadUpdates = 100
+
+func maintenanceWindowSelects(window *virtv1.WorkloadUpdateMaintenanceWindow, vmi *virtv1.VirtualMachineInstance) bool {
+	if len(window.Namespaces) > 0 {
+		found := false
+		for _, namespace := range window.Namespaces {
+			if namespace == vmi.Namespace {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+	if window.Selector != nil {
+		selector, err := metav1.LabelSelectorAsSelector(window.Selector)
+		if err != nil {
+			log.Log.Reason(err).Warningf("Ignoring maintenance window %s with an invalid selector", window.Name)
+			return false
+		}
+		if !selector.Matches(labels.Set(vmi.Labels)) {
+			return false
+		}
+	}
+	return true
+}
+
+// nextMaintenanceWindowOpening returns t if the window is open at t, the time it opens next otherwise,
+// or nil if it does not open again
+func nextMaintenanceWindowOpening(window *virtv1.WorkloadUpdateMaintenanceWindow, t time.Time) *time.Time {
+	var next *time.Time
+	earliest := func(candidate time.Time) {
+		if next == nil || candidate.Before(*next) {
+			next = &candidate
+		}
+	}
+
+	for _, timeRange := range window.TimeRanges {
+		switch {
+		case !t.Before(timeRange.Start.Time) && t.Before(timeRange.End.Time):
+			earliest(t)
+		case t.Before(timeRange.Start.Time) && timeRange.Start.Before(&timeRange.End):
+			earliest(timeRange.Start.Time)
+		}
+	}
+
+	if window.Schedule != "" {
+		schedule, err := cron.ParseStandard(window.Schedule)
+		if err != nil {
+			log.Log.Reason(err).Warningf("Ignoring the schedule of maintenance window %s", window.Name)
+			return next
+		}
+		duration := defaultMaintenanceWindowDuration
+		if window.Duration != nil {
+			duration = window.Duration.Duration
+		}
+		// the window is open if it opened less than its duration ago. Schedules are in UTC, while
+		// the schedule is evaluated in the location of the time it is given.
+		if opening := schedule.Next(t.UTC().Add(-duration)); !opening.IsZero() {
+			if opening.After(t) {
+				earliest(opening)
+			} else {
+				earliest(t)
+			}
+		}
+	}
+	return next
+}
+
+// nextWorkloadUpdateTime returns t if one of the maintenance windows selecting the vmi is open at t or if none
+// selects it, the time one of them opens next otherwise, or nil if none of them opens again
+func nextWorkloadUpdateTime(windows []virtv1.WorkloadUpdateMaintenanceWindow, vmi *virtv1.VirtualMachineInstance, t time.Time) *time.Time {
+	selected := false
+	var next *time.Time
+	for i := range windows {
+		if !maintenanceWindowSelects(&windows[i], vmi) {
+			continue
+		}
+		selected = true
+		if opening := nextMaintenanceWindowOpening(&windows[i], t); opening != nil && (next == nil || opening.Before(*next)) {
+			next = opening
+		}
+	}
+	if !selected {
+		return &t
+	}
+	return next
+}
+
+// heldWorkloadUpdate returns why and until when the automated workload update of the outdated vmi is
+// held back, or nil if the vmi can be updated now
+func heldWorkloadUpdate(kv *virtv1.KubeVirt, vmi *virtv1.VirtualMachineInstance, now time.Time) *virtv1.HeldWorkloadUpdate {
+	held := &virtv1.HeldWorkloadUpdate{
+		Namespace: vmi.Namespace,
+		Name:      vmi.Name,
+	}
+	if vmi.Annotations[virtv1.WorkloadUpdateOptOutAnnotation] == "true" {
+		held.Reason = virtv1.WorkloadUpdateOptedOut
+		return held
+	}
+
+	from := now
+	deferred := false
+	if value, exists := vmi.Annotations[virtv1.WorkloadUpdateDeferUntilAnnotation]; exists {
+		deferUntil, err := time.Parse(time.RFC3339, value)
+		if err != nil {
+			log.Log.Object(vmi).Reason(err).Warningf("Ignoring invalid annotation %s", virtv1.WorkloadUpdateDeferUntilAnnotation)
+		} else if deferUntil.After(now) {
+			from = deferUntil
+			deferred = true
+		}
+	}
+
+	next := nextWorkloadUpdateTime(kv.Spec.WorkloadUpdateStrategy.MaintenanceWindows, vmi, from)
+	switch {
+	case next == nil:
+		held.Reason = virtv1.WorkloadUpdateOutsideMaintenanceWindow
+		return held
+	case !next.After(now):
+		return nil
+	case deferred && next.Equal(from):
+		held.Reason = virtv1.WorkloadUpdateDeferred
+	default:
+		held.Reason = virtv1.WorkloadUpdateOutsideMaintenanceWindow
+	}
+	scheduledTime := metav1.NewTime(*next)
+	held.ScheduledTime = &scheduledTime
+	return held
+}
+
+// reportedHeldWorkloadUpdates returns the held back updates in a stable order, limited to the ones reported on the KubeVirt CR
+func reportedHeldWorkloadUpdates(heldUpdates []virtv1.HeldWorkloadUpdate) []virtv1.HeldWorkloadUpdate {
+	sort.Slice(heldUpdates, func(i, j int) bool {
+		if heldUpdates[i].Namespace != heldUpdates[j].Namespace {
+			return heldUpdates[i].Namespace < heldUpdates[j].Namespace
+		}
+		return heldUpdates[i].Name < heldUpdates[j].Name
+	})
+	if len(heldUpdates) > maxHeldWorkloadUpdates {
+		heldUpdates = heldUpdates[:maxHeldWorkloadUpdates]
+	}
+	return heldUpdates
+}
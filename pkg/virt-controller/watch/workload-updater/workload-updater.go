@@ -12,6 +12,7 @@ import (
 
 	k8sv1 "k8s.io/api/core/v1"
 	policy "k8s.io/api/policy/v1beta1"
+	"k8s.io/apimachinery/pkg/api/equality"
 	"k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
@@ -81,6 +82,7 @@ type updateData struct {
 	migratableOutdatedVMIs []*virtv1.VirtualMachineInstance
 	evictOutdatedVMIs      []*virtv1.VirtualMachineInstance
 	abortChangeVMIs        []*virtv1.VirtualMachineInstance
+	heldUpdates            []virtv1.HeldWorkloadUpdate
 
 	numActiveMigrations int
 }
@@ -361,7 +363,7 @@ func (c *WorkloadUpdateController) shouldAbortMigration(vmi *virtv1.VirtualMachi
 	return numMig > 0
 }
 
-func (c *WorkloadUpdateController) getUpdateData(kv *virtv1.KubeVirt) *updateData {
+func (c *WorkloadUpdateController) getUpdateData(kv *virtv1.KubeVirt, now time.Time) *updateData {
 	data := &updateData{}
 
 	lookup := make(map[string]bool)
@@ -411,6 +413,13 @@ func (c *WorkloadUpdateController) getUpdateData(kv *virtv1.KubeVirt) *updateDat
 		} else if exists := lookup[vmi.Namespace+"/"+vmi.Name]; exists {
 			continue
 		}
+		// maintenance windows and opt-outs only hold back the update of the launcher, not changes requested for the VMI
+		if (automatedMigrationAllowed || automatedShutdownAllowed) && c.isOutdated(vmi) && !c.doesRequireMigration(vmi) {
+			if held := heldWorkloadUpdate(kv, vmi, now); held != nil {
+				data.heldUpdates = append(data.heldUpdates, *held)
+				continue
+			}
+		}
 		volMig := false
 		errValid := volumemig.ValidateVolumesUpdateMigration(vmi, nil, vmi.Status.MigratedVolumes)
 		if len(vmi.Status.MigratedVolumes) > 0 && errValid == nil {
@@ -457,7 +466,8 @@ func (c *WorkloadUpdateController) execute(key string) error {
 
 func (c *WorkloadUpdateController) sync(kv *virtv1.KubeVirt) error {
 
-	data := c.getUpdateData(kv)
+	now := time.Now()
+	data := c.getUpdateData(kv, now)
 
 	key, err := controller.KeyFunc(kv)
 	if err != nil {
@@ -466,12 +476,12 @@ func (c *WorkloadUpdateController) sync(kv *virtv1.KubeVirt) error {
 
 	metrics.SetOutdatedVirtualMachineInstanceWorkloads(len(data.allOutdatedVMIs))
 
+	patchSet := patch.New()
 	// update outdated workload count on kv
 	if kv.Status.OutdatedVirtualMachineInstanceWorkloads == nil || *kv.Status.OutdatedVirtualMachineInstanceWorkloads != len(data.allOutdatedVMIs) {
 		l := len(data.allOutdatedVMIs)
 		kvCopy := kv.DeepCopy()
 		kvCopy.Status.OutdatedVirtualMachineInstanceWorkloads = &l
-		patchSet := patch.New()
 		if kv.Status.OutdatedVirtualMachineInstanceWorkloads == nil {
 			patchSet.AddOption(patch.WithAdd("/status/outdatedVirtualMachineInstanceWorkloads", kvCopy.Status.OutdatedVirtualMachineInstanceWorkloads))
 		} else {
@@ -480,15 +490,46 @@ func (c *WorkloadUpdateController) sync(kv *virtv1.KubeVirt) error {
 				patch.WithReplace("/status/outdatedVirtualMachineInstanceWorkloads", kvCopy.Status.OutdatedVirtualMachineInstanceWorkloads),
 			)
 		}
+	}
+	// update the report of held back updates on kv
+	heldUpdates := reportedHeldWorkloadUpdates(data.heldUpdates)
+	if !equality.Semantic.DeepEqual(kv.Status.HeldWorkloadUpdates, heldUpdates) {
+		switch {
+		case len(kv.Status.HeldWorkloadUpdates) == 0:
+			patchSet.AddOption(patch.WithAdd("/status/heldWorkloadUpdates", heldUpdates))
+		case len(heldUpdates) == 0:
+			patchSet.AddOption(
+				patch.WithTest("/status/heldWorkloadUpdates", kv.Status.HeldWorkloadUpdates),
+				patch.WithRemove("/status/heldWorkloadUpdates"),
+			)
+		default:
+			patchSet.AddOption(
+				patch.WithTest("/status/heldWorkloadUpdates", kv.Status.HeldWorkloadUpdates),
+				patch.WithReplace("/status/heldWorkloadUpdates", heldUpdates),
+			)
+		}
+	}
+	if !patchSet.IsEmpty() {
 		patchBytes, err := patchSet.GeneratePayload()
 		if err != nil {
 			return err
 		}
 		_, err = c.clientset.KubeVirt(kv.Namespace).PatchStatus(context.Background(), kv.Name, types.JSONPatchType, patchBytes, metav1.PatchOptions{})
 		if err != nil {
-			return fmt.Errorf("unable to patch kubevirt obj status to update the outdatedVirtualMachineInstanceWorkloads valued: %v", err)
+			return fmt.Errorf("unable to patch kubevirt obj status to update the outdated workloads: %v", err)
+		}
+	}
+
+	// Held back updates are picked up again once the first of them is scheduled
+	var nextScheduledUpdate *metav1.Time
+	for _, held := range data.heldUpdates {
+		if held.ScheduledTime != nil && (nextScheduledUpdate == nil || held.ScheduledTime.Before(nextScheduledUpdate)) {
+			nextScheduledUpdate = held.ScheduledTime
 		}
 	}
+	if nextScheduledUpdate != nil {
+		c.queue.AddAfter(key, nextScheduledUpdate.Sub(now))
+	}
 
 	// Rather than enqueing based on VMI activity, we keep periodically poping the loop
 	// until all VMIs are updated. Watching all VMI activity is chatty for this controller
@@ -514,8 +555,6 @@ func (c *WorkloadUpdateController) sync(kv *virtv1.KubeVirt) error {
 		batchDeletionInterval = kv.Spec.WorkloadUpdateStrategy.BatchEvictionInterval.Duration
 	}
 
-	now := time.Now()
-
 	nextBatch := c.lastDeletionBatch.Add(batchDeletionInterval)
 	if now.After(nextBatch) && len(data.evictOutdatedVMIs) > 0 {
 		batchDeletionCount = int(math.Min(float64(batchDeletionCount), float64(len(data.evictOutdatedVMIs))))
@@ -494,6 +494,152 @@ var _ = Describe("Workload Updater", func() {
 
 	})
 
+	Context("maintenance windows", func() {
+		var kv *v1.KubeVirt
+
+		BeforeEach(func() {
+			kv = newKubeVirt(1)
+			kv.Spec.WorkloadUpdateStrategy.WorkloadUpdateMethods = []v1.WorkloadUpdateMethod{v1.WorkloadUpdateMethodLiveMigrate}
+		})
+
+		addOutdatedVMI := func(annotations map[string]string) {
+			vmi := newVirtualMachineInstance("testvm", true, "madeup")
+			vmi.Annotations = annotations
+			controller.vmiStore.Add(vmi)
+			controller.podIndexer.Add(newLauncherPodForVMI(vmi))
+			waitForNumberOfInstancesOnVMIInformerCache(controller, 1)
+		}
+
+		syncKubeVirt := func() *v1.KubeVirt {
+			addKubeVirt(kv)
+			_, err := fakeVirtClient.KubevirtV1().KubeVirts(k8sv1.NamespaceDefault).Create(context.Background(), kv, metav1.CreateOptions{})
+			Expect(err).ToNot(HaveOccurred())
+			sanityExecute()
+			updatedKV, err := fakeVirtClient.KubevirtV1().KubeVirts(k8sv1.NamespaceDefault).Get(context.Background(), kv.Name, metav1.GetOptions{})
+			Expect(err).ToNot(HaveOccurred())
+			return updatedKV
+		}
+
+		expectNoMigration := func() {
+			migrations, err := fakeVirtClient.KubevirtV1().VirtualMachineInstanceMigrations(k8sv1.NamespaceDefault).List(context.Background(), metav1.ListOptions{})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(migrations.Items).To(BeEmpty())
+		}
+
+		It("should hold back the update until the maintenance window opens", func() {
+			start := metav1.NewTime(time.Now().Add(2 * time.Hour).Truncate(time.Second))
+			kv.Spec.WorkloadUpdateStrategy.MaintenanceWindows = []v1.WorkloadUpdateMaintenanceWindow{{
+				Name:       "later",
+				TimeRanges: []v1.WorkloadUpdateTimeRange{{Start: start, End: metav1.NewTime(start.Add(time.Hour))}},
+			}}
+			addOutdatedVMI(nil)
+
+			updatedKV := syncKubeVirt()
+			expectNoMigration()
+			Expect(updatedKV.Status.HeldWorkloadUpdates).To(ConsistOf(v1.HeldWorkloadUpdate{
+				Namespace:     k8sv1.NamespaceDefault,
+				Name:          "testvm",
+				Reason:        v1.WorkloadUpdateOutsideMaintenanceWindow,
+				ScheduledTime: &start,
+			}))
+		})
+
+		It("should update while the maintenance window is open", func() {
+			kv.Spec.WorkloadUpdateStrategy.MaintenanceWindows = []v1.WorkloadUpdateMaintenanceWindow{{
+				Name: "now",
+				TimeRanges: []v1.WorkloadUpdateTimeRange{{
+					Start: metav1.NewTime(time.Now().Add(-time.Hour)),
+					End:   metav1.NewTime(time.Now().Add(time.Hour)),
+				}},
+			}}
+			addOutdatedVMI(nil)
+
+			updatedKV := syncKubeVirt()
+			testutils.ExpectEvent(recorder, SuccessfulCreateVirtualMachineInstanceMigrationReason)
+			Expect(updatedKV.Status.HeldWorkloadUpdates).To(BeEmpty())
+		})
+
+		It("should update VMIs which are not selected by a maintenance window at any time", func() {
+			kv.Spec.WorkloadUpdateStrategy.MaintenanceWindows = []v1.WorkloadUpdateMaintenanceWindow{{
+				Name:       "other-namespace",
+				Namespaces: []string{"other"},
+				Schedule:   "0 0 1 1 *",
+			}, {
+				Name:     "other-tier",
+				Selector: &metav1.LabelSelector{MatchLabels: map[string]string{"tier": "database"}},
+				Schedule: "0 0 1 1 *",
+			}}
+			addOutdatedVMI(nil)
+
+			syncKubeVirt()
+			testutils.ExpectEvent(recorder, SuccessfulCreateVirtualMachineInstanceMigrationReason)
+		})
+
+		It("should not update VMIs which opted out", func() {
+			addOutdatedVMI(map[string]string{v1.WorkloadUpdateOptOutAnnotation: "true"})
+
+			updatedKV := syncKubeVirt()
+			expectNoMigration()
+			Expect(updatedKV.Status.HeldWorkloadUpdates).To(ConsistOf(v1.HeldWorkloadUpdate{
+				Namespace: k8sv1.NamespaceDefault,
+				Name:      "testvm",
+				Reason:    v1.WorkloadUpdateOptedOut,
+			}))
+		})
+
+		It("should defer the update of a VMI", func() {
+			deferUntil := metav1.NewTime(time.Now().Add(time.Hour).Truncate(time.Second))
+			addOutdatedVMI(map[string]string{v1.WorkloadUpdateDeferUntilAnnotation: deferUntil.Format(time.RFC3339)})
+
+			updatedKV := syncKubeVirt()
+			expectNoMigration()
+			Expect(updatedKV.Status.HeldWorkloadUpdates).To(ConsistOf(v1.HeldWorkloadUpdate{
+				Namespace:     k8sv1.NamespaceDefault,
+				Name:          "testvm",
+				Reason:        v1.WorkloadUpdateDeferred,
+				ScheduledTime: &deferUntil,
+			}))
+		})
+
+		It("should remove the report once the update is no longer held back", func() {
+			kv.Status.HeldWorkloadUpdates = []v1.HeldWorkloadUpdate{{
+				Namespace: k8sv1.NamespaceDefault,
+				Name:      "testvm",
+				Reason:    v1.WorkloadUpdateOptedOut,
+			}}
+			addOutdatedVMI(nil)
+
+			updatedKV := syncKubeVirt()
+			testutils.ExpectEvent(recorder, SuccessfulCreateVirtualMachineInstanceMigrationReason)
+			Expect(updatedKV.Status.HeldWorkloadUpdates).To(BeEmpty())
+		})
+
+		DescribeTable("should find the next opening of a scheduled window", func(offset time.Duration, expectedOpening time.Time) {
+			window := &v1.WorkloadUpdateMaintenanceWindow{
+				Name:     "nightly",
+				Schedule: "0 22 * * *",
+				Duration: &metav1.Duration{Duration: 4 * time.Hour},
+			}
+			t := time.Date(2024, 6, 1, 0, 0, 0, 0, time.UTC).Add(offset)
+			Expect(nextMaintenanceWindowOpening(window, t)).To(HaveValue(BeTemporally("==", expectedOpening)))
+		},
+			Entry("before the window", 12*time.Hour, time.Date(2024, 6, 1, 22, 0, 0, 0, time.UTC)),
+			Entry("in the window", 23*time.Hour, time.Date(2024, 6, 1, 23, 0, 0, 0, time.UTC)),
+			Entry("in the window after midnight", 25*time.Hour, time.Date(2024, 6, 2, 1, 0, 0, 0, time.UTC)),
+			Entry("after the window", 26*time.Hour, time.Date(2024, 6, 2, 22, 0, 0, 0, time.UTC)),
+		)
+
+		It("should evaluate the schedule of a window in UTC", func() {
+			window := &v1.WorkloadUpdateMaintenanceWindow{
+				Name:     "nightly",
+				Schedule: "0 22 * * *",
+				Duration: &metav1.Duration{Duration: 4 * time.Hour},
+			}
+			t := time.Date(2024, 6, 1, 12, 0, 0, 0, time.UTC).In(time.FixedZone("UTC+2", 2*60*60))
+			Expect(nextMaintenanceWindowOpening(window, t)).To(HaveValue(BeTemporally("==", time.Date(2024, 6, 1, 22, 0, 0, 0, time.UTC))))
+		})
+	})
+
 	Context("LiveUpdate features", func() {
 		It("VMI needs to be migrated when memory hotplug is requested", func() {
 			condition := v1.VirtualMachineInstanceCondition{
@@ -77,6 +77,16 @@ type MigrationOptions struct {
 	AllowPostCopy            bool
 	ParallelMigrationThreads *uint
 	AllowWorkloadDisruption  bool
+	Compression              v1.MigrationCompression
+	XBZRLECacheSize          resource.Quantity
+	MaxDowntimeMilliseconds  uint64
+}
+
+type BackupOptions struct {
+	TargetDir       string
+	BackupName      string
+	IncrementalFrom string
+	Format          v1.BackupFormat
 }
 
 type LauncherClient interface {
@@ -100,7 +110,7 @@ type LauncherClient interface {
 	GetGuestInfo() (*v1.VirtualMachineInstanceGuestAgentInfo, error)
 	GetUsers() (v1.VirtualMachineInstanceGuestOSUserList, error)
 	GetFilesystems() (v1.VirtualMachineInstanceFileSystemList, error)
-	Exec(string, string, []string, int32) (int, string, error)
+	Exec(string, string, []string, int32) (int, string, string, error)
 	Ping() error
 	GuestPing(string, int32) error
 	Close()
@@ -111,6 +121,8 @@ type LauncherClient interface {
 	GetLaunchMeasurement(*v1.VirtualMachineInstance) (*v1.SEVMeasurementInfo, error)
 	InjectLaunchSecret(*v1.VirtualMachineInstance, *v1.SEVSecretOptions) error
 	SyncVirtualMachineMemory(vmi *v1.VirtualMachineInstance, options *cmdv1.VirtualMachineOptions) error
+	BackupVirtualMachine(vmi *v1.VirtualMachineInstance, options *BackupOptions) error
+	AbortVirtualMachineBackup(vmi *v1.VirtualMachineInstance) error
 }
 
 type VirtLauncherClient struct {
@@ -429,6 +441,35 @@ func (c *VirtLauncherClient) VirtualMachineMemoryDump(vmi *v1.VirtualMachineInst
 	return err
 }
 
+func (c *VirtLauncherClient) BackupVirtualMachine(vmi *v1.VirtualMachineInstance, options *BackupOptions) error {
+	vmiJson, err := json.Marshal(vmi)
+	if err != nil {
+		return err
+	}
+
+	optionsJson, err := json.Marshal(options)
+	if err != nil {
+		return err
+	}
+
+	request := &cmdv1.BackupRequest{
+		Vmi: &cmdv1.VMI{
+			VmiJson: vmiJson,
+		},
+		Options: optionsJson,
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), longTimeout)
+	defer cancel()
+	response, err := c.v1client.BackupVirtualMachine(ctx, request)
+	err = handleError(err, "Backup", response)
+	return err
+}
+
+func (c *VirtLauncherClient) AbortVirtualMachineBackup(vmi *v1.VirtualMachineInstance) error {
+	return c.genericSendVMICmd("AbortBackup", c.v1client.AbortVirtualMachineBackup, vmi, &cmdv1.VirtualMachineOptions{})
+}
+
 func (c *VirtLauncherClient) SoftRebootVirtualMachine(vmi *v1.VirtualMachineInstance) error {
 	return c.genericSendVMICmd("SoftReboot", c.v1client.SoftRebootVirtualMachine, vmi, &cmdv1.VirtualMachineOptions{})
 }
@@ -677,8 +718,8 @@ func (c *VirtLauncherClient) GetFilesystems() (v1.VirtualMachineInstanceFileSyst
 	return filesystemList, nil
 }
 
-// Exec the command with args on the guest and return the resulting status code, stdOut and error
-func (c *VirtLauncherClient) Exec(domainName, command string, args []string, timeoutSeconds int32) (int, string, error) {
+// Exec the command with args on the guest and return the resulting status code, stdOut, stdErr and error
+func (c *VirtLauncherClient) Exec(domainName, command string, args []string, timeoutSeconds int32) (int, string, string, error) {
 	request := &cmdv1.ExecRequest{
 		DomainName:     domainName,
 		Command:        command,
@@ -687,6 +728,7 @@ func (c *VirtLauncherClient) Exec(domainName, command string, args []string, tim
 	}
 	exitCode := -1
 	stdOut := ""
+	stdErr := ""
 
 	ctx, cancel := context.WithTimeout(
 		context.Background(),
@@ -698,13 +740,14 @@ func (c *VirtLauncherClient) Exec(domainName, command string, args []string, tim
 
 	resp, err := c.v1client.Exec(ctx, request)
 	if resp == nil {
-		return exitCode, stdOut, err
+		return exitCode, stdOut, stdErr, err
 	}
 
 	exitCode = int(resp.ExitCode)
 	stdOut = resp.StdOut
+	stdErr = resp.StdErr
 
-	return exitCode, stdOut, err
+	return exitCode, stdOut, stdErr, err
 }
 
 func (c *VirtLauncherClient) GuestPing(domainName string, timeoutSeconds int32) error {
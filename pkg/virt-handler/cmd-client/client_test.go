@@ -147,6 +147,7 @@ var _ = Describe("Virt remote commands", func() {
 				testArgs                 = []string{"-v", "2"}
 				testClientErr            = errors.New("client error")
 				testStdOut               = "stdOut"
+				testStdErr               = "stdErr"
 				testTimeoutSeconds int32 = 10
 
 				expectExec = func() *gomock.Call {
@@ -180,17 +181,19 @@ var _ = Describe("Virt remote commands", func() {
 			})
 			It("returns client errors", func() {
 				expectExec().Times(1).Return(&cmdv1.ExecResponse{}, testClientErr)
-				_, _, err := client.Exec(testDomainName, testCommand, testArgs, testTimeoutSeconds)
+				_, _, _, err := client.Exec(testDomainName, testCommand, testArgs, testTimeoutSeconds)
 				Expect(err).To(HaveOccurred())
 			})
-			It("returns exitCode and stdOut if possible", func() {
+			It("returns exitCode, stdOut and stdErr if possible", func() {
 				expectExec().Times(1).Return(&cmdv1.ExecResponse{
 					ExitCode: 1,
 					StdOut:   testStdOut,
+					StdErr:   testStdErr,
 				}, nil)
-				exitCode, stdOut, _ := client.Exec(testDomainName, testCommand, testArgs, testTimeoutSeconds)
+				exitCode, stdOut, stdErr, _ := client.Exec(testDomainName, testCommand, testArgs, testTimeoutSeconds)
 				Expect(exitCode).To(Equal(1))
 				Expect(stdOut).To(Equal(testStdOut))
+				Expect(stdErr).To(Equal(testStdErr))
 			})
 			It("provide ctx with a shortTimeout", func() {
 				expectExec().Times(1).Do(func(ctx context.Context, _ *cmdv1.ExecRequest, _ ...grpc.CallOption) (*cmdv1.ExecResponse, error) {
@@ -240,12 +240,13 @@ func (_mr *_MockLauncherClientRecorder) GetFilesystems() *gomock.Call {
 	return _mr.mock.ctrl.RecordCall(_mr.mock, "GetFilesystems")
 }
 
-func (_m *MockLauncherClient) Exec(_param0 string, _param1 string, _param2 []string, _param3 int32) (int, string, error) {
+func (_m *MockLauncherClient) Exec(_param0 string, _param1 string, _param2 []string, _param3 int32) (int, string, string, error) {
 	ret := _m.ctrl.Call(_m, "Exec", _param0, _param1, _param2, _param3)
 	ret0, _ := ret[0].(int)
 	ret1, _ := ret[1].(string)
-	ret2, _ := ret[2].(error)
-	return ret0, ret1, ret2
+	ret2, _ := ret[2].(string)
+	ret3, _ := ret[3].(error)
+	return ret0, ret1, ret2, ret3
 }
 
 func (_mr *_MockLauncherClientRecorder) Exec(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
@@ -352,3 +353,23 @@ func (_m *MockLauncherClient) SyncVirtualMachineMemory(vmi *v1.VirtualMachineIns
 func (_mr *_MockLauncherClientRecorder) SyncVirtualMachineMemory(arg0, arg1 interface{}) *gomock.Call {
 	return _mr.mock.ctrl.RecordCall(_mr.mock, "SyncVirtualMachineMemory", arg0, arg1)
 }
+
+func (_m *MockLauncherClient) BackupVirtualMachine(vmi *v1.VirtualMachineInstance, options *BackupOptions) error {
+	ret := _m.ctrl.Call(_m, "BackupVirtualMachine", vmi, options)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+func (_mr *_MockLauncherClientRecorder) BackupVirtualMachine(arg0, arg1 interface{}) *gomock.Call {
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "BackupVirtualMachine", arg0, arg1)
+}
+
+func (_m *MockLauncherClient) AbortVirtualMachineBackup(vmi *v1.VirtualMachineInstance) error {
+	ret := _m.ctrl.Call(_m, "AbortVirtualMachineBackup", vmi)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+func (_mr *_MockLauncherClientRecorder) AbortVirtualMachineBackup(arg0 interface{}) *gomock.Call {
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "AbortVirtualMachineBackup", arg0)
+}
@@ -0,0 +1,11 @@
+package guestload_test
+
+import (
+	"testing"
+
+	"kubevirt.io/client-go/testutils"
+)
+
+func TestGuestLoad(t *testing.T) {
+	testutils.KubeVirtTestSuiteSetup(t)
+}
@@ -0,0 +1,228 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+// Package guestload reports the load of the guests of VirtualMachinePools in
+// the status of their VMIs, for the pools to autoscale on.
+package guestload
+
+import (
+	"context"
+	"math"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/client-go/tools/cache"
+
+	v1 "kubevirt.io/api/core/v1"
+	"kubevirt.io/client-go/kubecli"
+	"kubevirt.io/client-go/log"
+
+	"kubevirt.io/kubevirt/pkg/apimachinery/patch"
+	"kubevirt.io/kubevirt/pkg/monitoring/metrics/virt-handler/collector"
+	"kubevirt.io/kubevirt/pkg/monitoring/metrics/virt-handler/domainstats"
+	"kubevirt.io/kubevirt/pkg/pointer"
+	virtconfig "kubevirt.io/kubevirt/pkg/virt-config"
+	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/stats"
+)
+
+const (
+	// ReportInterval is how often the guest load is sampled
+	ReportInterval = 30 * time.Second
+
+	// reportThreshold is the change of a utilization, in percentage points,
+	// which is reported right away
+	reportThreshold = 5
+
+	// refreshInterval is the age after which an unchanged guest load is
+	// reported again, so that it is not mistaken for a stale one
+	refreshInterval = 2 * time.Minute
+)
+
+type vmiStats struct {
+	vmi         *v1.VirtualMachineInstance
+	domainStats *stats.DomainStats
+}
+
+type cpuSample struct {
+	vcpuTime  uint64
+	timestamp time.Time
+}
+
+type LoadReporter struct {
+	clientset     kubecli.KubevirtClient
+	vmiStore      cache.Store
+	clusterConfig *virtconfig.ClusterConfig
+	collect       func(vmis []*v1.VirtualMachineInstance) []vmiStats
+	cpuSamples    map[types.UID]cpuSample
+}
+
+func NewLoadReporter(clientset kubecli.KubevirtClient, vmiStore cache.Store, clusterConfig *virtconfig.ClusterConfig) *LoadReporter {
+	return &LoadReporter{
+		clientset:     clientset,
+		vmiStore:      vmiStore,
+		clusterConfig: clusterConfig,
+		collect:       collectDomainStats(collector.NewConcurrentCollector(1)),
+		cpuSamples:    map[types.UID]cpuSample{},
+	}
+}
+
+// collectDomainStats scrapes the domain stats of the VMIs like the domainstats
+// metrics collector does
+func collectDomainStats(concCollector collector.Collector) func(vmis []*v1.VirtualMachineInstance) []vmiStats {
+	return func(vmis []*v1.VirtualMachineInstance) []vmiStats {
+		scraper := domainstats.NewDomainstatsScraper(len(vmis))
+		concCollector.Collect(vmis, scraper, collector.CollectionTimeout)
+
+		var collected []vmiStats
+		for _, vmiReport := range scraper.GetValues() {
+			collected = append(collected, vmiStats{
+				vmi:         vmiReport.GetVmi(),
+				domainStats: vmiReport.GetVmiStats().DomainStats,
+			})
+		}
+		return collected
+	}
+}
+
+func (r *LoadReporter) Run(stopCh <-chan struct{}) {
+	wait.Until(r.report, ReportInterval, stopCh)
+}
+
+func isPoolVMI(vmi *v1.VirtualMachineInstance) bool {
+	_, exists := vmi.Labels[v1.VirtualMachinePoolRevisionName]
+	return exists
+}
+
+func (r *LoadReporter) report() {
+	if !r.clusterConfig.VMPoolAutoscalingEnabled() {
+		clear(r.cpuSamples)
+		return
+	}
+
+	var vmis []*v1.VirtualMachineInstance
+	for _, obj := range r.vmiStore.List() {
+		vmi := obj.(*v1.VirtualMachineInstance)
+		if vmi.IsRunning() && isPoolVMI(vmi) {
+			vmis = append(vmis, vmi)
+		}
+	}
+
+	sampled := map[types.UID]struct{}{}
+	if len(vmis) > 0 {
+		now := time.Now()
+		for _, collected := range r.collect(vmis) {
+			sampled[collected.vmi.UID] = struct{}{}
+			load := r.sampleGuestLoad(collected.vmi.UID, collected.domainStats, now)
+			if !needsReport(collected.vmi.Status.GuestLoad, load) {
+				continue
+			}
+			if err := r.updateGuestLoad(collected.vmi, load); err != nil {
+				log.Log.Object(collected.vmi).Reason(err).Warning("Failed to report the guest load")
+			}
+		}
+	}
+
+	for uid := range r.cpuSamples {
+		if _, exists := sampled[uid]; !exists {
+			delete(r.cpuSamples, uid)
+		}
+	}
+}
+
+func utilizationPercentage(used, available float64) int32 {
+	return int32(math.Round(math.Min(math.Max(used/available, 0), 1) * 100))
+}
+
+// sampleGuestLoad calculates the guest load from the domain stats. The vCPU
+// utilization needs the vCPU time of the previous sample of the VMI.
+func (r *LoadReporter) sampleGuestLoad(uid types.UID, domainStats *stats.DomainStats, now time.Time) *v1.GuestLoadStatus {
+	load := &v1.GuestLoadStatus{LastProbeTime: metav1.NewTime(now)}
+	if domainStats == nil {
+		return load
+	}
+
+	if len(domainStats.Vcpu) > 0 {
+		vcpuTime := uint64(0)
+		for _, vcpu := range domainStats.Vcpu {
+			vcpuTime += vcpu.Time
+		}
+
+		previous, exists := r.cpuSamples[uid]
+		if exists && vcpuTime >= previous.vcpuTime && now.After(previous.timestamp) {
+			available := float64(now.Sub(previous.timestamp).Nanoseconds()) * float64(len(domainStats.Vcpu))
+			load.CPUUtilizationPercentage = pointer.P(utilizationPercentage(float64(vcpuTime-previous.vcpuTime), available))
+		}
+		r.cpuSamples[uid] = cpuSample{vcpuTime: vcpuTime, timestamp: now}
+	}
+
+	if mem := domainStats.Memory; mem != nil && mem.AvailableSet && mem.UsableSet && mem.Available > 0 {
+		used := mem.Available - min(mem.Usable, mem.Available)
+		load.MemoryUtilizationPercentage = pointer.P(utilizationPercentage(float64(used), float64(mem.Available)))
+	}
+
+	return load
+}
+
+func utilizationChanged(reported, sampled *int32) bool {
+	if sampled == nil {
+		return false
+	}
+	if reported == nil {
+		return true
+	}
+	return math.Abs(float64(*sampled-*reported)) >= reportThreshold
+}
+
+// needsReport decides whether the sampled guest load is worth an update of
+// the VMI. Small changes are only reported once the reported load gets old.
+func needsReport(reported, sampled *v1.GuestLoadStatus) bool {
+	if sampled.CPUUtilizationPercentage == nil && sampled.MemoryUtilizationPercentage == nil {
+		return false
+	}
+	if reported == nil || sampled.LastProbeTime.Sub(reported.LastProbeTime.Time) >= refreshInterval {
+		return true
+	}
+	return utilizationChanged(reported.CPUUtilizationPercentage, sampled.CPUUtilizationPercentage) ||
+		utilizationChanged(reported.MemoryUtilizationPercentage, sampled.MemoryUtilizationPercentage)
+}
+
+// updateGuestLoad patches the guest load in the status of the VMI, without
+// touching the rest of the VMI the sync of virt-handler is responsible for. A
+// guest load changed since the VMI was cached fails the patch and is resolved
+// by the next report.
+func (r *LoadReporter) updateGuestLoad(vmi *v1.VirtualMachineInstance, load *v1.GuestLoadStatus) error {
+	patchSet := patch.New()
+	if vmi.Status.GuestLoad == nil {
+		patchSet.AddOption(patch.WithAdd("/status/guestLoad", load))
+	} else {
+		patchSet.AddOption(
+			patch.WithTest("/status/guestLoad", vmi.Status.GuestLoad),
+			patch.WithReplace("/status/guestLoad", load),
+		)
+	}
+
+	payload, err := patchSet.GeneratePayload()
+	if err != nil {
+		return err
+	}
+	_, err = r.clientset.VirtualMachineInstance(vmi.Namespace).Patch(context.Background(), vmi.Name, types.JSONPatchType, payload, metav1.PatchOptions{})
+	return err
+}
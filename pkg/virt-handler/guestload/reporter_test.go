@@ -0,0 +1,186 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package guestload
+
+import (
+	"context"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/cache"
+
+	v1 "kubevirt.io/api/core/v1"
+	"kubevirt.io/client-go/api"
+	"kubevirt.io/client-go/kubecli"
+	kubevirtfake "kubevirt.io/client-go/kubevirt/fake"
+	"kubevirt.io/client-go/testing"
+
+	"kubevirt.io/kubevirt/pkg/pointer"
+	"kubevirt.io/kubevirt/pkg/testutils"
+	"kubevirt.io/kubevirt/pkg/virt-config/featuregate"
+	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/stats"
+)
+
+var _ = Describe("Guest load reporter", func() {
+	var reporter *LoadReporter
+	var virtClient *kubecli.MockKubevirtClient
+	var fakeVirtClient *kubevirtfake.Clientset
+	var vmiStore cache.Store
+	var domainStats map[string]*stats.DomainStats
+
+	newPoolVMI := func(name string) *v1.VirtualMachineInstance {
+		vmi := api.NewMinimalVMI(name)
+		vmi.UID = "uid-" + vmi.UID
+		vmi.Labels = map[string]string{v1.VirtualMachinePoolRevisionName: "pool-1"}
+		vmi.Status.Phase = v1.Running
+		return vmi
+	}
+
+	newReporter := func(featureGates ...string) {
+		clusterConfig, _, _ := testutils.NewFakeClusterConfigUsingKVConfig(&v1.KubeVirtConfiguration{
+			DeveloperConfiguration: &v1.DeveloperConfiguration{FeatureGates: featureGates},
+		})
+		reporter = NewLoadReporter(virtClient, vmiStore, clusterConfig)
+		reporter.collect = func(vmis []*v1.VirtualMachineInstance) []vmiStats {
+			var collected []vmiStats
+			for _, vmi := range vmis {
+				collected = append(collected, vmiStats{vmi: vmi, domainStats: domainStats[vmi.Name]})
+			}
+			return collected
+		}
+	}
+
+	reportedGuestLoad := func(name string) *v1.GuestLoadStatus {
+		vmi, err := fakeVirtClient.KubevirtV1().VirtualMachineInstances(metav1.NamespaceDefault).Get(context.Background(), name, metav1.GetOptions{})
+		Expect(err).ToNot(HaveOccurred())
+		return vmi.Status.GuestLoad
+	}
+
+	BeforeEach(func() {
+		virtClient = kubecli.NewMockKubevirtClient(gomock.NewController(GinkgoT()))
+		fakeVirtClient = kubevirtfake.NewSimpleClientset()
+		virtClient.EXPECT().VirtualMachineInstance(metav1.NamespaceDefault).Return(fakeVirtClient.KubevirtV1().VirtualMachineInstances(metav1.NamespaceDefault)).AnyTimes()
+		vmiStore = cache.NewStore(cache.MetaNamespaceKeyFunc)
+		domainStats = map[string]*stats.DomainStats{}
+	})
+
+	It("should report the guest load of VMIs of pools", func() {
+		poolVMI := newPoolVMI("pool-vmi")
+		otherVMI := api.NewMinimalVMI("other-vmi")
+		otherVMI.Status.Phase = v1.Running
+		for _, vmi := range []*v1.VirtualMachineInstance{poolVMI, otherVMI} {
+			Expect(vmiStore.Add(vmi)).To(Succeed())
+			_, err := fakeVirtClient.KubevirtV1().VirtualMachineInstances(vmi.Namespace).Create(context.Background(), vmi, metav1.CreateOptions{})
+			Expect(err).ToNot(HaveOccurred())
+		}
+		domainStats[poolVMI.Name] = &stats.DomainStats{
+			Vcpu: []stats.DomainStatsVcpu{{Time: 0}, {Time: 0}},
+			Memory: &stats.DomainStatsMemory{
+				AvailableSet: true,
+				Available:    1000,
+				UsableSet:    true,
+				Usable:       250,
+			},
+		}
+		newReporter(featuregate.VMPoolAutoscalingGate)
+
+		reporter.report()
+
+		load := reportedGuestLoad(poolVMI.Name)
+		Expect(load).ToNot(BeNil())
+		Expect(load.CPUUtilizationPercentage).To(BeNil())
+		Expect(load.MemoryUtilizationPercentage).To(HaveValue(BeEquivalentTo(75)))
+		Expect(reportedGuestLoad(otherVMI.Name)).To(BeNil())
+	})
+
+	It("should not report the guest load without the feature gate", func() {
+		vmi := newPoolVMI("pool-vmi")
+		Expect(vmiStore.Add(vmi)).To(Succeed())
+		domainStats[vmi.Name] = &stats.DomainStats{
+			Memory: &stats.DomainStatsMemory{AvailableSet: true, Available: 1000, UsableSet: true, Usable: 250},
+		}
+		newReporter()
+
+		reporter.report()
+
+		Expect(testing.FilterActions(&fakeVirtClient.Fake, "patch", "virtualmachineinstances")).To(BeEmpty())
+	})
+
+	It("should only replace the guest load it has seen", func() {
+		vmi := newPoolVMI("pool-vmi")
+		vmi.Status.GuestLoad = &v1.GuestLoadStatus{MemoryUtilizationPercentage: pointer.P(int32(10))}
+		_, err := fakeVirtClient.KubevirtV1().VirtualMachineInstances(vmi.Namespace).Create(context.Background(), vmi, metav1.CreateOptions{})
+		Expect(err).ToNot(HaveOccurred())
+		newReporter(featuregate.VMPoolAutoscalingGate)
+
+		load := &v1.GuestLoadStatus{MemoryUtilizationPercentage: pointer.P(int32(50))}
+		Expect(reporter.updateGuestLoad(vmi, load)).To(Succeed())
+		Expect(reportedGuestLoad(vmi.Name)).To(Equal(load))
+
+		Expect(reporter.updateGuestLoad(vmi, &v1.GuestLoadStatus{MemoryUtilizationPercentage: pointer.P(int32(90))})).ToNot(Succeed())
+		Expect(reportedGuestLoad(vmi.Name)).To(Equal(load))
+	})
+
+	It("should calculate the vCPU utilization since the previous sample", func() {
+		newReporter(featuregate.VMPoolAutoscalingGate)
+		now := time.Now()
+		reporter.cpuSamples["uid"] = cpuSample{vcpuTime: uint64(time.Second), timestamp: now.Add(-10 * time.Second)}
+
+		load := reporter.sampleGuestLoad("uid", &stats.DomainStats{
+			Vcpu: []stats.DomainStatsVcpu{{Time: uint64(5 * time.Second)}, {Time: uint64(1 * time.Second)}},
+		}, now)
+
+		// 5s of the 20s of two vCPUs in 10s were used
+		Expect(load.CPUUtilizationPercentage).To(HaveValue(BeEquivalentTo(25)))
+		Expect(reporter.cpuSamples).To(HaveKeyWithValue(BeEquivalentTo("uid"), cpuSample{vcpuTime: uint64(6 * time.Second), timestamp: now}))
+	})
+
+	DescribeTable("should decide whether the guest load is reported", func(reported *v1.GuestLoadStatus, sampled *v1.GuestLoadStatus, expected bool) {
+		Expect(needsReport(reported, sampled)).To(Equal(expected))
+	},
+		Entry("not when nothing was sampled",
+			nil,
+			&v1.GuestLoadStatus{},
+			false),
+		Entry("when nothing was reported yet",
+			nil,
+			&v1.GuestLoadStatus{CPUUtilizationPercentage: pointer.P(int32(10))},
+			true),
+		Entry("not when the change is small",
+			&v1.GuestLoadStatus{CPUUtilizationPercentage: pointer.P(int32(10))},
+			&v1.GuestLoadStatus{CPUUtilizationPercentage: pointer.P(int32(12))},
+			false),
+		Entry("when the change is big",
+			&v1.GuestLoadStatus{CPUUtilizationPercentage: pointer.P(int32(10))},
+			&v1.GuestLoadStatus{CPUUtilizationPercentage: pointer.P(int32(20))},
+			true),
+		Entry("when a new utilization was sampled",
+			&v1.GuestLoadStatus{CPUUtilizationPercentage: pointer.P(int32(10))},
+			&v1.GuestLoadStatus{CPUUtilizationPercentage: pointer.P(int32(10)), MemoryUtilizationPercentage: pointer.P(int32(50))},
+			true),
+		Entry("when the reported load gets old",
+			&v1.GuestLoadStatus{CPUUtilizationPercentage: pointer.P(int32(10))},
+			&v1.GuestLoadStatus{CPUUtilizationPercentage: pointer.P(int32(10)), LastProbeTime: metav1.NewTime(time.Now())},
+			true),
+	)
+})
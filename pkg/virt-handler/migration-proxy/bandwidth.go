@@ -0,0 +1,87 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package migrationproxy
+
+import (
+	"context"
+	"io"
+
+	"golang.org/x/time/rate"
+)
+
+// bandwidthChunkSize is the largest amount of data sent at once by a rate limited connection,
+// it is also the burst of the token buckets.
+const bandwidthChunkSize = 64 * 1024
+
+// newBandwidthLimiter returns a token bucket allowing bytesPerSecond, a value of 0 or less means no limit
+func newBandwidthLimiter(bytesPerSecond int64) *rate.Limiter {
+	limiter := rate.NewLimiter(rate.Inf, bandwidthChunkSize)
+	setBandwidth(limiter, bytesPerSecond)
+	return limiter
+}
+
+func setBandwidth(limiter *rate.Limiter, bytesPerSecond int64) {
+	if bytesPerSecond <= 0 {
+		limiter.SetLimit(rate.Inf)
+		return
+	}
+	limiter.SetLimit(rate.Limit(bytesPerSecond))
+}
+
+// rateLimitedWriter takes tokens from all of its buckets before passing data on,
+// so that the writes are throttled to the lowest of the bandwidths.
+type rateLimitedWriter struct {
+	ctx      context.Context
+	writer   io.Writer
+	limiters []*rate.Limiter
+}
+
+func newRateLimitedWriter(ctx context.Context, writer io.Writer, limiters []*rate.Limiter) io.Writer {
+	if len(limiters) == 0 {
+		return writer
+	}
+	return &rateLimitedWriter{
+		ctx:      ctx,
+		writer:   writer,
+		limiters: limiters,
+	}
+}
+
+func (w *rateLimitedWriter) Write(p []byte) (int, error) {
+	written := 0
+	for len(p) > 0 {
+		chunk := p
+		if len(chunk) > bandwidthChunkSize {
+			chunk = chunk[:bandwidthChunkSize]
+		}
+		for _, limiter := range w.limiters {
+			if err := limiter.WaitN(w.ctx, len(chunk)); err != nil {
+				return written, err
+			}
+		}
+		n, err := w.writer.Write(chunk)
+		written += n
+		if err != nil {
+			return written, err
+		}
+		p = p[n:]
+	}
+	return written, nil
+}
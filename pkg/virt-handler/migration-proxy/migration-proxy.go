@@ -20,6 +20,7 @@
 package migrationproxy
 
 import (
+	"context"
 	"crypto/tls"
 	"fmt"
 	"io"
@@ -29,6 +30,9 @@ import (
 	"strconv"
 	"strings"
 	"sync"
+	"time"
+
+	"golang.org/x/time/rate"
 
 	"kubevirt.io/client-go/log"
 
@@ -41,6 +45,14 @@ import (
 const (
 	LibvirtDirectMigrationPort = 49152
 	LibvirtBlockMigrationPort  = 49153
+	// CrossClusterMigrationTunnelPort is the port virt-handler receives migrations from other clusters on.
+	// All connections of a migration are tunnelled through it.
+	CrossClusterMigrationTunnelPort = 49160
+
+	// maxTunnelHeaderLength limits the header identifying the migration and the port of a tunnelled connection
+	maxTunnelHeaderLength = 512
+	// tunnelHeaderTimeout limits the time a tunnelled connection may take for the TLS handshake and its header
+	tunnelHeaderTimeout = 10 * time.Second
 )
 
 var migrationPortsRange = []int{LibvirtDirectMigrationPort, LibvirtBlockMigrationPort}
@@ -50,10 +62,13 @@ type ProxyManager interface {
 	GetTargetListenerPorts(key string) map[string]int
 	StopTargetListener(key string)
 
-	StartSourceListener(key string, targetAddress string, destSrcPortMap map[string]int, baseDir string) error
+	StartSourceListener(key string, targetAddress string, destSrcPortMap map[string]int, baseDir string, bandwidth int64) error
 	GetSourceListenerFiles(key string) []string
 	StopSourceListener(key string)
 
+	StartTargetTunnel(key string, migrationID string) error
+	StartSourceTunnelListener(key string, migrationID string, connectURL string, ports []int, baseDir string, bandwidth int64) error
+
 	OpenListenerCount() int
 
 	InitiateGracefulShutdown()
@@ -66,6 +81,17 @@ type migrationProxyManager struct {
 	serverTLSConfig *tls.Config
 	clientTLSConfig *tls.Config
 
+	// tunnelTargets maps the IDs of migrations received from other clusters to the keys of their target proxies
+	tunnelTargets       map[string]string
+	tunnelListener      net.Listener
+	tunnelPort          int
+	tunnelHeaderTimeout time.Duration
+
+	// nodeLimiter is shared by the connections of all outgoing migrations, sourceLimiters by the
+	// connections of a single outgoing migration
+	nodeLimiter    *rate.Limiter
+	sourceLimiters map[string]*rate.Limiter
+
 	isShuttingDown bool
 	config         *virtconfig.ClusterConfig
 }
@@ -88,6 +114,10 @@ type migrationProxy struct {
 	listener        net.Listener
 	serverTLSConfig *tls.Config
 	clientTLSConfig *tls.Config
+	// tunnelHeader is sent ahead of the data of every connection tunnelled to another cluster
+	tunnelHeader []byte
+	// limiters throttle the data sent on the outbound connections
+	limiters []*rate.Limiter
 
 	logger *log.FilteredLogger
 }
@@ -116,11 +146,16 @@ func GetMigrationPortsList(isBlockMigration bool) (ports []int) {
 
 func NewMigrationProxyManager(serverTLSConfig *tls.Config, clientTLSConfig *tls.Config, config *virtconfig.ClusterConfig) ProxyManager {
 	return &migrationProxyManager{
-		sourceProxies:   make(map[string][]*migrationProxy),
-		targetProxies:   make(map[string][]*migrationProxy),
-		serverTLSConfig: serverTLSConfig,
-		clientTLSConfig: clientTLSConfig,
-		config:          config,
+		sourceProxies:       make(map[string][]*migrationProxy),
+		targetProxies:       make(map[string][]*migrationProxy),
+		serverTLSConfig:     serverTLSConfig,
+		clientTLSConfig:     clientTLSConfig,
+		tunnelTargets:       make(map[string]string),
+		tunnelPort:          CrossClusterMigrationTunnelPort,
+		tunnelHeaderTimeout: tunnelHeaderTimeout,
+		nodeLimiter:         newBandwidthLimiter(0),
+		sourceLimiters:      make(map[string]*rate.Limiter),
+		config:              config,
 	}
 }
 
@@ -221,16 +256,6 @@ func (m *migrationProxyManager) GetTargetListenerPorts(key string) map[string]in
 	m.managerLock.Lock()
 	defer m.managerLock.Unlock()
 
-	getPortFromSocket := func(id string, path string) int {
-		for _, port := range migrationPortsRange {
-			key := ConstructProxyKey(id, port)
-			if strings.Contains(path, key) {
-				return port
-			}
-		}
-		return 0
-	}
-
 	curProxies, exists := m.targetProxies[key]
 	targetSrcPortMap := make(map[string]int)
 
@@ -243,6 +268,18 @@ func (m *migrationProxyManager) GetTargetListenerPorts(key string) map[string]in
 	return targetSrcPortMap
 }
 
+// getPortFromSocket returns the direct migration port a target unix socket belongs to,
+// or 0 for the libvirt connection
+func getPortFromSocket(id string, path string) int {
+	for _, port := range migrationPortsRange {
+		key := ConstructProxyKey(id, port)
+		if strings.Contains(path, key) {
+			return port
+		}
+	}
+	return 0
+}
+
 func (m *migrationProxyManager) StopTargetListener(key string) {
 	m.managerLock.Lock()
 	defer m.managerLock.Unlock()
@@ -255,15 +292,35 @@ func (m *migrationProxyManager) StopTargetListener(key string) {
 			delete(m.targetProxies, key)
 		}
 	}
+	m.stopTargetTunnel(key)
 }
 
-func (m *migrationProxyManager) StartSourceListener(key string, targetAddress string, destSrcPortMap map[string]int, baseDir string) error {
+// sourceBandwidthLimiters returns the token buckets limiting the bandwidth of the outgoing migration key
+// and of all outgoing migrations of the node, after updating them to the current limits.
+func (m *migrationProxyManager) sourceBandwidthLimiters(key string, bandwidth int64) []*rate.Limiter {
+	var bandwidthPerNode int64
+	if quantity := m.config.GetMigrationConfiguration().BandwidthPerNode; quantity != nil {
+		bandwidthPerNode = quantity.Value()
+	}
+	setBandwidth(m.nodeLimiter, bandwidthPerNode)
+
+	limiter, exists := m.sourceLimiters[key]
+	if !exists {
+		limiter = newBandwidthLimiter(bandwidth)
+		m.sourceLimiters[key] = limiter
+	}
+	setBandwidth(limiter, bandwidth)
+	return []*rate.Limiter{limiter, m.nodeLimiter}
+}
+
+func (m *migrationProxyManager) StartSourceListener(key string, targetAddress string, destSrcPortMap map[string]int, baseDir string, bandwidth int64) error {
 	m.managerLock.Lock()
 	defer m.managerLock.Unlock()
 
 	if m.isShuttingDown {
 		return fmt.Errorf("unable to process new migration connections during virt-handler shutdown")
 	}
+	limiters := m.sourceBandwidthLimiters(key, bandwidth)
 
 	isExistingProxy := func(curProxies []*migrationProxy, targetAddress string, destSrcPortMap map[string]int) bool {
 		if len(curProxies) != len(destSrcPortMap) {
@@ -311,6 +368,7 @@ func (m *migrationProxyManager) StartSourceListener(key string, targetAddress st
 		os.RemoveAll(filePath)
 
 		proxy := NewSourceProxy(filePath, targetFullAddr, serverTLSConfig, clientTLSConfig, key)
+		proxy.limiters = limiters
 
 		err := proxy.Start()
 		if err != nil {
@@ -341,6 +399,7 @@ func (m *migrationProxyManager) StopSourceListener(key string) {
 		}
 		delete(m.sourceProxies, key)
 	}
+	delete(m.sourceLimiters, key)
 }
 
 // SRC POD ENV(migration unix socket) <-> HOST ENV (tcp client) <-----> HOST ENV (tcp server) <-> TARGET POD ENV (virtqemud unix socket)
@@ -439,6 +498,9 @@ func (m *migrationProxy) Stop() {
 func (m *migrationProxy) handleConnection(fd net.Conn) {
 	defer fd.Close()
 
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
 	outBoundErr := make(chan error, 1)
 	inBoundErr := make(chan error, 1)
 
@@ -454,6 +516,13 @@ func (m *migrationProxy) handleConnection(fd net.Conn) {
 		return
 	}
 
+	if len(m.tunnelHeader) > 0 {
+		if _, err := conn.Write(m.tunnelHeader); err != nil {
+			m.logger.Reason(err).Error("unable to send the header of the migration tunnel")
+			return
+		}
+	}
+
 	go func() {
 		//from outbound connection to proxy
 		n, err := io.Copy(fd, conn)
@@ -462,7 +531,7 @@ func (m *migrationProxy) handleConnection(fd net.Conn) {
 	}()
 	go func() {
 		//from proxy to outbound connection
-		n, err := io.Copy(conn, fd)
+		n, err := io.Copy(newRateLimitedWriter(ctx, conn, m.limiters), fd)
 		m.logger.Infof("%d bytes copied from inbound to outbound", n)
 		outBoundErr <- err
 	}()
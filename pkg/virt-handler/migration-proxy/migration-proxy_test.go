@@ -20,14 +20,20 @@
 package migrationproxy
 
 import (
+	"bytes"
+	"context"
 	"crypto/tls"
+	"io"
 	"net"
 	"os"
 	"path/filepath"
 	"strings"
+	"time"
 
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
+	"golang.org/x/time/rate"
+	"k8s.io/apimachinery/pkg/api/resource"
 	v1 "kubevirt.io/api/core/v1"
 
 	"kubevirt.io/kubevirt/pkg/certificates"
@@ -165,7 +171,7 @@ var _ = Describe("MigrationProxy", func() {
 				manager := NewMigrationProxyManager(tlsConfig, tlsConfig, config)
 				manager.StartTargetListener("mykey", []string{virtqemudSock, directSock})
 				destSrcPortMap := manager.GetTargetListenerPorts("mykey")
-				manager.StartSourceListener("mykey", "127.0.0.1", destSrcPortMap, tmpDir)
+				manager.StartSourceListener("mykey", "127.0.0.1", destSrcPortMap, tmpDir, 0)
 
 				defer manager.StopTargetListener("myKey")
 				defer manager.StopSourceListener("myKey")
@@ -212,6 +218,129 @@ var _ = Describe("MigrationProxy", func() {
 				Entry("with TLS disabled", &v1.MigrationConfiguration{DisableTLS: pointer.P(true)}),
 			)
 
+			It("by tunnelling the connections of a migration to another cluster", func() {
+				directMigrationPort := "49152"
+				virtqemudSock := filepath.Join(tmpDir, "virtqemud-sock")
+				virtqemudListener, err := net.Listen("unix", virtqemudSock)
+				Expect(err).ShouldNot(HaveOccurred())
+				defer virtqemudListener.Close()
+				directSock := filepath.Join(tmpDir, "targetkey-"+directMigrationPort)
+				directListener, err := net.Listen("unix", directSock)
+				Expect(err).ShouldNot(HaveOccurred())
+				defer directListener.Close()
+
+				config, _, _ := testutils.NewFakeClusterConfigUsingKVConfig(&v1.KubeVirtConfiguration{})
+				targetManager := NewMigrationProxyManager(tlsConfig, tlsConfig, config).(*migrationProxyManager)
+				// use a random port for the tunnel
+				targetManager.tunnelPort = 0
+				Expect(targetManager.StartTargetListener("targetkey", []string{virtqemudSock, directSock})).To(Succeed())
+				Expect(targetManager.StartTargetTunnel("targetkey", "migration-id")).To(Succeed())
+				defer targetManager.StopTargetListener("targetkey")
+
+				sourceManager := NewMigrationProxyManager(tlsConfig, tlsConfig, config)
+				Expect(sourceManager.StartSourceTunnelListener("sourcekey", "migration-id", targetManager.tunnelListener.Addr().String(), []int{LibvirtDirectMigrationPort}, tmpDir, 0)).To(Succeed())
+				defer sourceManager.StopSourceListener("sourcekey")
+
+				msgReader := func(listener net.Listener, messages chan string) {
+					defer GinkgoRecover()
+					fd, err := listener.Accept()
+					Expect(err).ShouldNot(HaveOccurred())
+
+					var bytes [1024]byte
+					n, err := fd.Read(bytes[0:])
+					Expect(err).ShouldNot(HaveOccurred())
+					messages <- string(bytes[:n])
+				}
+				libvirtChan := make(chan string)
+				directChan := make(chan string)
+				go msgReader(virtqemudListener, libvirtChan)
+				go msgReader(directListener, directChan)
+
+				sourceFiles := sourceManager.GetSourceListenerFiles("sourcekey")
+				Expect(sourceFiles).To(ConsistOf(
+					SourceUnixFile(tmpDir, "sourcekey"),
+					SourceUnixFile(tmpDir, "sourcekey-"+directMigrationPort),
+				))
+				for _, sockFile := range sourceFiles {
+					conn, err := net.Dial("unix", sockFile)
+					Expect(err).ShouldNot(HaveOccurred())
+					defer conn.Close()
+					if strings.Contains(sockFile, directMigrationPort) {
+						_, err = conn.Write([]byte("some direct message"))
+						Expect(err).ShouldNot(HaveOccurred())
+						Eventually(directChan).Should(Receive(Equal("some direct message")))
+					} else {
+						_, err = conn.Write([]byte("some libvirt message"))
+						Expect(err).ShouldNot(HaveOccurred())
+						Eventually(libvirtChan).Should(Receive(Equal("some libvirt message")))
+					}
+				}
+			})
+
+			It("by refusing tunnelled connections of unknown migrations", func() {
+				virtqemudSock := filepath.Join(tmpDir, "virtqemud-sock")
+				virtqemudListener, err := net.Listen("unix", virtqemudSock)
+				Expect(err).ShouldNot(HaveOccurred())
+				defer virtqemudListener.Close()
+
+				config, _, _ := testutils.NewFakeClusterConfigUsingKVConfig(&v1.KubeVirtConfiguration{})
+				manager := NewMigrationProxyManager(tlsConfig, tlsConfig, config).(*migrationProxyManager)
+				manager.tunnelPort = 0
+				Expect(manager.StartTargetListener("targetkey", []string{virtqemudSock})).To(Succeed())
+				Expect(manager.StartTargetTunnel("targetkey", "migration-id")).To(Succeed())
+				tunnelAddress := manager.tunnelListener.Addr().String()
+
+				conn, err := tls.Dial("tcp", tunnelAddress, tlsConfig)
+				Expect(err).ShouldNot(HaveOccurred())
+				defer conn.Close()
+				_, err = conn.Write(tunnelHeader("other-migration-id", 0))
+				Expect(err).ShouldNot(HaveOccurred())
+				_, err = conn.Read(make([]byte, 1))
+				Expect(err).To(MatchError(io.EOF))
+
+				By("stopping the tunnel with the target listener")
+				manager.StopTargetListener("targetkey")
+				Expect(manager.tunnelListener).To(BeNil())
+				Expect(manager.tunnelTargets).To(BeEmpty())
+			})
+
+			DescribeTable("by closing tunnelled connections which stall", func(sendHeader bool) {
+				virtqemudSock := filepath.Join(tmpDir, "virtqemud-sock")
+				virtqemudListener, err := net.Listen("unix", virtqemudSock)
+				Expect(err).ShouldNot(HaveOccurred())
+				defer virtqemudListener.Close()
+
+				config, _, _ := testutils.NewFakeClusterConfigUsingKVConfig(&v1.KubeVirtConfiguration{})
+				manager := NewMigrationProxyManager(tlsConfig, tlsConfig, config).(*migrationProxyManager)
+				manager.tunnelPort = 0
+				manager.tunnelHeaderTimeout = 200 * time.Millisecond
+				Expect(manager.StartTargetListener("targetkey", []string{virtqemudSock})).To(Succeed())
+				Expect(manager.StartTargetTunnel("targetkey", "migration-id")).To(Succeed())
+				defer manager.StopTargetListener("targetkey")
+				tunnelAddress := manager.tunnelListener.Addr().String()
+
+				var conn net.Conn
+				if sendHeader {
+					// complete the handshake, but never finish the header
+					conn, err = tls.Dial("tcp", tunnelAddress, tlsConfig)
+					Expect(err).ShouldNot(HaveOccurred())
+					_, err = conn.Write([]byte("migration-id"))
+					Expect(err).ShouldNot(HaveOccurred())
+				} else {
+					// never start the handshake
+					conn, err = net.Dial("tcp", tunnelAddress)
+					Expect(err).ShouldNot(HaveOccurred())
+				}
+				defer conn.Close()
+
+				Expect(conn.SetReadDeadline(time.Now().Add(5 * time.Second))).To(Succeed())
+				_, err = conn.Read(make([]byte, 1))
+				Expect(err).To(MatchError(io.EOF))
+			},
+				Entry("during the TLS handshake", false),
+				Entry("while reading the header", true),
+			)
+
 			DescribeTable("by ensuring no new listeners can be created after shutdown", func(migrationConfig *v1.MigrationConfiguration) {
 
 				key1 := "key1"
@@ -234,7 +363,7 @@ var _ = Describe("MigrationProxy", func() {
 				err = manager.StartTargetListener(key1, []string{virtqemudSock, directSock})
 				Expect(err).ShouldNot(HaveOccurred())
 				destSrcPortMap := manager.GetTargetListenerPorts(key1)
-				err = manager.StartSourceListener(key1, "127.0.0.1", destSrcPortMap, tmpDir)
+				err = manager.StartSourceListener(key1, "127.0.0.1", destSrcPortMap, tmpDir, 0)
 				Expect(err).ShouldNot(HaveOccurred())
 
 				defer manager.StopTargetListener(key1)
@@ -249,7 +378,7 @@ var _ = Describe("MigrationProxy", func() {
 				Expect(err).Should(HaveOccurred())
 				Expect(err.Error()).To(Equal("unable to process new migration connections during virt-handler shutdown"))
 
-				err = manager.StartSourceListener(key2, "127.0.0.1", destSrcPortMap, tmpDir)
+				err = manager.StartSourceListener(key2, "127.0.0.1", destSrcPortMap, tmpDir, 0)
 				Expect(err).Should(HaveOccurred())
 				Expect(err.Error()).To(Equal("unable to process new migration connections during virt-handler shutdown"))
 
@@ -258,5 +387,65 @@ var _ = Describe("MigrationProxy", func() {
 				Entry("with TLS disabled", &v1.MigrationConfiguration{DisableTLS: pointer.P(true)}),
 			)
 		})
+
+		Context("bandwidth", func() {
+			It("should limit the outgoing migrations to the bandwidth per migration and per node", func() {
+				config, _, _ := testutils.NewFakeClusterConfigUsingKVConfig(&v1.KubeVirtConfiguration{
+					MigrationConfiguration: &v1.MigrationConfiguration{
+						BandwidthPerNode: resource.NewScaledQuantity(1, resource.Giga),
+					},
+				})
+				manager := NewMigrationProxyManager(tlsConfig, tlsConfig, config).(*migrationProxyManager)
+
+				Expect(manager.StartSourceListener("mykey", "127.0.0.1", map[string]int{"49152": 0}, tmpDir, 1000)).To(Succeed())
+				defer manager.StopSourceListener("mykey")
+
+				Expect(manager.sourceLimiters).To(HaveKey("mykey"))
+				Expect(manager.sourceLimiters["mykey"].Limit()).To(BeEquivalentTo(1000))
+				Expect(manager.nodeLimiter.Limit()).To(BeEquivalentTo(1000 * 1000 * 1000))
+				for _, proxy := range manager.sourceProxies["mykey"] {
+					Expect(proxy.limiters).To(ConsistOf(manager.sourceLimiters["mykey"], manager.nodeLimiter))
+				}
+
+				By("lifting the limit of the migration")
+				Expect(manager.StartSourceListener("mykey", "127.0.0.1", map[string]int{"49152": 0}, tmpDir, 0)).To(Succeed())
+				Expect(manager.sourceLimiters["mykey"].Limit()).To(Equal(rate.Inf))
+
+				manager.StopSourceListener("mykey")
+				Expect(manager.sourceLimiters).To(BeEmpty())
+			})
+
+			It("should throttle writes to the lowest bandwidth", func() {
+				var buffer bytes.Buffer
+				writer := newRateLimitedWriter(context.Background(), &buffer, []*rate.Limiter{
+					newBandwidthLimiter(0),
+					newBandwidthLimiter(1024 * 1024),
+				})
+
+				// the first chunk is covered by the burst, the remaining 256KiB take a quarter of a second
+				data := make([]byte, 5*bandwidthChunkSize)
+				start := time.Now()
+				n, err := writer.Write(data)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(n).To(Equal(len(data)))
+				Expect(buffer.Len()).To(Equal(len(data)))
+				Expect(time.Since(start)).To(BeNumerically(">=", 200*time.Millisecond))
+			})
+
+			It("should stop waiting for the bandwidth once the connection is closed", func() {
+				var buffer bytes.Buffer
+				ctx, cancel := context.WithCancel(context.Background())
+				writer := newRateLimitedWriter(ctx, &buffer, []*rate.Limiter{newBandwidthLimiter(1)})
+				cancel()
+
+				_, err := writer.Write(make([]byte, bandwidthChunkSize))
+				Expect(err).To(HaveOccurred())
+			})
+
+			It("should not wrap the connection without limits", func() {
+				var buffer bytes.Buffer
+				Expect(newRateLimitedWriter(context.Background(), &buffer, nil)).To(BeIdenticalTo(&buffer))
+			})
+		})
 	})
 })
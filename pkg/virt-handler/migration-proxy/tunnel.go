@@ -0,0 +1,244 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package migrationproxy
+
+import (
+	"crypto/tls"
+	"fmt"
+	"io"
+	"net"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"kubevirt.io/client-go/log"
+
+	"kubevirt.io/kubevirt/pkg/util/net/ip"
+)
+
+// SRC POD ENV(migration unix socket) <-> SRC HOST ENV (tls client) <-----> TARGET CLUSTER SERVICE <-----> TARGET HOST ENV (tunnel server) <-> TARGET POD ENV (virtqemud unix socket)
+//
+// Migrations to other clusters can't reach the randomly chosen ports of the target proxies. All their
+// connections are tunnelled through a single port instead, which the receiving cluster exposes. Each
+// tunnelled connection starts with a header naming the migration and the port it belongs to.
+
+// StartTargetTunnel forwards the connections of the migration with the given ID,
+// received from another cluster, to the already started target proxies of key.
+func (m *migrationProxyManager) StartTargetTunnel(key string, migrationID string) error {
+	m.managerLock.Lock()
+	defer m.managerLock.Unlock()
+
+	if m.isShuttingDown {
+		return fmt.Errorf("unable to process new migration connections during virt-handler shutdown")
+	}
+	if _, exists := m.targetProxies[key]; !exists {
+		return fmt.Errorf("no migration target listener is up for %s", key)
+	}
+	if m.serverTLSConfig == nil {
+		return fmt.Errorf("migrations from other clusters require TLS")
+	}
+
+	if m.tunnelListener == nil {
+		laddr := net.JoinHostPort(ip.GetIPZeroAddress(), strconv.Itoa(m.tunnelPort))
+		listener, err := tls.Listen("tcp", laddr, m.serverTLSConfig)
+		if err != nil {
+			log.Log.Reason(err).Error("failed to create the migration tunnel listener")
+			return err
+		}
+		m.tunnelListener = listener
+		go m.acceptTunnelConnections(listener)
+		log.Log.Infof("migration tunnel started listening on %s", listener.Addr().String())
+	}
+	m.tunnelTargets[migrationID] = key
+	return nil
+}
+
+// stopTargetTunnel stops forwarding tunnelled connections to the target proxies of key.
+// The tunnel listener is closed once no migration is received anymore. The manager lock has to be held.
+func (m *migrationProxyManager) stopTargetTunnel(key string) {
+	for migrationID, targetKey := range m.tunnelTargets {
+		if targetKey == key {
+			delete(m.tunnelTargets, migrationID)
+		}
+	}
+	if len(m.tunnelTargets) == 0 && m.tunnelListener != nil {
+		log.Log.Info("migration tunnel stopped listening")
+		m.tunnelListener.Close()
+		m.tunnelListener = nil
+	}
+}
+
+func (m *migrationProxyManager) acceptTunnelConnections(listener net.Listener) {
+	for {
+		fd, err := listener.Accept()
+		if err != nil {
+			log.Log.Reason(err).V(3).Info("migration tunnel listener exited")
+			return
+		}
+		go m.handleTunnelConnection(fd)
+	}
+}
+
+func (m *migrationProxyManager) handleTunnelConnection(fd net.Conn) {
+	migrationID, port, err := m.receiveTunnelHeader(fd)
+	if err != nil {
+		log.Log.Reason(err).Error("received an invalid migration tunnel connection")
+		fd.Close()
+		return
+	}
+
+	proxy := m.lookupTunnelTarget(migrationID, port)
+	if proxy == nil {
+		log.Log.Errorf("no migration target is waiting for migration %s on port %d", migrationID, port)
+		fd.Close()
+		return
+	}
+	proxy.handleConnection(fd)
+}
+
+// receiveTunnelHeader completes the TLS handshake and reads the header of a tunnelled connection.
+// Both have to finish in time, so that stalled peers don't hold connections open forever.
+func (m *migrationProxyManager) receiveTunnelHeader(fd net.Conn) (migrationID string, port int, err error) {
+	if err := fd.SetReadDeadline(time.Now().Add(m.tunnelHeaderTimeout)); err != nil {
+		return "", 0, err
+	}
+	if tlsConn, ok := fd.(*tls.Conn); ok {
+		if err := tlsConn.Handshake(); err != nil {
+			return "", 0, err
+		}
+	}
+	migrationID, port, err = readTunnelHeader(fd)
+	if err != nil {
+		return "", 0, err
+	}
+	if err := fd.SetReadDeadline(time.Time{}); err != nil {
+		return "", 0, err
+	}
+	return migrationID, port, nil
+}
+
+func (m *migrationProxyManager) lookupTunnelTarget(migrationID string, port int) *migrationProxy {
+	m.managerLock.Lock()
+	defer m.managerLock.Unlock()
+
+	key, exists := m.tunnelTargets[migrationID]
+	if !exists {
+		return nil
+	}
+	for _, proxy := range m.targetProxies[key] {
+		if getPortFromSocket(key, proxy.targetAddress) == port {
+			return proxy
+		}
+	}
+	return nil
+}
+
+// StartSourceTunnelListener creates the source unix sockets of key for the libvirt connection and the
+// given direct migration ports, and tunnels their connections to the migration endpoint of another cluster.
+func (m *migrationProxyManager) StartSourceTunnelListener(key string, migrationID string, connectURL string, ports []int, baseDir string, bandwidth int64) error {
+	m.managerLock.Lock()
+	defer m.managerLock.Unlock()
+
+	if m.isShuttingDown {
+		return fmt.Errorf("unable to process new migration connections during virt-handler shutdown")
+	}
+	if m.clientTLSConfig == nil {
+		return fmt.Errorf("migrations to other clusters require TLS")
+	}
+	limiters := m.sourceBandwidthLimiters(key, bandwidth)
+
+	// the libvirt connection is tunnelled as port 0
+	ports = append([]int{0}, ports...)
+
+	curProxies, exists := m.sourceProxies[key]
+	if exists {
+		isExistingTunnel := len(curProxies) == len(ports)
+		for _, curProxy := range curProxies {
+			if curProxy.targetAddress != connectURL {
+				isExistingTunnel = false
+			}
+		}
+		if isExistingTunnel {
+			// No Op, already exists
+			return nil
+		}
+		for _, curProxy := range curProxies {
+			curProxy.logger.Infof("Manager is stopping proxy on source node due to new target location")
+			curProxy.Stop()
+		}
+	}
+
+	proxiesList := []*migrationProxy{}
+	for _, port := range ports {
+		filePath := SourceUnixFile(baseDir, ConstructProxyKey(key, port))
+		os.RemoveAll(filePath)
+
+		proxy := NewSourceProxy(filePath, connectURL, nil, m.clientTLSConfig, key)
+		proxy.tunnelHeader = tunnelHeader(migrationID, port)
+		proxy.limiters = limiters
+
+		err := proxy.Start()
+		if err != nil {
+			proxy.Stop()
+			// close all already created proxies for this key
+			for _, curProxy := range proxiesList {
+				curProxy.Stop()
+			}
+			return err
+		}
+		proxiesList = append(proxiesList, proxy)
+		proxy.logger.Infof("Manager created tunnel proxy on source node")
+	}
+	m.sourceProxies[key] = proxiesList
+	return nil
+}
+
+func tunnelHeader(migrationID string, port int) []byte {
+	return []byte(fmt.Sprintf("%s %d\n", migrationID, port))
+}
+
+// readTunnelHeader reads the header byte by byte, so that no data following it is consumed
+func readTunnelHeader(conn io.Reader) (migrationID string, port int, err error) {
+	var header []byte
+	b := make([]byte, 1)
+	for {
+		if _, err := io.ReadFull(conn, b); err != nil {
+			return "", 0, err
+		}
+		if b[0] == '\n' {
+			break
+		}
+		header = append(header, b[0])
+		if len(header) > maxTunnelHeaderLength {
+			return "", 0, fmt.Errorf("migration tunnel header exceeds %d bytes", maxTunnelHeaderLength)
+		}
+	}
+
+	fields := strings.Fields(string(header))
+	if len(fields) != 2 {
+		return "", 0, fmt.Errorf("malformed migration tunnel header %q", string(header))
+	}
+	port, err = strconv.Atoi(fields[1])
+	if err != nil {
+		return "", 0, fmt.Errorf("malformed port in migration tunnel header %q: %v", string(header), err)
+	}
+	return fields[0], port, nil
+}
@@ -28,13 +28,37 @@ import (
 
 // FindMigrationIP looks for dedicated migration network migration0. If found, sets migration IP to it
 func FindMigrationIP(migrationIp string) (string, error) {
-	ief, err := net.InterfaceByName(v1.MigrationInterfaceName)
+	ip, found, err := findInterfaceIP(v1.MigrationInterfaceName)
+	if !found || err != nil {
+		return migrationIp, err
+	}
+	return ip, nil
+}
+
+// FindMigrationNetworkIPs looks for the interfaces of the additional migration networks, named migration1, migration2, ...
+// in the order of the networks, and returns the IPs found on them by the name of their network
+func FindMigrationNetworkIPs(networks []string) (map[string]string, error) {
+	ips := map[string]string{}
+	for i, network := range networks {
+		ip, found, err := findInterfaceIP(fmt.Sprintf("%s%d", v1.AdditionalMigrationInterfacePrefix, i+1))
+		if err != nil {
+			return nil, err
+		}
+		if found {
+			ips[network] = ip
+		}
+	}
+	return ips, nil
+}
+
+func findInterfaceIP(name string) (string, bool, error) {
+	ief, err := net.InterfaceByName(name)
 	if err != nil {
-		return migrationIp, nil
+		return "", false, nil
 	}
 	addrs, err := ief.Addrs()
 	if err != nil { // get addresses
-		return migrationIp, fmt.Errorf("%s present but doesn't have an IP", v1.MigrationInterfaceName)
+		return "", true, fmt.Errorf("%s present but doesn't have an IP", name)
 	}
 	for _, addr := range addrs {
 		if !addr.(*net.IPNet).IP.IsGlobalUnicast() {
@@ -43,9 +67,9 @@ func FindMigrationIP(migrationIp string) (string, error) {
 		}
 		ip := addr.(*net.IPNet).IP.To16()
 		if ip != nil {
-			return ip.String(), nil
+			return ip.String(), true, nil
 		}
 	}
 
-	return migrationIp, fmt.Errorf("no IP found on %s", v1.MigrationInterfaceName)
+	return "", true, fmt.Errorf("no IP found on %s", name)
 }
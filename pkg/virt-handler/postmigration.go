@@ -0,0 +1,175 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package virthandler
+
+import (
+	"errors"
+	"net"
+	"strconv"
+	"sync"
+	"syscall"
+	"time"
+
+	k8sv1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+
+	v1 "kubevirt.io/api/core/v1"
+
+	"kubevirt.io/kubevirt/pkg/controller"
+	"kubevirt.io/kubevirt/pkg/network/vmispec"
+	"kubevirt.io/kubevirt/pkg/util/migrations"
+)
+
+const (
+	guestNetworkProbeTimeout  = 2 * time.Second
+	guestNetworkProbeInterval = 5 * time.Second
+)
+
+type guestNetworkProbe struct {
+	inflight  bool
+	reachable bool
+	probedAt  metav1.Time
+}
+
+// guestNetworkProber connects to guests in the background, so that the sync of a VMI never waits for its guest
+type guestNetworkProber struct {
+	lock    sync.Mutex
+	probes  map[types.UID]*guestNetworkProbe
+	dial    func(network, address string, timeout time.Duration) (net.Conn, error)
+	enqueue func(vmi *v1.VirtualMachineInstance)
+}
+
+func newGuestNetworkProber(enqueue func(vmi *v1.VirtualMachineInstance)) *guestNetworkProber {
+	return &guestNetworkProber{
+		probes:  map[types.UID]*guestNetworkProbe{},
+		dial:    net.DialTimeout,
+		enqueue: enqueue,
+	}
+}
+
+// result returns the outcome of the last probe of the guest, and whether a probe finished at all.
+// A new probe is started unless one is running, the guest was reachable, or the last probe is too recent.
+func (p *guestNetworkProber) result(vmi *v1.VirtualMachineInstance, address string) (reachable bool, probedAt metav1.Time, probed bool) {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+
+	probe, exists := p.probes[vmi.UID]
+	if !exists {
+		probe = &guestNetworkProbe{}
+		p.probes[vmi.UID] = probe
+	}
+	if !probe.inflight && !probe.reachable && time.Since(probe.probedAt.Time) >= guestNetworkProbeInterval {
+		probe.inflight = true
+		go p.run(vmi.DeepCopy(), address)
+	}
+	return probe.reachable, probe.probedAt, !probe.probedAt.IsZero()
+}
+
+func (p *guestNetworkProber) run(vmi *v1.VirtualMachineInstance, address string) {
+	conn, err := p.dial("tcp", address, guestNetworkProbeTimeout)
+	// a refused connection proves that the guest is reachable as well
+	reachable := err == nil || errors.Is(err, syscall.ECONNREFUSED)
+	if conn != nil {
+		conn.Close()
+	}
+
+	p.lock.Lock()
+	probe, exists := p.probes[vmi.UID]
+	if exists {
+		probe.inflight = false
+		probe.reachable = reachable
+		probe.probedAt = metav1.Now()
+	}
+	p.lock.Unlock()
+
+	if exists {
+		p.enqueue(vmi)
+	}
+}
+
+func (p *guestNetworkProber) forget(uid types.UID) {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+	delete(p.probes, uid)
+}
+
+// postMigrationNetworkCheckAddress returns the address the guest is probed at, as long as the
+// Network check of the verification of its last migration runs
+func postMigrationNetworkCheckAddress(vmi *v1.VirtualMachineInstance) (string, bool) {
+	state := vmi.Status.MigrationState
+	if state == nil || !state.Completed || state.Failed || state.EndTimestamp == nil ||
+		state.MigrationConfiguration == nil || state.MigrationConfiguration.PostMigrationVerification == nil {
+		return "", false
+	}
+	verification := state.MigrationConfiguration.PostMigrationVerification
+	checksNetwork := false
+	for _, check := range verification.Checks {
+		checksNetwork = checksNetwork || check == v1.PostMigrationCheckNetwork
+	}
+	if !checksNetwork || time.Since(state.EndTimestamp.Time) > migrations.PostMigrationVerificationTimeout(verification) {
+		return "", false
+	}
+
+	podNetwork := vmispec.LookupPodNetwork(vmi.Spec.Networks)
+	if podNetwork == nil {
+		return "", false
+	}
+	iface := vmispec.LookupInterfaceStatusByName(vmi.Status.Interfaces, podNetwork.Name)
+	if iface == nil || iface.IP == "" {
+		return "", false
+	}
+	port := migrations.PostMigrationNetworkCheckPort(verification)
+	return net.JoinHostPort(iface.IP, strconv.Itoa(int(port))), true
+}
+
+// updateGuestNetworkCondition reports whether the guest answered a TCP connection from this node, while
+// the verification of its last migration asks for the Network check
+func (c *VirtualMachineController) updateGuestNetworkCondition(vmi *v1.VirtualMachineInstance, condManager *controller.VirtualMachineInstanceConditionManager) {
+	address, checking := postMigrationNetworkCheckAddress(vmi)
+	if !checking {
+		c.guestNetworkProber.forget(vmi.UID)
+		return
+	}
+
+	reachable, probedAt, probed := c.guestNetworkProber.result(vmi, address)
+	if !reachable {
+		c.queue.AddAfter(controller.VirtualMachineInstanceKey(vmi), guestNetworkProbeInterval)
+	}
+	if !probed {
+		return
+	}
+	status := k8sv1.ConditionFalse
+	if reachable {
+		status = k8sv1.ConditionTrue
+	}
+	condition := v1.VirtualMachineInstanceCondition{
+		Type:               v1.VirtualMachineInstanceGuestNetworkReachable,
+		Status:             status,
+		LastProbeTime:      probedAt,
+		LastTransitionTime: probedAt,
+	}
+	// the probe time tells virt-controller that the guest was probed after the migration
+	if existing := condManager.GetCondition(vmi, v1.VirtualMachineInstanceGuestNetworkReachable); existing != nil && existing.Status == status {
+		condition.LastTransitionTime = existing.LastTransitionTime
+	}
+	condManager.RemoveCondition(vmi, v1.VirtualMachineInstanceGuestNetworkReachable)
+	vmi.Status.Conditions = append(vmi.Status.Conditions, condition)
+}
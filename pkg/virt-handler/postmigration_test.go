@@ -0,0 +1,144 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package virthandler
+
+import (
+	"net"
+	"syscall"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gstruct"
+	k8sv1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/util/workqueue"
+
+	v1 "kubevirt.io/api/core/v1"
+
+	"kubevirt.io/kubevirt/pkg/controller"
+	"kubevirt.io/kubevirt/pkg/libvmi"
+	"kubevirt.io/kubevirt/pkg/pointer"
+)
+
+var _ = Describe("Post migration network check", func() {
+	var vmi *v1.VirtualMachineInstance
+	var vmController *VirtualMachineController
+	var dialed chan string
+
+	BeforeEach(func() {
+		vmi = libvmi.New(libvmi.WithNetwork(v1.DefaultPodNetwork()))
+		vmi.UID = "1234"
+		vmi.Status.Interfaces = []v1.VirtualMachineInstanceNetworkInterface{
+			{Name: v1.DefaultPodNetwork().Name, IP: "10.244.0.10"},
+		}
+		vmi.Status.MigrationState = &v1.VirtualMachineInstanceMigrationState{
+			Completed:    true,
+			EndTimestamp: pointer.P(metav1.Now()),
+			MigrationConfiguration: &v1.MigrationConfiguration{
+				PostMigrationVerification: &v1.PostMigrationVerification{
+					Checks:           []v1.PostMigrationCheck{v1.PostMigrationCheckNetwork},
+					NetworkCheckPort: pointer.P(int32(8080)),
+				},
+			},
+		}
+
+		dialed = make(chan string, 1)
+		vmController = &VirtualMachineController{
+			queue: workqueue.NewTypedRateLimitingQueue[string](workqueue.DefaultTypedControllerRateLimiter[string]()),
+		}
+		vmController.guestNetworkProber = newGuestNetworkProber(func(_ *v1.VirtualMachineInstance) {})
+		DeferCleanup(vmController.queue.ShutDown)
+	})
+
+	setDialResult := func(dialErr error) {
+		vmController.guestNetworkProber.dial = func(_, address string, _ time.Duration) (net.Conn, error) {
+			dialed <- address
+			if dialErr != nil {
+				return nil, dialErr
+			}
+			client, server := net.Pipe()
+			server.Close()
+			return client, nil
+		}
+	}
+
+	updateCondition := func() {
+		vmController.updateGuestNetworkCondition(vmi, controller.NewVirtualMachineInstanceConditionManager())
+	}
+
+	DescribeTable("should report the guest network on the VMI once the probe finished", func(dialErr error, expectedStatus k8sv1.ConditionStatus) {
+		setDialResult(dialErr)
+
+		updateCondition()
+		Eventually(dialed).Should(Receive(Equal("10.244.0.10:8080")))
+		Expect(vmi.Status.Conditions).To(BeEmpty())
+
+		Eventually(func() []v1.VirtualMachineInstanceCondition {
+			updateCondition()
+			return vmi.Status.Conditions
+		}).Should(ConsistOf(MatchFields(IgnoreExtras, Fields{
+			"Type":   Equal(v1.VirtualMachineInstanceGuestNetworkReachable),
+			"Status": Equal(expectedStatus),
+		})))
+	},
+		Entry("reachable if the guest accepts the connection", nil, k8sv1.ConditionTrue),
+		Entry("reachable if the guest refuses the connection", &net.OpError{Op: "dial", Err: syscall.ECONNREFUSED}, k8sv1.ConditionTrue),
+		Entry("unreachable if the connection times out", &net.OpError{Op: "dial", Err: syscall.ETIMEDOUT}, k8sv1.ConditionFalse),
+	)
+
+	It("should refresh the probe time of a condition left from an earlier migration", func() {
+		setDialResult(nil)
+		vmi.Status.Conditions = []v1.VirtualMachineInstanceCondition{{
+			Type:          v1.VirtualMachineInstanceGuestNetworkReachable,
+			Status:        k8sv1.ConditionTrue,
+			LastProbeTime: metav1.NewTime(time.Now().Add(-time.Hour)),
+		}}
+
+		Eventually(func() metav1.Time {
+			updateCondition()
+			return controller.NewVirtualMachineInstanceConditionManager().GetCondition(vmi, v1.VirtualMachineInstanceGuestNetworkReachable).LastProbeTime
+		}).Should(WithTransform(func(probedAt metav1.Time) bool {
+			return !probedAt.Before(vmi.Status.MigrationState.EndTimestamp)
+		}, BeTrue()))
+	})
+
+	DescribeTable("should not probe the guest", func(update func(vmi *v1.VirtualMachineInstance)) {
+		setDialResult(nil)
+		update(vmi)
+
+		updateCondition()
+		Consistently(dialed, 100*time.Millisecond).ShouldNot(Receive())
+		Expect(vmi.Status.Conditions).To(BeEmpty())
+	},
+		Entry("without the Network check", func(vmi *v1.VirtualMachineInstance) {
+			vmi.Status.MigrationState.MigrationConfiguration.PostMigrationVerification.Checks = []v1.PostMigrationCheck{v1.PostMigrationCheckGuestAgent}
+		}),
+		Entry("before the migration completed", func(vmi *v1.VirtualMachineInstance) {
+			vmi.Status.MigrationState.Completed = false
+		}),
+		Entry("after the verification timed out", func(vmi *v1.VirtualMachineInstance) {
+			vmi.Status.MigrationState.EndTimestamp = pointer.P(metav1.NewTime(time.Now().Add(-time.Hour)))
+		}),
+		Entry("without an address on the pod network", func(vmi *v1.VirtualMachineInstance) {
+			vmi.Status.Interfaces = nil
+		}),
+	)
+})
@@ -34,6 +34,7 @@ import (
 	"kubevirt.io/client-go/log"
 
 	cmdclient "kubevirt.io/kubevirt/pkg/virt-handler/cmd-client"
+	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/api"
 )
 
 const (
@@ -313,3 +314,48 @@ func (lh *LifecycleHandler) SEVInjectLaunchSecretHandler(request *restful.Reques
 
 	response.WriteHeader(http.StatusAccepted)
 }
+
+// GuestExecHandler runs a command in the guest and responds with its output
+// once it exited, the guest agent does not provide it any earlier
+func (lh *LifecycleHandler) GuestExecHandler(request *restful.Request, response *restful.Response) {
+	vmi, client, err := lh.getVMILauncherClient(request, response)
+	if err != nil {
+		return
+	}
+
+	if request.Request.Body == nil {
+		log.Log.Object(vmi).Error("Request with no body: guest exec options are required")
+		response.WriteError(http.StatusBadRequest, fmt.Errorf("failed to retrieve guest exec options from request"))
+		return
+	}
+
+	opts := &v1.VirtualMachineInstanceGuestExecOptions{}
+	err = yaml.NewYAMLOrJSONDecoder(request.Request.Body, 1024).Decode(opts)
+	switch err {
+	case io.EOF, nil:
+		break
+	default:
+		log.Log.Object(vmi).Reason(err).Error("Failed to decode guest exec options")
+		response.WriteError(http.StatusBadRequest, err)
+		return
+	}
+	if opts.Command == "" || opts.TimeoutSeconds == nil {
+		response.WriteError(http.StatusBadRequest, fmt.Errorf("a command and a timeout are required for guest exec"))
+		return
+	}
+
+	log.Log.Object(vmi).Infof("Executing command %s in the guest", opts.Command)
+
+	exitCode, stdOut, stdErr, err := client.Exec(api.VMINamespaceKeyFunc(vmi), opts.Command, opts.Args, *opts.TimeoutSeconds)
+	if err != nil {
+		log.Log.Object(vmi).Reason(err).Error("Failed to execute command in the guest")
+		response.WriteError(http.StatusInternalServerError, err)
+		return
+	}
+
+	response.WriteEntity(v1.VirtualMachineInstanceGuestExecResult{
+		ExitCode: int32(exitCode),
+		Stdout:   stdOut,
+		Stderr:   stdErr,
+	})
+}
@@ -97,6 +97,7 @@ import (
 type netconf interface {
 	Setup(vmi *v1.VirtualMachineInstance, networks []v1.Network, launcherPid int, preSetup func() error) error
 	Teardown(vmi *v1.VirtualMachineInstance) error
+	EnsureRouterAdvertisers(vmi *v1.VirtualMachineInstance, launcherPid int)
 }
 
 type netstat interface {
@@ -162,6 +163,7 @@ func NewController(
 	clientset kubecli.KubevirtClient,
 	host string,
 	migrationIpAddress string,
+	migrationNetworkIpAddresses map[string]string,
 	virtShareDir string,
 	virtPrivateDir string,
 	kubeletPodsDir string,
@@ -201,6 +203,7 @@ func NewController(
 		clientset:                        clientset,
 		host:                             host,
 		migrationIpAddress:               migrationIpAddress,
+		migrationNetworkIpAddresses:      migrationNetworkIpAddresses,
 		virtShareDir:                     virtShareDir,
 		vmiSourceStore:                   vmiSourceInformer.GetStore(),
 		vmiTargetStore:                   vmiTargetInformer.GetStore(),
@@ -255,6 +258,10 @@ func NewController(
 
 	c.launcherClients = virtcache.LauncherClientInfoByVMI{}
 
+	c.guestNetworkProber = newGuestNetworkProber(func(vmi *v1.VirtualMachineInstance) {
+		c.queue.Add(controller.VirtualMachineInstanceKey(vmi))
+	})
+
 	c.downwardMetricsManager = downwardMetricsManager
 
 	c.domainNotifyPipes = make(map[string]string)
@@ -288,26 +295,28 @@ type netBindingPluginMemoryCalculator interface {
 }
 
 type VirtualMachineController struct {
-	recorder                 record.EventRecorder
-	clientset                kubecli.KubevirtClient
-	host                     string
-	migrationIpAddress       string
-	virtShareDir             string
-	virtPrivateDir           string
-	queue                    workqueue.TypedRateLimitingInterface[string]
-	vmiSourceStore           cache.Store
-	vmiTargetStore           cache.Store
-	domainStore              cache.Store
-	launcherClients          virtcache.LauncherClientInfoByVMI
-	heartBeatInterval        time.Duration
-	deviceManagerController  *device_manager.DeviceController
-	migrationProxy           migrationproxy.ProxyManager
-	podIsolationDetector     isolation.PodIsolationDetector
-	containerDiskMounter     container_disk.Mounter
-	hotplugVolumeMounter     hotplug_volume.VolumeMounter
-	clusterConfig            *virtconfig.ClusterConfig
-	sriovHotplugExecutorPool *executor.RateLimitedExecutorPool
-	downwardMetricsManager   downwardMetricsManager
+	recorder                    record.EventRecorder
+	clientset                   kubecli.KubevirtClient
+	host                        string
+	migrationIpAddress          string
+	migrationNetworkIpAddresses map[string]string
+	virtShareDir                string
+	virtPrivateDir              string
+	queue                       workqueue.TypedRateLimitingInterface[string]
+	vmiSourceStore              cache.Store
+	vmiTargetStore              cache.Store
+	domainStore                 cache.Store
+	launcherClients             virtcache.LauncherClientInfoByVMI
+	guestNetworkProber          *guestNetworkProber
+	heartBeatInterval           time.Duration
+	deviceManagerController     *device_manager.DeviceController
+	migrationProxy              migrationproxy.ProxyManager
+	podIsolationDetector        isolation.PodIsolationDetector
+	containerDiskMounter        container_disk.Mounter
+	hotplugVolumeMounter        hotplug_volume.VolumeMounter
+	clusterConfig               *virtconfig.ClusterConfig
+	sriovHotplugExecutorPool    *executor.RateLimitedExecutorPool
+	downwardMetricsManager      downwardMetricsManager
 
 	netConf                          netconf
 	netStat                          netstat
@@ -615,6 +624,7 @@ func (c *VirtualMachineController) setMigrationProgressStatus(vmi *v1.VirtualMac
 	if vmi.Status.MigrationState.EndTimestamp == nil && migrationMetadata.EndTimestamp != nil {
 		if migrationMetadata.Failed {
 			vmi.Status.MigrationState.FailureReason = migrationMetadata.FailureReason
+			vmi.Status.MigrationState.FailureClass = migrationMetadata.FailureClass
 			c.recorder.Event(vmi, k8sv1.EventTypeWarning, v1.Migrated.String(), fmt.Sprintf("VirtualMachineInstance migration uid %s failed. reason:%s", string(migrationMetadata.UID), migrationMetadata.FailureReason))
 		}
 	}
@@ -670,7 +680,14 @@ func (c *VirtualMachineController) migrationSourceUpdateVMIStatus(origVMI *v1.Vi
 	// way of transferring ownership. The only option here is to move the
 	// vmi to failed.  The cluster vmi controller will then tear down the
 	// resulting pods.
-	if migrationHost == "" {
+	if vmi.Status.MigrationState != nil && vmi.Status.MigrationState.CrossCluster != nil {
+		// the VMI now runs in another cluster. Its phase is left untouched,
+		// virt-controller hands over the ownership of the VM once it observes
+		// the completed migration.
+		vmi.Status.MigrationState.Completed = true
+		c.recorder.Event(vmi, k8sv1.EventTypeNormal, v1.Migrated.String(), fmt.Sprintf("The VirtualMachineInstance migrated to another cluster."))
+		log.Log.Object(vmi).Info("migration completed to another cluster")
+	} else if migrationHost == "" {
 		// migrated to unknown host.
 		vmi.Status.Phase = v1.Failed
 		vmi.Status.MigrationState.Completed = true
@@ -752,6 +769,11 @@ func (c *VirtualMachineController) migrationTargetUpdateVMIStatus(vmi *v1.Virtua
 		// record that we've see the domain populated on the target's node
 		log.Log.Object(vmi).Info("The target node received the migrated domain")
 		vmiCopy.Status.MigrationState.TargetNodeDomainDetected = true
+		if isCrossClusterMigrationReceiver(vmi) && vmiCopy.Status.MigrationState.StartTimestamp == nil {
+			// the source in the other cluster can't report the start of the migration
+			now := metav1.Now()
+			vmiCopy.Status.MigrationState.StartTimestamp = &now
+		}
 
 		// adjust QEMU process memlock limits in order to enable old virt-launcher pod's to
 		// perform hotplug host-devices on post migration.
@@ -775,7 +797,15 @@ func (c *VirtualMachineController) migrationTargetUpdateVMIStatus(vmi *v1.Virtua
 		c.finalizeMigration(vmiCopy)
 	}
 
-	if !migrations.IsMigrating(vmi) {
+	if isCrossClusterMigrationReceiver(vmi) && vmiCopy.Status.MigrationState.TargetNodeDomainReadyTimestamp != nil {
+		// there is no source in this cluster to acknowledge the migration,
+		// the VMI is taken over as soon as the domain runs on this node.
+		now := metav1.Now()
+		vmiCopy.Status.MigrationState.Completed = true
+		vmiCopy.Status.MigrationState.EndTimestamp = &now
+		vmiCopy.Status.Phase = v1.Running
+		c.recorder.Event(vmi, k8sv1.EventTypeNormal, v1.Migrated.String(), "The VirtualMachineInstance migrated from another cluster.")
+	} else if !migrations.IsMigrating(vmi) {
 		destSrcPortsMap := c.migrationProxy.GetTargetListenerPorts(string(vmi.UID))
 		if len(destSrcPortsMap) == 0 {
 			msg := "target migration listener is not up for this vmi"
@@ -788,15 +818,16 @@ func (c *VirtualMachineController) migrationTargetUpdateVMIStatus(vmi *v1.Virtua
 		if vmi.Status.MigrationState != nil {
 			hostAddress = vmi.Status.MigrationState.TargetNodeAddress
 		}
-		if hostAddress != c.migrationIpAddress {
+		migrationIpAddress := c.migrationIpAddressForVMI(vmi)
+		if hostAddress != migrationIpAddress {
 			portsList := make([]string, 0, len(destSrcPortsMap))
 
 			for k := range destSrcPortsMap {
 				portsList = append(portsList, k)
 			}
 			portsStrList := strings.Trim(strings.Join(strings.Fields(fmt.Sprint(portsList)), ","), "[]")
-			c.recorder.Event(vmi, k8sv1.EventTypeNormal, v1.PreparingTarget.String(), fmt.Sprintf("Migration Target is listening at %s, on ports: %s", c.migrationIpAddress, portsStrList))
-			vmiCopy.Status.MigrationState.TargetNodeAddress = c.migrationIpAddress
+			c.recorder.Event(vmi, k8sv1.EventTypeNormal, v1.PreparingTarget.String(), fmt.Sprintf("Migration Target is listening at %s, on ports: %s", migrationIpAddress, portsStrList))
+			vmiCopy.Status.MigrationState.TargetNodeAddress = migrationIpAddress
 			vmiCopy.Status.MigrationState.TargetDirectMigrationNodePorts = destSrcPortsMap
 		}
 
@@ -1013,6 +1044,10 @@ func (c *VirtualMachineController) updateVolumeStatusesFromDomain(vmi *v1.Virtua
 				volumeStatus, tmpNeedsRefresh = c.updateMemoryDumpInfo(vmi, volumeStatus, domain)
 				needsRefresh = needsRefresh || tmpNeedsRefresh
 			}
+			if volumeStatus.BackupVolume != nil {
+				volumeStatus, tmpNeedsRefresh = c.updateBackupInfo(vmi, volumeStatus, domain)
+				needsRefresh = needsRefresh || tmpNeedsRefresh
+			}
 			newStatuses = append(newStatuses, volumeStatus)
 			newStatusMap[volumeStatus.Name] = volumeStatus
 		}
@@ -1246,6 +1281,42 @@ func (c *VirtualMachineController) updateMemoryDumpInfo(vmi *v1.VirtualMachineIn
 	return volumeStatus, needsRefresh
 }
 
+func (c *VirtualMachineController) updateBackupInfo(vmi *v1.VirtualMachineInstance, volumeStatus v1.VolumeStatus, domain *api.Domain) (v1.VolumeStatus, bool) {
+	needsRefresh := false
+	switch volumeStatus.Phase {
+	case v1.HotplugVolumeMounted:
+		needsRefresh = true
+		log.Log.Object(vmi).V(3).Infof("Backup volume %s attached, marking it in progress", volumeStatus.Name)
+		volumeStatus.Phase = v1.BackupVolumeInProgress
+		volumeStatus.Message = fmt.Sprintf("Backup Volume %s is attached, taking backup %s", volumeStatus.Name, volumeStatus.BackupVolume.BackupName)
+		volumeStatus.Reason = VolumeMountedToPodReason
+	case v1.BackupVolumeInProgress:
+		backupMetadata := domain.Spec.Metadata.KubeVirt.Backup
+		if backupMetadata == nil || backupMetadata.BackupName != volumeStatus.BackupVolume.BackupName {
+			// backup wasnt triggered yet
+			return volumeStatus, needsRefresh
+		}
+		needsRefresh = true
+		if backupMetadata.StartTimestamp != nil {
+			volumeStatus.BackupVolume.StartTimestamp = backupMetadata.StartTimestamp
+		}
+		if backupMetadata.EndTimestamp != nil && backupMetadata.Failed {
+			log.Log.Object(vmi).Errorf("Backup to pvc %s failed: %v", volumeStatus.Name, backupMetadata.FailureReason)
+			volumeStatus.Message = fmt.Sprintf("Backup to pvc %s failed: %v", volumeStatus.Name, backupMetadata.FailureReason)
+			volumeStatus.Phase = v1.BackupVolumeFailed
+			volumeStatus.BackupVolume.EndTimestamp = backupMetadata.EndTimestamp
+		} else if backupMetadata.Completed {
+			log.Log.Object(vmi).V(3).Infof("Marking backup to volume %s has completed", volumeStatus.Name)
+			volumeStatus.Phase = v1.BackupVolumeCompleted
+			volumeStatus.Message = fmt.Sprintf("Backup to Volume %s has completed successfully", volumeStatus.Name)
+			volumeStatus.Reason = VolumeReadyReason
+			volumeStatus.BackupVolume.EndTimestamp = backupMetadata.EndTimestamp
+		}
+	}
+
+	return volumeStatus, needsRefresh
+}
+
 func (c *VirtualMachineController) updateFSFreezeStatus(vmi *v1.VirtualMachineInstance, domain *api.Domain) {
 
 	if domain == nil || domain.Status.FSFreezeStatus.Status == "" {
@@ -1392,6 +1463,7 @@ func (c *VirtualMachineController) updateVMIConditions(vmi *v1.VirtualMachineIns
 		return err
 	}
 	c.updatePausedConditions(vmi, domain, condManager)
+	c.updateGuestNetworkCondition(vmi, condManager)
 
 	return nil
 }
@@ -1803,7 +1875,7 @@ func (c *VirtualMachineController) migrationTargetExecute(vmi *v1.VirtualMachine
 	// set true when the current migration target has exitted and needs to be cleaned up.
 	shouldCleanUp := false
 
-	if vmiExists && vmi.IsRunning() {
+	if vmiExists && (vmi.IsRunning() || c.isMigrationReceiver(vmi)) {
 		shouldUpdate = true
 	}
 
@@ -2131,7 +2203,17 @@ func (c *VirtualMachineController) execute(key string) error {
 	// Take different execution paths depending on the state of the migration and the
 	// node this is executed on.
 
-	if vmiExists && c.isPreMigrationTarget(vmi) {
+	if vmiExists && c.isMigrationReceiver(vmi) {
+		// 0. CROSS CLUSTER MIGRATION RECEIVER PATH
+		//
+		// The VMI is migrated from another cluster and must not be started
+		// here. Once virt-controller handed the migration over to this node,
+		// the migration target is prepared like for any other migration.
+		if vmi.Status.MigrationState == nil || vmi.Status.MigrationState.TargetNode != c.host {
+			return nil
+		}
+		return c.migrationTargetExecute(vmi, vmiExists, domain)
+	} else if vmiExists && c.isPreMigrationTarget(vmi) {
 		// 1. PRE-MIGRATION TARGET PREPARATION PATH
 		//
 		// If this node is the target of the vmi's migration, take
@@ -2181,6 +2263,7 @@ func (c *VirtualMachineController) processVmCleanup(vmi *v1.VirtualMachineInstan
 	c.teardownNetwork(vmi)
 
 	c.sriovHotplugExecutorPool.Delete(vmi.UID)
+	c.guestNetworkProber.forget(vmi.UID)
 
 	// Watch dog file and command client must be the last things removed here
 	if err := c.closeLauncherClient(vmi); err != nil {
@@ -2476,6 +2559,22 @@ func (c *VirtualMachineController) isPreMigrationTarget(vmi *v1.VirtualMachineIn
 	return false
 }
 
+// isMigrationReceiver returns true if this node hosts a VMI which waits for
+// its domain to be migrated from another cluster.
+func (c *VirtualMachineController) isMigrationReceiver(vmi *v1.VirtualMachineInstance) bool {
+	if _, ok := vmi.Annotations[v1.CrossClusterMigrationReceiverAnnotation]; !ok {
+		return false
+	}
+	return vmi.DeletionTimestamp == nil &&
+		vmi.Status.Phase == v1.Scheduled &&
+		vmi.Status.NodeName == c.host
+}
+
+func isCrossClusterMigrationReceiver(vmi *v1.VirtualMachineInstance) bool {
+	_, ok := vmi.Annotations[v1.CrossClusterMigrationReceiverAnnotation]
+	return ok && vmi.Status.MigrationState != nil && vmi.Status.MigrationState.CrossCluster != nil && vmi.Status.Phase == v1.Scheduled
+}
+
 func (c *VirtualMachineController) checkNetworkInterfacesForMigration(vmi *v1.VirtualMachineInstance) error {
 	return netvmispec.VerifyVMIMigratable(vmi, c.clusterConfig.GetNetworkBindings())
 }
@@ -2492,6 +2591,8 @@ func (c *VirtualMachineController) checkVolumesForMigration(vmi *v1.VirtualMachi
 	}
 
 	filesystems := storagetypes.GetFilesystemsFromVolumes(vmi)
+	migrationConfig := c.clusterConfig.GetMigrationConfiguration()
+	blockMigrateLocalVolumes := migrationConfig.BlockMigrateLocalVolumes != nil && *migrationConfig.BlockMigrateLocalVolumes
 
 	// Check if all VMI volumes can be shared between the source and the destination
 	// of a live migration. blockMigrate will be returned as false, only if all volumes
@@ -2514,13 +2615,21 @@ func (c *VirtualMachineController) checkVolumesForMigration(vmi *v1.VirtualMachi
 			if !ok || volumeStatus.PersistentVolumeClaimInfo == nil {
 				return true, fmt.Errorf("cannot migrate VMI: Unable to determine if PVC %v is shared, live migration requires that all PVCs must be shared (using ReadWriteMany access mode)", claimName)
 			} else if !pvctypes.HasSharedAccessMode(volumeStatus.PersistentVolumeClaimInfo.AccessModes) && !pvctypes.IsMigratedVolume(volumeStatus.Name, vmi) {
-				return true, fmt.Errorf("cannot migrate VMI: PVC %v is not shared, live migration requires that all PVCs must be shared (using ReadWriteMany access mode)", claimName)
+				// The migration controller copies local volumes to new PVCs on the target node
+				if !blockMigrateLocalVolumes || !pvctypes.IsLocalVolumeToCopy(vmi, &volume) {
+					return true, fmt.Errorf("cannot migrate VMI: PVC %v is not shared, live migration requires that all PVCs must be shared (using ReadWriteMany access mode)", claimName)
+				}
+				blockMigrate = true
 			}
 
 		} else if volSrc.HostDisk != nil {
 			shared := volSrc.HostDisk.Shared != nil && *volSrc.HostDisk.Shared
 			if !shared {
-				return true, fmt.Errorf("cannot migrate VMI with non-shared HostDisk")
+				// The target creates an empty disk image, which the migration copies the local one to
+				if !blockMigrateLocalVolumes || volSrc.HostDisk.Type != v1.HostDiskExistsOrCreate {
+					return true, fmt.Errorf("cannot migrate VMI with non-shared HostDisk")
+				}
+				blockMigrate = true
 			}
 		} else {
 			if _, ok := filesystems[volume.Name]; ok {
@@ -2594,6 +2703,10 @@ func (c *VirtualMachineController) handleTargetMigrationProxy(vmi *v1.VirtualMac
 	if err != nil {
 		return err
 	}
+	if crossCluster := vmi.Status.MigrationState.CrossCluster; crossCluster != nil {
+		// the source in the other cluster reaches the listener through the tunnel
+		return c.migrationProxy.StartTargetTunnel(string(vmi.UID), crossCluster.MigrationID)
+	}
 	return nil
 }
 
@@ -2614,6 +2727,16 @@ func (c *VirtualMachineController) handleSourceMigrationProxy(vmi *v1.VirtualMac
 	// pass in the virt-launcher's baseDir to reach the unix sockets.
 	baseDir := fmt.Sprintf(filepath.Join(c.virtLauncherFSRunDirPattern, "kubevirt"), res.Pid())
 	c.migrationProxy.StopTargetListener(string(vmi.UID))
+	if crossCluster := vmi.Status.MigrationState.CrossCluster; crossCluster != nil {
+		return c.migrationProxy.StartSourceTunnelListener(
+			string(vmi.UID),
+			crossCluster.MigrationID,
+			crossCluster.ConnectURL,
+			migrationproxy.GetMigrationPortsList(vmi.IsBlockMigration()),
+			baseDir,
+			migrationBandwidth(vmi),
+		)
+	}
 	if vmi.Status.MigrationState.TargetDirectMigrationNodePorts == nil {
 		msg := "No migration proxy has been created for this vmi"
 		return fmt.Errorf("%s", msg)
@@ -2623,6 +2746,7 @@ func (c *VirtualMachineController) handleSourceMigrationProxy(vmi *v1.VirtualMac
 		vmi.Status.MigrationState.TargetNodeAddress,
 		vmi.Status.MigrationState.TargetDirectMigrationNodePorts,
 		baseDir,
+		migrationBandwidth(vmi),
 	)
 	if err != nil {
 		return err
@@ -2631,6 +2755,29 @@ func (c *VirtualMachineController) handleSourceMigrationProxy(vmi *v1.VirtualMac
 	return nil
 }
 
+// migrationIpAddressForVMI returns the address of the node on the network the migration of the vmi
+// is configured to use, or the address on the default migration network
+func (c *VirtualMachineController) migrationIpAddressForVMI(vmi *v1.VirtualMachineInstance) string {
+	if vmi.Status.MigrationState != nil && vmi.Status.MigrationState.MigrationConfiguration != nil {
+		if network := vmi.Status.MigrationState.MigrationConfiguration.Network; network != nil {
+			if address, exists := c.migrationNetworkIpAddresses[*network]; exists {
+				return address
+			}
+			log.Log.Object(vmi).Warningf("virt-handler is not connected to the migration network %s, falling back to the default migration network", *network)
+		}
+	}
+	return c.migrationIpAddress
+}
+
+// migrationBandwidth returns the bandwidth in bytes per second the migration of the vmi is allowed to use, or 0 for no limit
+func migrationBandwidth(vmi *v1.VirtualMachineInstance) int64 {
+	if vmi.Status.MigrationState == nil || vmi.Status.MigrationState.MigrationConfiguration == nil ||
+		vmi.Status.MigrationState.MigrationConfiguration.BandwidthPerMigration == nil {
+		return 0
+	}
+	return vmi.Status.MigrationState.MigrationConfiguration.BandwidthPerMigration.Value()
+}
+
 func (c *VirtualMachineController) getLauncherClientInfo(vmi *v1.VirtualMachineInstance) *virtcache.LauncherClientInfo {
 	launcherInfo, exists := c.launcherClients.Load(vmi.UID)
 	if !exists {
@@ -2695,7 +2842,17 @@ func (c *VirtualMachineController) vmUpdateHelperMigrationSource(origVMI *v1.Vir
 			AllowWorkloadDisruption: *migrationConfiguration.AllowWorkloadDisruption,
 		}
 
-		configureParallelMigrationThreads(options, origVMI)
+		if migrationConfiguration.Compression != nil {
+			options.Compression = *migrationConfiguration.Compression
+		}
+		if migrationConfiguration.XBZRLECacheSize != nil {
+			options.XBZRLECacheSize = *migrationConfiguration.XBZRLECacheSize
+		}
+		if migrationConfiguration.MaxDowntimeMilliseconds != nil {
+			options.MaxDowntimeMilliseconds = *migrationConfiguration.MaxDowntimeMilliseconds
+		}
+
+		configureParallelMigrationThreads(options, origVMI, migrationConfiguration.ParallelMigrationThreads)
 
 		marshalledOptions, err := json.Marshal(options)
 		if err != nil {
@@ -3116,6 +3273,10 @@ func (c *VirtualMachineController) vmUpdateHelperDefault(origVMI *v1.VirtualMach
 			return err
 		}
 
+		if err := c.handleBackup(vmi); err != nil {
+			return err
+		}
+
 		isolationRes, err := c.podIsolationDetector.Detect(vmi)
 		if err != nil {
 			return fmt.Errorf(failedDetectIsolationFmt, err)
@@ -3125,6 +3286,8 @@ func (c *VirtualMachineController) vmUpdateHelperDefault(origVMI *v1.VirtualMach
 			return err
 		}
 
+		c.netConf.EnsureRouterAdvertisers(vmi, isolationRes.Pid())
+
 		netsToHotplug := netvmispec.NetworksToHotplugWhosePodIfacesAreReady(vmi)
 		nonAbsentIfaces := netvmispec.FilterInterfacesSpec(vmi.Spec.Domain.Devices.Interfaces, func(iface v1.Interface) bool {
 			return iface.State != v1.InterfaceStateAbsent
@@ -3194,18 +3357,17 @@ func (c *VirtualMachineController) hotplugSriovInterfaces(vmi *v1.VirtualMachine
 	sriovSpecInterfaces := netvmispec.FilterSRIOVInterfaces(vmi.Spec.Domain.Devices.Interfaces)
 
 	sriovSpecIfacesNames := netvmispec.IndexInterfaceSpecByName(sriovSpecInterfaces)
-	attachedSriovStatusIfaces := netvmispec.IndexInterfaceStatusByName(vmi.Status.Interfaces, func(iface v1.VirtualMachineInstanceNetworkInterface) bool {
-		_, exist := sriovSpecIfacesNames[iface.Name]
-		return exist && netvmispec.ContainsInfoSource(iface.InfoSource, netvmispec.InfoSourceDomain) &&
-			netvmispec.ContainsInfoSource(iface.InfoSource, netvmispec.InfoSourceMultusStatus)
+	sriovIfacesToAttachOrDetach := netvmispec.IndexInterfaceStatusByName(vmi.Status.Interfaces, func(iface v1.VirtualMachineInstanceNetworkInterface) bool {
+		specIface, exist := sriovSpecIfacesNames[iface.Name]
+		if !exist || !netvmispec.ContainsInfoSource(iface.InfoSource, netvmispec.InfoSourceMultusStatus) {
+			return false
+		}
+		isAttached := netvmispec.ContainsInfoSource(iface.InfoSource, netvmispec.InfoSourceDomain)
+		isAbsent := specIface.State == v1.InterfaceStateAbsent
+		return isAttached == isAbsent
 	})
 
-	desiredSriovMultusPluggedIfaces := netvmispec.IndexInterfaceStatusByName(vmi.Status.Interfaces, func(iface v1.VirtualMachineInstanceNetworkInterface) bool {
-		_, exist := sriovSpecIfacesNames[iface.Name]
-		return exist && netvmispec.ContainsInfoSource(iface.InfoSource, netvmispec.InfoSourceMultusStatus)
-	})
-
-	if len(desiredSriovMultusPluggedIfaces) == len(attachedSriovStatusIfaces) {
+	if len(sriovIfacesToAttachOrDetach) == 0 {
 		c.sriovHotplugExecutorPool.Delete(vmi.UID)
 		return nil
 	}
@@ -3265,6 +3427,59 @@ func (c *VirtualMachineController) getMemoryDump(vmi *v1.VirtualMachineInstance)
 	return nil
 }
 
+func backupOptions(volumeStatus v1.VolumeStatus, source *v1.BackupVolumeSource) *cmdclient.BackupOptions {
+	options := &cmdclient.BackupOptions{
+		TargetDir:       hotplugdisk.GetVolumeMountDir(volumeStatus.Name),
+		BackupName:      volumeStatus.BackupVolume.BackupName,
+		IncrementalFrom: source.IncrementalFrom,
+		Format:          source.Format,
+	}
+	if options.Format == "" {
+		options.Format = v1.BackupFormatQCOW2
+	}
+	return options
+}
+
+func backupVolumeSource(vmi *v1.VirtualMachineInstance, volumeName string) *v1.BackupVolumeSource {
+	for _, volume := range vmi.Spec.Volumes {
+		if volume.Name == volumeName {
+			return volume.Backup
+		}
+	}
+	return nil
+}
+
+func (c *VirtualMachineController) handleBackup(vmi *v1.VirtualMachineInstance) error {
+	const errMsgPrefix = "failed to handle backup"
+
+	for _, volumeStatus := range vmi.Status.VolumeStatus {
+		if volumeStatus.BackupVolume == nil || volumeStatus.Phase != v1.BackupVolumeInProgress {
+			continue
+		}
+		client, err := c.getVerifiedLauncherClient(vmi)
+		if err != nil {
+			return fmt.Errorf("%s: %v", errMsgPrefix, err)
+		}
+
+		source := backupVolumeSource(vmi, volumeStatus.Name)
+		if source == nil {
+			// the backup volume is being removed, stop writing to it
+			log.Log.V(3).Object(vmi).Info("sending abort backup command")
+			if err = client.AbortVirtualMachineBackup(vmi); err != nil {
+				return fmt.Errorf("%s: %v", errMsgPrefix, err)
+			}
+			continue
+		}
+
+		log.Log.V(3).Object(vmi).Info("sending backup command")
+		if err = client.BackupVirtualMachine(vmi, backupOptions(volumeStatus, source)); err != nil {
+			return fmt.Errorf("%s: %v", errMsgPrefix, err)
+		}
+	}
+
+	return nil
+}
+
 func (c *VirtualMachineController) processVmUpdate(vmi *v1.VirtualMachineInstance, domain *api.Domain) error {
 
 	isUnresponsive, isInitialized, err := c.isLauncherClientUnresponsive(vmi)
@@ -3280,7 +3495,7 @@ func (c *VirtualMachineController) processVmUpdate(vmi *v1.VirtualMachineInstanc
 
 	c.handlePostMigrationProxyCleanup(vmi)
 
-	if c.isPreMigrationTarget(vmi) {
+	if c.isPreMigrationTarget(vmi) || c.isMigrationReceiver(vmi) {
 		return c.vmUpdateHelperMigrationTarget(vmi)
 	} else if c.isMigrationSource(vmi) {
 		return c.vmUpdateHelperMigrationSource(vmi, domain)
@@ -3696,7 +3911,15 @@ func (c *VirtualMachineController) updateMemoryInfo(vmi *v1.VirtualMachineInstan
 	return nil
 }
 
-func configureParallelMigrationThreads(options *cmdclient.MigrationOptions, vm *v1.VirtualMachineInstance) {
+func configureParallelMigrationThreads(options *cmdclient.MigrationOptions, vm *v1.VirtualMachineInstance, configuredThreads *uint32) {
+	// An explicitly configured number of threads is always respected
+	if configuredThreads != nil {
+		if *configuredThreads > 0 {
+			options.ParallelMigrationThreads = pointer.P(uint(*configuredThreads))
+		}
+		return
+	}
+
 	// When the CPU is limited, there's a risk of the migration threads choking the CPU resources on the compute container.
 	// For this reason, we will avoid configuring migration threads in such scenarios.
 	if cpuLimit, cpuLimitExists := vm.Spec.Domain.Resources.Limits[k8sv1.ResourceCPU]; cpuLimitExists && !cpuLimit.IsZero() {
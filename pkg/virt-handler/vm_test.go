@@ -205,6 +205,7 @@ var _ = Describe("VirtualMachineInstance", func() {
 			virtClient,
 			host,
 			podIpAddress,
+			nil,
 			shareDir,
 			privateDir,
 			podsDir,
@@ -1054,6 +1055,26 @@ var _ = Describe("VirtualMachineInstance", func() {
 			Expect(updatedVMI.Status.Phase).To(Equal(v1.Failed))
 		})
 
+		It("should restore the router advertisers of a running VirtualMachineInstance", func() {
+			vmi := api2.NewMinimalVMI("testvmi")
+			vmi.UID = vmiTestUUID
+			vmi.Status.Phase = v1.Running
+			domain := api.NewMinimalDomainWithUUID("testvmi", vmiTestUUID)
+			domain.Status.Status = api.Running
+			vmiFeeder.Add(vmi)
+			domainFeeder.Add(domain)
+			createVMI(vmi)
+			netConf := &netConfStub{}
+			controller.netConf = netConf
+
+			mockHotplugVolumeMounter.EXPECT().Unmount(gomock.Any(), mockCgroupManager).Return(nil)
+			mockHotplugVolumeMounter.EXPECT().Mount(gomock.Any(), mockCgroupManager).Return(nil)
+			client.EXPECT().SyncVirtualMachine(vmi, gomock.Any())
+
+			sanityExecute()
+			Expect(netConf.routerAdvertisersEnsuredFor).To(Equal(vmi.UID))
+		})
+
 		It("should remove an error condition if a synchronization run succeeds", func() {
 			vmi := api2.NewMinimalVMI("testvmi")
 			vmi.UID = vmiTestUUID
@@ -1579,6 +1600,121 @@ var _ = Describe("VirtualMachineInstance", func() {
 
 		})
 
+		Context("backup status events", func() {
+			newBackupVMI := func(phase v1.VolumePhase) *v1.VirtualMachineInstance {
+				vmi := api2.NewMinimalVMI("testvmi")
+				vmi.UID = vmiTestUUID
+				vmi.Status.Phase = v1.Running
+				vmi.Spec.Volumes = append(vmi.Spec.Volumes, v1.Volume{
+					Name: "test",
+				})
+				vmi.Status.VolumeStatus = append(vmi.Status.VolumeStatus, v1.VolumeStatus{
+					Name:    "test",
+					Phase:   phase,
+					Reason:  "reason",
+					Message: "message",
+					HotplugVolume: &v1.HotplugVolumeStatus{
+						AttachPodName: "testpod",
+						AttachPodUID:  "1234",
+					},
+					BackupVolume: &v1.DomainBackupInfo{
+						ClaimName:  "test",
+						BackupName: "backup-1",
+					},
+				})
+				return vmi
+			}
+
+			It("Should trigger backup and generate InProgress event once mounted", func() {
+				vmi := newBackupVMI(v1.HotplugVolumeMounted)
+				domain := api.NewMinimalDomainWithUUID("testvmi", vmiTestUUID)
+				domain.Status.Status = api.Running
+				vmiFeeder.Add(vmi)
+				domainFeeder.Add(domain)
+
+				mockHotplugVolumeMounter.EXPECT().IsMounted(vmi, "test", gomock.Any()).Return(true, nil)
+				hasHotplug := controller.updateVolumeStatusesFromDomain(vmi, domain)
+				Expect(hasHotplug).To(BeTrue())
+
+				Expect(vmi.Status.VolumeStatus[0].Phase).To(Equal(v1.BackupVolumeInProgress))
+				testutils.ExpectEvent(recorder, "Backup Volume test is attached, taking backup backup-1")
+				By("Calling it again with updated status, no new events are generated as long as backup not completed")
+				mockHotplugVolumeMounter.EXPECT().IsMounted(vmi, "test", gomock.Any()).Return(true, nil)
+				controller.updateVolumeStatusesFromDomain(vmi, domain)
+			})
+
+			It("Should ignore backup metadata of a previous backup", func() {
+				vmi := newBackupVMI(v1.BackupVolumeInProgress)
+				domain := api.NewMinimalDomainWithUUID("testvmi", vmiTestUUID)
+				now := metav1.Now()
+				domain.Spec.Metadata.KubeVirt.Backup = &api.BackupMetadata{
+					BackupName:     "backup-0",
+					StartTimestamp: &now,
+					EndTimestamp:   &now,
+					Completed:      true,
+				}
+				domain.Status.Status = api.Running
+				vmiFeeder.Add(vmi)
+				domainFeeder.Add(domain)
+
+				mockHotplugVolumeMounter.EXPECT().IsMounted(vmi, "test", gomock.Any()).Return(true, nil)
+				controller.updateVolumeStatusesFromDomain(vmi, domain)
+
+				Expect(vmi.Status.VolumeStatus[0].Phase).To(Equal(v1.BackupVolumeInProgress))
+				Expect(vmi.Status.VolumeStatus[0].BackupVolume.StartTimestamp).To(BeNil())
+			})
+
+			It("Should generate backup completed event once backup completed", func() {
+				vmi := newBackupVMI(v1.BackupVolumeInProgress)
+				domain := api.NewMinimalDomainWithUUID("testvmi", vmiTestUUID)
+				now := metav1.Now()
+				domain.Spec.Metadata.KubeVirt.Backup = &api.BackupMetadata{
+					BackupName:     "backup-1",
+					StartTimestamp: &now,
+					EndTimestamp:   &now,
+					Completed:      true,
+				}
+				domain.Status.Status = api.Running
+				vmiFeeder.Add(vmi)
+				domainFeeder.Add(domain)
+
+				mockHotplugVolumeMounter.EXPECT().IsMounted(vmi, "test", gomock.Any()).Return(true, nil)
+				hasHotplug := controller.updateVolumeStatusesFromDomain(vmi, domain)
+				Expect(hasHotplug).To(BeTrue())
+
+				Expect(vmi.Status.VolumeStatus[0].Phase).To(Equal(v1.BackupVolumeCompleted))
+				Expect(vmi.Status.VolumeStatus[0].BackupVolume.StartTimestamp).ToNot(BeNil())
+				Expect(vmi.Status.VolumeStatus[0].BackupVolume.EndTimestamp).ToNot(BeNil())
+				testutils.ExpectEvent(recorder, "Backup to Volume test has completed successfully")
+			})
+
+			It("Should generate backup failed event if backup failed", func() {
+				vmi := newBackupVMI(v1.BackupVolumeInProgress)
+				domain := api.NewMinimalDomainWithUUID("testvmi", vmiTestUUID)
+				now := metav1.Now()
+				failureReason := "backup failed"
+				domain.Spec.Metadata.KubeVirt.Backup = &api.BackupMetadata{
+					BackupName:     "backup-1",
+					StartTimestamp: &now,
+					EndTimestamp:   &now,
+					Completed:      true,
+					Failed:         true,
+					FailureReason:  failureReason,
+				}
+				domain.Status.Status = api.Running
+				vmiFeeder.Add(vmi)
+				domainFeeder.Add(domain)
+
+				mockHotplugVolumeMounter.EXPECT().IsMounted(vmi, "test", gomock.Any()).Return(true, nil)
+				hasHotplug := controller.updateVolumeStatusesFromDomain(vmi, domain)
+				Expect(hasHotplug).To(BeTrue())
+
+				Expect(vmi.Status.VolumeStatus[0].Phase).To(Equal(v1.BackupVolumeFailed))
+				Expect(vmi.Status.VolumeStatus[0].BackupVolume.EndTimestamp).ToNot(BeNil())
+				testutils.ExpectEvent(recorder, fmt.Sprintf("Backup to pvc test failed: %s", failureReason))
+			})
+		})
+
 		DescribeTable("should leave the VirtualMachineInstance alone if it is in the final phase", func(phase v1.VirtualMachineInstancePhase) {
 			vmi := api2.NewMinimalVMI("testvmi")
 			vmi.Status.Phase = phase
@@ -1721,6 +1857,19 @@ var _ = Describe("VirtualMachineInstance", func() {
 			sanityExecute()
 		})
 
+		DescribeTable("should advertise the address of the migration network", func(network *string, expectedAddress string) {
+			controller.migrationNetworkIpAddresses = map[string]string{"storage-migration-net": "10.20.30.40"}
+			vmi := api2.NewMinimalVMI("testvmi")
+			vmi.Status.MigrationState = &v1.VirtualMachineInstanceMigrationState{
+				MigrationConfiguration: &v1.MigrationConfiguration{Network: network},
+			}
+			Expect(controller.migrationIpAddressForVMI(vmi)).To(Equal(expectedAddress))
+		},
+			Entry("selected for the migration", pointer.P("storage-migration-net"), "10.20.30.40"),
+			Entry("by default", nil, "10.10.10.10"),
+			Entry("by default if the selected network is not attached", pointer.P("other-net"), "10.10.10.10"),
+		)
+
 		// handles case where a failed migration to this node has left overs still on local storage
 		It("should clean stale clients when preparing migration target", func() {
 			vmi := api2.NewMinimalVMI("testvmi")
@@ -1976,6 +2125,64 @@ var _ = Describe("VirtualMachineInstance", func() {
 			Expect(updatedVMI.Status.CurrentCPUTopology).To(BeNil())
 		})
 
+		Context("receiving a VMI from another cluster", func() {
+			newReceiverVMI := func() *v1.VirtualMachineInstance {
+				vmi := api2.NewMinimalVMI("testvmi")
+				vmi.UID = vmiTestUUID
+				vmi.ObjectMeta.ResourceVersion = "1"
+				vmi.Annotations = map[string]string{v1.CrossClusterMigrationReceiverAnnotation: "migration-1"}
+				vmi.Labels = map[string]string{v1.NodeNameLabel: host}
+				vmi.Status.Phase = v1.Scheduled
+				vmi.Status.NodeName = host
+				return vmi
+			}
+
+			It("should not start the VMI before the migration is handed over to the node", func() {
+				vmi := newReceiverVMI()
+				vmiFeeder.Add(vmi)
+
+				// no interaction with virt-launcher is expected
+				sanityExecute()
+				Expect(mockQueue.Len()).To(Equal(0))
+			})
+
+			It("should take over the VMI once the migrated domain runs", func() {
+				vmi := newReceiverVMI()
+				pastTime := metav1.NewTime(metav1.Now().Add(time.Duration(-10) * time.Second))
+				vmi.Status.MigrationState = &v1.VirtualMachineInstanceMigrationState{
+					TargetNode:        host,
+					TargetNodeAddress: "127.0.0.1",
+					MigrationUID:      "123",
+					StartTimestamp:    &pastTime,
+					CrossCluster:      &v1.VirtualMachineInstanceCrossClusterMigrationState{MigrationID: "migration-1"},
+				}
+
+				domain := api.NewMinimalDomainWithUUID("testvmi", vmiTestUUID)
+				domain.Status.Status = api.Running
+				domain.Spec.Metadata.KubeVirt.Migration = &api.MigrationMetadata{
+					UID:            "123",
+					StartTimestamp: &pastTime,
+				}
+
+				domainFeeder.Add(domain)
+				vmiFeeder.Add(vmi)
+				createVMI(vmi)
+
+				client.EXPECT().Ping().AnyTimes()
+				client.EXPECT().FinalizeVirtualMachineMigration(gomock.Any(), gomock.Any())
+
+				sanityExecute()
+
+				updatedVMI, err := virtfakeClient.KubevirtV1().VirtualMachineInstances(metav1.NamespaceDefault).Get(context.TODO(), vmi.Name, metav1.GetOptions{})
+				Expect(err).NotTo(HaveOccurred())
+				Expect(updatedVMI.Status.Phase).To(Equal(v1.Running))
+				Expect(updatedVMI.Status.MigrationState.TargetNodeDomainReadyTimestamp).ToNot(BeNil())
+				Expect(updatedVMI.Status.MigrationState.Completed).To(BeTrue())
+				Expect(updatedVMI.Status.MigrationState.EndTimestamp).ToNot(BeNil())
+				testutils.ExpectEvent(recorder, "migrated from another cluster")
+			})
+		})
+
 		It("should hotplug CPU in post-migration when target pod has the required conditions", func() {
 			vmi := api2.NewMinimalVMI("testvmi")
 			vmi.UID = vmiTestUUID
@@ -2566,6 +2773,80 @@ var _ = Describe("VirtualMachineInstance", func() {
 			Expect(blockMigrate).To(BeTrue())
 			Expect(err).To(Equal(fmt.Errorf("cannot migrate VMI with non-shared HostDisk")))
 		})
+		Context("with block migration of local volumes", func() {
+			BeforeEach(func() {
+				controller.clusterConfig, _, _ = testutils.NewFakeClusterConfigUsingKVConfig(&v1.KubeVirtConfiguration{
+					MigrationConfiguration: &v1.MigrationConfiguration{BlockMigrateLocalVolumes: pointer.P(true)},
+				})
+			})
+
+			newVMIWithVolume := func(volumeSource v1.VolumeSource) *v1.VirtualMachineInstance {
+				vmi := api2.NewMinimalVMI("testvmi")
+				vmi.Spec.Domain.Devices.Disks = []v1.Disk{{
+					Name:       "myvolume",
+					DiskDevice: v1.DiskDevice{Disk: &v1.DiskTarget{Bus: v1.DiskBusVirtio}},
+				}}
+				vmi.Spec.Volumes = []v1.Volume{{Name: "myvolume", VolumeSource: volumeSource}}
+				return vmi
+			}
+
+			It("should block migrate non-shared PVCs", func() {
+				vmi := newVMIWithVolume(v1.VolumeSource{
+					PersistentVolumeClaim: &v1.PersistentVolumeClaimVolumeSource{PersistentVolumeClaimVolumeSource: k8sv1.PersistentVolumeClaimVolumeSource{
+						ClaimName: "testblock",
+					}},
+				})
+				vmi.Status.VolumeStatus = []v1.VolumeStatus{{
+					Name: "myvolume",
+					PersistentVolumeClaimInfo: &v1.PersistentVolumeClaimInfo{
+						AccessModes: []k8sv1.PersistentVolumeAccessMode{k8sv1.ReadWriteOnce},
+					},
+				}}
+
+				blockMigrate, err := controller.checkVolumesForMigration(vmi)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(blockMigrate).To(BeTrue())
+			})
+
+			It("should not migrate non-shared PVCs used as LUN", func() {
+				vmi := newVMIWithVolume(v1.VolumeSource{
+					PersistentVolumeClaim: &v1.PersistentVolumeClaimVolumeSource{PersistentVolumeClaimVolumeSource: k8sv1.PersistentVolumeClaimVolumeSource{
+						ClaimName: "testblock",
+					}},
+				})
+				vmi.Spec.Domain.Devices.Disks[0].DiskDevice = v1.DiskDevice{LUN: &v1.LunTarget{}}
+				vmi.Status.VolumeStatus = []v1.VolumeStatus{{
+					Name: "myvolume",
+					PersistentVolumeClaimInfo: &v1.PersistentVolumeClaimInfo{
+						AccessModes: []k8sv1.PersistentVolumeAccessMode{k8sv1.ReadWriteOnce},
+					},
+				}}
+
+				_, err := controller.checkVolumesForMigration(vmi)
+				Expect(err).To(MatchError(ContainSubstring("PVC testblock is not shared")))
+			})
+
+			DescribeTable("with a non-shared HostDisk", func(diskType v1.HostDiskType, migratable bool) {
+				vmi := newVMIWithVolume(v1.VolumeSource{
+					HostDisk: &v1.HostDisk{
+						Path:     "/var/run/kubevirt-private/vmi-disks/volume3/disk.img",
+						Type:     diskType,
+						Capacity: resource.MustParse("1Gi"),
+					},
+				})
+
+				blockMigrate, err := controller.checkVolumesForMigration(vmi)
+				Expect(blockMigrate).To(BeTrue())
+				if migratable {
+					Expect(err).ToNot(HaveOccurred())
+				} else {
+					Expect(err).To(MatchError("cannot migrate VMI with non-shared HostDisk"))
+				}
+			},
+				Entry("should block migrate a disk the target can create", v1.HostDiskExistsOrCreate, true),
+				Entry("should not migrate a disk which has to exist on the target", v1.HostDiskExists, false),
+			)
+		})
 		DescribeTable("with host model", func(hostCpuModel string) {
 			vmi := api2.NewMinimalVMI("testvmi")
 			vmi.Spec.Domain.CPU = &v1.CPU{Model: v1.CPUModeHostModel}
@@ -3379,6 +3660,52 @@ var _ = Describe("VirtualMachineInstance", func() {
 				controller.Execute()
 				testutils.ExpectEvent(recorder, VMIMigrating)
 			})
+
+			Context("with a migration policy", func() {
+				BeforeEach(func() {
+					clusterConfig, _, _ := testutils.NewFakeClusterConfigUsingKV(&v1.KubeVirt{})
+					vmi.Status.MigrationState.MigrationConfiguration = clusterConfig.GetMigrationConfiguration()
+				})
+
+				It("should use the configured number of threads even if CPU is limited", func() {
+					vmi.Spec.Domain.Resources.Limits[k8sv1.ResourceCPU] = resource.MustParse("4")
+					vmi.Status.MigrationState.MigrationConfiguration.ParallelMigrationThreads = pointer.P(uint32(4))
+
+					client.EXPECT().MigrateVirtualMachine(gomock.Any(), gomock.Any()).Do(func(_ *v1.VirtualMachineInstance, options *cmdclient.MigrationOptions) {
+						Expect(options.ParallelMigrationThreads).To(HaveValue(BeEquivalentTo(4)))
+					}).Times(1).Return(nil)
+
+					controller.Execute()
+					testutils.ExpectEvent(recorder, VMIMigrating)
+				})
+
+				It("should not configure multiple threads if zero threads are configured", func() {
+					vmi.Status.MigrationState.MigrationConfiguration.ParallelMigrationThreads = pointer.P(uint32(0))
+
+					client.EXPECT().MigrateVirtualMachine(gomock.Any(), gomock.Any()).Do(func(_ *v1.VirtualMachineInstance, options *cmdclient.MigrationOptions) {
+						Expect(options.ParallelMigrationThreads).To(BeNil())
+					}).Times(1).Return(nil)
+
+					controller.Execute()
+					testutils.ExpectEvent(recorder, VMIMigrating)
+				})
+
+				It("should pass compression and downtime settings", func() {
+					xbzrleCacheSize := resource.MustParse("256Mi")
+					vmi.Status.MigrationState.MigrationConfiguration.Compression = pointer.P(v1.MigrationCompressionZstd)
+					vmi.Status.MigrationState.MigrationConfiguration.XBZRLECacheSize = &xbzrleCacheSize
+					vmi.Status.MigrationState.MigrationConfiguration.MaxDowntimeMilliseconds = pointer.P(uint64(300))
+
+					client.EXPECT().MigrateVirtualMachine(gomock.Any(), gomock.Any()).Do(func(_ *v1.VirtualMachineInstance, options *cmdclient.MigrationOptions) {
+						Expect(options.Compression).To(Equal(v1.MigrationCompressionZstd))
+						Expect(options.XBZRLECacheSize.Equal(xbzrleCacheSize)).To(BeTrue())
+						Expect(options.MaxDowntimeMilliseconds).To(BeEquivalentTo(300))
+					}).Times(1).Return(nil)
+
+					controller.Execute()
+					testutils.ExpectEvent(recorder, VMIMigrating)
+				})
+			})
 		})
 	})
 
@@ -3621,6 +3948,8 @@ func NewScheduledVMI(vmiUID types.UID, podUID types.UID, hostname string) *v1.Vi
 type netConfStub struct {
 	vmiUID     types.UID
 	SetupError error
+
+	routerAdvertisersEnsuredFor types.UID
 }
 
 func (nc *netConfStub) Setup(vmi *v1.VirtualMachineInstance, _ []v1.Network, launcherPid int, preSetup func() error) error {
@@ -3635,6 +3964,10 @@ func (nc *netConfStub) Teardown(vmi *v1.VirtualMachineInstance) error {
 	return nil
 }
 
+func (nc *netConfStub) EnsureRouterAdvertisers(vmi *v1.VirtualMachineInstance, _ int) {
+	nc.routerAdvertisersEnsuredFor = vmi.UID
+}
+
 func (nc *netConfStub) HotUnplugInterfaces(vmi *v1.VirtualMachineInstance) error {
 	return nil
 }
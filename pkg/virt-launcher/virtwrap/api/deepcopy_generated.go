@@ -180,6 +180,30 @@ func (in *BackingStoreFormat) DeepCopy() *BackingStoreFormat {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BackupMetadata) DeepCopyInto(out *BackupMetadata) {
+	*out = *in
+	if in.StartTimestamp != nil {
+		in, out := &in.StartTimestamp, &out.StartTimestamp
+		*out = (*in).DeepCopy()
+	}
+	if in.EndTimestamp != nil {
+		in, out := &in.EndTimestamp, &out.EndTimestamp
+		*out = (*in).DeepCopy()
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BackupMetadata.
+func (in *BackupMetadata) DeepCopy() *BackupMetadata {
+	if in == nil {
+		return nil
+	}
+	out := new(BackupMetadata)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *BandWidth) DeepCopyInto(out *BandWidth) {
 	*out = *in
@@ -1080,6 +1104,160 @@ func (in *Domain) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DomainBackup) DeepCopyInto(out *DomainBackup) {
+	*out = *in
+	out.XMLName = in.XMLName
+	in.Disks.DeepCopyInto(&out.Disks)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DomainBackup.
+func (in *DomainBackup) DeepCopy() *DomainBackup {
+	if in == nil {
+		return nil
+	}
+	out := new(DomainBackup)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DomainBackupDisk) DeepCopyInto(out *DomainBackupDisk) {
+	*out = *in
+	if in.Driver != nil {
+		in, out := &in.Driver, &out.Driver
+		*out = new(DomainBackupDiskDriver)
+		**out = **in
+	}
+	if in.Target != nil {
+		in, out := &in.Target, &out.Target
+		*out = new(DomainBackupDiskTarget)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DomainBackupDisk.
+func (in *DomainBackupDisk) DeepCopy() *DomainBackupDisk {
+	if in == nil {
+		return nil
+	}
+	out := new(DomainBackupDisk)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DomainBackupDiskDriver) DeepCopyInto(out *DomainBackupDiskDriver) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DomainBackupDiskDriver.
+func (in *DomainBackupDiskDriver) DeepCopy() *DomainBackupDiskDriver {
+	if in == nil {
+		return nil
+	}
+	out := new(DomainBackupDiskDriver)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DomainBackupDiskTarget) DeepCopyInto(out *DomainBackupDiskTarget) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DomainBackupDiskTarget.
+func (in *DomainBackupDiskTarget) DeepCopy() *DomainBackupDiskTarget {
+	if in == nil {
+		return nil
+	}
+	out := new(DomainBackupDiskTarget)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DomainBackupDisks) DeepCopyInto(out *DomainBackupDisks) {
+	*out = *in
+	if in.Disks != nil {
+		in, out := &in.Disks, &out.Disks
+		*out = make([]DomainBackupDisk, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DomainBackupDisks.
+func (in *DomainBackupDisks) DeepCopy() *DomainBackupDisks {
+	if in == nil {
+		return nil
+	}
+	out := new(DomainBackupDisks)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DomainCheckpoint) DeepCopyInto(out *DomainCheckpoint) {
+	*out = *in
+	out.XMLName = in.XMLName
+	in.Disks.DeepCopyInto(&out.Disks)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DomainCheckpoint.
+func (in *DomainCheckpoint) DeepCopy() *DomainCheckpoint {
+	if in == nil {
+		return nil
+	}
+	out := new(DomainCheckpoint)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DomainCheckpointDisk) DeepCopyInto(out *DomainCheckpointDisk) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DomainCheckpointDisk.
+func (in *DomainCheckpointDisk) DeepCopy() *DomainCheckpointDisk {
+	if in == nil {
+		return nil
+	}
+	out := new(DomainCheckpointDisk)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DomainCheckpointDisks) DeepCopyInto(out *DomainCheckpointDisks) {
+	*out = *in
+	if in.Disks != nil {
+		in, out := &in.Disks, &out.Disks
+		*out = make([]DomainCheckpointDisk, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DomainCheckpointDisks.
+func (in *DomainCheckpointDisks) DeepCopy() *DomainCheckpointDisks {
+	if in == nil {
+		return nil
+	}
+	out := new(DomainCheckpointDisks)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *DomainGuestInfo) DeepCopyInto(out *DomainGuestInfo) {
 	*out = *in
@@ -1146,6 +1324,78 @@ func (in *DomainList) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DomainSnapshot) DeepCopyInto(out *DomainSnapshot) {
+	*out = *in
+	out.XMLName = in.XMLName
+	out.Memory = in.Memory
+	in.Disks.DeepCopyInto(&out.Disks)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DomainSnapshot.
+func (in *DomainSnapshot) DeepCopy() *DomainSnapshot {
+	if in == nil {
+		return nil
+	}
+	out := new(DomainSnapshot)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DomainSnapshotDisk) DeepCopyInto(out *DomainSnapshotDisk) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DomainSnapshotDisk.
+func (in *DomainSnapshotDisk) DeepCopy() *DomainSnapshotDisk {
+	if in == nil {
+		return nil
+	}
+	out := new(DomainSnapshotDisk)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DomainSnapshotDisks) DeepCopyInto(out *DomainSnapshotDisks) {
+	*out = *in
+	if in.Disks != nil {
+		in, out := &in.Disks, &out.Disks
+		*out = make([]DomainSnapshotDisk, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DomainSnapshotDisks.
+func (in *DomainSnapshotDisks) DeepCopy() *DomainSnapshotDisks {
+	if in == nil {
+		return nil
+	}
+	out := new(DomainSnapshotDisks)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DomainSnapshotMemory) DeepCopyInto(out *DomainSnapshotMemory) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DomainSnapshotMemory.
+func (in *DomainSnapshotMemory) DeepCopy() *DomainSnapshotMemory {
+	if in == nil {
+		return nil
+	}
+	out := new(DomainSnapshotMemory)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *DomainSpec) DeepCopyInto(out *DomainSpec) {
 	*out = *in
@@ -2238,6 +2488,11 @@ func (in *KubeVirtMetadata) DeepCopyInto(out *KubeVirtMetadata) {
 		*out = new(MemoryDumpMetadata)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Backup != nil {
+		in, out := &in.Backup, &out.Backup
+		*out = new(BackupMetadata)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
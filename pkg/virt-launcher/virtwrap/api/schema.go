@@ -390,6 +390,7 @@ type KubeVirtMetadata struct {
 	Migration        *MigrationMetadata        `xml:"migration,omitempty"`
 	AccessCredential *AccessCredentialMetadata `xml:"accessCredential,omitempty"`
 	MemoryDump       *MemoryDumpMetadata       `xml:"memoryDump,omitempty"`
+	Backup           *BackupMetadata           `xml:"backup,omitempty"`
 }
 
 type AccessCredentialMetadata struct {
@@ -406,15 +407,25 @@ type MemoryDumpMetadata struct {
 	FailureReason  string       `xml:"failureReason,omitempty"`
 }
 
+type BackupMetadata struct {
+	BackupName     string       `xml:"backupName,omitempty"`
+	StartTimestamp *metav1.Time `xml:"startTimestamp,omitempty"`
+	EndTimestamp   *metav1.Time `xml:"endTimestamp,omitempty"`
+	Completed      bool         `xml:"completed,omitempty"`
+	Failed         bool         `xml:"failed,omitempty"`
+	FailureReason  string       `xml:"failureReason,omitempty"`
+}
+
 type MigrationMetadata struct {
-	UID            types.UID        `xml:"uid,omitempty"`
-	StartTimestamp *metav1.Time     `xml:"startTimestamp,omitempty"`
-	EndTimestamp   *metav1.Time     `xml:"endTimestamp,omitempty"`
-	Completed      bool             `xml:"completed,omitempty"`
-	Failed         bool             `xml:"failed,omitempty"`
-	FailureReason  string           `xml:"failureReason,omitempty"`
-	AbortStatus    string           `xml:"abortStatus,omitempty"`
-	Mode           v1.MigrationMode `xml:"mode,omitempty"`
+	UID            types.UID                `xml:"uid,omitempty"`
+	StartTimestamp *metav1.Time             `xml:"startTimestamp,omitempty"`
+	EndTimestamp   *metav1.Time             `xml:"endTimestamp,omitempty"`
+	Completed      bool                     `xml:"completed,omitempty"`
+	Failed         bool                     `xml:"failed,omitempty"`
+	FailureReason  string                   `xml:"failureReason,omitempty"`
+	FailureClass   v1.MigrationFailureClass `xml:"failureClass,omitempty"`
+	AbortStatus    string                   `xml:"abortStatus,omitempty"`
+	Mode           v1.MigrationMode         `xml:"mode,omitempty"`
 }
 
 type GracePeriodMetadata struct {
@@ -721,6 +732,76 @@ type DiskDriver struct {
 	IOMMU       string             `xml:"iommu,attr,omitempty"`
 }
 
+// DomainBackup represents a push mode backup job as described in
+// https://libvirt.org/formatbackup.html.
+type DomainBackup struct {
+	XMLName     xml.Name          `xml:"domainbackup"`
+	Mode        string            `xml:"mode,attr,omitempty"`
+	Incremental string            `xml:"incremental,omitempty"`
+	Disks       DomainBackupDisks `xml:"disks"`
+}
+
+type DomainBackupDisks struct {
+	Disks []DomainBackupDisk `xml:"disk"`
+}
+
+type DomainBackupDisk struct {
+	Name        string                  `xml:"name,attr"`
+	Backup      string                  `xml:"backup,attr"`
+	Type        string                  `xml:"type,attr,omitempty"`
+	BackupMode  string                  `xml:"backupmode,attr,omitempty"`
+	Incremental string                  `xml:"incremental,attr,omitempty"`
+	Driver      *DomainBackupDiskDriver `xml:"driver,omitempty"`
+	Target      *DomainBackupDiskTarget `xml:"target,omitempty"`
+}
+
+type DomainBackupDiskDriver struct {
+	Type string `xml:"type,attr"`
+}
+
+type DomainBackupDiskTarget struct {
+	File string `xml:"file,attr"`
+}
+
+// DomainCheckpoint represents a checkpoint as described in
+// https://libvirt.org/formatcheckpoint.html.
+type DomainCheckpoint struct {
+	XMLName xml.Name              `xml:"domaincheckpoint"`
+	Name    string                `xml:"name"`
+	Disks   DomainCheckpointDisks `xml:"disks"`
+}
+
+type DomainCheckpointDisks struct {
+	Disks []DomainCheckpointDisk `xml:"disk"`
+}
+
+type DomainCheckpointDisk struct {
+	Name       string `xml:"name,attr"`
+	Checkpoint string `xml:"checkpoint,attr"`
+}
+
+// DomainSnapshot represents a snapshot as described in
+// https://libvirt.org/formatsnapshot.html.
+type DomainSnapshot struct {
+	XMLName xml.Name             `xml:"domainsnapshot"`
+	Memory  DomainSnapshotMemory `xml:"memory"`
+	Disks   DomainSnapshotDisks  `xml:"disks"`
+}
+
+type DomainSnapshotMemory struct {
+	Snapshot string `xml:"snapshot,attr"`
+	File     string `xml:"file,attr,omitempty"`
+}
+
+type DomainSnapshotDisks struct {
+	Disks []DomainSnapshotDisk `xml:"disk"`
+}
+
+type DomainSnapshotDisk struct {
+	Name     string `xml:"name,attr"`
+	Snapshot string `xml:"snapshot,attr"`
+}
+
 type DiskIOThreads struct {
 	IOThread []DiskIOThread `xml:"iothread"`
 }
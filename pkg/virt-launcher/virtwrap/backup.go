@@ -0,0 +1,404 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package virtwrap
+
+import (
+	"encoding/json"
+	"encoding/xml"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+
+	"libvirt.org/go/libvirt"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	v1 "kubevirt.io/api/core/v1"
+	"kubevirt.io/client-go/log"
+
+	cmdclient "kubevirt.io/kubevirt/pkg/virt-handler/cmd-client"
+	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/api"
+	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/cli"
+	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/util"
+)
+
+const (
+	failedDomainBackup   = "Domain backup failed"
+	maxConcurrentBackups = 1
+
+	// backupManifestFile is written next to the disk images and describes the latest backup,
+	// every backup also keeps its own manifest named after it for the backups incremental from it
+	backupManifestFile = "backup.json"
+	// backupManifestSuffix is appended to the name of a backup for its own manifest
+	backupManifestSuffix = ".manifest.json"
+
+	backupModeFull        = "full"
+	backupModeIncremental = "incremental"
+)
+
+var backupJobPollInterval = 1 * time.Second
+
+type backupManifest struct {
+	Name            string               `json:"name"`
+	IncrementalFrom string               `json:"incrementalFrom,omitempty"`
+	StartTimestamp  *metav1.Time         `json:"startTimestamp,omitempty"`
+	EndTimestamp    *metav1.Time         `json:"endTimestamp,omitempty"`
+	Disks           []backupManifestDisk `json:"disks"`
+}
+
+type backupManifestDisk struct {
+	Name   string `json:"name"`
+	File   string `json:"file"`
+	Format string `json:"format"`
+	Mode   string `json:"mode"`
+}
+
+// isBackupVolume returns true for volumes holding persistent guest data
+func isBackupVolume(volume *v1.Volume) bool {
+	return volume.PersistentVolumeClaim != nil || volume.DataVolume != nil || volume.HostDisk != nil
+}
+
+// generateBackupXML builds the backup and checkpoint definitions for the persistent disks of the domain.
+// Only qcow2 disks can carry a dirty bitmap, all other disks are always copied in full.
+func generateBackupXML(vmi *v1.VirtualMachineInstance, domSpec *api.DomainSpec, options *cmdclient.BackupOptions) (*api.DomainBackup, *api.DomainCheckpoint, *backupManifest) {
+	volumes := make(map[string]*v1.Volume)
+	for i := range vmi.Spec.Volumes {
+		volumes[vmi.Spec.Volumes[i].Name] = &vmi.Spec.Volumes[i]
+	}
+
+	backup := &api.DomainBackup{
+		Mode:        "push",
+		Incremental: options.IncrementalFrom,
+	}
+	checkpoint := &api.DomainCheckpoint{
+		Name: options.BackupName,
+	}
+	manifest := &backupManifest{
+		Name:            options.BackupName,
+		IncrementalFrom: options.IncrementalFrom,
+	}
+
+	for _, disk := range domSpec.Devices.Disks {
+		diskName := disk.Target.Device
+		volume, exists := volumes[disk.Alias.GetName()]
+		if disk.Device != "disk" || !exists || !isBackupVolume(volume) {
+			backup.Disks.Disks = append(backup.Disks.Disks, api.DomainBackupDisk{Name: diskName, Backup: "no"})
+			checkpoint.Disks.Disks = append(checkpoint.Disks.Disks, api.DomainCheckpointDisk{Name: diskName, Checkpoint: "no"})
+			continue
+		}
+
+		supportsBitmap := disk.Driver != nil && disk.Driver.Type == "qcow2"
+		if supportsBitmap {
+			checkpoint.Disks.Disks = append(checkpoint.Disks.Disks, api.DomainCheckpointDisk{Name: diskName, Checkpoint: "bitmap"})
+		} else {
+			checkpoint.Disks.Disks = append(checkpoint.Disks.Disks, api.DomainCheckpointDisk{Name: diskName, Checkpoint: "no"})
+		}
+
+		mode := backupModeFull
+		if options.IncrementalFrom != "" && supportsBitmap {
+			mode = backupModeIncremental
+		}
+
+		// the images of a backup must not overwrite the ones an incremental backup is based on
+		fileName := fmt.Sprintf("%s-%s.%s", options.BackupName, volume.Name, options.Format)
+		backupDisk := api.DomainBackupDisk{
+			Name:       diskName,
+			Backup:     "yes",
+			Type:       "file",
+			BackupMode: mode,
+			Driver:     &api.DomainBackupDiskDriver{Type: string(options.Format)},
+			Target:     &api.DomainBackupDiskTarget{File: filepath.Join(options.TargetDir, fileName)},
+		}
+		if mode == backupModeIncremental {
+			backupDisk.Incremental = options.IncrementalFrom
+		}
+		backup.Disks.Disks = append(backup.Disks.Disks, backupDisk)
+		manifest.Disks = append(manifest.Disks, backupManifestDisk{
+			Name:   volume.Name,
+			File:   fileName,
+			Format: string(options.Format),
+			Mode:   mode,
+		})
+	}
+
+	return backup, checkpoint, manifest
+}
+
+func readBackupManifest(dir, backupName string) (*backupManifest, error) {
+	data, err := os.ReadFile(filepath.Join(dir, backupName+backupManifestSuffix))
+	if err != nil {
+		return nil, err
+	}
+	manifest := &backupManifest{}
+	if err := json.Unmarshal(data, manifest); err != nil {
+		return nil, err
+	}
+	return manifest, nil
+}
+
+// backupChainFiles returns the manifests and images of the backup and of
+// all the backups it is incremental from
+func backupChainFiles(dir, backupName string) map[string]struct{} {
+	files := map[string]struct{}{}
+	for backupName != "" {
+		manifestFile := backupName + backupManifestSuffix
+		if _, exists := files[manifestFile]; exists {
+			break
+		}
+		manifest, err := readBackupManifest(dir, backupName)
+		if err != nil {
+			log.Log.Reason(err).Errorf("failed to read the manifest of backup %s", backupName)
+			break
+		}
+		files[manifestFile] = struct{}{}
+		for _, disk := range manifest.Disks {
+			files[disk.File] = struct{}{}
+		}
+		backupName = manifest.IncrementalFrom
+	}
+	return files
+}
+
+// removePreviousBackup removes the backups which are not needed to restore
+// the new one, an incremental backup keeps the chain it is based on
+func removePreviousBackup(dir, incrementalFrom string) {
+	files, err := os.ReadDir(dir)
+	if err != nil {
+		log.Log.Reason(err).Errorf("failed to remove older backups")
+		return
+	}
+	keep := backupChainFiles(dir, incrementalFrom)
+	for _, file := range files {
+		if file.IsDir() {
+			continue
+		}
+		if _, exists := keep[file.Name()]; exists {
+			continue
+		}
+		switch filepath.Ext(file.Name()) {
+		case ".qcow2", ".raw", ".json":
+			if err = os.Remove(filepath.Join(dir, file.Name())); err != nil {
+				log.Log.Reason(err).Errorf("failed to remove older backups")
+			}
+		}
+	}
+}
+
+func writeBackupManifest(dir string, manifest *backupManifest) error {
+	data, err := json.MarshalIndent(manifest, "", "  ")
+	if err != nil {
+		return err
+	}
+	if err := os.WriteFile(filepath.Join(dir, manifest.Name+backupManifestSuffix), data, 0644); err != nil {
+		return err
+	}
+	return os.WriteFile(filepath.Join(dir, backupManifestFile), data, 0644)
+}
+
+func (l *LibvirtDomainManager) BackupVMI(vmi *v1.VirtualMachineInstance, options *cmdclient.BackupOptions) error {
+	select {
+	case l.backupInProgress <- struct{}{}:
+	default:
+		log.Log.Object(vmi).Infof("backup is in progress")
+		return nil
+	}
+
+	go func() {
+		defer func() { <-l.backupInProgress }()
+		if err := l.backup(vmi, options); err != nil {
+			log.Log.Object(vmi).Reason(err).Error(failedDomainBackup)
+		}
+	}()
+	return nil
+}
+
+func (l *LibvirtDomainManager) backup(vmi *v1.VirtualMachineInstance, options *cmdclient.BackupOptions) error {
+	logger := log.Log.Object(vmi)
+
+	if l.shouldSkipBackup(options.BackupName) {
+		return nil
+	}
+	l.initializeBackupMetadata(options.BackupName)
+
+	err := l.runBackup(vmi, options)
+	reason := ""
+	if err != nil {
+		reason = fmt.Sprintf("%s: %s", failedDomainBackup, err)
+	} else {
+		logger.Infof("Completed backup %s successfully", options.BackupName)
+	}
+
+	l.setBackupResult(err != nil, reason)
+	return err
+}
+
+func (l *LibvirtDomainManager) runBackup(vmi *v1.VirtualMachineInstance, options *cmdclient.BackupOptions) error {
+	logger := log.Log.Object(vmi)
+
+	domName := api.VMINamespaceKeyFunc(vmi)
+	dom, err := l.virConn.LookupDomainByName(domName)
+	if err != nil {
+		return err
+	}
+	defer dom.Free()
+
+	domSpec, err := util.GetDomainSpecWithFlags(dom, 0)
+	if err != nil {
+		return err
+	}
+
+	backup, checkpoint, manifest := generateBackupXML(vmi, domSpec, options)
+	if len(manifest.Disks) == 0 {
+		return fmt.Errorf("no disks to backup")
+	}
+	backupXML, err := xml.Marshal(backup)
+	if err != nil {
+		return err
+	}
+	checkpointXML, err := xml.Marshal(checkpoint)
+	if err != nil {
+		return err
+	}
+
+	// keep trying to do the backup even if removing the previous one failed
+	removePreviousBackup(options.TargetDir, options.IncrementalFrom)
+
+	logger.Infof("Starting backup %s", options.BackupName)
+	now := metav1.Now()
+	manifest.StartTimestamp = &now
+	if err := dom.BackupBegin(string(backupXML), string(checkpointXML), 0); err != nil {
+		return err
+	}
+
+	if err := waitForBackupJob(dom); err != nil {
+		deleteCheckpoints(dom, func(name string) bool { return name == options.BackupName })
+		return err
+	}
+
+	now = metav1.Now()
+	manifest.EndTimestamp = &now
+	if err := writeBackupManifest(options.TargetDir, manifest); err != nil {
+		return err
+	}
+
+	// the new checkpoint is the only valid base for the next incremental backup
+	deleteCheckpoints(dom, func(name string) bool { return name != options.BackupName })
+	return nil
+}
+
+func waitForBackupJob(dom cli.VirDomain) error {
+	for {
+		stats, err := dom.GetJobStats(0)
+		if err != nil {
+			return err
+		}
+		if stats.Type == libvirt.DOMAIN_JOB_NONE {
+			// the job is gone, fetch the stats of the completed job to find out how it ended
+			stats, err = dom.GetJobStats(libvirt.DOMAIN_JOB_STATS_COMPLETED)
+			if err != nil {
+				return err
+			}
+		}
+
+		switch stats.Type {
+		case libvirt.DOMAIN_JOB_COMPLETED:
+			return nil
+		case libvirt.DOMAIN_JOB_FAILED:
+			if stats.ErrorMessageSet {
+				return fmt.Errorf("backup job failed: %s", stats.ErrorMessage)
+			}
+			return fmt.Errorf("backup job failed")
+		case libvirt.DOMAIN_JOB_CANCELLED:
+			return fmt.Errorf("backup job was aborted")
+		case libvirt.DOMAIN_JOB_NONE:
+			return fmt.Errorf("backup job ended without reporting a result")
+		}
+		time.Sleep(backupJobPollInterval)
+	}
+}
+
+func deleteCheckpoints(dom cli.VirDomain, shouldDelete func(name string) bool) {
+	checkpoints, err := dom.ListAllCheckpoints(0)
+	if err != nil {
+		log.Log.Reason(err).Error("failed to list domain checkpoints")
+		return
+	}
+	for i := range checkpoints {
+		name, err := checkpoints[i].GetName()
+		if err == nil && shouldDelete(name) {
+			if err := checkpoints[i].Delete(0); err != nil {
+				log.Log.Reason(err).Errorf("failed to delete checkpoint %s", name)
+			}
+		}
+		_ = checkpoints[i].Free()
+	}
+}
+
+func (l *LibvirtDomainManager) AbortVMIBackup(vmi *v1.VirtualMachineInstance) error {
+	backupMetadata, exists := l.metadataCache.Backup.Load()
+	if !exists || backupMetadata.Completed {
+		log.Log.Object(vmi).V(4).Info("no backup in progress, nothing to abort")
+		return nil
+	}
+
+	domName := api.VMINamespaceKeyFunc(vmi)
+	dom, err := l.virConn.LookupDomainByName(domName)
+	if err != nil {
+		return err
+	}
+	defer dom.Free()
+
+	return dom.AbortJob()
+}
+
+func (l *LibvirtDomainManager) shouldSkipBackup(backupName string) bool {
+	backupMetadata, _ := l.metadataCache.Backup.Load()
+	// backup still in progress or have just completed
+	// no need to trigger another one
+	return backupMetadata.BackupName == backupName
+}
+
+func (l *LibvirtDomainManager) initializeBackupMetadata(backupName string) {
+	l.metadataCache.Backup.WithSafeBlock(func(backupMetadata *api.BackupMetadata, initialized bool) {
+		now := metav1.Now()
+		*backupMetadata = api.BackupMetadata{
+			BackupName:     backupName,
+			StartTimestamp: &now,
+		}
+	})
+	log.Log.V(4).Infof("initialize backup metadata: %s", l.metadataCache.Backup.String())
+}
+
+func (l *LibvirtDomainManager) setBackupResult(failed bool, reason string) {
+	l.metadataCache.Backup.WithSafeBlock(func(backupMetadata *api.BackupMetadata, initialized bool) {
+		if !initialized {
+			// nothing to report if backup metadata is empty
+			return
+		}
+
+		now := metav1.Now()
+		backupMetadata.Completed = true
+		backupMetadata.EndTimestamp = &now
+		backupMetadata.Failed = failed
+		backupMetadata.FailureReason = reason
+	})
+	log.Log.V(4).Infof("set backup results in metadata: %s", l.metadataCache.Backup.String())
+}
@@ -0,0 +1,318 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package virtwrap
+
+import (
+	"encoding/json"
+	"encoding/xml"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"libvirt.org/go/libvirt"
+
+	k8sv1 "k8s.io/api/core/v1"
+
+	v1 "kubevirt.io/api/core/v1"
+
+	"kubevirt.io/kubevirt/pkg/ephemeral-disk/fake"
+	cmdclient "kubevirt.io/kubevirt/pkg/virt-handler/cmd-client"
+	"kubevirt.io/kubevirt/pkg/virt-launcher/metadata"
+	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/api"
+	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/cli"
+)
+
+var _ = Describe("VMI backup", func() {
+	const (
+		testNamespace  = "testnamespace"
+		testVmName     = "testvmi"
+		testDomainName = testNamespace + "_" + testVmName
+	)
+
+	var (
+		ctrl          *gomock.Controller
+		mockConn      *cli.MockConnection
+		mockDomain    *cli.MockVirDomain
+		metadataCache *metadata.Cache
+		manager       DomainManager
+		vmi           *v1.VirtualMachineInstance
+		domSpec       *api.DomainSpec
+		targetDir     string
+	)
+
+	newDisk := func(volumeName, target, format string) api.Disk {
+		return api.Disk{
+			Device: "disk",
+			Type:   "file",
+			Target: api.DiskTarget{Device: target},
+			Driver: &api.DiskDriver{Name: "qemu", Type: format},
+			Alias:  api.NewUserDefinedAlias(volumeName),
+		}
+	}
+
+	newBackupOptions := func(name, incrementalFrom string) *cmdclient.BackupOptions {
+		return &cmdclient.BackupOptions{
+			TargetDir:       targetDir,
+			BackupName:      name,
+			IncrementalFrom: incrementalFrom,
+			Format:          v1.BackupFormatQCOW2,
+		}
+	}
+
+	BeforeEach(func() {
+		ctrl = gomock.NewController(GinkgoT())
+		mockConn = cli.NewMockConnection(ctrl)
+		mockDomain = cli.NewMockVirDomain(ctrl)
+		metadataCache = metadata.NewCache()
+		targetDir = GinkgoT().TempDir()
+		backupJobPollInterval = 10 * time.Millisecond
+
+		vmi = newVMI(testNamespace, testVmName)
+		vmi.Spec.Volumes = []v1.Volume{
+			{
+				Name: "rootdisk",
+				VolumeSource: v1.VolumeSource{
+					PersistentVolumeClaim: &v1.PersistentVolumeClaimVolumeSource{
+						PersistentVolumeClaimVolumeSource: k8sv1.PersistentVolumeClaimVolumeSource{ClaimName: "rootpvc"},
+					},
+				},
+			},
+			{
+				Name: "datadisk",
+				VolumeSource: v1.VolumeSource{
+					DataVolume: &v1.DataVolumeSource{Name: "datadv"},
+				},
+			},
+			{
+				Name: "cloudinit",
+				VolumeSource: v1.VolumeSource{
+					CloudInitNoCloud: &v1.CloudInitNoCloudSource{UserData: "#cloud-config"},
+				},
+			},
+		}
+		domSpec = &api.DomainSpec{}
+		domSpec.Devices.Disks = []api.Disk{
+			newDisk("rootdisk", "vda", "qcow2"),
+			newDisk("datadisk", "vdb", "raw"),
+			newDisk("cloudinit", "vdc", "raw"),
+		}
+
+		var err error
+		manager, err = NewLibvirtDomainManager(mockConn, "fake-virt-share", "fake-ephemeral-disk", nil, "/usr/share/OVMF", &fake.MockEphemeralDiskImageCreator{}, metadataCache)
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	expectDomainLookup := func() {
+		mockConn.EXPECT().LookupDomainByName(testDomainName).Return(mockDomain, nil)
+		mockDomain.EXPECT().Free()
+	}
+
+	expectDomainXML := func() {
+		domXML, err := xml.Marshal(domSpec)
+		Expect(err).ToNot(HaveOccurred())
+		mockDomain.EXPECT().GetXMLDesc(libvirt.DomainXMLFlags(0)).Return(string(domXML), nil)
+	}
+
+	writeBackup := func(backupName, incrementalFrom string) {
+		manifest := &backupManifest{
+			Name:            backupName,
+			IncrementalFrom: incrementalFrom,
+			Disks: []backupManifestDisk{
+				{Name: "rootdisk", File: backupName + "-rootdisk.qcow2", Format: "qcow2", Mode: backupModeFull},
+			},
+		}
+		Expect(os.WriteFile(filepath.Join(targetDir, manifest.Disks[0].File), []byte(backupName), 0644)).To(Succeed())
+		Expect(writeBackupManifest(targetDir, manifest)).To(Succeed())
+	}
+
+	loadBackupMetadata := func() api.BackupMetadata {
+		backupMetadata, _ := metadataCache.Backup.Load()
+		return backupMetadata
+	}
+
+	Context("generateBackupXML", func() {
+		It("should only backup persistent disks and checkpoint qcow2 disks", func() {
+			backup, checkpoint, manifest := generateBackupXML(vmi, domSpec, newBackupOptions("backup-1", ""))
+
+			Expect(backup.Mode).To(Equal("push"))
+			Expect(backup.Incremental).To(BeEmpty())
+			Expect(backup.Disks.Disks).To(ConsistOf(
+				api.DomainBackupDisk{
+					Name:       "vda",
+					Backup:     "yes",
+					Type:       "file",
+					BackupMode: backupModeFull,
+					Driver:     &api.DomainBackupDiskDriver{Type: "qcow2"},
+					Target:     &api.DomainBackupDiskTarget{File: filepath.Join(targetDir, "backup-1-rootdisk.qcow2")},
+				},
+				api.DomainBackupDisk{
+					Name:       "vdb",
+					Backup:     "yes",
+					Type:       "file",
+					BackupMode: backupModeFull,
+					Driver:     &api.DomainBackupDiskDriver{Type: "qcow2"},
+					Target:     &api.DomainBackupDiskTarget{File: filepath.Join(targetDir, "backup-1-datadisk.qcow2")},
+				},
+				api.DomainBackupDisk{Name: "vdc", Backup: "no"},
+			))
+			Expect(checkpoint.Name).To(Equal("backup-1"))
+			Expect(checkpoint.Disks.Disks).To(ConsistOf(
+				api.DomainCheckpointDisk{Name: "vda", Checkpoint: "bitmap"},
+				api.DomainCheckpointDisk{Name: "vdb", Checkpoint: "no"},
+				api.DomainCheckpointDisk{Name: "vdc", Checkpoint: "no"},
+			))
+			Expect(manifest.Disks).To(HaveLen(2))
+		})
+
+		It("should copy only the dirty blocks of qcow2 disks on incremental backup", func() {
+			backup, _, manifest := generateBackupXML(vmi, domSpec, newBackupOptions("backup-2", "backup-1"))
+
+			Expect(backup.Incremental).To(Equal("backup-1"))
+			Expect(backup.Disks.Disks[0].BackupMode).To(Equal(backupModeIncremental))
+			Expect(backup.Disks.Disks[0].Incremental).To(Equal("backup-1"))
+			Expect(backup.Disks.Disks[1].BackupMode).To(Equal(backupModeFull))
+			Expect(backup.Disks.Disks[1].Incremental).To(BeEmpty())
+			Expect(manifest.IncrementalFrom).To(Equal("backup-1"))
+			Expect(manifest.Disks).To(ConsistOf(
+				backupManifestDisk{Name: "rootdisk", File: "backup-2-rootdisk.qcow2", Format: "qcow2", Mode: backupModeIncremental},
+				backupManifestDisk{Name: "datadisk", File: "backup-2-datadisk.qcow2", Format: "qcow2", Mode: backupModeFull},
+			))
+		})
+	})
+
+	Context("removePreviousBackup", func() {
+		BeforeEach(func() {
+			writeBackup("backup-0", "")
+			writeBackup("backup-1", "")
+			writeBackup("backup-2", "backup-1")
+		})
+
+		It("should remove all the backups before a full backup", func() {
+			removePreviousBackup(targetDir, "")
+
+			files, err := os.ReadDir(targetDir)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(files).To(BeEmpty())
+		})
+
+		It("should keep the backups an incremental backup is based on", func() {
+			removePreviousBackup(targetDir, "backup-2")
+
+			for _, file := range []string{"backup-1-rootdisk.qcow2", "backup-1" + backupManifestSuffix, "backup-2-rootdisk.qcow2", "backup-2" + backupManifestSuffix} {
+				Expect(filepath.Join(targetDir, file)).To(BeAnExistingFile())
+			}
+			for _, file := range []string{"backup-0-rootdisk.qcow2", "backup-0" + backupManifestSuffix, backupManifestFile} {
+				Expect(filepath.Join(targetDir, file)).ToNot(BeAnExistingFile())
+			}
+		})
+	})
+
+	Context("BackupVMI", func() {
+		It("should write the manifest and update the metadata when the backup completes", func() {
+			writeBackup("backup-0", "")
+
+			expectDomainLookup()
+			expectDomainXML()
+			mockDomain.EXPECT().BackupBegin(gomock.Any(), gomock.Any(), libvirt.DomainBackupBeginFlags(0)).Return(nil)
+			mockDomain.EXPECT().GetJobStats(libvirt.DomainGetJobStatsFlags(0)).Return(&libvirt.DomainJobInfo{Type: libvirt.DOMAIN_JOB_UNBOUNDED}, nil)
+			mockDomain.EXPECT().GetJobStats(libvirt.DomainGetJobStatsFlags(0)).Return(&libvirt.DomainJobInfo{Type: libvirt.DOMAIN_JOB_NONE}, nil)
+			mockDomain.EXPECT().GetJobStats(libvirt.DOMAIN_JOB_STATS_COMPLETED).Return(&libvirt.DomainJobInfo{Type: libvirt.DOMAIN_JOB_COMPLETED}, nil)
+			mockDomain.EXPECT().ListAllCheckpoints(libvirt.DomainCheckpointListFlags(0)).Return(nil, nil)
+
+			Expect(manager.BackupVMI(vmi, newBackupOptions("backup-1", ""))).To(Succeed())
+			// Expect extra call to backup not to impact
+			Expect(manager.BackupVMI(vmi, newBackupOptions("backup-1", ""))).To(Succeed())
+
+			Eventually(func() bool {
+				return loadBackupMetadata().Completed
+			}, 5*time.Second).Should(BeTrue())
+			Expect(loadBackupMetadata().Failed).To(BeFalse())
+			Expect(loadBackupMetadata().BackupName).To(Equal("backup-1"))
+
+			Expect(filepath.Join(targetDir, "backup-0-rootdisk.qcow2")).ToNot(BeAnExistingFile())
+			Expect(filepath.Join(targetDir, "backup-0"+backupManifestSuffix)).ToNot(BeAnExistingFile())
+			data, err := os.ReadFile(filepath.Join(targetDir, backupManifestFile))
+			Expect(err).ToNot(HaveOccurred())
+			manifest := &backupManifest{}
+			Expect(json.Unmarshal(data, manifest)).To(Succeed())
+			Expect(manifest.Name).To(Equal("backup-1"))
+			Expect(readBackupManifest(targetDir, "backup-1")).To(Equal(manifest))
+			Expect(manifest.Disks).To(HaveLen(2))
+			Expect(manifest.StartTimestamp).ToNot(BeNil())
+			Expect(manifest.EndTimestamp).ToNot(BeNil())
+
+			// Expect extra call to backup after completion
+			// not to start another backup job
+			Expect(manager.BackupVMI(vmi, newBackupOptions("backup-1", ""))).To(Succeed())
+		})
+
+		It("should update the metadata if the backup could not be started", func() {
+			expectDomainLookup()
+			expectDomainXML()
+			mockDomain.EXPECT().BackupBegin(gomock.Any(), gomock.Any(), gomock.Any()).Return(fmt.Errorf("checkpoint backup-1 does not exist"))
+
+			Expect(manager.BackupVMI(vmi, newBackupOptions("backup-2", "backup-1"))).To(Succeed())
+
+			Eventually(func() bool {
+				return loadBackupMetadata().Failed
+			}, 5*time.Second).Should(BeTrue(), "failed backup result wasn't set")
+			Expect(loadBackupMetadata().FailureReason).To(ContainSubstring("checkpoint backup-1 does not exist"))
+			Expect(filepath.Join(targetDir, backupManifestFile)).ToNot(BeAnExistingFile())
+		})
+
+		It("should update the metadata if the backup job failed", func() {
+			expectDomainLookup()
+			expectDomainXML()
+			mockDomain.EXPECT().BackupBegin(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil)
+			mockDomain.EXPECT().GetJobStats(libvirt.DomainGetJobStatsFlags(0)).Return(&libvirt.DomainJobInfo{
+				Type:            libvirt.DOMAIN_JOB_FAILED,
+				ErrorMessageSet: true,
+				ErrorMessage:    "No space left on device",
+			}, nil)
+			mockDomain.EXPECT().ListAllCheckpoints(libvirt.DomainCheckpointListFlags(0)).Return(nil, nil)
+
+			Expect(manager.BackupVMI(vmi, newBackupOptions("backup-1", ""))).To(Succeed())
+
+			Eventually(func() bool {
+				return loadBackupMetadata().Failed
+			}, 5*time.Second).Should(BeTrue(), "failed backup result wasn't set")
+			Expect(loadBackupMetadata().FailureReason).To(ContainSubstring("No space left on device"))
+		})
+	})
+
+	Context("AbortVMIBackup", func() {
+		It("should not abort anything if no backup is running", func() {
+			Expect(manager.AbortVMIBackup(vmi)).To(Succeed())
+		})
+
+		It("should abort the running backup job", func() {
+			metadataCache.Backup.Store(api.BackupMetadata{BackupName: "backup-1"})
+			expectDomainLookup()
+			mockDomain.EXPECT().AbortJob().Return(nil)
+
+			Expect(manager.AbortVMIBackup(vmi)).To(Succeed())
+		})
+	})
+})
@@ -54,6 +54,16 @@ func (_mr *_MockConnectionRecorder) DomainDefineXML(arg0 interface{}) *gomock.Ca
 	return _mr.mock.ctrl.RecordCall(_mr.mock, "DomainDefineXML", arg0)
 }
 
+func (_m *MockConnection) DomainRestoreFlags(srcFile string, xml string, flags libvirt.DomainSaveRestoreFlags) error {
+	ret := _m.ctrl.Call(_m, "DomainRestoreFlags", srcFile, xml, flags)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+func (_mr *_MockConnectionRecorder) DomainRestoreFlags(arg0, arg1, arg2 interface{}) *gomock.Call {
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "DomainRestoreFlags", arg0, arg1, arg2)
+}
+
 func (_m *MockConnection) Close() (int, error) {
 	ret := _m.ctrl.Call(_m, "Close")
 	ret0, _ := ret[0].(int)
@@ -480,6 +490,16 @@ func (_mr *_MockVirDomainRecorder) MigrateStartPostCopy(arg0 interface{}) *gomoc
 	return _mr.mock.ctrl.RecordCall(_mr.mock, "MigrateStartPostCopy", arg0)
 }
 
+func (_m *MockVirDomain) MigrateSetMaxDowntime(downtime uint64, flags uint32) error {
+	ret := _m.ctrl.Call(_m, "MigrateSetMaxDowntime", downtime, flags)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+func (_mr *_MockVirDomainRecorder) MigrateSetMaxDowntime(arg0, arg1 interface{}) *gomock.Call {
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "MigrateSetMaxDowntime", arg0, arg1)
+}
+
 func (_m *MockVirDomain) MemoryStats(nrStats uint32, flags uint32) ([]libvirt.DomainMemoryStat, error) {
 	ret := _m.ctrl.Call(_m, "MemoryStats", nrStats, flags)
 	ret0, _ := ret[0].([]libvirt.DomainMemoryStat)
@@ -624,3 +644,35 @@ func (_m *MockVirDomain) SetLaunchSecurityState(params *libvirt.DomainLaunchSecu
 func (_mr *_MockVirDomainRecorder) SetLaunchSecurityState(arg0, arg1 interface{}) *gomock.Call {
 	return _mr.mock.ctrl.RecordCall(_mr.mock, "SetLaunchSecurityState", arg0, arg1)
 }
+
+func (_m *MockVirDomain) BackupBegin(backupXML string, checkpointXML string, flags libvirt.DomainBackupBeginFlags) error {
+	ret := _m.ctrl.Call(_m, "BackupBegin", backupXML, checkpointXML, flags)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+func (_mr *_MockVirDomainRecorder) BackupBegin(arg0, arg1, arg2 interface{}) *gomock.Call {
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "BackupBegin", arg0, arg1, arg2)
+}
+
+func (_m *MockVirDomain) ListAllCheckpoints(flags libvirt.DomainCheckpointListFlags) ([]libvirt.DomainCheckpoint, error) {
+	ret := _m.ctrl.Call(_m, "ListAllCheckpoints", flags)
+	ret0, _ := ret[0].([]libvirt.DomainCheckpoint)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+func (_mr *_MockVirDomainRecorder) ListAllCheckpoints(arg0 interface{}) *gomock.Call {
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "ListAllCheckpoints", arg0)
+}
+
+func (_m *MockVirDomain) CreateSnapshotXML(xml string, flags libvirt.DomainSnapshotCreateFlags) (*libvirt.DomainSnapshot, error) {
+	ret := _m.ctrl.Call(_m, "CreateSnapshotXML", xml, flags)
+	ret0, _ := ret[0].(*libvirt.DomainSnapshot)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+func (_mr *_MockVirDomainRecorder) CreateSnapshotXML(arg0, arg1 interface{}) *gomock.Call {
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "CreateSnapshotXML", arg0, arg1)
+}
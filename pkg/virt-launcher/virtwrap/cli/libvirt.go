@@ -47,6 +47,7 @@ const ConnectionInterval = 500 * time.Millisecond
 type Connection interface {
 	LookupDomainByName(name string) (VirDomain, error)
 	DomainDefineXML(xml string) (VirDomain, error)
+	DomainRestoreFlags(srcFile string, xml string, flags libvirt.DomainSaveRestoreFlags) error
 	Close() (int, error)
 	DomainEventLifecycleRegister(callback libvirt.DomainEventLifecycleCallback) error
 	DomainEventDeviceAddedRegister(callback libvirt.DomainEventDeviceAddedCallback) error
@@ -220,6 +221,16 @@ func (l *LibvirtConnection) DomainDefineXML(xml string) (dom VirDomain, err erro
 	return
 }
 
+func (l *LibvirtConnection) DomainRestoreFlags(srcFile string, xml string, flags libvirt.DomainSaveRestoreFlags) (err error) {
+	if err = l.reconnectIfNecessary(); err != nil {
+		return
+	}
+
+	err = l.Connect.DomainRestoreFlags(srcFile, xml, flags)
+	l.checkConnectionLost(err)
+	return
+}
+
 func (l *LibvirtConnection) ListAllDomains(flags libvirt.ConnectListAllDomainsFlags) ([]VirDomain, error) {
 	if err := l.reconnectIfNecessary(); err != nil {
 		return nil, err
@@ -521,6 +532,7 @@ type VirDomain interface {
 	GetXMLDesc(flags libvirt.DomainXMLFlags) (string, error)
 	MigrateToURI3(string, *libvirt.DomainMigrateParameters, libvirt.DomainMigrateFlags) error
 	MigrateStartPostCopy(flags uint32) error
+	MigrateSetMaxDowntime(downtime uint64, flags uint32) error
 	MemoryStats(nrStats uint32, flags uint32) ([]libvirt.DomainMemoryStat, error)
 	GetJobStats(flags libvirt.DomainGetJobStatsFlags) (*libvirt.DomainJobInfo, error)
 	GetJobInfo() (*libvirt.DomainJobInfo, error)
@@ -535,6 +547,9 @@ type VirDomain interface {
 	SetVcpusFlags(vcpu uint, flags libvirt.DomainVcpuFlags) error
 	GetLaunchSecurityInfo(flags uint32) (*libvirt.DomainLaunchSecurityParameters, error)
 	SetLaunchSecurityState(params *libvirt.DomainLaunchSecurityStateParameters, flags uint32) error
+	BackupBegin(backupXML string, checkpointXML string, flags libvirt.DomainBackupBeginFlags) error
+	ListAllCheckpoints(flags libvirt.DomainCheckpointListFlags) ([]libvirt.DomainCheckpoint, error)
+	CreateSnapshotXML(xml string, flags libvirt.DomainSnapshotCreateFlags) (*libvirt.DomainSnapshot, error)
 }
 
 func NewConnection(uri string, user string, pass string, checkInterval time.Duration) (Connection, error) {
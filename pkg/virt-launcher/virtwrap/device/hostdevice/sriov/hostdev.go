@@ -25,6 +25,7 @@ import (
 	"fmt"
 	"os"
 	"path"
+	"strings"
 	"time"
 
 	v1 "kubevirt.io/api/core/v1"
@@ -41,7 +42,7 @@ import (
 
 func CreateHostDevices(vmi *v1.VirtualMachineInstance) ([]api.HostDevice, error) {
 	SRIOVInterfaces := vmispec.FilterInterfacesSpec(vmi.Spec.Domain.Devices.Interfaces, func(iface v1.Interface) bool {
-		if iface.SRIOV == nil {
+		if iface.SRIOV == nil || iface.State == v1.InterfaceStateAbsent {
 			return false
 		}
 		ifaceStatus := vmispec.LookupInterfaceStatusByName(vmi.Status.Interfaces, iface.Name)
@@ -155,3 +156,20 @@ func GetHostDevicesToAttach(vmi *v1.VirtualMachineInstance, domainSpec *api.Doma
 
 	return sriovHostDevicesToAttach, nil
 }
+
+func GetHostDevicesToDetach(vmi *v1.VirtualMachineInstance, domainSpec *api.DomainSpec) []api.HostDevice {
+	absentSRIOVIfacesByName := vmispec.IndexInterfaceSpecByName(
+		vmispec.FilterInterfacesSpec(vmi.Spec.Domain.Devices.Interfaces, func(iface v1.Interface) bool {
+			return iface.SRIOV != nil && iface.State == v1.InterfaceStateAbsent
+		}),
+	)
+
+	var sriovHostDevicesToDetach []api.HostDevice
+	for _, hostDevice := range hostdevice.FilterHostDevicesByAlias(domainSpec.Devices.HostDevices, deviceinfo.SRIOVAliasPrefix) {
+		ifaceName := strings.TrimPrefix(hostDevice.Alias.GetName(), deviceinfo.SRIOVAliasPrefix)
+		if _, isAbsent := absentSRIOVIfacesByName[ifaceName]; isAbsent {
+			sriovHostDevicesToDetach = append(sriovHostDevicesToDetach, hostDevice)
+		}
+	}
+	return sriovHostDevicesToDetach
+}
@@ -82,6 +82,21 @@ var _ = Describe("SRIOV HostDevice", func() {
 			Expect(sriov.CreateHostDevices(vmi)).To(BeEmpty())
 		})
 
+		It("creates no device given an absent SRIOV interface", func() {
+			iface := newSRIOVInterface("test")
+			iface.State = v1.InterfaceStateAbsent
+			vmi := &v1.VirtualMachineInstance{}
+			vmi.Spec.Domain.Devices.Interfaces = []v1.Interface{iface}
+			vmi.Status = v1.VirtualMachineInstanceStatus{
+				Interfaces: []v1.VirtualMachineInstanceNetworkInterface{{
+					Name:       "test",
+					InfoSource: vmispec.InfoSourceMultusStatus,
+				}},
+			}
+
+			Expect(sriov.CreateHostDevices(vmi)).To(BeEmpty())
+		})
+
 		It("fails to create device given no available host PCI", func() {
 			iface := newSRIOVInterface("test")
 			vmi := &v1.VirtualMachineInstance{}
@@ -321,6 +336,33 @@ var _ = Describe("SRIOV HostDevice", func() {
 		)
 	})
 
+	Context("hot unplug", func() {
+		It("selects the host devices of absent SRIOV interfaces", func() {
+			absentIface := newSRIOVInterface(netname1)
+			absentIface.State = v1.InterfaceStateAbsent
+			vmi := &v1.VirtualMachineInstance{}
+			vmi.Spec.Domain.Devices.Interfaces = []v1.Interface{absentIface, newSRIOVInterface(netname2)}
+
+			absentHostDevice := api.HostDevice{Alias: newSRIOVAlias(netname1)}
+			domainSpec := newDomainSpec(
+				absentHostDevice,
+				api.HostDevice{Alias: newSRIOVAlias(netname2)},
+				api.HostDevice{Alias: api.NewUserDefinedAlias(netname1)},
+			)
+
+			Expect(sriov.GetHostDevicesToDetach(vmi, domainSpec)).To(Equal([]api.HostDevice{absentHostDevice}))
+		})
+
+		It("selects no host device when the absent SRIOV interface is already detached", func() {
+			absentIface := newSRIOVInterface(netname1)
+			absentIface.State = v1.InterfaceStateAbsent
+			vmi := &v1.VirtualMachineInstance{}
+			vmi.Spec.Domain.Devices.Interfaces = []v1.Interface{absentIface}
+
+			Expect(sriov.GetHostDevicesToDetach(vmi, newDomainSpec())).To(BeEmpty())
+		})
+	})
+
 	Context("safe detachment", func() {
 		hostDevice := api.HostDevice{Alias: api.NewUserDefinedAlias(netsriov.SRIOVAliasPrefix + "net1")}
 
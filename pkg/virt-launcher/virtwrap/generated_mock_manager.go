@@ -255,11 +255,12 @@ func (_mr *_MockDomainManagerRecorder) GetGuestOSInfo() *gomock.Call {
 	return _mr.mock.ctrl.RecordCall(_mr.mock, "GetGuestOSInfo")
 }
 
-func (_m *MockDomainManager) Exec(_param0 string, _param1 string, _param2 []string, _param3 int32) (string, error) {
+func (_m *MockDomainManager) Exec(_param0 string, _param1 string, _param2 []string, _param3 int32) (string, string, error) {
 	ret := _m.ctrl.Call(_m, "Exec", _param0, _param1, _param2, _param3)
 	ret0, _ := ret[0].(string)
-	ret1, _ := ret[1].(error)
-	return ret0, ret1
+	ret1, _ := ret[1].(string)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
 }
 
 func (_mr *_MockDomainManagerRecorder) Exec(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
@@ -348,3 +349,23 @@ func (_m *MockDomainManager) UpdateGuestMemory(vmi *v1.VirtualMachineInstance) e
 func (_mr *_MockDomainManagerRecorder) UpdateGuestMemory(arg0 interface{}) *gomock.Call {
 	return _mr.mock.ctrl.RecordCall(_mr.mock, "UpdateGuestMemory", arg0)
 }
+
+func (_m *MockDomainManager) BackupVMI(_param0 *v1.VirtualMachineInstance, _param1 *cmd_client.BackupOptions) error {
+	ret := _m.ctrl.Call(_m, "BackupVMI", _param0, _param1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+func (_mr *_MockDomainManagerRecorder) BackupVMI(arg0, arg1 interface{}) *gomock.Call {
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "BackupVMI", arg0, arg1)
+}
+
+func (_m *MockDomainManager) AbortVMIBackup(_param0 *v1.VirtualMachineInstance) error {
+	ret := _m.ctrl.Call(_m, "AbortVMIBackup", _param0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+func (_mr *_MockDomainManagerRecorder) AbortVMIBackup(arg0 interface{}) *gomock.Call {
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "AbortVMIBackup", arg0)
+}
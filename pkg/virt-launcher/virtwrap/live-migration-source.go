@@ -22,6 +22,7 @@ package virtwrap
 import (
 	"encoding/json"
 	"encoding/xml"
+	"errors"
 	"fmt"
 	"path/filepath"
 	"strings"
@@ -62,6 +63,8 @@ import (
 
 const liveMigrationFailed = "Live migration failed."
 
+const migrationCompressionXBZRLE = "xbzrle"
+
 const (
 	monitorSleepPeriodMS = 400
 	monitorLogPeriodMS   = 4000
@@ -92,8 +95,29 @@ type migrationMonitor struct {
 }
 
 type inflightMigrationAborted struct {
-	message     string
-	abortStatus v1.MigrationAbortStatus
+	message      string
+	abortStatus  v1.MigrationAbortStatus
+	failureClass v1.MigrationFailureClass
+}
+
+// classifyMigrationError tells the kind of problem a migration failed on from the libvirt error it failed with
+func classifyMigrationError(err error) v1.MigrationFailureClass {
+	var libvirtErr libvirt.Error
+	if !errors.As(err, &libvirtErr) {
+		return v1.MigrationFailureUnknown
+	}
+	switch {
+	case libvirtErr.Code == libvirt.ERR_OPERATION_ABORTED:
+		return v1.MigrationFailureAborted
+	case libvirtErr.Code == libvirt.ERR_OPERATION_TIMEOUT:
+		return v1.MigrationFailureTimeout
+	case libvirtErr.Code == libvirt.ERR_NO_DOMAIN || libvirtErr.Code == libvirt.ERR_OPERATION_INVALID:
+		return v1.MigrationFailureGuest
+	case libvirtErr.Code == libvirt.ERR_RPC || libvirtErr.Code == libvirt.ERR_NO_CONNECT ||
+		libvirtErr.Domain == libvirt.FROM_RPC || libvirtErr.Domain == libvirt.FROM_STREAMS:
+		return v1.MigrationFailureNetwork
+	}
+	return v1.MigrationFailureUnknown
 }
 
 func generateMigrationFlags(isBlockMigration, migratePaused bool, options *cmdclient.MigrationOptions) libvirt.DomainMigrateFlags {
@@ -117,6 +141,9 @@ func generateMigrationFlags(isBlockMigration, migratePaused bool, options *cmdcl
 	if shouldConfigureParallel, _ := shouldConfigureParallelMigration(options); shouldConfigureParallel {
 		migrateFlags |= libvirt.MIGRATE_PARALLEL
 	}
+	if migrationCompressionMethod(options) != "" {
+		migrateFlags |= libvirt.MIGRATE_COMPRESSED
+	}
 
 	return migrateFlags
 
@@ -374,7 +401,7 @@ func (l *LibvirtDomainManager) cancelMigration(vmi *v1.VirtualMachineInstance) e
 	return nil
 }
 
-func (l *LibvirtDomainManager) setMigrationResultHelper(failed bool, completed bool, reason string, abortStatus v1.MigrationAbortStatus) error {
+func (l *LibvirtDomainManager) setMigrationResultHelper(failed bool, completed bool, reason string, failureClass v1.MigrationFailureClass, abortStatus v1.MigrationAbortStatus) error {
 	migrationMetadata, exists := l.metadataCache.Migration.Load()
 	if !exists {
 		// nothing to report if migration metadata is empty
@@ -396,6 +423,7 @@ func (l *LibvirtDomainManager) setMigrationResultHelper(failed bool, completed b
 		if failed {
 			migrationMetadata.Failed = true
 			migrationMetadata.FailureReason = reason
+			migrationMetadata.FailureClass = failureClass
 		}
 		if completed {
 			migrationMetadata.Completed = true
@@ -412,12 +440,12 @@ func (l *LibvirtDomainManager) setMigrationResultHelper(failed bool, completed b
 	return nil
 }
 
-func (l *LibvirtDomainManager) setMigrationResult(failed bool, reason string, abortStatus v1.MigrationAbortStatus) error {
-	return l.setMigrationResultHelper(failed, true, reason, abortStatus)
+func (l *LibvirtDomainManager) setMigrationResult(failed bool, reason string, failureClass v1.MigrationFailureClass, abortStatus v1.MigrationAbortStatus) error {
+	return l.setMigrationResultHelper(failed, true, reason, failureClass, abortStatus)
 }
 
 func (l *LibvirtDomainManager) setMigrationAbortStatus(abortStatus v1.MigrationAbortStatus) error {
-	return l.setMigrationResultHelper(false, false, "", abortStatus)
+	return l.setMigrationResultHelper(false, false, "", "", abortStatus)
 }
 
 func newMigrationMonitor(vmi *v1.VirtualMachineInstance, l *LibvirtDomainManager, options *cmdclient.MigrationOptions, migrationErr chan error) *migrationMonitor {
@@ -580,6 +608,7 @@ func (m *migrationMonitor) processInflightMigration(dom cli.VirDomain, stats *li
 		aborted := &inflightMigrationAborted{}
 		aborted.message = fmt.Sprintf("Live migration stuck for %d seconds and has been aborted", progressDelay/int64(time.Second))
 		aborted.abortStatus = v1.MigrationAbortSucceeded
+		aborted.failureClass = v1.MigrationFailureTimeout
 		return aborted
 	case m.shouldTriggerTimeout(elapsed):
 		// check the overall migration time
@@ -596,6 +625,7 @@ func (m *migrationMonitor) processInflightMigration(dom cli.VirDomain, stats *li
 		aborted := &inflightMigrationAborted{}
 		aborted.message = fmt.Sprintf("Live migration is not completed after %d seconds and has been aborted", m.acceptableCompletionTime)
 		aborted.abortStatus = v1.MigrationAbortSucceeded
+		aborted.failureClass = v1.MigrationFailureNonConvergence
 		return aborted
 	}
 
@@ -618,7 +648,7 @@ func (m *migrationMonitor) startMonitor() {
 	dom, err := m.l.virConn.LookupDomainByName(domName)
 	if err != nil {
 		logger.Reason(err).Error(liveMigrationFailed)
-		m.l.setMigrationResult(true, fmt.Sprintf("%v", err), "")
+		m.l.setMigrationResult(true, fmt.Sprintf("%v", err), classifyMigrationError(err), "")
 		return
 	}
 	defer dom.Free()
@@ -639,10 +669,12 @@ func (m *migrationMonitor) startMonitor() {
 			logger.Info("Didn't manage to get a job status. Post the received error and finalize.")
 			logger.Reason(m.migrationFailedWithError).Error(liveMigrationFailed)
 			var abortStatus v1.MigrationAbortStatus
+			failureClass := classifyMigrationError(m.migrationFailedWithError)
 			if strings.Contains(m.migrationFailedWithError.Error(), "canceled by client") {
 				abortStatus = v1.MigrationAbortSucceeded
+				failureClass = v1.MigrationFailureAborted
 			}
-			m.l.setMigrationResult(true, fmt.Sprintf("Live migration failed %v", m.migrationFailedWithError), abortStatus)
+			m.l.setMigrationResult(true, fmt.Sprintf("Live migration failed %v", m.migrationFailedWithError), failureClass, abortStatus)
 			return
 		}
 
@@ -664,7 +696,7 @@ func (m *migrationMonitor) startMonitor() {
 			aborted := m.processInflightMigration(dom, stats)
 			if aborted != nil {
 				logger.Errorf("Live migration abort detected with reason: %s", aborted.message)
-				m.l.setMigrationResult(true, aborted.message, aborted.abortStatus)
+				m.l.setMigrationResult(true, aborted.message, aborted.failureClass, aborted.abortStatus)
 				return
 			}
 			logInterval++
@@ -675,15 +707,15 @@ func (m *migrationMonitor) startMonitor() {
 			completedJobInfo = m.determineNonRunningMigrationStatus(dom)
 		case libvirt.DOMAIN_JOB_COMPLETED:
 			logger.Info("Migration has been completed")
-			m.l.setMigrationResult(false, "", "")
+			m.l.setMigrationResult(false, "", "", "")
 			return
 		case libvirt.DOMAIN_JOB_FAILED:
 			logger.Info("Migration job failed")
-			m.l.setMigrationResult(true, fmt.Sprintf("%v", m.migrationFailedWithError), "")
+			m.l.setMigrationResult(true, fmt.Sprintf("%v", m.migrationFailedWithError), classifyMigrationError(m.migrationFailedWithError), "")
 			return
 		case libvirt.DOMAIN_JOB_CANCELLED:
 			logger.Info("Migration was canceled")
-			m.l.setMigrationResult(true, "Live migration aborted ", v1.MigrationAbortSucceeded)
+			m.l.setMigrationResult(true, "Live migration aborted ", v1.MigrationFailureAborted, v1.MigrationAbortSucceeded)
 			return
 		}
 	}
@@ -766,6 +798,18 @@ func generateMigrationParams(dom cli.VirDomain, vmi *v1.VirtualMachineInstance,
 		ParallelConnections:    parallelMigrationThreads,
 	}
 
+	switch compression := migrationCompressionMethod(options); compression {
+	case "":
+	case migrationCompressionXBZRLE:
+		params.Compression = compression
+		params.CompressionSet = true
+		params.CompressionXBZRLECache = uint64(options.XBZRLECacheSize.Value())
+		params.CompressionXBZRLECacheSet = true
+	default:
+		params.Compression = compression
+		params.CompressionSet = true
+	}
+
 	copyDisks := getDiskTargetsForMigration(dom, vmi)
 	if len(copyDisks) != 0 {
 		params.MigrateDisks = copyDisks
@@ -982,9 +1026,15 @@ func (l *LibvirtDomainManager) migrateHelper(vmi *v1.VirtualMachineInstance, opt
 		dstURI = fmt.Sprintf("qemu+unix:///system?socket=%s", migrationproxy.SourceUnixFile(l.virtShareDir, string(vmi.UID)))
 	}
 
+	if options.MaxDowntimeMilliseconds > 0 {
+		if err := dom.MigrateSetMaxDowntime(options.MaxDowntimeMilliseconds, 0); err != nil {
+			return fmt.Errorf("failed to set the maximum downtime of the migration: %v", err)
+		}
+	}
+
 	err = dom.MigrateToURI3(dstURI, params, migrateFlags)
 	if err != nil {
-		return fmt.Errorf("error encountered during MigrateToURI3 libvirt api call: %v", err)
+		return fmt.Errorf("error encountered during MigrateToURI3 libvirt api call: %w", err)
 	}
 
 	return nil
@@ -1008,7 +1058,7 @@ func shouldImmediatelyFailMigration(vmi *v1.VirtualMachineInstance) bool {
 func (l *LibvirtDomainManager) migrate(vmi *v1.VirtualMachineInstance, options *cmdclient.MigrationOptions) {
 	if shouldImmediatelyFailMigration(vmi) {
 		log.Log.Object(vmi).Error("Live migration failed. Failure is forced by functional tests suite.")
-		l.setMigrationResult(true, "Failed migration to satisfy functional test condition", "")
+		l.setMigrationResult(true, "Failed migration to satisfy functional test condition", v1.MigrationFailureUnknown, "")
 		return
 	}
 
@@ -1058,3 +1108,19 @@ func shouldConfigureParallelMigration(options *cmdclient.MigrationOptions) (shou
 	threadsCount = int(*options.ParallelMigrationThreads)
 	return
 }
+
+// migrationCompressionMethod returns the libvirt compression method of the migration.
+// QEMU compresses the memory with zlib or zstd only over parallel connections, while
+// XBZRLE is only available without them.
+func migrationCompressionMethod(options *cmdclient.MigrationOptions) string {
+	if options == nil {
+		return ""
+	}
+	if shouldConfigureParallel, _ := shouldConfigureParallelMigration(options); shouldConfigureParallel {
+		return string(options.Compression)
+	}
+	if options.XBZRLECacheSize.Value() > 0 {
+		return migrationCompressionXBZRLE
+	}
+	return ""
+}
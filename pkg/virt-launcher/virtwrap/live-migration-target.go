@@ -53,6 +53,10 @@ func (l *LibvirtDomainManager) finalizeMigrationTarget(vmi *v1.VirtualMachineIns
 		return err
 	}
 
+	if err := hooks.GetManager().PostMigration(vmi); err != nil {
+		return fmt.Errorf("PostMigration hook failed: %v", err)
+	}
+
 	return nil
 }
 
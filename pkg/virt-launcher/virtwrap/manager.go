@@ -136,7 +136,7 @@ type DomainManager interface {
 	HotplugHostDevices(vmi *v1.VirtualMachineInstance) error
 	InterfacesStatus() []api.InterfaceStatus
 	GetGuestOSInfo() *api.GuestOSInfo
-	Exec(string, string, []string, int32) (string, error)
+	Exec(string, string, []string, int32) (string, string, error)
 	GuestPing(string) error
 	MemoryDump(vmi *v1.VirtualMachineInstance, dumpPath string) error
 	GetQemuVersion() (string, error)
@@ -145,6 +145,8 @@ type DomainManager interface {
 	GetLaunchMeasurement(*v1.VirtualMachineInstance) (*v1.SEVMeasurementInfo, error)
 	InjectLaunchSecret(*v1.VirtualMachineInstance, *v1.SEVSecretOptions) error
 	UpdateGuestMemory(vmi *v1.VirtualMachineInstance) error
+	BackupVMI(*v1.VirtualMachineInstance, *cmdclient.BackupOptions) error
+	AbortVMIBackup(*v1.VirtualMachineInstance) error
 }
 
 type LibvirtDomainManager struct {
@@ -159,6 +161,7 @@ type LibvirtDomainManager struct {
 
 	hotplugHostDevicesInProgress chan struct{}
 	memoryDumpInProgress         chan struct{}
+	backupInProgress             chan struct{}
 
 	virtShareDir             string
 	ephemeralDiskDir         string
@@ -226,6 +229,7 @@ func newLibvirtDomainManager(connection cli.Connection, virtShareDir, ephemeralD
 
 	manager.hotplugHostDevicesInProgress = make(chan struct{}, maxConcurrentHotplugHostDevices)
 	manager.memoryDumpInProgress = make(chan struct{}, maxConcurrentMemoryDumps)
+	manager.backupInProgress = make(chan struct{}, maxConcurrentBackups)
 	manager.credManager = accesscredentials.NewManager(connection, &manager.domainModifyLock, metadataCache)
 
 	reCalcDomainStats := func() (*stats.DomainStats, error) {
@@ -543,7 +547,8 @@ func maxSlice(slice []int) int {
 	return max
 }
 
-// HotplugHostDevices attach host-devices to running domain, currently only SRIOV host-devices are supported.
+// HotplugHostDevices attach host-devices to running domain and detach the ones of absent interfaces,
+// currently only SRIOV host-devices are supported.
 // This operation runs in the background, only one hotplug operation can occur at a time.
 func (l *LibvirtDomainManager) HotplugHostDevices(vmi *v1.VirtualMachineInstance) error {
 	select {
@@ -580,6 +585,10 @@ func (l *LibvirtDomainManager) hotPlugHostDevices(vmi *v1.VirtualMachineInstance
 		return fmt.Errorf("%s: %v", errMsgPrefix, err)
 	}
 
+	if err := l.hotUnplugSRIOVHostDevices(vmi, domain, domainSpec); err != nil {
+		return fmt.Errorf("failed to hot-unplug host-devices: %v", err)
+	}
+
 	sriovHostDevices, err := sriov.GetHostDevicesToAttach(vmi, domainSpec)
 	if err != nil {
 		return fmt.Errorf("%s: %v", errMsgPrefix, err)
@@ -592,8 +601,26 @@ func (l *LibvirtDomainManager) hotPlugHostDevices(vmi *v1.VirtualMachineInstance
 	return nil
 }
 
-func (l *LibvirtDomainManager) Exec(domainName, command string, args []string, timeoutSeconds int32) (string, error) {
-	return agent.GuestExec(l.virConn, domainName, command, args, timeoutSeconds)
+// hotUnplugSRIOVHostDevices detaches the host-devices of absent SRIOV interfaces,
+// waiting for the guest to release them.
+func (l *LibvirtDomainManager) hotUnplugSRIOVHostDevices(vmi *v1.VirtualMachineInstance, domain cli.VirDomain, domainSpec *api.DomainSpec) error {
+	hostDevicesToDetach := sriov.GetHostDevicesToDetach(vmi, domainSpec)
+	if len(hostDevicesToDetach) == 0 {
+		return nil
+	}
+
+	eventChan := make(chan interface{}, hostdevice.MaxConcurrentHotPlugDevicesEvents)
+	var callback libvirt.DomainEventDeviceRemovedCallback = func(c *libvirt.Connect, d *libvirt.Domain, event *libvirt.DomainEventDeviceRemoved) {
+		eventChan <- event.DevAlias
+	}
+	domainEvent := cli.NewDomainEventDeviceRemoved(l.virConn, domain, callback, eventChan)
+
+	const waitForDetachTimeout = 30 * time.Second
+	return hostdevice.SafelyDetachHostDevices(hostDevicesToDetach, domainEvent, domain, waitForDetachTimeout)
+}
+
+func (l *LibvirtDomainManager) Exec(domainName, command string, args []string, timeoutSeconds int32) (string, string, error) {
+	return agent.GuestExecWithOutput(l.virConn, domainName, command, args, timeoutSeconds)
 }
 
 func (l *LibvirtDomainManager) GuestPing(domainName string) error {
@@ -782,6 +809,11 @@ func (l *LibvirtDomainManager) preStartHook(vmi *v1.VirtualMachineInstance, doma
 		return domain, fmt.Errorf("preparing the pod network failed: %v", err)
 	}
 
+	logger.Info("Starting SetupNetwork hook")
+	if err := hooksManager.SetupNetwork(vmi); err != nil {
+		return domain, fmt.Errorf("SetupNetwork hook failed: %v", err)
+	}
+
 	// Create ephemeral disk for container disks
 	err = containerdisk.CreateEphemeralImages(vmi, l.ephemeralDiskCreator, disksInfo)
 	if err != nil {
@@ -1254,7 +1286,7 @@ func (l *LibvirtDomainManager) syncNetworkHotplug(
 	}
 
 	networkConfigurator := netsetup.NewVMNetworkConfigurator(vmi, cache.CacheCreator{}, netsetup.WithDomainAttachments(domainAttachments))
-	networkInterfaceManager := newVirtIOInterfaceManager(dom, networkConfigurator)
+	networkInterfaceManager := newVirtIOInterfaceManager(dom, networkConfigurator, hooks.GetManager())
 	if err := networkInterfaceManager.hotplugVirtioInterface(vmi, &api.Domain{Spec: *oldSpec}, domain); err != nil {
 		return err
 	}
@@ -1274,10 +1306,12 @@ func (l *LibvirtDomainManager) startDomain(
 	}
 
 	createFlags := getDomainCreateFlags(vmi)
-	if err := dom.CreateWithFlags(createFlags); err != nil {
-		logger.Reason(err).
-			Errorf("Failed to start VirtualMachineInstance with flags %v.", createFlags)
-		return err
+	if resumed := l.startDomainFromMemoryState(vmi, dom, createFlags); !resumed {
+		if err := dom.CreateWithFlags(createFlags); err != nil {
+			logger.Reason(err).
+				Errorf("Failed to start VirtualMachineInstance with flags %v.", createFlags)
+			return err
+		}
 	}
 
 	logger.Info("Domain started.")
@@ -1287,6 +1321,30 @@ func (l *LibvirtDomainManager) startDomain(
 	return nil
 }
 
+// startDomainFromMemoryState resumes the domain from a saved memory state if
+// the VMI requests it. The domain boots instead if that is not possible, its
+// disks were snapshotted together with the memory state and are consistent.
+func (l *LibvirtDomainManager) startDomainFromMemoryState(vmi *v1.VirtualMachineInstance, dom cli.VirDomain, createFlags libvirt.DomainCreateFlags) bool {
+	logger := log.Log.Object(vmi)
+
+	memoryState, err := findMemoryState(vmi, memoryStateDir)
+	if err != nil {
+		logger.Reason(err).Warning("Failed to find the memory state to resume from, booting instead.")
+		return false
+	}
+	if memoryState == "" {
+		return false
+	}
+
+	if err := l.resumeFromMemoryState(dom, memoryState, createFlags); err != nil {
+		logger.Reason(err).Warningf("Failed to resume from memory state %s, booting instead.", memoryState)
+		return false
+	}
+
+	logger.Infof("Domain resumed from memory state %s.", memoryState)
+	return true
+}
+
 func (l *LibvirtDomainManager) lookupOrCreateVirDomain(
 	domain *api.Domain,
 	vmi *v1.VirtualMachineInstance,
@@ -1542,7 +1600,11 @@ func (l *LibvirtDomainManager) memoryDump(vmi *v1.VirtualMachineInstance, dumpPa
 	logger.Infof("Starting memory dump")
 	failed := false
 	reason := ""
-	err = dom.CoreDumpWithFormat(dumpPath, libvirt.DOMAIN_CORE_DUMP_FORMAT_RAW, libvirt.DUMP_MEMORY_ONLY)
+	if memoryDumpFormat(vmi, dumpPath) == v1.MemoryDumpFormatSaveState {
+		err = saveMemoryState(dom, dumpPath)
+	} else {
+		err = dom.CoreDumpWithFormat(dumpPath, libvirt.DOMAIN_CORE_DUMP_FORMAT_RAW, libvirt.DUMP_MEMORY_ONLY)
+	}
 	if err != nil {
 		failed = true
 		reason = fmt.Sprintf("%s: %s", failedDomainMemoryDump, err)
@@ -2174,9 +2236,33 @@ func (l *LibvirtDomainManager) GetGuestInfo() v1.VirtualMachineInstanceGuestAgen
 	return guestInfo
 }
 
-// InterfacesStatus returns the interfaces Guest Agent reported
+// InterfacesStatus returns the interfaces Guest Agent reported,
+// completed by the ones reported by the network binding plugins.
 func (l *LibvirtDomainManager) InterfacesStatus() []api.InterfaceStatus {
-	return l.agentData.GetInterfaceStatus()
+	interfacesStatus := l.agentData.GetInterfaceStatus()
+
+	pluginInterfacesStatus, err := hooks.GetManager().InterfacesStatus()
+	if err != nil {
+		log.Log.Reason(err).Warning("failed to get the interfaces status from the network binding plugins")
+		return interfacesStatus
+	}
+	return mergeInterfacesStatus(interfacesStatus, pluginInterfacesStatus)
+}
+
+// mergeInterfacesStatus appends the interfaces reported by the network binding plugins
+// which are not reported by the Guest Agent.
+func mergeInterfacesStatus(agentInterfacesStatus, pluginInterfacesStatus []api.InterfaceStatus) []api.InterfaceStatus {
+	reportedMACs := map[string]struct{}{}
+	for _, ifaceStatus := range agentInterfacesStatus {
+		reportedMACs[ifaceStatus.Mac] = struct{}{}
+	}
+
+	for _, ifaceStatus := range pluginInterfacesStatus {
+		if _, reported := reportedMACs[ifaceStatus.Mac]; !reported {
+			agentInterfacesStatus = append(agentInterfacesStatus, ifaceStatus)
+		}
+	}
+	return agentInterfacesStatus
 }
 
 // GetGuestOSInfo returns the Guest OS version and architecture
@@ -55,6 +55,7 @@ import (
 	"kubevirt.io/kubevirt/pkg/network/vmispec"
 	virtpointer "kubevirt.io/kubevirt/pkg/pointer"
 	"kubevirt.io/kubevirt/pkg/testutils"
+	kutil "kubevirt.io/kubevirt/pkg/util"
 	"kubevirt.io/kubevirt/pkg/util/net/ip"
 	virtconfig "kubevirt.io/kubevirt/pkg/virt-config"
 	cmdclient "kubevirt.io/kubevirt/pkg/virt-handler/cmd-client"
@@ -470,6 +471,54 @@ var _ = Describe("Manager", func() {
 			Expect(err).ToNot(HaveOccurred())
 			Expect(newspec).ToNot(BeNil())
 		})
+		It("should define and resume a new VirtualMachineInstance from its memory state", func() {
+			vmi := newVMI(testNamespace, testVmName)
+			vmi.Annotations = map[string]string{v1.ResumeFromMemoryStateAnnotation: "memory-state"}
+			memoryStateDir = GinkgoT().TempDir()
+			DeferCleanup(func() { memoryStateDir = kutil.MemoryStateDir })
+			memoryState := filepath.Join(memoryStateDir, "testvmi-memory-20250101-000000.memory.dump")
+			Expect(os.WriteFile(memoryState, []byte{}, 0o644)).To(Succeed())
+			mockConn.EXPECT().LookupDomainByName(testDomainName).Return(nil, libvirt.Error{Code: libvirt.ERR_NO_DOMAIN})
+
+			domainSpec := expectedDomainFor(vmi)
+
+			xml, err := xml.MarshalIndent(domainSpec, "", "\t")
+			Expect(err).ToNot(HaveOccurred())
+			mockConn.EXPECT().DomainDefineXML(string(xml)).DoAndReturn(mockDomainWithFreeExpectation)
+			mockDomain.EXPECT().GetState().Return(libvirt.DOMAIN_SHUTDOWN, 1, nil)
+			mockDomain.EXPECT().GetXMLDesc(libvirt.DOMAIN_XML_SECURE).Return(string(xml), nil)
+			mockConn.EXPECT().DomainRestoreFlags(memoryState, string(xml), libvirt.DOMAIN_SAVE_RUNNING).Return(nil)
+			mockDomain.EXPECT().CreateWithFlags(gomock.Any()).Times(0)
+			mockDomain.EXPECT().GetXMLDesc(libvirt.DomainXMLFlags(0)).MaxTimes(2).Return(string(xml), nil)
+			manager, _ := NewLibvirtDomainManager(mockConn, testVirtShareDir, testEphemeralDiskDir, nil, "/usr/share/OVMF", ephemeralDiskCreatorMock, metadataCache)
+			newspec, err := manager.SyncVMI(vmi, true, &cmdv1.VirtualMachineOptions{VirtualMachineSMBios: &cmdv1.SMBios{}})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(newspec).ToNot(BeNil())
+		})
+		It("should boot a new VirtualMachineInstance if resuming from its memory state fails", func() {
+			vmi := newVMI(testNamespace, testVmName)
+			vmi.Annotations = map[string]string{v1.ResumeFromMemoryStateAnnotation: "memory-state"}
+			memoryStateDir = GinkgoT().TempDir()
+			DeferCleanup(func() { memoryStateDir = kutil.MemoryStateDir })
+			memoryState := filepath.Join(memoryStateDir, "testvmi-memory-20250101-000000.memory.dump")
+			Expect(os.WriteFile(memoryState, []byte{}, 0o644)).To(Succeed())
+			mockConn.EXPECT().LookupDomainByName(testDomainName).Return(nil, libvirt.Error{Code: libvirt.ERR_NO_DOMAIN})
+
+			domainSpec := expectedDomainFor(vmi)
+
+			xml, err := xml.MarshalIndent(domainSpec, "", "\t")
+			Expect(err).ToNot(HaveOccurred())
+			mockConn.EXPECT().DomainDefineXML(string(xml)).DoAndReturn(mockDomainWithFreeExpectation)
+			mockDomain.EXPECT().GetState().Return(libvirt.DOMAIN_SHUTDOWN, 1, nil)
+			mockDomain.EXPECT().GetXMLDesc(libvirt.DOMAIN_XML_SECURE).Return(string(xml), nil)
+			mockConn.EXPECT().DomainRestoreFlags(memoryState, string(xml), libvirt.DOMAIN_SAVE_RUNNING).Return(fmt.Errorf("incompatible domain"))
+			mockDomain.EXPECT().CreateWithFlags(libvirt.DOMAIN_NONE).Return(nil)
+			mockDomain.EXPECT().GetXMLDesc(libvirt.DomainXMLFlags(0)).MaxTimes(2).Return(string(xml), nil)
+			manager, _ := NewLibvirtDomainManager(mockConn, testVirtShareDir, testEphemeralDiskDir, nil, "/usr/share/OVMF", ephemeralDiskCreatorMock, metadataCache)
+			newspec, err := manager.SyncVMI(vmi, true, &cmdv1.VirtualMachineOptions{VirtualMachineSMBios: &cmdv1.SMBios{}})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(newspec).ToNot(BeNil())
+		})
 		It("should define and start a new VirtualMachineInstance with userData", func() {
 			vmi := newVMI(testNamespace, testVmName)
 			mockConn.EXPECT().LookupDomainByName(testDomainName).Return(nil, libvirt.Error{Code: libvirt.ERR_NO_DOMAIN})
@@ -674,6 +723,32 @@ var _ = Describe("Manager", func() {
 			// not to call core dump command again
 			Expect(manager.MemoryDump(vmi, testDumpPath)).To(Succeed())
 		})
+		It("should save the memory state when the memory dump volume requests it", func() {
+			mockConn.EXPECT().LookupDomainByName(testDomainName).DoAndReturn(mockDomainWithFreeExpectation)
+			domainSpec := &api.DomainSpec{}
+			domainSpec.Devices.Disks = []api.Disk{{Target: api.DiskTarget{Device: "vda"}}}
+			domainXML, err := xml.Marshal(domainSpec)
+			Expect(err).ToNot(HaveOccurred())
+			mockDomain.EXPECT().GetXMLDesc(libvirt.DomainXMLFlags(0)).Return(string(domainXML), nil)
+			snapshotXML := `<domainsnapshot><memory snapshot="external" file="` + testDumpPath + `"></memory><disks><disk name="vda" snapshot="no"></disk></disks></domainsnapshot>`
+			mockDomain.EXPECT().CreateSnapshotXML(snapshotXML, libvirt.DOMAIN_SNAPSHOT_CREATE_NO_METADATA).Return(nil, nil)
+
+			manager, _ := NewLibvirtDomainManager(mockConn, testVirtShareDir, testEphemeralDiskDir, nil, "/usr/share/OVMF", ephemeralDiskCreatorMock, metadataCache)
+
+			vmi := newVMI(testNamespace, testVmName)
+			vmi.Spec.Volumes = append(vmi.Spec.Volumes, v1.Volume{
+				Name: filepath.Base(filepath.Dir(testDumpPath)),
+				VolumeSource: v1.VolumeSource{
+					MemoryDump: &v1.MemoryDumpVolumeSource{Format: v1.MemoryDumpFormatSaveState},
+				},
+			})
+			Expect(manager.MemoryDump(vmi, testDumpPath)).To(Succeed())
+
+			Eventually(func() bool {
+				memoryDump, _ := metadataCache.MemoryDump.Load()
+				return memoryDump.Completed && !memoryDump.Failed
+			}, 5*time.Second, 2).Should(BeTrue())
+		})
 		It("should update domain with memory dump info if memory dump failed", func() {
 			mockConn.EXPECT().LookupDomainByName(testDomainName).DoAndReturn(mockDomainWithFreeExpectation)
 			dumpFailure := fmt.Errorf("Memory dump failed!!")
@@ -2276,6 +2351,57 @@ var _ = Describe("Manager", func() {
 		Expect(libvirtmanager.hotPlugHostDevices(vmi)).To(Succeed())
 	})
 
+	It("executes hotPlugHostDevices and detaches the host-devices of absent interfaces", func() {
+		manager, _ := NewLibvirtDomainManager(mockConn, testVirtShareDir, testEphemeralDiskDir, nil, "/usr/share/OVMF", ephemeralDiskCreatorMock, metadataCache)
+		libvirtmanager := manager.(*LibvirtDomainManager)
+
+		vmi := newVMI(testNamespace, testVmName)
+		vmi.Spec.Domain.Devices.Interfaces = append(
+			vmi.Spec.Domain.Devices.Interfaces,
+			v1.Interface{
+				Name:                   "test1",
+				State:                  v1.InterfaceStateAbsent,
+				InterfaceBindingMethod: v1.InterfaceBindingMethod{SRIOV: &v1.InterfaceSRIOV{}},
+			},
+		)
+		vmi.Spec.Networks = append(
+			vmi.Spec.Networks,
+			v1.Network{Name: "test1", NetworkSource: v1.NetworkSource{Multus: &v1.MultusNetwork{NetworkName: "test1"}}},
+		)
+
+		domainSpec := expectedDomainFor(vmi)
+		domainSpec.Devices.HostDevices = append(domainSpec.Devices.HostDevices, api.HostDevice{
+			Type:    api.HostDevicePCI,
+			Managed: "no",
+			Source: api.HostDeviceSource{
+				Address: &api.Address{Type: api.AddressPCI, Domain: "0x0000", Bus: "0x81", Slot: "0x01", Function: "0x0"},
+			},
+			Alias: api.NewUserDefinedAlias("sriov-test1"),
+		})
+		xml, err := xml.MarshalIndent(domainSpec, "", "\t")
+		Expect(err).NotTo(HaveOccurred())
+
+		const registrationID = 1
+		var deviceRemovedCallback libvirt.DomainEventDeviceRemovedCallback
+		mockConn.EXPECT().LookupDomainByName(testDomainName).DoAndReturn(mockDomainWithFreeExpectation)
+		mockDomain.EXPECT().GetXMLDesc(libvirt.DomainXMLFlags(0)).Return(string(xml), nil)
+		mockConn.EXPECT().VolatileDomainEventDeviceRemovedRegister(mockDomain, gomock.Any()).DoAndReturn(
+			func(_ cli.VirDomain, callback libvirt.DomainEventDeviceRemovedCallback) (int, error) {
+				deviceRemovedCallback = callback
+				return registrationID, nil
+			})
+		mockDomain.EXPECT().DetachDeviceFlags(
+			`<hostdev type="pci" managed="no"><source><address type="pci" domain="0x0000" bus="0x81" slot="0x01" function="0x0"></address></source><alias name="ua-sriov-test1"></alias></hostdev>`,
+			libvirt.DomainDeviceModifyFlags(3),
+		).DoAndReturn(func(_ string, _ libvirt.DomainDeviceModifyFlags) error {
+			deviceRemovedCallback(nil, nil, &libvirt.DomainEventDeviceRemoved{DevAlias: "ua-sriov-test1"})
+			return nil
+		})
+		mockConn.EXPECT().DomainEventDeregister(registrationID).Return(nil)
+
+		Expect(libvirtmanager.hotPlugHostDevices(vmi)).To(Succeed())
+	})
+
 	It("executes GetGuestInfo", func() {
 		agentStore := agentpoller.NewAsyncAgentStore()
 		agentStore.Store(agentpoller.GET_USERS, []api.User{
@@ -3134,6 +3260,50 @@ var _ = Describe("Manager helper functions", func() {
 		})
 	})
 
+	Context("migrationCompressionMethod", func() {
+		DescribeTable("should select", func(options *cmdclient.MigrationOptions, expectedMethod string) {
+			Expect(migrationCompressionMethod(options)).To(Equal(expectedMethod))
+			if options == nil {
+				return
+			}
+
+			flags := generateMigrationFlags(false, false, options)
+			if expectedMethod == "" {
+				Expect(flags & libvirt.MIGRATE_COMPRESSED).To(BeZero())
+			} else {
+				Expect(flags & libvirt.MIGRATE_COMPRESSED).To(Equal(libvirt.MIGRATE_COMPRESSED))
+			}
+		},
+			Entry("no compression with nil options", nil, ""),
+			Entry("no compression without settings", &cmdclient.MigrationOptions{}, ""),
+			Entry("zstd over parallel connections",
+				&cmdclient.MigrationOptions{ParallelMigrationThreads: virtpointer.P(uint(3)), Compression: v1.MigrationCompressionZstd}, "zstd"),
+			Entry("zlib over parallel connections",
+				&cmdclient.MigrationOptions{ParallelMigrationThreads: virtpointer.P(uint(3)), Compression: v1.MigrationCompressionZlib}, "zlib"),
+			Entry("no compression without parallel connections if only zstd is set",
+				&cmdclient.MigrationOptions{Compression: v1.MigrationCompressionZstd}, ""),
+			Entry("XBZRLE without parallel connections",
+				&cmdclient.MigrationOptions{XBZRLECacheSize: resource.MustParse("64Mi")}, migrationCompressionXBZRLE),
+			Entry("no XBZRLE over parallel connections",
+				&cmdclient.MigrationOptions{ParallelMigrationThreads: virtpointer.P(uint(3)), XBZRLECacheSize: resource.MustParse("64Mi")}, ""),
+		)
+	})
+
+	Context("classifyMigrationError", func() {
+		DescribeTable("should classify", func(err error, expectedClass v1.MigrationFailureClass) {
+			Expect(classifyMigrationError(err)).To(Equal(expectedClass))
+		},
+			Entry("an aborted job as aborted", libvirt.Error{Code: libvirt.ERR_OPERATION_ABORTED}, v1.MigrationFailureAborted),
+			Entry("a timed out operation as timeout", libvirt.Error{Code: libvirt.ERR_OPERATION_TIMEOUT}, v1.MigrationFailureTimeout),
+			Entry("a vanished domain as guest failure", libvirt.Error{Code: libvirt.ERR_NO_DOMAIN}, v1.MigrationFailureGuest),
+			Entry("an RPC error as network failure", libvirt.Error{Code: libvirt.ERR_INTERNAL_ERROR, Domain: libvirt.FROM_RPC}, v1.MigrationFailureNetwork),
+			Entry("a wrapped connection error as network failure",
+				fmt.Errorf("error encountered during MigrateToURI3 libvirt api call: %w", libvirt.Error{Code: libvirt.ERR_NO_CONNECT}), v1.MigrationFailureNetwork),
+			Entry("any other libvirt error as unknown", libvirt.Error{Code: libvirt.ERR_INTERNAL_ERROR}, v1.MigrationFailureUnknown),
+			Entry("a non-libvirt error as unknown", fmt.Errorf("something went wrong"), v1.MigrationFailureUnknown),
+		)
+	})
+
 })
 
 func newVMI(namespace, name string) *v1.VirtualMachineInstance {
@@ -0,0 +1,128 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package virtwrap
+
+import (
+	"encoding/xml"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"libvirt.org/go/libvirt"
+
+	v1 "kubevirt.io/api/core/v1"
+
+	kutil "kubevirt.io/kubevirt/pkg/util"
+	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/api"
+	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/cli"
+	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/util"
+)
+
+const memoryDumpSuffix = ".memory.dump"
+
+var memoryStateDir = kutil.MemoryStateDir
+
+// memoryDumpFormat returns the format requested for the memory dump volume
+// the dump is written to. The volume is mounted in a directory named after it.
+func memoryDumpFormat(vmi *v1.VirtualMachineInstance, dumpPath string) v1.MemoryDumpFormat {
+	volumeName := filepath.Base(filepath.Dir(dumpPath))
+	for _, volume := range vmi.Spec.Volumes {
+		if volume.Name == volumeName && volume.MemoryDump != nil && volume.MemoryDump.Format != "" {
+			return volume.MemoryDump.Format
+		}
+	}
+	return v1.MemoryDumpFormatRaw
+}
+
+// generateMemoryStateSnapshot builds a snapshot which only saves the memory
+// and device state of the domain, the disks are snapshotted by the storage.
+func generateMemoryStateSnapshot(domSpec *api.DomainSpec, dumpPath string) *api.DomainSnapshot {
+	snapshot := &api.DomainSnapshot{
+		Memory: api.DomainSnapshotMemory{
+			Snapshot: "external",
+			File:     dumpPath,
+		},
+	}
+	for _, disk := range domSpec.Devices.Disks {
+		snapshot.Disks.Disks = append(snapshot.Disks.Disks, api.DomainSnapshotDisk{
+			Name:     disk.Target.Device,
+			Snapshot: "no",
+		})
+	}
+	return snapshot
+}
+
+// saveMemoryState writes a save image of the domain the VMI can later be
+// resumed from, without stopping the domain.
+func saveMemoryState(dom cli.VirDomain, dumpPath string) error {
+	domSpec, err := util.GetDomainSpecWithFlags(dom, 0)
+	if err != nil {
+		return err
+	}
+
+	snapshotXML, err := xml.Marshal(generateMemoryStateSnapshot(domSpec, dumpPath))
+	if err != nil {
+		return err
+	}
+
+	snapshot, err := dom.CreateSnapshotXML(string(snapshotXML), libvirt.DOMAIN_SNAPSHOT_CREATE_NO_METADATA)
+	if err != nil {
+		return err
+	}
+	if snapshot != nil {
+		snapshot.Free()
+	}
+	return nil
+}
+
+// findMemoryState returns the saved memory state the VMI should be resumed
+// from, or an empty string if it should boot.
+func findMemoryState(vmi *v1.VirtualMachineInstance, dir string) (string, error) {
+	if _, exists := vmi.Annotations[v1.ResumeFromMemoryStateAnnotation]; !exists {
+		return "", nil
+	}
+
+	files, err := os.ReadDir(dir)
+	if err != nil {
+		return "", err
+	}
+	for _, file := range files {
+		if !file.IsDir() && strings.HasSuffix(file.Name(), memoryDumpSuffix) {
+			return filepath.Join(dir, file.Name()), nil
+		}
+	}
+	return "", fmt.Errorf("no memory state found in %s", dir)
+}
+
+// resumeFromMemoryState starts the defined domain from a saved memory state.
+// libvirt verifies that the domain definition is compatible with the saved one.
+func (l *LibvirtDomainManager) resumeFromMemoryState(dom cli.VirDomain, memoryState string, createFlags libvirt.DomainCreateFlags) error {
+	domXML, err := dom.GetXMLDesc(libvirt.DOMAIN_XML_SECURE)
+	if err != nil {
+		return err
+	}
+
+	restoreFlags := libvirt.DOMAIN_SAVE_RUNNING
+	if createFlags&libvirt.DOMAIN_START_PAUSED != 0 {
+		restoreFlags = libvirt.DOMAIN_SAVE_PAUSED
+	}
+	return l.virConn.DomainRestoreFlags(memoryState, domXML, restoreFlags)
+}
@@ -43,9 +43,15 @@ type vmConfigurator interface {
 	SetupPodNetworkPhase2(domain *api.Domain, networksToPlug []v1.Network) error
 }
 
+type bindingPluginsNotifier interface {
+	HotplugInterfaces(vmi *v1.VirtualMachineInstance, networks []string) error
+	UnplugInterfaces(vmi *v1.VirtualMachineInstance, networks []string) error
+}
+
 type virtIOInterfaceManager struct {
-	dom          cli.VirDomain
-	configurator vmConfigurator
+	dom            cli.VirDomain
+	configurator   vmConfigurator
+	bindingPlugins bindingPluginsNotifier
 }
 
 const (
@@ -57,15 +63,24 @@ const (
 func newVirtIOInterfaceManager(
 	libvirtClient cli.VirDomain,
 	configurator vmConfigurator,
+	bindingPlugins bindingPluginsNotifier,
 ) *virtIOInterfaceManager {
 	return &virtIOInterfaceManager{
-		dom:          libvirtClient,
-		configurator: configurator,
+		dom:            libvirtClient,
+		configurator:   configurator,
+		bindingPlugins: bindingPlugins,
 	}
 }
 
 func (vim *virtIOInterfaceManager) hotplugVirtioInterface(vmi *v1.VirtualMachineInstance, currentDomain *api.Domain, updatedDomain *api.Domain) error {
-	for _, network := range networksToHotplugWhoseInterfacesAreNotInTheDomain(vmi, indexedDomainInterfaces(currentDomain)) {
+	networksToHotplug := networksToHotplugWhoseInterfacesAreNotInTheDomain(vmi, indexedDomainInterfaces(currentDomain))
+	if pluginNetworks := networksBoundByPlugins(vmi, networksToHotplug); len(pluginNetworks) > 0 {
+		if err := vim.bindingPlugins.HotplugInterfaces(vmi, pluginNetworks); err != nil {
+			return err
+		}
+	}
+
+	for _, network := range networksToHotplug {
 		log.Log.Infof("will hot plug %s", network.Name)
 
 		if err := vim.configurator.SetupPodNetworkPhase2(updatedDomain, []v1.Network{network}); err != nil {
@@ -96,6 +111,7 @@ func (vim *virtIOInterfaceManager) hotplugVirtioInterface(vmi *v1.VirtualMachine
 }
 
 func (vim *virtIOInterfaceManager) hotUnplugVirtioInterface(vmi *v1.VirtualMachineInstance, currentDomain *api.Domain) error {
+	var unpluggedNetworks []v1.Network
 	for _, domainIface := range interfacesToHotUnplug(vmi.Spec.Domain.Devices.Interfaces, vmi.Spec.Networks, currentDomain.Spec.Devices.Interfaces) {
 		log.Log.Infof("preparing to hot-unplug %s", domainIface.Alias.GetName())
 
@@ -108,10 +124,29 @@ func (vim *virtIOInterfaceManager) hotUnplugVirtioInterface(vmi *v1.VirtualMachi
 			log.Log.Reason(derr).Errorf("libvirt failed to detach interface %s: %v", domainIface.Alias.GetName(), derr)
 			return derr
 		}
+		if network := netvmispec.LookupNetworkByName(vmi.Spec.Networks, domainIface.Alias.GetName()); network != nil {
+			unpluggedNetworks = append(unpluggedNetworks, *network)
+		}
+	}
+
+	if pluginNetworks := networksBoundByPlugins(vmi, unpluggedNetworks); len(pluginNetworks) > 0 {
+		return vim.bindingPlugins.UnplugInterfaces(vmi, pluginNetworks)
 	}
 	return nil
 }
 
+// networksBoundByPlugins returns the names of the given networks whose interfaces use a network binding plugin.
+func networksBoundByPlugins(vmi *v1.VirtualMachineInstance, networks []v1.Network) []string {
+	var networkNames []string
+	for _, network := range networks {
+		iface := netvmispec.LookupInterfaceByName(vmi.Spec.Domain.Devices.Interfaces, network.Name)
+		if iface != nil && iface.Binding != nil {
+			networkNames = append(networkNames, network.Name)
+		}
+	}
+	return networkNames
+}
+
 func interfacesToHotUnplug(vmiSpecInterfaces []v1.Interface, vmiSpecNets []v1.Network, domainSpecInterfaces []api.Interface) []api.Interface {
 	ifaces2remove := netvmispec.FilterInterfacesSpec(vmiSpecInterfaces, func(iface v1.Interface) bool {
 		return iface.State == v1.InterfaceStateAbsent
@@ -147,6 +147,7 @@ var _ = Describe("nic hotplug on virt-launcher", func() {
 			networkInterfaceManager := newVirtIOInterfaceManager(
 				mockLibvirtClient(gomock.NewController(GinkgoT()), result),
 				&fakeVMConfigurator{},
+				&fakeBindingPlugins{},
 			)
 			Expect(networkInterfaceManager.hotplugVirtioInterface(vmi, currentDomain, updatedDomain)).To(Succeed())
 		},
@@ -171,6 +172,7 @@ var _ = Describe("nic hotplug on virt-launcher", func() {
 			networkInterfaceManager := newVirtIOInterfaceManager(
 				mockLibvirtClient(gomock.NewController(GinkgoT()), result),
 				configurator,
+				&fakeBindingPlugins{},
 			)
 			Expect(networkInterfaceManager.hotplugVirtioInterface(vmi, currentDomain, updatedDomain)).To(MatchError("boom"))
 		},
@@ -189,6 +191,34 @@ var _ = Describe("nic hotplug on virt-launcher", func() {
 			libvirtClientResult{expectedError: fmt.Errorf("boom")},
 		),
 	)
+
+	It("hotplugVirtioInterface notifies the network binding plugins of the interfaces they serve", func() {
+		vmi := vmiWithSingleBridgeInterfaceWithPodInterfaceReady(networkName, nadName)
+		vmi.Spec.Domain.Devices.Interfaces[0].InterfaceBindingMethod = v1.InterfaceBindingMethod{}
+		vmi.Spec.Domain.Devices.Interfaces[0].Binding = &v1.PluginBinding{Name: "custom"}
+
+		bindingPlugins := &fakeBindingPlugins{}
+		networkInterfaceManager := newVirtIOInterfaceManager(
+			mockLibvirtClient(gomock.NewController(GinkgoT()), libvirtClientResult{expectedAttachedDevices: 1}),
+			&fakeVMConfigurator{},
+			bindingPlugins,
+		)
+		Expect(networkInterfaceManager.hotplugVirtioInterface(vmi, dummyDomain(), dummyDomain(networkName))).To(Succeed())
+		Expect(bindingPlugins.hotpluggedNetworks).To(Equal([]string{networkName}))
+	})
+
+	It("hotplugVirtioInterface FAILS when the network binding plugins fail to prepare the interface", func() {
+		vmi := vmiWithSingleBridgeInterfaceWithPodInterfaceReady(networkName, nadName)
+		vmi.Spec.Domain.Devices.Interfaces[0].InterfaceBindingMethod = v1.InterfaceBindingMethod{}
+		vmi.Spec.Domain.Devices.Interfaces[0].Binding = &v1.PluginBinding{Name: "custom"}
+
+		networkInterfaceManager := newVirtIOInterfaceManager(
+			mockLibvirtClient(gomock.NewController(GinkgoT()), libvirtClientResult{}),
+			&fakeVMConfigurator{},
+			&fakeBindingPlugins{expectedError: fmt.Errorf("boom")},
+		)
+		Expect(networkInterfaceManager.hotplugVirtioInterface(vmi, dummyDomain(), dummyDomain(networkName))).To(MatchError("boom"))
+	})
 })
 
 var _ = Describe("nic hot-unplug on virt-launcher", func() {
@@ -237,6 +267,27 @@ var _ = Describe("nic hot-unplug on virt-launcher", func() {
 			},
 		),
 	)
+
+	It("hotUnplugVirtioInterface notifies the network binding plugins of the interfaces they served", func() {
+		vmi := &v1.VirtualMachineInstance{}
+		vmi.Spec.Networks = []v1.Network{{Name: networkName, NetworkSource: v1.NetworkSource{Multus: &v1.MultusNetwork{}}}}
+		vmi.Spec.Domain.Devices.Interfaces = []v1.Interface{{
+			Name:    networkName,
+			State:   v1.InterfaceStateAbsent,
+			Binding: &v1.PluginBinding{Name: "custom"},
+		}}
+		currentDomain := &api.Domain{Spec: api.DomainSpec{Devices: api.Devices{Interfaces: []api.Interface{
+			{Target: &api.InterfaceTarget{Device: hashedDevice}, Alias: api.NewUserDefinedAlias(networkName)},
+		}}}}
+
+		mockClient := cli.NewMockVirDomain(gomock.NewController(GinkgoT()))
+		mockClient.EXPECT().DetachDeviceFlags(gomock.Any(), gomock.Any()).Return(nil)
+		bindingPlugins := &fakeBindingPlugins{}
+		networkInterfaceManager := newVirtIOInterfaceManager(mockClient, &fakeVMConfigurator{}, bindingPlugins)
+
+		Expect(networkInterfaceManager.hotUnplugVirtioInterface(vmi, currentDomain)).To(Succeed())
+		Expect(bindingPlugins.unpluggedNetworks).To(Equal([]string{networkName}))
+	})
 })
 
 var _ = Describe("domain network interfaces resources", func() {
@@ -381,3 +432,19 @@ type fakeVMConfigurator struct {
 func (fvc *fakeVMConfigurator) SetupPodNetworkPhase2(*api.Domain, []v1.Network) error {
 	return fvc.expectedError
 }
+
+type fakeBindingPlugins struct {
+	expectedError      error
+	hotpluggedNetworks []string
+	unpluggedNetworks  []string
+}
+
+func (f *fakeBindingPlugins) HotplugInterfaces(_ *v1.VirtualMachineInstance, networks []string) error {
+	f.hotpluggedNetworks = append(f.hotpluggedNetworks, networks...)
+	return f.expectedError
+}
+
+func (f *fakeBindingPlugins) UnplugInterfaces(_ *v1.VirtualMachineInstance, networks []string) error {
+	f.unpluggedNetworks = append(f.unpluggedNetworks, networks...)
+	return f.expectedError
+}
@@ -190,4 +190,8 @@ type DomainJobInfo struct {
 	DataRemaining    uint64
 	MemDirtyRateSet  bool
 	MemDirtyRate     uint64
+	// CompressionRatioSet is true if the ratio of migrated guest memory to
+	// transferred memory data is known
+	CompressionRatioSet bool
+	CompressionRatio    float64
 }
@@ -220,6 +220,8 @@ func Convert_libvirt_DomainJobInfo_To_stats_DomainJobInfo(info *libvirt.DomainJo
 		return &stats.DomainJobInfo{}
 	}
 
+	compressionRatioSet, compressionRatio := migrationCompressionRatio(info)
+
 	return &stats.DomainJobInfo{
 		DataTotalSet:     info.DataTotalSet,
 		DataTotal:        info.DataTotal,
@@ -231,5 +233,21 @@ func Convert_libvirt_DomainJobInfo_To_stats_DomainJobInfo(info *libvirt.DomainJo
 		DataRemaining:    info.DataRemaining,
 		MemDirtyRateSet:  info.MemDirtyRateSet && info.MemPageSizeSet,
 		MemDirtyRate:     info.MemDirtyRate * info.MemPageSize,
+
+		CompressionRatioSet: compressionRatioSet,
+		CompressionRatio:    compressionRatio,
+	}
+}
+
+// migrationCompressionRatio relates the guest memory migrated so far to the memory
+// data transferred for it. Zero pages are not transferred and therefore not counted.
+func migrationCompressionRatio(info *libvirt.DomainJobInfo) (bool, float64) {
+	if !info.MemProcessedSet || info.MemProcessed == 0 || !info.MemNormalBytesSet {
+		return false, 0
+	}
+	migrated := info.MemNormalBytes
+	if info.CompressionPagesSet && info.MemPageSizeSet {
+		migrated += info.CompressionPages * info.MemPageSize
 	}
+	return true, float64(migrated) / float64(info.MemProcessed)
 }
@@ -113,6 +113,31 @@ var _ = Describe("StatsConverter", func() {
 			Expect(equal).To(BeTrue())
 		})
 	})
+
+	Context("on job info conversion", func() {
+		It("should calculate the compression ratio of the migrated memory", func() {
+			out := Convert_libvirt_DomainJobInfo_To_stats_DomainJobInfo(&libvirt.DomainJobInfo{
+				MemProcessedSet:     true,
+				MemProcessed:        1000,
+				MemNormalBytesSet:   true,
+				MemNormalBytes:      1000,
+				CompressionPagesSet: true,
+				CompressionPages:    2,
+				MemPageSizeSet:      true,
+				MemPageSize:         500,
+			})
+			Expect(out.CompressionRatioSet).To(BeTrue())
+			Expect(out.CompressionRatio).To(BeNumerically("==", 2))
+		})
+
+		It("should not report a compression ratio before memory is transferred", func() {
+			out := Convert_libvirt_DomainJobInfo_To_stats_DomainJobInfo(&libvirt.DomainJobInfo{
+				MemProcessedSet:   true,
+				MemNormalBytesSet: true,
+			})
+			Expect(out.CompressionRatioSet).To(BeFalse())
+		})
+	})
 })
 
 func JSONEqual(a, b io.Reader) (bool, error) {
@@ -200,6 +200,8 @@ var Testdataexpected = `{
      "DataRemainingSet": false,
      "MemDirtyRate": 0,
      "MemDirtyRateSet": false,
+     "CompressionRatio": 0,
+     "CompressionRatioSet": false,
      "MemoryBpsSet": false,
      "MemoryBps": 0
    },
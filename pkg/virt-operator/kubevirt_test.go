@@ -84,8 +84,8 @@ const (
 
 	NAMESPACE = "kubevirt-test"
 
-	resourceCount = 78
-	patchCount    = 50
+	resourceCount = 81
+	patchCount    = 53
 	updateCount   = 29
 )
 
@@ -1197,6 +1197,9 @@ func (k *KubeVirtTestData) addAllWithExclusionMap(config *util.KubeVirtDeploymen
 		components.NewVirtualMachineClusterInstancetypeCrd, components.NewVirtualMachinePoolCrd,
 		components.NewMigrationPolicyCrd, components.NewVirtualMachinePreferenceCrd,
 		components.NewVirtualMachineClusterPreferenceCrd, components.NewVirtualMachineCloneCrd,
+		components.NewVirtualMachineSnapshotScheduleCrd,
+		components.NewVirtualMachineSnapshotGroupCrd,
+		components.NewVirtualMachineRestoreGroupCrd,
 	}
 	for _, f := range functions {
 		crd, err := f()
@@ -2401,7 +2404,7 @@ var _ = Describe("KubeVirt Operator", func() {
 			Expect(kvTestData.controller.stores.ClusterRoleBindingCache.List()).To(HaveLen(7))
 			Expect(kvTestData.controller.stores.RoleCache.List()).To(HaveLen(5))
 			Expect(kvTestData.controller.stores.RoleBindingCache.List()).To(HaveLen(5))
-			Expect(kvTestData.controller.stores.OperatorCrdCache.List()).To(HaveLen(16))
+			Expect(kvTestData.controller.stores.OperatorCrdCache.List()).To(HaveLen(19))
 			Expect(kvTestData.controller.stores.ServiceCache.List()).To(HaveLen(4))
 			Expect(kvTestData.controller.stores.DeploymentCache.List()).To(HaveLen(1))
 			Expect(kvTestData.controller.stores.DaemonSetCache.List()).To(BeEmpty())
@@ -3143,6 +3146,7 @@ func getDefaultVirtHandlerDaemonSet(namespace string, config *util.KubeVirtDeplo
 		config.GetImagePullPolicy(),
 		config.GetImagePullSecrets(),
 		nil,
+		nil,
 		config.GetVerbosity(),
 		config.GetExtraEnv(),
 		false)
@@ -345,6 +345,7 @@ var _ = Describe("Apply Apps", func() {
 				virtHandlerConfig.GetImagePullPolicy(),
 				virtHandlerConfig.GetImagePullSecrets(),
 				nil,
+				nil,
 				virtHandlerConfig.GetVerbosity(),
 				virtHandlerConfig.GetExtraEnv(),
 				false)
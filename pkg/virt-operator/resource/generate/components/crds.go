@@ -66,6 +66,9 @@ var (
 	VIRTUALMACHINEPOOL               = "virtualmachinepools." + poolv1.SchemeGroupVersion.Group
 	VIRTUALMACHINESNAPSHOT           = "virtualmachinesnapshots." + snapshotv1beta1.SchemeGroupVersion.Group
 	VIRTUALMACHINESNAPSHOTCONTENT    = "virtualmachinesnapshotcontents." + snapshotv1beta1.SchemeGroupVersion.Group
+	VIRTUALMACHINESNAPSHOTSCHEDULE   = "virtualmachinesnapshotschedules." + snapshotv1beta1.SchemeGroupVersion.Group
+	VIRTUALMACHINESNAPSHOTGROUP      = "virtualmachinesnapshotgroups." + snapshotv1beta1.SchemeGroupVersion.Group
+	VIRTUALMACHINERESTOREGROUP       = "virtualmachinerestoregroups." + snapshotv1beta1.SchemeGroupVersion.Group
 	VIRTUALMACHINEEXPORT             = "virtualmachineexports." + exportv1beta1.SchemeGroupVersion.Group
 	MIGRATIONPOLICY                  = "migrationpolicies." + migrationsv1.MigrationPolicyKind.Group
 	VIRTUALMACHINECLONE              = "virtualmachineclones." + clone.GroupName
@@ -553,6 +556,135 @@ func NewVirtualMachineSnapshotContentCrd() (*extv1.CustomResourceDefinition, err
 	return crd, nil
 }
 
+func NewVirtualMachineSnapshotScheduleCrd() (*extv1.CustomResourceDefinition, error) {
+	crd := newBlankCrd()
+
+	crd.ObjectMeta.Name = VIRTUALMACHINESNAPSHOTSCHEDULE
+	crd.Spec = extv1.CustomResourceDefinitionSpec{
+		Group: snapshotv1beta1.SchemeGroupVersion.Group,
+		Versions: []extv1.CustomResourceDefinitionVersion{
+			{
+				Name:    snapshotv1beta1.SchemeGroupVersion.Version,
+				Served:  true,
+				Storage: true,
+				Subresources: &extv1.CustomResourceSubresources{
+					Status: &extv1.CustomResourceSubresourceStatus{},
+				},
+			},
+		},
+		Scope: "Namespaced",
+		Names: extv1.CustomResourceDefinitionNames{
+			Plural:     "virtualmachinesnapshotschedules",
+			Singular:   "virtualmachinesnapshotschedule",
+			Kind:       "VirtualMachineSnapshotSchedule",
+			ShortNames: []string{"vmsnapshotschedule", "vmsnapshotschedules"},
+			Categories: []string{
+				"all",
+			},
+		},
+	}
+	err := addFieldsToAllVersions(crd, []extv1.CustomResourceColumnDefinition{
+		{Name: "Schedule", Type: "string", JSONPath: ".spec.schedule"},
+		{Name: "Disabled", Type: "boolean", JSONPath: ".spec.disabled"},
+		{Name: "LastSchedule", Type: "date", JSONPath: ".status.lastScheduleTime"},
+		{Name: "LastSuccess", Type: "date", JSONPath: ".status.lastSuccess.time"},
+		{Name: "LastFailure", Type: "date", JSONPath: ".status.lastFailure.time"},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if err = patchValidationForAllVersions(crd); err != nil {
+		return nil, err
+	}
+	return crd, nil
+}
+
+func NewVirtualMachineSnapshotGroupCrd() (*extv1.CustomResourceDefinition, error) {
+	crd := newBlankCrd()
+
+	crd.ObjectMeta.Name = VIRTUALMACHINESNAPSHOTGROUP
+	crd.Spec = extv1.CustomResourceDefinitionSpec{
+		Group: snapshotv1beta1.SchemeGroupVersion.Group,
+		Versions: []extv1.CustomResourceDefinitionVersion{
+			{
+				Name:    snapshotv1beta1.SchemeGroupVersion.Version,
+				Served:  true,
+				Storage: true,
+				Subresources: &extv1.CustomResourceSubresources{
+					Status: &extv1.CustomResourceSubresourceStatus{},
+				},
+			},
+		},
+		Scope: "Namespaced",
+		Names: extv1.CustomResourceDefinitionNames{
+			Plural:     "virtualmachinesnapshotgroups",
+			Singular:   "virtualmachinesnapshotgroup",
+			Kind:       "VirtualMachineSnapshotGroup",
+			ShortNames: []string{"vmsnapshotgroup", "vmsnapshotgroups"},
+			Categories: []string{
+				"all",
+			},
+		},
+	}
+	err := addFieldsToAllVersions(crd, []extv1.CustomResourceColumnDefinition{
+		{Name: "Phase", Type: "string", JSONPath: ".status.phase"},
+		{Name: "ReadyToUse", Type: "boolean", JSONPath: ".status.readyToUse"},
+		{Name: "CreationTime", Type: "date", JSONPath: ".status.creationTime"},
+		{Name: "Error", Type: "string", JSONPath: errorMessageJSONPath},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if err = patchValidationForAllVersions(crd); err != nil {
+		return nil, err
+	}
+	return crd, nil
+}
+
+func NewVirtualMachineRestoreGroupCrd() (*extv1.CustomResourceDefinition, error) {
+	crd := newBlankCrd()
+
+	crd.ObjectMeta.Name = VIRTUALMACHINERESTOREGROUP
+	crd.Spec = extv1.CustomResourceDefinitionSpec{
+		Group: snapshotv1beta1.SchemeGroupVersion.Group,
+		Versions: []extv1.CustomResourceDefinitionVersion{
+			{
+				Name:    snapshotv1beta1.SchemeGroupVersion.Version,
+				Served:  true,
+				Storage: true,
+				Subresources: &extv1.CustomResourceSubresources{
+					Status: &extv1.CustomResourceSubresourceStatus{},
+				},
+			},
+		},
+		Scope: "Namespaced",
+		Names: extv1.CustomResourceDefinitionNames{
+			Plural:     "virtualmachinerestoregroups",
+			Singular:   "virtualmachinerestoregroup",
+			Kind:       "VirtualMachineRestoreGroup",
+			ShortNames: []string{"vmrestoregroup", "vmrestoregroups"},
+			Categories: []string{
+				"all",
+			},
+		},
+	}
+	err := addFieldsToAllVersions(crd, []extv1.CustomResourceColumnDefinition{
+		{Name: "SnapshotGroup", Type: "string", JSONPath: ".spec.virtualMachineSnapshotGroupName"},
+		{Name: "Complete", Type: "boolean", JSONPath: ".status.complete"},
+		{Name: "RestoreTime", Type: "date", JSONPath: ".status.restoreTime"},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if err = patchValidationForAllVersions(crd); err != nil {
+		return nil, err
+	}
+	return crd, nil
+}
+
 func NewVirtualMachineRestoreCrd() (*extv1.CustomResourceDefinition, error) {
 	crd := newBlankCrd()
 
@@ -24,6 +24,9 @@ var _ = Describe("CRDs", func() {
 		Entry("for KV", NewKubeVirtCrd),
 		Entry("for VMSNAPSHOT", NewVirtualMachineSnapshotCrd),
 		Entry("for VMSNAPSHOTCONTENT", NewVirtualMachineSnapshotContentCrd),
+		Entry("for VMSNAPSHOTSCHEDULE", NewVirtualMachineSnapshotScheduleCrd),
+		Entry("for VMSNAPSHOTGROUP", NewVirtualMachineSnapshotGroupCrd),
+		Entry("for VMRESTOREGROUP", NewVirtualMachineRestoreGroupCrd),
 		Entry("for VMPOOL", NewVirtualMachinePoolCrd),
 	)
 
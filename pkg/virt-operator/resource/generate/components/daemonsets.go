@@ -2,6 +2,7 @@ package components
 
 import (
 	"fmt"
+	"strings"
 
 	appsv1 "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
@@ -52,7 +53,7 @@ func RenderPrHelperContainer(image string, pullPolicy corev1.PullPolicy) corev1.
 	}
 }
 
-func NewHandlerDaemonSet(namespace, repository, imagePrefix, version, launcherVersion, prHelperVersion, sidecarShimVersion, productName, productVersion, productComponent, image, launcherImage, prHelperImage, sidecarShimImage string, pullPolicy corev1.PullPolicy, imagePullSecrets []corev1.LocalObjectReference, migrationNetwork *string, verbosity string, extraEnv map[string]string, enablePrHelper bool) *appsv1.DaemonSet {
+func NewHandlerDaemonSet(namespace, repository, imagePrefix, version, launcherVersion, prHelperVersion, sidecarShimVersion, productName, productVersion, productComponent, image, launcherImage, prHelperImage, sidecarShimImage string, pullPolicy corev1.PullPolicy, imagePullSecrets []corev1.LocalObjectReference, migrationNetwork *string, additionalMigrationNetworks []string, verbosity string, extraEnv map[string]string, enablePrHelper bool) *appsv1.DaemonSet {
 
 	deploymentName := VirtHandlerName
 	imageName := fmt.Sprintf("%s%s", imagePrefix, deploymentName)
@@ -63,12 +64,20 @@ func NewHandlerDaemonSet(namespace, repository, imagePrefix, version, launcherVe
 		launcherImage = fmt.Sprintf("%s/%s%s%s", repository, imagePrefix, "virt-launcher", AddVersionSeparatorPrefix(launcherVersion))
 	}
 
+	var migrationNetworks []string
 	if migrationNetwork != nil {
+		// Join the pod to the migration network and name the corresponding interface "migration0"
+		migrationNetworks = append(migrationNetworks, *migrationNetwork+"@"+virtv1.MigrationInterfaceName)
+	}
+	for i, network := range additionalMigrationNetworks {
+		// The additional migration networks get the interfaces "migration1", "migration2", ...
+		migrationNetworks = append(migrationNetworks, fmt.Sprintf("%s@%s%d", network, virtv1.AdditionalMigrationInterfacePrefix, i+1))
+	}
+	if len(migrationNetworks) > 0 {
 		if podTemplateSpec.ObjectMeta.Annotations == nil {
 			podTemplateSpec.ObjectMeta.Annotations = make(map[string]string)
 		}
-		// Join the pod to the migration network and name the corresponding interface "migration0"
-		podTemplateSpec.ObjectMeta.Annotations[networkv1.NetworkAttachmentAnnot] = *migrationNetwork + "@" + virtv1.MigrationInterfaceName
+		podTemplateSpec.ObjectMeta.Annotations[networkv1.NetworkAttachmentAnnot] = strings.Join(migrationNetworks, ",")
 	}
 
 	if podTemplateSpec.Annotations == nil {
@@ -86,7 +86,7 @@ func newApiServerClusterRole() *rbacv1.ClusterRole {
 					"pods",
 				},
 				Verbs: []string{
-					"get", "list", "delete", "patch",
+					"get", "list", "watch", "delete", "patch",
 				},
 			},
 			{
@@ -101,6 +101,17 @@ func newApiServerClusterRole() *rbacv1.ClusterRole {
 					"get", "list", "watch", "patch", "update",
 				},
 			},
+			{
+				APIGroups: []string{
+					"",
+				},
+				Resources: []string{
+					"nodes",
+				},
+				Verbs: []string{
+					"list", "watch",
+				},
+			},
 			{
 				APIGroups: []string{
 					"",
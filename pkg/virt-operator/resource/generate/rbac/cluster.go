@@ -39,21 +39,24 @@ const (
 	defaultClusterRoleName          = "kubevirt.io:default"
 	instancetypeViewClusterRoleName = "instancetype.kubevirt.io:view"
 
-	apiVersion            = "version"
-	apiGuestFs            = "guestfs"
-	apiExpandVmSpec       = "expand-vm-spec"
-	apiKubevirts          = "kubevirts"
-	apiVM                 = "virtualmachines"
-	apiVMInstances        = "virtualmachineinstances"
-	apiVMIPresets         = "virtualmachineinstancepresets"
-	apiVMIReplicasets     = "virtualmachineinstancereplicasets"
-	apiVMIMigrations      = "virtualmachineinstancemigrations"
-	apiVMSnapshots        = "virtualmachinesnapshots"
-	apiVMSnapshotContents = "virtualmachinesnapshotcontents"
-	apiVMRestores         = "virtualmachinerestores"
-	apiVMExports          = "virtualmachineexports"
-	apiVMClones           = "virtualmachineclones"
-	apiVMPools            = "virtualmachinepools"
+	apiVersion             = "version"
+	apiGuestFs             = "guestfs"
+	apiExpandVmSpec        = "expand-vm-spec"
+	apiKubevirts           = "kubevirts"
+	apiVM                  = "virtualmachines"
+	apiVMInstances         = "virtualmachineinstances"
+	apiVMIPresets          = "virtualmachineinstancepresets"
+	apiVMIReplicasets      = "virtualmachineinstancereplicasets"
+	apiVMIMigrations       = "virtualmachineinstancemigrations"
+	apiVMSnapshots         = "virtualmachinesnapshots"
+	apiVMSnapshotContents  = "virtualmachinesnapshotcontents"
+	apiVMSnapshotSchedules = "virtualmachinesnapshotschedules"
+	apiVMSnapshotGroups    = "virtualmachinesnapshotgroups"
+	apiVMRestoreGroups     = "virtualmachinerestoregroups"
+	apiVMRestores          = "virtualmachinerestores"
+	apiVMExports           = "virtualmachineexports"
+	apiVMClones            = "virtualmachineclones"
+	apiVMPools             = "virtualmachinepools"
 
 	apiVMExpandSpec   = "virtualmachines/expand-spec"
 	apiVMPortForward  = "virtualmachines/portforward"
@@ -64,6 +67,8 @@ const (
 	apiVMRemoveVolume = "virtualmachines/removevolume"
 	apiVMMigrate      = "virtualmachines/migrate"
 	apiVMMemoryDump   = "virtualmachines/memorydump"
+	apiVMBackup       = "virtualmachines/backup"
+	apiVMRemoveBackup = "virtualmachines/removebackup"
 
 	apiVMInstancesConsole                   = "virtualmachineinstances/console"
 	apiVMInstancesVNC                       = "virtualmachineinstances/vnc"
@@ -76,6 +81,8 @@ const (
 	apiVMInstancesFreeze                    = "virtualmachineinstances/freeze"
 	apiVMInstancesUnfreeze                  = "virtualmachineinstances/unfreeze"
 	apiVMInstancesSoftReboot                = "virtualmachineinstances/softreboot"
+	apiVMInstancesGuestExec                 = "virtualmachineinstances/guestexec"
+	apiVMInstancesMigrateCheck              = "virtualmachineinstances/migrate/check"
 	apiVMInstancesGuestOSInfo               = "virtualmachineinstances/guestosinfo"
 	apiVMInstancesFileSysList               = "virtualmachineinstances/filesystemlist"
 	apiVMInstancesUserList                  = "virtualmachineinstances/userlist"
@@ -219,6 +226,7 @@ func newAdminClusterRole() *rbacv1.ClusterRole {
 					apiVMInstancesFreeze,
 					apiVMInstancesUnfreeze,
 					apiVMInstancesSoftReboot,
+					apiVMInstancesGuestExec,
 					apiVMInstancesSEVSetupSession,
 					apiVMInstancesSEVInjectLaunchSecret,
 				},
@@ -249,6 +257,8 @@ func newAdminClusterRole() *rbacv1.ClusterRole {
 					apiVMAddVolume,
 					apiVMRemoveVolume,
 					apiVMMemoryDump,
+					apiVMBackup,
+					apiVMRemoveBackup,
 				},
 				Verbs: []string{
 					"update",
@@ -297,7 +307,10 @@ func newAdminClusterRole() *rbacv1.ClusterRole {
 				Resources: []string{
 					apiVMSnapshots,
 					apiVMSnapshotContents,
+					apiVMSnapshotSchedules,
+					apiVMSnapshotGroups,
 					apiVMRestores,
+					apiVMRestoreGroups,
 				},
 				Verbs: []string{
 					"get", "delete", "create", "update", "patch", "list", "watch", "deletecollection",
@@ -411,6 +424,7 @@ func newEditClusterRole() *rbacv1.ClusterRole {
 					apiVMInstancesFreeze,
 					apiVMInstancesUnfreeze,
 					apiVMInstancesSoftReboot,
+					apiVMInstancesGuestExec,
 					apiVMInstancesSEVSetupSession,
 					apiVMInstancesSEVInjectLaunchSecret,
 				},
@@ -441,6 +455,8 @@ func newEditClusterRole() *rbacv1.ClusterRole {
 					apiVMAddVolume,
 					apiVMRemoveVolume,
 					apiVMMemoryDump,
+					apiVMBackup,
+					apiVMRemoveBackup,
 				},
 				Verbs: []string{
 					"update",
@@ -489,7 +505,10 @@ func newEditClusterRole() *rbacv1.ClusterRole {
 				Resources: []string{
 					apiVMSnapshots,
 					apiVMSnapshotContents,
+					apiVMSnapshotSchedules,
+					apiVMSnapshotGroups,
 					apiVMRestores,
+					apiVMRestoreGroups,
 				},
 				Verbs: []string{
 					"get", "delete", "create", "update", "patch", "list", "watch",
@@ -587,6 +606,7 @@ func newMigrateClusterRole() *rbacv1.ClusterRole {
 				},
 				Resources: []string{
 					apiVMMigrate,
+					apiVMInstancesMigrateCheck,
 				},
 				Verbs: []string{
 					"update",
@@ -681,7 +701,10 @@ func newViewClusterRole() *rbacv1.ClusterRole {
 				Resources: []string{
 					apiVMSnapshots,
 					apiVMSnapshotContents,
+					apiVMSnapshotSchedules,
+					apiVMSnapshotGroups,
 					apiVMRestores,
+					apiVMRestoreGroups,
 				},
 				Verbs: []string{
 					"get", "list", "watch",
@@ -271,6 +271,17 @@ func newControllerClusterRole() *rbacv1.ClusterRole {
 					"get", "list", "watch", "update", "patch",
 				},
 			},
+			{
+				APIGroups: []string{
+					"discovery.k8s.io",
+				},
+				Resources: []string{
+					"endpointslices",
+				},
+				Verbs: []string{
+					"get", "list", "watch", "delete", "update", "create",
+				},
+			},
 			{
 				APIGroups: []string{
 					"apps",
@@ -320,6 +331,14 @@ func newControllerClusterRole() *rbacv1.ClusterRole {
 					"virtualmachinesnapshotcontents",
 					"virtualmachinesnapshotcontents/status",
 					"virtualmachinesnapshotcontents/finalizers",
+					"virtualmachinesnapshotschedules",
+					"virtualmachinesnapshotschedules/status",
+					"virtualmachinesnapshotgroups",
+					"virtualmachinesnapshotgroups/status",
+					"virtualmachinesnapshotgroups/finalizers",
+					"virtualmachinerestoregroups",
+					"virtualmachinerestoregroups/status",
+					"virtualmachinerestoregroups/finalizers",
 					"virtualmachinerestores",
 					"virtualmachinerestores/status",
 				},
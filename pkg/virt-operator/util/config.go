@@ -97,6 +97,9 @@ const (
 	// lookup key in AdditionalProperties
 	AdditionalPropertiesMigrationNetwork = "MigrationNetwork"
 
+	// lookup key in AdditionalProperties
+	AdditionalPropertiesAdditionalMigrationNetworks = "AdditionalMigrationNetworks"
+
 	// lookup key in AdditionalProperties
 	AdditionalPropertiesPersistentReservationEnabled = "PersistentReservationEnabled"
 
@@ -210,6 +213,10 @@ func GetTargetConfigFromKVWithEnvVarManager(kv *v1.KubeVirt, envVarManager EnvVa
 		kv.Spec.Configuration.MigrationConfiguration.Network != nil {
 		additionalProperties[AdditionalPropertiesMigrationNetwork] = *kv.Spec.Configuration.MigrationConfiguration.Network
 	}
+	if kv.Spec.Configuration.MigrationConfiguration != nil &&
+		len(kv.Spec.Configuration.MigrationConfiguration.AdditionalNetworks) > 0 {
+		additionalProperties[AdditionalPropertiesAdditionalMigrationNetworks] = strings.Join(kv.Spec.Configuration.MigrationConfiguration.AdditionalNetworks, ",")
+	}
 	if kv.Spec.Configuration.DeveloperConfiguration != nil && len(kv.Spec.Configuration.DeveloperConfiguration.FeatureGates) > 0 {
 		for _, v := range kv.Spec.Configuration.DeveloperConfiguration.FeatureGates {
 			if v == featuregate.PersistentReservation {
@@ -630,6 +637,14 @@ func (c *KubeVirtDeploymentConfig) GetMigrationNetwork() *string {
 	}
 }
 
+func (c *KubeVirtDeploymentConfig) GetAdditionalMigrationNetworks() []string {
+	value, enabled := c.AdditionalProperties[AdditionalPropertiesAdditionalMigrationNetworks]
+	if !enabled {
+		return nil
+	}
+	return strings.Split(value, ",")
+}
+
 /*
 if the monitoring namespace field is defiend in kubevirtCR than return it
 otherwise we return common monitoring namespaces.
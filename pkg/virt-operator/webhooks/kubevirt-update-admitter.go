@@ -25,6 +25,8 @@ import (
 	"fmt"
 	"strconv"
 
+	"github.com/robfig/cron/v3"
+
 	kvtls "kubevirt.io/kubevirt/pkg/util/tls"
 	"kubevirt.io/kubevirt/pkg/virt-config/featuregate"
 
@@ -111,6 +113,11 @@ func (admitter *KubeVirtUpdateAdmitter) Admit(ctx context.Context, ar *admission
 		results = append(results, validateInfraReplicas(newKV.Spec.Infra.Replicas)...)
 	}
 
+	if !equality.Semantic.DeepEqual(currKV.Spec.WorkloadUpdateStrategy, newKV.Spec.WorkloadUpdateStrategy) {
+		results = append(results,
+			validateMaintenanceWindows(field.NewPath("spec").Child("workloadUpdateStrategy", "maintenanceWindows"), newKV.Spec.WorkloadUpdateStrategy.MaintenanceWindows)...)
+	}
+
 	response := validating_webhooks.NewAdmissionResponse(results)
 
 	if featureGatesChanged(&currKV.Spec, &newKV.Spec) {
@@ -309,6 +316,76 @@ func validateSeccompConfiguration(field *field.Path, seccompConf *v1.SeccompConf
 
 }
 
+func validateMaintenanceWindows(field *field.Path, windows []v1.WorkloadUpdateMaintenanceWindow) []metav1.StatusCause {
+	statuses := []metav1.StatusCause{}
+	names := map[string]bool{}
+
+	for i, window := range windows {
+		windowField := field.Index(i)
+		if window.Name == "" {
+			statuses = append(statuses, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueRequired,
+				Field:   windowField.Child("name").String(),
+				Message: fmt.Sprintf("%s must not be empty", windowField.Child("name").String()),
+			})
+		} else if names[window.Name] {
+			statuses = append(statuses, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueDuplicate,
+				Field:   windowField.Child("name").String(),
+				Message: fmt.Sprintf("maintenance window %s is defined more than once", window.Name),
+			})
+		}
+		names[window.Name] = true
+
+		if window.Selector != nil {
+			if _, err := metav1.LabelSelectorAsSelector(window.Selector); err != nil {
+				statuses = append(statuses, metav1.StatusCause{
+					Type:    metav1.CauseTypeFieldValueInvalid,
+					Field:   windowField.Child("selector").String(),
+					Message: fmt.Sprintf("%s is invalid: %v", windowField.Child("selector").String(), err),
+				})
+			}
+		}
+
+		if window.Schedule == "" && len(window.TimeRanges) == 0 {
+			statuses = append(statuses, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueRequired,
+				Field:   windowField.String(),
+				Message: fmt.Sprintf("%s needs a schedule or time ranges", windowField.String()),
+			})
+		}
+		if window.Schedule != "" {
+			if _, err := cron.ParseStandard(window.Schedule); err != nil {
+				statuses = append(statuses, metav1.StatusCause{
+					Type:    metav1.CauseTypeFieldValueInvalid,
+					Field:   windowField.Child("schedule").String(),
+					Message: fmt.Sprintf("%s is not a valid cron expression: %v", windowField.Child("schedule").String(), err),
+				})
+			}
+		}
+		if window.Duration != nil && window.Duration.Duration <= 0 {
+			statuses = append(statuses, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueInvalid,
+				Field:   windowField.Child("duration").String(),
+				Message: fmt.Sprintf("%s must be positive", windowField.Child("duration").String()),
+			})
+		}
+
+		for j, timeRange := range window.TimeRanges {
+			if !timeRange.Start.Before(&timeRange.End) {
+				rangeField := windowField.Child("timeRanges").Index(j)
+				statuses = append(statuses, metav1.StatusCause{
+					Type:    metav1.CauseTypeFieldValueInvalid,
+					Field:   rangeField.String(),
+					Message: fmt.Sprintf("%s must end after it starts", rangeField.String()),
+				})
+			}
+		}
+	}
+
+	return statuses
+}
+
 func validateWorkloadPlacement(ctx context.Context, namespace string, placementConfig *v1.NodePlacement, client kubecli.KubevirtClient) []metav1.StatusCause {
 	statuses := []metav1.StatusCause{}
 
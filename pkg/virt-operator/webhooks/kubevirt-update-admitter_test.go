@@ -24,6 +24,7 @@ import (
 	"crypto/tls"
 	"encoding/json"
 	"fmt"
+	"time"
 
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
@@ -68,6 +69,50 @@ var _ = Describe("Validating KubeVirtUpdate Admitter", func() {
 		}, []string{vmProfileField.Child("customProfile", "runtimeDefaultProfile").String(), vmProfileField.Child("customProfile", "localhostProfile").String()}),
 	)
 
+	Context("with maintenance windows", func() {
+		start := metav1.NewTime(time.Date(2024, 6, 1, 22, 0, 0, 0, time.UTC))
+		end := metav1.NewTime(start.Add(4 * time.Hour))
+
+		DescribeTable("validateMaintenanceWindows", func(windows []v1.WorkloadUpdateMaintenanceWindow, expectedFields []string) {
+			causes := validateMaintenanceWindows(test, windows)
+			Expect(causes).To(HaveLen(len(expectedFields)))
+			for _, cause := range causes {
+				Expect(cause.Field).To(BeElementOf(expectedFields))
+			}
+		},
+			Entry("with a schedule", []v1.WorkloadUpdateMaintenanceWindow{
+				{Name: "nightly", Schedule: "0 22 * * *", Duration: &metav1.Duration{Duration: 4 * time.Hour}},
+			}, nil),
+			Entry("with time ranges", []v1.WorkloadUpdateMaintenanceWindow{
+				{Name: "weekend", TimeRanges: []v1.WorkloadUpdateTimeRange{{Start: start, End: end}}},
+			}, nil),
+			Entry("without name", []v1.WorkloadUpdateMaintenanceWindow{
+				{Schedule: "0 22 * * *"},
+			}, []string{test.Index(0).Child("name").String()}),
+			Entry("with duplicate names", []v1.WorkloadUpdateMaintenanceWindow{
+				{Name: "nightly", Schedule: "0 22 * * *"},
+				{Name: "nightly", Schedule: "0 23 * * *"},
+			}, []string{test.Index(1).Child("name").String()}),
+			Entry("without schedule and time ranges", []v1.WorkloadUpdateMaintenanceWindow{
+				{Name: "never"},
+			}, []string{test.Index(0).String()}),
+			Entry("with an invalid schedule", []v1.WorkloadUpdateMaintenanceWindow{
+				{Name: "nightly", Schedule: "every night"},
+			}, []string{test.Index(0).Child("schedule").String()}),
+			Entry("with a negative duration", []v1.WorkloadUpdateMaintenanceWindow{
+				{Name: "nightly", Schedule: "0 22 * * *", Duration: &metav1.Duration{Duration: -time.Hour}},
+			}, []string{test.Index(0).Child("duration").String()}),
+			Entry("with a time range ending before it starts", []v1.WorkloadUpdateMaintenanceWindow{
+				{Name: "weekend", TimeRanges: []v1.WorkloadUpdateTimeRange{{Start: end, End: start}}},
+			}, []string{test.Index(0).Child("timeRanges").Index(0).String()}),
+			Entry("with an invalid selector", []v1.WorkloadUpdateMaintenanceWindow{
+				{Name: "nightly", Schedule: "0 22 * * *", Selector: &metav1.LabelSelector{
+					MatchExpressions: []metav1.LabelSelectorRequirement{{Key: "tier", Operator: "Bogus"}},
+				}},
+			}, []string{test.Index(0).Child("selector").String()}),
+		)
+	})
+
 	DescribeTable("test validateCustomizeComponents", func(cc v1.CustomizeComponents, expectedCauses int) {
 		causes := validateCustomizeComponents(cc)
 		Expect(causes).To(HaveLen(expectedCauses))
@@ -43,6 +43,7 @@ const (
 	TargetNameFlag               = "target-name"
 	SourceTypeFlag               = "source-type"
 	TargetTypeFlag               = "target-type"
+	TargetNamespaceFlag          = "target-namespace"
 	LabelFilterFlag              = "label-filter"
 	AnnotationFilterFlag         = "annotation-filter"
 	TemplateLabelFilterFlag      = "template-label-filter"
@@ -51,7 +52,7 @@ const (
 	NewSMBiosSerialFlag          = "new-smbios-serial"
 
 	supportedSourceTypes = "vm, vmsnapshot"
-	supportedTargetTypes = "vm"
+	supportedTargetTypes = "vm, vmexport"
 )
 
 type createClone struct {
@@ -61,6 +62,7 @@ type createClone struct {
 	targetName                string
 	sourceType                string
 	targetType                string
+	targetNamespace           string
 	labelFilters              []string
 	annotationFilters         []string
 	templateLabelFilters      []string
@@ -93,6 +95,7 @@ func NewCommand() *cobra.Command {
 	cmd.Flags().StringVar(&c.targetName, TargetNameFlag, emptyValue, "Specify the clone's target name.")
 	cmd.Flags().StringVar(&c.sourceType, SourceTypeFlag, emptyValue, "Specify the clone's source type. Default type is VM. Supported types: "+supportedSourceTypes)
 	cmd.Flags().StringVar(&c.targetType, TargetTypeFlag, emptyValue, "Specify the clone's target type. Default type is VM. Supported types: "+supportedTargetTypes)
+	cmd.Flags().StringVar(&c.targetNamespace, TargetNamespaceFlag, emptyValue, "Specify the namespace the target VM is created in. Default is the namespace of the clone.")
 	cmd.Flags().StringArrayVar(&c.labelFilters, LabelFilterFlag, nil, "Specify clone's label filters. "+supportsMultipleFlags)
 	cmd.Flags().StringArrayVar(&c.annotationFilters, AnnotationFilterFlag, nil, "Specify clone's annotation filters. "+supportsMultipleFlags)
 	cmd.Flags().StringArrayVar(&c.templateLabelFilters, TemplateLabelFilterFlag, nil, "Specify clone's template label filters. "+supportsMultipleFlags)
@@ -146,11 +149,18 @@ func (c *createClone) usage() string {
   {{ProgramName}} create clone --source-name sourceVM --source-type vm --target-name targetVM --target-type vm
 
   # Supported source types are vm (aliases: VM, VirtualMachine, virtualmachine) and snapshot (aliases: vmsnapshot
-  # VirtualMachineSnapshot, VMSnapshot). The only supported target type is vm.
+  # VirtualMachineSnapshot, VMSnapshot). Supported target types are vm and vmexport (aliases: VirtualMachineExport,
+  # VMExport).
 
   # Create a manifest for a clone with a source type snapshot to a target type VM:
   {{ProgramName}} create clone --source-name mySnapshot --source-type snapshot --target-name targetVM
 
+  # Create a manifest for a clone with a target VM in another namespace:
+  {{ProgramName}} create clone --source-name sourceVM --target-name targetVM --target-namespace other-namespace
+
+  # Create a manifest for a clone exporting the source VM:
+  {{ProgramName}} create clone --source-name sourceVM --target-type vmexport
+
   # Create a manifest for a clone with label filters:
   {{ProgramName}} create clone --source-name sourceVM --label-filter '*' --label-filter '!some/key'
 
@@ -188,10 +198,14 @@ func (c *createClone) newClone() (*clone.VirtualMachineClone, error) {
 	if err != nil {
 		return nil, err
 	}
+	if c.targetNamespace != "" && target.Kind != "VirtualMachine" {
+		return nil, fmt.Errorf("a target namespace is only supported for a target of type vm")
+	}
 
 	vmClone.Spec = clone.VirtualMachineCloneSpec{
 		Source:            source,
 		Target:            target,
+		TargetNamespace:   c.targetNamespace,
 		AnnotationFilters: c.annotationFilters,
 		LabelFilters:      c.labelFilters,
 		Template: clone.VirtualMachineCloneTemplateFilters{
@@ -281,6 +295,13 @@ func (c *createClone) typeToTypedLocalObjectReference(sourceOrTargetType, source
 
 		kind = "VirtualMachineSnapshot"
 		apiGroup = "snapshot.kubevirt.io"
+	case "vmexport", "VirtualMachineExport", "VMExport":
+		if isSource {
+			return nil, generateErr()
+		}
+
+		kind = "VirtualMachineExport"
+		apiGroup = "export.kubevirt.io"
 	default:
 		return nil, generateErr()
 	}
@@ -38,6 +38,7 @@ const (
 
 	vmKind, vmApiGroup             = "VirtualMachine", "kubevirt.io"
 	snapshotKind, snapshotApiGroup = "VirtualMachineSnapshot", "snapshot.kubevirt.io"
+	exportKind, exportApiGroup     = "VirtualMachineExport", "export.kubevirt.io"
 )
 const (
 	labelFilters = iota
@@ -96,8 +97,20 @@ var _ = Describe("create clone", func() {
 			Entry("VirtualMachineSnapshot source, vm target", "VirtualMachineSnapshot", snapshotKind, snapshotApiGroup, "vm", vmKind, vmApiGroup),
 			Entry("vmsnapshot source, vm target", "vmsnapshot", snapshotKind, snapshotApiGroup, "vm", vmKind, vmApiGroup),
 			Entry("VMSnapshot source, vm target", "VMSnapshot", snapshotKind, snapshotApiGroup, "vm", vmKind, vmApiGroup),
+
+			Entry("vm source, vmexport target", "vm", vmKind, vmApiGroup, "vmexport", exportKind, exportApiGroup),
+			Entry("vm source, VirtualMachineExport target", "vm", vmKind, vmApiGroup, "VirtualMachineExport", exportKind, exportApiGroup),
+			Entry("snapshot source, VMExport target", "snapshot", snapshotKind, snapshotApiGroup, "VMExport", exportKind, exportApiGroup),
 		)
 
+		It("vmexport is not supported as a source type", func() {
+			flags := getSourceNameFlags()
+			flags = addFlag(flags, virtctlclone.SourceTypeFlag, "vmexport")
+
+			_, err := newCommand(flags...)
+			Expect(err).To(HaveOccurred())
+		})
+
 		It("snapshot is not supported as a target type", func() {
 			flags := addFlag(nil, virtctlclone.SourceNameFlag, "source-name")
 			flags = addFlag(flags, virtctlclone.TargetNameFlag, "target-name")
@@ -254,6 +267,27 @@ var _ = Describe("create clone", func() {
 		Expect(cloneObj.Namespace).To(Equal(namespace))
 	})
 
+	It("sets the provided target namespace", func() {
+		flags := getSourceNameFlags()
+
+		const targetNamespace = "target-namespace"
+		flags = addFlag(flags, virtctlclone.TargetNamespaceFlag, targetNamespace)
+
+		cloneObj, err := newCommand(flags...)
+		Expect(err).ToNot(HaveOccurred())
+
+		Expect(cloneObj.Spec.TargetNamespace).To(Equal(targetNamespace))
+	})
+
+	It("rejects a target namespace for a vmexport target", func() {
+		flags := getSourceNameFlags()
+		flags = addFlag(flags, virtctlclone.TargetTypeFlag, "vmexport")
+		flags = addFlag(flags, virtctlclone.TargetNamespaceFlag, "target-namespace")
+
+		_, err := newCommand(flags...)
+		Expect(err).To(HaveOccurred())
+	})
+
 })
 
 func addFlag(s []string, flag, value string) []string {
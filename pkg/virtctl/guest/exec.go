@@ -0,0 +1,107 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package guest
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	v1 "kubevirt.io/api/core/v1"
+
+	"kubevirt.io/kubevirt/pkg/virtctl/clientconfig"
+	"kubevirt.io/kubevirt/pkg/virtctl/templates"
+)
+
+const (
+	EXEC = "exec"
+
+	timeoutFlag    = "timeout"
+	defaultTimeout = int32(30)
+)
+
+// ExitCodeError is returned when the command executed in the guest exits with a non-zero exit code
+type ExitCodeError struct {
+	exitCode int
+}
+
+func (e ExitCodeError) Error() string {
+	return fmt.Sprintf("command in the guest exited with exit code %d", e.exitCode)
+}
+
+func (e ExitCodeError) ExitCode() int {
+	return e.exitCode
+}
+
+type exec struct {
+	timeout int32
+}
+
+func NewExecCommand() *cobra.Command {
+	c := exec{}
+	cmd := &cobra.Command{
+		Use:   "exec (VMI) -- (COMMAND) [ARGS...]",
+		Short: "Execute a command in the guest through the guest agent.",
+		Long: `Execute a command in the guest through the qemu-guest-agent and print its stdout and stderr.
+The output is not streamed, the guest agent only returns it once the command exited.
+The exit code of virtctl is the exit code of the command in the guest.`,
+		Example: usage(),
+		Args:    cobra.MinimumNArgs(2),
+		RunE:    c.run,
+	}
+	cmd.Flags().Int32Var(&c.timeout, timeoutFlag, defaultTimeout, "Number of seconds to wait for the command to exit, at most 300")
+	cmd.SetUsageTemplate(templates.UsageTemplate())
+	return cmd
+}
+
+func usage() string {
+	usage := "  # Print the uptime of the guest of a virtualmachineinstance called 'myvmi':\n"
+	usage += "  {{ProgramName}} guest exec myvmi -- /usr/bin/cat /proc/uptime\n\n"
+	usage += "  # Run a longer command in the guest of 'myvmi':\n"
+	usage += "  {{ProgramName}} guest exec myvmi --timeout 120 -- /usr/bin/fstrim -av"
+	return usage
+}
+
+func (c *exec) run(cmd *cobra.Command, args []string) error {
+	vmiName := args[0]
+
+	virtClient, namespace, _, err := clientconfig.ClientAndNamespaceFromContext(cmd.Context())
+	if err != nil {
+		return err
+	}
+
+	opts := &v1.VirtualMachineInstanceGuestExecOptions{
+		Command:        args[1],
+		Args:           args[2:],
+		TimeoutSeconds: &c.timeout,
+	}
+	result, err := virtClient.VirtualMachineInstance(namespace).GuestExec(context.Background(), vmiName, opts)
+	if err != nil {
+		return fmt.Errorf("Error executing command in VirtualMachineInstance %s: %v", vmiName, err)
+	}
+
+	fmt.Fprint(cmd.OutOrStdout(), result.Stdout)
+	fmt.Fprint(cmd.ErrOrStderr(), result.Stderr)
+	if result.ExitCode != 0 {
+		return ExitCodeError{exitCode: int(result.ExitCode)}
+	}
+	return nil
+}
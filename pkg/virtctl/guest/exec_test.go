@@ -0,0 +1,108 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+package guest_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	k8smetav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	v1 "kubevirt.io/api/core/v1"
+	"kubevirt.io/client-go/kubecli"
+
+	"kubevirt.io/kubevirt/pkg/pointer"
+	"kubevirt.io/kubevirt/pkg/virtctl/guest"
+	"kubevirt.io/kubevirt/pkg/virtctl/testing"
+)
+
+var _ = Describe("Guest exec command", func() {
+	var vmiInterface *kubecli.MockVirtualMachineInstanceInterface
+	const vmiName = "testvmi"
+
+	BeforeEach(func() {
+		ctrl := gomock.NewController(GinkgoT())
+		kubecli.GetKubevirtClientFromClientConfig = kubecli.GetMockKubevirtClientFromClientConfig
+		kubecli.MockKubevirtClientInstance = kubecli.NewMockKubevirtClient(ctrl)
+		vmiInterface = kubecli.NewMockVirtualMachineInstanceInterface(ctrl)
+	})
+
+	expectGuestExec := func(opts *v1.VirtualMachineInstanceGuestExecOptions, result *v1.VirtualMachineInstanceGuestExecResult, err error) {
+		kubecli.MockKubevirtClientInstance.EXPECT().VirtualMachineInstance(k8smetav1.NamespaceDefault).Return(vmiInterface).Times(1)
+		vmiInterface.EXPECT().GuestExec(context.Background(), vmiName, opts).Return(result, err).Times(1)
+	}
+
+	It("should fail without a command", func() {
+		cmd := testing.NewRepeatableVirtctlCommand("guest", "exec", vmiName)
+		Expect(cmd()).To(MatchError("requires at least 2 arg(s), only received 1"))
+	})
+
+	It("should print the output of the command", func() {
+		expectGuestExec(&v1.VirtualMachineInstanceGuestExecOptions{
+			Command:        "/usr/bin/cat",
+			Args:           []string{"/proc/uptime"},
+			TimeoutSeconds: pointer.P(int32(30)),
+		}, &v1.VirtualMachineInstanceGuestExecResult{Stdout: "12.34 56.78\n"}, nil)
+
+		cmd := testing.NewRepeatableVirtctlCommandWithOut("guest", "exec", vmiName, "--", "/usr/bin/cat", "/proc/uptime")
+		out, err := cmd()
+		Expect(err).ToNot(HaveOccurred())
+		Expect(string(out)).To(Equal("12.34 56.78\n"))
+	})
+
+	It("should pass the timeout", func() {
+		expectGuestExec(&v1.VirtualMachineInstanceGuestExecOptions{
+			Command:        "/usr/bin/fstrim",
+			Args:           []string{"-av"},
+			TimeoutSeconds: pointer.P(int32(120)),
+		}, &v1.VirtualMachineInstanceGuestExecResult{}, nil)
+
+		cmd := testing.NewRepeatableVirtctlCommand("guest", "exec", vmiName, "--timeout", "120", "--", "/usr/bin/fstrim", "-av")
+		Expect(cmd()).To(Succeed())
+	})
+
+	It("should return the exit code of the command", func() {
+		expectGuestExec(&v1.VirtualMachineInstanceGuestExecOptions{
+			Command:        "/usr/bin/false",
+			Args:           []string{},
+			TimeoutSeconds: pointer.P(int32(30)),
+		}, &v1.VirtualMachineInstanceGuestExecResult{ExitCode: 3}, nil)
+
+		cmd := testing.NewRepeatableVirtctlCommand("guest", "exec", vmiName, "--", "/usr/bin/false")
+		err := cmd()
+		var exitCodeErr guest.ExitCodeError
+		Expect(errors.As(err, &exitCodeErr)).To(BeTrue())
+		Expect(exitCodeErr.ExitCode()).To(Equal(3))
+	})
+
+	It("should fail if the command cannot be executed", func() {
+		expectGuestExec(&v1.VirtualMachineInstanceGuestExecOptions{
+			Command:        "/usr/bin/true",
+			Args:           []string{},
+			TimeoutSeconds: pointer.P(int32(30)),
+		}, nil, fmt.Errorf("VMI does not have guest agent connected"))
+
+		cmd := testing.NewRepeatableVirtctlCommand("guest", "exec", vmiName, "--", "/usr/bin/true")
+		Expect(cmd()).To(MatchError("Error executing command in VirtualMachineInstance testvmi: VMI does not have guest agent connected"))
+	})
+})
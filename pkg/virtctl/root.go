@@ -2,6 +2,7 @@ package virtctl
 
 import (
 	"context"
+	"errors"
 	"flag"
 	"fmt"
 	"os"
@@ -23,6 +24,7 @@ import (
 	"kubevirt.io/kubevirt/pkg/virtctl/create"
 	"kubevirt.io/kubevirt/pkg/virtctl/credentials"
 	"kubevirt.io/kubevirt/pkg/virtctl/expose"
+	"kubevirt.io/kubevirt/pkg/virtctl/guest"
 	"kubevirt.io/kubevirt/pkg/virtctl/guestfs"
 	"kubevirt.io/kubevirt/pkg/virtctl/imageupload"
 	"kubevirt.io/kubevirt/pkg/virtctl/memorydump"
@@ -117,11 +119,14 @@ func NewVirtctlCommandFn() *cobra.Command {
 		vm.NewFSListCommand(),
 		vm.NewAddVolumeCommand(),
 		vm.NewRemoveVolumeCommand(),
+		vm.NewAddInterfaceCommand(),
+		vm.NewRemoveInterfaceCommand(),
 		vm.NewExpandCommand(),
 		memorydump.NewMemoryDumpCommand(),
 		pause.NewCommand(),
 		unpause.NewCommand(),
 		softreboot.NewSoftRebootCommand(),
+		guest.NewCommand(),
 		expose.NewCommand(),
 		version.VersionCommand(),
 		imageupload.NewImageUploadCommand(),
@@ -151,6 +156,11 @@ func Execute() int {
 	log.InitializeLogging(programName)
 	cmd := NewVirtctlCommand()
 	if err := cmd.Execute(); err != nil {
+		// Pass on the exit code of commands executed in the guest
+		var exitCodeErr guest.ExitCodeError
+		if errors.As(err, &exitCodeErr) {
+			return exitCodeErr.ExitCode()
+		}
 		if versionErr := checkClientServerVersion(cmd.Context()); versionErr != nil {
 			cmd.PrintErrln(versionErr)
 		}
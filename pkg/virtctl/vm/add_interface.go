@@ -0,0 +1,133 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package vm
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+
+	v1 "kubevirt.io/api/core/v1"
+
+	"kubevirt.io/kubevirt/pkg/apimachinery/patch"
+	"kubevirt.io/kubevirt/pkg/virtctl/clientconfig"
+	"kubevirt.io/kubevirt/pkg/virtctl/templates"
+)
+
+const (
+	ifaceNameArg         = "name"
+	networkAttachmentArg = "network-attachment-definition-name"
+	bindingArg           = "binding"
+
+	bindingBridge = "bridge"
+	bindingSRIOV  = "sriov"
+
+	vmInterfacesPath = "/spec/template/spec/domain/devices/interfaces"
+	vmNetworksPath   = "/spec/template/spec/networks"
+)
+
+var (
+	ifaceName         string
+	networkAttachment string
+	binding           string
+)
+
+func NewAddInterfaceCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "addinterface VM",
+		Short:   "add a network interface to a VM, hot-plugging it when the VM is running",
+		Example: usageAddInterface(),
+		Args:    cobra.ExactArgs(1),
+		RunE:    addInterfaceRun,
+	}
+	cmd.SetUsageTemplate(templates.UsageTemplate())
+	cmd.Flags().StringVar(&ifaceName, ifaceNameArg, "", "name of the interface and of the network it is connected to")
+	cmd.MarkFlagRequired(ifaceNameArg)
+	cmd.Flags().StringVar(&networkAttachment, networkAttachmentArg, "", "name of the network-attachment-definition the interface is connected to")
+	cmd.MarkFlagRequired(networkAttachmentArg)
+	cmd.Flags().StringVar(&binding, bindingArg, bindingBridge, "binding of the interface (bridge/sriov)")
+	cmd.Flags().BoolVar(&dryRun, dryRunArg, false, dryRunCommandUsage)
+	return cmd
+}
+
+func usageAddInterface() string {
+	return `  #Add a bridge interface connected to the 'blue-net' network-attachment-definition to a VM.
+  {{ProgramName}} addinterface fedora --name=blue --network-attachment-definition-name=blue-net
+
+  #Add an SR-IOV interface to a VM.
+  {{ProgramName}} addinterface fedora --name=fast --network-attachment-definition-name=sriov-net --binding=sriov
+  `
+}
+
+func addInterfaceRun(cmd *cobra.Command, args []string) error {
+	vmName := args[0]
+
+	iface := v1.Interface{Name: ifaceName}
+	switch binding {
+	case bindingBridge:
+		iface.Bridge = &v1.InterfaceBridge{}
+	case bindingSRIOV:
+		iface.SRIOV = &v1.InterfaceSRIOV{}
+	default:
+		return fmt.Errorf("unsupported binding %q, supported bindings: %s, %s", binding, bindingBridge, bindingSRIOV)
+	}
+	network := v1.Network{
+		Name:          ifaceName,
+		NetworkSource: v1.NetworkSource{Multus: &v1.MultusNetwork{NetworkName: networkAttachment}},
+	}
+
+	virtClient, namespace, _, err := clientconfig.ClientAndNamespaceFromContext(cmd.Context())
+	if err != nil {
+		return err
+	}
+
+	vm, err := virtClient.VirtualMachine(namespace).Get(cmd.Context(), vmName, metav1.GetOptions{})
+	if err != nil {
+		return fmt.Errorf("error getting VM %s: %v", vmName, err)
+	}
+	vmiSpec := vm.Spec.Template.Spec
+	for _, existingIface := range vmiSpec.Domain.Devices.Interfaces {
+		if existingIface.Name == ifaceName {
+			return fmt.Errorf("interface %s already exists in VM %s", ifaceName, vmName)
+		}
+	}
+
+	patchBytes, err := patch.New(
+		patch.WithTest(vmInterfacesPath, vmiSpec.Domain.Devices.Interfaces),
+		patch.WithAdd(vmInterfacesPath, append(vmiSpec.Domain.Devices.Interfaces, iface)),
+		patch.WithTest(vmNetworksPath, vmiSpec.Networks),
+		patch.WithAdd(vmNetworksPath, append(vmiSpec.Networks, network)),
+	).GeneratePayload()
+	if err != nil {
+		return err
+	}
+
+	_, err = virtClient.VirtualMachine(namespace).Patch(
+		cmd.Context(), vmName, types.JSONPatchType, patchBytes, metav1.PatchOptions{DryRun: setDryRunOption(dryRun)},
+	)
+	if err != nil {
+		return fmt.Errorf("error adding interface %s to VM %s: %v", ifaceName, vmName, err)
+	}
+
+	cmd.Printf("Successfully submitted add interface request to VM %s for interface %s\n", vmName, ifaceName)
+	return nil
+}
@@ -0,0 +1,100 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+package vm_test
+
+import (
+	"context"
+
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	v1 "kubevirt.io/api/core/v1"
+	"kubevirt.io/client-go/kubecli"
+	kubevirtfake "kubevirt.io/client-go/kubevirt/fake"
+	kvtesting "kubevirt.io/client-go/testing"
+
+	"kubevirt.io/kubevirt/pkg/virtctl/testing"
+)
+
+var _ = Describe("Add interface command", func() {
+	const vmName = "testvm"
+
+	var virtClient *kubevirtfake.Clientset
+
+	BeforeEach(func() {
+		ctrl := gomock.NewController(GinkgoT())
+		kubecli.GetKubevirtClientFromClientConfig = kubecli.GetMockKubevirtClientFromClientConfig
+		kubecli.MockKubevirtClientInstance = kubecli.NewMockKubevirtClient(ctrl)
+
+		vm := kubecli.NewMinimalVM(vmName)
+		vm.Namespace = metav1.NamespaceDefault
+		vm.Spec.Template = &v1.VirtualMachineInstanceTemplateSpec{}
+		vm.Spec.Template.Spec.Domain.Devices.Interfaces = []v1.Interface{*v1.DefaultMasqueradeNetworkInterface()}
+		vm.Spec.Template.Spec.Networks = []v1.Network{*v1.DefaultPodNetwork()}
+		virtClient = kubevirtfake.NewSimpleClientset(vm)
+		kubecli.MockKubevirtClientInstance.EXPECT().VirtualMachine(metav1.NamespaceDefault).
+			Return(virtClient.KubevirtV1().VirtualMachines(metav1.NamespaceDefault)).AnyTimes()
+	})
+
+	DescribeTable("should fail with missing required or invalid parameters", func(expected string, extraArgs ...string) {
+		args := append([]string{"addinterface"}, extraArgs...)
+		cmd := testing.NewRepeatableVirtctlCommand(args...)
+		Expect(cmd()).To(MatchError(ContainSubstring(expected)))
+	},
+		Entry("no args", "accepts 1 arg(s), received 0"),
+		Entry("missing required name and network-attachment-definition-name", "required flag(s)", vmName),
+		Entry("missing required network-attachment-definition-name", "required flag(s)", vmName, "--name=blue"),
+		Entry("unsupported binding", "unsupported binding \"masquerade\"",
+			vmName, "--name=blue", "--network-attachment-definition-name=blue-net", "--binding=masquerade"),
+	)
+
+	DescribeTable("should add the interface and network to the VM", func(extraArgs []string, expectedBinding v1.InterfaceBindingMethod) {
+		args := append([]string{"addinterface", vmName, "--name=blue", "--network-attachment-definition-name=blue-net"}, extraArgs...)
+		cmd := testing.NewRepeatableVirtctlCommand(args...)
+		Expect(cmd()).To(Succeed())
+
+		vm, err := virtClient.KubevirtV1().VirtualMachines(metav1.NamespaceDefault).Get(context.Background(), vmName, metav1.GetOptions{})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(vm.Spec.Template.Spec.Domain.Devices.Interfaces).To(Equal([]v1.Interface{
+			*v1.DefaultMasqueradeNetworkInterface(),
+			{Name: "blue", InterfaceBindingMethod: expectedBinding},
+		}))
+		Expect(vm.Spec.Template.Spec.Networks).To(Equal([]v1.Network{
+			*v1.DefaultPodNetwork(),
+			{Name: "blue", NetworkSource: v1.NetworkSource{Multus: &v1.MultusNetwork{NetworkName: "blue-net"}}},
+		}))
+	},
+		Entry("with the default bridge binding", nil, v1.InterfaceBindingMethod{Bridge: &v1.InterfaceBridge{}}),
+		Entry("with SR-IOV binding", []string{"--binding=sriov"}, v1.InterfaceBindingMethod{SRIOV: &v1.InterfaceSRIOV{}}),
+	)
+
+	It("should fail when the interface already exists", func() {
+		cmd := testing.NewRepeatableVirtctlCommand("addinterface", vmName, "--name=default", "--network-attachment-definition-name=blue-net")
+		Expect(cmd()).To(MatchError(ContainSubstring("interface default already exists")))
+		Expect(kvtesting.FilterActions(&virtClient.Fake, "patch", "virtualmachines")).To(BeEmpty())
+	})
+
+	It("should fail when the VM does not exist", func() {
+		cmd := testing.NewRepeatableVirtctlCommand("addinterface", "other", "--name=blue", "--network-attachment-definition-name=blue-net")
+		Expect(cmd()).To(MatchError(ContainSubstring("error getting VM other")))
+	})
+})
@@ -22,8 +22,11 @@ package vm
 import (
 	"context"
 	"fmt"
+	"strings"
 
 	"github.com/spf13/cobra"
+	k8sv1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/labels"
 
 	v1 "kubevirt.io/api/core/v1"
 
@@ -31,18 +34,30 @@ import (
 	"kubevirt.io/kubevirt/pkg/virtctl/templates"
 )
 
-const COMMAND_MIGRATE = "migrate"
+const (
+	COMMAND_MIGRATE = "migrate"
+
+	targetNodeArg = "target-node"
+	selectorArg   = "selector"
+)
+
+var (
+	targetNode   string
+	nodeSelector string
+)
 
 func NewMigrateCommand() *cobra.Command {
 	c := Command{command: COMMAND_MIGRATE}
 	cmd := &cobra.Command{
 		Use:     "migrate (VM)",
 		Short:   "Migrate a virtual machine.",
-		Example: usage(COMMAND_MIGRATE),
+		Example: migrateUsage(),
 		Args:    cobra.ExactArgs(1),
 		RunE:    c.migrateRun,
 	}
-	cmd.Flags().BoolVar(&dryRun, dryRunArg, false, dryRunCommandUsage)
+	cmd.Flags().BoolVar(&dryRun, dryRunArg, false, "--dry-run=false: Flag used to set whether to perform a dry run or not. If true, it is only checked whether the virtual machine can be migrated.")
+	cmd.Flags().StringVar(&targetNode, targetNodeArg, "", "The name of the node to migrate the virtual machine to.")
+	cmd.Flags().StringVar(&nodeSelector, selectorArg, "", "A comma separated list of node labels (e.g. zone=east,rack=r1) the target node of the migration has to match.")
 	cmd.SetUsageTemplate(templates.UsageTemplate())
 	return cmd
 }
@@ -55,9 +70,29 @@ func (o *Command) migrateRun(cmd *cobra.Command, args []string) error {
 		return err
 	}
 
+	addedNodeSelector, err := migrationNodeSelector(targetNode, nodeSelector)
+	if err != nil {
+		return err
+	}
+
 	dryRunOption := setDryRunOption(dryRun)
 
-	err = virtClient.VirtualMachine(namespace).Migrate(context.Background(), vmiName, &v1.MigrateOptions{DryRun: dryRunOption})
+	migrateOptions := &v1.MigrateOptions{
+		DryRun:            dryRunOption,
+		AddedNodeSelector: addedNodeSelector,
+	}
+	if dryRun {
+		result, err := virtClient.VirtualMachineInstance(namespace).MigrationCheck(context.Background(), vmiName, migrateOptions)
+		if err != nil {
+			return fmt.Errorf("Error checking the migration of VirtualMachine %v", err)
+		}
+		if !result.Migratable {
+			return fmt.Errorf("VM %s can not be migrated:\n%s", vmiName, formatMigrationBlockers(result.Blockers))
+		}
+		cmd.Printf("VM %s can be migrated to the nodes: %s\n", vmiName, strings.Join(result.TargetNodes, ", "))
+	}
+
+	err = virtClient.VirtualMachine(namespace).Migrate(context.Background(), vmiName, migrateOptions)
 	if err != nil {
 		return fmt.Errorf("Error migrating VirtualMachine %v", err)
 	}
@@ -66,3 +101,49 @@ func (o *Command) migrateRun(cmd *cobra.Command, args []string) error {
 
 	return nil
 }
+
+func migrateUsage() string {
+	usage := usage(COMMAND_MIGRATE)
+	usage += "\n\n  # Migrate a virtual machine called 'myvm' to the node 'node02':\n"
+	usage += "  {{ProgramName}} migrate myvm --target-node node02\n\n"
+	usage += "  # Migrate a virtual machine called 'myvm' to a node in zone 'east':\n"
+	usage += "  {{ProgramName}} migrate myvm --selector zone=east\n\n"
+	usage += "  # Check whether a virtual machine called 'myvm' can be migrated to the node 'node02':\n"
+	usage += "  {{ProgramName}} migrate myvm --target-node node02 --dry-run"
+	return usage
+}
+
+func formatMigrationBlockers(blockers []v1.MigrationBlocker) string {
+	lines := make([]string, 0, len(blockers))
+	for _, blocker := range blockers {
+		if blocker.Reason != "" {
+			lines = append(lines, fmt.Sprintf("  %s (%s): %s", blocker.Type, blocker.Reason, blocker.Message))
+		} else {
+			lines = append(lines, fmt.Sprintf("  %s: %s", blocker.Type, blocker.Message))
+		}
+	}
+	return strings.Join(lines, "\n")
+}
+
+func migrationNodeSelector(targetNode, selector string) (map[string]string, error) {
+	if targetNode == "" && selector == "" {
+		return nil, nil
+	}
+
+	addedNodeSelector := map[string]string{}
+	if selector != "" {
+		labelsMap, err := labels.ConvertSelectorToLabelsMap(selector)
+		if err != nil {
+			return nil, fmt.Errorf("invalid node selector %q: %v", selector, err)
+		}
+		addedNodeSelector = labelsMap
+	}
+	if targetNode != "" {
+		if node, exists := addedNodeSelector[k8sv1.LabelHostname]; exists && node != targetNode {
+			return nil, fmt.Errorf("the target node %s conflicts with the node %s in the node selector", targetNode, node)
+		}
+		addedNodeSelector[k8sv1.LabelHostname] = targetNode
+	}
+
+	return addedNodeSelector, nil
+}
@@ -26,6 +26,7 @@ import (
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 
+	k8sv1 "k8s.io/api/core/v1"
 	k8smetav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	v1 "kubevirt.io/api/core/v1"
@@ -36,6 +37,7 @@ import (
 
 var _ = Describe("Migrate command", func() {
 	var vmInterface *kubecli.MockVirtualMachineInterface
+	var vmiInterface *kubecli.MockVirtualMachineInstanceInterface
 	var ctrl *gomock.Controller
 	const vmName = "testvm"
 
@@ -44,6 +46,7 @@ var _ = Describe("Migrate command", func() {
 		kubecli.GetKubevirtClientFromClientConfig = kubecli.GetMockKubevirtClientFromClientConfig
 		kubecli.MockKubevirtClientInstance = kubecli.NewMockKubevirtClient(ctrl)
 		vmInterface = kubecli.NewMockVirtualMachineInterface(ctrl)
+		vmiInterface = kubecli.NewMockVirtualMachineInstanceInterface(ctrl)
 	})
 
 	It("should fail with missing input parameters", func() {
@@ -53,19 +56,71 @@ var _ = Describe("Migrate command", func() {
 		Expect(err).Should(MatchError("accepts 1 arg(s), received 0"))
 	})
 
-	DescribeTable("should migrate a vm according to options", func(migrateOptions *v1.MigrateOptions) {
+	DescribeTable("should migrate a vm according to options", func(migrateOptions *v1.MigrateOptions, extraArgs ...string) {
 		vm := kubecli.NewMinimalVM(vmName)
 
 		kubecli.MockKubevirtClientInstance.EXPECT().VirtualMachine(k8smetav1.NamespaceDefault).Return(vmInterface).Times(1)
 		vmInterface.EXPECT().Migrate(context.Background(), vm.Name, migrateOptions).Return(nil).Times(1)
 
-		args := []string{"migrate", vmName}
-		if len(migrateOptions.DryRun) > 0 {
-			args = append(args, "--dry-run")
-		}
+		args := append([]string{"migrate", vmName}, extraArgs...)
 		Expect(testing.NewRepeatableVirtctlCommand(args...)()).To(Succeed())
 	},
 		Entry("with default", &v1.MigrateOptions{}),
-		Entry("with dry-run option", &v1.MigrateOptions{DryRun: []string{k8smetav1.DryRunAll}}),
+		Entry("with target node", &v1.MigrateOptions{
+			AddedNodeSelector: map[string]string{k8sv1.LabelHostname: "node02"},
+		}, "--target-node", "node02"),
+		Entry("with node selector", &v1.MigrateOptions{
+			AddedNodeSelector: map[string]string{"zone": "east", "rack": "r1"},
+		}, "--selector", "zone=east,rack=r1"),
+		Entry("with target node and node selector", &v1.MigrateOptions{
+			AddedNodeSelector: map[string]string{"zone": "east", k8sv1.LabelHostname: "node02"},
+		}, "--selector", "zone=east", "--target-node", "node02"),
+	)
+
+	Context("with dry-run option", func() {
+		var migrateOptions *v1.MigrateOptions
+
+		BeforeEach(func() {
+			migrateOptions = &v1.MigrateOptions{
+				DryRun:            []string{k8smetav1.DryRunAll},
+				AddedNodeSelector: map[string]string{k8sv1.LabelHostname: "node02"},
+			}
+			kubecli.MockKubevirtClientInstance.EXPECT().VirtualMachineInstance(k8smetav1.NamespaceDefault).Return(vmiInterface).Times(1)
+		})
+
+		It("should check the migration and report the target nodes", func() {
+			vmiInterface.EXPECT().MigrationCheck(context.Background(), vmName, migrateOptions).Return(&v1.VirtualMachineInstanceMigrationCheckResult{
+				Migratable:  true,
+				TargetNodes: []string{"node02"},
+			}, nil).Times(1)
+			kubecli.MockKubevirtClientInstance.EXPECT().VirtualMachine(k8smetav1.NamespaceDefault).Return(vmInterface).Times(1)
+			vmInterface.EXPECT().Migrate(context.Background(), vmName, migrateOptions).Return(nil).Times(1)
+
+			out, err := testing.NewRepeatableVirtctlCommandWithOut("migrate", vmName, "--dry-run", "--target-node", "node02")()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(string(out)).To(ContainSubstring("VM testvm can be migrated to the nodes: node02"))
+		})
+
+		It("should fail with the blockers of the migration", func() {
+			vmiInterface.EXPECT().MigrationCheck(context.Background(), vmName, migrateOptions).Return(&v1.VirtualMachineInstanceMigrationCheckResult{
+				Blockers: []v1.MigrationBlocker{
+					{Type: v1.MigrationBlockerLiveMigratable, Reason: v1.VirtualMachineInstanceReasonHostDeviceNotMigratable, Message: "VMI uses a PCI host devices"},
+					{Type: v1.MigrationBlockerCPUModel, Message: "no schedulable node supports the CPU model"},
+				},
+			}, nil).Times(1)
+
+			err := testing.NewRepeatableVirtctlCommand("migrate", vmName, "--dry-run", "--target-node", "node02")()
+			Expect(err).To(MatchError(ContainSubstring("VM testvm can not be migrated")))
+			Expect(err).To(MatchError(ContainSubstring("LiveMigratable (HostDeviceNotLiveMigratable): VMI uses a PCI host devices")))
+			Expect(err).To(MatchError(ContainSubstring("CPUModel: no schedulable node supports the CPU model")))
+		})
+	})
+
+	DescribeTable("should fail with invalid node constraints", func(expectedErr string, extraArgs ...string) {
+		args := append([]string{"migrate", vmName}, extraArgs...)
+		Expect(testing.NewRepeatableVirtctlCommand(args...)()).To(MatchError(ContainSubstring(expectedErr)))
+	},
+		Entry("with a malformed node selector", "invalid node selector", "--selector", "zone"),
+		Entry("with a conflicting target node", "conflicts with the node node01", "--selector", "kubernetes.io/hostname=node01", "--target-node", "node02"),
 	)
 })
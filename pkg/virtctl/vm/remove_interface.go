@@ -0,0 +1,103 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package vm
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+
+	v1 "kubevirt.io/api/core/v1"
+
+	"kubevirt.io/kubevirt/pkg/apimachinery/patch"
+	"kubevirt.io/kubevirt/pkg/virtctl/clientconfig"
+	"kubevirt.io/kubevirt/pkg/virtctl/templates"
+)
+
+func NewRemoveInterfaceCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "removeinterface VM",
+		Short:   "remove a network interface from a VM, hot-unplugging it when the VM is running",
+		Example: usageRemoveInterface(),
+		Args:    cobra.ExactArgs(1),
+		RunE:    removeInterfaceRun,
+	}
+	cmd.SetUsageTemplate(templates.UsageTemplate())
+	cmd.Flags().StringVar(&ifaceName, ifaceNameArg, "", "name of the interface to remove")
+	cmd.MarkFlagRequired(ifaceNameArg)
+	cmd.Flags().BoolVar(&dryRun, dryRunArg, false, dryRunCommandUsage)
+	return cmd
+}
+
+func usageRemoveInterface() string {
+	return `  #Remove the 'blue' interface from a VM.
+  {{ProgramName}} removeinterface fedora --name=blue
+  `
+}
+
+func removeInterfaceRun(cmd *cobra.Command, args []string) error {
+	vmName := args[0]
+
+	virtClient, namespace, _, err := clientconfig.ClientAndNamespaceFromContext(cmd.Context())
+	if err != nil {
+		return err
+	}
+
+	vm, err := virtClient.VirtualMachine(namespace).Get(cmd.Context(), vmName, metav1.GetOptions{})
+	if err != nil {
+		return fmt.Errorf("error getting VM %s: %v", vmName, err)
+	}
+
+	ifaceIndex := -1
+	for i, iface := range vm.Spec.Template.Spec.Domain.Devices.Interfaces {
+		if iface.Name == ifaceName {
+			ifaceIndex = i
+			break
+		}
+	}
+	if ifaceIndex == -1 {
+		return fmt.Errorf("interface %s not found in VM %s", ifaceName, vmName)
+	}
+	if vm.Spec.Template.Spec.Domain.Devices.Interfaces[ifaceIndex].State == v1.InterfaceStateAbsent {
+		cmd.Printf("Interface %s of VM %s is already being removed\n", ifaceName, vmName)
+		return nil
+	}
+
+	ifacePath := fmt.Sprintf("%s/%d", vmInterfacesPath, ifaceIndex)
+	patchBytes, err := patch.New(
+		patch.WithTest(ifacePath+"/name", ifaceName),
+		patch.WithAdd(ifacePath+"/state", v1.InterfaceStateAbsent),
+	).GeneratePayload()
+	if err != nil {
+		return err
+	}
+
+	_, err = virtClient.VirtualMachine(namespace).Patch(
+		cmd.Context(), vmName, types.JSONPatchType, patchBytes, metav1.PatchOptions{DryRun: setDryRunOption(dryRun)},
+	)
+	if err != nil {
+		return fmt.Errorf("error removing interface %s from VM %s: %v", ifaceName, vmName, err)
+	}
+
+	cmd.Printf("Successfully submitted remove interface request to VM %s for interface %s\n", vmName, ifaceName)
+	return nil
+}
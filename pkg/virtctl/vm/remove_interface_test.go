@@ -0,0 +1,108 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+package vm_test
+
+import (
+	"context"
+
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	v1 "kubevirt.io/api/core/v1"
+	"kubevirt.io/client-go/kubecli"
+	kubevirtfake "kubevirt.io/client-go/kubevirt/fake"
+	kvtesting "kubevirt.io/client-go/testing"
+
+	"kubevirt.io/kubevirt/pkg/virtctl/testing"
+)
+
+var _ = Describe("Remove interface command", func() {
+	const (
+		vmName    = "testvm"
+		ifaceName = "blue"
+	)
+
+	var virtClient *kubevirtfake.Clientset
+
+	newVM := func(ifaceState v1.InterfaceState) *v1.VirtualMachine {
+		vm := kubecli.NewMinimalVM(vmName)
+		vm.Namespace = metav1.NamespaceDefault
+		vm.Spec.Template = &v1.VirtualMachineInstanceTemplateSpec{}
+		vm.Spec.Template.Spec.Domain.Devices.Interfaces = []v1.Interface{
+			*v1.DefaultMasqueradeNetworkInterface(),
+			{Name: ifaceName, State: ifaceState, InterfaceBindingMethod: v1.InterfaceBindingMethod{SRIOV: &v1.InterfaceSRIOV{}}},
+		}
+		vm.Spec.Template.Spec.Networks = []v1.Network{
+			*v1.DefaultPodNetwork(),
+			{Name: ifaceName, NetworkSource: v1.NetworkSource{Multus: &v1.MultusNetwork{NetworkName: "blue-net"}}},
+		}
+		return vm
+	}
+
+	setupClient := func(vm *v1.VirtualMachine) {
+		virtClient = kubevirtfake.NewSimpleClientset(vm)
+		kubecli.MockKubevirtClientInstance.EXPECT().VirtualMachine(metav1.NamespaceDefault).
+			Return(virtClient.KubevirtV1().VirtualMachines(metav1.NamespaceDefault)).AnyTimes()
+	}
+
+	BeforeEach(func() {
+		ctrl := gomock.NewController(GinkgoT())
+		kubecli.GetKubevirtClientFromClientConfig = kubecli.GetMockKubevirtClientFromClientConfig
+		kubecli.MockKubevirtClientInstance = kubecli.NewMockKubevirtClient(ctrl)
+	})
+
+	DescribeTable("should fail with missing required or invalid parameters", func(expected string, extraArgs ...string) {
+		args := append([]string{"removeinterface"}, extraArgs...)
+		cmd := testing.NewRepeatableVirtctlCommand(args...)
+		Expect(cmd()).To(MatchError(ContainSubstring(expected)))
+	},
+		Entry("no args", "accepts 1 arg(s), received 0"),
+		Entry("missing required name", "required flag(s)", vmName),
+	)
+
+	It("should mark the interface as absent", func() {
+		setupClient(newVM(""))
+
+		cmd := testing.NewRepeatableVirtctlCommand("removeinterface", vmName, "--name="+ifaceName)
+		Expect(cmd()).To(Succeed())
+
+		vm, err := virtClient.KubevirtV1().VirtualMachines(metav1.NamespaceDefault).Get(context.Background(), vmName, metav1.GetOptions{})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(vm.Spec.Template.Spec.Domain.Devices.Interfaces[1].State).To(Equal(v1.InterfaceStateAbsent))
+		Expect(vm.Spec.Template.Spec.Networks).To(HaveLen(2))
+	})
+
+	It("should not patch an interface which is already absent", func() {
+		setupClient(newVM(v1.InterfaceStateAbsent))
+
+		cmd := testing.NewRepeatableVirtctlCommand("removeinterface", vmName, "--name="+ifaceName)
+		Expect(cmd()).To(Succeed())
+		Expect(kvtesting.FilterActions(&virtClient.Fake, "patch", "virtualmachines")).To(BeEmpty())
+	})
+
+	It("should fail when the interface does not exist", func() {
+		setupClient(newVM(""))
+
+		cmd := testing.NewRepeatableVirtctlCommand("removeinterface", vmName, "--name=red")
+		Expect(cmd()).To(MatchError(ContainSubstring("interface red not found in VM testvm")))
+	})
+})
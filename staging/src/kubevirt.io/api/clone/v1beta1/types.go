@@ -59,13 +59,23 @@ type VirtualMachineCloneSpec struct {
 	// Target is the outcome of the cloning process.
 	// Currently supported source types are:
 	// - VirtualMachine of kubevirt.io API group
+	// - VirtualMachineExport of export.kubevirt.io API group
 	// - Empty (nil).
 	// If the target is not provided, the target type would default to VirtualMachine and a random
 	// name would be generated for the target. The target's name can be viewed by
 	// inspecting status "TargetName" field below.
+	// A VirtualMachineExport target publishes the manifests and disks of the source for a
+	// VirtualMachineRestore of a peer cluster to import, which also assigns the new identity
+	// of the clone there.
 	// +optional
 	Target *corev1.TypedLocalObjectReference `json:"target,omitempty"`
 
+	// TargetNamespace is the namespace a VirtualMachine target is created in, defaults to the
+	// namespace of the clone. Cloning to another namespace requires the CrossNamespaceRestore
+	// feature gate and permission to create VirtualMachines in that namespace.
+	// +optional
+	TargetNamespace string `json:"targetNamespace,omitempty"`
+
 	// Example use: "!some/key*".
 	// For a detailed description, please refer to https://kubevirt.io/user-guide/operations/clone_api/#label-annotation-filters.
 	// +optional
@@ -97,6 +107,7 @@ const (
 	SnapshotInProgress VirtualMachineClonePhase = "SnapshotInProgress"
 	CreatingTargetVM   VirtualMachineClonePhase = "CreatingTargetVM"
 	RestoreInProgress  VirtualMachineClonePhase = "RestoreInProgress"
+	ExportInProgress   VirtualMachineClonePhase = "ExportInProgress"
 	Succeeded          VirtualMachineClonePhase = "Succeeded"
 	Failed             VirtualMachineClonePhase = "Failed"
 	Unknown            VirtualMachineClonePhase = "Unknown"
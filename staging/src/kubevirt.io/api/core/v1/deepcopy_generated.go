@@ -206,6 +206,23 @@ func (in *BIOS) DeepCopy() *BIOS {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BackupVolumeSource) DeepCopyInto(out *BackupVolumeSource) {
+	*out = *in
+	out.PersistentVolumeClaimVolumeSource = in.PersistentVolumeClaimVolumeSource
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BackupVolumeSource.
+func (in *BackupVolumeSource) DeepCopy() *BackupVolumeSource {
+	if in == nil {
+		return nil
+	}
+	out := new(BackupVolumeSource)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *BlockSize) DeepCopyInto(out *BlockSize) {
 	*out = *in
@@ -1242,6 +1259,30 @@ func (in *DiskVerification) DeepCopy() *DiskVerification {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DomainBackupInfo) DeepCopyInto(out *DomainBackupInfo) {
+	*out = *in
+	if in.StartTimestamp != nil {
+		in, out := &in.StartTimestamp, &out.StartTimestamp
+		*out = (*in).DeepCopy()
+	}
+	if in.EndTimestamp != nil {
+		in, out := &in.EndTimestamp, &out.EndTimestamp
+		*out = (*in).DeepCopy()
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DomainBackupInfo.
+func (in *DomainBackupInfo) DeepCopy() *DomainBackupInfo {
+	if in == nil {
+		return nil
+	}
+	out := new(DomainBackupInfo)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *DomainMemoryDumpInfo) DeepCopyInto(out *DomainMemoryDumpInfo) {
 	*out = *in
@@ -1728,6 +1769,81 @@ func (in *FilesystemVirtiofs) DeepCopy() *FilesystemVirtiofs {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FirewallPort) DeepCopyInto(out *FirewallPort) {
+	*out = *in
+	if in.EndPort != nil {
+		in, out := &in.EndPort, &out.EndPort
+		*out = new(int32)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FirewallPort.
+func (in *FirewallPort) DeepCopy() *FirewallPort {
+	if in == nil {
+		return nil
+	}
+	out := new(FirewallPort)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FirewallRateLimit) DeepCopyInto(out *FirewallRateLimit) {
+	*out = *in
+	if in.Burst != nil {
+		in, out := &in.Burst, &out.Burst
+		*out = new(int32)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FirewallRateLimit.
+func (in *FirewallRateLimit) DeepCopy() *FirewallRateLimit {
+	if in == nil {
+		return nil
+	}
+	out := new(FirewallRateLimit)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FirewallRule) DeepCopyInto(out *FirewallRule) {
+	*out = *in
+	if in.CIDRs != nil {
+		in, out := &in.CIDRs, &out.CIDRs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Ports != nil {
+		in, out := &in.Ports, &out.Ports
+		*out = make([]FirewallPort, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.RateLimit != nil {
+		in, out := &in.RateLimit, &out.RateLimit
+		*out = new(FirewallRateLimit)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FirewallRule.
+func (in *FirewallRule) DeepCopy() *FirewallRule {
+	if in == nil {
+		return nil
+	}
+	out := new(FirewallRule)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Firmware) DeepCopyInto(out *Firmware) {
 	*out = *in
@@ -1886,6 +2002,33 @@ func (in *GuestAgentPing) DeepCopy() *GuestAgentPing {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GuestLoadStatus) DeepCopyInto(out *GuestLoadStatus) {
+	*out = *in
+	if in.CPUUtilizationPercentage != nil {
+		in, out := &in.CPUUtilizationPercentage, &out.CPUUtilizationPercentage
+		*out = new(int32)
+		**out = **in
+	}
+	if in.MemoryUtilizationPercentage != nil {
+		in, out := &in.MemoryUtilizationPercentage, &out.MemoryUtilizationPercentage
+		*out = new(int32)
+		**out = **in
+	}
+	in.LastProbeTime.DeepCopyInto(&out.LastProbeTime)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GuestLoadStatus.
+func (in *GuestLoadStatus) DeepCopy() *GuestLoadStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(GuestLoadStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *HPETTimer) DeepCopyInto(out *HPETTimer) {
 	*out = *in
@@ -1943,6 +2086,26 @@ func (in *Handler) DeepCopy() *Handler {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HeldWorkloadUpdate) DeepCopyInto(out *HeldWorkloadUpdate) {
+	*out = *in
+	if in.ScheduledTime != nil {
+		in, out := &in.ScheduledTime, &out.ScheduledTime
+		*out = (*in).DeepCopy()
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HeldWorkloadUpdate.
+func (in *HeldWorkloadUpdate) DeepCopy() *HeldWorkloadUpdate {
+	if in == nil {
+		return nil
+	}
+	out := new(HeldWorkloadUpdate)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *HostDevice) DeepCopyInto(out *HostDevice) {
 	*out = *in
@@ -2195,6 +2358,11 @@ func (in *Interface) DeepCopyInto(out *Interface) {
 		*out = new(DHCPOptions)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Firewall != nil {
+		in, out := &in.Firewall, &out.Firewall
+		*out = new(InterfaceFirewall)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -2312,6 +2480,36 @@ func (in *InterfaceBridge) DeepCopy() *InterfaceBridge {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *InterfaceFirewall) DeepCopyInto(out *InterfaceFirewall) {
+	*out = *in
+	if in.Ingress != nil {
+		in, out := &in.Ingress, &out.Ingress
+		*out = make([]FirewallRule, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Egress != nil {
+		in, out := &in.Egress, &out.Egress
+		*out = make([]FirewallRule, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InterfaceFirewall.
+func (in *InterfaceFirewall) DeepCopy() *InterfaceFirewall {
+	if in == nil {
+		return nil
+	}
+	out := new(InterfaceFirewall)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *InterfaceMasquerade) DeepCopyInto(out *InterfaceMasquerade) {
 	*out = *in
@@ -2684,6 +2882,11 @@ func (in *KubeVirtConfiguration) DeepCopyInto(out *KubeVirtConfiguration) {
 		*out = new(InstancetypeConfiguration)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.VMRestoreImportAllowedHosts != nil {
+		in, out := &in.VMRestoreImportAllowedHosts, &out.VMRestoreImportAllowedHosts
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 	return
 }
 
@@ -2831,6 +3034,13 @@ func (in *KubeVirtStatus) DeepCopyInto(out *KubeVirtStatus) {
 		*out = make([]GenerationStatus, len(*in))
 		copy(*out, *in)
 	}
+	if in.HeldWorkloadUpdates != nil {
+		in, out := &in.HeldWorkloadUpdates, &out.HeldWorkloadUpdates
+		*out = make([]HeldWorkloadUpdate, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	return
 }
 
@@ -2862,6 +3072,13 @@ func (in *KubeVirtWorkloadUpdateStrategy) DeepCopyInto(out *KubeVirtWorkloadUpda
 		*out = new(metav1.Duration)
 		**out = **in
 	}
+	if in.MaintenanceWindows != nil {
+		in, out := &in.MaintenanceWindows, &out.MaintenanceWindows
+		*out = make([]WorkloadUpdateMaintenanceWindow, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	return
 }
 
@@ -3114,6 +3331,18 @@ func (in *MigrateOptions) DeepCopyInto(out *MigrateOptions) {
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
+	if in.AddedNodeSelector != nil {
+		in, out := &in.AddedNodeSelector, &out.AddedNodeSelector
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.AddedNodeAffinity != nil {
+		in, out := &in.AddedNodeAffinity, &out.AddedNodeAffinity
+		*out = new(corev1.NodeAffinity)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -3127,6 +3356,22 @@ func (in *MigrateOptions) DeepCopy() *MigrateOptions {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MigrationBlocker) DeepCopyInto(out *MigrationBlocker) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MigrationBlocker.
+func (in *MigrationBlocker) DeepCopy() *MigrationBlocker {
+	if in == nil {
+		return nil
+	}
+	out := new(MigrationBlocker)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *MigrationConfiguration) DeepCopyInto(out *MigrationConfiguration) {
 	*out = *in
@@ -3190,11 +3435,66 @@ func (in *MigrationConfiguration) DeepCopyInto(out *MigrationConfiguration) {
 		*out = new(string)
 		**out = **in
 	}
+	if in.AdditionalNetworks != nil {
+		in, out := &in.AdditionalNetworks, &out.AdditionalNetworks
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.BandwidthPerNode != nil {
+		in, out := &in.BandwidthPerNode, &out.BandwidthPerNode
+		x := (*in).DeepCopy()
+		*out = &x
+	}
 	if in.MatchSELinuxLevelOnMigration != nil {
 		in, out := &in.MatchSELinuxLevelOnMigration, &out.MatchSELinuxLevelOnMigration
 		*out = new(bool)
 		**out = **in
 	}
+	if in.ParallelMigrationThreads != nil {
+		in, out := &in.ParallelMigrationThreads, &out.ParallelMigrationThreads
+		*out = new(uint32)
+		**out = **in
+	}
+	if in.Compression != nil {
+		in, out := &in.Compression, &out.Compression
+		*out = new(MigrationCompression)
+		**out = **in
+	}
+	if in.XBZRLECacheSize != nil {
+		in, out := &in.XBZRLECacheSize, &out.XBZRLECacheSize
+		x := (*in).DeepCopy()
+		*out = &x
+	}
+	if in.MaxDowntimeMilliseconds != nil {
+		in, out := &in.MaxDowntimeMilliseconds, &out.MaxDowntimeMilliseconds
+		*out = new(uint64)
+		**out = **in
+	}
+	if in.MaxRetries != nil {
+		in, out := &in.MaxRetries, &out.MaxRetries
+		*out = new(int32)
+		**out = **in
+	}
+	if in.RetryBackoffSeconds != nil {
+		in, out := &in.RetryBackoffSeconds, &out.RetryBackoffSeconds
+		*out = new(int64)
+		**out = **in
+	}
+	if in.NonConvergenceEscalation != nil {
+		in, out := &in.NonConvergenceEscalation, &out.NonConvergenceEscalation
+		*out = new(MigrationRetryEscalation)
+		**out = **in
+	}
+	if in.BlockMigrateLocalVolumes != nil {
+		in, out := &in.BlockMigrateLocalVolumes, &out.BlockMigrateLocalVolumes
+		*out = new(bool)
+		**out = **in
+	}
+	if in.PostMigrationVerification != nil {
+		in, out := &in.PostMigrationVerification, &out.PostMigrationVerification
+		*out = new(PostMigrationVerification)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -3623,6 +3923,71 @@ func (in *Port) DeepCopy() *Port {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PostMigrationVerification) DeepCopyInto(out *PostMigrationVerification) {
+	*out = *in
+	if in.Checks != nil {
+		in, out := &in.Checks, &out.Checks
+		*out = make([]PostMigrationCheck, len(*in))
+		copy(*out, *in)
+	}
+	if in.TimeoutSeconds != nil {
+		in, out := &in.TimeoutSeconds, &out.TimeoutSeconds
+		*out = new(int64)
+		**out = **in
+	}
+	if in.NetworkCheckPort != nil {
+		in, out := &in.NetworkCheckPort, &out.NetworkCheckPort
+		*out = new(int32)
+		**out = **in
+	}
+	if in.FailureAction != nil {
+		in, out := &in.FailureAction, &out.FailureAction
+		*out = new(PostMigrationFailureAction)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PostMigrationVerification.
+func (in *PostMigrationVerification) DeepCopy() *PostMigrationVerification {
+	if in == nil {
+		return nil
+	}
+	out := new(PostMigrationVerification)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PostMigrationVerificationStatus) DeepCopyInto(out *PostMigrationVerificationStatus) {
+	*out = *in
+	if in.FailedChecks != nil {
+		in, out := &in.FailedChecks, &out.FailedChecks
+		*out = make([]PostMigrationCheck, len(*in))
+		copy(*out, *in)
+	}
+	if in.StartTimestamp != nil {
+		in, out := &in.StartTimestamp, &out.StartTimestamp
+		*out = (*in).DeepCopy()
+	}
+	if in.EndTimestamp != nil {
+		in, out := &in.EndTimestamp, &out.EndTimestamp
+		*out = (*in).DeepCopy()
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PostMigrationVerificationStatus.
+func (in *PostMigrationVerificationStatus) DeepCopy() *PostMigrationVerificationStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(PostMigrationVerificationStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *PreferenceMatcher) DeepCopyInto(out *PreferenceMatcher) {
 	*out = *in
@@ -4769,6 +5134,30 @@ func (in *VirtualMachine) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VirtualMachineBackupRequest) DeepCopyInto(out *VirtualMachineBackupRequest) {
+	*out = *in
+	if in.StartTimestamp != nil {
+		in, out := &in.StartTimestamp, &out.StartTimestamp
+		*out = (*in).DeepCopy()
+	}
+	if in.EndTimestamp != nil {
+		in, out := &in.EndTimestamp, &out.EndTimestamp
+		*out = (*in).DeepCopy()
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineBackupRequest.
+func (in *VirtualMachineBackupRequest) DeepCopy() *VirtualMachineBackupRequest {
+	if in == nil {
+		return nil
+	}
+	out := new(VirtualMachineBackupRequest)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *VirtualMachineCondition) DeepCopyInto(out *VirtualMachineCondition) {
 	*out = *in
@@ -4833,6 +5222,22 @@ func (in *VirtualMachineInstanceCondition) DeepCopy() *VirtualMachineInstanceCon
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VirtualMachineInstanceCrossClusterMigrationState) DeepCopyInto(out *VirtualMachineInstanceCrossClusterMigrationState) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineInstanceCrossClusterMigrationState.
+func (in *VirtualMachineInstanceCrossClusterMigrationState) DeepCopy() *VirtualMachineInstanceCrossClusterMigrationState {
+	if in == nil {
+		return nil
+	}
+	out := new(VirtualMachineInstanceCrossClusterMigrationState)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *VirtualMachineInstanceFileSystem) DeepCopyInto(out *VirtualMachineInstanceFileSystem) {
 	*out = *in
@@ -4963,6 +5368,57 @@ func (in *VirtualMachineInstanceGuestAgentInfo) DeepCopyObject() runtime.Object
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VirtualMachineInstanceGuestExecOptions) DeepCopyInto(out *VirtualMachineInstanceGuestExecOptions) {
+	*out = *in
+	if in.Args != nil {
+		in, out := &in.Args, &out.Args
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.TimeoutSeconds != nil {
+		in, out := &in.TimeoutSeconds, &out.TimeoutSeconds
+		*out = new(int32)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineInstanceGuestExecOptions.
+func (in *VirtualMachineInstanceGuestExecOptions) DeepCopy() *VirtualMachineInstanceGuestExecOptions {
+	if in == nil {
+		return nil
+	}
+	out := new(VirtualMachineInstanceGuestExecOptions)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VirtualMachineInstanceGuestExecResult) DeepCopyInto(out *VirtualMachineInstanceGuestExecResult) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineInstanceGuestExecResult.
+func (in *VirtualMachineInstanceGuestExecResult) DeepCopy() *VirtualMachineInstanceGuestExecResult {
+	if in == nil {
+		return nil
+	}
+	out := new(VirtualMachineInstanceGuestExecResult)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *VirtualMachineInstanceGuestExecResult) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *VirtualMachineInstanceGuestOSInfo) DeepCopyInto(out *VirtualMachineInstanceGuestOSInfo) {
 	*out = *in
@@ -5064,7 +5520,7 @@ func (in *VirtualMachineInstanceMigration) DeepCopyInto(out *VirtualMachineInsta
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
-	out.Spec = in.Spec
+	in.Spec.DeepCopyInto(&out.Spec)
 	in.Status.DeepCopyInto(&out.Status)
 	return
 }
@@ -5087,6 +5543,70 @@ func (in *VirtualMachineInstanceMigration) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VirtualMachineInstanceMigrationAttempt) DeepCopyInto(out *VirtualMachineInstanceMigrationAttempt) {
+	*out = *in
+	if in.StartTimestamp != nil {
+		in, out := &in.StartTimestamp, &out.StartTimestamp
+		*out = (*in).DeepCopy()
+	}
+	if in.EndTimestamp != nil {
+		in, out := &in.EndTimestamp, &out.EndTimestamp
+		*out = (*in).DeepCopy()
+	}
+	if in.Failure != nil {
+		in, out := &in.Failure, &out.Failure
+		*out = new(VirtualMachineInstanceMigrationFailure)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineInstanceMigrationAttempt.
+func (in *VirtualMachineInstanceMigrationAttempt) DeepCopy() *VirtualMachineInstanceMigrationAttempt {
+	if in == nil {
+		return nil
+	}
+	out := new(VirtualMachineInstanceMigrationAttempt)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VirtualMachineInstanceMigrationCheckResult) DeepCopyInto(out *VirtualMachineInstanceMigrationCheckResult) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	if in.Blockers != nil {
+		in, out := &in.Blockers, &out.Blockers
+		*out = make([]MigrationBlocker, len(*in))
+		copy(*out, *in)
+	}
+	if in.TargetNodes != nil {
+		in, out := &in.TargetNodes, &out.TargetNodes
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineInstanceMigrationCheckResult.
+func (in *VirtualMachineInstanceMigrationCheckResult) DeepCopy() *VirtualMachineInstanceMigrationCheckResult {
+	if in == nil {
+		return nil
+	}
+	out := new(VirtualMachineInstanceMigrationCheckResult)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *VirtualMachineInstanceMigrationCheckResult) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *VirtualMachineInstanceMigrationCondition) DeepCopyInto(out *VirtualMachineInstanceMigrationCondition) {
 	*out = *in
@@ -5105,6 +5625,22 @@ func (in *VirtualMachineInstanceMigrationCondition) DeepCopy() *VirtualMachineIn
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VirtualMachineInstanceMigrationFailure) DeepCopyInto(out *VirtualMachineInstanceMigrationFailure) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineInstanceMigrationFailure.
+func (in *VirtualMachineInstanceMigrationFailure) DeepCopy() *VirtualMachineInstanceMigrationFailure {
+	if in == nil {
+		return nil
+	}
+	out := new(VirtualMachineInstanceMigrationFailure)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *VirtualMachineInstanceMigrationList) DeepCopyInto(out *VirtualMachineInstanceMigrationList) {
 	*out = *in
@@ -5155,9 +5691,73 @@ func (in *VirtualMachineInstanceMigrationPhaseTransitionTimestamp) DeepCopy() *V
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VirtualMachineInstanceMigrationReceive) DeepCopyInto(out *VirtualMachineInstanceMigrationReceive) {
+	*out = *in
+	if in.VirtualMachine != nil {
+		in, out := &in.VirtualMachine, &out.VirtualMachine
+		*out = new(VirtualMachineSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineInstanceMigrationReceive.
+func (in *VirtualMachineInstanceMigrationReceive) DeepCopy() *VirtualMachineInstanceMigrationReceive {
+	if in == nil {
+		return nil
+	}
+	out := new(VirtualMachineInstanceMigrationReceive)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VirtualMachineInstanceMigrationSendTo) DeepCopyInto(out *VirtualMachineInstanceMigrationSendTo) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineInstanceMigrationSendTo.
+func (in *VirtualMachineInstanceMigrationSendTo) DeepCopy() *VirtualMachineInstanceMigrationSendTo {
+	if in == nil {
+		return nil
+	}
+	out := new(VirtualMachineInstanceMigrationSendTo)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *VirtualMachineInstanceMigrationSpec) DeepCopyInto(out *VirtualMachineInstanceMigrationSpec) {
 	*out = *in
+	if in.AddedNodeSelector != nil {
+		in, out := &in.AddedNodeSelector, &out.AddedNodeSelector
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.AddedNodeAffinity != nil {
+		in, out := &in.AddedNodeAffinity, &out.AddedNodeAffinity
+		*out = new(corev1.NodeAffinity)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.SendTo != nil {
+		in, out := &in.SendTo, &out.SendTo
+		*out = new(VirtualMachineInstanceMigrationSendTo)
+		**out = **in
+	}
+	if in.Receive != nil {
+		in, out := &in.Receive, &out.Receive
+		*out = new(VirtualMachineInstanceMigrationReceive)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Priority != nil {
+		in, out := &in.Priority, &out.Priority
+		*out = new(int32)
+		**out = **in
+	}
 	return
 }
 
@@ -5208,6 +5808,11 @@ func (in *VirtualMachineInstanceMigrationState) DeepCopyInto(out *VirtualMachine
 		*out = make([]int, len(*in))
 		copy(*out, *in)
 	}
+	if in.CrossCluster != nil {
+		in, out := &in.CrossCluster, &out.CrossCluster
+		*out = new(VirtualMachineInstanceCrossClusterMigrationState)
+		**out = **in
+	}
 	return
 }
 
@@ -5243,6 +5848,28 @@ func (in *VirtualMachineInstanceMigrationStatus) DeepCopyInto(out *VirtualMachin
 		*out = new(VirtualMachineInstanceMigrationState)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.QueuePosition != nil {
+		in, out := &in.QueuePosition, &out.QueuePosition
+		*out = new(int32)
+		**out = **in
+	}
+	if in.Failure != nil {
+		in, out := &in.Failure, &out.Failure
+		*out = new(VirtualMachineInstanceMigrationFailure)
+		**out = **in
+	}
+	if in.AttemptHistory != nil {
+		in, out := &in.AttemptHistory, &out.AttemptHistory
+		*out = make([]VirtualMachineInstanceMigrationAttempt, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.PostMigrationVerification != nil {
+		in, out := &in.PostMigrationVerification, &out.PostMigrationVerification
+		*out = new(PostMigrationVerificationStatus)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -5264,6 +5891,11 @@ func (in *VirtualMachineInstanceNetworkInterface) DeepCopyInto(out *VirtualMachi
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
+	if in.FirewallRules != nil {
+		in, out := &in.FirewallRules, &out.FirewallRules
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 	return
 }
 
@@ -5710,6 +6342,11 @@ func (in *VirtualMachineInstanceStatus) DeepCopyInto(out *VirtualMachineInstance
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.GuestLoad != nil {
+		in, out := &in.GuestLoad, &out.GuestLoad
+		*out = new(GuestLoadStatus)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -5979,6 +6616,11 @@ func (in *VirtualMachineStatus) DeepCopyInto(out *VirtualMachineStatus) {
 		*out = new(VirtualMachineMemoryDumpRequest)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.BackupRequest != nil {
+		in, out := &in.BackupRequest, &out.BackupRequest
+		*out = new(VirtualMachineBackupRequest)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.VolumeUpdateState != nil {
 		in, out := &in.VolumeUpdateState, &out.VolumeUpdateState
 		*out = new(VolumeUpdateState)
@@ -6157,6 +6799,11 @@ func (in *VolumeSource) DeepCopyInto(out *VolumeSource) {
 		*out = new(MemoryDumpVolumeSource)
 		**out = **in
 	}
+	if in.Backup != nil {
+		in, out := &in.Backup, &out.Backup
+		*out = new(BackupVolumeSource)
+		**out = **in
+	}
 	return
 }
 
@@ -6188,6 +6835,11 @@ func (in *VolumeStatus) DeepCopyInto(out *VolumeStatus) {
 		*out = new(DomainMemoryDumpInfo)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.BackupVolume != nil {
+		in, out := &in.BackupVolume, &out.BackupVolume
+		*out = new(DomainBackupInfo)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.ContainerDiskVolume != nil {
 		in, out := &in.ContainerDiskVolume, &out.ContainerDiskVolume
 		*out = new(ContainerDiskInfo)
@@ -6264,3 +6916,59 @@ func (in *WatchdogDevice) DeepCopy() *WatchdogDevice {
 	in.DeepCopyInto(out)
 	return out
 }
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *WorkloadUpdateMaintenanceWindow) DeepCopyInto(out *WorkloadUpdateMaintenanceWindow) {
+	*out = *in
+	if in.Namespaces != nil {
+		in, out := &in.Namespaces, &out.Namespaces
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Selector != nil {
+		in, out := &in.Selector, &out.Selector
+		*out = new(metav1.LabelSelector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Duration != nil {
+		in, out := &in.Duration, &out.Duration
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+	if in.TimeRanges != nil {
+		in, out := &in.TimeRanges, &out.TimeRanges
+		*out = make([]WorkloadUpdateTimeRange, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WorkloadUpdateMaintenanceWindow.
+func (in *WorkloadUpdateMaintenanceWindow) DeepCopy() *WorkloadUpdateMaintenanceWindow {
+	if in == nil {
+		return nil
+	}
+	out := new(WorkloadUpdateMaintenanceWindow)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *WorkloadUpdateTimeRange) DeepCopyInto(out *WorkloadUpdateTimeRange) {
+	*out = *in
+	in.Start.DeepCopyInto(&out.Start)
+	in.End.DeepCopyInto(&out.End)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WorkloadUpdateTimeRange.
+func (in *WorkloadUpdateTimeRange) DeepCopy() *WorkloadUpdateTimeRange {
+	if in == nil {
+		return nil
+	}
+	out := new(WorkloadUpdateTimeRange)
+	in.DeepCopyInto(out)
+	return out
+}
@@ -843,6 +843,8 @@ type VolumeSource struct {
 	DownwardMetrics *DownwardMetricsVolumeSource `json:"downwardMetrics,omitempty"`
 	// MemoryDump is attached to the virt launcher and is populated with a memory dump of the vmi
 	MemoryDump *MemoryDumpVolumeSource `json:"memoryDump,omitempty"`
+	// Backup is attached to the virt launcher and is populated with a backup of the vmi disks
+	Backup *BackupVolumeSource `json:"backup,omitempty"`
 }
 
 // HotplugVolumeSource Represents the source of a volume to mount which are capable
@@ -883,6 +885,25 @@ type MemoryDumpVolumeSource struct {
 	// Directly attached to the virt launcher
 	// +optional
 	PersistentVolumeClaimVolumeSource `json:",inline"`
+	// Format is the format of the memory dump, defaults to raw
+	// +optional
+	Format MemoryDumpFormat `json:"format,omitempty"`
+}
+
+type BackupVolumeSource struct {
+	// PersistentVolumeClaimVolumeSource represents a reference to a PersistentVolumeClaim in the same namespace.
+	// Directly attached to the virt launcher
+	// +optional
+	PersistentVolumeClaimVolumeSource `json:",inline"`
+	// BackupName is the name of the backup and of the checkpoint created with it
+	BackupName string `json:"backupName"`
+	// IncrementalFrom is the name of the checkpoint of a previous backup, only the
+	// blocks changed since that checkpoint are backed up. A full backup is taken if empty.
+	// +optional
+	IncrementalFrom string `json:"incrementalFrom,omitempty"`
+	// Format is the format of the disk backup images
+	// +optional
+	Format BackupFormat `json:"format,omitempty"`
 }
 
 type EphemeralVolumeSource struct {
@@ -1283,8 +1304,89 @@ type Interface struct {
 	// The (only) value supported is `absent`, expressing a request to remove the interface.
 	// +optional
 	State InterfaceState `json:"state,omitempty"`
+	// Firewall restricts the traffic of the interface. It is enforced with nftables in the
+	// virt-launcher pod, for interfaces with the bridge or masquerade binding.
+	// +optional
+	Firewall *InterfaceFirewall `json:"firewall,omitempty"`
 }
 
+// InterfaceFirewall holds the rules restricting the traffic of an interface.
+// The rules of a direction are evaluated in order, the first matching rule decides.
+// Replies to accepted traffic are always accepted, and so are ARP, IPv6 neighbor
+// discovery and DHCP with the bridge binding.
+type InterfaceFirewall struct {
+	// Ingress are the rules for the traffic to the guest
+	// +optional
+	// +listType=atomic
+	Ingress []FirewallRule `json:"ingress,omitempty"`
+	// Egress are the rules for the traffic from the guest
+	// +optional
+	// +listType=atomic
+	Egress []FirewallRule `json:"egress,omitempty"`
+	// IngressDefaultAction is applied to the traffic to the guest which matches none of the ingress rules.
+	// Defaults to Drop if there are ingress rules, Accept otherwise
+	// +kubebuilder:validation:Enum=Accept;Drop
+	// +optional
+	IngressDefaultAction FirewallAction `json:"ingressDefaultAction,omitempty"`
+	// EgressDefaultAction is applied to the traffic from the guest which matches none of the egress rules.
+	// Defaults to Drop if there are egress rules, Accept otherwise
+	// +kubebuilder:validation:Enum=Accept;Drop
+	// +optional
+	EgressDefaultAction FirewallAction `json:"egressDefaultAction,omitempty"`
+}
+
+// FirewallRule matches traffic of an interface. All of its fields have to match.
+type FirewallRule struct {
+	// CIDRs are the networks the traffic comes from for ingress rules, or goes to for egress rules.
+	// Any address matches if empty
+	// +optional
+	// +listType=atomic
+	CIDRs []string `json:"cidrs,omitempty"`
+	// Protocol of the traffic, one of TCP, UDP, SCTP or ICMP. Any protocol matches if empty
+	// +kubebuilder:validation:Enum=TCP;UDP;SCTP;ICMP
+	// +optional
+	Protocol string `json:"protocol,omitempty"`
+	// Ports are the destination ports of the traffic. They require the TCP, UDP or SCTP protocol.
+	// Any port matches if empty
+	// +optional
+	// +listType=atomic
+	Ports []FirewallPort `json:"ports,omitempty"`
+	// RateLimit limits the rate of the traffic the rule accepts. The traffic above the limit
+	// is evaluated by the following rules
+	// +optional
+	RateLimit *FirewallRateLimit `json:"rateLimit,omitempty"`
+	// Action is applied to the matching traffic. Defaults to Accept
+	// +kubebuilder:validation:Enum=Accept;Drop
+	// +optional
+	Action FirewallAction `json:"action,omitempty"`
+}
+
+// FirewallPort is a port or a range of ports
+type FirewallPort struct {
+	// Port is the port, or the first port of the range
+	Port int32 `json:"port"`
+	// EndPort is the last port of the range
+	// +optional
+	EndPort *int32 `json:"endPort,omitempty"`
+}
+
+// FirewallRateLimit is a rate of packets
+type FirewallRateLimit struct {
+	// PacketsPerSecond is the number of packets allowed per second
+	PacketsPerSecond int32 `json:"packetsPerSecond"`
+	// Burst is the number of packets allowed to exceed the rate at once. Defaults to 5
+	// +optional
+	Burst *int32 `json:"burst,omitempty"`
+}
+
+// FirewallAction is what a firewall does with traffic
+type FirewallAction string
+
+const (
+	FirewallActionAccept FirewallAction = "Accept"
+	FirewallActionDrop   FirewallAction = "Drop"
+)
+
 type InterfaceState string
 
 const (
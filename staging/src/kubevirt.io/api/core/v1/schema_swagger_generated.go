@@ -450,6 +450,7 @@ func (VolumeSource) SwaggerDoc() map[string]string {
 		"serviceAccount":        "ServiceAccountVolumeSource represents a reference to a service account.\nThere can only be one volume of this type!\nMore info: https://kubernetes.io/docs/tasks/configure-pod-container/configure-service-account/\n+optional",
 		"downwardMetrics":       "DownwardMetrics adds a very small disk to VMIs which contains a limited view of host and guest\nmetrics. The disk content is compatible with vhostmd (https://github.com/vhostmd/vhostmd) and vm-dump-metrics.",
 		"memoryDump":            "MemoryDump is attached to the virt launcher and is populated with a memory dump of the vmi",
+		"backup":                "Backup is attached to the virt launcher and is populated with a backup of the vmi disks",
 	}
 }
 
@@ -476,7 +477,17 @@ func (PersistentVolumeClaimVolumeSource) SwaggerDoc() map[string]string {
 }
 
 func (MemoryDumpVolumeSource) SwaggerDoc() map[string]string {
-	return map[string]string{}
+	return map[string]string{
+		"format": "Format is the format of the memory dump, defaults to raw\n+optional",
+	}
+}
+
+func (BackupVolumeSource) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"backupName":      "BackupName is the name of the backup and of the checkpoint created with it",
+		"incrementalFrom": "IncrementalFrom is the name of the checkpoint of a previous backup, only the\nblocks changed since that checkpoint are backed up. A full backup is taken if empty.\n+optional",
+		"format":          "Format is the format of the disk backup images\n+optional",
+	}
 }
 
 func (EphemeralVolumeSource) SwaggerDoc() map[string]string {
@@ -679,6 +690,44 @@ func (Interface) SwaggerDoc() map[string]string {
 		"tag":         "If specified, the virtual network interface address and its tag will be provided to the guest via config drive\n+optional",
 		"acpiIndex":   "If specified, the ACPI index is used to provide network interface device naming, that is stable across changes\nin PCI addresses assigned to the device.\nThis value is required to be unique across all devices and be between 1 and (16*1024-1).\n+optional",
 		"state":       "State represents the requested operational state of the interface.\nThe (only) value supported is `absent`, expressing a request to remove the interface.\n+optional",
+		"firewall":    "Firewall restricts the traffic of the interface. It is enforced with nftables in the\nvirt-launcher pod, for interfaces with the bridge or masquerade binding.\n+optional",
+	}
+}
+
+func (InterfaceFirewall) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":                     "InterfaceFirewall holds the rules restricting the traffic of an interface.\nThe rules of a direction are evaluated in order, the first matching rule decides.\nReplies to accepted traffic are always accepted, and so are ARP, IPv6 neighbor\ndiscovery and DHCP with the bridge binding.",
+		"ingress":              "Ingress are the rules for the traffic to the guest\n+optional\n+listType=atomic",
+		"egress":               "Egress are the rules for the traffic from the guest\n+optional\n+listType=atomic",
+		"ingressDefaultAction": "IngressDefaultAction is applied to the traffic to the guest which matches none of the ingress rules.\nDefaults to Drop if there are ingress rules, Accept otherwise\n+kubebuilder:validation:Enum=Accept;Drop\n+optional",
+		"egressDefaultAction":  "EgressDefaultAction is applied to the traffic from the guest which matches none of the egress rules.\nDefaults to Drop if there are egress rules, Accept otherwise\n+kubebuilder:validation:Enum=Accept;Drop\n+optional",
+	}
+}
+
+func (FirewallRule) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":          "FirewallRule matches traffic of an interface. All of its fields have to match.",
+		"cidrs":     "CIDRs are the networks the traffic comes from for ingress rules, or goes to for egress rules.\nAny address matches if empty\n+optional\n+listType=atomic",
+		"protocol":  "Protocol of the traffic, one of TCP, UDP, SCTP or ICMP. Any protocol matches if empty\n+kubebuilder:validation:Enum=TCP;UDP;SCTP;ICMP\n+optional",
+		"ports":     "Ports are the destination ports of the traffic. They require the TCP, UDP or SCTP protocol.\nAny port matches if empty\n+optional\n+listType=atomic",
+		"rateLimit": "RateLimit limits the rate of the traffic the rule accepts. The traffic above the limit\nis evaluated by the following rules\n+optional",
+		"action":    "Action is applied to the matching traffic. Defaults to Accept\n+kubebuilder:validation:Enum=Accept;Drop\n+optional",
+	}
+}
+
+func (FirewallPort) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":        "FirewallPort is a port or a range of ports",
+		"port":    "Port is the port, or the first port of the range",
+		"endPort": "EndPort is the last port of the range\n+optional",
+	}
+}
+
+func (FirewallRateLimit) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":                 "FirewallRateLimit is a rate of packets",
+		"packetsPerSecond": "PacketsPerSecond is the number of packets allowed per second",
+		"burst":            "Burst is the number of packets allowed to exceed the rate at once. Defaults to 5\n+optional",
 	}
 }
 
@@ -299,6 +299,29 @@ type VirtualMachineInstanceStatus struct {
 	// +listType=atomic
 	// +optional
 	MigratedVolumes []StorageMigratedVolumeInfo `json:"migratedVolumes,omitempty"`
+
+	// GuestLoad shows the load of the guest, as last sampled by virt-handler.
+	// It is only reported for VMIs of VirtualMachinePools, which autoscale on it.
+	// +optional
+	GuestLoad *GuestLoadStatus `json:"guestLoad,omitempty"`
+}
+
+// GuestLoadStatus holds the load of the guest of a VMI
+type GuestLoadStatus struct {
+	// CPUUtilizationPercentage is the time the vCPUs of the guest were busy
+	// since the previous sample, in percent of the time they were available.
+	// +optional
+	CPUUtilizationPercentage *int32 `json:"cpuUtilizationPercentage,omitempty"`
+
+	// MemoryUtilizationPercentage is the memory of the guest which is in use
+	// and can't be reclaimed, in percent of the memory available to the guest.
+	// It requires the memory balloon device to be reported.
+	// +optional
+	MemoryUtilizationPercentage *int32 `json:"memoryUtilizationPercentage,omitempty"`
+
+	// LastProbeTime is the time the guest load was sampled.
+	// +nullable
+	LastProbeTime metav1.Time `json:"lastProbeTime,omitempty"`
 }
 
 // StorageMigratedVolumeInfo tracks the information about the source and destination volumes during the volume migration
@@ -367,6 +390,8 @@ type VolumeStatus struct {
 	Size int64 `json:"size,omitempty"`
 	// If the volume is memorydump volume, this will contain the memorydump info.
 	MemoryDumpVolume *DomainMemoryDumpInfo `json:"memoryDumpVolume,omitempty"`
+	// If the volume is a backup volume, this will contain the backup info.
+	BackupVolume *DomainBackupInfo `json:"backupVolume,omitempty"`
 	// ContainerDiskVolume shows info about the containerdisk, if the volume is a containerdisk
 	ContainerDiskVolume *ContainerDiskInfo `json:"containerDiskVolume,omitempty"`
 }
@@ -403,6 +428,18 @@ type DomainMemoryDumpInfo struct {
 	TargetFileName string `json:"targetFileName,omitempty"`
 }
 
+// DomainBackupInfo represents the backup information
+type DomainBackupInfo struct {
+	// StartTimestamp is the time when the backup started
+	StartTimestamp *metav1.Time `json:"startTimestamp,omitempty"`
+	// EndTimestamp is the time when the backup completed
+	EndTimestamp *metav1.Time `json:"endTimestamp,omitempty"`
+	// ClaimName is the name of the pvc the backup was written to
+	ClaimName string `json:"claimName,omitempty"`
+	// BackupName is the name of the backup and of the checkpoint created with it
+	BackupName string `json:"backupName,omitempty"`
+}
+
 // HotplugVolumeStatus represents the hotplug status of the volume
 type HotplugVolumeStatus struct {
 	// AttachPodName is the name of the pod used to attach the volume to the node.
@@ -441,6 +478,12 @@ const (
 	MemoryDumpVolumeInProgress VolumePhase = "MemoryDumpInProgress"
 	// MemoryDumpVolumeInProgress means that the volume for the memory dump was attached, and now the command is being triggered
 	MemoryDumpVolumeFailed VolumePhase = "MemoryDumpFailed"
+	// BackupVolumeCompleted means that the requested backup was completed and the disk images are ready in the volume
+	BackupVolumeCompleted VolumePhase = "BackupCompleted"
+	// BackupVolumeInProgress means that the volume for the backup was attached, and now the backup is being taken
+	BackupVolumeInProgress VolumePhase = "BackupInProgress"
+	// BackupVolumeFailed means that the backup to the volume failed
+	BackupVolumeFailed VolumePhase = "BackupFailed"
 )
 
 func (v *VirtualMachineInstance) IsScheduling() bool {
@@ -580,6 +623,13 @@ const (
 
 	// Indicates whether the VMI is live migratable
 	VirtualMachineInstanceIsStorageLiveMigratable VirtualMachineInstanceConditionType = "StorageLiveMigratable"
+
+	// Reflects that the guest failed the checks on the target of its last migration
+	VirtualMachineInstancePostMigrationVerificationFailed VirtualMachineInstanceConditionType = "PostMigrationVerificationFailed"
+
+	// Reflects whether virt-handler reached the guest over the pod network after its last migration.
+	// It is only probed while the Network check of the post migration verification runs.
+	VirtualMachineInstanceGuestNetworkReachable VirtualMachineInstanceConditionType = "GuestNetworkReachable"
 )
 
 // These are valid reasons for VMI conditions.
@@ -686,6 +736,11 @@ func (m *VirtualMachineInstanceMigration) TargetIsHandedOff() bool {
 		m.Status.Phase != MigrationScheduled
 }
 
+// IsCrossCluster returns true if the VMI is migrated to or from another cluster
+func (m *VirtualMachineInstanceMigration) IsCrossCluster() bool {
+	return m.Spec.SendTo != nil || m.Spec.Receive != nil
+}
+
 type VirtualMachineInstanceNetworkInterface struct {
 	// IP address of a Virtual Machine interface. It is always the first item of
 	// IPs
@@ -706,8 +761,28 @@ type VirtualMachineInstanceNetworkInterface struct {
 	QueueCount int32 `json:"queueCount,omitempty"`
 	// LinkState Reports the current operational link state`. values: up, down.
 	LinkState string `json:"linkState,omitempty"`
+	// FirewallRules are the nftables rules enforcing the firewall of the interface in the virt-launcher pod
+	// +listType=atomic
+	// +optional
+	FirewallRules []string `json:"firewallRules,omitempty"`
+	// HotUnplugPhase reports the progress of the interface hot-unplug, once it was requested.
+	// values: Requested, DetachedInGuest, PodNetworkRemoved.
+	// +optional
+	HotUnplugPhase InterfaceHotUnplugPhase `json:"hotUnplugPhase,omitempty"`
 }
 
+// InterfaceHotUnplugPhase is the phase of an interface hot-unplug.
+type InterfaceHotUnplugPhase string
+
+const (
+	// InterfaceHotUnplugRequested means the interface was marked absent and awaits its removal from the guest.
+	InterfaceHotUnplugRequested InterfaceHotUnplugPhase = "Requested"
+	// InterfaceHotUnplugDetachedInGuest means the device was removed from the guest.
+	InterfaceHotUnplugDetachedInGuest InterfaceHotUnplugPhase = "DetachedInGuest"
+	// InterfaceHotUnplugPodNetworkRemoved means the network was removed from the virt-launcher pod.
+	InterfaceHotUnplugPodNetworkRemoved InterfaceHotUnplugPhase = "PodNetworkRemoved"
+)
+
 type VirtualMachineInstanceGuestOSInfo struct {
 	// Name of the Guest OS
 	Name string `json:"name,omitempty"`
@@ -770,6 +845,9 @@ type VirtualMachineInstanceMigrationState struct {
 	AbortStatus MigrationAbortStatus `json:"abortStatus,omitempty"`
 	// Contains the reason why the migration failed
 	FailureReason string `json:"failureReason,omitempty"`
+	// The kind of problem the migration failed on, as seen by the source
+	// +optional
+	FailureClass MigrationFailureClass `json:"failureClass,omitempty"`
 	// The VirtualMachineInstanceMigration object associated with this migration
 	MigrationUID types.UID `json:"migrationUid,omitempty"`
 	// Lets us know if the vmi is currently running pre or post copy migration
@@ -790,6 +868,18 @@ type VirtualMachineInstanceMigrationState struct {
 	SourcePersistentStatePVCName string `json:"sourcePersistentStatePVCName,omitempty"`
 	// If the VMI being migrated uses persistent features (backend-storage), its target PVC name is saved here
 	TargetPersistentStatePVCName string `json:"targetPersistentStatePVCName,omitempty"`
+	// If the VMI is migrated to or from another cluster, the details of the cross cluster migration are saved here
+	// +optional
+	CrossCluster *VirtualMachineInstanceCrossClusterMigrationState `json:"crossCluster,omitempty"`
+}
+
+// VirtualMachineInstanceCrossClusterMigrationState holds the details of a migration between two clusters
+type VirtualMachineInstanceCrossClusterMigrationState struct {
+	// The ID shared by the sending and the receiving migration
+	MigrationID string `json:"migrationID"`
+	// The address of the migration endpoint of the receiving cluster, only set on the sending side
+	// +optional
+	ConnectURL string `json:"connectURL,omitempty"`
 }
 
 type MigrationAbortStatus string
@@ -913,9 +1003,37 @@ const (
 	// This annotation indicates that a migration is the result of an
 	// automated workload update
 	WorkloadUpdateMigrationAnnotation string = "kubevirt.io/workloadUpdateMigration"
+	// This annotation indicates that a migration retries a failed migration.
+	// Its value is the name of the failed migration.
+	MigrationRetryOfAnnotation string = "kubevirt.io/migrationRetryOf"
+	// This annotation indicates that a migration retries a migration which did
+	// not converge in a more aggressive mode. Its value is the escalation used.
+	MigrationRetryEscalationAnnotation string = "kubevirt.io/migrationRetryEscalation"
+	// This annotation indicates that a migration moves a VMI back to its previous
+	// node because the guest failed the checks after a migration. Its value is the
+	// name of the migration which is rolled back.
+	MigrationRollbackOfAnnotation string = "kubevirt.io/migrationRollbackOf"
+	// This annotation marks a PVC which a migration provisioned to copy a local
+	// volume to. Its value is the name of the PVC the volume is copied from.
+	MigrationSourcePVCAnnotation string = "kubevirt.io/migrationSourcePVC"
+	// This annotation marks a VirtualMachineInstance whose local volumes a migration
+	// copies to new PVCs. Its value is the name of the migration. While it is set, the
+	// volumes of the VirtualMachineInstance are not synced with its VirtualMachine.
+	LocalVolumesCopyAnnotation string = "kubevirt.io/localVolumesCopy"
+	// This annotation marks a VirtualMachineInstance which waits to receive a
+	// migration from another cluster instead of being started. Its value is the
+	// migration ID of the receiving migration. It is set on the template of the
+	// VirtualMachine created for a receiving migration until the migration succeeded.
+	CrossClusterMigrationReceiverAnnotation string = "kubevirt.io/crossClusterMigrationReceiver"
 	// This annotation indicates to abort any migration due to an automated
 	// workload update. It should only be used for testing purposes.
 	WorkloadUpdateMigrationAbortionAnnotation string = "kubevirt.io/testWorkloadUpdateMigrationAbortion"
+	// This annotation excludes a VirtualMachineInstance from automated workload
+	// updates when set to "true".
+	WorkloadUpdateOptOutAnnotation string = "kubevirt.io/workloadUpdateOptOut"
+	// This annotation defers the automated workload update of a
+	// VirtualMachineInstance until the given time, in RFC 3339 format.
+	WorkloadUpdateDeferUntilAnnotation string = "kubevirt.io/workloadUpdateDeferUntil"
 	// This label declares whether a particular node is available for
 	// scheduling virtual machine instances on it. Used on Node.
 	NodeSchedulable string = "kubevirt.io/schedulable"
@@ -1070,6 +1188,21 @@ const (
 	// originated from.
 	VirtualMachinePoolRevisionName string = "kubevirt.io/vm-pool-revision-name"
 
+	// VirtualMachinePoolScaleInAnnotation is set on a VM of a vmpool with a
+	// proactive scale-in strategy once it is selected for removal. It holds the
+	// time the VM was selected, in RFC3339 format.
+	VirtualMachinePoolScaleInAnnotation string = "kubevirt.io/vm-pool-scale-in"
+
+	// VMIEndpointsSelectorAnnotation is set on a Service without a selector to have
+	// virt-controller publish its endpoints. It holds a label selector of the VMIs
+	// backing the Service, in the Service namespace.
+	VMIEndpointsSelectorAnnotation string = "kubevirt.io/vmi-endpoints-selector"
+
+	// VirtualMachinePoolEndpointsAnnotation is set on a Service without a selector to
+	// have virt-controller publish its endpoints. It holds the name of the vmpool whose
+	// VMIs back the Service, in the Service namespace.
+	VirtualMachinePoolEndpointsAnnotation string = "kubevirt.io/vm-pool-endpoints"
+
 	// VirtualMachineNameLabel is the name of the Virtual Machine
 	VirtualMachineNameLabel string = "vm.kubevirt.io/name"
 
@@ -1077,6 +1210,13 @@ const (
 	// pvc name and the timestamp the memory dump was collected
 	PVCMemoryDumpAnnotation string = "kubevirt.io/memory-dump"
 
+	// ResumeFromMemoryStateAnnotation names a PVC holding a saved memory state,
+	// the next VMI started from the VM resumes from it instead of booting
+	ResumeFromMemoryStateAnnotation string = "kubevirt.io/resume-from-memory-state"
+
+	// PVCBackupAnnotation is the name of the backup stored in the pvc
+	PVCBackupAnnotation string = "kubevirt.io/backup"
+
 	// AllowPodBridgeNetworkLiveMigrationAnnotation allow to run live migration when the
 	// vm has the pod networking bind with a bridge
 	AllowPodBridgeNetworkLiveMigrationAnnotation string = "kubevirt.io/allow-pod-bridge-network-live-migration"
@@ -1113,6 +1253,9 @@ const (
 
 	// MigrationInterfaceName is an arbitrary name used in virt-handler to connect it to a dedicated migration network
 	MigrationInterfaceName string = "migration0"
+	// AdditionalMigrationInterfacePrefix is the prefix of the interfaces connecting virt-handler to the additional
+	// migration networks. The additional networks are named migration1, migration2, ... in the order they are listed in.
+	AdditionalMigrationInterfacePrefix string = "migration"
 
 	// EmulatorThreadCompleteToEvenParity alpha annotation will cause Kubevirt to complete the VMI's CPU count to an even parity when IsolateEmulatorThread options are requested
 	EmulatorThreadCompleteToEvenParity string = "alpha.kubevirt.io/EmulatorThreadCompleteToEvenParity"
@@ -1383,6 +1526,56 @@ type VirtualMachineInstanceMigrationList struct {
 type VirtualMachineInstanceMigrationSpec struct {
 	// The name of the VMI to perform the migration on. VMI must exist in the migration objects namespace
 	VMIName string `json:"vmiName,omitempty" valid:"required"`
+
+	// AddedNodeSelector is an additional selector that can be used to complement a NodeSelector or NodeAffinity as set on the VM
+	// to restrict the set of allowed target nodes for a migration.
+	// In case of key collisions, values set on the VM objects are going to be preserved to ensure that addedNodeSelector
+	// can only restrict but not bypass constraints already set on the VM object.
+	// +optional
+	AddedNodeSelector map[string]string `json:"addedNodeSelector,omitempty"`
+
+	// AddedNodeAffinity is an additional node affinity for the target of a migration.
+	// Its required node selector terms are combined with the ones of the VM, so that they can only restrict the set
+	// of allowed target nodes. Its preferred scheduling terms are added to the ones of the VM.
+	// +optional
+	AddedNodeAffinity *k8sv1.NodeAffinity `json:"addedNodeAffinity,omitempty"`
+
+	// SendTo migrates the VMI to another cluster, where a migration with a matching receive section waits for it.
+	// Once the migration succeeded, the VMI is stopped in this cluster.
+	// +optional
+	SendTo *VirtualMachineInstanceMigrationSendTo `json:"sendTo,omitempty"`
+
+	// Receive creates the VM named by vmiName in this cluster, with a VMI which waits to receive a
+	// migration from another cluster instead of being started.
+	// +optional
+	Receive *VirtualMachineInstanceMigrationReceive `json:"receive,omitempty"`
+
+	// Priority of the migration. Pending migrations with a higher priority are started first.
+	// Migrations with the same priority are started in turns for evacuations, workload updates and
+	// user requests, so that none of them can starve the others. Defaults to 0.
+	// A priority above 0 requires the permission to prioritize virtualmachineinstancemigrations
+	// cluster wide, since the queue is shared by all namespaces.
+	// +optional
+	Priority *int32 `json:"priority,omitempty"`
+}
+
+// VirtualMachineInstanceMigrationSendTo describes where to migrate a VMI to in another cluster
+type VirtualMachineInstanceMigrationSendTo struct {
+	// MigrationID identifies the migration, it has to match the ID of the receiving migration
+	MigrationID string `json:"migrationID"`
+	// ConnectURL is the host:port of the migration endpoint of the receiving cluster,
+	// as reported in the status of the receiving migration and exposed to this cluster
+	ConnectURL string `json:"connectURL"`
+}
+
+// VirtualMachineInstanceMigrationReceive describes a migration received from another cluster
+type VirtualMachineInstanceMigrationReceive struct {
+	// MigrationID identifies the migration, it has to match the ID of the sending migration
+	MigrationID string `json:"migrationID"`
+	// VirtualMachine is the spec of the VirtualMachine created to own the received VMI, usually the one
+	// of the VirtualMachine in the sending cluster. Its disks have to exist in this cluster already,
+	// DataVolumeTemplates are not supported.
+	VirtualMachine *VirtualMachineSpec `json:"virtualMachine"`
 }
 
 // VirtualMachineInstanceMigrationPhaseTransitionTimestamp gives a timestamp in relation to when a phase is set on a vmi
@@ -1403,6 +1596,100 @@ type VirtualMachineInstanceMigrationStatus struct {
 	PhaseTransitionTimestamps []VirtualMachineInstanceMigrationPhaseTransitionTimestamp `json:"phaseTransitionTimestamps,omitempty"`
 	// Represents the status of a live migration
 	MigrationState *VirtualMachineInstanceMigrationState `json:"migrationState,omitempty"`
+	// ConnectURL is the address of the LoadBalancer Service exposing the migration endpoint of a receiving
+	// migration, to be set as sendTo.connectURL of the sending migration.
+	// +optional
+	ConnectURL string `json:"connectURL,omitempty"`
+	// QueuePosition is the position of a pending migration in the cluster wide queue of migrations
+	// waiting for a free migration slot, starting with 1. It is only set while the migration is pending.
+	// +optional
+	QueuePosition *int32 `json:"queuePosition,omitempty"`
+	// Failure classifies why the migration failed
+	// +optional
+	Failure *VirtualMachineInstanceMigrationFailure `json:"failure,omitempty"`
+	// AttemptHistory records the finished attempts to migrate the VMI, starting with the first
+	// migration and ending with this one when migrations are retried.
+	// +listType=atomic
+	// +optional
+	AttemptHistory []VirtualMachineInstanceMigrationAttempt `json:"attemptHistory,omitempty"`
+	// PostMigrationVerification reports the checks of the guest on the target after the migration succeeded
+	// +optional
+	PostMigrationVerification *PostMigrationVerificationStatus `json:"postMigrationVerification,omitempty"`
+}
+
+// PostMigrationVerificationPhase is the phase of the checks of the guest after a migration
+type PostMigrationVerificationPhase string
+
+const (
+	PostMigrationVerificationPending   PostMigrationVerificationPhase = "Pending"
+	PostMigrationVerificationSucceeded PostMigrationVerificationPhase = "Succeeded"
+	PostMigrationVerificationFailed    PostMigrationVerificationPhase = "Failed"
+)
+
+// PostMigrationVerificationStatus reports the checks of the guest on the target after a migration
+type PostMigrationVerificationStatus struct {
+	Phase PostMigrationVerificationPhase `json:"phase,omitempty"`
+	// FailedChecks are the checks which did not pass (yet)
+	// +listType=atomic
+	// +optional
+	FailedChecks []PostMigrationCheck `json:"failedChecks,omitempty"`
+	// +optional
+	Message string `json:"message,omitempty"`
+	// StartTimestamp is the time the verification started
+	// +optional
+	StartTimestamp *metav1.Time `json:"startTimestamp,omitempty"`
+	// EndTimestamp is the time the verification succeeded or failed
+	// +optional
+	EndTimestamp *metav1.Time `json:"endTimestamp,omitempty"`
+}
+
+// MigrationFailureClass is the kind of problem a migration failed on
+type MigrationFailureClass string
+
+const (
+	// MigrationFailureTargetScheduling means that the target pod could not be scheduled or started
+	MigrationFailureTargetScheduling MigrationFailureClass = "TargetScheduling"
+	// MigrationFailureNetwork means that the connection between the source and the target failed
+	MigrationFailureNetwork MigrationFailureClass = "Network"
+	// MigrationFailureTimeout means that the migration made no progress for too long
+	MigrationFailureTimeout MigrationFailureClass = "Timeout"
+	// MigrationFailureNonConvergence means that the guest dirtied its memory faster than it could be transferred
+	MigrationFailureNonConvergence MigrationFailureClass = "NonConvergence"
+	// MigrationFailureGuest means that the guest stopped or failed during the migration
+	MigrationFailureGuest MigrationFailureClass = "Guest"
+	// MigrationFailureAborted means that the migration was canceled
+	MigrationFailureAborted MigrationFailureClass = "Aborted"
+	// MigrationFailureUnknown means that the failure could not be classified
+	MigrationFailureUnknown MigrationFailureClass = "Unknown"
+)
+
+// VirtualMachineInstanceMigrationFailure describes why a migration failed
+type VirtualMachineInstanceMigrationFailure struct {
+	Class MigrationFailureClass `json:"class"`
+	// Message is the failure reason as reported by the source or the controller
+	// +optional
+	Message string `json:"message,omitempty"`
+}
+
+// VirtualMachineInstanceMigrationAttempt records a finished attempt to migrate a VMI
+type VirtualMachineInstanceMigrationAttempt struct {
+	// MigrationName is the name of the migration which made the attempt
+	MigrationName string `json:"migrationName"`
+	// +optional
+	StartTimestamp *metav1.Time `json:"startTimestamp,omitempty"`
+	// +optional
+	EndTimestamp *metav1.Time `json:"endTimestamp,omitempty"`
+	// +optional
+	SourceNode string `json:"sourceNode,omitempty"`
+	// +optional
+	TargetNode string `json:"targetNode,omitempty"`
+	// Phase is the final phase of the attempt
+	Phase VirtualMachineInstanceMigrationPhase `json:"phase"`
+	// Escalation is the more aggressive mode the attempt was made in, if any
+	// +optional
+	Escalation MigrationRetryEscalation `json:"escalation,omitempty"`
+	// +optional
+	Failure *VirtualMachineInstanceMigrationFailure `json:"failure,omitempty"`
 }
 
 // VirtualMachineInstanceMigrationPhase is a label for the condition of a VirtualMachineInstanceMigration at the current time.
@@ -1686,6 +1973,12 @@ type VirtualMachineStatus struct {
 	// +optional
 	MemoryDumpRequest *VirtualMachineMemoryDumpRequest `json:"memoryDumpRequest,omitempty" optional:"true"`
 
+	// BackupRequest tracks backup request phase and info of backing up
+	// the vm disks to the given pvc
+	// +nullable
+	// +optional
+	BackupRequest *VirtualMachineBackupRequest `json:"backupRequest,omitempty" optional:"true"`
+
 	// ObservedGeneration is the generation observed by the vmi when started.
 	// +optional
 	ObservedGeneration int64 `json:"observedGeneration,omitempty" optional:"true"`
@@ -1970,6 +2263,83 @@ type KubeVirtWorkloadUpdateStrategy struct {
 	//
 	// +optional
 	BatchEvictionInterval *metav1.Duration `json:"batchEvictionInterval,omitempty"`
+
+	// MaintenanceWindows restrict the automated workload updates of the VMIs they select
+	// to the times the windows are open. VMIs which are not selected by any window are
+	// updated at any time, VMIs selected by several windows while any of them is open
+	//
+	// +listType=atomic
+	// +optional
+	MaintenanceWindows []WorkloadUpdateMaintenanceWindow `json:"maintenanceWindows,omitempty"`
+}
+
+// WorkloadUpdateMaintenanceWindow defines when the automated workload updates of a group of VMIs may happen.
+// The window is open during its time ranges and, if it has a schedule, for the duration following every
+// time of the schedule
+type WorkloadUpdateMaintenanceWindow struct {
+	// Name identifies the maintenance window
+	Name string `json:"name"`
+
+	// Namespaces are the namespaces of the VMIs the window applies to.
+	// Defaults to all namespaces
+	//
+	// +listType=set
+	// +optional
+	Namespaces []string `json:"namespaces,omitempty"`
+
+	// Selector selects the VMIs the window applies to by their labels.
+	// Defaults to all VMIs
+	//
+	// +optional
+	Selector *metav1.LabelSelector `json:"selector,omitempty"`
+
+	// Schedule is a cron expression of the times the window opens at, in UTC
+	//
+	// +optional
+	Schedule string `json:"schedule,omitempty"`
+
+	// Duration is how long the window stays open every time it opens according to its schedule
+	//
+	// Defaults to 1 hour
+	//
+	// +optional
+	Duration *metav1.Duration `json:"duration,omitempty"`
+
+	// TimeRanges are fixed periods of time the window is open during
+	//
+	// +listType=atomic
+	// +optional
+	TimeRanges []WorkloadUpdateTimeRange `json:"timeRanges,omitempty"`
+}
+
+// WorkloadUpdateTimeRange is a period of time a maintenance window is open during
+type WorkloadUpdateTimeRange struct {
+	Start metav1.Time `json:"start"`
+	End   metav1.Time `json:"end"`
+}
+
+// WorkloadUpdateHoldReason tells why the automated workload update of an outdated VMI is held back
+type WorkloadUpdateHoldReason string
+
+const (
+	// WorkloadUpdateOptedOut means the VMI is excluded from automated workload updates
+	WorkloadUpdateOptedOut WorkloadUpdateHoldReason = "OptedOut"
+	// WorkloadUpdateDeferred means the update of the VMI is deferred by its annotation
+	WorkloadUpdateDeferred WorkloadUpdateHoldReason = "Deferred"
+	// WorkloadUpdateOutsideMaintenanceWindow means no maintenance window of the VMI is open
+	WorkloadUpdateOutsideMaintenanceWindow WorkloadUpdateHoldReason = "OutsideMaintenanceWindow"
+)
+
+// HeldWorkloadUpdate reports when the automated workload update of an outdated VMI happens
+type HeldWorkloadUpdate struct {
+	Namespace string `json:"namespace"`
+	Name      string `json:"name"`
+	// Reason tells why the update is held back
+	Reason WorkloadUpdateHoldReason `json:"reason"`
+	// ScheduledTime is the time from which on the VMI is updated. It is unset if the VMI is not
+	// updated automatically, or if none of its maintenance windows opens again
+	// +optional
+	ScheduledTime *metav1.Time `json:"scheduledTime,omitempty"`
 }
 
 type KubeVirtSpec struct {
@@ -2119,6 +2489,11 @@ type KubeVirtStatus struct {
 	DefaultArchitecture                     string              `json:"defaultArchitecture,omitempty"`
 	// +listType=atomic
 	Generations []GenerationStatus `json:"generations,omitempty" optional:"true"`
+	// HeldWorkloadUpdates lists the outdated VMIs whose automated workload update is held back
+	// by a maintenance window or their annotations, and when they are updated. Outdated VMIs which
+	// are not listed are updated as soon as the batch settings allow. At most 100 VMIs are listed
+	// +listType=atomic
+	HeldWorkloadUpdates []HeldWorkloadUpdate `json:"heldWorkloadUpdates,omitempty" optional:"true"`
 }
 
 // KubeVirtPhase is a label for the phase of a KubeVirt deployment at the current time.
@@ -2275,6 +2650,19 @@ type MigrateOptions struct {
 	// +optional
 	// +listType=atomic
 	DryRun []string `json:"dryRun,omitempty" protobuf:"bytes,1,rep,name=dryRun"`
+
+	// AddedNodeSelector is an additional selector that can be used to complement a NodeSelector or NodeAffinity as set on the VM
+	// to restrict the set of allowed target nodes for a migration.
+	// In case of key collisions, values set on the VM objects are going to be preserved to ensure that addedNodeSelector
+	// can only restrict but not bypass constraints already set on the VM object.
+	// +optional
+	AddedNodeSelector map[string]string `json:"addedNodeSelector,omitempty"`
+
+	// AddedNodeAffinity is an additional node affinity for the target of a migration.
+	// Its required node selector terms are combined with the ones of the VM, so that they can only restrict the set
+	// of allowed target nodes. Its preferred scheduling terms are added to the ones of the VM.
+	// +optional
+	AddedNodeAffinity *k8sv1.NodeAffinity `json:"addedNodeAffinity,omitempty"`
 }
 
 // VirtualMachineInstanceGuestAgentInfo represents information from the installed guest agent
@@ -2354,6 +2742,82 @@ type VirtualMachineInstanceFileSystem struct {
 	Disk           []VirtualMachineInstanceFileSystemDisk `json:"disk,omitempty"`
 }
 
+// VirtualMachineInstanceGuestExecOptions is provided when executing a command
+// on the guest through the guest agent
+type VirtualMachineInstanceGuestExecOptions struct {
+	// Command is the path of the executable to run on the guest
+	Command string `json:"command"`
+	// Args are the arguments passed to the command
+	// +optional
+	// +listType=atomic
+	Args []string `json:"args,omitempty"`
+	// TimeoutSeconds is the time to wait for the command to exit.
+	// Defaults to 30, and can be at most 300.
+	// +optional
+	TimeoutSeconds *int32 `json:"timeoutSeconds,omitempty"`
+}
+
+// VirtualMachineInstanceGuestExecResult is the outcome of a command executed
+// on the guest through the guest agent. The output is not streamed: the guest
+// agent only hands it out once the command exited, so it is returned at once
+// together with the exit code.
+//
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+type VirtualMachineInstanceGuestExecResult struct {
+	metav1.TypeMeta `json:",inline"`
+	// ExitCode is the exit code of the command
+	ExitCode int32 `json:"exitCode"`
+	// Stdout is what the command wrote to its standard output
+	// +optional
+	Stdout string `json:"stdout,omitempty"`
+	// Stderr is what the command wrote to its standard error
+	// +optional
+	Stderr string `json:"stderr,omitempty"`
+}
+
+// VirtualMachineInstanceMigrationCheckResult is the outcome of checking whether
+// a VirtualMachineInstance can be live migrated
+//
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+type VirtualMachineInstanceMigrationCheckResult struct {
+	metav1.TypeMeta `json:",inline"`
+	// Migratable is true when nothing blocks the migration
+	Migratable bool `json:"migratable"`
+	// Blockers are the reasons preventing the migration
+	// +optional
+	// +listType=atomic
+	Blockers []MigrationBlocker `json:"blockers,omitempty"`
+	// TargetNodes are the nodes the target pod of the migration could be scheduled to
+	// +optional
+	// +listType=atomic
+	TargetNodes []string `json:"targetNodes,omitempty"`
+}
+
+// MigrationBlocker describes why a VirtualMachineInstance can not be live migrated
+type MigrationBlocker struct {
+	// Type is the kind of check which found the blocker
+	Type MigrationBlockerType `json:"type"`
+	// Reason is a brief CamelCase string that describes the blocker
+	// +optional
+	Reason string `json:"reason,omitempty"`
+	// Message is a human readable description of the blocker
+	Message string `json:"message"`
+}
+
+type MigrationBlockerType string
+
+const (
+	// MigrationBlockerLiveMigratable is reported when the VMI is not live migratable, e.g. because of
+	// host devices, SR-IOV interfaces or volumes which can not be migrated
+	MigrationBlockerLiveMigratable MigrationBlockerType = "LiveMigratable"
+	// MigrationBlockerMigrationInProgress is reported when another migration of the VMI is in flight
+	MigrationBlockerMigrationInProgress MigrationBlockerType = "MigrationInProgress"
+	// MigrationBlockerScheduling is reported when no node matches the scheduling constraints of the target pod
+	MigrationBlockerScheduling MigrationBlockerType = "Scheduling"
+	// MigrationBlockerCPUModel is reported when no schedulable node supports the CPU model and features of the VMI
+	MigrationBlockerCPUModel MigrationBlockerType = "CPUModel"
+)
+
 // FreezeUnfreezeTimeout represent the time unfreeze will be triggered if guest was not unfrozen by unfreeze command
 type FreezeUnfreezeTimeout struct {
 	UnfreezeTimeout *metav1.Duration `json:"unfreezeTimeout"`
@@ -2380,10 +2844,25 @@ type VirtualMachineMemoryDumpRequest struct {
 	// Message is a detailed message about failure of the memory dump
 	// +optional
 	Message string `json:"message,omitempty"`
+	// Format is the format of the memory dump, defaults to raw
+	// +optional
+	Format MemoryDumpFormat `json:"format,omitempty"`
 }
 
 type MemoryDumpPhase string
 
+// MemoryDumpFormat describes what a memory dump contains
+type MemoryDumpFormat string
+
+const (
+	// MemoryDumpFormatRaw is a raw dump of the guest memory, meant for analysis
+	MemoryDumpFormatRaw MemoryDumpFormat = "raw"
+	// MemoryDumpFormatSaveState is a libvirt save image of the guest memory and
+	// device state, which the VM can be resumed from. It is reserved for VM
+	// snapshots which include the memory state.
+	MemoryDumpFormatSaveState MemoryDumpFormat = "savestate"
+)
+
 const (
 	// The memorydump is during pvc Associating
 	MemoryDumpAssociating MemoryDumpPhase = "Associating"
@@ -2399,6 +2878,64 @@ const (
 	MemoryDumpFailed MemoryDumpPhase = "Failed"
 )
 
+// VirtualMachineBackupRequest represent the backup request phase and info
+type VirtualMachineBackupRequest struct {
+	// ClaimName is the name of the pvc that will contain the backup
+	ClaimName string `json:"claimName"`
+	// BackupName is the name of the backup. A checkpoint with the same name is
+	// created, and can be used as the base of a following incremental backup
+	// +optional
+	BackupName string `json:"backupName,omitempty"`
+	// IncrementalFrom is the name of the checkpoint of a previous backup, only the
+	// blocks changed since that checkpoint are backed up. A full backup is taken if empty.
+	// +optional
+	IncrementalFrom string `json:"incrementalFrom,omitempty"`
+	// Format is the format of the disk backup images, defaults to qcow2.
+	// Incremental backups are always qcow2.
+	// +optional
+	Format BackupFormat `json:"format,omitempty"`
+	// Phase represents the backup phase
+	Phase BackupPhase `json:"phase"`
+	// Remove represents request of dissociating the backup pvc
+	// +optional
+	Remove bool `json:"remove,omitempty"`
+	// StartTimestamp represents the time the backup started
+	// +optional
+	StartTimestamp *metav1.Time `json:"startTimestamp,omitempty"`
+	// EndTimestamp represents the time the backup was completed
+	// +optional
+	EndTimestamp *metav1.Time `json:"endTimestamp,omitempty"`
+	// Message is a detailed message about failure of the backup
+	// +optional
+	Message string `json:"message,omitempty"`
+}
+
+type BackupFormat string
+
+const (
+	// BackupFormatRaw writes the disk backups as raw images
+	BackupFormatRaw BackupFormat = "raw"
+	// BackupFormatQCOW2 writes the disk backups as qcow2 images
+	BackupFormatQCOW2 BackupFormat = "qcow2"
+)
+
+type BackupPhase string
+
+const (
+	// The backup is during pvc Associating
+	BackupAssociating BackupPhase = "Associating"
+	// The backup is in progress
+	BackupInProgress BackupPhase = "InProgress"
+	// The backup pvc is being unmounted
+	BackupUnmounting BackupPhase = "Unmounting"
+	// The backup is completed
+	BackupCompleted BackupPhase = "Completed"
+	// The backup pvc is being unbound
+	BackupDissociating BackupPhase = "Dissociating"
+	// The backup failed
+	BackupFailed BackupPhase = "Failed"
+)
+
 // AddVolumeOptions is provided when dynamically hot plugging a volume and disk
 type AddVolumeOptions struct {
 	// Name represents the name that will be used to map the
@@ -2550,6 +3087,12 @@ type KubeVirtConfiguration struct {
 	// Instancetype configuration
 	// +nullable
 	Instancetype *InstancetypeConfiguration `json:"instancetype,omitempty"`
+
+	// VMRestoreImportAllowedHosts lists the hosts VirtualMachineRestores may import VirtualMachineExports
+	// of other clusters from. An entry is a host name, or "*." followed by a domain to allow all of its
+	// subdomains. Imports are refused while the list is empty.
+	// +listType=set
+	VMRestoreImportAllowedHosts []string `json:"vmRestoreImportAllowedHosts,omitempty"`
 }
 
 type InstancetypeConfiguration struct {
@@ -2741,13 +3284,118 @@ type MigrationConfiguration struct {
 	// Network is the name of the CNI network to use for live migrations. By default, migrations go
 	// through the pod network.
 	Network *string `json:"network,omitempty"`
+	// AdditionalNetworks are the names of further CNI networks virt-handler is connected to.
+	// Migration policies can select any of them, or Network, as the network of their migrations
+	// +listType=set
+	AdditionalNetworks []string `json:"additionalNetworks,omitempty"`
+	// BandwidthPerNode limits the amount of network bandwidth all outgoing live migrations of a node are
+	// allowed to use together. It is enforced by the migration proxy of virt-handler, which also enforces
+	// BandwidthPerMigration. The value is in quantity per second. Defaults to 0 (no limit)
+	BandwidthPerNode *resource.Quantity `json:"bandwidthPerNode,omitempty"`
 	// By default, the SELinux level of target virt-launcher pods is forced to the level of the source virt-launcher.
 	// When set to true, MatchSELinuxLevelOnMigration lets the CRI auto-assign a random level to the target.
 	// That will ensure the target virt-launcher doesn't share categories with another pod on the node.
 	// However, migrations will fail when using RWX volumes that don't automatically deal with SELinux levels.
 	MatchSELinuxLevelOnMigration *bool `json:"matchSELinuxLevelOnMigration,omitempty"`
+	// ParallelMigrationThreads is the number of parallel (multifd) connections a live migration
+	// transfers the guest memory over. 0 disables parallel connections. By default, 8 connections
+	// are used unless the VMI has CPU limits. Parallel connections are never used with post-copy
+	ParallelMigrationThreads *uint32 `json:"parallelMigrationThreads,omitempty"`
+	// Compression is the algorithm compressing the guest memory sent over the parallel connections
+	// of a live migration. It has no effect on migrations without parallel connections. Defaults to none
+	// +kubebuilder:validation:Enum=zlib;zstd
+	Compression *MigrationCompression `json:"compression,omitempty"`
+	// XBZRLECacheSize enables the XBZRLE compression of memory pages which are dirtied again during a
+	// live migration and sets the size of its page cache. It has no effect on migrations with parallel
+	// connections. Defaults to 0 (disabled)
+	XBZRLECacheSize *resource.Quantity `json:"xbzrleCacheSize,omitempty"`
+	// MaxDowntimeMilliseconds is the longest time a VMI may be paused when a live migration switches
+	// over to the target. Defaults to the hypervisor default of 300
+	MaxDowntimeMilliseconds *uint64 `json:"maxDowntimeMilliseconds,omitempty"`
+	// MaxRetries is the number of times a failed migration is retried. Migrations failing because they
+	// were aborted or because of the guest are not retried. Defaults to 0 (no retries)
+	MaxRetries *int32 `json:"maxRetries,omitempty"`
+	// RetryBackoffSeconds is the number of seconds to wait before the first retry of a failed migration.
+	// The delay is doubled for every further retry. Defaults to 10
+	RetryBackoffSeconds *int64 `json:"retryBackoffSeconds,omitempty"`
+	// NonConvergenceEscalation is the mode a migration which did not converge is retried in.
+	// Defaults to none, which retries the migration with the same settings
+	// +kubebuilder:validation:Enum=PostCopy;AutoConverge
+	NonConvergenceEscalation *MigrationRetryEscalation `json:"nonConvergenceEscalation,omitempty"`
+	// BlockMigrateLocalVolumes allows to live migrate VMIs with volumes which are local to their node.
+	// ReadWriteOnce PVCs are copied to new PVCs of the same storage class, which are provisioned for
+	// the target node, and non-shared hostDisks of type DiskOrCreate are copied to a new disk image
+	// on the target node. The storage class should use the WaitForFirstConsumer binding mode.
+	// Once the migration succeeded, VirtualMachines are updated to use the new PVCs, the
+	// DataVolumeTemplates of the copied volumes are dropped, and the source PVCs, or the DataVolumes
+	// they belong to, are deleted. Defaults to false
+	BlockMigrateLocalVolumes *bool `json:"blockMigrateLocalVolumes,omitempty"`
+	// PostMigrationVerification checks that the guest is healthy on the target once a live migration
+	// succeeded, and tells what to do if it is not. Defaults to none (no verification)
+	PostMigrationVerification *PostMigrationVerification `json:"postMigrationVerification,omitempty"`
+}
+
+// PostMigrationVerification configures the checks the guest has to pass on the target of a migration
+type PostMigrationVerification struct {
+	// Checks are the checks which all have to pass within the timeout
+	// +listType=set
+	Checks []PostMigrationCheck `json:"checks"`
+	// TimeoutSeconds is the number of seconds after the end of the migration within which the checks
+	// have to pass. Defaults to 60
+	// +optional
+	TimeoutSeconds *int64 `json:"timeoutSeconds,omitempty"`
+	// NetworkCheckPort is the TCP port of the guest the Network check connects to. The guest is reachable
+	// if it accepts or refuses the connection. Defaults to 22
+	// +optional
+	NetworkCheckPort *int32 `json:"networkCheckPort,omitempty"`
+	// FailureAction is what to do when the checks did not pass within the timeout. Defaults to Report
+	// +kubebuilder:validation:Enum=Report;MigrateBack
+	// +optional
+	FailureAction *PostMigrationFailureAction `json:"failureAction,omitempty"`
 }
 
+// PostMigrationCheck is a check of the guest on the target of a migration
+// +kubebuilder:validation:Enum=GuestAgent;ReadinessProbe;Network
+type PostMigrationCheck string
+
+const (
+	// PostMigrationCheckGuestAgent passes when the guest agent is connected on the target
+	PostMigrationCheckGuestAgent PostMigrationCheck = "GuestAgent"
+	// PostMigrationCheckReadinessProbe passes when the VMI is ready on the target
+	PostMigrationCheckReadinessProbe PostMigrationCheck = "ReadinessProbe"
+	// PostMigrationCheckNetwork passes when virt-handler on the target node can reach the guest over the pod network
+	PostMigrationCheckNetwork PostMigrationCheck = "Network"
+)
+
+// PostMigrationFailureAction is what to do when the guest failed the checks after a migration
+type PostMigrationFailureAction string
+
+const (
+	// PostMigrationFailureActionReport sets the PostMigrationVerificationFailed condition on the VMI and emits an event
+	PostMigrationFailureActionReport PostMigrationFailureAction = "Report"
+	// PostMigrationFailureActionMigrateBack reports the failure and migrates the VMI back to its source node.
+	// Evacuations, workload updates and migrations off a node that is gone or unschedulable are not migrated back.
+	PostMigrationFailureActionMigrateBack PostMigrationFailureAction = "MigrateBack"
+)
+
+// MigrationRetryEscalation is a more aggressive mode to retry a migration which did not converge in
+type MigrationRetryEscalation string
+
+const (
+	// MigrationRetryEscalationPostCopy switches the retried migration to post-copy once it times out
+	MigrationRetryEscalationPostCopy MigrationRetryEscalation = "PostCopy"
+	// MigrationRetryEscalationAutoConverge throttles the guest CPUs during the retried migration
+	MigrationRetryEscalationAutoConverge MigrationRetryEscalation = "AutoConverge"
+)
+
+// MigrationCompression is an algorithm compressing the guest memory of a live migration
+type MigrationCompression string
+
+const (
+	MigrationCompressionZlib MigrationCompression = "zlib"
+	MigrationCompressionZstd MigrationCompression = "zstd"
+)
+
 // DiskVerification holds container disks verification limits
 type DiskVerification struct {
 	MemoryLimit *resource.Quantity `json:"memoryLimit"`
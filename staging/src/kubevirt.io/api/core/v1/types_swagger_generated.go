@@ -83,6 +83,16 @@ func (VirtualMachineInstanceStatus) SwaggerDoc() map[string]string {
 		"currentCPUTopology":            "CurrentCPUTopology specifies the current CPU topology used by the VM workload.\nCurrent topology may differ from the desired topology in the spec while CPU hotplug\ntakes place.",
 		"memory":                        "Memory shows various informations about the VirtualMachine memory.\n+optional",
 		"migratedVolumes":               "MigratedVolumes lists the source and destination volumes during the volume migration\n+listType=atomic\n+optional",
+		"guestLoad":                     "GuestLoad shows the load of the guest, as last sampled by virt-handler.\nIt is only reported for VMIs of VirtualMachinePools, which autoscale on it.\n+optional",
+	}
+}
+
+func (GuestLoadStatus) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":                            "GuestLoadStatus holds the load of the guest of a VMI",
+		"cpuUtilizationPercentage":    "CPUUtilizationPercentage is the time the vCPUs of the guest were busy\nsince the previous sample, in percent of the time they were available.\n+optional",
+		"memoryUtilizationPercentage": "MemoryUtilizationPercentage is the memory of the guest which is in use\nand can't be reclaimed, in percent of the memory available to the guest.\nIt requires the memory balloon device to be reported.\n+optional",
+		"lastProbeTime":               "LastProbeTime is the time the guest load was sampled.\n+nullable",
 	}
 }
 
@@ -120,6 +130,7 @@ func (VolumeStatus) SwaggerDoc() map[string]string {
 		"hotplugVolume":             "If the volume is hotplug, this will contain the hotplug status.",
 		"size":                      "Represents the size of the volume",
 		"memoryDumpVolume":          "If the volume is memorydump volume, this will contain the memorydump info.",
+		"backupVolume":              "If the volume is a backup volume, this will contain the backup info.",
 		"containerDiskVolume":       "ContainerDiskVolume shows info about the containerdisk, if the volume is a containerdisk",
 	}
 }
@@ -156,6 +167,16 @@ func (DomainMemoryDumpInfo) SwaggerDoc() map[string]string {
 	}
 }
 
+func (DomainBackupInfo) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":               "DomainBackupInfo represents the backup information",
+		"startTimestamp": "StartTimestamp is the time when the backup started",
+		"endTimestamp":   "EndTimestamp is the time when the backup completed",
+		"claimName":      "ClaimName is the name of the pvc the backup was written to",
+		"backupName":     "BackupName is the name of the backup and of the checkpoint created with it",
+	}
+}
+
 func (HotplugVolumeStatus) SwaggerDoc() map[string]string {
 	return map[string]string{
 		"":              "HotplugVolumeStatus represents the hotplug status of the volume",
@@ -196,6 +217,8 @@ func (VirtualMachineInstanceNetworkInterface) SwaggerDoc() map[string]string {
 		"infoSource":       "Specifies the origin of the interface data collected. values: domain, guest-agent, multus-status.",
 		"queueCount":       "Specifies how many queues are allocated by MultiQueue",
 		"linkState":        "LinkState Reports the current operational link state`. values: up, down.",
+		"firewallRules":    "FirewallRules are the nftables rules enforcing the firewall of the interface in the virt-launcher pod\n+listType=atomic\n+optional",
+		"hotUnplugPhase":   "HotUnplugPhase reports the progress of the interface hot-unplug, once it was requested.\nvalues: Requested, DetachedInGuest, PodNetworkRemoved.\n+optional",
 	}
 }
 
@@ -230,6 +253,7 @@ func (VirtualMachineInstanceMigrationState) SwaggerDoc() map[string]string {
 		"abortRequested":                 "Indicates that the migration has been requested to abort",
 		"abortStatus":                    "Indicates the final status of the live migration abortion",
 		"failureReason":                  "Contains the reason why the migration failed",
+		"failureClass":                   "The kind of problem the migration failed on, as seen by the source\n+optional",
 		"migrationUid":                   "The VirtualMachineInstanceMigration object associated with this migration",
 		"mode":                           "Lets us know if the vmi is currently running pre or post copy migration",
 		"migrationPolicyName":            "Name of the migration policy. If string is empty, no policy is matched",
@@ -238,6 +262,15 @@ func (VirtualMachineInstanceMigrationState) SwaggerDoc() map[string]string {
 		"targetNodeTopology":             "If the VMI requires dedicated CPUs, this field will\nhold the numa topology on the target node",
 		"sourcePersistentStatePVCName":   "If the VMI being migrated uses persistent features (backend-storage), its source PVC name is saved here",
 		"targetPersistentStatePVCName":   "If the VMI being migrated uses persistent features (backend-storage), its target PVC name is saved here",
+		"crossCluster":                   "If the VMI is migrated to or from another cluster, the details of the cross cluster migration are saved here\n+optional",
+	}
+}
+
+func (VirtualMachineInstanceCrossClusterMigrationState) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":            "VirtualMachineInstanceCrossClusterMigrationState holds the details of a migration between two clusters",
+		"migrationID": "The ID shared by the sending and the receiving migration",
+		"connectURL":  "The address of the migration endpoint of the receiving cluster, only set on the sending side\n+optional",
 	}
 }
 
@@ -317,7 +350,28 @@ func (VirtualMachineInstanceMigrationList) SwaggerDoc() map[string]string {
 
 func (VirtualMachineInstanceMigrationSpec) SwaggerDoc() map[string]string {
 	return map[string]string{
-		"vmiName": "The name of the VMI to perform the migration on. VMI must exist in the migration objects namespace",
+		"vmiName":           "The name of the VMI to perform the migration on. VMI must exist in the migration objects namespace",
+		"addedNodeSelector": "AddedNodeSelector is an additional selector that can be used to complement a NodeSelector or NodeAffinity as set on the VM\nto restrict the set of allowed target nodes for a migration.\nIn case of key collisions, values set on the VM objects are going to be preserved to ensure that addedNodeSelector\ncan only restrict but not bypass constraints already set on the VM object.\n+optional",
+		"addedNodeAffinity": "AddedNodeAffinity is an additional node affinity for the target of a migration.\nIts required node selector terms are combined with the ones of the VM, so that they can only restrict the set\nof allowed target nodes. Its preferred scheduling terms are added to the ones of the VM.\n+optional",
+		"sendTo":            "SendTo migrates the VMI to another cluster, where a migration with a matching receive section waits for it.\nOnce the migration succeeded, the VMI is stopped in this cluster.\n+optional",
+		"receive":           "Receive creates the VM named by vmiName in this cluster, with a VMI which waits to receive a\nmigration from another cluster instead of being started.\n+optional",
+		"priority":          "Priority of the migration. Pending migrations with a higher priority are started first.\nMigrations with the same priority are started in turns for evacuations, workload updates and\nuser requests, so that none of them can starve the others. Defaults to 0.\nA priority above 0 requires the permission to prioritize virtualmachineinstancemigrations\ncluster wide, since the queue is shared by all namespaces.\n+optional",
+	}
+}
+
+func (VirtualMachineInstanceMigrationSendTo) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":            "VirtualMachineInstanceMigrationSendTo describes where to migrate a VMI to in another cluster",
+		"migrationID": "MigrationID identifies the migration, it has to match the ID of the receiving migration",
+		"connectURL":  "ConnectURL is the host:port of the migration endpoint of the receiving cluster,\nas reported in the status of the receiving migration and exposed to this cluster",
+	}
+}
+
+func (VirtualMachineInstanceMigrationReceive) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":               "VirtualMachineInstanceMigrationReceive describes a migration received from another cluster",
+		"migrationID":    "MigrationID identifies the migration, it has to match the ID of the sending migration",
+		"virtualMachine": "VirtualMachine is the spec of the VirtualMachine created to own the received VMI, usually the one\nof the VirtualMachine in the sending cluster. Its disks have to exist in this cluster already,\nDataVolumeTemplates are not supported.",
 	}
 }
 
@@ -334,6 +388,42 @@ func (VirtualMachineInstanceMigrationStatus) SwaggerDoc() map[string]string {
 		"":                          "VirtualMachineInstanceMigration reprents information pertaining to a VMI's migration.",
 		"phaseTransitionTimestamps": "PhaseTransitionTimestamp is the timestamp of when the last phase change occurred\n+listType=atomic\n+optional",
 		"migrationState":            "Represents the status of a live migration",
+		"connectURL":                "ConnectURL is the address of the LoadBalancer Service exposing the migration endpoint of a receiving\nmigration, to be set as sendTo.connectURL of the sending migration.\n+optional",
+		"queuePosition":             "QueuePosition is the position of a pending migration in the cluster wide queue of migrations\nwaiting for a free migration slot, starting with 1. It is only set while the migration is pending.\n+optional",
+		"failure":                   "Failure classifies why the migration failed\n+optional",
+		"attemptHistory":            "AttemptHistory records the finished attempts to migrate the VMI, starting with the first\nmigration and ending with this one when migrations are retried.\n+listType=atomic\n+optional",
+		"postMigrationVerification": "PostMigrationVerification reports the checks of the guest on the target after the migration succeeded\n+optional",
+	}
+}
+
+func (PostMigrationVerificationStatus) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":               "PostMigrationVerificationStatus reports the checks of the guest on the target after a migration",
+		"failedChecks":   "FailedChecks are the checks which did not pass (yet)\n+listType=atomic\n+optional",
+		"message":        "+optional",
+		"startTimestamp": "StartTimestamp is the time the verification started\n+optional",
+		"endTimestamp":   "EndTimestamp is the time the verification succeeded or failed\n+optional",
+	}
+}
+
+func (VirtualMachineInstanceMigrationFailure) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":        "VirtualMachineInstanceMigrationFailure describes why a migration failed",
+		"message": "Message is the failure reason as reported by the source or the controller\n+optional",
+	}
+}
+
+func (VirtualMachineInstanceMigrationAttempt) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":               "VirtualMachineInstanceMigrationAttempt records a finished attempt to migrate a VMI",
+		"migrationName":  "MigrationName is the name of the migration which made the attempt",
+		"startTimestamp": "+optional",
+		"endTimestamp":   "+optional",
+		"sourceNode":     "+optional",
+		"targetNode":     "+optional",
+		"phase":          "Phase is the final phase of the attempt",
+		"escalation":     "Escalation is the more aggressive mode the attempt was made in, if any\n+optional",
+		"failure":        "+optional",
 	}
 }
 
@@ -404,6 +494,7 @@ func (VirtualMachineStatus) SwaggerDoc() map[string]string {
 		"volumeSnapshotStatuses": "VolumeSnapshotStatuses indicates a list of statuses whether snapshotting is\nsupported by each volume.",
 		"startFailure":           "StartFailure tracks consecutive VMI startup failures for the purposes of\ncrash loop backoffs\n+nullable\n+optional",
 		"memoryDumpRequest":      "MemoryDumpRequest tracks memory dump request phase and info of getting a memory\ndump to the given pvc\n+nullable\n+optional",
+		"backupRequest":          "BackupRequest tracks backup request phase and info of backing up\nthe vm disks to the given pvc\n+nullable\n+optional",
 		"observedGeneration":     "ObservedGeneration is the generation observed by the vmi when started.\n+optional",
 		"desiredGeneration":      "DesiredGeneration is the generation which is desired for the VMI.\nThis will be used in comparisons with ObservedGeneration to understand when\nthe VMI is out of sync. This will be changed at the same time as\nObservedGeneration to remove errors which could occur if Generation is\nupdated through an Update() before ObservedGeneration in Status.\n+optional",
 		"runStrategy":            "RunStrategy tracks the last recorded RunStrategy used by the VM.\nThis is needed to correctly process the next strategy (for now only the RerunOnFailure)",
@@ -515,6 +606,33 @@ func (KubeVirtWorkloadUpdateStrategy) SwaggerDoc() map[string]string {
 		"workloadUpdateMethods": "WorkloadUpdateMethods defines the methods that can be used to disrupt workloads\nduring automated workload updates.\nWhen multiple methods are present, the least disruptive method takes\nprecedence over more disruptive methods. For example if both LiveMigrate and Shutdown\nmethods are listed, only VMs which are not live migratable will be restarted/shutdown\n\nAn empty list defaults to no automated workload updating\n\n+listType=atomic\n+optional",
 		"batchEvictionSize":     "BatchEvictionSize Represents the number of VMIs that can be forced updated per\nthe BatchShutdownInteral interval\n\nDefaults to 10\n\n+optional",
 		"batchEvictionInterval": "BatchEvictionInterval Represents the interval to wait before issuing the next\nbatch of shutdowns\n\nDefaults to 1 minute\n\n+optional",
+		"maintenanceWindows":    "MaintenanceWindows restrict the automated workload updates of the VMIs they select\nto the times the windows are open. VMIs which are not selected by any window are\nupdated at any time, VMIs selected by several windows while any of them is open\n\n+listType=atomic\n+optional",
+	}
+}
+
+func (WorkloadUpdateMaintenanceWindow) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":           "WorkloadUpdateMaintenanceWindow defines when the automated workload updates of a group of VMIs may happen.\nThe window is open during its time ranges and, if it has a schedule, for the duration following every\ntime of the schedule",
+		"name":       "Name identifies the maintenance window",
+		"namespaces": "Namespaces are the namespaces of the VMIs the window applies to.\nDefaults to all namespaces\n\n+listType=set\n+optional",
+		"selector":   "Selector selects the VMIs the window applies to by their labels.\nDefaults to all VMIs\n\n+optional",
+		"schedule":   "Schedule is a cron expression of the times the window opens at, in UTC\n\n+optional",
+		"duration":   "Duration is how long the window stays open every time it opens according to its schedule\n\nDefaults to 1 hour\n\n+optional",
+		"timeRanges": "TimeRanges are fixed periods of time the window is open during\n\n+listType=atomic\n+optional",
+	}
+}
+
+func (WorkloadUpdateTimeRange) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"": "WorkloadUpdateTimeRange is a period of time a maintenance window is open during",
+	}
+}
+
+func (HeldWorkloadUpdate) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":              "HeldWorkloadUpdate reports when the automated workload update of an outdated VMI happens",
+		"reason":        "Reason tells why the update is held back",
+		"scheduledTime": "ScheduledTime is the time from which on the VMI is updated. It is unset if the VMI is not\nupdated automatically, or if none of its maintenance windows opens again\n+optional",
 	}
 }
 
@@ -572,8 +690,9 @@ func (GenerationStatus) SwaggerDoc() map[string]string {
 
 func (KubeVirtStatus) SwaggerDoc() map[string]string {
 	return map[string]string{
-		"":            "KubeVirtStatus represents information pertaining to a KubeVirt deployment.",
-		"generations": "+listType=atomic",
+		"":                    "KubeVirtStatus represents information pertaining to a KubeVirt deployment.",
+		"generations":         "+listType=atomic",
+		"heldWorkloadUpdates": "HeldWorkloadUpdates lists the outdated VMIs whose automated workload update is held back\nby a maintenance window or their annotations, and when they are updated. Outdated VMIs which\nare not listed are updated as soon as the batch settings allow. At most 100 VMIs are listed\n+listType=atomic",
 	}
 }
 
@@ -625,8 +744,10 @@ func (StopOptions) SwaggerDoc() map[string]string {
 
 func (MigrateOptions) SwaggerDoc() map[string]string {
 	return map[string]string{
-		"":       "MigrateOptions may be provided on migrate request.",
-		"dryRun": "When present, indicates that modifications should not be\npersisted. An invalid or unrecognized dryRun directive will\nresult in an error response and no further processing of the\nrequest. Valid values are:\n- All: all dry run stages will be processed\n+optional\n+listType=atomic",
+		"":                  "MigrateOptions may be provided on migrate request.",
+		"dryRun":            "When present, indicates that modifications should not be\npersisted. An invalid or unrecognized dryRun directive will\nresult in an error response and no further processing of the\nrequest. Valid values are:\n- All: all dry run stages will be processed\n+optional\n+listType=atomic",
+		"addedNodeSelector": "AddedNodeSelector is an additional selector that can be used to complement a NodeSelector or NodeAffinity as set on the VM\nto restrict the set of allowed target nodes for a migration.\nIn case of key collisions, values set on the VM objects are going to be preserved to ensure that addedNodeSelector\ncan only restrict but not bypass constraints already set on the VM object.\n+optional",
+		"addedNodeAffinity": "AddedNodeAffinity is an additional node affinity for the target of a migration.\nIts required node selector terms are combined with the ones of the VM, so that they can only restrict the set\nof allowed target nodes. Its preferred scheduling terms are added to the ones of the VM.\n+optional",
 	}
 }
 
@@ -686,6 +807,42 @@ func (VirtualMachineInstanceFileSystem) SwaggerDoc() map[string]string {
 	}
 }
 
+func (VirtualMachineInstanceGuestExecOptions) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":               "VirtualMachineInstanceGuestExecOptions is provided when executing a command\non the guest through the guest agent",
+		"command":        "Command is the path of the executable to run on the guest",
+		"args":           "Args are the arguments passed to the command\n+optional\n+listType=atomic",
+		"timeoutSeconds": "TimeoutSeconds is the time to wait for the command to exit.\nDefaults to 30, and can be at most 300.\n+optional",
+	}
+}
+
+func (VirtualMachineInstanceGuestExecResult) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":         "VirtualMachineInstanceGuestExecResult is the outcome of a command executed\non the guest through the guest agent. The output is not streamed: the guest\nagent only hands it out once the command exited, so it is returned at once\ntogether with the exit code.\n\n+k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object",
+		"exitCode": "ExitCode is the exit code of the command",
+		"stdout":   "Stdout is what the command wrote to its standard output\n+optional",
+		"stderr":   "Stderr is what the command wrote to its standard error\n+optional",
+	}
+}
+
+func (VirtualMachineInstanceMigrationCheckResult) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":            "VirtualMachineInstanceMigrationCheckResult is the outcome of checking whether\na VirtualMachineInstance can be live migrated\n\n+k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object",
+		"migratable":  "Migratable is true when nothing blocks the migration",
+		"blockers":    "Blockers are the reasons preventing the migration\n+optional\n+listType=atomic",
+		"targetNodes": "TargetNodes are the nodes the target pod of the migration could be scheduled to\n+optional\n+listType=atomic",
+	}
+}
+
+func (MigrationBlocker) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":        "MigrationBlocker describes why a VirtualMachineInstance can not be live migrated",
+		"type":    "Type is the kind of check which found the blocker",
+		"reason":  "Reason is a brief CamelCase string that describes the blocker\n+optional",
+		"message": "Message is a human readable description of the blocker",
+	}
+}
+
 func (FreezeUnfreezeTimeout) SwaggerDoc() map[string]string {
 	return map[string]string{
 		"": "FreezeUnfreezeTimeout represent the time unfreeze will be triggered if guest was not unfrozen by unfreeze command",
@@ -702,6 +859,22 @@ func (VirtualMachineMemoryDumpRequest) SwaggerDoc() map[string]string {
 		"endTimestamp":   "EndTimestamp represents the time the memory dump was completed\n+optional",
 		"fileName":       "FileName represents the name of the output file\n+optional",
 		"message":        "Message is a detailed message about failure of the memory dump\n+optional",
+		"format":         "Format is the format of the memory dump, defaults to raw\n+optional",
+	}
+}
+
+func (VirtualMachineBackupRequest) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":                "VirtualMachineBackupRequest represent the backup request phase and info",
+		"claimName":       "ClaimName is the name of the pvc that will contain the backup",
+		"backupName":      "BackupName is the name of the backup. A checkpoint with the same name is\ncreated, and can be used as the base of a following incremental backup\n+optional",
+		"incrementalFrom": "IncrementalFrom is the name of the checkpoint of a previous backup, only the\nblocks changed since that checkpoint are backed up. A full backup is taken if empty.\n+optional",
+		"format":          "Format is the format of the disk backup images, defaults to qcow2.\nIncremental backups are always qcow2.\n+optional",
+		"phase":           "Phase represents the backup phase",
+		"remove":          "Remove represents request of dissociating the backup pvc\n+optional",
+		"startTimestamp":  "StartTimestamp represents the time the backup started\n+optional",
+		"endTimestamp":    "EndTimestamp represents the time the backup was completed\n+optional",
+		"message":         "Message is a detailed message about failure of the backup\n+optional",
 	}
 }
 
@@ -773,6 +946,7 @@ func (KubeVirtConfiguration) SwaggerDoc() map[string]string {
 		"vmRolloutStrategy":                  "VMRolloutStrategy defines how live-updatable fields, like CPU sockets, memory,\ntolerations, and affinity, are propagated from a VM to its VMI.\n+nullable\n+kubebuilder:validation:Enum=Stage;LiveUpdate",
 		"commonInstancetypesDeployment":      "CommonInstancetypesDeployment controls the deployment of common-instancetypes resources\n+nullable",
 		"instancetype":                       "Instancetype configuration\n+nullable",
+		"vmRestoreImportAllowedHosts":        "VMRestoreImportAllowedHosts lists the hosts VirtualMachineRestores may import VirtualMachineExports\nof other clusters from. An entry is a host name, or \"*.\" followed by a domain to allow all of its\nsubdomains. Imports are refused while the list is empty.\n+listType=set",
 	}
 }
 
@@ -864,7 +1038,28 @@ func (MigrationConfiguration) SwaggerDoc() map[string]string {
 		"allowWorkloadDisruption":           "AllowWorkloadDisruption indicates that the migration shouldn't be\ncanceled after acceptableCompletionTime is exceeded. Instead, if\npermitted, migration will be switched to post-copy or the VMI will be\npaused to allow the migration to complete",
 		"disableTLS":                        "When set to true, DisableTLS will disable the additional layer of live migration encryption\nprovided by KubeVirt. This is usually a bad idea. Defaults to false",
 		"network":                           "Network is the name of the CNI network to use for live migrations. By default, migrations go\nthrough the pod network.",
+		"additionalNetworks":                "AdditionalNetworks are the names of further CNI networks virt-handler is connected to.\nMigration policies can select any of them, or Network, as the network of their migrations\n+listType=set",
+		"bandwidthPerNode":                  "BandwidthPerNode limits the amount of network bandwidth all outgoing live migrations of a node are\nallowed to use together. It is enforced by the migration proxy of virt-handler, which also enforces\nBandwidthPerMigration. The value is in quantity per second. Defaults to 0 (no limit)",
 		"matchSELinuxLevelOnMigration":      "By default, the SELinux level of target virt-launcher pods is forced to the level of the source virt-launcher.\nWhen set to true, MatchSELinuxLevelOnMigration lets the CRI auto-assign a random level to the target.\nThat will ensure the target virt-launcher doesn't share categories with another pod on the node.\nHowever, migrations will fail when using RWX volumes that don't automatically deal with SELinux levels.",
+		"parallelMigrationThreads":          "ParallelMigrationThreads is the number of parallel (multifd) connections a live migration\ntransfers the guest memory over. 0 disables parallel connections. By default, 8 connections\nare used unless the VMI has CPU limits. Parallel connections are never used with post-copy",
+		"compression":                       "Compression is the algorithm compressing the guest memory sent over the parallel connections\nof a live migration. It has no effect on migrations without parallel connections. Defaults to none\n+kubebuilder:validation:Enum=zlib;zstd",
+		"xbzrleCacheSize":                   "XBZRLECacheSize enables the XBZRLE compression of memory pages which are dirtied again during a\nlive migration and sets the size of its page cache. It has no effect on migrations with parallel\nconnections. Defaults to 0 (disabled)",
+		"maxDowntimeMilliseconds":           "MaxDowntimeMilliseconds is the longest time a VMI may be paused when a live migration switches\nover to the target. Defaults to the hypervisor default of 300",
+		"maxRetries":                        "MaxRetries is the number of times a failed migration is retried. Migrations failing because they\nwere aborted or because of the guest are not retried. Defaults to 0 (no retries)",
+		"retryBackoffSeconds":               "RetryBackoffSeconds is the number of seconds to wait before the first retry of a failed migration.\nThe delay is doubled for every further retry. Defaults to 10",
+		"nonConvergenceEscalation":          "NonConvergenceEscalation is the mode a migration which did not converge is retried in.\nDefaults to none, which retries the migration with the same settings\n+kubebuilder:validation:Enum=PostCopy;AutoConverge",
+		"blockMigrateLocalVolumes":          "BlockMigrateLocalVolumes allows to live migrate VMIs with volumes which are local to their node.\nReadWriteOnce PVCs are copied to new PVCs of the same storage class, which are provisioned for\nthe target node, and non-shared hostDisks of type DiskOrCreate are copied to a new disk image\non the target node. The storage class should use the WaitForFirstConsumer binding mode.\nOnce the migration succeeded, VirtualMachines are updated to use the new PVCs, the\nDataVolumeTemplates of the copied volumes are dropped, and the source PVCs, or the DataVolumes\nthey belong to, are deleted. Defaults to false",
+		"postMigrationVerification":         "PostMigrationVerification checks that the guest is healthy on the target once a live migration\nsucceeded, and tells what to do if it is not. Defaults to none (no verification)",
+	}
+}
+
+func (PostMigrationVerification) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":                 "PostMigrationVerification configures the checks the guest has to pass on the target of a migration",
+		"checks":           "Checks are the checks which all have to pass within the timeout\n+listType=set",
+		"timeoutSeconds":   "TimeoutSeconds is the number of seconds after the end of the migration within which the checks\nhave to pass. Defaults to 60\n+optional",
+		"networkCheckPort": "NetworkCheckPort is the TCP port of the guest the Network check connects to. The guest is reachable\nif it accepts or refuses the connection. Defaults to 22\n+optional",
+		"failureAction":    "FailureAction is what to do when the checks did not pass within the timeout. Defaults to Report\n+kubebuilder:validation:Enum=Report;MigrateBack\n+optional",
 	}
 }
 
@@ -180,6 +180,9 @@ const (
 	Dir ExportVolumeFormat = "dir"
 	// ArchiveGz is a tarred and gzipped version of the root of a PersistentVolumeClaim
 	ArchiveGz ExportVolumeFormat = "tar.gz"
+	// Backup points to the manifest of a VM backup stored on a PersistentVolumeClaim, the manifest lists the
+	// raw or qcow2 disk images of the backup, which are available below the same URL
+	Backup ExportVolumeFormat = "backup"
 )
 
 // VirtualMachineExportVolumeFormat contains the format type and URL to get the volume in that format
@@ -23,6 +23,7 @@ package v1alpha1
 
 import (
 	runtime "k8s.io/apimachinery/pkg/runtime"
+	v1 "kubevirt.io/api/core/v1"
 )
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
@@ -141,6 +142,51 @@ func (in *MigrationPolicySpec) DeepCopyInto(out *MigrationPolicySpec) {
 		*out = new(bool)
 		**out = **in
 	}
+	if in.ParallelMigrationThreads != nil {
+		in, out := &in.ParallelMigrationThreads, &out.ParallelMigrationThreads
+		*out = new(uint32)
+		**out = **in
+	}
+	if in.Compression != nil {
+		in, out := &in.Compression, &out.Compression
+		*out = new(v1.MigrationCompression)
+		**out = **in
+	}
+	if in.XBZRLECacheSize != nil {
+		in, out := &in.XBZRLECacheSize, &out.XBZRLECacheSize
+		x := (*in).DeepCopy()
+		*out = &x
+	}
+	if in.MaxDowntimeMilliseconds != nil {
+		in, out := &in.MaxDowntimeMilliseconds, &out.MaxDowntimeMilliseconds
+		*out = new(uint64)
+		**out = **in
+	}
+	if in.MaxRetries != nil {
+		in, out := &in.MaxRetries, &out.MaxRetries
+		*out = new(int32)
+		**out = **in
+	}
+	if in.RetryBackoffSeconds != nil {
+		in, out := &in.RetryBackoffSeconds, &out.RetryBackoffSeconds
+		*out = new(int64)
+		**out = **in
+	}
+	if in.NonConvergenceEscalation != nil {
+		in, out := &in.NonConvergenceEscalation, &out.NonConvergenceEscalation
+		*out = new(v1.MigrationRetryEscalation)
+		**out = **in
+	}
+	if in.Network != nil {
+		in, out := &in.Network, &out.Network
+		*out = new(string)
+		**out = **in
+	}
+	if in.PostMigrationVerification != nil {
+		in, out := &in.PostMigrationVerification, &out.PostMigrationVerification
+		*out = new(v1.PostMigrationVerification)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
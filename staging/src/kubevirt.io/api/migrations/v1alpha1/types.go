@@ -53,6 +53,26 @@ type MigrationPolicySpec struct {
 	AllowPostCopy *bool `json:"allowPostCopy,omitempty"`
 	//+optional
 	AllowWorkloadDisruption *bool `json:"allowWorkloadDisruption,omitempty"`
+	//+optional
+	ParallelMigrationThreads *uint32 `json:"parallelMigrationThreads,omitempty"`
+	//+optional
+	// +kubebuilder:validation:Enum=zlib;zstd
+	Compression *k6tv1.MigrationCompression `json:"compression,omitempty"`
+	//+optional
+	XBZRLECacheSize *resource.Quantity `json:"xbzrleCacheSize,omitempty"`
+	//+optional
+	MaxDowntimeMilliseconds *uint64 `json:"maxDowntimeMilliseconds,omitempty"`
+	//+optional
+	MaxRetries *int32 `json:"maxRetries,omitempty"`
+	//+optional
+	RetryBackoffSeconds *int64 `json:"retryBackoffSeconds,omitempty"`
+	//+optional
+	// +kubebuilder:validation:Enum=PostCopy;AutoConverge
+	NonConvergenceEscalation *k6tv1.MigrationRetryEscalation `json:"nonConvergenceEscalation,omitempty"`
+	//+optional
+	Network *string `json:"network,omitempty"`
+	//+optional
+	PostMigrationVerification *k6tv1.PostMigrationVerification `json:"postMigrationVerification,omitempty"`
 }
 
 type LabelSelector map[string]string
@@ -109,6 +129,50 @@ func (m *MigrationPolicy) GetMigrationConfByPolicy(clusterMigrationConfiguration
 		// value of AllowPostCopy, if not explicitly set
 		*clusterMigrationConfigurations.AllowWorkloadDisruption = *policySpec.AllowPostCopy
 	}
+	if policySpec.ParallelMigrationThreads != nil {
+		changed = true
+		parallelMigrationThreads := *policySpec.ParallelMigrationThreads
+		clusterMigrationConfigurations.ParallelMigrationThreads = &parallelMigrationThreads
+	}
+	if policySpec.Compression != nil {
+		changed = true
+		compression := *policySpec.Compression
+		clusterMigrationConfigurations.Compression = &compression
+	}
+	if policySpec.XBZRLECacheSize != nil {
+		changed = true
+		xbzrleCacheSize := policySpec.XBZRLECacheSize.DeepCopy()
+		clusterMigrationConfigurations.XBZRLECacheSize = &xbzrleCacheSize
+	}
+	if policySpec.MaxDowntimeMilliseconds != nil {
+		changed = true
+		maxDowntime := *policySpec.MaxDowntimeMilliseconds
+		clusterMigrationConfigurations.MaxDowntimeMilliseconds = &maxDowntime
+	}
+	if policySpec.MaxRetries != nil {
+		changed = true
+		maxRetries := *policySpec.MaxRetries
+		clusterMigrationConfigurations.MaxRetries = &maxRetries
+	}
+	if policySpec.RetryBackoffSeconds != nil {
+		changed = true
+		retryBackoffSeconds := *policySpec.RetryBackoffSeconds
+		clusterMigrationConfigurations.RetryBackoffSeconds = &retryBackoffSeconds
+	}
+	if policySpec.NonConvergenceEscalation != nil {
+		changed = true
+		escalation := *policySpec.NonConvergenceEscalation
+		clusterMigrationConfigurations.NonConvergenceEscalation = &escalation
+	}
+	if policySpec.Network != nil {
+		changed = true
+		network := *policySpec.Network
+		clusterMigrationConfigurations.Network = &network
+	}
+	if policySpec.PostMigrationVerification != nil {
+		changed = true
+		clusterMigrationConfigurations.PostMigrationVerification = policySpec.PostMigrationVerification.DeepCopy()
+	}
 
 	return changed, nil
 }
@@ -11,11 +11,20 @@ func (MigrationPolicy) SwaggerDoc() map[string]string {
 
 func (MigrationPolicySpec) SwaggerDoc() map[string]string {
 	return map[string]string{
-		"allowAutoConverge":       "+optional",
-		"bandwidthPerMigration":   "+optional",
-		"completionTimeoutPerGiB": "+optional",
-		"allowPostCopy":           "+optional",
-		"allowWorkloadDisruption": "+optional",
+		"allowAutoConverge":         "+optional",
+		"bandwidthPerMigration":     "+optional",
+		"completionTimeoutPerGiB":   "+optional",
+		"allowPostCopy":             "+optional",
+		"allowWorkloadDisruption":   "+optional",
+		"parallelMigrationThreads":  "+optional",
+		"compression":               "+optional\n+kubebuilder:validation:Enum=zlib;zstd",
+		"xbzrleCacheSize":           "+optional",
+		"maxDowntimeMilliseconds":   "+optional",
+		"maxRetries":                "+optional",
+		"retryBackoffSeconds":       "+optional",
+		"nonConvergenceEscalation":  "+optional\n+kubebuilder:validation:Enum=PostCopy;AutoConverge",
+		"network":                   "+optional",
+		"postMigrationVerification": "+optional",
 	}
 }
 
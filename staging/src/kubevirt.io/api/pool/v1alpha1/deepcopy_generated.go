@@ -24,6 +24,7 @@ package v1alpha1
 import (
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	runtime "k8s.io/apimachinery/pkg/runtime"
+	intstr "k8s.io/apimachinery/pkg/util/intstr"
 )
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
@@ -54,6 +55,47 @@ func (in *VirtualMachinePool) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VirtualMachinePoolAutoscaling) DeepCopyInto(out *VirtualMachinePoolAutoscaling) {
+	*out = *in
+	if in.MinReplicas != nil {
+		in, out := &in.MinReplicas, &out.MinReplicas
+		*out = new(int32)
+		**out = **in
+	}
+	if in.TargetCPUUtilizationPercentage != nil {
+		in, out := &in.TargetCPUUtilizationPercentage, &out.TargetCPUUtilizationPercentage
+		*out = new(int32)
+		**out = **in
+	}
+	if in.TargetMemoryUtilizationPercentage != nil {
+		in, out := &in.TargetMemoryUtilizationPercentage, &out.TargetMemoryUtilizationPercentage
+		*out = new(int32)
+		**out = **in
+	}
+	if in.ScaleOutCooldownSeconds != nil {
+		in, out := &in.ScaleOutCooldownSeconds, &out.ScaleOutCooldownSeconds
+		*out = new(int32)
+		**out = **in
+	}
+	if in.ScaleInCooldownSeconds != nil {
+		in, out := &in.ScaleInCooldownSeconds, &out.ScaleInCooldownSeconds
+		*out = new(int32)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachinePoolAutoscaling.
+func (in *VirtualMachinePoolAutoscaling) DeepCopy() *VirtualMachinePoolAutoscaling {
+	if in == nil {
+		return nil
+	}
+	out := new(VirtualMachinePoolAutoscaling)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *VirtualMachinePoolCondition) DeepCopyInto(out *VirtualMachinePoolCondition) {
 	*out = *in
@@ -105,6 +147,74 @@ func (in *VirtualMachinePoolList) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VirtualMachinePoolProactiveScaleInStrategy) DeepCopyInto(out *VirtualMachinePoolProactiveScaleInStrategy) {
+	*out = *in
+	if in.PreStopGracePeriodSeconds != nil {
+		in, out := &in.PreStopGracePeriodSeconds, &out.PreStopGracePeriodSeconds
+		*out = new(int64)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachinePoolProactiveScaleInStrategy.
+func (in *VirtualMachinePoolProactiveScaleInStrategy) DeepCopy() *VirtualMachinePoolProactiveScaleInStrategy {
+	if in == nil {
+		return nil
+	}
+	out := new(VirtualMachinePoolProactiveScaleInStrategy)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VirtualMachinePoolRollingUpdate) DeepCopyInto(out *VirtualMachinePoolRollingUpdate) {
+	*out = *in
+	if in.MaxUnavailable != nil {
+		in, out := &in.MaxUnavailable, &out.MaxUnavailable
+		*out = new(intstr.IntOrString)
+		**out = **in
+	}
+	if in.MaxSurge != nil {
+		in, out := &in.MaxSurge, &out.MaxSurge
+		*out = new(intstr.IntOrString)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachinePoolRollingUpdate.
+func (in *VirtualMachinePoolRollingUpdate) DeepCopy() *VirtualMachinePoolRollingUpdate {
+	if in == nil {
+		return nil
+	}
+	out := new(VirtualMachinePoolRollingUpdate)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VirtualMachinePoolScaleInStrategy) DeepCopyInto(out *VirtualMachinePoolScaleInStrategy) {
+	*out = *in
+	if in.Proactive != nil {
+		in, out := &in.Proactive, &out.Proactive
+		*out = new(VirtualMachinePoolProactiveScaleInStrategy)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachinePoolScaleInStrategy.
+func (in *VirtualMachinePoolScaleInStrategy) DeepCopy() *VirtualMachinePoolScaleInStrategy {
+	if in == nil {
+		return nil
+	}
+	out := new(VirtualMachinePoolScaleInStrategy)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *VirtualMachinePoolSpec) DeepCopyInto(out *VirtualMachinePoolSpec) {
 	*out = *in
@@ -123,6 +233,21 @@ func (in *VirtualMachinePoolSpec) DeepCopyInto(out *VirtualMachinePoolSpec) {
 		*out = new(VirtualMachineTemplateSpec)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.UpdateStrategy != nil {
+		in, out := &in.UpdateStrategy, &out.UpdateStrategy
+		*out = new(VirtualMachinePoolUpdateStrategy)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ScaleInStrategy != nil {
+		in, out := &in.ScaleInStrategy, &out.ScaleInStrategy
+		*out = new(VirtualMachinePoolScaleInStrategy)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Autoscaling != nil {
+		in, out := &in.Autoscaling, &out.Autoscaling
+		*out = new(VirtualMachinePoolAutoscaling)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -146,6 +271,10 @@ func (in *VirtualMachinePoolStatus) DeepCopyInto(out *VirtualMachinePoolStatus)
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.LastScaleTime != nil {
+		in, out := &in.LastScaleTime, &out.LastScaleTime
+		*out = (*in).DeepCopy()
+	}
 	return
 }
 
@@ -159,6 +288,27 @@ func (in *VirtualMachinePoolStatus) DeepCopy() *VirtualMachinePoolStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VirtualMachinePoolUpdateStrategy) DeepCopyInto(out *VirtualMachinePoolUpdateStrategy) {
+	*out = *in
+	if in.RollingUpdate != nil {
+		in, out := &in.RollingUpdate, &out.RollingUpdate
+		*out = new(VirtualMachinePoolRollingUpdate)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachinePoolUpdateStrategy.
+func (in *VirtualMachinePoolUpdateStrategy) DeepCopy() *VirtualMachinePoolUpdateStrategy {
+	if in == nil {
+		return nil
+	}
+	out := new(VirtualMachinePoolUpdateStrategy)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *VirtualMachineTemplateSpec) DeepCopyInto(out *VirtualMachineTemplateSpec) {
 	*out = *in
@@ -22,6 +22,7 @@ package v1alpha1
 import (
 	k8sv1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
 
 	virtv1 "kubevirt.io/api/core/v1"
 )
@@ -89,6 +90,183 @@ type VirtualMachinePoolStatus struct {
 
 	// Canonical form of the label selector for HPA which consumes it through the scale subresource.
 	LabelSelector string `json:"labelSelector,omitempty"`
+
+	// UpdateRevision is the name of the ControllerRevision holding the
+	// VirtualMachine template the VMs of the pool are updated to.
+	// +optional
+	UpdateRevision string `json:"updateRevision,omitempty" optional:"true"`
+
+	// CurrentRevision is the name of the ControllerRevision all VMs of the
+	// pool, and their running VMIs, were last fully updated to.
+	// +optional
+	CurrentRevision string `json:"currentRevision,omitempty" optional:"true"`
+
+	// UpdatedReplicas is the number of VMs of the pool, including their
+	// running VMI, which match the update revision.
+	// +optional
+	UpdatedReplicas int32 `json:"updatedReplicas,omitempty" optional:"true"`
+
+	// LastScaleTime is the last time the pool was scaled by its autoscaling.
+	// +optional
+	// +nullable
+	LastScaleTime *metav1.Time `json:"lastScaleTime,omitempty" optional:"true"`
+}
+
+// +k8s:openapi-gen=true
+type VirtualMachinePoolUpdateMethod string
+
+const (
+	// VirtualMachinePoolUpdateMethodRestart restarts the outdated VMIs of the pool.
+	VirtualMachinePoolUpdateMethodRestart VirtualMachinePoolUpdateMethod = "Restart"
+
+	// VirtualMachinePoolUpdateMethodLiveMigrate live migrates the outdated VMIs of
+	// the pool whose VM can apply the update without a restart, and restarts the others.
+	VirtualMachinePoolUpdateMethodLiveMigrate VirtualMachinePoolUpdateMethod = "LiveMigrate"
+)
+
+// VirtualMachinePoolUpdateStrategy describes how changes of the VirtualMachine
+// template are propagated to the running VMIs of the pool.
+//
+// +k8s:openapi-gen=true
+type VirtualMachinePoolUpdateStrategy struct {
+	// RollingUpdate updates the running VMIs of the pool a few at a time.
+	// Without it all outdated VMIs are restarted at once.
+	// +optional
+	RollingUpdate *VirtualMachinePoolRollingUpdate `json:"rollingUpdate,omitempty"`
+}
+
+// +k8s:openapi-gen=true
+type VirtualMachinePoolRollingUpdate struct {
+	// Method used to propagate the update to the running VMIs.
+	// Defaults to Restart.
+	// +kubebuilder:validation:Enum=Restart;LiveMigrate
+	// +optional
+	Method VirtualMachinePoolUpdateMethod `json:"method,omitempty"`
+
+	// MaxUnavailable is the maximum number of VMs of the pool which can be
+	// unavailable during the update. Value can be an absolute number or a
+	// percentage of the desired replicas, rounded down. Defaults to 1.
+	// +optional
+	MaxUnavailable *intstr.IntOrString `json:"maxUnavailable,omitempty"`
+
+	// MaxSurge is the maximum number of VMIs of the pool which can be live
+	// migrated at the same time, each migration runs an additional
+	// virt-launcher pod. Value can be an absolute number or a percentage of
+	// the desired replicas, rounded up. Defaults to 1.
+	// +optional
+	MaxSurge *intstr.IntOrString `json:"maxSurge,omitempty"`
+}
+
+// +k8s:openapi-gen=true
+type VirtualMachinePoolSelectionPolicy string
+
+const (
+	// VirtualMachinePoolSelectionPolicyRandom removes random VMs of the pool.
+	VirtualMachinePoolSelectionPolicyRandom VirtualMachinePoolSelectionPolicy = "Random"
+
+	// VirtualMachinePoolSelectionPolicyNewest removes the most recently created VMs of the pool first.
+	VirtualMachinePoolSelectionPolicyNewest VirtualMachinePoolSelectionPolicy = "Newest"
+
+	// VirtualMachinePoolSelectionPolicyOldest removes the least recently created VMs of the pool first.
+	VirtualMachinePoolSelectionPolicyOldest VirtualMachinePoolSelectionPolicy = "Oldest"
+
+	// VirtualMachinePoolSelectionPolicyUnhealthyFirst removes the VMs of the pool which are not
+	// ready first, followed by the VMs with the highest ordinal.
+	VirtualMachinePoolSelectionPolicyUnhealthyFirst VirtualMachinePoolSelectionPolicy = "UnhealthyFirst"
+
+	// VirtualMachinePoolSelectionPolicyDescendingOrdinal removes the VMs with the highest ordinal
+	// first. As new VMs take the lowest free ordinal, the VMs of the pool keep the ordinals
+	// from 0 to replicas-1.
+	VirtualMachinePoolSelectionPolicyDescendingOrdinal VirtualMachinePoolSelectionPolicy = "DescendingOrdinal"
+)
+
+// +k8s:openapi-gen=true
+type VirtualMachinePoolVolumeRetentionPolicy string
+
+const (
+	// VirtualMachinePoolVolumeRetentionPolicyDelete deletes the volumes of the
+	// DataVolumeTemplates of a VM together with the VM.
+	VirtualMachinePoolVolumeRetentionPolicyDelete VirtualMachinePoolVolumeRetentionPolicy = "Delete"
+
+	// VirtualMachinePoolVolumeRetentionPolicyRetain keeps the volumes of the
+	// DataVolumeTemplates of a VM removed from the pool. They are reused by the
+	// VM created with the same ordinal when the pool scales out again, and are
+	// deleted together with the pool.
+	VirtualMachinePoolVolumeRetentionPolicyRetain VirtualMachinePoolVolumeRetentionPolicy = "Retain"
+)
+
+// VirtualMachinePoolScaleInStrategy describes which VMs are removed when the
+// pool scales in and how.
+//
+// +k8s:openapi-gen=true
+type VirtualMachinePoolScaleInStrategy struct {
+	// SelectionPolicy decides which VMs of the pool are removed first.
+	// Defaults to Random.
+	// +kubebuilder:validation:Enum=Random;Newest;Oldest;UnhealthyFirst;DescendingOrdinal
+	// +optional
+	SelectionPolicy VirtualMachinePoolSelectionPolicy `json:"selectionPolicy,omitempty"`
+
+	// Proactive announces the removal of the selected VMs before they are deleted.
+	// Without it the selected VMs are deleted right away.
+	// +optional
+	Proactive *VirtualMachinePoolProactiveScaleInStrategy `json:"proactive,omitempty"`
+
+	// VolumeRetentionPolicy decides what happens to the volumes of the
+	// DataVolumeTemplates of the removed VMs. Defaults to Delete.
+	// +kubebuilder:validation:Enum=Delete;Retain
+	// +optional
+	VolumeRetentionPolicy VirtualMachinePoolVolumeRetentionPolicy `json:"volumeRetentionPolicy,omitempty"`
+}
+
+// VirtualMachinePoolProactiveScaleInStrategy announces the removal of a VM by
+// annotating it with the kubevirt.io/vm-pool-scale-in annotation, which
+// external tooling can act on before the VM is stopped. A VM which is still
+// announced when the pool scales out again is kept instead of being deleted.
+//
+// +k8s:openapi-gen=true
+type VirtualMachinePoolProactiveScaleInStrategy struct {
+	// PreStopGracePeriodSeconds is the time between the announcement of the
+	// removal of a VM and its deletion. Defaults to 30.
+	// +optional
+	PreStopGracePeriodSeconds *int64 `json:"preStopGracePeriodSeconds,omitempty"`
+}
+
+// VirtualMachinePoolAutoscaling scales the pool on the load of the guests of
+// its VMs, as reported by virt-handler. The desired replicas are calculated
+// like the HorizontalPodAutoscaler does, from the average utilization of the
+// running VMIs and its target. The pool is not scaled in while a running VMI
+// did not report its load recently.
+//
+// +k8s:openapi-gen=true
+type VirtualMachinePoolAutoscaling struct {
+	// MinReplicas is the lower limit for the replicas the pool can be scaled
+	// in to. Defaults to 1.
+	// +optional
+	MinReplicas *int32 `json:"minReplicas,omitempty"`
+
+	// MaxReplicas is the upper limit for the replicas the pool can be scaled
+	// out to. It cannot be less than MinReplicas.
+	MaxReplicas int32 `json:"maxReplicas"`
+
+	// TargetCPUUtilizationPercentage is the target average vCPU utilization
+	// of the guests.
+	// +optional
+	TargetCPUUtilizationPercentage *int32 `json:"targetCPUUtilizationPercentage,omitempty"`
+
+	// TargetMemoryUtilizationPercentage is the target average memory
+	// utilization of the guests.
+	// +optional
+	TargetMemoryUtilizationPercentage *int32 `json:"targetMemoryUtilizationPercentage,omitempty"`
+
+	// ScaleOutCooldownSeconds is the minimum time between a scaling, or the
+	// creation, of the pool and its next scale out. Defaults to 60.
+	// +optional
+	ScaleOutCooldownSeconds *int32 `json:"scaleOutCooldownSeconds,omitempty"`
+
+	// ScaleInCooldownSeconds is the minimum time between a scaling, or the
+	// creation, of the pool and its next scale in. Defaults to 300.
+	// +optional
+	ScaleInCooldownSeconds *int32 `json:"scaleInCooldownSeconds,omitempty"`
 }
 
 // +k8s:openapi-gen=true
@@ -108,6 +286,21 @@ type VirtualMachinePoolSpec struct {
 	// Indicates that the pool is paused.
 	// +optional
 	Paused bool `json:"paused,omitempty" protobuf:"varint,7,opt,name=paused"`
+
+	// UpdateStrategy describes how changes of the VirtualMachine template are
+	// propagated to the running VMIs of the pool.
+	// +optional
+	UpdateStrategy *VirtualMachinePoolUpdateStrategy `json:"updateStrategy,omitempty"`
+
+	// ScaleInStrategy describes which VMs are removed when the pool scales in
+	// and how.
+	// +optional
+	ScaleInStrategy *VirtualMachinePoolScaleInStrategy `json:"scaleInStrategy,omitempty"`
+
+	// Autoscaling scales the pool on the load of the guests of its VMs. The
+	// replicas of the pool are managed by it.
+	// +optional
+	Autoscaling *VirtualMachinePoolAutoscaling `json:"autoscaling,omitempty"`
 }
 
 // VirtualMachinePoolList is a list of VirtualMachinePool resources.
@@ -26,9 +26,57 @@ func (VirtualMachinePoolCondition) SwaggerDoc() map[string]string {
 
 func (VirtualMachinePoolStatus) SwaggerDoc() map[string]string {
 	return map[string]string{
-		"":              "+k8s:openapi-gen=true",
-		"conditions":    "+listType=atomic",
-		"labelSelector": "Canonical form of the label selector for HPA which consumes it through the scale subresource.",
+		"":                "+k8s:openapi-gen=true",
+		"conditions":      "+listType=atomic",
+		"labelSelector":   "Canonical form of the label selector for HPA which consumes it through the scale subresource.",
+		"updateRevision":  "UpdateRevision is the name of the ControllerRevision holding the\nVirtualMachine template the VMs of the pool are updated to.\n+optional",
+		"currentRevision": "CurrentRevision is the name of the ControllerRevision all VMs of the\npool, and their running VMIs, were last fully updated to.\n+optional",
+		"updatedReplicas": "UpdatedReplicas is the number of VMs of the pool, including their\nrunning VMI, which match the update revision.\n+optional",
+		"lastScaleTime":   "LastScaleTime is the last time the pool was scaled by its autoscaling.\n+optional\n+nullable",
+	}
+}
+
+func (VirtualMachinePoolUpdateStrategy) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":              "VirtualMachinePoolUpdateStrategy describes how changes of the VirtualMachine\ntemplate are propagated to the running VMIs of the pool.\n\n+k8s:openapi-gen=true",
+		"rollingUpdate": "RollingUpdate updates the running VMIs of the pool a few at a time.\nWithout it all outdated VMIs are restarted at once.\n+optional",
+	}
+}
+
+func (VirtualMachinePoolRollingUpdate) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":               "+k8s:openapi-gen=true",
+		"method":         "Method used to propagate the update to the running VMIs.\nDefaults to Restart.\n+kubebuilder:validation:Enum=Restart;LiveMigrate\n+optional",
+		"maxUnavailable": "MaxUnavailable is the maximum number of VMs of the pool which can be\nunavailable during the update. Value can be an absolute number or a\npercentage of the desired replicas, rounded down. Defaults to 1.\n+optional",
+		"maxSurge":       "MaxSurge is the maximum number of VMIs of the pool which can be live\nmigrated at the same time, each migration runs an additional\nvirt-launcher pod. Value can be an absolute number or a percentage of\nthe desired replicas, rounded up. Defaults to 1.\n+optional",
+	}
+}
+
+func (VirtualMachinePoolScaleInStrategy) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":                      "VirtualMachinePoolScaleInStrategy describes which VMs are removed when the\npool scales in and how.\n\n+k8s:openapi-gen=true",
+		"selectionPolicy":       "SelectionPolicy decides which VMs of the pool are removed first.\nDefaults to Random.\n+kubebuilder:validation:Enum=Random;Newest;Oldest;UnhealthyFirst;DescendingOrdinal\n+optional",
+		"proactive":             "Proactive announces the removal of the selected VMs before they are deleted.\nWithout it the selected VMs are deleted right away.\n+optional",
+		"volumeRetentionPolicy": "VolumeRetentionPolicy decides what happens to the volumes of the\nDataVolumeTemplates of the removed VMs. Defaults to Delete.\n+kubebuilder:validation:Enum=Delete;Retain\n+optional",
+	}
+}
+
+func (VirtualMachinePoolProactiveScaleInStrategy) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":                          "VirtualMachinePoolProactiveScaleInStrategy announces the removal of a VM by\nannotating it with the kubevirt.io/vm-pool-scale-in annotation, which\nexternal tooling can act on before the VM is stopped. A VM which is still\nannounced when the pool scales out again is kept instead of being deleted.\n\n+k8s:openapi-gen=true",
+		"preStopGracePeriodSeconds": "PreStopGracePeriodSeconds is the time between the announcement of the\nremoval of a VM and its deletion. Defaults to 30.\n+optional",
+	}
+}
+
+func (VirtualMachinePoolAutoscaling) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":                                  "VirtualMachinePoolAutoscaling scales the pool on the load of the guests of\nits VMs, as reported by virt-handler. The desired replicas are calculated\nlike the HorizontalPodAutoscaler does, from the average utilization of the\nrunning VMIs and its target. The pool is not scaled in while a running VMI\ndid not report its load recently.\n\n+k8s:openapi-gen=true",
+		"minReplicas":                       "MinReplicas is the lower limit for the replicas the pool can be scaled\nin to. Defaults to 1.\n+optional",
+		"maxReplicas":                       "MaxReplicas is the upper limit for the replicas the pool can be scaled\nout to. It cannot be less than MinReplicas.",
+		"targetCPUUtilizationPercentage":    "TargetCPUUtilizationPercentage is the target average vCPU utilization\nof the guests.\n+optional",
+		"targetMemoryUtilizationPercentage": "TargetMemoryUtilizationPercentage is the target average memory\nutilization of the guests.\n+optional",
+		"scaleOutCooldownSeconds":           "ScaleOutCooldownSeconds is the minimum time between a scaling, or the\ncreation, of the pool and its next scale out. Defaults to 60.\n+optional",
+		"scaleInCooldownSeconds":            "ScaleInCooldownSeconds is the minimum time between a scaling, or the\ncreation, of the pool and its next scale in. Defaults to 300.\n+optional",
 	}
 }
 
@@ -39,6 +87,9 @@ func (VirtualMachinePoolSpec) SwaggerDoc() map[string]string {
 		"selector":               "Label selector for pods. Existing Poolss whose pods are\nselected by this will be the ones affected by this deployment.",
 		"virtualMachineTemplate": "Template describes the VM that will be created.",
 		"paused":                 "Indicates that the pool is paused.\n+optional",
+		"updateStrategy":         "UpdateStrategy describes how changes of the VirtualMachine template are\npropagated to the running VMIs of the pool.\n+optional",
+		"scaleInStrategy":        "ScaleInStrategy describes which VMs are removed when the pool scales in\nand how.\n+optional",
+		"autoscaling":            "Autoscaling scales the pool on the load of the guests of its VMs. The\nreplicas of the pool are managed by it.\n+optional",
 	}
 }
 
@@ -70,6 +70,22 @@ func (in *Error) DeepCopy() *Error {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MemorySnapshotStatus) DeepCopyInto(out *MemorySnapshotStatus) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MemorySnapshotStatus.
+func (in *MemorySnapshotStatus) DeepCopy() *MemorySnapshotStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(MemorySnapshotStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *PersistentVolumeClaim) DeepCopyInto(out *PersistentVolumeClaim) {
 	*out = *in
@@ -88,6 +104,31 @@ func (in *PersistentVolumeClaim) DeepCopy() *PersistentVolumeClaim {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ScheduledSnapshotResult) DeepCopyInto(out *ScheduledSnapshotResult) {
+	*out = *in
+	if in.Time != nil {
+		in, out := &in.Time, &out.Time
+		*out = (*in).DeepCopy()
+	}
+	if in.Message != nil {
+		in, out := &in.Message, &out.Message
+		*out = new(string)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ScheduledSnapshotResult.
+func (in *ScheduledSnapshotResult) DeepCopy() *ScheduledSnapshotResult {
+	if in == nil {
+		return nil
+	}
+	out := new(ScheduledSnapshotResult)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *SnapshotVolumesLists) DeepCopyInto(out *SnapshotVolumesLists) {
 	*out = *in
@@ -186,6 +227,189 @@ func (in *VirtualMachineRestore) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VirtualMachineRestoreExportSource) DeepCopyInto(out *VirtualMachineRestoreExportSource) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineRestoreExportSource.
+func (in *VirtualMachineRestoreExportSource) DeepCopy() *VirtualMachineRestoreExportSource {
+	if in == nil {
+		return nil
+	}
+	out := new(VirtualMachineRestoreExportSource)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VirtualMachineRestoreGroup) DeepCopyInto(out *VirtualMachineRestoreGroup) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	if in.Status != nil {
+		in, out := &in.Status, &out.Status
+		*out = new(VirtualMachineRestoreGroupStatus)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineRestoreGroup.
+func (in *VirtualMachineRestoreGroup) DeepCopy() *VirtualMachineRestoreGroup {
+	if in == nil {
+		return nil
+	}
+	out := new(VirtualMachineRestoreGroup)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *VirtualMachineRestoreGroup) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VirtualMachineRestoreGroupList) DeepCopyInto(out *VirtualMachineRestoreGroupList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]VirtualMachineRestoreGroup, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineRestoreGroupList.
+func (in *VirtualMachineRestoreGroupList) DeepCopy() *VirtualMachineRestoreGroupList {
+	if in == nil {
+		return nil
+	}
+	out := new(VirtualMachineRestoreGroupList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *VirtualMachineRestoreGroupList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VirtualMachineRestoreGroupMember) DeepCopyInto(out *VirtualMachineRestoreGroupMember) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineRestoreGroupMember.
+func (in *VirtualMachineRestoreGroupMember) DeepCopy() *VirtualMachineRestoreGroupMember {
+	if in == nil {
+		return nil
+	}
+	out := new(VirtualMachineRestoreGroupMember)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VirtualMachineRestoreGroupSpec) DeepCopyInto(out *VirtualMachineRestoreGroupSpec) {
+	*out = *in
+	if in.TargetReadinessPolicy != nil {
+		in, out := &in.TargetReadinessPolicy, &out.TargetReadinessPolicy
+		*out = new(TargetReadinessPolicy)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineRestoreGroupSpec.
+func (in *VirtualMachineRestoreGroupSpec) DeepCopy() *VirtualMachineRestoreGroupSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(VirtualMachineRestoreGroupSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VirtualMachineRestoreGroupStatus) DeepCopyInto(out *VirtualMachineRestoreGroupStatus) {
+	*out = *in
+	if in.Members != nil {
+		in, out := &in.Members, &out.Members
+		*out = make([]VirtualMachineRestoreGroupMember, len(*in))
+		copy(*out, *in)
+	}
+	if in.RestoreTime != nil {
+		in, out := &in.RestoreTime, &out.RestoreTime
+		*out = (*in).DeepCopy()
+	}
+	if in.Complete != nil {
+		in, out := &in.Complete, &out.Complete
+		*out = new(bool)
+		**out = **in
+	}
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineRestoreGroupStatus.
+func (in *VirtualMachineRestoreGroupStatus) DeepCopy() *VirtualMachineRestoreGroupStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(VirtualMachineRestoreGroupStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VirtualMachineRestoreIdentity) DeepCopyInto(out *VirtualMachineRestoreIdentity) {
+	*out = *in
+	if in.NewMacAddresses != nil {
+		in, out := &in.NewMacAddresses, &out.NewMacAddresses
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.NewSMBiosSerial != nil {
+		in, out := &in.NewSMBiosSerial, &out.NewSMBiosSerial
+		*out = new(string)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineRestoreIdentity.
+func (in *VirtualMachineRestoreIdentity) DeepCopy() *VirtualMachineRestoreIdentity {
+	if in == nil {
+		return nil
+	}
+	out := new(VirtualMachineRestoreIdentity)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *VirtualMachineRestoreList) DeepCopyInto(out *VirtualMachineRestoreList) {
 	*out = *in
@@ -223,6 +447,16 @@ func (in *VirtualMachineRestoreList) DeepCopyObject() runtime.Object {
 func (in *VirtualMachineRestoreSpec) DeepCopyInto(out *VirtualMachineRestoreSpec) {
 	*out = *in
 	in.Target.DeepCopyInto(&out.Target)
+	if in.VirtualMachineExport != nil {
+		in, out := &in.VirtualMachineExport, &out.VirtualMachineExport
+		*out = new(VirtualMachineRestoreExportSource)
+		**out = **in
+	}
+	if in.NewIdentity != nil {
+		in, out := &in.NewIdentity, &out.NewIdentity
+		*out = new(VirtualMachineRestoreIdentity)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.TargetReadinessPolicy != nil {
 		in, out := &in.TargetReadinessPolicy, &out.TargetReadinessPolicy
 		*out = new(TargetReadinessPolicy)
@@ -453,6 +687,164 @@ func (in *VirtualMachineSnapshotContentStatus) DeepCopy() *VirtualMachineSnapsho
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VirtualMachineSnapshotGroup) DeepCopyInto(out *VirtualMachineSnapshotGroup) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	if in.Status != nil {
+		in, out := &in.Status, &out.Status
+		*out = new(VirtualMachineSnapshotGroupStatus)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineSnapshotGroup.
+func (in *VirtualMachineSnapshotGroup) DeepCopy() *VirtualMachineSnapshotGroup {
+	if in == nil {
+		return nil
+	}
+	out := new(VirtualMachineSnapshotGroup)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *VirtualMachineSnapshotGroup) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VirtualMachineSnapshotGroupList) DeepCopyInto(out *VirtualMachineSnapshotGroupList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]VirtualMachineSnapshotGroup, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineSnapshotGroupList.
+func (in *VirtualMachineSnapshotGroupList) DeepCopy() *VirtualMachineSnapshotGroupList {
+	if in == nil {
+		return nil
+	}
+	out := new(VirtualMachineSnapshotGroupList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *VirtualMachineSnapshotGroupList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VirtualMachineSnapshotGroupMember) DeepCopyInto(out *VirtualMachineSnapshotGroupMember) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineSnapshotGroupMember.
+func (in *VirtualMachineSnapshotGroupMember) DeepCopy() *VirtualMachineSnapshotGroupMember {
+	if in == nil {
+		return nil
+	}
+	out := new(VirtualMachineSnapshotGroupMember)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VirtualMachineSnapshotGroupSpec) DeepCopyInto(out *VirtualMachineSnapshotGroupSpec) {
+	*out = *in
+	in.Selector.DeepCopyInto(&out.Selector)
+	if in.DeletionPolicy != nil {
+		in, out := &in.DeletionPolicy, &out.DeletionPolicy
+		*out = new(DeletionPolicy)
+		**out = **in
+	}
+	if in.FailureDeadline != nil {
+		in, out := &in.FailureDeadline, &out.FailureDeadline
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineSnapshotGroupSpec.
+func (in *VirtualMachineSnapshotGroupSpec) DeepCopy() *VirtualMachineSnapshotGroupSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(VirtualMachineSnapshotGroupSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VirtualMachineSnapshotGroupStatus) DeepCopyInto(out *VirtualMachineSnapshotGroupStatus) {
+	*out = *in
+	if in.Members != nil {
+		in, out := &in.Members, &out.Members
+		*out = make([]VirtualMachineSnapshotGroupMember, len(*in))
+		copy(*out, *in)
+	}
+	if in.FreezeTime != nil {
+		in, out := &in.FreezeTime, &out.FreezeTime
+		*out = (*in).DeepCopy()
+	}
+	if in.ThawTime != nil {
+		in, out := &in.ThawTime, &out.ThawTime
+		*out = (*in).DeepCopy()
+	}
+	if in.CreationTime != nil {
+		in, out := &in.CreationTime, &out.CreationTime
+		*out = (*in).DeepCopy()
+	}
+	if in.ReadyToUse != nil {
+		in, out := &in.ReadyToUse, &out.ReadyToUse
+		*out = new(bool)
+		**out = **in
+	}
+	if in.Error != nil {
+		in, out := &in.Error, &out.Error
+		*out = new(Error)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineSnapshotGroupStatus.
+func (in *VirtualMachineSnapshotGroupStatus) DeepCopy() *VirtualMachineSnapshotGroupStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(VirtualMachineSnapshotGroupStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *VirtualMachineSnapshotList) DeepCopyInto(out *VirtualMachineSnapshotList) {
 	*out = *in
@@ -486,6 +878,175 @@ func (in *VirtualMachineSnapshotList) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VirtualMachineSnapshotSchedule) DeepCopyInto(out *VirtualMachineSnapshotSchedule) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	if in.Status != nil {
+		in, out := &in.Status, &out.Status
+		*out = new(VirtualMachineSnapshotScheduleStatus)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineSnapshotSchedule.
+func (in *VirtualMachineSnapshotSchedule) DeepCopy() *VirtualMachineSnapshotSchedule {
+	if in == nil {
+		return nil
+	}
+	out := new(VirtualMachineSnapshotSchedule)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *VirtualMachineSnapshotSchedule) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VirtualMachineSnapshotScheduleList) DeepCopyInto(out *VirtualMachineSnapshotScheduleList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]VirtualMachineSnapshotSchedule, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineSnapshotScheduleList.
+func (in *VirtualMachineSnapshotScheduleList) DeepCopy() *VirtualMachineSnapshotScheduleList {
+	if in == nil {
+		return nil
+	}
+	out := new(VirtualMachineSnapshotScheduleList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *VirtualMachineSnapshotScheduleList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VirtualMachineSnapshotScheduleRetention) DeepCopyInto(out *VirtualMachineSnapshotScheduleRetention) {
+	*out = *in
+	if in.Last != nil {
+		in, out := &in.Last, &out.Last
+		*out = new(int32)
+		**out = **in
+	}
+	if in.Daily != nil {
+		in, out := &in.Daily, &out.Daily
+		*out = new(int32)
+		**out = **in
+	}
+	if in.Weekly != nil {
+		in, out := &in.Weekly, &out.Weekly
+		*out = new(int32)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineSnapshotScheduleRetention.
+func (in *VirtualMachineSnapshotScheduleRetention) DeepCopy() *VirtualMachineSnapshotScheduleRetention {
+	if in == nil {
+		return nil
+	}
+	out := new(VirtualMachineSnapshotScheduleRetention)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VirtualMachineSnapshotScheduleSpec) DeepCopyInto(out *VirtualMachineSnapshotScheduleSpec) {
+	*out = *in
+	in.Selector.DeepCopyInto(&out.Selector)
+	if in.Disabled != nil {
+		in, out := &in.Disabled, &out.Disabled
+		*out = new(bool)
+		**out = **in
+	}
+	if in.Retention != nil {
+		in, out := &in.Retention, &out.Retention
+		*out = new(VirtualMachineSnapshotScheduleRetention)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.SnapshotTemplate != nil {
+		in, out := &in.SnapshotTemplate, &out.SnapshotTemplate
+		*out = new(VirtualMachineSnapshotTemplateSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineSnapshotScheduleSpec.
+func (in *VirtualMachineSnapshotScheduleSpec) DeepCopy() *VirtualMachineSnapshotScheduleSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(VirtualMachineSnapshotScheduleSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VirtualMachineSnapshotScheduleStatus) DeepCopyInto(out *VirtualMachineSnapshotScheduleStatus) {
+	*out = *in
+	if in.LastScheduleTime != nil {
+		in, out := &in.LastScheduleTime, &out.LastScheduleTime
+		*out = (*in).DeepCopy()
+	}
+	if in.NextScheduleTime != nil {
+		in, out := &in.NextScheduleTime, &out.NextScheduleTime
+		*out = (*in).DeepCopy()
+	}
+	if in.LastSuccess != nil {
+		in, out := &in.LastSuccess, &out.LastSuccess
+		*out = new(ScheduledSnapshotResult)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.LastFailure != nil {
+		in, out := &in.LastFailure, &out.LastFailure
+		*out = new(ScheduledSnapshotResult)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineSnapshotScheduleStatus.
+func (in *VirtualMachineSnapshotScheduleStatus) DeepCopy() *VirtualMachineSnapshotScheduleStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(VirtualMachineSnapshotScheduleStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *VirtualMachineSnapshotSpec) DeepCopyInto(out *VirtualMachineSnapshotSpec) {
 	*out = *in
@@ -557,6 +1118,11 @@ func (in *VirtualMachineSnapshotStatus) DeepCopyInto(out *VirtualMachineSnapshot
 		*out = new(SnapshotVolumesLists)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Memory != nil {
+		in, out := &in.Memory, &out.Memory
+		*out = new(MemorySnapshotStatus)
+		**out = **in
+	}
 	return
 }
 
@@ -570,6 +1136,39 @@ func (in *VirtualMachineSnapshotStatus) DeepCopy() *VirtualMachineSnapshotStatus
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VirtualMachineSnapshotTemplateSpec) DeepCopyInto(out *VirtualMachineSnapshotTemplateSpec) {
+	*out = *in
+	if in.Labels != nil {
+		in, out := &in.Labels, &out.Labels
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.DeletionPolicy != nil {
+		in, out := &in.DeletionPolicy, &out.DeletionPolicy
+		*out = new(DeletionPolicy)
+		**out = **in
+	}
+	if in.FailureDeadline != nil {
+		in, out := &in.FailureDeadline, &out.FailureDeadline
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineSnapshotTemplateSpec.
+func (in *VirtualMachineSnapshotTemplateSpec) DeepCopy() *VirtualMachineSnapshotTemplateSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(VirtualMachineSnapshotTemplateSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *VolumeBackup) DeepCopyInto(out *VolumeBackup) {
 	*out = *in
@@ -32,6 +32,15 @@ import (
 const DefaultFailureDeadline = 5 * time.Minute
 const DefaultGracePeriod = 5 * time.Minute
 
+// SnapshotScheduleLabel is set on VirtualMachineSnapshots created by a VirtualMachineSnapshotSchedule
+const SnapshotScheduleLabel = "snapshot.kubevirt.io/schedule"
+
+// SnapshotGroupLabel is set on VirtualMachineSnapshots created by a VirtualMachineSnapshotGroup
+const SnapshotGroupLabel = "snapshot.kubevirt.io/group"
+
+// RestoreGroupLabel is set on VirtualMachineRestores created by a VirtualMachineRestoreGroup
+const RestoreGroupLabel = "snapshot.kubevirt.io/restore-group"
+
 // VirtualMachineSnapshot defines the operation of snapshotting a VM
 // +genclient
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
@@ -72,6 +81,12 @@ type VirtualMachineSnapshotSpec struct {
 	// Defaults to DefaultFailureDeadline - 5min
 	// +optional
 	FailureDeadline *metav1.Duration `json:"failureDeadline,omitempty"`
+
+	// IncludeMemory saves the guest memory and device state of a running VM
+	// along with its volumes, so that restoring the snapshot resumes the VM
+	// instead of booting it. The VM is paused until its volumes are snapshotted.
+	// +optional
+	IncludeMemory bool `json:"includeMemory,omitempty"`
 }
 
 // Indication is a way to indicate the state of the vm when taking the snapshot
@@ -126,6 +141,20 @@ type VirtualMachineSnapshotStatus struct {
 
 	// +optional
 	SnapshotVolumes *SnapshotVolumesLists `json:"snapshotVolumes,omitempty"`
+
+	// +optional
+	Memory *MemorySnapshotStatus `json:"memory,omitempty"`
+}
+
+// MemorySnapshotStatus tracks the guest memory and device state saved by the snapshot
+type MemorySnapshotStatus struct {
+	// ClaimName is the name of the PVC the memory state is saved to
+	ClaimName string `json:"claimName"`
+
+	// SourcePaused is set when the snapshot paused the VM to save the memory state,
+	// the VM is resumed once its volumes are snapshotted
+	// +optional
+	SourcePaused bool `json:"sourcePaused,omitempty"`
 }
 
 // SnapshotVolumesLists includes the list of volumes which were included in the snapshot and volumes which were excluded from the snapshot
@@ -339,8 +368,27 @@ type VirtualMachineRestoreSpec struct {
 	// initially only VirtualMachine type supported
 	Target corev1.TypedLocalObjectReference `json:"target"`
 
+	// VirtualMachineSnapshotName is the name of the VirtualMachineSnapshot to restore.
+	// It must be set to an empty string when the target is imported from a VirtualMachineExport.
 	VirtualMachineSnapshotName string `json:"virtualMachineSnapshotName"`
 
+	// VirtualMachineSnapshotNamespace is the namespace of the VirtualMachineSnapshot to restore,
+	// defaults to the namespace of the restore. Restoring from another namespace requires the
+	// CrossNamespaceVolumeDataSource feature of Kubernetes and a ReferenceGrant allowing
+	// PersistentVolumeClaims of the restore namespace to use VolumeSnapshots of that namespace.
+	// +optional
+	VirtualMachineSnapshotNamespace string `json:"virtualMachineSnapshotNamespace,omitempty"`
+
+	// VirtualMachineExport imports the target from a VirtualMachineExport of another cluster
+	// instead of restoring a VirtualMachineSnapshot. The target must not exist.
+	// +optional
+	VirtualMachineExport *VirtualMachineRestoreExportSource `json:"virtualMachineExport,omitempty"`
+
+	// NewIdentity gives a new target new MAC addresses, a new SMBios serial and a new firmware UUID
+	// the same way a VirtualMachineClone does. The target must not exist.
+	// +optional
+	NewIdentity *VirtualMachineRestoreIdentity `json:"newIdentity,omitempty"`
+
 	// +optional
 	TargetReadinessPolicy *TargetReadinessPolicy `json:"targetReadinessPolicy,omitempty"`
 
@@ -354,6 +402,35 @@ type VirtualMachineRestoreSpec struct {
 	Patches []string `json:"patches,omitempty"`
 }
 
+// VirtualMachineRestoreExportSource points to a VirtualMachineExport of another cluster
+type VirtualMachineRestoreExportSource struct {
+	// ManifestURL is the external URL of the AllManifests manifest of the VirtualMachineExport
+	ManifestURL string `json:"manifestURL"`
+
+	// TokenSecretRef is the name of a Secret in the namespace of the restore holding the
+	// token of the VirtualMachineExport under the "token" key
+	TokenSecretRef string `json:"tokenSecretRef"`
+
+	// Cert is the PEM encoded CA certificate of the export server, as published in the
+	// external links of the VirtualMachineExport. Defaults to the system CAs.
+	// +optional
+	Cert string `json:"cert,omitempty"`
+}
+
+// VirtualMachineRestoreIdentity holds the identifiers of a new target
+type VirtualMachineRestoreIdentity struct {
+	// NewMacAddresses manually sets that target interfaces' mac addresses. The key is the interface name and the
+	// value is the new mac address. An empty mac address is assigned to interfaces not included in this map, so
+	// that a new one is generated.
+	// +optional
+	NewMacAddresses map[string]string `json:"newMacAddresses,omitempty"`
+
+	// NewSMBiosSerial manually sets that target's SMbios serial. If this field is not specified, the serial is
+	// cleared so that a new one is generated.
+	// +optional
+	NewSMBiosSerial *string `json:"newSMBiosSerial,omitempty"`
+}
+
 // VirtualMachineRestoreStatus is the spec for a VirtualMachineRestoreresource
 type VirtualMachineRestoreStatus struct {
 	// +optional
@@ -395,3 +472,252 @@ type VirtualMachineRestoreList struct {
 
 	Items []VirtualMachineRestore `json:"items"`
 }
+
+// VirtualMachineSnapshotSchedule periodically takes VirtualMachineSnapshots
+// of the VirtualMachines matched by its selector
+// +genclient
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+type VirtualMachineSnapshotSchedule struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec VirtualMachineSnapshotScheduleSpec `json:"spec"`
+
+	// +optional
+	Status *VirtualMachineSnapshotScheduleStatus `json:"status,omitempty"`
+}
+
+// VirtualMachineSnapshotScheduleSpec is the spec for a VirtualMachineSnapshotSchedule resource
+type VirtualMachineSnapshotScheduleSpec struct {
+	// Selector selects the VirtualMachines in the namespace of the schedule which are snapshotted
+	Selector metav1.LabelSelector `json:"selector"`
+
+	// Schedule in cron format, for example "0 */6 * * *"
+	Schedule string `json:"schedule"`
+
+	// Disabled stops the schedule from taking new snapshots.
+	// Retention is still applied to existing snapshots.
+	// +optional
+	Disabled *bool `json:"disabled,omitempty"`
+
+	// +optional
+	Retention *VirtualMachineSnapshotScheduleRetention `json:"retention,omitempty"`
+
+	// +optional
+	SnapshotTemplate *VirtualMachineSnapshotTemplateSpec `json:"snapshotTemplate,omitempty"`
+}
+
+// VirtualMachineSnapshotScheduleRetention defines which snapshots taken by a schedule are kept.
+// Rules are evaluated per VirtualMachine, a snapshot is kept if any rule selects it.
+// Without any rule all snapshots are kept.
+type VirtualMachineSnapshotScheduleRetention struct {
+	// Last keeps the given number of most recent snapshots
+	// +optional
+	Last *int32 `json:"last,omitempty"`
+
+	// Daily keeps the most recent snapshot of each of the given number of most recent days
+	// +optional
+	Daily *int32 `json:"daily,omitempty"`
+
+	// Weekly keeps the most recent snapshot of each of the given number of most recent weeks
+	// +optional
+	Weekly *int32 `json:"weekly,omitempty"`
+}
+
+// VirtualMachineSnapshotTemplateSpec describes the VirtualMachineSnapshots created by a schedule
+type VirtualMachineSnapshotTemplateSpec struct {
+	// Labels added to the created snapshots
+	// +optional
+	Labels map[string]string `json:"labels,omitempty"`
+
+	// +optional
+	DeletionPolicy *DeletionPolicy `json:"deletionPolicy,omitempty"`
+
+	// +optional
+	FailureDeadline *metav1.Duration `json:"failureDeadline,omitempty"`
+}
+
+// VirtualMachineSnapshotScheduleStatus is the status for a VirtualMachineSnapshotSchedule resource
+type VirtualMachineSnapshotScheduleStatus struct {
+	// LastScheduleTime is the last time snapshots were scheduled
+	// +optional
+	// +nullable
+	LastScheduleTime *metav1.Time `json:"lastScheduleTime,omitempty"`
+
+	// NextScheduleTime is the next time snapshots will be scheduled
+	// +optional
+	// +nullable
+	NextScheduleTime *metav1.Time `json:"nextScheduleTime,omitempty"`
+
+	// +optional
+	LastSuccess *ScheduledSnapshotResult `json:"lastSuccess,omitempty"`
+
+	// +optional
+	LastFailure *ScheduledSnapshotResult `json:"lastFailure,omitempty"`
+
+	// +optional
+	// +listType=atomic
+	Conditions []Condition `json:"conditions,omitempty"`
+}
+
+// ScheduledSnapshotResult describes the outcome of a snapshot taken by a schedule
+type ScheduledSnapshotResult struct {
+	// +optional
+	VirtualMachineSnapshotName string `json:"virtualMachineSnapshotName,omitempty"`
+
+	// +optional
+	// +nullable
+	Time *metav1.Time `json:"time,omitempty"`
+
+	// +optional
+	Message *string `json:"message,omitempty"`
+}
+
+// VirtualMachineSnapshotScheduleList is a list of VirtualMachineSnapshotSchedule resources
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+type VirtualMachineSnapshotScheduleList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata"`
+
+	Items []VirtualMachineSnapshotSchedule `json:"items"`
+}
+
+// VirtualMachineSnapshotGroup defines the operation of snapshotting a group of VMs
+// consistently. The file systems of all VMs are frozen before any volume is
+// snapshotted and thawed once every volume snapshot was taken.
+// +genclient
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+type VirtualMachineSnapshotGroup struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec VirtualMachineSnapshotGroupSpec `json:"spec"`
+
+	// +optional
+	Status *VirtualMachineSnapshotGroupStatus `json:"status,omitempty"`
+}
+
+// VirtualMachineSnapshotGroupSpec is the spec for a VirtualMachineSnapshotGroup resource
+type VirtualMachineSnapshotGroupSpec struct {
+	// Selector selects the VirtualMachines in the namespace of the group which are snapshotted.
+	// The members are resolved once, when the group is created.
+	Selector metav1.LabelSelector `json:"selector"`
+
+	// DeletionPolicy of the VirtualMachineSnapshots of the members
+	// +optional
+	DeletionPolicy *DeletionPolicy `json:"deletionPolicy,omitempty"`
+
+	// This time represents the number of seconds we permit the group snapshot
+	// to take. In case we pass this deadline we mark this snapshot
+	// as failed.
+	// Defaults to DefaultFailureDeadline - 5min
+	// +optional
+	FailureDeadline *metav1.Duration `json:"failureDeadline,omitempty"`
+}
+
+// VirtualMachineSnapshotGroupStatus is the status for a VirtualMachineSnapshotGroup resource
+type VirtualMachineSnapshotGroupStatus struct {
+	// +optional
+	Phase VirtualMachineSnapshotPhase `json:"phase,omitempty"`
+
+	// +optional
+	// +listType=atomic
+	Members []VirtualMachineSnapshotGroupMember `json:"members,omitempty"`
+
+	// FreezeTime is the time the members were frozen, volume snapshots
+	// of the members are only taken after it is set
+	// +optional
+	// +nullable
+	FreezeTime *metav1.Time `json:"freezeTime,omitempty"`
+
+	// ThawTime is the time the members were thawed
+	// +optional
+	// +nullable
+	ThawTime *metav1.Time `json:"thawTime,omitempty"`
+
+	// +optional
+	// +nullable
+	CreationTime *metav1.Time `json:"creationTime,omitempty"`
+
+	// +optional
+	ReadyToUse *bool `json:"readyToUse,omitempty"`
+
+	// +optional
+	Error *Error `json:"error,omitempty"`
+
+	// +optional
+	// +listType=atomic
+	Conditions []Condition `json:"conditions,omitempty"`
+}
+
+// VirtualMachineSnapshotGroupMember links a VirtualMachine of the group to its VirtualMachineSnapshot
+type VirtualMachineSnapshotGroupMember struct {
+	VirtualMachineName string `json:"virtualMachineName"`
+
+	VirtualMachineSnapshotName string `json:"virtualMachineSnapshotName"`
+}
+
+// VirtualMachineSnapshotGroupList is a list of VirtualMachineSnapshotGroup resources
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+type VirtualMachineSnapshotGroupList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata"`
+
+	Items []VirtualMachineSnapshotGroup `json:"items"`
+}
+
+// VirtualMachineRestoreGroup defines the operation of restoring all VMs of a VirtualMachineSnapshotGroup
+// +genclient
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+type VirtualMachineRestoreGroup struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec VirtualMachineRestoreGroupSpec `json:"spec"`
+
+	// +optional
+	Status *VirtualMachineRestoreGroupStatus `json:"status,omitempty"`
+}
+
+// VirtualMachineRestoreGroupSpec is the spec for a VirtualMachineRestoreGroup resource
+type VirtualMachineRestoreGroupSpec struct {
+	VirtualMachineSnapshotGroupName string `json:"virtualMachineSnapshotGroupName"`
+
+	// TargetReadinessPolicy of the VirtualMachineRestores of the members
+	// +optional
+	TargetReadinessPolicy *TargetReadinessPolicy `json:"targetReadinessPolicy,omitempty"`
+}
+
+// VirtualMachineRestoreGroupStatus is the status for a VirtualMachineRestoreGroup resource
+type VirtualMachineRestoreGroupStatus struct {
+	// +optional
+	// +listType=atomic
+	Members []VirtualMachineRestoreGroupMember `json:"members,omitempty"`
+
+	// +optional
+	// +nullable
+	RestoreTime *metav1.Time `json:"restoreTime,omitempty"`
+
+	// +optional
+	Complete *bool `json:"complete,omitempty"`
+
+	// +optional
+	// +listType=atomic
+	Conditions []Condition `json:"conditions,omitempty"`
+}
+
+// VirtualMachineRestoreGroupMember links a VirtualMachine of the group to its VirtualMachineRestore
+type VirtualMachineRestoreGroupMember struct {
+	VirtualMachineName string `json:"virtualMachineName"`
+
+	VirtualMachineRestoreName string `json:"virtualMachineRestoreName"`
+}
+
+// VirtualMachineRestoreGroupList is a list of VirtualMachineRestoreGroup resources
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+type VirtualMachineRestoreGroupList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata"`
+
+	Items []VirtualMachineRestoreGroup `json:"items"`
+}
@@ -14,6 +14,7 @@ func (VirtualMachineSnapshotSpec) SwaggerDoc() map[string]string {
 		"":                "VirtualMachineSnapshotSpec is the spec for a VirtualMachineSnapshot resource",
 		"deletionPolicy":  "+optional",
 		"failureDeadline": "This time represents the number of seconds we permit the vm snapshot\nto take. In case we pass this deadline we mark this snapshot\nas failed.\nDefaults to DefaultFailureDeadline - 5min\n+optional",
+		"includeMemory":   "IncludeMemory saves the guest memory and device state of a running VM\nalong with its volumes, so that restoring the snapshot resumes the VM\ninstead of booting it. The VM is paused until its volumes are snapshotted.\n+optional",
 	}
 }
 
@@ -29,6 +30,15 @@ func (VirtualMachineSnapshotStatus) SwaggerDoc() map[string]string {
 		"conditions":                        "+optional\n+listType=atomic",
 		"indications":                       "+optional\n+listType=set",
 		"snapshotVolumes":                   "+optional",
+		"memory":                            "+optional",
+	}
+}
+
+func (MemorySnapshotStatus) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":             "MemorySnapshotStatus tracks the guest memory and device state saved by the snapshot",
+		"claimName":    "ClaimName is the name of the PVC the memory state is saved to",
+		"sourcePaused": "SourcePaused is set when the snapshot paused the VM to save the memory state,\nthe VM is resumed once its volumes are snapshotted\n+optional",
 	}
 }
 
@@ -139,10 +149,31 @@ func (VirtualMachineRestore) SwaggerDoc() map[string]string {
 
 func (VirtualMachineRestoreSpec) SwaggerDoc() map[string]string {
 	return map[string]string{
-		"":                      "VirtualMachineRestoreSpec is the spec for a VirtualMachineRestoreresource",
-		"target":                "initially only VirtualMachine type supported",
-		"targetReadinessPolicy": "+optional",
-		"patches":               "If the target for the restore does not exist, it will be created. Patches holds JSON patches that would be\napplied to the target manifest before it's created. Patches should fit the target's Kind.\n\nExample for a patch: {\"op\": \"replace\", \"path\": \"/metadata/name\", \"value\": \"new-vm-name\"}\n\n+optional\n+listType=atomic",
+		"":                                "VirtualMachineRestoreSpec is the spec for a VirtualMachineRestoreresource",
+		"target":                          "initially only VirtualMachine type supported",
+		"virtualMachineSnapshotName":      "VirtualMachineSnapshotName is the name of the VirtualMachineSnapshot to restore.\nIt must be set to an empty string when the target is imported from a VirtualMachineExport.",
+		"virtualMachineSnapshotNamespace": "VirtualMachineSnapshotNamespace is the namespace of the VirtualMachineSnapshot to restore,\ndefaults to the namespace of the restore. Restoring from another namespace requires the\nCrossNamespaceVolumeDataSource feature of Kubernetes and a ReferenceGrant allowing\nPersistentVolumeClaims of the restore namespace to use VolumeSnapshots of that namespace.\n+optional",
+		"virtualMachineExport":            "VirtualMachineExport imports the target from a VirtualMachineExport of another cluster\ninstead of restoring a VirtualMachineSnapshot. The target must not exist.\n+optional",
+		"newIdentity":                     "NewIdentity gives a new target new MAC addresses, a new SMBios serial and a new firmware UUID\nthe same way a VirtualMachineClone does. The target must not exist.\n+optional",
+		"targetReadinessPolicy":           "+optional",
+		"patches":                         "If the target for the restore does not exist, it will be created. Patches holds JSON patches that would be\napplied to the target manifest before it's created. Patches should fit the target's Kind.\n\nExample for a patch: {\"op\": \"replace\", \"path\": \"/metadata/name\", \"value\": \"new-vm-name\"}\n\n+optional\n+listType=atomic",
+	}
+}
+
+func (VirtualMachineRestoreExportSource) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":               "VirtualMachineRestoreExportSource points to a VirtualMachineExport of another cluster",
+		"manifestURL":    "ManifestURL is the external URL of the AllManifests manifest of the VirtualMachineExport",
+		"tokenSecretRef": "TokenSecretRef is the name of a Secret in the namespace of the restore holding the\ntoken of the VirtualMachineExport under the \"token\" key",
+		"cert":           "Cert is the PEM encoded CA certificate of the export server, as published in the\nexternal links of the VirtualMachineExport. Defaults to the system CAs.\n+optional",
+	}
+}
+
+func (VirtualMachineRestoreIdentity) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":                "VirtualMachineRestoreIdentity holds the identifiers of a new target",
+		"newMacAddresses": "NewMacAddresses manually sets that target interfaces' mac addresses. The key is the interface name and the\nvalue is the new mac address. An empty mac address is assigned to interfaces not included in this map, so\nthat a new one is generated.\n+optional",
+		"newSMBiosSerial": "NewSMBiosSerial manually sets that target's SMbios serial. If this field is not specified, the serial is\ncleared so that a new one is generated.\n+optional",
 	}
 }
 
@@ -169,3 +200,143 @@ func (VirtualMachineRestoreList) SwaggerDoc() map[string]string {
 		"": "VirtualMachineRestoreList is a list of VirtualMachineRestore resources\n+k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object",
 	}
 }
+
+func (VirtualMachineSnapshotSchedule) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":       "VirtualMachineSnapshotSchedule periodically takes VirtualMachineSnapshots\nof the VirtualMachines matched by its selector\n+genclient\n+k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object",
+		"status": "+optional",
+	}
+}
+
+func (VirtualMachineSnapshotScheduleSpec) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":                 "VirtualMachineSnapshotScheduleSpec is the spec for a VirtualMachineSnapshotSchedule resource",
+		"selector":         "Selector selects the VirtualMachines in the namespace of the schedule which are snapshotted",
+		"schedule":         "Schedule in cron format, for example \"0 */6 * * *\"",
+		"disabled":         "Disabled stops the schedule from taking new snapshots.\nRetention is still applied to existing snapshots.\n+optional",
+		"retention":        "+optional",
+		"snapshotTemplate": "+optional",
+	}
+}
+
+func (VirtualMachineSnapshotScheduleRetention) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":       "VirtualMachineSnapshotScheduleRetention defines which snapshots taken by a schedule are kept.\nRules are evaluated per VirtualMachine, a snapshot is kept if any rule selects it.\nWithout any rule all snapshots are kept.",
+		"last":   "Last keeps the given number of most recent snapshots\n+optional",
+		"daily":  "Daily keeps the most recent snapshot of each of the given number of most recent days\n+optional",
+		"weekly": "Weekly keeps the most recent snapshot of each of the given number of most recent weeks\n+optional",
+	}
+}
+
+func (VirtualMachineSnapshotTemplateSpec) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":                "VirtualMachineSnapshotTemplateSpec describes the VirtualMachineSnapshots created by a schedule",
+		"labels":          "Labels added to the created snapshots\n+optional",
+		"deletionPolicy":  "+optional",
+		"failureDeadline": "+optional",
+	}
+}
+
+func (VirtualMachineSnapshotScheduleStatus) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":                 "VirtualMachineSnapshotScheduleStatus is the status for a VirtualMachineSnapshotSchedule resource",
+		"lastScheduleTime": "LastScheduleTime is the last time snapshots were scheduled\n+optional\n+nullable",
+		"nextScheduleTime": "NextScheduleTime is the next time snapshots will be scheduled\n+optional\n+nullable",
+		"lastSuccess":      "+optional",
+		"lastFailure":      "+optional",
+		"conditions":       "+optional\n+listType=atomic",
+	}
+}
+
+func (ScheduledSnapshotResult) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":                           "ScheduledSnapshotResult describes the outcome of a snapshot taken by a schedule",
+		"virtualMachineSnapshotName": "+optional",
+		"time":                       "+optional\n+nullable",
+		"message":                    "+optional",
+	}
+}
+
+func (VirtualMachineSnapshotScheduleList) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"": "VirtualMachineSnapshotScheduleList is a list of VirtualMachineSnapshotSchedule resources\n+k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object",
+	}
+}
+
+func (VirtualMachineSnapshotGroup) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":       "VirtualMachineSnapshotGroup defines the operation of snapshotting a group of VMs\nconsistently. The file systems of all VMs are frozen before any volume is\nsnapshotted and thawed once every volume snapshot was taken.\n+genclient\n+k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object",
+		"status": "+optional",
+	}
+}
+
+func (VirtualMachineSnapshotGroupSpec) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":                "VirtualMachineSnapshotGroupSpec is the spec for a VirtualMachineSnapshotGroup resource",
+		"selector":        "Selector selects the VirtualMachines in the namespace of the group which are snapshotted.\nThe members are resolved once, when the group is created.",
+		"deletionPolicy":  "DeletionPolicy of the VirtualMachineSnapshots of the members\n+optional",
+		"failureDeadline": "This time represents the number of seconds we permit the group snapshot\nto take. In case we pass this deadline we mark this snapshot\nas failed.\nDefaults to DefaultFailureDeadline - 5min\n+optional",
+	}
+}
+
+func (VirtualMachineSnapshotGroupStatus) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":             "VirtualMachineSnapshotGroupStatus is the status for a VirtualMachineSnapshotGroup resource",
+		"phase":        "+optional",
+		"members":      "+optional\n+listType=atomic",
+		"freezeTime":   "FreezeTime is the time the members were frozen, volume snapshots\nof the members are only taken after it is set\n+optional\n+nullable",
+		"thawTime":     "ThawTime is the time the members were thawed\n+optional\n+nullable",
+		"creationTime": "+optional\n+nullable",
+		"readyToUse":   "+optional",
+		"error":        "+optional",
+		"conditions":   "+optional\n+listType=atomic",
+	}
+}
+
+func (VirtualMachineSnapshotGroupMember) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"": "VirtualMachineSnapshotGroupMember links a VirtualMachine of the group to its VirtualMachineSnapshot",
+	}
+}
+
+func (VirtualMachineSnapshotGroupList) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"": "VirtualMachineSnapshotGroupList is a list of VirtualMachineSnapshotGroup resources\n+k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object",
+	}
+}
+
+func (VirtualMachineRestoreGroup) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":       "VirtualMachineRestoreGroup defines the operation of restoring all VMs of a VirtualMachineSnapshotGroup\n+genclient\n+k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object",
+		"status": "+optional",
+	}
+}
+
+func (VirtualMachineRestoreGroupSpec) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":                      "VirtualMachineRestoreGroupSpec is the spec for a VirtualMachineRestoreGroup resource",
+		"targetReadinessPolicy": "TargetReadinessPolicy of the VirtualMachineRestores of the members\n+optional",
+	}
+}
+
+func (VirtualMachineRestoreGroupStatus) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":            "VirtualMachineRestoreGroupStatus is the status for a VirtualMachineRestoreGroup resource",
+		"members":     "+optional\n+listType=atomic",
+		"restoreTime": "+optional\n+nullable",
+		"complete":    "+optional",
+		"conditions":  "+optional\n+listType=atomic",
+	}
+}
+
+func (VirtualMachineRestoreGroupMember) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"": "VirtualMachineRestoreGroupMember links a VirtualMachine of the group to its VirtualMachineRestore",
+	}
+}
+
+func (VirtualMachineRestoreGroupList) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"": "VirtualMachineRestoreGroupList is a list of VirtualMachineRestoreGroup resources\n+k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object",
+	}
+}
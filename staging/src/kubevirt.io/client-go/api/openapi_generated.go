@@ -336,6 +336,7 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		"kubevirt.io/api/core/v1.ArchSpecificConfiguration":                                          schema_kubevirtio_api_core_v1_ArchSpecificConfiguration(ref),
 		"kubevirt.io/api/core/v1.AuthorizedKeysFile":                                                 schema_kubevirtio_api_core_v1_AuthorizedKeysFile(ref),
 		"kubevirt.io/api/core/v1.BIOS":                                                               schema_kubevirtio_api_core_v1_BIOS(ref),
+		"kubevirt.io/api/core/v1.BackupVolumeSource":                                                 schema_kubevirtio_api_core_v1_BackupVolumeSource(ref),
 		"kubevirt.io/api/core/v1.BlockSize":                                                          schema_kubevirtio_api_core_v1_BlockSize(ref),
 		"kubevirt.io/api/core/v1.Bootloader":                                                         schema_kubevirtio_api_core_v1_Bootloader(ref),
 		"kubevirt.io/api/core/v1.CDRomTarget":                                                        schema_kubevirtio_api_core_v1_CDRomTarget(ref),
@@ -379,6 +380,7 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		"kubevirt.io/api/core/v1.DiskIOThreads":                                                      schema_kubevirtio_api_core_v1_DiskIOThreads(ref),
 		"kubevirt.io/api/core/v1.DiskTarget":                                                         schema_kubevirtio_api_core_v1_DiskTarget(ref),
 		"kubevirt.io/api/core/v1.DiskVerification":                                                   schema_kubevirtio_api_core_v1_DiskVerification(ref),
+		"kubevirt.io/api/core/v1.DomainBackupInfo":                                                   schema_kubevirtio_api_core_v1_DomainBackupInfo(ref),
 		"kubevirt.io/api/core/v1.DomainMemoryDumpInfo":                                               schema_kubevirtio_api_core_v1_DomainMemoryDumpInfo(ref),
 		"kubevirt.io/api/core/v1.DomainSpec":                                                         schema_kubevirtio_api_core_v1_DomainSpec(ref),
 		"kubevirt.io/api/core/v1.DownwardAPIVolumeSource":                                            schema_kubevirtio_api_core_v1_DownwardAPIVolumeSource(ref),
@@ -396,6 +398,9 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		"kubevirt.io/api/core/v1.Features":                                                           schema_kubevirtio_api_core_v1_Features(ref),
 		"kubevirt.io/api/core/v1.Filesystem":                                                         schema_kubevirtio_api_core_v1_Filesystem(ref),
 		"kubevirt.io/api/core/v1.FilesystemVirtiofs":                                                 schema_kubevirtio_api_core_v1_FilesystemVirtiofs(ref),
+		"kubevirt.io/api/core/v1.FirewallPort":                                                       schema_kubevirtio_api_core_v1_FirewallPort(ref),
+		"kubevirt.io/api/core/v1.FirewallRateLimit":                                                  schema_kubevirtio_api_core_v1_FirewallRateLimit(ref),
+		"kubevirt.io/api/core/v1.FirewallRule":                                                       schema_kubevirtio_api_core_v1_FirewallRule(ref),
 		"kubevirt.io/api/core/v1.Firmware":                                                           schema_kubevirtio_api_core_v1_Firmware(ref),
 		"kubevirt.io/api/core/v1.Flags":                                                              schema_kubevirtio_api_core_v1_Flags(ref),
 		"kubevirt.io/api/core/v1.FreezeUnfreezeTimeout":                                              schema_kubevirtio_api_core_v1_FreezeUnfreezeTimeout(ref),
@@ -403,8 +408,10 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		"kubevirt.io/api/core/v1.GenerationStatus":                                                   schema_kubevirtio_api_core_v1_GenerationStatus(ref),
 		"kubevirt.io/api/core/v1.GuestAgentCommandInfo":                                              schema_kubevirtio_api_core_v1_GuestAgentCommandInfo(ref),
 		"kubevirt.io/api/core/v1.GuestAgentPing":                                                     schema_kubevirtio_api_core_v1_GuestAgentPing(ref),
+		"kubevirt.io/api/core/v1.GuestLoadStatus":                                                    schema_kubevirtio_api_core_v1_GuestLoadStatus(ref),
 		"kubevirt.io/api/core/v1.HPETTimer":                                                          schema_kubevirtio_api_core_v1_HPETTimer(ref),
 		"kubevirt.io/api/core/v1.Handler":                                                            schema_kubevirtio_api_core_v1_Handler(ref),
+		"kubevirt.io/api/core/v1.HeldWorkloadUpdate":                                                 schema_kubevirtio_api_core_v1_HeldWorkloadUpdate(ref),
 		"kubevirt.io/api/core/v1.HostDevice":                                                         schema_kubevirtio_api_core_v1_HostDevice(ref),
 		"kubevirt.io/api/core/v1.HostDisk":                                                           schema_kubevirtio_api_core_v1_HostDisk(ref),
 		"kubevirt.io/api/core/v1.HotplugVolumeSource":                                                schema_kubevirtio_api_core_v1_HotplugVolumeSource(ref),
@@ -422,6 +429,7 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		"kubevirt.io/api/core/v1.InterfaceBindingMigration":                                          schema_kubevirtio_api_core_v1_InterfaceBindingMigration(ref),
 		"kubevirt.io/api/core/v1.InterfaceBindingPlugin":                                             schema_kubevirtio_api_core_v1_InterfaceBindingPlugin(ref),
 		"kubevirt.io/api/core/v1.InterfaceBridge":                                                    schema_kubevirtio_api_core_v1_InterfaceBridge(ref),
+		"kubevirt.io/api/core/v1.InterfaceFirewall":                                                  schema_kubevirtio_api_core_v1_InterfaceFirewall(ref),
 		"kubevirt.io/api/core/v1.InterfaceMasquerade":                                                schema_kubevirtio_api_core_v1_InterfaceMasquerade(ref),
 		"kubevirt.io/api/core/v1.InterfaceSRIOV":                                                     schema_kubevirtio_api_core_v1_InterfaceSRIOV(ref),
 		"kubevirt.io/api/core/v1.KSMConfiguration":                                                   schema_kubevirtio_api_core_v1_KSMConfiguration(ref),
@@ -450,6 +458,7 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		"kubevirt.io/api/core/v1.MemoryDumpVolumeSource":                                             schema_kubevirtio_api_core_v1_MemoryDumpVolumeSource(ref),
 		"kubevirt.io/api/core/v1.MemoryStatus":                                                       schema_kubevirtio_api_core_v1_MemoryStatus(ref),
 		"kubevirt.io/api/core/v1.MigrateOptions":                                                     schema_kubevirtio_api_core_v1_MigrateOptions(ref),
+		"kubevirt.io/api/core/v1.MigrationBlocker":                                                   schema_kubevirtio_api_core_v1_MigrationBlocker(ref),
 		"kubevirt.io/api/core/v1.MigrationConfiguration":                                             schema_kubevirtio_api_core_v1_MigrationConfiguration(ref),
 		"kubevirt.io/api/core/v1.MultusNetwork":                                                      schema_kubevirtio_api_core_v1_MultusNetwork(ref),
 		"kubevirt.io/api/core/v1.NUMA":                                                               schema_kubevirtio_api_core_v1_NUMA(ref),
@@ -469,6 +478,8 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		"kubevirt.io/api/core/v1.PluginBinding":                                                      schema_kubevirtio_api_core_v1_PluginBinding(ref),
 		"kubevirt.io/api/core/v1.PodNetwork":                                                         schema_kubevirtio_api_core_v1_PodNetwork(ref),
 		"kubevirt.io/api/core/v1.Port":                                                               schema_kubevirtio_api_core_v1_Port(ref),
+		"kubevirt.io/api/core/v1.PostMigrationVerification":                                          schema_kubevirtio_api_core_v1_PostMigrationVerification(ref),
+		"kubevirt.io/api/core/v1.PostMigrationVerificationStatus":                                    schema_kubevirtio_api_core_v1_PostMigrationVerificationStatus(ref),
 		"kubevirt.io/api/core/v1.PreferenceMatcher":                                                  schema_kubevirtio_api_core_v1_PreferenceMatcher(ref),
 		"kubevirt.io/api/core/v1.Probe":                                                              schema_kubevirtio_api_core_v1_Probe(ref),
 		"kubevirt.io/api/core/v1.ProfilerResult":                                                     schema_kubevirtio_api_core_v1_ProfilerResult(ref),
@@ -522,22 +533,31 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		"kubevirt.io/api/core/v1.VMISelector":                                                        schema_kubevirtio_api_core_v1_VMISelector(ref),
 		"kubevirt.io/api/core/v1.VSOCKOptions":                                                       schema_kubevirtio_api_core_v1_VSOCKOptions(ref),
 		"kubevirt.io/api/core/v1.VirtualMachine":                                                     schema_kubevirtio_api_core_v1_VirtualMachine(ref),
+		"kubevirt.io/api/core/v1.VirtualMachineBackupRequest":                                        schema_kubevirtio_api_core_v1_VirtualMachineBackupRequest(ref),
 		"kubevirt.io/api/core/v1.VirtualMachineCondition":                                            schema_kubevirtio_api_core_v1_VirtualMachineCondition(ref),
 		"kubevirt.io/api/core/v1.VirtualMachineInstance":                                             schema_kubevirtio_api_core_v1_VirtualMachineInstance(ref),
 		"kubevirt.io/api/core/v1.VirtualMachineInstanceCondition":                                    schema_kubevirtio_api_core_v1_VirtualMachineInstanceCondition(ref),
+		"kubevirt.io/api/core/v1.VirtualMachineInstanceCrossClusterMigrationState":                   schema_kubevirtio_api_core_v1_VirtualMachineInstanceCrossClusterMigrationState(ref),
 		"kubevirt.io/api/core/v1.VirtualMachineInstanceFileSystem":                                   schema_kubevirtio_api_core_v1_VirtualMachineInstanceFileSystem(ref),
 		"kubevirt.io/api/core/v1.VirtualMachineInstanceFileSystemDisk":                               schema_kubevirtio_api_core_v1_VirtualMachineInstanceFileSystemDisk(ref),
 		"kubevirt.io/api/core/v1.VirtualMachineInstanceFileSystemInfo":                               schema_kubevirtio_api_core_v1_VirtualMachineInstanceFileSystemInfo(ref),
 		"kubevirt.io/api/core/v1.VirtualMachineInstanceFileSystemList":                               schema_kubevirtio_api_core_v1_VirtualMachineInstanceFileSystemList(ref),
 		"kubevirt.io/api/core/v1.VirtualMachineInstanceGuestAgentInfo":                               schema_kubevirtio_api_core_v1_VirtualMachineInstanceGuestAgentInfo(ref),
+		"kubevirt.io/api/core/v1.VirtualMachineInstanceGuestExecOptions":                             schema_kubevirtio_api_core_v1_VirtualMachineInstanceGuestExecOptions(ref),
+		"kubevirt.io/api/core/v1.VirtualMachineInstanceGuestExecResult":                              schema_kubevirtio_api_core_v1_VirtualMachineInstanceGuestExecResult(ref),
 		"kubevirt.io/api/core/v1.VirtualMachineInstanceGuestOSInfo":                                  schema_kubevirtio_api_core_v1_VirtualMachineInstanceGuestOSInfo(ref),
 		"kubevirt.io/api/core/v1.VirtualMachineInstanceGuestOSUser":                                  schema_kubevirtio_api_core_v1_VirtualMachineInstanceGuestOSUser(ref),
 		"kubevirt.io/api/core/v1.VirtualMachineInstanceGuestOSUserList":                              schema_kubevirtio_api_core_v1_VirtualMachineInstanceGuestOSUserList(ref),
 		"kubevirt.io/api/core/v1.VirtualMachineInstanceList":                                         schema_kubevirtio_api_core_v1_VirtualMachineInstanceList(ref),
 		"kubevirt.io/api/core/v1.VirtualMachineInstanceMigration":                                    schema_kubevirtio_api_core_v1_VirtualMachineInstanceMigration(ref),
+		"kubevirt.io/api/core/v1.VirtualMachineInstanceMigrationAttempt":                             schema_kubevirtio_api_core_v1_VirtualMachineInstanceMigrationAttempt(ref),
+		"kubevirt.io/api/core/v1.VirtualMachineInstanceMigrationCheckResult":                         schema_kubevirtio_api_core_v1_VirtualMachineInstanceMigrationCheckResult(ref),
 		"kubevirt.io/api/core/v1.VirtualMachineInstanceMigrationCondition":                           schema_kubevirtio_api_core_v1_VirtualMachineInstanceMigrationCondition(ref),
+		"kubevirt.io/api/core/v1.VirtualMachineInstanceMigrationFailure":                             schema_kubevirtio_api_core_v1_VirtualMachineInstanceMigrationFailure(ref),
 		"kubevirt.io/api/core/v1.VirtualMachineInstanceMigrationList":                                schema_kubevirtio_api_core_v1_VirtualMachineInstanceMigrationList(ref),
 		"kubevirt.io/api/core/v1.VirtualMachineInstanceMigrationPhaseTransitionTimestamp":            schema_kubevirtio_api_core_v1_VirtualMachineInstanceMigrationPhaseTransitionTimestamp(ref),
+		"kubevirt.io/api/core/v1.VirtualMachineInstanceMigrationReceive":                             schema_kubevirtio_api_core_v1_VirtualMachineInstanceMigrationReceive(ref),
+		"kubevirt.io/api/core/v1.VirtualMachineInstanceMigrationSendTo":                              schema_kubevirtio_api_core_v1_VirtualMachineInstanceMigrationSendTo(ref),
 		"kubevirt.io/api/core/v1.VirtualMachineInstanceMigrationSpec":                                schema_kubevirtio_api_core_v1_VirtualMachineInstanceMigrationSpec(ref),
 		"kubevirt.io/api/core/v1.VirtualMachineInstanceMigrationState":                               schema_kubevirtio_api_core_v1_VirtualMachineInstanceMigrationState(ref),
 		"kubevirt.io/api/core/v1.VirtualMachineInstanceMigrationStatus":                              schema_kubevirtio_api_core_v1_VirtualMachineInstanceMigrationStatus(ref),
@@ -571,6 +591,8 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		"kubevirt.io/api/core/v1.VolumeUpdateState":                                                  schema_kubevirtio_api_core_v1_VolumeUpdateState(ref),
 		"kubevirt.io/api/core/v1.Watchdog":                                                           schema_kubevirtio_api_core_v1_Watchdog(ref),
 		"kubevirt.io/api/core/v1.WatchdogDevice":                                                     schema_kubevirtio_api_core_v1_WatchdogDevice(ref),
+		"kubevirt.io/api/core/v1.WorkloadUpdateMaintenanceWindow":                                    schema_kubevirtio_api_core_v1_WorkloadUpdateMaintenanceWindow(ref),
+		"kubevirt.io/api/core/v1.WorkloadUpdateTimeRange":                                            schema_kubevirtio_api_core_v1_WorkloadUpdateTimeRange(ref),
 		"kubevirt.io/api/export/v1alpha1.Condition":                                                  schema_kubevirtio_api_export_v1alpha1_Condition(ref),
 		"kubevirt.io/api/export/v1alpha1.VirtualMachineExport":                                       schema_kubevirtio_api_export_v1alpha1_VirtualMachineExport(ref),
 		"kubevirt.io/api/export/v1alpha1.VirtualMachineExportLink":                                   schema_kubevirtio_api_export_v1alpha1_VirtualMachineExportLink(ref),
@@ -659,10 +681,15 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		"kubevirt.io/api/migrations/v1alpha1.MigrationPolicyStatus":                                  schema_kubevirtio_api_migrations_v1alpha1_MigrationPolicyStatus(ref),
 		"kubevirt.io/api/migrations/v1alpha1.Selectors":                                              schema_kubevirtio_api_migrations_v1alpha1_Selectors(ref),
 		"kubevirt.io/api/pool/v1alpha1.VirtualMachinePool":                                           schema_kubevirtio_api_pool_v1alpha1_VirtualMachinePool(ref),
+		"kubevirt.io/api/pool/v1alpha1.VirtualMachinePoolAutoscaling":                                schema_kubevirtio_api_pool_v1alpha1_VirtualMachinePoolAutoscaling(ref),
 		"kubevirt.io/api/pool/v1alpha1.VirtualMachinePoolCondition":                                  schema_kubevirtio_api_pool_v1alpha1_VirtualMachinePoolCondition(ref),
 		"kubevirt.io/api/pool/v1alpha1.VirtualMachinePoolList":                                       schema_kubevirtio_api_pool_v1alpha1_VirtualMachinePoolList(ref),
+		"kubevirt.io/api/pool/v1alpha1.VirtualMachinePoolProactiveScaleInStrategy":                   schema_kubevirtio_api_pool_v1alpha1_VirtualMachinePoolProactiveScaleInStrategy(ref),
+		"kubevirt.io/api/pool/v1alpha1.VirtualMachinePoolRollingUpdate":                              schema_kubevirtio_api_pool_v1alpha1_VirtualMachinePoolRollingUpdate(ref),
+		"kubevirt.io/api/pool/v1alpha1.VirtualMachinePoolScaleInStrategy":                            schema_kubevirtio_api_pool_v1alpha1_VirtualMachinePoolScaleInStrategy(ref),
 		"kubevirt.io/api/pool/v1alpha1.VirtualMachinePoolSpec":                                       schema_kubevirtio_api_pool_v1alpha1_VirtualMachinePoolSpec(ref),
 		"kubevirt.io/api/pool/v1alpha1.VirtualMachinePoolStatus":                                     schema_kubevirtio_api_pool_v1alpha1_VirtualMachinePoolStatus(ref),
+		"kubevirt.io/api/pool/v1alpha1.VirtualMachinePoolUpdateStrategy":                             schema_kubevirtio_api_pool_v1alpha1_VirtualMachinePoolUpdateStrategy(ref),
 		"kubevirt.io/api/pool/v1alpha1.VirtualMachineTemplateSpec":                                   schema_kubevirtio_api_pool_v1alpha1_VirtualMachineTemplateSpec(ref),
 		"kubevirt.io/api/snapshot/v1alpha1.Condition":                                                schema_kubevirtio_api_snapshot_v1alpha1_Condition(ref),
 		"kubevirt.io/api/snapshot/v1alpha1.Error":                                                    schema_kubevirtio_api_snapshot_v1alpha1_Error(ref),
@@ -687,11 +714,20 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		"kubevirt.io/api/snapshot/v1alpha1.VolumeSnapshotStatus":                                     schema_kubevirtio_api_snapshot_v1alpha1_VolumeSnapshotStatus(ref),
 		"kubevirt.io/api/snapshot/v1beta1.Condition":                                                 schema_kubevirtio_api_snapshot_v1beta1_Condition(ref),
 		"kubevirt.io/api/snapshot/v1beta1.Error":                                                     schema_kubevirtio_api_snapshot_v1beta1_Error(ref),
+		"kubevirt.io/api/snapshot/v1beta1.MemorySnapshotStatus":                                      schema_kubevirtio_api_snapshot_v1beta1_MemorySnapshotStatus(ref),
 		"kubevirt.io/api/snapshot/v1beta1.PersistentVolumeClaim":                                     schema_kubevirtio_api_snapshot_v1beta1_PersistentVolumeClaim(ref),
+		"kubevirt.io/api/snapshot/v1beta1.ScheduledSnapshotResult":                                   schema_kubevirtio_api_snapshot_v1beta1_ScheduledSnapshotResult(ref),
 		"kubevirt.io/api/snapshot/v1beta1.SnapshotVolumesLists":                                      schema_kubevirtio_api_snapshot_v1beta1_SnapshotVolumesLists(ref),
 		"kubevirt.io/api/snapshot/v1beta1.SourceSpec":                                                schema_kubevirtio_api_snapshot_v1beta1_SourceSpec(ref),
 		"kubevirt.io/api/snapshot/v1beta1.VirtualMachine":                                            schema_kubevirtio_api_snapshot_v1beta1_VirtualMachine(ref),
 		"kubevirt.io/api/snapshot/v1beta1.VirtualMachineRestore":                                     schema_kubevirtio_api_snapshot_v1beta1_VirtualMachineRestore(ref),
+		"kubevirt.io/api/snapshot/v1beta1.VirtualMachineRestoreExportSource":                         schema_kubevirtio_api_snapshot_v1beta1_VirtualMachineRestoreExportSource(ref),
+		"kubevirt.io/api/snapshot/v1beta1.VirtualMachineRestoreGroup":                                schema_kubevirtio_api_snapshot_v1beta1_VirtualMachineRestoreGroup(ref),
+		"kubevirt.io/api/snapshot/v1beta1.VirtualMachineRestoreGroupList":                            schema_kubevirtio_api_snapshot_v1beta1_VirtualMachineRestoreGroupList(ref),
+		"kubevirt.io/api/snapshot/v1beta1.VirtualMachineRestoreGroupMember":                          schema_kubevirtio_api_snapshot_v1beta1_VirtualMachineRestoreGroupMember(ref),
+		"kubevirt.io/api/snapshot/v1beta1.VirtualMachineRestoreGroupSpec":                            schema_kubevirtio_api_snapshot_v1beta1_VirtualMachineRestoreGroupSpec(ref),
+		"kubevirt.io/api/snapshot/v1beta1.VirtualMachineRestoreGroupStatus":                          schema_kubevirtio_api_snapshot_v1beta1_VirtualMachineRestoreGroupStatus(ref),
+		"kubevirt.io/api/snapshot/v1beta1.VirtualMachineRestoreIdentity":                             schema_kubevirtio_api_snapshot_v1beta1_VirtualMachineRestoreIdentity(ref),
 		"kubevirt.io/api/snapshot/v1beta1.VirtualMachineRestoreList":                                 schema_kubevirtio_api_snapshot_v1beta1_VirtualMachineRestoreList(ref),
 		"kubevirt.io/api/snapshot/v1beta1.VirtualMachineRestoreSpec":                                 schema_kubevirtio_api_snapshot_v1beta1_VirtualMachineRestoreSpec(ref),
 		"kubevirt.io/api/snapshot/v1beta1.VirtualMachineRestoreStatus":                               schema_kubevirtio_api_snapshot_v1beta1_VirtualMachineRestoreStatus(ref),
@@ -700,9 +736,20 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		"kubevirt.io/api/snapshot/v1beta1.VirtualMachineSnapshotContentList":                         schema_kubevirtio_api_snapshot_v1beta1_VirtualMachineSnapshotContentList(ref),
 		"kubevirt.io/api/snapshot/v1beta1.VirtualMachineSnapshotContentSpec":                         schema_kubevirtio_api_snapshot_v1beta1_VirtualMachineSnapshotContentSpec(ref),
 		"kubevirt.io/api/snapshot/v1beta1.VirtualMachineSnapshotContentStatus":                       schema_kubevirtio_api_snapshot_v1beta1_VirtualMachineSnapshotContentStatus(ref),
+		"kubevirt.io/api/snapshot/v1beta1.VirtualMachineSnapshotGroup":                               schema_kubevirtio_api_snapshot_v1beta1_VirtualMachineSnapshotGroup(ref),
+		"kubevirt.io/api/snapshot/v1beta1.VirtualMachineSnapshotGroupList":                           schema_kubevirtio_api_snapshot_v1beta1_VirtualMachineSnapshotGroupList(ref),
+		"kubevirt.io/api/snapshot/v1beta1.VirtualMachineSnapshotGroupMember":                         schema_kubevirtio_api_snapshot_v1beta1_VirtualMachineSnapshotGroupMember(ref),
+		"kubevirt.io/api/snapshot/v1beta1.VirtualMachineSnapshotGroupSpec":                           schema_kubevirtio_api_snapshot_v1beta1_VirtualMachineSnapshotGroupSpec(ref),
+		"kubevirt.io/api/snapshot/v1beta1.VirtualMachineSnapshotGroupStatus":                         schema_kubevirtio_api_snapshot_v1beta1_VirtualMachineSnapshotGroupStatus(ref),
 		"kubevirt.io/api/snapshot/v1beta1.VirtualMachineSnapshotList":                                schema_kubevirtio_api_snapshot_v1beta1_VirtualMachineSnapshotList(ref),
+		"kubevirt.io/api/snapshot/v1beta1.VirtualMachineSnapshotSchedule":                            schema_kubevirtio_api_snapshot_v1beta1_VirtualMachineSnapshotSchedule(ref),
+		"kubevirt.io/api/snapshot/v1beta1.VirtualMachineSnapshotScheduleList":                        schema_kubevirtio_api_snapshot_v1beta1_VirtualMachineSnapshotScheduleList(ref),
+		"kubevirt.io/api/snapshot/v1beta1.VirtualMachineSnapshotScheduleRetention":                   schema_kubevirtio_api_snapshot_v1beta1_VirtualMachineSnapshotScheduleRetention(ref),
+		"kubevirt.io/api/snapshot/v1beta1.VirtualMachineSnapshotScheduleSpec":                        schema_kubevirtio_api_snapshot_v1beta1_VirtualMachineSnapshotScheduleSpec(ref),
+		"kubevirt.io/api/snapshot/v1beta1.VirtualMachineSnapshotScheduleStatus":                      schema_kubevirtio_api_snapshot_v1beta1_VirtualMachineSnapshotScheduleStatus(ref),
 		"kubevirt.io/api/snapshot/v1beta1.VirtualMachineSnapshotSpec":                                schema_kubevirtio_api_snapshot_v1beta1_VirtualMachineSnapshotSpec(ref),
 		"kubevirt.io/api/snapshot/v1beta1.VirtualMachineSnapshotStatus":                              schema_kubevirtio_api_snapshot_v1beta1_VirtualMachineSnapshotStatus(ref),
+		"kubevirt.io/api/snapshot/v1beta1.VirtualMachineSnapshotTemplateSpec":                        schema_kubevirtio_api_snapshot_v1beta1_VirtualMachineSnapshotTemplateSpec(ref),
 		"kubevirt.io/api/snapshot/v1beta1.VolumeBackup":                                              schema_kubevirtio_api_snapshot_v1beta1_VolumeBackup(ref),
 		"kubevirt.io/api/snapshot/v1beta1.VolumeRestore":                                             schema_kubevirtio_api_snapshot_v1beta1_VolumeRestore(ref),
 		"kubevirt.io/api/snapshot/v1beta1.VolumeSnapshotStatus":                                      schema_kubevirtio_api_snapshot_v1beta1_VolumeSnapshotStatus(ref),
@@ -17295,10 +17342,17 @@ func schema_kubevirtio_api_clone_v1beta1_VirtualMachineCloneSpec(ref common.Refe
 					},
 					"target": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Target is the outcome of the cloning process. Currently supported source types are: - VirtualMachine of kubevirt.io API group - Empty (nil). If the target is not provided, the target type would default to VirtualMachine and a random name would be generated for the target. The target's name can be viewed by inspecting status \"TargetName\" field below.",
+							Description: "Target is the outcome of the cloning process. Currently supported source types are: - VirtualMachine of kubevirt.io API group - VirtualMachineExport of export.kubevirt.io API group - Empty (nil). If the target is not provided, the target type would default to VirtualMachine and a random name would be generated for the target. The target's name can be viewed by inspecting status \"TargetName\" field below. A VirtualMachineExport target publishes the manifests and disks of the source for a VirtualMachineRestore of a peer cluster to import, which also assigns the new identity of the clone there.",
 							Ref:         ref("k8s.io/api/core/v1.TypedLocalObjectReference"),
 						},
 					},
+					"targetNamespace": {
+						SchemaProps: spec.SchemaProps{
+							Description: "TargetNamespace is the namespace a VirtualMachine target is created in, defaults to the namespace of the clone. Cloning to another namespace requires the CrossNamespaceRestore feature gate and permission to create VirtualMachines in that namespace.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
 					"annotationFilters": {
 						VendorExtensible: spec.VendorExtensible{
 							Extensions: spec.Extensions{
@@ -17734,6 +17788,63 @@ func schema_kubevirtio_api_core_v1_BIOS(ref common.ReferenceCallback) common.Ope
 	}
 }
 
+func schema_kubevirtio_api_core_v1_BackupVolumeSource(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Type: []string{"object"},
+				Properties: map[string]spec.Schema{
+					"claimName": {
+						SchemaProps: spec.SchemaProps{
+							Description: "claimName is the name of a PersistentVolumeClaim in the same namespace as the pod using this volume. More info: https://kubernetes.io/docs/concepts/storage/persistent-volumes#persistentvolumeclaims",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"readOnly": {
+						SchemaProps: spec.SchemaProps{
+							Description: "readOnly Will force the ReadOnly setting in VolumeMounts. Default false.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"hotpluggable": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Hotpluggable indicates whether the volume can be hotplugged and hotunplugged.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"backupName": {
+						SchemaProps: spec.SchemaProps{
+							Description: "BackupName is the name of the backup and of the checkpoint created with it",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"incrementalFrom": {
+						SchemaProps: spec.SchemaProps{
+							Description: "IncrementalFrom is the name of the checkpoint of a previous backup, only the blocks changed since that checkpoint are backed up. A full backup is taken if empty.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"format": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Format is the format of the disk backup images",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"claimName", "backupName"},
+			},
+		},
+	}
+}
+
 func schema_kubevirtio_api_core_v1_BlockSize(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -19358,6 +19469,47 @@ func schema_kubevirtio_api_core_v1_DiskVerification(ref common.ReferenceCallback
 	}
 }
 
+func schema_kubevirtio_api_core_v1_DomainBackupInfo(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "DomainBackupInfo represents the backup information",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"startTimestamp": {
+						SchemaProps: spec.SchemaProps{
+							Description: "StartTimestamp is the time when the backup started",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+					"endTimestamp": {
+						SchemaProps: spec.SchemaProps{
+							Description: "EndTimestamp is the time when the backup completed",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+					"claimName": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ClaimName is the name of the pvc the backup was written to",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"backupName": {
+						SchemaProps: spec.SchemaProps{
+							Description: "BackupName is the name of the backup and of the checkpoint created with it",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
+	}
+}
+
 func schema_kubevirtio_api_core_v1_DomainMemoryDumpInfo(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -19927,6 +20079,138 @@ func schema_kubevirtio_api_core_v1_FilesystemVirtiofs(ref common.ReferenceCallba
 	}
 }
 
+func schema_kubevirtio_api_core_v1_FirewallPort(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "FirewallPort is a port or a range of ports",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"port": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Port is the port, or the first port of the range",
+							Default:     0,
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"endPort": {
+						SchemaProps: spec.SchemaProps{
+							Description: "EndPort is the last port of the range",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+				},
+				Required: []string{"port"},
+			},
+		},
+	}
+}
+
+func schema_kubevirtio_api_core_v1_FirewallRateLimit(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "FirewallRateLimit is a rate of packets",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"packetsPerSecond": {
+						SchemaProps: spec.SchemaProps{
+							Description: "PacketsPerSecond is the number of packets allowed per second",
+							Default:     0,
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"burst": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Burst is the number of packets allowed to exceed the rate at once. Defaults to 5",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+				},
+				Required: []string{"packetsPerSecond"},
+			},
+		},
+	}
+}
+
+func schema_kubevirtio_api_core_v1_FirewallRule(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "FirewallRule matches traffic of an interface. All of its fields have to match.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"cidrs": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "atomic",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "CIDRs are the networks the traffic comes from for ingress rules, or goes to for egress rules. Any address matches if empty",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"protocol": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Protocol of the traffic, one of TCP, UDP, SCTP or ICMP. Any protocol matches if empty",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"ports": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "atomic",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "Ports are the destination ports of the traffic. They require the TCP, UDP or SCTP protocol. Any port matches if empty",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("kubevirt.io/api/core/v1.FirewallPort"),
+									},
+								},
+							},
+						},
+					},
+					"rateLimit": {
+						SchemaProps: spec.SchemaProps{
+							Description: "RateLimit limits the rate of the traffic the rule accepts. The traffic above the limit is evaluated by the following rules",
+							Ref:         ref("kubevirt.io/api/core/v1.FirewallRateLimit"),
+						},
+					},
+					"action": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Action is applied to the matching traffic. Defaults to Accept",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"kubevirt.io/api/core/v1.FirewallPort", "kubevirt.io/api/core/v1.FirewallRateLimit"},
+	}
+}
+
 func schema_kubevirtio_api_core_v1_Firmware(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -20192,6 +20476,41 @@ func schema_kubevirtio_api_core_v1_GuestAgentPing(ref common.ReferenceCallback)
 	}
 }
 
+func schema_kubevirtio_api_core_v1_GuestLoadStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "GuestLoadStatus holds the load of the guest of a VMI",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"cpuUtilizationPercentage": {
+						SchemaProps: spec.SchemaProps{
+							Description: "CPUUtilizationPercentage is the time the vCPUs of the guest were busy since the previous sample, in percent of the time they were available.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"memoryUtilizationPercentage": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MemoryUtilizationPercentage is the memory of the guest which is in use and can't be reclaimed, in percent of the memory available to the guest. It requires the memory balloon device to be reported.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"lastProbeTime": {
+						SchemaProps: spec.SchemaProps{
+							Description: "LastProbeTime is the time the guest load was sampled.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
+	}
+}
+
 func schema_kubevirtio_api_core_v1_HPETTimer(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -20257,6 +20576,50 @@ func schema_kubevirtio_api_core_v1_Handler(ref common.ReferenceCallback) common.
 	}
 }
 
+func schema_kubevirtio_api_core_v1_HeldWorkloadUpdate(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "HeldWorkloadUpdate reports when the automated workload update of an outdated VMI happens",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"namespace": {
+						SchemaProps: spec.SchemaProps{
+							Default: "",
+							Type:    []string{"string"},
+							Format:  "",
+						},
+					},
+					"name": {
+						SchemaProps: spec.SchemaProps{
+							Default: "",
+							Type:    []string{"string"},
+							Format:  "",
+						},
+					},
+					"reason": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Reason tells why the update is held back",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"scheduledTime": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ScheduledTime is the time from which on the VMI is updated. It is unset if the VMI is not updated automatically, or if none of its maintenance windows opens again",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+				},
+				Required: []string{"namespace", "name", "reason"},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
+	}
+}
+
 func schema_kubevirtio_api_core_v1_HostDevice(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -20713,12 +21076,18 @@ func schema_kubevirtio_api_core_v1_Interface(ref common.ReferenceCallback) commo
 							Format:      "",
 						},
 					},
+					"firewall": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Firewall restricts the traffic of the interface. It is enforced with nftables in the virt-launcher pod, for interfaces with the bridge or masquerade binding.",
+							Ref:         ref("kubevirt.io/api/core/v1.InterfaceFirewall"),
+						},
+					},
 				},
 				Required: []string{"name"},
 			},
 		},
 		Dependencies: []string{
-			"kubevirt.io/api/core/v1.DHCPOptions", "kubevirt.io/api/core/v1.DeprecatedInterfaceMacvtap", "kubevirt.io/api/core/v1.DeprecatedInterfacePasst", "kubevirt.io/api/core/v1.DeprecatedInterfaceSlirp", "kubevirt.io/api/core/v1.InterfaceBridge", "kubevirt.io/api/core/v1.InterfaceMasquerade", "kubevirt.io/api/core/v1.InterfaceSRIOV", "kubevirt.io/api/core/v1.PluginBinding", "kubevirt.io/api/core/v1.Port"},
+			"kubevirt.io/api/core/v1.DHCPOptions", "kubevirt.io/api/core/v1.DeprecatedInterfaceMacvtap", "kubevirt.io/api/core/v1.DeprecatedInterfacePasst", "kubevirt.io/api/core/v1.DeprecatedInterfaceSlirp", "kubevirt.io/api/core/v1.InterfaceBridge", "kubevirt.io/api/core/v1.InterfaceFirewall", "kubevirt.io/api/core/v1.InterfaceMasquerade", "kubevirt.io/api/core/v1.InterfaceSRIOV", "kubevirt.io/api/core/v1.PluginBinding", "kubevirt.io/api/core/v1.Port"},
 	}
 }
 
@@ -20854,6 +21223,73 @@ func schema_kubevirtio_api_core_v1_InterfaceBridge(ref common.ReferenceCallback)
 	}
 }
 
+func schema_kubevirtio_api_core_v1_InterfaceFirewall(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "InterfaceFirewall holds the rules restricting the traffic of an interface. The rules of a direction are evaluated in order, the first matching rule decides. Replies to accepted traffic are always accepted, and so are ARP, IPv6 neighbor discovery and DHCP with the bridge binding.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"ingress": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "atomic",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "Ingress are the rules for the traffic to the guest",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("kubevirt.io/api/core/v1.FirewallRule"),
+									},
+								},
+							},
+						},
+					},
+					"egress": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "atomic",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "Egress are the rules for the traffic from the guest",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("kubevirt.io/api/core/v1.FirewallRule"),
+									},
+								},
+							},
+						},
+					},
+					"ingressDefaultAction": {
+						SchemaProps: spec.SchemaProps{
+							Description: "IngressDefaultAction is applied to the traffic to the guest which matches none of the ingress rules. Defaults to Drop if there are ingress rules, Accept otherwise",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"egressDefaultAction": {
+						SchemaProps: spec.SchemaProps{
+							Description: "EgressDefaultAction is applied to the traffic from the guest which matches none of the egress rules. Defaults to Drop if there are egress rules, Accept otherwise",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"kubevirt.io/api/core/v1.FirewallRule"},
+	}
+}
+
 func schema_kubevirtio_api_core_v1_InterfaceMasquerade(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -21426,6 +21862,26 @@ func schema_kubevirtio_api_core_v1_KubeVirtConfiguration(ref common.ReferenceCal
 							Ref:         ref("kubevirt.io/api/core/v1.InstancetypeConfiguration"),
 						},
 					},
+					"vmRestoreImportAllowedHosts": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "set",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "VMRestoreImportAllowedHosts lists the hosts VirtualMachineRestores may import VirtualMachineExports of other clusters from. An entry is a host name, or \"*.\" followed by a domain to allow all of its subdomains. Imports are refused while the list is empty.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
 				},
 			},
 		},
@@ -21785,11 +22241,30 @@ func schema_kubevirtio_api_core_v1_KubeVirtStatus(ref common.ReferenceCallback)
 							},
 						},
 					},
+					"heldWorkloadUpdates": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "atomic",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "HeldWorkloadUpdates lists the outdated VMIs whose automated workload update is held back by a maintenance window or their annotations, and when they are updated. Outdated VMIs which are not listed are updated as soon as the batch settings allow. At most 100 VMIs are listed",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("kubevirt.io/api/core/v1.HeldWorkloadUpdate"),
+									},
+								},
+							},
+						},
+					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"kubevirt.io/api/core/v1.GenerationStatus", "kubevirt.io/api/core/v1.KubeVirtCondition"},
+			"kubevirt.io/api/core/v1.GenerationStatus", "kubevirt.io/api/core/v1.HeldWorkloadUpdate", "kubevirt.io/api/core/v1.KubeVirtCondition"},
 	}
 }
 
@@ -21833,11 +22308,30 @@ func schema_kubevirtio_api_core_v1_KubeVirtWorkloadUpdateStrategy(ref common.Ref
 							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Duration"),
 						},
 					},
+					"maintenanceWindows": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "atomic",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "MaintenanceWindows restrict the automated workload updates of the VMIs they select to the times the windows are open. VMIs which are not selected by any window are updated at any time, VMIs selected by several windows while any of them is open",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("kubevirt.io/api/core/v1.WorkloadUpdateMaintenanceWindow"),
+									},
+								},
+							},
+						},
+					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"k8s.io/apimachinery/pkg/apis/meta/v1.Duration"},
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Duration", "kubevirt.io/api/core/v1.WorkloadUpdateMaintenanceWindow"},
 	}
 }
 
@@ -22174,6 +22668,13 @@ func schema_kubevirtio_api_core_v1_MemoryDumpVolumeSource(ref common.ReferenceCa
 							Format:      "",
 						},
 					},
+					"format": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Format is the format of the memory dump, defaults to raw",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
 				},
 				Required: []string{"claimName"},
 			},
@@ -22254,7 +22755,68 @@ func schema_kubevirtio_api_core_v1_MigrateOptions(ref common.ReferenceCallback)
 							},
 						},
 					},
+					"addedNodeSelector": {
+						SchemaProps: spec.SchemaProps{
+							Description: "AddedNodeSelector is an additional selector that can be used to complement a NodeSelector or NodeAffinity as set on the VM to restrict the set of allowed target nodes for a migration. In case of key collisions, values set on the VM objects are going to be preserved to ensure that addedNodeSelector can only restrict but not bypass constraints already set on the VM object.",
+							Type:        []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"addedNodeAffinity": {
+						SchemaProps: spec.SchemaProps{
+							Description: "AddedNodeAffinity is an additional node affinity for the target of a migration. Its required node selector terms are combined with the ones of the VM, so that they can only restrict the set of allowed target nodes. Its preferred scheduling terms are added to the ones of the VM.",
+							Ref:         ref("k8s.io/api/core/v1.NodeAffinity"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/api/core/v1.NodeAffinity"},
+	}
+}
+
+func schema_kubevirtio_api_core_v1_MigrationBlocker(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "MigrationBlocker describes why a VirtualMachineInstance can not be live migrated",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"type": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Type is the kind of check which found the blocker",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"reason": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Reason is a brief CamelCase string that describes the blocker",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"message": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Message is a human readable description of the blocker",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
 				},
+				Required: []string{"type", "message"},
 			},
 		},
 	}
@@ -22350,6 +22912,32 @@ func schema_kubevirtio_api_core_v1_MigrationConfiguration(ref common.ReferenceCa
 							Format:      "",
 						},
 					},
+					"additionalNetworks": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "set",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "AdditionalNetworks are the names of further CNI networks virt-handler is connected to. Migration policies can select any of them, or Network, as the network of their migrations",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"bandwidthPerNode": {
+						SchemaProps: spec.SchemaProps{
+							Description: "BandwidthPerNode limits the amount of network bandwidth all outgoing live migrations of a node are allowed to use together. It is enforced by the migration proxy of virt-handler, which also enforces BandwidthPerMigration. The value is in quantity per second. Defaults to 0 (no limit)",
+							Ref:         ref("k8s.io/apimachinery/pkg/api/resource.Quantity"),
+						},
+					},
 					"matchSELinuxLevelOnMigration": {
 						SchemaProps: spec.SchemaProps{
 							Description: "By default, the SELinux level of target virt-launcher pods is forced to the level of the source virt-launcher. When set to true, MatchSELinuxLevelOnMigration lets the CRI auto-assign a random level to the target. That will ensure the target virt-launcher doesn't share categories with another pod on the node. However, migrations will fail when using RWX volumes that don't automatically deal with SELinux levels.",
@@ -22357,11 +22945,72 @@ func schema_kubevirtio_api_core_v1_MigrationConfiguration(ref common.ReferenceCa
 							Format:      "",
 						},
 					},
+					"parallelMigrationThreads": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ParallelMigrationThreads is the number of parallel (multifd) connections a live migration transfers the guest memory over. 0 disables parallel connections. By default, 8 connections are used unless the VMI has CPU limits. Parallel connections are never used with post-copy",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+					"compression": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Compression is the algorithm compressing the guest memory sent over the parallel connections of a live migration. It has no effect on migrations without parallel connections. Defaults to none",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"xbzrleCacheSize": {
+						SchemaProps: spec.SchemaProps{
+							Description: "XBZRLECacheSize enables the XBZRLE compression of memory pages which are dirtied again during a live migration and sets the size of its page cache. It has no effect on migrations with parallel connections. Defaults to 0 (disabled)",
+							Ref:         ref("k8s.io/apimachinery/pkg/api/resource.Quantity"),
+						},
+					},
+					"maxDowntimeMilliseconds": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MaxDowntimeMilliseconds is the longest time a VMI may be paused when a live migration switches over to the target. Defaults to the hypervisor default of 300",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+					"maxRetries": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MaxRetries is the number of times a failed migration is retried. Migrations failing because they were aborted or because of the guest are not retried. Defaults to 0 (no retries)",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"retryBackoffSeconds": {
+						SchemaProps: spec.SchemaProps{
+							Description: "RetryBackoffSeconds is the number of seconds to wait before the first retry of a failed migration. The delay is doubled for every further retry. Defaults to 10",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+					"nonConvergenceEscalation": {
+						SchemaProps: spec.SchemaProps{
+							Description: "NonConvergenceEscalation is the mode a migration which did not converge is retried in. Defaults to none, which retries the migration with the same settings",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"blockMigrateLocalVolumes": {
+						SchemaProps: spec.SchemaProps{
+							Description: "BlockMigrateLocalVolumes allows to live migrate VMIs with volumes which are local to their node. ReadWriteOnce PVCs are copied to new PVCs of the same storage class, which are provisioned for the target node, and non-shared hostDisks of type DiskOrCreate are copied to a new disk image on the target node. The storage class should use the WaitForFirstConsumer binding mode. Once the migration succeeded, VirtualMachines are updated to use the new PVCs, the DataVolumeTemplates of the copied volumes are dropped, and the source PVCs, or the DataVolumes they belong to, are deleted. Defaults to false",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"postMigrationVerification": {
+						SchemaProps: spec.SchemaProps{
+							Description: "PostMigrationVerification checks that the guest is healthy on the target once a live migration succeeded, and tells what to do if it is not. Defaults to none (no verification)",
+							Ref:         ref("kubevirt.io/api/core/v1.PostMigrationVerification"),
+						},
+					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"k8s.io/apimachinery/pkg/api/resource.Quantity"},
+			"k8s.io/apimachinery/pkg/api/resource.Quantity", "kubevirt.io/api/core/v1.PostMigrationVerification"},
 	}
 }
 
@@ -23055,6 +23704,120 @@ func schema_kubevirtio_api_core_v1_Port(ref common.ReferenceCallback) common.Ope
 	}
 }
 
+func schema_kubevirtio_api_core_v1_PostMigrationVerification(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "PostMigrationVerification configures the checks the guest has to pass on the target of a migration",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"checks": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "set",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "Checks are the checks which all have to pass within the timeout",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"timeoutSeconds": {
+						SchemaProps: spec.SchemaProps{
+							Description: "TimeoutSeconds is the number of seconds after the end of the migration within which the checks have to pass. Defaults to 60",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+					"networkCheckPort": {
+						SchemaProps: spec.SchemaProps{
+							Description: "NetworkCheckPort is the TCP port of the guest the Network check connects to. The guest is reachable if it accepts or refuses the connection. Defaults to 22",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"failureAction": {
+						SchemaProps: spec.SchemaProps{
+							Description: "FailureAction is what to do when the checks did not pass within the timeout. Defaults to Report",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"checks"},
+			},
+		},
+	}
+}
+
+func schema_kubevirtio_api_core_v1_PostMigrationVerificationStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "PostMigrationVerificationStatus reports the checks of the guest on the target after a migration",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"phase": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"failedChecks": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "atomic",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "FailedChecks are the checks which did not pass (yet)",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"message": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"startTimestamp": {
+						SchemaProps: spec.SchemaProps{
+							Description: "StartTimestamp is the time the verification started",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+					"endTimestamp": {
+						SchemaProps: spec.SchemaProps{
+							Description: "EndTimestamp is the time the verification succeeded or failed",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
+	}
+}
+
 func schema_kubevirtio_api_core_v1_PreferenceMatcher(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -24754,6 +25517,85 @@ func schema_kubevirtio_api_core_v1_VirtualMachine(ref common.ReferenceCallback)
 	}
 }
 
+func schema_kubevirtio_api_core_v1_VirtualMachineBackupRequest(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "VirtualMachineBackupRequest represent the backup request phase and info",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"claimName": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ClaimName is the name of the pvc that will contain the backup",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"backupName": {
+						SchemaProps: spec.SchemaProps{
+							Description: "BackupName is the name of the backup. A checkpoint with the same name is created, and can be used as the base of a following incremental backup",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"incrementalFrom": {
+						SchemaProps: spec.SchemaProps{
+							Description: "IncrementalFrom is the name of the checkpoint of a previous backup, only the blocks changed since that checkpoint are backed up. A full backup is taken if empty.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"format": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Format is the format of the disk backup images, defaults to qcow2. Incremental backups are always qcow2.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"phase": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Phase represents the backup phase",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"remove": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Remove represents request of dissociating the backup pvc",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"startTimestamp": {
+						SchemaProps: spec.SchemaProps{
+							Description: "StartTimestamp represents the time the backup started",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+					"endTimestamp": {
+						SchemaProps: spec.SchemaProps{
+							Description: "EndTimestamp represents the time the backup was completed",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+					"message": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Message is a detailed message about failure of the backup",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"claimName", "phase"},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
+	}
+}
+
 func schema_kubevirtio_api_core_v1_VirtualMachineCondition(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -24906,6 +25748,35 @@ func schema_kubevirtio_api_core_v1_VirtualMachineInstanceCondition(ref common.Re
 	}
 }
 
+func schema_kubevirtio_api_core_v1_VirtualMachineInstanceCrossClusterMigrationState(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "VirtualMachineInstanceCrossClusterMigrationState holds the details of a migration between two clusters",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"migrationID": {
+						SchemaProps: spec.SchemaProps{
+							Description: "The ID shared by the sending and the receiving migration",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"connectURL": {
+						SchemaProps: spec.SchemaProps{
+							Description: "The address of the migration endpoint of the receiving cluster, only set on the sending side",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"migrationID"},
+			},
+		},
+	}
+}
+
 func schema_kubevirtio_api_core_v1_VirtualMachineInstanceFileSystem(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -25179,6 +26050,105 @@ func schema_kubevirtio_api_core_v1_VirtualMachineInstanceGuestAgentInfo(ref comm
 	}
 }
 
+func schema_kubevirtio_api_core_v1_VirtualMachineInstanceGuestExecOptions(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "VirtualMachineInstanceGuestExecOptions is provided when executing a command on the guest through the guest agent",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"command": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Command is the path of the executable to run on the guest",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"args": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "atomic",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "Args are the arguments passed to the command",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"timeoutSeconds": {
+						SchemaProps: spec.SchemaProps{
+							Description: "TimeoutSeconds is the time to wait for the command to exit. Defaults to 30, and can be at most 300.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+				},
+				Required: []string{"command"},
+			},
+		},
+	}
+}
+
+func schema_kubevirtio_api_core_v1_VirtualMachineInstanceGuestExecResult(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "VirtualMachineInstanceGuestExecResult is the outcome of a command executed on the guest through the guest agent. The output is not streamed: the guest agent only hands it out once the command exited, so it is returned at once together with the exit code.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"exitCode": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ExitCode is the exit code of the command",
+							Default:     0,
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"stdout": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Stdout is what the command wrote to its standard output",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"stderr": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Stderr is what the command wrote to its standard error",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"exitCode"},
+			},
+		},
+	}
+}
+
 func schema_kubevirtio_api_core_v1_VirtualMachineInstanceGuestOSInfo(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -25426,6 +26396,149 @@ func schema_kubevirtio_api_core_v1_VirtualMachineInstanceMigration(ref common.Re
 	}
 }
 
+func schema_kubevirtio_api_core_v1_VirtualMachineInstanceMigrationAttempt(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "VirtualMachineInstanceMigrationAttempt records a finished attempt to migrate a VMI",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"migrationName": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MigrationName is the name of the migration which made the attempt",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"startTimestamp": {
+						SchemaProps: spec.SchemaProps{
+							Ref: ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+					"endTimestamp": {
+						SchemaProps: spec.SchemaProps{
+							Ref: ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+					"sourceNode": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"targetNode": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"phase": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Phase is the final phase of the attempt",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"escalation": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Escalation is the more aggressive mode the attempt was made in, if any",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"failure": {
+						SchemaProps: spec.SchemaProps{
+							Ref: ref("kubevirt.io/api/core/v1.VirtualMachineInstanceMigrationFailure"),
+						},
+					},
+				},
+				Required: []string{"migrationName", "phase"},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Time", "kubevirt.io/api/core/v1.VirtualMachineInstanceMigrationFailure"},
+	}
+}
+
+func schema_kubevirtio_api_core_v1_VirtualMachineInstanceMigrationCheckResult(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "VirtualMachineInstanceMigrationCheckResult is the outcome of checking whether a VirtualMachineInstance can be live migrated",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"migratable": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Migratable is true when nothing blocks the migration",
+							Default:     false,
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"blockers": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "atomic",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "Blockers are the reasons preventing the migration",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("kubevirt.io/api/core/v1.MigrationBlocker"),
+									},
+								},
+							},
+						},
+					},
+					"targetNodes": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "atomic",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "TargetNodes are the nodes the target pod of the migration could be scheduled to",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+				},
+				Required: []string{"migratable"},
+			},
+		},
+		Dependencies: []string{
+			"kubevirt.io/api/core/v1.MigrationBlocker"},
+	}
+}
+
 func schema_kubevirtio_api_core_v1_VirtualMachineInstanceMigrationCondition(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -25477,6 +26590,34 @@ func schema_kubevirtio_api_core_v1_VirtualMachineInstanceMigrationCondition(ref
 	}
 }
 
+func schema_kubevirtio_api_core_v1_VirtualMachineInstanceMigrationFailure(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "VirtualMachineInstanceMigrationFailure describes why a migration failed",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"class": {
+						SchemaProps: spec.SchemaProps{
+							Default: "",
+							Type:    []string{"string"},
+							Format:  "",
+						},
+					},
+					"message": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Message is the failure reason as reported by the source or the controller",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"class"},
+			},
+		},
+	}
+}
+
 func schema_kubevirtio_api_core_v1_VirtualMachineInstanceMigrationList(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -25554,6 +26695,66 @@ func schema_kubevirtio_api_core_v1_VirtualMachineInstanceMigrationPhaseTransitio
 	}
 }
 
+func schema_kubevirtio_api_core_v1_VirtualMachineInstanceMigrationReceive(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "VirtualMachineInstanceMigrationReceive describes a migration received from another cluster",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"migrationID": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MigrationID identifies the migration, it has to match the ID of the sending migration",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"virtualMachine": {
+						SchemaProps: spec.SchemaProps{
+							Description: "VirtualMachine is the spec of the VirtualMachine created to own the received VMI, usually the one of the VirtualMachine in the sending cluster. Its disks have to exist in this cluster already, DataVolumeTemplates are not supported.",
+							Ref:         ref("kubevirt.io/api/core/v1.VirtualMachineSpec"),
+						},
+					},
+				},
+				Required: []string{"migrationID", "virtualMachine"},
+			},
+		},
+		Dependencies: []string{
+			"kubevirt.io/api/core/v1.VirtualMachineSpec"},
+	}
+}
+
+func schema_kubevirtio_api_core_v1_VirtualMachineInstanceMigrationSendTo(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "VirtualMachineInstanceMigrationSendTo describes where to migrate a VMI to in another cluster",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"migrationID": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MigrationID identifies the migration, it has to match the ID of the receiving migration",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"connectURL": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ConnectURL is the host:port of the migration endpoint of the receiving cluster, as reported in the status of the receiving migration and exposed to this cluster",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"migrationID", "connectURL"},
+			},
+		},
+	}
+}
+
 func schema_kubevirtio_api_core_v1_VirtualMachineInstanceMigrationSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -25567,9 +26768,52 @@ func schema_kubevirtio_api_core_v1_VirtualMachineInstanceMigrationSpec(ref commo
 							Format:      "",
 						},
 					},
+					"addedNodeSelector": {
+						SchemaProps: spec.SchemaProps{
+							Description: "AddedNodeSelector is an additional selector that can be used to complement a NodeSelector or NodeAffinity as set on the VM to restrict the set of allowed target nodes for a migration. In case of key collisions, values set on the VM objects are going to be preserved to ensure that addedNodeSelector can only restrict but not bypass constraints already set on the VM object.",
+							Type:        []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"addedNodeAffinity": {
+						SchemaProps: spec.SchemaProps{
+							Description: "AddedNodeAffinity is an additional node affinity for the target of a migration. Its required node selector terms are combined with the ones of the VM, so that they can only restrict the set of allowed target nodes. Its preferred scheduling terms are added to the ones of the VM.",
+							Ref:         ref("k8s.io/api/core/v1.NodeAffinity"),
+						},
+					},
+					"sendTo": {
+						SchemaProps: spec.SchemaProps{
+							Description: "SendTo migrates the VMI to another cluster, where a migration with a matching receive section waits for it. Once the migration succeeded, the VMI is stopped in this cluster.",
+							Ref:         ref("kubevirt.io/api/core/v1.VirtualMachineInstanceMigrationSendTo"),
+						},
+					},
+					"receive": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Receive creates the VM named by vmiName in this cluster, with a VMI which waits to receive a migration from another cluster instead of being started.",
+							Ref:         ref("kubevirt.io/api/core/v1.VirtualMachineInstanceMigrationReceive"),
+						},
+					},
+					"priority": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Priority of the migration. Pending migrations with a higher priority are started first. Migrations with the same priority are started in turns for evacuations, workload updates and user requests, so that none of them can starve the others. Defaults to 0. A priority above 0 requires the permission to prioritize virtualmachineinstancemigrations cluster wide, since the queue is shared by all namespaces.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
 				},
 			},
 		},
+		Dependencies: []string{
+			"k8s.io/api/core/v1.NodeAffinity", "kubevirt.io/api/core/v1.VirtualMachineInstanceMigrationReceive", "kubevirt.io/api/core/v1.VirtualMachineInstanceMigrationSendTo"},
 	}
 }
 
@@ -25696,6 +26940,13 @@ func schema_kubevirtio_api_core_v1_VirtualMachineInstanceMigrationState(ref comm
 							Format:      "",
 						},
 					},
+					"failureClass": {
+						SchemaProps: spec.SchemaProps{
+							Description: "The kind of problem the migration failed on, as seen by the source",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
 					"migrationUid": {
 						SchemaProps: spec.SchemaProps{
 							Description: "The VirtualMachineInstanceMigration object associated with this migration",
@@ -25764,11 +27015,17 @@ func schema_kubevirtio_api_core_v1_VirtualMachineInstanceMigrationState(ref comm
 							Format:      "",
 						},
 					},
+					"crossCluster": {
+						SchemaProps: spec.SchemaProps{
+							Description: "If the VMI is migrated to or from another cluster, the details of the cross cluster migration are saved here",
+							Ref:         ref("kubevirt.io/api/core/v1.VirtualMachineInstanceCrossClusterMigrationState"),
+						},
+					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"k8s.io/apimachinery/pkg/apis/meta/v1.Time", "kubevirt.io/api/core/v1.MigrationConfiguration"},
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Time", "kubevirt.io/api/core/v1.MigrationConfiguration", "kubevirt.io/api/core/v1.VirtualMachineInstanceCrossClusterMigrationState"},
 	}
 }
 
@@ -25823,11 +27080,56 @@ func schema_kubevirtio_api_core_v1_VirtualMachineInstanceMigrationStatus(ref com
 							Ref:         ref("kubevirt.io/api/core/v1.VirtualMachineInstanceMigrationState"),
 						},
 					},
+					"connectURL": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ConnectURL is the address of the LoadBalancer Service exposing the migration endpoint of a receiving migration, to be set as sendTo.connectURL of the sending migration.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"queuePosition": {
+						SchemaProps: spec.SchemaProps{
+							Description: "QueuePosition is the position of a pending migration in the cluster wide queue of migrations waiting for a free migration slot, starting with 1. It is only set while the migration is pending.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"failure": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Failure classifies why the migration failed",
+							Ref:         ref("kubevirt.io/api/core/v1.VirtualMachineInstanceMigrationFailure"),
+						},
+					},
+					"attemptHistory": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "atomic",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "AttemptHistory records the finished attempts to migrate the VMI, starting with the first migration and ending with this one when migrations are retried.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("kubevirt.io/api/core/v1.VirtualMachineInstanceMigrationAttempt"),
+									},
+								},
+							},
+						},
+					},
+					"postMigrationVerification": {
+						SchemaProps: spec.SchemaProps{
+							Description: "PostMigrationVerification reports the checks of the guest on the target after the migration succeeded",
+							Ref:         ref("kubevirt.io/api/core/v1.PostMigrationVerificationStatus"),
+						},
+					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"kubevirt.io/api/core/v1.VirtualMachineInstanceMigrationCondition", "kubevirt.io/api/core/v1.VirtualMachineInstanceMigrationPhaseTransitionTimestamp", "kubevirt.io/api/core/v1.VirtualMachineInstanceMigrationState"},
+			"kubevirt.io/api/core/v1.PostMigrationVerificationStatus", "kubevirt.io/api/core/v1.VirtualMachineInstanceMigrationAttempt", "kubevirt.io/api/core/v1.VirtualMachineInstanceMigrationCondition", "kubevirt.io/api/core/v1.VirtualMachineInstanceMigrationFailure", "kubevirt.io/api/core/v1.VirtualMachineInstanceMigrationPhaseTransitionTimestamp", "kubevirt.io/api/core/v1.VirtualMachineInstanceMigrationState"},
 	}
 }
 
@@ -25908,6 +27210,33 @@ func schema_kubevirtio_api_core_v1_VirtualMachineInstanceNetworkInterface(ref co
 							Format:      "",
 						},
 					},
+					"firewallRules": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "atomic",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "FirewallRules are the nftables rules enforcing the firewall of the interface in the virt-launcher pod",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"hotUnplugPhase": {
+						SchemaProps: spec.SchemaProps{
+							Description: "HotUnplugPhase reports the progress of the interface hot-unplug, once it was requested. values: Requested, DetachedInGuest, PodNetworkRemoved.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
 				},
 			},
 		},
@@ -26776,11 +28105,17 @@ func schema_kubevirtio_api_core_v1_VirtualMachineInstanceStatus(ref common.Refer
 							},
 						},
 					},
+					"guestLoad": {
+						SchemaProps: spec.SchemaProps{
+							Description: "GuestLoad shows the load of the guest, as last sampled by virt-handler. It is only reported for VMIs of VirtualMachinePools, which autoscale on it.",
+							Ref:         ref("kubevirt.io/api/core/v1.GuestLoadStatus"),
+						},
+					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"kubevirt.io/api/core/v1.CPUTopology", "kubevirt.io/api/core/v1.KernelBootStatus", "kubevirt.io/api/core/v1.Machine", "kubevirt.io/api/core/v1.MemoryStatus", "kubevirt.io/api/core/v1.StorageMigratedVolumeInfo", "kubevirt.io/api/core/v1.TopologyHints", "kubevirt.io/api/core/v1.VirtualMachineInstanceCondition", "kubevirt.io/api/core/v1.VirtualMachineInstanceGuestOSInfo", "kubevirt.io/api/core/v1.VirtualMachineInstanceMigrationState", "kubevirt.io/api/core/v1.VirtualMachineInstanceNetworkInterface", "kubevirt.io/api/core/v1.VirtualMachineInstancePhaseTransitionTimestamp", "kubevirt.io/api/core/v1.VolumeStatus"},
+			"kubevirt.io/api/core/v1.CPUTopology", "kubevirt.io/api/core/v1.GuestLoadStatus", "kubevirt.io/api/core/v1.KernelBootStatus", "kubevirt.io/api/core/v1.Machine", "kubevirt.io/api/core/v1.MemoryStatus", "kubevirt.io/api/core/v1.StorageMigratedVolumeInfo", "kubevirt.io/api/core/v1.TopologyHints", "kubevirt.io/api/core/v1.VirtualMachineInstanceCondition", "kubevirt.io/api/core/v1.VirtualMachineInstanceGuestOSInfo", "kubevirt.io/api/core/v1.VirtualMachineInstanceMigrationState", "kubevirt.io/api/core/v1.VirtualMachineInstanceNetworkInterface", "kubevirt.io/api/core/v1.VirtualMachineInstancePhaseTransitionTimestamp", "kubevirt.io/api/core/v1.VolumeStatus"},
 	}
 }
 
@@ -26915,6 +28250,13 @@ func schema_kubevirtio_api_core_v1_VirtualMachineMemoryDumpRequest(ref common.Re
 							Format:      "",
 						},
 					},
+					"format": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Format is the format of the memory dump, defaults to raw",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
 				},
 				Required: []string{"claimName", "phase"},
 			},
@@ -27211,6 +28553,12 @@ func schema_kubevirtio_api_core_v1_VirtualMachineStatus(ref common.ReferenceCall
 							Ref:         ref("kubevirt.io/api/core/v1.VirtualMachineMemoryDumpRequest"),
 						},
 					},
+					"backupRequest": {
+						SchemaProps: spec.SchemaProps{
+							Description: "BackupRequest tracks backup request phase and info of backing up the vm disks to the given pvc",
+							Ref:         ref("kubevirt.io/api/core/v1.VirtualMachineBackupRequest"),
+						},
+					},
 					"observedGeneration": {
 						SchemaProps: spec.SchemaProps{
 							Description: "ObservedGeneration is the generation observed by the vmi when started.",
@@ -27242,7 +28590,7 @@ func schema_kubevirtio_api_core_v1_VirtualMachineStatus(ref common.ReferenceCall
 			},
 		},
 		Dependencies: []string{
-			"kubevirt.io/api/core/v1.VirtualMachineCondition", "kubevirt.io/api/core/v1.VirtualMachineMemoryDumpRequest", "kubevirt.io/api/core/v1.VirtualMachineStartFailure", "kubevirt.io/api/core/v1.VirtualMachineStateChangeRequest", "kubevirt.io/api/core/v1.VirtualMachineVolumeRequest", "kubevirt.io/api/core/v1.VolumeSnapshotStatus", "kubevirt.io/api/core/v1.VolumeUpdateState"},
+			"kubevirt.io/api/core/v1.VirtualMachineBackupRequest", "kubevirt.io/api/core/v1.VirtualMachineCondition", "kubevirt.io/api/core/v1.VirtualMachineMemoryDumpRequest", "kubevirt.io/api/core/v1.VirtualMachineStartFailure", "kubevirt.io/api/core/v1.VirtualMachineStateChangeRequest", "kubevirt.io/api/core/v1.VirtualMachineVolumeRequest", "kubevirt.io/api/core/v1.VolumeSnapshotStatus", "kubevirt.io/api/core/v1.VolumeUpdateState"},
 	}
 }
 
@@ -27377,12 +28725,18 @@ func schema_kubevirtio_api_core_v1_Volume(ref common.ReferenceCallback) common.O
 							Ref:         ref("kubevirt.io/api/core/v1.MemoryDumpVolumeSource"),
 						},
 					},
+					"backup": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Backup is attached to the virt launcher and is populated with a backup of the vmi disks",
+							Ref:         ref("kubevirt.io/api/core/v1.BackupVolumeSource"),
+						},
+					},
 				},
 				Required: []string{"name"},
 			},
 		},
 		Dependencies: []string{
-			"kubevirt.io/api/core/v1.CloudInitConfigDriveSource", "kubevirt.io/api/core/v1.CloudInitNoCloudSource", "kubevirt.io/api/core/v1.ConfigMapVolumeSource", "kubevirt.io/api/core/v1.ContainerDiskSource", "kubevirt.io/api/core/v1.DataVolumeSource", "kubevirt.io/api/core/v1.DownwardAPIVolumeSource", "kubevirt.io/api/core/v1.DownwardMetricsVolumeSource", "kubevirt.io/api/core/v1.EmptyDiskSource", "kubevirt.io/api/core/v1.EphemeralVolumeSource", "kubevirt.io/api/core/v1.HostDisk", "kubevirt.io/api/core/v1.MemoryDumpVolumeSource", "kubevirt.io/api/core/v1.PersistentVolumeClaimVolumeSource", "kubevirt.io/api/core/v1.SecretVolumeSource", "kubevirt.io/api/core/v1.ServiceAccountVolumeSource", "kubevirt.io/api/core/v1.SysprepSource"},
+			"kubevirt.io/api/core/v1.BackupVolumeSource", "kubevirt.io/api/core/v1.CloudInitConfigDriveSource", "kubevirt.io/api/core/v1.CloudInitNoCloudSource", "kubevirt.io/api/core/v1.ConfigMapVolumeSource", "kubevirt.io/api/core/v1.ContainerDiskSource", "kubevirt.io/api/core/v1.DataVolumeSource", "kubevirt.io/api/core/v1.DownwardAPIVolumeSource", "kubevirt.io/api/core/v1.DownwardMetricsVolumeSource", "kubevirt.io/api/core/v1.EmptyDiskSource", "kubevirt.io/api/core/v1.EphemeralVolumeSource", "kubevirt.io/api/core/v1.HostDisk", "kubevirt.io/api/core/v1.MemoryDumpVolumeSource", "kubevirt.io/api/core/v1.PersistentVolumeClaimVolumeSource", "kubevirt.io/api/core/v1.SecretVolumeSource", "kubevirt.io/api/core/v1.ServiceAccountVolumeSource", "kubevirt.io/api/core/v1.SysprepSource"},
 	}
 }
 
@@ -27552,11 +28906,17 @@ func schema_kubevirtio_api_core_v1_VolumeSource(ref common.ReferenceCallback) co
 							Ref:         ref("kubevirt.io/api/core/v1.MemoryDumpVolumeSource"),
 						},
 					},
+					"backup": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Backup is attached to the virt launcher and is populated with a backup of the vmi disks",
+							Ref:         ref("kubevirt.io/api/core/v1.BackupVolumeSource"),
+						},
+					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"kubevirt.io/api/core/v1.CloudInitConfigDriveSource", "kubevirt.io/api/core/v1.CloudInitNoCloudSource", "kubevirt.io/api/core/v1.ConfigMapVolumeSource", "kubevirt.io/api/core/v1.ContainerDiskSource", "kubevirt.io/api/core/v1.DataVolumeSource", "kubevirt.io/api/core/v1.DownwardAPIVolumeSource", "kubevirt.io/api/core/v1.DownwardMetricsVolumeSource", "kubevirt.io/api/core/v1.EmptyDiskSource", "kubevirt.io/api/core/v1.EphemeralVolumeSource", "kubevirt.io/api/core/v1.HostDisk", "kubevirt.io/api/core/v1.MemoryDumpVolumeSource", "kubevirt.io/api/core/v1.PersistentVolumeClaimVolumeSource", "kubevirt.io/api/core/v1.SecretVolumeSource", "kubevirt.io/api/core/v1.ServiceAccountVolumeSource", "kubevirt.io/api/core/v1.SysprepSource"},
+			"kubevirt.io/api/core/v1.BackupVolumeSource", "kubevirt.io/api/core/v1.CloudInitConfigDriveSource", "kubevirt.io/api/core/v1.CloudInitNoCloudSource", "kubevirt.io/api/core/v1.ConfigMapVolumeSource", "kubevirt.io/api/core/v1.ContainerDiskSource", "kubevirt.io/api/core/v1.DataVolumeSource", "kubevirt.io/api/core/v1.DownwardAPIVolumeSource", "kubevirt.io/api/core/v1.DownwardMetricsVolumeSource", "kubevirt.io/api/core/v1.EmptyDiskSource", "kubevirt.io/api/core/v1.EphemeralVolumeSource", "kubevirt.io/api/core/v1.HostDisk", "kubevirt.io/api/core/v1.MemoryDumpVolumeSource", "kubevirt.io/api/core/v1.PersistentVolumeClaimVolumeSource", "kubevirt.io/api/core/v1.SecretVolumeSource", "kubevirt.io/api/core/v1.ServiceAccountVolumeSource", "kubevirt.io/api/core/v1.SysprepSource"},
 	}
 }
 
@@ -27629,6 +28989,12 @@ func schema_kubevirtio_api_core_v1_VolumeStatus(ref common.ReferenceCallback) co
 							Ref:         ref("kubevirt.io/api/core/v1.DomainMemoryDumpInfo"),
 						},
 					},
+					"backupVolume": {
+						SchemaProps: spec.SchemaProps{
+							Description: "If the volume is a backup volume, this will contain the backup info.",
+							Ref:         ref("kubevirt.io/api/core/v1.DomainBackupInfo"),
+						},
+					},
 					"containerDiskVolume": {
 						SchemaProps: spec.SchemaProps{
 							Description: "ContainerDiskVolume shows info about the containerdisk, if the volume is a containerdisk",
@@ -27640,7 +29006,7 @@ func schema_kubevirtio_api_core_v1_VolumeStatus(ref common.ReferenceCallback) co
 			},
 		},
 		Dependencies: []string{
-			"kubevirt.io/api/core/v1.ContainerDiskInfo", "kubevirt.io/api/core/v1.DomainMemoryDumpInfo", "kubevirt.io/api/core/v1.HotplugVolumeStatus", "kubevirt.io/api/core/v1.PersistentVolumeClaimInfo"},
+			"kubevirt.io/api/core/v1.ContainerDiskInfo", "kubevirt.io/api/core/v1.DomainBackupInfo", "kubevirt.io/api/core/v1.DomainMemoryDumpInfo", "kubevirt.io/api/core/v1.HotplugVolumeStatus", "kubevirt.io/api/core/v1.PersistentVolumeClaimInfo"},
 	}
 }
 
@@ -27715,6 +29081,114 @@ func schema_kubevirtio_api_core_v1_WatchdogDevice(ref common.ReferenceCallback)
 	}
 }
 
+func schema_kubevirtio_api_core_v1_WorkloadUpdateMaintenanceWindow(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "WorkloadUpdateMaintenanceWindow defines when the automated workload updates of a group of VMIs may happen. The window is open during its time ranges and, if it has a schedule, for the duration following every time of the schedule",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"name": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Name identifies the maintenance window",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"namespaces": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "set",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "Namespaces are the namespaces of the VMIs the window applies to. Defaults to all namespaces",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"selector": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Selector selects the VMIs the window applies to by their labels. Defaults to all VMIs",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.LabelSelector"),
+						},
+					},
+					"schedule": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Schedule is a cron expression of the times the window opens at, in UTC",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"duration": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Duration is how long the window stays open every time it opens according to its schedule\n\nDefaults to 1 hour",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Duration"),
+						},
+					},
+					"timeRanges": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "atomic",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "TimeRanges are fixed periods of time the window is open during",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("kubevirt.io/api/core/v1.WorkloadUpdateTimeRange"),
+									},
+								},
+							},
+						},
+					},
+				},
+				Required: []string{"name"},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Duration", "k8s.io/apimachinery/pkg/apis/meta/v1.LabelSelector", "kubevirt.io/api/core/v1.WorkloadUpdateTimeRange"},
+	}
+}
+
+func schema_kubevirtio_api_core_v1_WorkloadUpdateTimeRange(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "WorkloadUpdateTimeRange is a period of time a maintenance window is open during",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"start": {
+						SchemaProps: spec.SchemaProps{
+							Ref: ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+					"end": {
+						SchemaProps: spec.SchemaProps{
+							Ref: ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+				},
+				Required: []string{"start", "end"},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
+	}
+}
+
 func schema_kubevirtio_api_export_v1alpha1_Condition(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -31898,12 +33372,64 @@ func schema_kubevirtio_api_migrations_v1alpha1_MigrationPolicySpec(ref common.Re
 							Format: "",
 						},
 					},
+					"parallelMigrationThreads": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"integer"},
+							Format: "int64",
+						},
+					},
+					"compression": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"xbzrleCacheSize": {
+						SchemaProps: spec.SchemaProps{
+							Ref: ref("k8s.io/apimachinery/pkg/api/resource.Quantity"),
+						},
+					},
+					"maxDowntimeMilliseconds": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"integer"},
+							Format: "int64",
+						},
+					},
+					"maxRetries": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"integer"},
+							Format: "int32",
+						},
+					},
+					"retryBackoffSeconds": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"integer"},
+							Format: "int64",
+						},
+					},
+					"nonConvergenceEscalation": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"network": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"postMigrationVerification": {
+						SchemaProps: spec.SchemaProps{
+							Ref: ref("kubevirt.io/api/core/v1.PostMigrationVerification"),
+						},
+					},
 				},
 				Required: []string{"selectors"},
 			},
 		},
 		Dependencies: []string{
-			"k8s.io/apimachinery/pkg/api/resource.Quantity", "kubevirt.io/api/migrations/v1alpha1.Selectors"},
+			"k8s.io/apimachinery/pkg/api/resource.Quantity", "kubevirt.io/api/core/v1.PostMigrationVerification", "kubevirt.io/api/migrations/v1alpha1.Selectors"},
 	}
 }
 
@@ -32007,6 +33533,63 @@ func schema_kubevirtio_api_pool_v1alpha1_VirtualMachinePool(ref common.Reference
 	}
 }
 
+func schema_kubevirtio_api_pool_v1alpha1_VirtualMachinePoolAutoscaling(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "VirtualMachinePoolAutoscaling scales the pool on the load of the guests of its VMs, as reported by virt-handler. The desired replicas are calculated like the HorizontalPodAutoscaler does, from the average utilization of the running VMIs and its target. The pool is not scaled in while a running VMI did not report its load recently.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"minReplicas": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MinReplicas is the lower limit for the replicas the pool can be scaled in to. Defaults to 1.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"maxReplicas": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MaxReplicas is the upper limit for the replicas the pool can be scaled out to. It cannot be less than MinReplicas.",
+							Default:     0,
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"targetCPUUtilizationPercentage": {
+						SchemaProps: spec.SchemaProps{
+							Description: "TargetCPUUtilizationPercentage is the target average vCPU utilization of the guests.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"targetMemoryUtilizationPercentage": {
+						SchemaProps: spec.SchemaProps{
+							Description: "TargetMemoryUtilizationPercentage is the target average memory utilization of the guests.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"scaleOutCooldownSeconds": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ScaleOutCooldownSeconds is the minimum time between a scaling, or the creation, of the pool and its next scale out. Defaults to 60.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"scaleInCooldownSeconds": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ScaleInCooldownSeconds is the minimum time between a scaling, or the creation, of the pool and its next scale in. Defaults to 300.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+				},
+				Required: []string{"maxReplicas"},
+			},
+		},
+	}
+}
+
 func schema_kubevirtio_api_pool_v1alpha1_VirtualMachinePoolCondition(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -32107,6 +33690,94 @@ func schema_kubevirtio_api_pool_v1alpha1_VirtualMachinePoolList(ref common.Refer
 	}
 }
 
+func schema_kubevirtio_api_pool_v1alpha1_VirtualMachinePoolProactiveScaleInStrategy(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "VirtualMachinePoolProactiveScaleInStrategy announces the removal of a VM by annotating it with the kubevirt.io/vm-pool-scale-in annotation, which external tooling can act on before the VM is stopped. A VM which is still announced when the pool scales out again is kept instead of being deleted.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"preStopGracePeriodSeconds": {
+						SchemaProps: spec.SchemaProps{
+							Description: "PreStopGracePeriodSeconds is the time between the announcement of the removal of a VM and its deletion. Defaults to 30.",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func schema_kubevirtio_api_pool_v1alpha1_VirtualMachinePoolRollingUpdate(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Type: []string{"object"},
+				Properties: map[string]spec.Schema{
+					"method": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Method used to propagate the update to the running VMIs. Defaults to Restart.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"maxUnavailable": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MaxUnavailable is the maximum number of VMs of the pool which can be unavailable during the update. Value can be an absolute number or a percentage of the desired replicas, rounded down. Defaults to 1.",
+							Ref:         ref("k8s.io/apimachinery/pkg/util/intstr.IntOrString"),
+						},
+					},
+					"maxSurge": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MaxSurge is the maximum number of VMIs of the pool which can be live migrated at the same time, each migration runs an additional virt-launcher pod. Value can be an absolute number or a percentage of the desired replicas, rounded up. Defaults to 1.",
+							Ref:         ref("k8s.io/apimachinery/pkg/util/intstr.IntOrString"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/util/intstr.IntOrString"},
+	}
+}
+
+func schema_kubevirtio_api_pool_v1alpha1_VirtualMachinePoolScaleInStrategy(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "VirtualMachinePoolScaleInStrategy describes which VMs are removed when the pool scales in and how.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"selectionPolicy": {
+						SchemaProps: spec.SchemaProps{
+							Description: "SelectionPolicy decides which VMs of the pool are removed first. Defaults to Random.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"proactive": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Proactive announces the removal of the selected VMs before they are deleted. Without it the selected VMs are deleted right away.",
+							Ref:         ref("kubevirt.io/api/pool/v1alpha1.VirtualMachinePoolProactiveScaleInStrategy"),
+						},
+					},
+					"volumeRetentionPolicy": {
+						SchemaProps: spec.SchemaProps{
+							Description: "VolumeRetentionPolicy decides what happens to the volumes of the DataVolumeTemplates of the removed VMs. Defaults to Delete.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"kubevirt.io/api/pool/v1alpha1.VirtualMachinePoolProactiveScaleInStrategy"},
+	}
+}
+
 func schema_kubevirtio_api_pool_v1alpha1_VirtualMachinePoolSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -32139,12 +33810,30 @@ func schema_kubevirtio_api_pool_v1alpha1_VirtualMachinePoolSpec(ref common.Refer
 							Format:      "",
 						},
 					},
+					"updateStrategy": {
+						SchemaProps: spec.SchemaProps{
+							Description: "UpdateStrategy describes how changes of the VirtualMachine template are propagated to the running VMIs of the pool.",
+							Ref:         ref("kubevirt.io/api/pool/v1alpha1.VirtualMachinePoolUpdateStrategy"),
+						},
+					},
+					"scaleInStrategy": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ScaleInStrategy describes which VMs are removed when the pool scales in and how.",
+							Ref:         ref("kubevirt.io/api/pool/v1alpha1.VirtualMachinePoolScaleInStrategy"),
+						},
+					},
+					"autoscaling": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Autoscaling scales the pool on the load of the guests of its VMs. The replicas of the pool are managed by it.",
+							Ref:         ref("kubevirt.io/api/pool/v1alpha1.VirtualMachinePoolAutoscaling"),
+						},
+					},
 				},
 				Required: []string{"selector", "virtualMachineTemplate"},
 			},
 		},
 		Dependencies: []string{
-			"k8s.io/apimachinery/pkg/apis/meta/v1.LabelSelector", "kubevirt.io/api/pool/v1alpha1.VirtualMachineTemplateSpec"},
+			"k8s.io/apimachinery/pkg/apis/meta/v1.LabelSelector", "kubevirt.io/api/pool/v1alpha1.VirtualMachinePoolAutoscaling", "kubevirt.io/api/pool/v1alpha1.VirtualMachinePoolScaleInStrategy", "kubevirt.io/api/pool/v1alpha1.VirtualMachinePoolUpdateStrategy", "kubevirt.io/api/pool/v1alpha1.VirtualMachineTemplateSpec"},
 	}
 }
 
@@ -32191,11 +33880,59 @@ func schema_kubevirtio_api_pool_v1alpha1_VirtualMachinePoolStatus(ref common.Ref
 							Format:      "",
 						},
 					},
+					"updateRevision": {
+						SchemaProps: spec.SchemaProps{
+							Description: "UpdateRevision is the name of the ControllerRevision holding the VirtualMachine template the VMs of the pool are updated to.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"currentRevision": {
+						SchemaProps: spec.SchemaProps{
+							Description: "CurrentRevision is the name of the ControllerRevision all VMs of the pool, and their running VMIs, were last fully updated to.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"updatedReplicas": {
+						SchemaProps: spec.SchemaProps{
+							Description: "UpdatedReplicas is the number of VMs of the pool, including their running VMI, which match the update revision.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"lastScaleTime": {
+						SchemaProps: spec.SchemaProps{
+							Description: "LastScaleTime is the last time the pool was scaled by its autoscaling.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Time", "kubevirt.io/api/pool/v1alpha1.VirtualMachinePoolCondition"},
+	}
+}
+
+func schema_kubevirtio_api_pool_v1alpha1_VirtualMachinePoolUpdateStrategy(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "VirtualMachinePoolUpdateStrategy describes how changes of the VirtualMachine template are propagated to the running VMIs of the pool.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"rollingUpdate": {
+						SchemaProps: spec.SchemaProps{
+							Description: "RollingUpdate updates the running VMIs of the pool a few at a time. Without it all outdated VMIs are restarted at once.",
+							Ref:         ref("kubevirt.io/api/pool/v1alpha1.VirtualMachinePoolRollingUpdate"),
+						},
+					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"kubevirt.io/api/pool/v1alpha1.VirtualMachinePoolCondition"},
+			"kubevirt.io/api/pool/v1alpha1.VirtualMachinePoolRollingUpdate"},
 	}
 }
 
@@ -33239,6 +34976,35 @@ func schema_kubevirtio_api_snapshot_v1beta1_Error(ref common.ReferenceCallback)
 	}
 }
 
+func schema_kubevirtio_api_snapshot_v1beta1_MemorySnapshotStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "MemorySnapshotStatus tracks the guest memory and device state saved by the snapshot",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"claimName": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ClaimName is the name of the PVC the memory state is saved to",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"sourcePaused": {
+						SchemaProps: spec.SchemaProps{
+							Description: "SourcePaused is set when the snapshot paused the VM to save the memory state, the VM is resumed once its volumes are snapshotted",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"claimName"},
+			},
+		},
+	}
+}
+
 func schema_kubevirtio_api_snapshot_v1beta1_PersistentVolumeClaim(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -33267,6 +35033,38 @@ func schema_kubevirtio_api_snapshot_v1beta1_PersistentVolumeClaim(ref common.Ref
 	}
 }
 
+func schema_kubevirtio_api_snapshot_v1beta1_ScheduledSnapshotResult(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ScheduledSnapshotResult describes the outcome of a snapshot taken by a schedule",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"virtualMachineSnapshotName": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"time": {
+						SchemaProps: spec.SchemaProps{
+							Ref: ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+					"message": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
+	}
+}
+
 func schema_kubevirtio_api_snapshot_v1beta1_SnapshotVolumesLists(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -33419,6 +35217,293 @@ func schema_kubevirtio_api_snapshot_v1beta1_VirtualMachineRestore(ref common.Ref
 	}
 }
 
+func schema_kubevirtio_api_snapshot_v1beta1_VirtualMachineRestoreExportSource(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "VirtualMachineRestoreExportSource points to a VirtualMachineExport of another cluster",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"manifestURL": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ManifestURL is the external URL of the AllManifests manifest of the VirtualMachineExport",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"tokenSecretRef": {
+						SchemaProps: spec.SchemaProps{
+							Description: "TokenSecretRef is the name of a Secret in the namespace of the restore holding the token of the VirtualMachineExport under the \"token\" key",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"cert": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Cert is the PEM encoded CA certificate of the export server, as published in the external links of the VirtualMachineExport. Defaults to the system CAs.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"manifestURL", "tokenSecretRef"},
+			},
+		},
+	}
+}
+
+func schema_kubevirtio_api_snapshot_v1beta1_VirtualMachineRestoreGroup(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "VirtualMachineRestoreGroup defines the operation of restoring all VMs of a VirtualMachineSnapshotGroup",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"),
+						},
+					},
+					"spec": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("kubevirt.io/api/snapshot/v1beta1.VirtualMachineRestoreGroupSpec"),
+						},
+					},
+					"status": {
+						SchemaProps: spec.SchemaProps{
+							Ref: ref("kubevirt.io/api/snapshot/v1beta1.VirtualMachineRestoreGroupStatus"),
+						},
+					},
+				},
+				Required: []string{"spec"},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta", "kubevirt.io/api/snapshot/v1beta1.VirtualMachineRestoreGroupSpec", "kubevirt.io/api/snapshot/v1beta1.VirtualMachineRestoreGroupStatus"},
+	}
+}
+
+func schema_kubevirtio_api_snapshot_v1beta1_VirtualMachineRestoreGroupList(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "VirtualMachineRestoreGroupList is a list of VirtualMachineRestoreGroup resources",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"),
+						},
+					},
+					"items": {
+						SchemaProps: spec.SchemaProps{
+							Type: []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("kubevirt.io/api/snapshot/v1beta1.VirtualMachineRestoreGroup"),
+									},
+								},
+							},
+						},
+					},
+				},
+				Required: []string{"metadata", "items"},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta", "kubevirt.io/api/snapshot/v1beta1.VirtualMachineRestoreGroup"},
+	}
+}
+
+func schema_kubevirtio_api_snapshot_v1beta1_VirtualMachineRestoreGroupMember(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "VirtualMachineRestoreGroupMember links a VirtualMachine of the group to its VirtualMachineRestore",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"virtualMachineName": {
+						SchemaProps: spec.SchemaProps{
+							Default: "",
+							Type:    []string{"string"},
+							Format:  "",
+						},
+					},
+					"virtualMachineRestoreName": {
+						SchemaProps: spec.SchemaProps{
+							Default: "",
+							Type:    []string{"string"},
+							Format:  "",
+						},
+					},
+				},
+				Required: []string{"virtualMachineName", "virtualMachineRestoreName"},
+			},
+		},
+	}
+}
+
+func schema_kubevirtio_api_snapshot_v1beta1_VirtualMachineRestoreGroupSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "VirtualMachineRestoreGroupSpec is the spec for a VirtualMachineRestoreGroup resource",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"virtualMachineSnapshotGroupName": {
+						SchemaProps: spec.SchemaProps{
+							Default: "",
+							Type:    []string{"string"},
+							Format:  "",
+						},
+					},
+					"targetReadinessPolicy": {
+						SchemaProps: spec.SchemaProps{
+							Description: "TargetReadinessPolicy of the VirtualMachineRestores of the members",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"virtualMachineSnapshotGroupName"},
+			},
+		},
+	}
+}
+
+func schema_kubevirtio_api_snapshot_v1beta1_VirtualMachineRestoreGroupStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "VirtualMachineRestoreGroupStatus is the status for a VirtualMachineRestoreGroup resource",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"members": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "atomic",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Type: []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("kubevirt.io/api/snapshot/v1beta1.VirtualMachineRestoreGroupMember"),
+									},
+								},
+							},
+						},
+					},
+					"restoreTime": {
+						SchemaProps: spec.SchemaProps{
+							Ref: ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+					"complete": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"boolean"},
+							Format: "",
+						},
+					},
+					"conditions": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "atomic",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Type: []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("kubevirt.io/api/snapshot/v1beta1.Condition"),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Time", "kubevirt.io/api/snapshot/v1beta1.Condition", "kubevirt.io/api/snapshot/v1beta1.VirtualMachineRestoreGroupMember"},
+	}
+}
+
+func schema_kubevirtio_api_snapshot_v1beta1_VirtualMachineRestoreIdentity(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "VirtualMachineRestoreIdentity holds the identifiers of a new target",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"newMacAddresses": {
+						SchemaProps: spec.SchemaProps{
+							Description: "NewMacAddresses manually sets that target interfaces' mac addresses. The key is the interface name and the value is the new mac address. An empty mac address is assigned to interfaces not included in this map, so that a new one is generated.",
+							Type:        []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"newSMBiosSerial": {
+						SchemaProps: spec.SchemaProps{
+							Description: "NewSMBiosSerial manually sets that target's SMbios serial. If this field is not specified, the serial is cleared so that a new one is generated.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
 func schema_kubevirtio_api_snapshot_v1beta1_VirtualMachineRestoreList(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -33453,7 +35538,498 @@ func schema_kubevirtio_api_snapshot_v1beta1_VirtualMachineRestoreList(ref common
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
 										Default: map[string]interface{}{},
-										Ref:     ref("kubevirt.io/api/snapshot/v1beta1.VirtualMachineRestore"),
+										Ref:     ref("kubevirt.io/api/snapshot/v1beta1.VirtualMachineRestore"),
+									},
+								},
+							},
+						},
+					},
+				},
+				Required: []string{"metadata", "items"},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta", "kubevirt.io/api/snapshot/v1beta1.VirtualMachineRestore"},
+	}
+}
+
+func schema_kubevirtio_api_snapshot_v1beta1_VirtualMachineRestoreSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "VirtualMachineRestoreSpec is the spec for a VirtualMachineRestoreresource",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"target": {
+						SchemaProps: spec.SchemaProps{
+							Description: "initially only VirtualMachine type supported",
+							Default:     map[string]interface{}{},
+							Ref:         ref("k8s.io/api/core/v1.TypedLocalObjectReference"),
+						},
+					},
+					"virtualMachineSnapshotName": {
+						SchemaProps: spec.SchemaProps{
+							Description: "VirtualMachineSnapshotName is the name of the VirtualMachineSnapshot to restore. It must be set to an empty string when the target is imported from a VirtualMachineExport.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"virtualMachineSnapshotNamespace": {
+						SchemaProps: spec.SchemaProps{
+							Description: "VirtualMachineSnapshotNamespace is the namespace of the VirtualMachineSnapshot to restore, defaults to the namespace of the restore. Restoring from another namespace requires the CrossNamespaceVolumeDataSource feature of Kubernetes and a ReferenceGrant allowing PersistentVolumeClaims of the restore namespace to use VolumeSnapshots of that namespace.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"virtualMachineExport": {
+						SchemaProps: spec.SchemaProps{
+							Description: "VirtualMachineExport imports the target from a VirtualMachineExport of another cluster instead of restoring a VirtualMachineSnapshot. The target must not exist.",
+							Ref:         ref("kubevirt.io/api/snapshot/v1beta1.VirtualMachineRestoreExportSource"),
+						},
+					},
+					"newIdentity": {
+						SchemaProps: spec.SchemaProps{
+							Description: "NewIdentity gives a new target new MAC addresses, a new SMBios serial and a new firmware UUID the same way a VirtualMachineClone does. The target must not exist.",
+							Ref:         ref("kubevirt.io/api/snapshot/v1beta1.VirtualMachineRestoreIdentity"),
+						},
+					},
+					"targetReadinessPolicy": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"patches": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "atomic",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "If the target for the restore does not exist, it will be created. Patches holds JSON patches that would be applied to the target manifest before it's created. Patches should fit the target's Kind.\n\nExample for a patch: {\"op\": \"replace\", \"path\": \"/metadata/name\", \"value\": \"new-vm-name\"}",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+				},
+				Required: []string{"target", "virtualMachineSnapshotName"},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/api/core/v1.TypedLocalObjectReference", "kubevirt.io/api/snapshot/v1beta1.VirtualMachineRestoreExportSource", "kubevirt.io/api/snapshot/v1beta1.VirtualMachineRestoreIdentity"},
+	}
+}
+
+func schema_kubevirtio_api_snapshot_v1beta1_VirtualMachineRestoreStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "VirtualMachineRestoreStatus is the spec for a VirtualMachineRestoreresource",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"restores": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "atomic",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Type: []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("kubevirt.io/api/snapshot/v1beta1.VolumeRestore"),
+									},
+								},
+							},
+						},
+					},
+					"restoreTime": {
+						SchemaProps: spec.SchemaProps{
+							Ref: ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+					"deletedDataVolumes": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "set",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Type: []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"complete": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"boolean"},
+							Format: "",
+						},
+					},
+					"conditions": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "atomic",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Type: []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("kubevirt.io/api/snapshot/v1beta1.Condition"),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Time", "kubevirt.io/api/snapshot/v1beta1.Condition", "kubevirt.io/api/snapshot/v1beta1.VolumeRestore"},
+	}
+}
+
+func schema_kubevirtio_api_snapshot_v1beta1_VirtualMachineSnapshot(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "VirtualMachineSnapshot defines the operation of snapshotting a VM",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"),
+						},
+					},
+					"spec": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("kubevirt.io/api/snapshot/v1beta1.VirtualMachineSnapshotSpec"),
+						},
+					},
+					"status": {
+						SchemaProps: spec.SchemaProps{
+							Ref: ref("kubevirt.io/api/snapshot/v1beta1.VirtualMachineSnapshotStatus"),
+						},
+					},
+				},
+				Required: []string{"spec"},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta", "kubevirt.io/api/snapshot/v1beta1.VirtualMachineSnapshotSpec", "kubevirt.io/api/snapshot/v1beta1.VirtualMachineSnapshotStatus"},
+	}
+}
+
+func schema_kubevirtio_api_snapshot_v1beta1_VirtualMachineSnapshotContent(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "VirtualMachineSnapshotContent contains the snapshot data",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"),
+						},
+					},
+					"spec": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("kubevirt.io/api/snapshot/v1beta1.VirtualMachineSnapshotContentSpec"),
+						},
+					},
+					"status": {
+						SchemaProps: spec.SchemaProps{
+							Ref: ref("kubevirt.io/api/snapshot/v1beta1.VirtualMachineSnapshotContentStatus"),
+						},
+					},
+				},
+				Required: []string{"spec"},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta", "kubevirt.io/api/snapshot/v1beta1.VirtualMachineSnapshotContentSpec", "kubevirt.io/api/snapshot/v1beta1.VirtualMachineSnapshotContentStatus"},
+	}
+}
+
+func schema_kubevirtio_api_snapshot_v1beta1_VirtualMachineSnapshotContentList(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "VirtualMachineSnapshotContentList is a list of VirtualMachineSnapshot resources",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"),
+						},
+					},
+					"items": {
+						SchemaProps: spec.SchemaProps{
+							Type: []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("kubevirt.io/api/snapshot/v1beta1.VirtualMachineSnapshotContent"),
+									},
+								},
+							},
+						},
+					},
+				},
+				Required: []string{"metadata", "items"},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta", "kubevirt.io/api/snapshot/v1beta1.VirtualMachineSnapshotContent"},
+	}
+}
+
+func schema_kubevirtio_api_snapshot_v1beta1_VirtualMachineSnapshotContentSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "VirtualMachineSnapshotContentSpec is the spec for a VirtualMachineSnapshotContent resource",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"virtualMachineSnapshotName": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"source": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("kubevirt.io/api/snapshot/v1beta1.SourceSpec"),
+						},
+					},
+					"volumeBackups": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "atomic",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Type: []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("kubevirt.io/api/snapshot/v1beta1.VolumeBackup"),
+									},
+								},
+							},
+						},
+					},
+				},
+				Required: []string{"source"},
+			},
+		},
+		Dependencies: []string{
+			"kubevirt.io/api/snapshot/v1beta1.SourceSpec", "kubevirt.io/api/snapshot/v1beta1.VolumeBackup"},
+	}
+}
+
+func schema_kubevirtio_api_snapshot_v1beta1_VirtualMachineSnapshotContentStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "VirtualMachineSnapshotContentStatus is the status for a VirtualMachineSnapshotStatus resource",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"creationTime": {
+						SchemaProps: spec.SchemaProps{
+							Ref: ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+					"readyToUse": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"boolean"},
+							Format: "",
+						},
+					},
+					"error": {
+						SchemaProps: spec.SchemaProps{
+							Ref: ref("kubevirt.io/api/snapshot/v1beta1.Error"),
+						},
+					},
+					"volumeSnapshotStatus": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "atomic",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Type: []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("kubevirt.io/api/snapshot/v1beta1.VolumeSnapshotStatus"),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Time", "kubevirt.io/api/snapshot/v1beta1.Error", "kubevirt.io/api/snapshot/v1beta1.VolumeSnapshotStatus"},
+	}
+}
+
+func schema_kubevirtio_api_snapshot_v1beta1_VirtualMachineSnapshotGroup(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "VirtualMachineSnapshotGroup defines the operation of snapshotting a group of VMs consistently. The file systems of all VMs are frozen before any volume is snapshotted and thawed once every volume snapshot was taken.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"),
+						},
+					},
+					"spec": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("kubevirt.io/api/snapshot/v1beta1.VirtualMachineSnapshotGroupSpec"),
+						},
+					},
+					"status": {
+						SchemaProps: spec.SchemaProps{
+							Ref: ref("kubevirt.io/api/snapshot/v1beta1.VirtualMachineSnapshotGroupStatus"),
+						},
+					},
+				},
+				Required: []string{"spec"},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta", "kubevirt.io/api/snapshot/v1beta1.VirtualMachineSnapshotGroupSpec", "kubevirt.io/api/snapshot/v1beta1.VirtualMachineSnapshotGroupStatus"},
+	}
+}
+
+func schema_kubevirtio_api_snapshot_v1beta1_VirtualMachineSnapshotGroupList(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "VirtualMachineSnapshotGroupList is a list of VirtualMachineSnapshotGroup resources",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"),
+						},
+					},
+					"items": {
+						SchemaProps: spec.SchemaProps{
+							Type: []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("kubevirt.io/api/snapshot/v1beta1.VirtualMachineSnapshotGroup"),
 									},
 								},
 							},
@@ -33464,22 +36040,22 @@ func schema_kubevirtio_api_snapshot_v1beta1_VirtualMachineRestoreList(ref common
 			},
 		},
 		Dependencies: []string{
-			"k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta", "kubevirt.io/api/snapshot/v1beta1.VirtualMachineRestore"},
+			"k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta", "kubevirt.io/api/snapshot/v1beta1.VirtualMachineSnapshotGroup"},
 	}
 }
 
-func schema_kubevirtio_api_snapshot_v1beta1_VirtualMachineRestoreSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_kubevirtio_api_snapshot_v1beta1_VirtualMachineSnapshotGroupMember(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "VirtualMachineRestoreSpec is the spec for a VirtualMachineRestoreresource",
+				Description: "VirtualMachineSnapshotGroupMember links a VirtualMachine of the group to its VirtualMachineSnapshot",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"target": {
+					"virtualMachineName": {
 						SchemaProps: spec.SchemaProps{
-							Description: "initially only VirtualMachine type supported",
-							Default:     map[string]interface{}{},
-							Ref:         ref("k8s.io/api/core/v1.TypedLocalObjectReference"),
+							Default: "",
+							Type:    []string{"string"},
+							Format:  "",
 						},
 					},
 					"virtualMachineSnapshotName": {
@@ -33489,49 +36065,63 @@ func schema_kubevirtio_api_snapshot_v1beta1_VirtualMachineRestoreSpec(ref common
 							Format:  "",
 						},
 					},
-					"targetReadinessPolicy": {
+				},
+				Required: []string{"virtualMachineName", "virtualMachineSnapshotName"},
+			},
+		},
+	}
+}
+
+func schema_kubevirtio_api_snapshot_v1beta1_VirtualMachineSnapshotGroupSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "VirtualMachineSnapshotGroupSpec is the spec for a VirtualMachineSnapshotGroup resource",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"selector": {
 						SchemaProps: spec.SchemaProps{
-							Type:   []string{"string"},
-							Format: "",
+							Description: "Selector selects the VirtualMachines in the namespace of the group which are snapshotted. The members are resolved once, when the group is created.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.LabelSelector"),
 						},
 					},
-					"patches": {
-						VendorExtensible: spec.VendorExtensible{
-							Extensions: spec.Extensions{
-								"x-kubernetes-list-type": "atomic",
-							},
+					"deletionPolicy": {
+						SchemaProps: spec.SchemaProps{
+							Description: "DeletionPolicy of the VirtualMachineSnapshots of the members",
+							Type:        []string{"string"},
+							Format:      "",
 						},
+					},
+					"failureDeadline": {
 						SchemaProps: spec.SchemaProps{
-							Description: "If the target for the restore does not exist, it will be created. Patches holds JSON patches that would be applied to the target manifest before it's created. Patches should fit the target's Kind.\n\nExample for a patch: {\"op\": \"replace\", \"path\": \"/metadata/name\", \"value\": \"new-vm-name\"}",
-							Type:        []string{"array"},
-							Items: &spec.SchemaOrArray{
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Default: "",
-										Type:    []string{"string"},
-										Format:  "",
-									},
-								},
-							},
+							Description: "This time represents the number of seconds we permit the group snapshot to take. In case we pass this deadline we mark this snapshot as failed. Defaults to DefaultFailureDeadline - 5min",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Duration"),
 						},
 					},
 				},
-				Required: []string{"target", "virtualMachineSnapshotName"},
+				Required: []string{"selector"},
 			},
 		},
 		Dependencies: []string{
-			"k8s.io/api/core/v1.TypedLocalObjectReference"},
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Duration", "k8s.io/apimachinery/pkg/apis/meta/v1.LabelSelector"},
 	}
 }
 
-func schema_kubevirtio_api_snapshot_v1beta1_VirtualMachineRestoreStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_kubevirtio_api_snapshot_v1beta1_VirtualMachineSnapshotGroupStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "VirtualMachineRestoreStatus is the spec for a VirtualMachineRestoreresource",
+				Description: "VirtualMachineSnapshotGroupStatus is the status for a VirtualMachineSnapshotGroup resource",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"restores": {
+					"phase": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"members": {
 						VendorExtensible: spec.VendorExtensible{
 							Extensions: spec.Extensions{
 								"x-kubernetes-list-type": "atomic",
@@ -33543,42 +36133,40 @@ func schema_kubevirtio_api_snapshot_v1beta1_VirtualMachineRestoreStatus(ref comm
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
 										Default: map[string]interface{}{},
-										Ref:     ref("kubevirt.io/api/snapshot/v1beta1.VolumeRestore"),
+										Ref:     ref("kubevirt.io/api/snapshot/v1beta1.VirtualMachineSnapshotGroupMember"),
 									},
 								},
 							},
 						},
 					},
-					"restoreTime": {
+					"freezeTime": {
 						SchemaProps: spec.SchemaProps{
-							Ref: ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+							Description: "FreezeTime is the time the members were frozen, volume snapshots of the members are only taken after it is set",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
 						},
 					},
-					"deletedDataVolumes": {
-						VendorExtensible: spec.VendorExtensible{
-							Extensions: spec.Extensions{
-								"x-kubernetes-list-type": "set",
-							},
+					"thawTime": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ThawTime is the time the members were thawed",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
 						},
+					},
+					"creationTime": {
 						SchemaProps: spec.SchemaProps{
-							Type: []string{"array"},
-							Items: &spec.SchemaOrArray{
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Default: "",
-										Type:    []string{"string"},
-										Format:  "",
-									},
-								},
-							},
+							Ref: ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
 						},
 					},
-					"complete": {
+					"readyToUse": {
 						SchemaProps: spec.SchemaProps{
 							Type:   []string{"boolean"},
 							Format: "",
 						},
 					},
+					"error": {
+						SchemaProps: spec.SchemaProps{
+							Ref: ref("kubevirt.io/api/snapshot/v1beta1.Error"),
+						},
+					},
 					"conditions": {
 						VendorExtensible: spec.VendorExtensible{
 							Extensions: spec.Extensions{
@@ -33601,15 +36189,15 @@ func schema_kubevirtio_api_snapshot_v1beta1_VirtualMachineRestoreStatus(ref comm
 			},
 		},
 		Dependencies: []string{
-			"k8s.io/apimachinery/pkg/apis/meta/v1.Time", "kubevirt.io/api/snapshot/v1beta1.Condition", "kubevirt.io/api/snapshot/v1beta1.VolumeRestore"},
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Time", "kubevirt.io/api/snapshot/v1beta1.Condition", "kubevirt.io/api/snapshot/v1beta1.Error", "kubevirt.io/api/snapshot/v1beta1.VirtualMachineSnapshotGroupMember"},
 	}
 }
 
-func schema_kubevirtio_api_snapshot_v1beta1_VirtualMachineSnapshot(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_kubevirtio_api_snapshot_v1beta1_VirtualMachineSnapshotList(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "VirtualMachineSnapshot defines the operation of snapshotting a VM",
+				Description: "VirtualMachineSnapshotList is a list of VirtualMachineSnapshot resources",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
 					"kind": {
@@ -33629,34 +36217,36 @@ func schema_kubevirtio_api_snapshot_v1beta1_VirtualMachineSnapshot(ref common.Re
 					"metadata": {
 						SchemaProps: spec.SchemaProps{
 							Default: map[string]interface{}{},
-							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"),
-						},
-					},
-					"spec": {
-						SchemaProps: spec.SchemaProps{
-							Default: map[string]interface{}{},
-							Ref:     ref("kubevirt.io/api/snapshot/v1beta1.VirtualMachineSnapshotSpec"),
+							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"),
 						},
 					},
-					"status": {
+					"items": {
 						SchemaProps: spec.SchemaProps{
-							Ref: ref("kubevirt.io/api/snapshot/v1beta1.VirtualMachineSnapshotStatus"),
+							Type: []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("kubevirt.io/api/snapshot/v1beta1.VirtualMachineSnapshot"),
+									},
+								},
+							},
 						},
 					},
 				},
-				Required: []string{"spec"},
+				Required: []string{"metadata", "items"},
 			},
 		},
 		Dependencies: []string{
-			"k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta", "kubevirt.io/api/snapshot/v1beta1.VirtualMachineSnapshotSpec", "kubevirt.io/api/snapshot/v1beta1.VirtualMachineSnapshotStatus"},
+			"k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta", "kubevirt.io/api/snapshot/v1beta1.VirtualMachineSnapshot"},
 	}
 }
 
-func schema_kubevirtio_api_snapshot_v1beta1_VirtualMachineSnapshotContent(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_kubevirtio_api_snapshot_v1beta1_VirtualMachineSnapshotSchedule(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "VirtualMachineSnapshotContent contains the snapshot data",
+				Description: "VirtualMachineSnapshotSchedule periodically takes VirtualMachineSnapshots of the VirtualMachines matched by its selector",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
 					"kind": {
@@ -33682,12 +36272,12 @@ func schema_kubevirtio_api_snapshot_v1beta1_VirtualMachineSnapshotContent(ref co
 					"spec": {
 						SchemaProps: spec.SchemaProps{
 							Default: map[string]interface{}{},
-							Ref:     ref("kubevirt.io/api/snapshot/v1beta1.VirtualMachineSnapshotContentSpec"),
+							Ref:     ref("kubevirt.io/api/snapshot/v1beta1.VirtualMachineSnapshotScheduleSpec"),
 						},
 					},
 					"status": {
 						SchemaProps: spec.SchemaProps{
-							Ref: ref("kubevirt.io/api/snapshot/v1beta1.VirtualMachineSnapshotContentStatus"),
+							Ref: ref("kubevirt.io/api/snapshot/v1beta1.VirtualMachineSnapshotScheduleStatus"),
 						},
 					},
 				},
@@ -33695,15 +36285,15 @@ func schema_kubevirtio_api_snapshot_v1beta1_VirtualMachineSnapshotContent(ref co
 			},
 		},
 		Dependencies: []string{
-			"k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta", "kubevirt.io/api/snapshot/v1beta1.VirtualMachineSnapshotContentSpec", "kubevirt.io/api/snapshot/v1beta1.VirtualMachineSnapshotContentStatus"},
+			"k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta", "kubevirt.io/api/snapshot/v1beta1.VirtualMachineSnapshotScheduleSpec", "kubevirt.io/api/snapshot/v1beta1.VirtualMachineSnapshotScheduleStatus"},
 	}
 }
 
-func schema_kubevirtio_api_snapshot_v1beta1_VirtualMachineSnapshotContentList(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_kubevirtio_api_snapshot_v1beta1_VirtualMachineSnapshotScheduleList(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "VirtualMachineSnapshotContentList is a list of VirtualMachineSnapshot resources",
+				Description: "VirtualMachineSnapshotScheduleList is a list of VirtualMachineSnapshotSchedule resources",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
 					"kind": {
@@ -33733,7 +36323,7 @@ func schema_kubevirtio_api_snapshot_v1beta1_VirtualMachineSnapshotContentList(re
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
 										Default: map[string]interface{}{},
-										Ref:     ref("kubevirt.io/api/snapshot/v1beta1.VirtualMachineSnapshotContent"),
+										Ref:     ref("kubevirt.io/api/snapshot/v1beta1.VirtualMachineSnapshotSchedule"),
 									},
 								},
 							},
@@ -33744,151 +36334,144 @@ func schema_kubevirtio_api_snapshot_v1beta1_VirtualMachineSnapshotContentList(re
 			},
 		},
 		Dependencies: []string{
-			"k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta", "kubevirt.io/api/snapshot/v1beta1.VirtualMachineSnapshotContent"},
+			"k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta", "kubevirt.io/api/snapshot/v1beta1.VirtualMachineSnapshotSchedule"},
 	}
 }
 
-func schema_kubevirtio_api_snapshot_v1beta1_VirtualMachineSnapshotContentSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_kubevirtio_api_snapshot_v1beta1_VirtualMachineSnapshotScheduleRetention(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "VirtualMachineSnapshotContentSpec is the spec for a VirtualMachineSnapshotContent resource",
+				Description: "VirtualMachineSnapshotScheduleRetention defines which snapshots taken by a schedule are kept. Rules are evaluated per VirtualMachine, a snapshot is kept if any rule selects it. Without any rule all snapshots are kept.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"virtualMachineSnapshotName": {
+					"last": {
 						SchemaProps: spec.SchemaProps{
-							Type:   []string{"string"},
-							Format: "",
+							Description: "Last keeps the given number of most recent snapshots",
+							Type:        []string{"integer"},
+							Format:      "int32",
 						},
 					},
-					"source": {
+					"daily": {
 						SchemaProps: spec.SchemaProps{
-							Default: map[string]interface{}{},
-							Ref:     ref("kubevirt.io/api/snapshot/v1beta1.SourceSpec"),
+							Description: "Daily keeps the most recent snapshot of each of the given number of most recent days",
+							Type:        []string{"integer"},
+							Format:      "int32",
 						},
 					},
-					"volumeBackups": {
-						VendorExtensible: spec.VendorExtensible{
-							Extensions: spec.Extensions{
-								"x-kubernetes-list-type": "atomic",
-							},
-						},
+					"weekly": {
 						SchemaProps: spec.SchemaProps{
-							Type: []string{"array"},
-							Items: &spec.SchemaOrArray{
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Default: map[string]interface{}{},
-										Ref:     ref("kubevirt.io/api/snapshot/v1beta1.VolumeBackup"),
-									},
-								},
-							},
+							Description: "Weekly keeps the most recent snapshot of each of the given number of most recent weeks",
+							Type:        []string{"integer"},
+							Format:      "int32",
 						},
 					},
 				},
-				Required: []string{"source"},
 			},
 		},
-		Dependencies: []string{
-			"kubevirt.io/api/snapshot/v1beta1.SourceSpec", "kubevirt.io/api/snapshot/v1beta1.VolumeBackup"},
 	}
 }
 
-func schema_kubevirtio_api_snapshot_v1beta1_VirtualMachineSnapshotContentStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_kubevirtio_api_snapshot_v1beta1_VirtualMachineSnapshotScheduleSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "VirtualMachineSnapshotContentStatus is the status for a VirtualMachineSnapshotStatus resource",
+				Description: "VirtualMachineSnapshotScheduleSpec is the spec for a VirtualMachineSnapshotSchedule resource",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"creationTime": {
+					"selector": {
 						SchemaProps: spec.SchemaProps{
-							Ref: ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+							Description: "Selector selects the VirtualMachines in the namespace of the schedule which are snapshotted",
+							Default:     map[string]interface{}{},
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.LabelSelector"),
 						},
 					},
-					"readyToUse": {
+					"schedule": {
 						SchemaProps: spec.SchemaProps{
-							Type:   []string{"boolean"},
-							Format: "",
+							Description: "Schedule in cron format, for example \"0 */6 * * *\"",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"error": {
+					"disabled": {
 						SchemaProps: spec.SchemaProps{
-							Ref: ref("kubevirt.io/api/snapshot/v1beta1.Error"),
+							Description: "Disabled stops the schedule from taking new snapshots. Retention is still applied to existing snapshots.",
+							Type:        []string{"boolean"},
+							Format:      "",
 						},
 					},
-					"volumeSnapshotStatus": {
-						VendorExtensible: spec.VendorExtensible{
-							Extensions: spec.Extensions{
-								"x-kubernetes-list-type": "atomic",
-							},
+					"retention": {
+						SchemaProps: spec.SchemaProps{
+							Ref: ref("kubevirt.io/api/snapshot/v1beta1.VirtualMachineSnapshotScheduleRetention"),
 						},
+					},
+					"snapshotTemplate": {
 						SchemaProps: spec.SchemaProps{
-							Type: []string{"array"},
-							Items: &spec.SchemaOrArray{
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Default: map[string]interface{}{},
-										Ref:     ref("kubevirt.io/api/snapshot/v1beta1.VolumeSnapshotStatus"),
-									},
-								},
-							},
+							Ref: ref("kubevirt.io/api/snapshot/v1beta1.VirtualMachineSnapshotTemplateSpec"),
 						},
 					},
 				},
+				Required: []string{"selector", "schedule"},
 			},
 		},
 		Dependencies: []string{
-			"k8s.io/apimachinery/pkg/apis/meta/v1.Time", "kubevirt.io/api/snapshot/v1beta1.Error", "kubevirt.io/api/snapshot/v1beta1.VolumeSnapshotStatus"},
+			"k8s.io/apimachinery/pkg/apis/meta/v1.LabelSelector", "kubevirt.io/api/snapshot/v1beta1.VirtualMachineSnapshotScheduleRetention", "kubevirt.io/api/snapshot/v1beta1.VirtualMachineSnapshotTemplateSpec"},
 	}
 }
 
-func schema_kubevirtio_api_snapshot_v1beta1_VirtualMachineSnapshotList(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_kubevirtio_api_snapshot_v1beta1_VirtualMachineSnapshotScheduleStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "VirtualMachineSnapshotList is a list of VirtualMachineSnapshot resources",
+				Description: "VirtualMachineSnapshotScheduleStatus is the status for a VirtualMachineSnapshotSchedule resource",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"kind": {
+					"lastScheduleTime": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
-							Type:        []string{"string"},
-							Format:      "",
+							Description: "LastScheduleTime is the last time snapshots were scheduled",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
 						},
 					},
-					"apiVersion": {
+					"nextScheduleTime": {
 						SchemaProps: spec.SchemaProps{
-							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
-							Type:        []string{"string"},
-							Format:      "",
+							Description: "NextScheduleTime is the next time snapshots will be scheduled",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
 						},
 					},
-					"metadata": {
+					"lastSuccess": {
 						SchemaProps: spec.SchemaProps{
-							Default: map[string]interface{}{},
-							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"),
+							Ref: ref("kubevirt.io/api/snapshot/v1beta1.ScheduledSnapshotResult"),
 						},
 					},
-					"items": {
+					"lastFailure": {
+						SchemaProps: spec.SchemaProps{
+							Ref: ref("kubevirt.io/api/snapshot/v1beta1.ScheduledSnapshotResult"),
+						},
+					},
+					"conditions": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "atomic",
+							},
+						},
 						SchemaProps: spec.SchemaProps{
 							Type: []string{"array"},
 							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
 										Default: map[string]interface{}{},
-										Ref:     ref("kubevirt.io/api/snapshot/v1beta1.VirtualMachineSnapshot"),
+										Ref:     ref("kubevirt.io/api/snapshot/v1beta1.Condition"),
 									},
 								},
 							},
 						},
 					},
 				},
-				Required: []string{"metadata", "items"},
 			},
 		},
 		Dependencies: []string{
-			"k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta", "kubevirt.io/api/snapshot/v1beta1.VirtualMachineSnapshot"},
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Time", "kubevirt.io/api/snapshot/v1beta1.Condition", "kubevirt.io/api/snapshot/v1beta1.ScheduledSnapshotResult"},
 	}
 }
 
@@ -33917,6 +36500,13 @@ func schema_kubevirtio_api_snapshot_v1beta1_VirtualMachineSnapshotSpec(ref commo
 							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Duration"),
 						},
 					},
+					"includeMemory": {
+						SchemaProps: spec.SchemaProps{
+							Description: "IncludeMemory saves the guest memory and device state of a running VM along with its volumes, so that restoring the snapshot resumes the VM instead of booting it. The VM is paused until its volumes are snapshotted.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
 				},
 				Required: []string{"source"},
 			},
@@ -34009,11 +36599,58 @@ func schema_kubevirtio_api_snapshot_v1beta1_VirtualMachineSnapshotStatus(ref com
 							Ref: ref("kubevirt.io/api/snapshot/v1beta1.SnapshotVolumesLists"),
 						},
 					},
+					"memory": {
+						SchemaProps: spec.SchemaProps{
+							Ref: ref("kubevirt.io/api/snapshot/v1beta1.MemorySnapshotStatus"),
+						},
+					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"k8s.io/apimachinery/pkg/apis/meta/v1.Time", "kubevirt.io/api/snapshot/v1beta1.Condition", "kubevirt.io/api/snapshot/v1beta1.Error", "kubevirt.io/api/snapshot/v1beta1.SnapshotVolumesLists"},
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Time", "kubevirt.io/api/snapshot/v1beta1.Condition", "kubevirt.io/api/snapshot/v1beta1.Error", "kubevirt.io/api/snapshot/v1beta1.MemorySnapshotStatus", "kubevirt.io/api/snapshot/v1beta1.SnapshotVolumesLists"},
+	}
+}
+
+func schema_kubevirtio_api_snapshot_v1beta1_VirtualMachineSnapshotTemplateSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "VirtualMachineSnapshotTemplateSpec describes the VirtualMachineSnapshots created by a schedule",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"labels": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Labels added to the created snapshots",
+							Type:        []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"deletionPolicy": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"failureDeadline": {
+						SchemaProps: spec.SchemaProps{
+							Ref: ref("k8s.io/apimachinery/pkg/apis/meta/v1.Duration"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Duration"},
 	}
 }
 
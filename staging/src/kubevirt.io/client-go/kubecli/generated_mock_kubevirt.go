@@ -208,6 +208,36 @@ func (_mr *_MockKubevirtClientRecorder) VirtualMachineRestore(arg0 interface{})
 	return _mr.mock.ctrl.RecordCall(_mr.mock, "VirtualMachineRestore", arg0)
 }
 
+func (_m *MockKubevirtClient) VirtualMachineSnapshotSchedule(namespace string) v1beta119.VirtualMachineSnapshotScheduleInterface {
+	ret := _m.ctrl.Call(_m, "VirtualMachineSnapshotSchedule", namespace)
+	ret0, _ := ret[0].(v1beta119.VirtualMachineSnapshotScheduleInterface)
+	return ret0
+}
+
+func (_mr *_MockKubevirtClientRecorder) VirtualMachineSnapshotSchedule(arg0 interface{}) *gomock.Call {
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "VirtualMachineSnapshotSchedule", arg0)
+}
+
+func (_m *MockKubevirtClient) VirtualMachineSnapshotGroup(namespace string) v1beta119.VirtualMachineSnapshotGroupInterface {
+	ret := _m.ctrl.Call(_m, "VirtualMachineSnapshotGroup", namespace)
+	ret0, _ := ret[0].(v1beta119.VirtualMachineSnapshotGroupInterface)
+	return ret0
+}
+
+func (_mr *_MockKubevirtClientRecorder) VirtualMachineSnapshotGroup(arg0 interface{}) *gomock.Call {
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "VirtualMachineSnapshotGroup", arg0)
+}
+
+func (_m *MockKubevirtClient) VirtualMachineRestoreGroup(namespace string) v1beta119.VirtualMachineRestoreGroupInterface {
+	ret := _m.ctrl.Call(_m, "VirtualMachineRestoreGroup", namespace)
+	ret0, _ := ret[0].(v1beta119.VirtualMachineRestoreGroupInterface)
+	return ret0
+}
+
+func (_mr *_MockKubevirtClientRecorder) VirtualMachineRestoreGroup(arg0 interface{}) *gomock.Call {
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "VirtualMachineRestoreGroup", arg0)
+}
+
 func (_m *MockKubevirtClient) VirtualMachineExport(namespace string) v1beta117.VirtualMachineExportInterface {
 	ret := _m.ctrl.Call(_m, "VirtualMachineExport", namespace)
 	ret0, _ := ret[0].(v1beta117.VirtualMachineExportInterface)
@@ -1260,6 +1290,28 @@ func (_mr *_MockVirtualMachineInstanceInterfaceRecorder) FilesystemList(arg0, ar
 	return _mr.mock.ctrl.RecordCall(_mr.mock, "FilesystemList", arg0, arg1)
 }
 
+func (_m *MockVirtualMachineInstanceInterface) GuestExec(ctx context.Context, name string, guestExecOptions *v121.VirtualMachineInstanceGuestExecOptions) (*v121.VirtualMachineInstanceGuestExecResult, error) {
+	ret := _m.ctrl.Call(_m, "GuestExec", ctx, name, guestExecOptions)
+	ret0, _ := ret[0].(*v121.VirtualMachineInstanceGuestExecResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+func (_mr *_MockVirtualMachineInstanceInterfaceRecorder) GuestExec(arg0, arg1, arg2 interface{}) *gomock.Call {
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "GuestExec", arg0, arg1, arg2)
+}
+
+func (_m *MockVirtualMachineInstanceInterface) MigrationCheck(ctx context.Context, name string, migrateOptions *v121.MigrateOptions) (*v121.VirtualMachineInstanceMigrationCheckResult, error) {
+	ret := _m.ctrl.Call(_m, "MigrationCheck", ctx, name, migrateOptions)
+	ret0, _ := ret[0].(*v121.VirtualMachineInstanceMigrationCheckResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+func (_mr *_MockVirtualMachineInstanceInterfaceRecorder) MigrationCheck(arg0, arg1, arg2 interface{}) *gomock.Call {
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "MigrationCheck", arg0, arg1, arg2)
+}
+
 func (_m *MockVirtualMachineInstanceInterface) AddVolume(ctx context.Context, name string, addVolumeOptions *v121.AddVolumeOptions) error {
 	ret := _m.ctrl.Call(_m, "AddVolume", ctx, name, addVolumeOptions)
 	ret0, _ := ret[0].(error)
@@ -1837,6 +1889,26 @@ func (_mr *_MockVirtualMachineInterfaceRecorder) RemoveMemoryDump(arg0, arg1 int
 	return _mr.mock.ctrl.RecordCall(_mr.mock, "RemoveMemoryDump", arg0, arg1)
 }
 
+func (_m *MockVirtualMachineInterface) Backup(ctx context.Context, name string, backupRequest *v121.VirtualMachineBackupRequest) error {
+	ret := _m.ctrl.Call(_m, "Backup", ctx, name, backupRequest)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+func (_mr *_MockVirtualMachineInterfaceRecorder) Backup(arg0, arg1, arg2 interface{}) *gomock.Call {
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "Backup", arg0, arg1, arg2)
+}
+
+func (_m *MockVirtualMachineInterface) RemoveBackup(ctx context.Context, name string) error {
+	ret := _m.ctrl.Call(_m, "RemoveBackup", ctx, name)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+func (_mr *_MockVirtualMachineInterfaceRecorder) RemoveBackup(arg0, arg1 interface{}) *gomock.Call {
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "RemoveBackup", arg0, arg1)
+}
+
 // Mock of VirtualMachineInstanceMigrationInterface interface
 type MockVirtualMachineInstanceMigrationInterface struct {
 	ctrl     *gomock.Controller
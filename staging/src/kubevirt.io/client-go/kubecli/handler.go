@@ -30,6 +30,7 @@ const (
 	guestInfoTemplateURI      = "https://%s:%v/v1/namespaces/%s/virtualmachineinstances/%s/guestosinfo"
 	userListTemplateURI       = "https://%s:%v/v1/namespaces/%s/virtualmachineinstances/%s/userlist"
 	filesystemListTemplateURI = "https://%s:%v/v1/namespaces/%s/virtualmachineinstances/%s/filesystemlist"
+	guestExecTemplateURI      = "https://%s:%v/v1/namespaces/%s/virtualmachineinstances/%s/guestexec"
 
 	sevFetchCertChainTemplateURI         = "https://%s:%v/v1/namespaces/%s/virtualmachineinstances/%s/sev/fetchcertchain"
 	sevQueryLaunchMeasurementTemplateURI = "https://%s:%v/v1/namespaces/%s/virtualmachineinstances/%s/sev/querylaunchmeasurement"
@@ -68,9 +69,11 @@ type VirtHandlerConn interface {
 	Pod() (pod *v1.Pod, err error)
 	Put(url string, body io.ReadCloser) error
 	Get(url string) (string, error)
+	Post(url string, body io.ReadCloser) (string, error)
 	GuestInfoURI(vmi *virtv1.VirtualMachineInstance) (string, error)
 	UserListURI(vmi *virtv1.VirtualMachineInstance) (string, error)
 	FilesystemListURI(vmi *virtv1.VirtualMachineInstance) (string, error)
+	GuestExecURI(vmi *virtv1.VirtualMachineInstance) (string, error)
 }
 
 type virtHandler struct {
@@ -273,6 +276,22 @@ func (v *virtHandlerConn) Get(url string) (string, error) {
 	return response, nil
 }
 
+func (v *virtHandlerConn) Post(url string, body io.ReadCloser) (string, error) {
+	req, err := http.NewRequest(http.MethodPost, url, body)
+	if err != nil {
+		return "", err
+	}
+
+	req.Header.Add("Content-Type", "application/json")
+	req.Header.Add("Accept", "application/json")
+	response, err := v.doRequest(req)
+	if err != nil {
+		return "", err
+	}
+
+	return response, nil
+}
+
 func (v *virtHandlerConn) GuestInfoURI(vmi *virtv1.VirtualMachineInstance) (string, error) {
 	return v.formatURI(guestInfoTemplateURI, vmi)
 }
@@ -292,6 +311,10 @@ func (v *virtHandlerConn) FilesystemListURI(vmi *virtv1.VirtualMachineInstance)
 	return v.formatURI(filesystemListTemplateURI, vmi)
 }
 
+func (v *virtHandlerConn) GuestExecURI(vmi *virtv1.VirtualMachineInstance) (string, error) {
+	return v.formatURI(guestExecTemplateURI, vmi)
+}
+
 func (v *virtHandlerConn) SEVFetchCertChainURI(vmi *virtv1.VirtualMachineInstance) (string, error) {
 	return v.formatURI(sevFetchCertChainTemplateURI, vmi)
 }
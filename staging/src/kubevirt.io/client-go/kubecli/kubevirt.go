@@ -65,6 +65,9 @@ type KubevirtClient interface {
 	VirtualMachineSnapshot(namespace string) snapshotv1.VirtualMachineSnapshotInterface
 	VirtualMachineSnapshotContent(namespace string) snapshotv1.VirtualMachineSnapshotContentInterface
 	VirtualMachineRestore(namespace string) snapshotv1.VirtualMachineRestoreInterface
+	VirtualMachineSnapshotSchedule(namespace string) snapshotv1.VirtualMachineSnapshotScheduleInterface
+	VirtualMachineSnapshotGroup(namespace string) snapshotv1.VirtualMachineSnapshotGroupInterface
+	VirtualMachineRestoreGroup(namespace string) snapshotv1.VirtualMachineRestoreGroupInterface
 	VirtualMachineExport(namespace string) exportv1.VirtualMachineExportInterface
 	VirtualMachineInstancetype(namespace string) instancetypev1beta1.VirtualMachineInstancetypeInterface
 	VirtualMachineClusterInstancetype() instancetypev1beta1.VirtualMachineClusterInstancetypeInterface
@@ -183,6 +186,18 @@ func (k kubevirtClient) VirtualMachineRestore(namespace string) snapshotv1.Virtu
 	return k.generatedKubeVirtClient.SnapshotV1beta1().VirtualMachineRestores(namespace)
 }
 
+func (k kubevirtClient) VirtualMachineSnapshotSchedule(namespace string) snapshotv1.VirtualMachineSnapshotScheduleInterface {
+	return k.generatedKubeVirtClient.SnapshotV1beta1().VirtualMachineSnapshotSchedules(namespace)
+}
+
+func (k kubevirtClient) VirtualMachineSnapshotGroup(namespace string) snapshotv1.VirtualMachineSnapshotGroupInterface {
+	return k.generatedKubeVirtClient.SnapshotV1beta1().VirtualMachineSnapshotGroups(namespace)
+}
+
+func (k kubevirtClient) VirtualMachineRestoreGroup(namespace string) snapshotv1.VirtualMachineRestoreGroupInterface {
+	return k.generatedKubeVirtClient.SnapshotV1beta1().VirtualMachineRestoreGroups(namespace)
+}
+
 func (k kubevirtClient) VirtualMachineExport(namespace string) exportv1.VirtualMachineExportInterface {
 	return k.generatedKubeVirtClient.ExportV1beta1().VirtualMachineExports(namespace)
 }
@@ -21,6 +21,7 @@ package kubecli
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"io"
 	"net/http"
@@ -363,6 +364,54 @@ var _ = Describe("Kubevirt VirtualMachineInstance Client", func() {
 		Entry("with proxied server URL", proxyPath),
 	)
 
+	DescribeTable("should execute a command in the guest of a VirtualMachineInstance", func(proxyPath string) {
+		client, err := GetKubevirtClientFromFlags(server.URL()+proxyPath, "")
+		Expect(err).ToNot(HaveOccurred())
+
+		opts := &v1.VirtualMachineInstanceGuestExecOptions{Command: "/usr/bin/cat", Args: []string{"/proc/uptime"}}
+		body, err := json.Marshal(opts)
+		Expect(err).ToNot(HaveOccurred())
+		expected := v1.VirtualMachineInstanceGuestExecResult{ExitCode: 1, Stdout: "out", Stderr: "err"}
+		server.AppendHandlers(ghttp.CombineHandlers(
+			ghttp.VerifyRequest("PUT", path.Join(proxyPath, subVMIPath, "guestexec")),
+			ghttp.VerifyBody(body),
+			ghttp.RespondWithJSONEncoded(http.StatusOK, expected),
+		))
+		result, err := client.VirtualMachineInstance(k8sv1.NamespaceDefault).GuestExec(context.Background(), "testvm", opts)
+
+		Expect(server.ReceivedRequests()).To(HaveLen(1))
+		Expect(err).ToNot(HaveOccurred())
+		Expect(*result).To(Equal(expected))
+	},
+		Entry("with regular server URL", ""),
+		Entry("with proxied server URL", proxyPath),
+	)
+
+	DescribeTable("should check whether a VirtualMachineInstance can be migrated", func(proxyPath string) {
+		client, err := GetKubevirtClientFromFlags(server.URL()+proxyPath, "")
+		Expect(err).ToNot(HaveOccurred())
+
+		opts := &v1.MigrateOptions{AddedNodeSelector: map[string]string{"zone": "east"}}
+		body, err := json.Marshal(opts)
+		Expect(err).ToNot(HaveOccurred())
+		expected := v1.VirtualMachineInstanceMigrationCheckResult{
+			Blockers: []v1.MigrationBlocker{{Type: v1.MigrationBlockerScheduling, Message: "no node"}},
+		}
+		server.AppendHandlers(ghttp.CombineHandlers(
+			ghttp.VerifyRequest("PUT", path.Join(proxyPath, subVMIPath, "migrate", "check")),
+			ghttp.VerifyBody(body),
+			ghttp.RespondWithJSONEncoded(http.StatusOK, expected),
+		))
+		result, err := client.VirtualMachineInstance(k8sv1.NamespaceDefault).MigrationCheck(context.Background(), "testvm", opts)
+
+		Expect(server.ReceivedRequests()).To(HaveLen(1))
+		Expect(err).ToNot(HaveOccurred())
+		Expect(*result).To(Equal(expected))
+	},
+		Entry("with regular server URL", ""),
+		Entry("with proxied server URL", proxyPath),
+	)
+
 	DescribeTable("should fetch GuestOSInfo from VirtualMachineInstance via subresource", func(proxyPath string) {
 		client, err := GetKubevirtClientFromFlags(server.URL()+proxyPath, "")
 		Expect(err).ToNot(HaveOccurred())
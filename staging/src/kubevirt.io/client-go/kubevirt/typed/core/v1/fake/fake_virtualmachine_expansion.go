@@ -87,6 +87,20 @@ func (c *FakeVirtualMachines) RemoveMemoryDump(ctx context.Context, name string)
 	return err
 }
 
+func (c *FakeVirtualMachines) Backup(ctx context.Context, name string, backupRequest *v1.VirtualMachineBackupRequest) error {
+	_, err := c.Fake.
+		Invokes(fake2.NewPutSubresourceAction(virtualmachinesResource, c.ns, "backup", name, backupRequest), nil)
+
+	return err
+}
+
+func (c *FakeVirtualMachines) RemoveBackup(ctx context.Context, name string) error {
+	_, err := c.Fake.
+		Invokes(fake2.NewPutSubresourceAction(virtualmachinesResource, c.ns, "removebackup", name, struct{}{}), nil)
+
+	return err
+}
+
 func (c *FakeVirtualMachines) AddVolume(ctx context.Context, name string, addVolumeOptions *v1.AddVolumeOptions) error {
 	_, err := c.Fake.
 		Invokes(fake2.NewPutSubresourceAction(virtualmachinesResource, c.ns, "addvolume", name, addVolumeOptions), nil)
@@ -107,6 +107,26 @@ func (c *FakeVirtualMachineInstances) FilesystemList(ctx context.Context, name s
 	return v1.VirtualMachineInstanceFileSystemList{}, err
 }
 
+func (c *FakeVirtualMachineInstances) GuestExec(ctx context.Context, name string, guestExecOptions *v1.VirtualMachineInstanceGuestExecOptions) (*v1.VirtualMachineInstanceGuestExecResult, error) {
+	obj, err := c.Fake.
+		Invokes(fake2.NewPutSubresourceAction(virtualmachineinstancesResource, c.ns, "guestexec", name, guestExecOptions), &v1.VirtualMachineInstanceGuestExecResult{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1.VirtualMachineInstanceGuestExecResult), err
+}
+
+func (c *FakeVirtualMachineInstances) MigrationCheck(ctx context.Context, name string, migrateOptions *v1.MigrateOptions) (*v1.VirtualMachineInstanceMigrationCheckResult, error) {
+	obj, err := c.Fake.
+		Invokes(fake2.NewPutSubresourceAction(virtualmachineinstancesResource, c.ns, "migrate/check", name, migrateOptions), &v1.VirtualMachineInstanceMigrationCheckResult{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1.VirtualMachineInstanceMigrationCheckResult), err
+}
+
 func (c *FakeVirtualMachineInstances) AddVolume(ctx context.Context, name string, addVolumeOptions *v1.AddVolumeOptions) error {
 	_, err := c.Fake.
 		Invokes(fake2.NewPutSubresourceAction(virtualmachineinstancesResource, c.ns, "addvolume", name, addVolumeOptions), nil)
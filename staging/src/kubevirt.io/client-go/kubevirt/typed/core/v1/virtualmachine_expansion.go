@@ -46,6 +46,8 @@ type VirtualMachineExpansion interface {
 	PortForward(name string, port int, protocol string) (StreamInterface, error)
 	MemoryDump(ctx context.Context, name string, memoryDumpRequest *v1.VirtualMachineMemoryDumpRequest) error
 	RemoveMemoryDump(ctx context.Context, name string) error
+	Backup(ctx context.Context, name string, backupRequest *v1.VirtualMachineBackupRequest) error
+	RemoveBackup(ctx context.Context, name string) error
 }
 
 func (c *virtualMachines) GetWithExpandedSpec(ctx context.Context, name string) (*v1.VirtualMachine, error) {
@@ -197,3 +199,31 @@ func (c *virtualMachines) RemoveMemoryDump(ctx context.Context, name string) err
 		Do(ctx).
 		Error()
 }
+
+func (c *virtualMachines) Backup(ctx context.Context, name string, backupRequest *v1.VirtualMachineBackupRequest) error {
+	body, err := json.Marshal(backupRequest)
+	if err != nil {
+		return err
+	}
+
+	return c.GetClient().Put().
+		AbsPath(fmt.Sprintf(vmSubresourceURLFmt, v1.ApiStorageVersion)).
+		Namespace(c.GetNamespace()).
+		Resource("virtualmachines").
+		Name(name).
+		SubResource("backup").
+		Body(body).
+		Do(ctx).
+		Error()
+}
+
+func (c *virtualMachines) RemoveBackup(ctx context.Context, name string) error {
+	return c.GetClient().Put().
+		AbsPath(fmt.Sprintf(vmSubresourceURLFmt, v1.ApiStorageVersion)).
+		Namespace(c.GetNamespace()).
+		Resource("virtualmachines").
+		Name(name).
+		SubResource("removebackup").
+		Do(ctx).
+		Error()
+}
@@ -51,6 +51,8 @@ type VirtualMachineInstanceExpansion interface {
 	GuestOsInfo(ctx context.Context, name string) (v1.VirtualMachineInstanceGuestAgentInfo, error)
 	UserList(ctx context.Context, name string) (v1.VirtualMachineInstanceGuestOSUserList, error)
 	FilesystemList(ctx context.Context, name string) (v1.VirtualMachineInstanceFileSystemList, error)
+	GuestExec(ctx context.Context, name string, guestExecOptions *v1.VirtualMachineInstanceGuestExecOptions) (*v1.VirtualMachineInstanceGuestExecResult, error)
+	MigrationCheck(ctx context.Context, name string, migrateOptions *v1.MigrateOptions) (*v1.VirtualMachineInstanceMigrationCheckResult, error)
 	AddVolume(ctx context.Context, name string, addVolumeOptions *v1.AddVolumeOptions) error
 	RemoveVolume(ctx context.Context, name string, removeVolumeOptions *v1.RemoveVolumeOptions) error
 	VSOCK(name string, options *v1.VSOCKOptions) (StreamInterface, error)
@@ -262,6 +264,46 @@ func (c *virtualMachineInstances) FilesystemList(ctx context.Context, name strin
 	return fsList, err
 }
 
+func (c *virtualMachineInstances) GuestExec(ctx context.Context, name string, guestExecOptions *v1.VirtualMachineInstanceGuestExecOptions) (*v1.VirtualMachineInstanceGuestExecResult, error) {
+	body, err := json.Marshal(guestExecOptions)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &v1.VirtualMachineInstanceGuestExecResult{}
+	err = c.GetClient().Put().
+		AbsPath(fmt.Sprintf(vmiSubresourceURL, v1.ApiStorageVersion)).
+		Namespace(c.GetNamespace()).
+		Resource("virtualmachineinstances").
+		Name(name).
+		SubResource("guestexec").
+		Body(body).
+		Do(ctx).
+		Into(result)
+
+	return result, err
+}
+
+func (c *virtualMachineInstances) MigrationCheck(ctx context.Context, name string, migrateOptions *v1.MigrateOptions) (*v1.VirtualMachineInstanceMigrationCheckResult, error) {
+	body, err := json.Marshal(migrateOptions)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &v1.VirtualMachineInstanceMigrationCheckResult{}
+	err = c.GetClient().Put().
+		AbsPath(fmt.Sprintf(vmiSubresourceURL, v1.ApiStorageVersion)).
+		Namespace(c.GetNamespace()).
+		Resource("virtualmachineinstances").
+		Name(name).
+		SubResource("migrate", "check").
+		Body(body).
+		Do(ctx).
+		Into(result)
+
+	return result, err
+}
+
 func (c *virtualMachineInstances) AddVolume(ctx context.Context, name string, addVolumeOptions *v1.AddVolumeOptions) error {
 	body, err := json.Marshal(addVolumeOptions)
 	if err != nil {
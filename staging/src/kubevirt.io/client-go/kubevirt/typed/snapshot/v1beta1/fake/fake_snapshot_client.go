@@ -32,6 +32,10 @@ func (c *FakeSnapshotV1beta1) VirtualMachineRestores(namespace string) v1beta1.V
 	return &FakeVirtualMachineRestores{c, namespace}
 }
 
+func (c *FakeSnapshotV1beta1) VirtualMachineRestoreGroups(namespace string) v1beta1.VirtualMachineRestoreGroupInterface {
+	return &FakeVirtualMachineRestoreGroups{c, namespace}
+}
+
 func (c *FakeSnapshotV1beta1) VirtualMachineSnapshots(namespace string) v1beta1.VirtualMachineSnapshotInterface {
 	return &FakeVirtualMachineSnapshots{c, namespace}
 }
@@ -40,6 +44,14 @@ func (c *FakeSnapshotV1beta1) VirtualMachineSnapshotContents(namespace string) v
 	return &FakeVirtualMachineSnapshotContents{c, namespace}
 }
 
+func (c *FakeSnapshotV1beta1) VirtualMachineSnapshotGroups(namespace string) v1beta1.VirtualMachineSnapshotGroupInterface {
+	return &FakeVirtualMachineSnapshotGroups{c, namespace}
+}
+
+func (c *FakeSnapshotV1beta1) VirtualMachineSnapshotSchedules(namespace string) v1beta1.VirtualMachineSnapshotScheduleInterface {
+	return &FakeVirtualMachineSnapshotSchedules{c, namespace}
+}
+
 // RESTClient returns a RESTClient that is used to communicate
 // with API server by this client implementation.
 func (c *FakeSnapshotV1beta1) RESTClient() rest.Interface {
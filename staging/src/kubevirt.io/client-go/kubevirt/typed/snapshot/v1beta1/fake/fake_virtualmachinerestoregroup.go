@@ -0,0 +1,147 @@
+/*
+Copyright The KubeVirt Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by client-gen. DO NOT EDIT.
+
+package fake
+
+import (
+	"context"
+
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	labels "k8s.io/apimachinery/pkg/labels"
+	types "k8s.io/apimachinery/pkg/types"
+	watch "k8s.io/apimachinery/pkg/watch"
+	testing "k8s.io/client-go/testing"
+	v1beta1 "kubevirt.io/api/snapshot/v1beta1"
+)
+
+// FakeVirtualMachineRestoreGroups implements VirtualMachineRestoreGroupInterface
+type FakeVirtualMachineRestoreGroups struct {
+	Fake *FakeSnapshotV1beta1
+	ns   string
+}
+
+var virtualmachinerestoregroupsResource = v1beta1.SchemeGroupVersion.WithResource("virtualmachinerestoregroups")
+
+var virtualmachinerestoregroupsKind = v1beta1.SchemeGroupVersion.WithKind("VirtualMachineRestoreGroup")
+
+// Get takes name of the virtualMachineRestoreGroup, and returns the corresponding virtualMachineRestoreGroup object, and an error if there is any.
+func (c *FakeVirtualMachineRestoreGroups) Get(ctx context.Context, name string, options v1.GetOptions) (result *v1beta1.VirtualMachineRestoreGroup, err error) {
+	emptyResult := &v1beta1.VirtualMachineRestoreGroup{}
+	obj, err := c.Fake.
+		Invokes(testing.NewGetActionWithOptions(virtualmachinerestoregroupsResource, c.ns, name, options), emptyResult)
+
+	if obj == nil {
+		return emptyResult, err
+	}
+	return obj.(*v1beta1.VirtualMachineRestoreGroup), err
+}
+
+// List takes label and field selectors, and returns the list of VirtualMachineRestoreGroups that match those selectors.
+func (c *FakeVirtualMachineRestoreGroups) List(ctx context.Context, opts v1.ListOptions) (result *v1beta1.VirtualMachineRestoreGroupList, err error) {
+	emptyResult := &v1beta1.VirtualMachineRestoreGroupList{}
+	obj, err := c.Fake.
+		Invokes(testing.NewListActionWithOptions(virtualmachinerestoregroupsResource, virtualmachinerestoregroupsKind, c.ns, opts), emptyResult)
+
+	if obj == nil {
+		return emptyResult, err
+	}
+
+	label, _, _ := testing.ExtractFromListOptions(opts)
+	if label == nil {
+		label = labels.Everything()
+	}
+	list := &v1beta1.VirtualMachineRestoreGroupList{ListMeta: obj.(*v1beta1.VirtualMachineRestoreGroupList).ListMeta}
+	for _, item := range obj.(*v1beta1.VirtualMachineRestoreGroupList).Items {
+		if label.Matches(labels.Set(item.Labels)) {
+			list.Items = append(list.Items, item)
+		}
+	}
+	return list, err
+}
+
+// Watch returns a watch.Interface that watches the requested virtualMachineRestoreGroups.
+func (c *FakeVirtualMachineRestoreGroups) Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error) {
+	return c.Fake.
+		InvokesWatch(testing.NewWatchActionWithOptions(virtualmachinerestoregroupsResource, c.ns, opts))
+
+}
+
+// Create takes the representation of a virtualMachineRestoreGroup and creates it.  Returns the server's representation of the virtualMachineRestoreGroup, and an error, if there is any.
+func (c *FakeVirtualMachineRestoreGroups) Create(ctx context.Context, virtualMachineRestoreGroup *v1beta1.VirtualMachineRestoreGroup, opts v1.CreateOptions) (result *v1beta1.VirtualMachineRestoreGroup, err error) {
+	emptyResult := &v1beta1.VirtualMachineRestoreGroup{}
+	obj, err := c.Fake.
+		Invokes(testing.NewCreateActionWithOptions(virtualmachinerestoregroupsResource, c.ns, virtualMachineRestoreGroup, opts), emptyResult)
+
+	if obj == nil {
+		return emptyResult, err
+	}
+	return obj.(*v1beta1.VirtualMachineRestoreGroup), err
+}
+
+// Update takes the representation of a virtualMachineRestoreGroup and updates it. Returns the server's representation of the virtualMachineRestoreGroup, and an error, if there is any.
+func (c *FakeVirtualMachineRestoreGroups) Update(ctx context.Context, virtualMachineRestoreGroup *v1beta1.VirtualMachineRestoreGroup, opts v1.UpdateOptions) (result *v1beta1.VirtualMachineRestoreGroup, err error) {
+	emptyResult := &v1beta1.VirtualMachineRestoreGroup{}
+	obj, err := c.Fake.
+		Invokes(testing.NewUpdateActionWithOptions(virtualmachinerestoregroupsResource, c.ns, virtualMachineRestoreGroup, opts), emptyResult)
+
+	if obj == nil {
+		return emptyResult, err
+	}
+	return obj.(*v1beta1.VirtualMachineRestoreGroup), err
+}
+
+// UpdateStatus was generated because the type contains a Status member.
+// Add a +genclient:noStatus comment above the type to avoid generating UpdateStatus().
+func (c *FakeVirtualMachineRestoreGroups) UpdateStatus(ctx context.Context, virtualMachineRestoreGroup *v1beta1.VirtualMachineRestoreGroup, opts v1.UpdateOptions) (result *v1beta1.VirtualMachineRestoreGroup, err error) {
+	emptyResult := &v1beta1.VirtualMachineRestoreGroup{}
+	obj, err := c.Fake.
+		Invokes(testing.NewUpdateSubresourceActionWithOptions(virtualmachinerestoregroupsResource, "status", c.ns, virtualMachineRestoreGroup, opts), emptyResult)
+
+	if obj == nil {
+		return emptyResult, err
+	}
+	return obj.(*v1beta1.VirtualMachineRestoreGroup), err
+}
+
+// Delete takes name of the virtualMachineRestoreGroup and deletes it. Returns an error if one occurs.
+func (c *FakeVirtualMachineRestoreGroups) Delete(ctx context.Context, name string, opts v1.DeleteOptions) error {
+	_, err := c.Fake.
+		Invokes(testing.NewDeleteActionWithOptions(virtualmachinerestoregroupsResource, c.ns, name, opts), &v1beta1.VirtualMachineRestoreGroup{})
+
+	return err
+}
+
+// DeleteCollection deletes a collection of objects.
+func (c *FakeVirtualMachineRestoreGroups) DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error {
+	action := testing.NewDeleteCollectionActionWithOptions(virtualmachinerestoregroupsResource, c.ns, opts, listOpts)
+
+	_, err := c.Fake.Invokes(action, &v1beta1.VirtualMachineRestoreGroupList{})
+	return err
+}
+
+// Patch applies the patch and returns the patched virtualMachineRestoreGroup.
+func (c *FakeVirtualMachineRestoreGroups) Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *v1beta1.VirtualMachineRestoreGroup, err error) {
+	emptyResult := &v1beta1.VirtualMachineRestoreGroup{}
+	obj, err := c.Fake.
+		Invokes(testing.NewPatchSubresourceActionWithOptions(virtualmachinerestoregroupsResource, c.ns, name, pt, data, opts, subresources...), emptyResult)
+
+	if obj == nil {
+		return emptyResult, err
+	}
+	return obj.(*v1beta1.VirtualMachineRestoreGroup), err
+}
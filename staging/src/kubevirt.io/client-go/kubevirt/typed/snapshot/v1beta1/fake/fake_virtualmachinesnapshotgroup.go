@@ -0,0 +1,147 @@
+/*
+Copyright The KubeVirt Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by client-gen. DO NOT EDIT.
+
+package fake
+
+import (
+	"context"
+
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	labels "k8s.io/apimachinery/pkg/labels"
+	types "k8s.io/apimachinery/pkg/types"
+	watch "k8s.io/apimachinery/pkg/watch"
+	testing "k8s.io/client-go/testing"
+	v1beta1 "kubevirt.io/api/snapshot/v1beta1"
+)
+
+// FakeVirtualMachineSnapshotGroups implements VirtualMachineSnapshotGroupInterface
+type FakeVirtualMachineSnapshotGroups struct {
+	Fake *FakeSnapshotV1beta1
+	ns   string
+}
+
+var virtualmachinesnapshotgroupsResource = v1beta1.SchemeGroupVersion.WithResource("virtualmachinesnapshotgroups")
+
+var virtualmachinesnapshotgroupsKind = v1beta1.SchemeGroupVersion.WithKind("VirtualMachineSnapshotGroup")
+
+// Get takes name of the virtualMachineSnapshotGroup, and returns the corresponding virtualMachineSnapshotGroup object, and an error if there is any.
+func (c *FakeVirtualMachineSnapshotGroups) Get(ctx context.Context, name string, options v1.GetOptions) (result *v1beta1.VirtualMachineSnapshotGroup, err error) {
+	emptyResult := &v1beta1.VirtualMachineSnapshotGroup{}
+	obj, err := c.Fake.
+		Invokes(testing.NewGetActionWithOptions(virtualmachinesnapshotgroupsResource, c.ns, name, options), emptyResult)
+
+	if obj == nil {
+		return emptyResult, err
+	}
+	return obj.(*v1beta1.VirtualMachineSnapshotGroup), err
+}
+
+// List takes label and field selectors, and returns the list of VirtualMachineSnapshotGroups that match those selectors.
+func (c *FakeVirtualMachineSnapshotGroups) List(ctx context.Context, opts v1.ListOptions) (result *v1beta1.VirtualMachineSnapshotGroupList, err error) {
+	emptyResult := &v1beta1.VirtualMachineSnapshotGroupList{}
+	obj, err := c.Fake.
+		Invokes(testing.NewListActionWithOptions(virtualmachinesnapshotgroupsResource, virtualmachinesnapshotgroupsKind, c.ns, opts), emptyResult)
+
+	if obj == nil {
+		return emptyResult, err
+	}
+
+	label, _, _ := testing.ExtractFromListOptions(opts)
+	if label == nil {
+		label = labels.Everything()
+	}
+	list := &v1beta1.VirtualMachineSnapshotGroupList{ListMeta: obj.(*v1beta1.VirtualMachineSnapshotGroupList).ListMeta}
+	for _, item := range obj.(*v1beta1.VirtualMachineSnapshotGroupList).Items {
+		if label.Matches(labels.Set(item.Labels)) {
+			list.Items = append(list.Items, item)
+		}
+	}
+	return list, err
+}
+
+// Watch returns a watch.Interface that watches the requested virtualMachineSnapshotGroups.
+func (c *FakeVirtualMachineSnapshotGroups) Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error) {
+	return c.Fake.
+		InvokesWatch(testing.NewWatchActionWithOptions(virtualmachinesnapshotgroupsResource, c.ns, opts))
+
+}
+
+// Create takes the representation of a virtualMachineSnapshotGroup and creates it.  Returns the server's representation of the virtualMachineSnapshotGroup, and an error, if there is any.
+func (c *FakeVirtualMachineSnapshotGroups) Create(ctx context.Context, virtualMachineSnapshotGroup *v1beta1.VirtualMachineSnapshotGroup, opts v1.CreateOptions) (result *v1beta1.VirtualMachineSnapshotGroup, err error) {
+	emptyResult := &v1beta1.VirtualMachineSnapshotGroup{}
+	obj, err := c.Fake.
+		Invokes(testing.NewCreateActionWithOptions(virtualmachinesnapshotgroupsResource, c.ns, virtualMachineSnapshotGroup, opts), emptyResult)
+
+	if obj == nil {
+		return emptyResult, err
+	}
+	return obj.(*v1beta1.VirtualMachineSnapshotGroup), err
+}
+
+// Update takes the representation of a virtualMachineSnapshotGroup and updates it. Returns the server's representation of the virtualMachineSnapshotGroup, and an error, if there is any.
+func (c *FakeVirtualMachineSnapshotGroups) Update(ctx context.Context, virtualMachineSnapshotGroup *v1beta1.VirtualMachineSnapshotGroup, opts v1.UpdateOptions) (result *v1beta1.VirtualMachineSnapshotGroup, err error) {
+	emptyResult := &v1beta1.VirtualMachineSnapshotGroup{}
+	obj, err := c.Fake.
+		Invokes(testing.NewUpdateActionWithOptions(virtualmachinesnapshotgroupsResource, c.ns, virtualMachineSnapshotGroup, opts), emptyResult)
+
+	if obj == nil {
+		return emptyResult, err
+	}
+	return obj.(*v1beta1.VirtualMachineSnapshotGroup), err
+}
+
+// UpdateStatus was generated because the type contains a Status member.
+// Add a +genclient:noStatus comment above the type to avoid generating UpdateStatus().
+func (c *FakeVirtualMachineSnapshotGroups) UpdateStatus(ctx context.Context, virtualMachineSnapshotGroup *v1beta1.VirtualMachineSnapshotGroup, opts v1.UpdateOptions) (result *v1beta1.VirtualMachineSnapshotGroup, err error) {
+	emptyResult := &v1beta1.VirtualMachineSnapshotGroup{}
+	obj, err := c.Fake.
+		Invokes(testing.NewUpdateSubresourceActionWithOptions(virtualmachinesnapshotgroupsResource, "status", c.ns, virtualMachineSnapshotGroup, opts), emptyResult)
+
+	if obj == nil {
+		return emptyResult, err
+	}
+	return obj.(*v1beta1.VirtualMachineSnapshotGroup), err
+}
+
+// Delete takes name of the virtualMachineSnapshotGroup and deletes it. Returns an error if one occurs.
+func (c *FakeVirtualMachineSnapshotGroups) Delete(ctx context.Context, name string, opts v1.DeleteOptions) error {
+	_, err := c.Fake.
+		Invokes(testing.NewDeleteActionWithOptions(virtualmachinesnapshotgroupsResource, c.ns, name, opts), &v1beta1.VirtualMachineSnapshotGroup{})
+
+	return err
+}
+
+// DeleteCollection deletes a collection of objects.
+func (c *FakeVirtualMachineSnapshotGroups) DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error {
+	action := testing.NewDeleteCollectionActionWithOptions(virtualmachinesnapshotgroupsResource, c.ns, opts, listOpts)
+
+	_, err := c.Fake.Invokes(action, &v1beta1.VirtualMachineSnapshotGroupList{})
+	return err
+}
+
+// Patch applies the patch and returns the patched virtualMachineSnapshotGroup.
+func (c *FakeVirtualMachineSnapshotGroups) Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *v1beta1.VirtualMachineSnapshotGroup, err error) {
+	emptyResult := &v1beta1.VirtualMachineSnapshotGroup{}
+	obj, err := c.Fake.
+		Invokes(testing.NewPatchSubresourceActionWithOptions(virtualmachinesnapshotgroupsResource, c.ns, name, pt, data, opts, subresources...), emptyResult)
+
+	if obj == nil {
+		return emptyResult, err
+	}
+	return obj.(*v1beta1.VirtualMachineSnapshotGroup), err
+}
@@ -20,6 +20,12 @@ package v1beta1
 
 type VirtualMachineRestoreExpansion interface{}
 
+type VirtualMachineRestoreGroupExpansion interface{}
+
 type VirtualMachineSnapshotExpansion interface{}
 
 type VirtualMachineSnapshotContentExpansion interface{}
+
+type VirtualMachineSnapshotGroupExpansion interface{}
+
+type VirtualMachineSnapshotScheduleExpansion interface{}
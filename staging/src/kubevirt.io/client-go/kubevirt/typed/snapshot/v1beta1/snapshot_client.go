@@ -29,8 +29,11 @@ import (
 type SnapshotV1beta1Interface interface {
 	RESTClient() rest.Interface
 	VirtualMachineRestoresGetter
+	VirtualMachineRestoreGroupsGetter
 	VirtualMachineSnapshotsGetter
 	VirtualMachineSnapshotContentsGetter
+	VirtualMachineSnapshotGroupsGetter
+	VirtualMachineSnapshotSchedulesGetter
 }
 
 // SnapshotV1beta1Client is used to interact with features provided by the snapshot.kubevirt.io group.
@@ -42,6 +45,10 @@ func (c *SnapshotV1beta1Client) VirtualMachineRestores(namespace string) Virtual
 	return newVirtualMachineRestores(c, namespace)
 }
 
+func (c *SnapshotV1beta1Client) VirtualMachineRestoreGroups(namespace string) VirtualMachineRestoreGroupInterface {
+	return newVirtualMachineRestoreGroups(c, namespace)
+}
+
 func (c *SnapshotV1beta1Client) VirtualMachineSnapshots(namespace string) VirtualMachineSnapshotInterface {
 	return newVirtualMachineSnapshots(c, namespace)
 }
@@ -50,6 +57,14 @@ func (c *SnapshotV1beta1Client) VirtualMachineSnapshotContents(namespace string)
 	return newVirtualMachineSnapshotContents(c, namespace)
 }
 
+func (c *SnapshotV1beta1Client) VirtualMachineSnapshotGroups(namespace string) VirtualMachineSnapshotGroupInterface {
+	return newVirtualMachineSnapshotGroups(c, namespace)
+}
+
+func (c *SnapshotV1beta1Client) VirtualMachineSnapshotSchedules(namespace string) VirtualMachineSnapshotScheduleInterface {
+	return newVirtualMachineSnapshotSchedules(c, namespace)
+}
+
 // NewForConfig creates a new SnapshotV1beta1Client for the given config.
 // NewForConfig is equivalent to NewForConfigAndClient(c, httpClient),
 // where httpClient was generated with rest.HTTPClientFor(c).
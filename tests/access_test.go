@@ -305,6 +305,33 @@ var _ = Describe("[rfe_id:500][crit:high][vendor:cnv-qe@redhat.com][level:compon
 				denyModificationsFor("view"),
 				denyAllFor("instancetype:view"),
 				denyAllFor("default")),
+			Entry("given a vmsnapshotschedule",
+				snapshotv1.SchemeGroupVersion.Group,
+				"virtualmachinesnapshotschedules",
+				false,
+				allowAllFor("admin"),
+				denyDeleteCollectionFor("edit"),
+				denyModificationsFor("view"),
+				denyAllFor("instancetype:view"),
+				denyAllFor("default")),
+			Entry("given a vmsnapshotgroup",
+				snapshotv1.SchemeGroupVersion.Group,
+				"virtualmachinesnapshotgroups",
+				false,
+				allowAllFor("admin"),
+				denyDeleteCollectionFor("edit"),
+				denyModificationsFor("view"),
+				denyAllFor("instancetype:view"),
+				denyAllFor("default")),
+			Entry("given a vmrestoregroup",
+				snapshotv1.SchemeGroupVersion.Group,
+				"virtualmachinerestoregroups",
+				false,
+				allowAllFor("admin"),
+				denyDeleteCollectionFor("edit"),
+				denyModificationsFor("view"),
+				denyAllFor("instancetype:view"),
+				denyAllFor("default")),
 			Entry("[test_id:5245]given a vmsrestore",
 				snapshotv1.SchemeGroupVersion.Group,
 				"virtualmachinerestores",
@@ -389,6 +416,10 @@ var _ = Describe("[rfe_id:500][crit:high][vendor:cnv-qe@redhat.com][level:compon
 				"virtualmachines", "migrate",
 				allowUpdateFor("migrate"),
 				denyAllFor("admin", "edit", "view", "default")),
+			Entry("on vmi migrate/check",
+				"virtualmachineinstances", "migrate/check",
+				allowUpdateFor("migrate"),
+				denyAllFor("admin", "edit", "view", "default")),
 			Entry("on vmi guestosinfo",
 				"virtualmachineinstances", "guestosinfo",
 				allowGetFor("admin", "edit", "view"),
@@ -421,6 +452,10 @@ var _ = Describe("[rfe_id:500][crit:high][vendor:cnv-qe@redhat.com][level:compon
 				"virtualmachineinstances", "softreboot",
 				allowUpdateFor("admin", "edit"),
 				denyAllFor("view", "migrate", "default")),
+			Entry("on vmi guestexec",
+				"virtualmachineinstances", "guestexec",
+				allowUpdateFor("admin", "edit"),
+				denyAllFor("view", "migrate", "default")),
 			Entry("on vmi portforward",
 				"virtualmachineinstances", "portforward",
 				allowGetFor("admin", "edit"),
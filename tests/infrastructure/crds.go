@@ -38,7 +38,8 @@ var _ = DescribeInfra("CRDs", func() {
 		ourCRDs := []string{
 			crds.VIRTUALMACHINE, crds.VIRTUALMACHINEINSTANCE, crds.VIRTUALMACHINEINSTANCEPRESET,
 			crds.VIRTUALMACHINEINSTANCEREPLICASET, crds.VIRTUALMACHINEINSTANCEMIGRATION, crds.KUBEVIRT,
-			crds.VIRTUALMACHINESNAPSHOT, crds.VIRTUALMACHINESNAPSHOTCONTENT,
+			crds.VIRTUALMACHINESNAPSHOT, crds.VIRTUALMACHINESNAPSHOTCONTENT, crds.VIRTUALMACHINESNAPSHOTSCHEDULE,
+			crds.VIRTUALMACHINESNAPSHOTGROUP, crds.VIRTUALMACHINERESTOREGROUP,
 			crds.VIRTUALMACHINECLONE,
 			crds.VIRTUALMACHINEEXPORT,
 		}